@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreementsim
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+type timeout struct {
+	delta time.Duration
+	at    time.Duration // simulated time at which the timeout fires
+	ch    chan time.Time
+	fired bool
+}
+
+// clock is a timers.Clock driven by the simulated time of a Simulator.
+//
+// Like timers.Monotonic, it keeps the latest timeout requested for each
+// TimeoutType since it was zeroed; those are the timeouts the node is waiting
+// on. Zero starts a new clock, dropping the timeouts of the previous one.
+type clock struct {
+	mu deadlock.Mutex
+
+	node *node
+	zero time.Duration
+
+	timeouts map[agreement.TimeoutType]*timeout
+}
+
+func makeClock(n *node, zero time.Duration) *clock {
+	return &clock{
+		node:     n,
+		zero:     zero,
+		timeouts: make(map[agreement.TimeoutType]*timeout),
+	}
+}
+
+// Zero implements timers.Clock.
+func (c *clock) Zero() timers.Clock[agreement.TimeoutType] {
+	z := makeClock(c.node, c.node.sim.Now())
+	c.node.setClock(z)
+	return z
+}
+
+// Since implements timers.Clock.
+func (c *clock) Since() time.Duration {
+	return c.node.sim.Now() - c.zero
+}
+
+// TimeoutAt implements timers.Clock.
+func (c *clock) TimeoutAt(delta time.Duration, timeoutType agreement.TimeoutType) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, ok := c.timeouts[timeoutType]
+	if !ok || t.delta != delta {
+		t = &timeout{delta: delta, at: c.zero + delta, ch: make(chan time.Time)}
+		c.timeouts[timeoutType] = t
+	}
+	return t.ch
+}
+
+// Encode implements timers.Clock. Simulated nodes never restore from disk.
+func (c *clock) Encode() []byte {
+	return nil
+}
+
+// Decode implements timers.Clock.
+func (c *clock) Decode([]byte) (timers.Clock[agreement.TimeoutType], error) {
+	return makeClock(c.node, c.node.sim.Now()), nil
+}
+
+// pending returns the earliest timeout the node is waiting on, if any.
+func (c *clock) pending() (t *timeout, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// ties are broken by timeout type, keeping simulations deterministic
+	var tt agreement.TimeoutType
+	for typ, r := range c.timeouts {
+		if r.fired {
+			continue
+		}
+		if t == nil || r.at < t.at || (r.at == t.at && typ < tt) {
+			t, tt = r, typ
+		}
+	}
+	return t, t != nil
+}
+
+// fire expires the given timeout.
+func (c *clock) fire(t *timeout) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t.fired = true
+	close(t.ch)
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreementsim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// simBlock is a block as seen by the simulated block factory and validator.
+type simBlock struct {
+	sim   *Simulator
+	Inner bookkeeping.Block
+}
+
+func (b simBlock) Block() bookkeeping.Block {
+	return b.Inner
+}
+
+func (b simBlock) Round() basics.Round {
+	return b.Inner.Round()
+}
+
+func (b simBlock) FinishBlock(s committee.Seed, proposer basics.Address, eligible bool) agreement.Block {
+	b.Inner.BlockHeader.Seed = s
+	b.Inner.BlockHeader.Proposer = proposer
+	if !eligible {
+		b.Inner.BlockHeader.ProposerPayout = basics.MicroAlgos{}
+	}
+	b.sim.rememberBlock(b.Inner)
+	return agreement.Block(b.Inner)
+}
+
+// blockFactory assembles empty blocks.
+type blockFactory struct {
+	sim *Simulator
+}
+
+func (f blockFactory) AssembleBlock(r basics.Round, _ []basics.Address) (agreement.UnfinishedBlock, error) {
+	return simBlock{sim: f.sim, Inner: bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: r}}}, nil
+}
+
+// blockValidator accepts every block.
+type blockValidator struct {
+	sim *Simulator
+}
+
+func (v blockValidator) Validate(ctx context.Context, b bookkeeping.Block) (agreement.ValidatedBlock, error) {
+	v.sim.rememberBlock(b)
+	return simBlock{sim: v.sim, Inner: b}, nil
+}
+
+// ledger is an in-memory agreement.Ledger with a fixed set of online accounts.
+type ledger struct {
+	mu deadlock.Mutex
+
+	node *node
+
+	blocks    map[basics.Round]bookkeeping.Block
+	certs     map[basics.Round]agreement.Certificate
+	nextRound basics.Round
+
+	accounts    map[basics.Address]basics.OnlineAccountData
+	circulation basics.MicroAlgos
+	version     protocol.ConsensusVersion
+
+	notifications map[basics.Round]chan struct{}
+}
+
+func makeLedger(n *node, accounts map[basics.Address]basics.OnlineAccountData, circulation basics.MicroAlgos, version protocol.ConsensusVersion) *ledger {
+	return &ledger{
+		node:          n,
+		blocks:        map[basics.Round]bookkeeping.Block{0: {}},
+		certs:         make(map[basics.Round]agreement.Certificate),
+		nextRound:     1,
+		accounts:      accounts,
+		circulation:   circulation,
+		version:       version,
+		notifications: make(map[basics.Round]chan struct{}),
+	}
+}
+
+func (l *ledger) NextRound() basics.Round {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.nextRound
+}
+
+func (l *ledger) Wait(r basics.Round) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ch, ok := l.notifications[r]
+	if !ok {
+		ch = make(chan struct{})
+		l.notifications[r] = ch
+		if r < l.nextRound {
+			close(ch)
+		}
+	}
+	return ch
+}
+
+// notify must be called with l.mu held.
+func (l *ledger) notify(r basics.Round) {
+	ch, ok := l.notifications[r]
+	if !ok {
+		ch = make(chan struct{})
+		l.notifications[r] = ch
+	}
+	select {
+	case <-ch:
+	default:
+		close(ch)
+	}
+}
+
+func (l *ledger) Seed(r basics.Round) (committee.Seed, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if r >= l.nextRound {
+		return committee.Seed{}, fmt.Errorf("no seed for round %d: ledger is at round %d", r, l.nextRound-1)
+	}
+	return l.blocks[r].Seed(), nil
+}
+
+func (l *ledger) LookupDigest(r basics.Round) (crypto.Digest, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if r >= l.nextRound {
+		return crypto.Digest{}, fmt.Errorf("no digest for round %d: ledger is at round %d", r, l.nextRound-1)
+	}
+	return l.blocks[r].Digest(), nil
+}
+
+func (l *ledger) LookupAgreement(r basics.Round, a basics.Address) (basics.OnlineAccountData, error) {
+	return l.accounts[a], nil
+}
+
+func (l *ledger) Circulation(r basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error) {
+	return l.circulation, nil
+}
+
+func (l *ledger) ConsensusParams(r basics.Round) (config.ConsensusParams, error) {
+	return config.Consensus[l.version], nil
+}
+
+func (l *ledger) ConsensusVersion(r basics.Round) (protocol.ConsensusVersion, error) {
+	return l.version, nil
+}
+
+func (l *ledger) EnsureValidatedBlock(vb agreement.ValidatedBlock, c agreement.Certificate) {
+	l.EnsureBlock(vb.Block(), c)
+}
+
+func (l *ledger) EnsureBlock(b bookkeeping.Block, c agreement.Certificate) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r := b.Round()
+	if existing, ok := l.blocks[r]; ok && r < l.nextRound {
+		if existing.Digest() != b.Digest() {
+			l.node.sim.reportFork(l.node.id, r)
+		}
+		return
+	}
+	if r != l.nextRound {
+		// the agreement service only writes the next round
+		return
+	}
+
+	l.blocks[r] = b
+	l.certs[r] = c
+	l.nextRound++
+	l.notify(r)
+	l.node.sim.recordCommit(l.node.id, r, uint64(c.Period))
+}
+
+// EnsureDigest fetches the certified block from the blocks seen by the
+// simulation, standing in for the catchup service.
+func (l *ledger) EnsureDigest(c agreement.Certificate, verifier *agreement.AsyncVoteVerifier) {
+	b, ok := l.node.sim.lookupBlock(c.Proposal.BlockDigest)
+	if !ok {
+		return
+	}
+	l.EnsureBlock(b, c)
+}
+
+// catchup copies the blocks of o which l is missing.
+func (l *ledger) catchup(o *ledger) (rounds []basics.Round, periods []uint64) {
+	o.mu.Lock()
+	blocks := make([]bookkeeping.Block, 0)
+	certs := make([]agreement.Certificate, 0)
+	l.mu.Lock()
+	for r := l.nextRound; r < o.nextRound; r++ {
+		blocks = append(blocks, o.blocks[r])
+		certs = append(certs, o.certs[r])
+	}
+	o.mu.Unlock()
+
+	for i, b := range blocks {
+		r := b.Round()
+		l.blocks[r] = b
+		l.certs[r] = certs[i]
+		l.nextRound++
+		l.notify(r)
+		rounds = append(rounds, r)
+		periods = append(periods, uint64(certs[i].Period))
+	}
+	l.mu.Unlock()
+	return
+}
@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreementsim
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// messageQueueLength is the capacity of each node's incoming message queues.
+const messageQueueLength = 1024
+
+// node is a single simulated agreement participant. It implements
+// agreement.Network, agreement.RandomSource, and
+// agreement.EventsProcessingMonitor.
+type node struct {
+	id  int
+	sim *Simulator
+
+	service *agreement.Service
+	ledger  *ledger
+
+	voteCh     chan agreement.Message
+	proposalCh chan agreement.Message
+	bundleCh   chan agreement.Message
+
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	clock *clock
+	rand  *rand.Rand
+
+	// queues holds the lengths of the event queues reported by the demux.
+	queues map[string]int
+	// demuxWaiting is set while the demux is blocked waiting for input.
+	demuxWaiting bool
+	// pendingDeliveries counts the messages which have been handed to the
+	// node but not yet picked up by its tokenizers.
+	pendingDeliveries int
+	// pendingWakeup is set when an input (a timeout or a ledger
+	// notification) has been signaled but the demux has not yet woken up.
+	pendingWakeup bool
+	// expired is set when waitIdle gives up.
+	expired bool
+}
+
+func makeNode(sim *Simulator, id int, seed int64) *node {
+	n := &node{
+		id:         id,
+		sim:        sim,
+		voteCh:     make(chan agreement.Message, messageQueueLength),
+		proposalCh: make(chan agreement.Message, messageQueueLength),
+		bundleCh:   make(chan agreement.Message, messageQueueLength),
+		rand:       rand.New(rand.NewSource(seed)),
+		queues:     make(map[string]int),
+	}
+	n.cond = sync.NewCond(&n.mu)
+	n.clock = makeClock(n, 0)
+	return n
+}
+
+// messageHandle identifies the sender of a delivered message.
+type messageHandle struct {
+	source int
+}
+
+// Messages implements agreement.Network.
+func (n *node) Messages(tag protocol.Tag) <-chan agreement.Message {
+	return n.inbox(tag)
+}
+
+func (n *node) inbox(tag protocol.Tag) chan agreement.Message {
+	switch tag {
+	case protocol.AgreementVoteTag:
+		return n.voteCh
+	case protocol.ProposalPayloadTag:
+		return n.proposalCh
+	case protocol.VoteBundleTag:
+		return n.bundleCh
+	default:
+		panic(fmt.Sprintf("agreementsim: unexpected message tag %v", tag))
+	}
+}
+
+// Broadcast implements agreement.Network.
+func (n *node) Broadcast(tag protocol.Tag, data []byte) error {
+	n.sim.send(n.id, -1, tag, data)
+	return nil
+}
+
+// Relay implements agreement.Network.
+func (n *node) Relay(h agreement.MessageHandle, tag protocol.Tag, data []byte) error {
+	exclude := -1
+	if mh, ok := h.(*messageHandle); ok {
+		exclude = mh.source
+	}
+	n.sim.send(n.id, exclude, tag, data)
+	return nil
+}
+
+// Disconnect implements agreement.Network. Simulated peers stay connected.
+func (n *node) Disconnect(agreement.MessageHandle) {}
+
+// Start implements agreement.Network.
+func (n *node) Start() {}
+
+// Uint64 implements agreement.RandomSource.
+func (n *node) Uint64() uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.rand.Uint64()
+}
+
+// UpdateEventsQueue implements agreement.EventsProcessingMonitor.
+func (n *node) UpdateEventsQueue(queueName string, queueLength int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if strings.HasPrefix(queueName, "Tokenizing") && queueLength > 0 && n.pendingDeliveries > 0 {
+		n.pendingDeliveries--
+	}
+	if queueName == "demux" {
+		n.demuxWaiting = queueLength == 0
+		if queueLength > 0 {
+			n.pendingWakeup = false
+		}
+	}
+	n.queues[queueName] = queueLength
+	n.cond.Broadcast()
+}
+
+func (n *node) setClock(c *clock) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.clock = c
+}
+
+func (n *node) currentClock() *clock {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.clock
+}
+
+// deliver hands a message to the node's tokenizers.
+func (n *node) deliver(source int, tag protocol.Tag, data []byte) bool {
+	n.mu.Lock()
+	n.pendingDeliveries++
+	n.mu.Unlock()
+
+	select {
+	case n.inbox(tag) <- agreement.Message{MessageHandle: &messageHandle{source: source}, Data: data}:
+		return true
+	default:
+		n.mu.Lock()
+		n.pendingDeliveries--
+		n.mu.Unlock()
+		return false
+	}
+}
+
+// wakeup records that an input the demux is waiting on is about to be signaled.
+func (n *node) wakeup() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.pendingWakeup = true
+}
+
+// idle must be called with n.mu held.
+func (n *node) idle() bool {
+	if !n.demuxWaiting || n.pendingDeliveries > 0 || n.pendingWakeup {
+		return false
+	}
+	for _, l := range n.queues {
+		if l > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// waitIdle blocks until the node has finished processing its inputs and is
+// waiting for new ones, or until the given (real) time has passed.
+func (n *node) waitIdle(limit time.Duration) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.expired = false
+	timer := time.AfterFunc(limit, func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		n.expired = true
+		n.cond.Broadcast()
+	})
+	defer timer.Stop()
+
+	for !n.idle() {
+		if n.expired {
+			return fmt.Errorf("agreementsim: node %d did not become idle within %v (%s)", n.id, limit, n.queueSummary())
+		}
+		n.cond.Wait()
+	}
+	return nil
+}
+
+// queueSummary must be called with n.mu held.
+func (n *node) queueSummary() string {
+	names := make([]string, 0, len(n.queues))
+	for name := range n.queues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names)+3)
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s=%d", name, n.queues[name]))
+	}
+	parts = append(parts, fmt.Sprintf("deliveries=%d", n.pendingDeliveries), fmt.Sprintf("wakeup=%v", n.pendingWakeup), fmt.Sprintf("waiting=%v", n.demuxWaiting))
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,660 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package agreementsim runs a committee of agreement services over a
+// simulated network, driven by simulated clocks, to evaluate how the protocol
+// behaves under latency, partitions, message loss, and various stake
+// distributions.
+//
+// Simulated time only advances when every node is idle, so a run does not
+// depend on the speed of the machine it runs on, and runs with the same
+// configuration and seed proceed identically.
+package agreementsim
+
+import (
+	"bytes"
+	"container/heap"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/agreement/agreementtest"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// DefaultMaxRoundDuration bounds the simulated time of a run whose
+// MaxDuration is not set, per round to be finalized.
+const DefaultMaxRoundDuration = 10 * time.Minute
+
+// idleWaitLimit bounds the real time a node may take to process its inputs
+// before the simulation is aborted.
+const idleWaitLimit = 30 * time.Second
+
+// A Partition splits the network into groups of nodes which cannot exchange
+// messages for a window of simulated time.
+type Partition struct {
+	// Start and End bound the window of simulated time (inclusive, exclusive)
+	// during which the partition is in effect.
+	Start time.Duration
+	End   time.Duration
+
+	// Groups lists the node indices of each side of the partition. Nodes
+	// which are not listed form one more group.
+	Groups [][]int
+}
+
+// Config describes a simulation.
+type Config struct {
+	// Stakes holds the stake, in microAlgos, of the single participation
+	// account of each node. The committee has one node per entry.
+	Stakes []uint64
+
+	// Rounds is the number of rounds every node must finalize.
+	Rounds int
+
+	// MaxDuration is the simulated time after which the run is considered
+	// stalled. If zero, it is Rounds times DefaultMaxRoundDuration.
+	MaxDuration time.Duration
+
+	// Seed seeds the keys of the participation accounts, the network
+	// randomness, and the random sources of the agreement services.
+	Seed int64
+
+	// Latency is the one-way delay of every message, to which a uniformly
+	// random delay in [0, Jitter) is added.
+	Latency time.Duration
+	Jitter  time.Duration
+
+	// DropRate is the probability that a message is lost on its way from
+	// one node to another.
+	DropRate float64
+
+	// Partitions lists the partitions the network goes through.
+	Partitions []Partition
+
+	// CatchupDelay is how long a node may stay behind the most advanced node
+	// before it fetches the missing blocks, standing in for the catchup
+	// service. If zero, nodes never catch up.
+	CatchupDelay time.Duration
+
+	// ConsensusVersion is the consensus version used by every node. If empty,
+	// protocol.ConsensusCurrentVersion is used.
+	ConsensusVersion protocol.ConsensusVersion
+
+	// CadaverDirectory, if set, is where each node records its cadaver
+	// (node-<index>.cdv).
+	CadaverDirectory string
+
+	// Logger receives the logs of the agreement services. If nil, they are
+	// discarded.
+	Logger logging.Logger
+}
+
+// MessageStats counts the messages of a single type.
+type MessageStats struct {
+	// Sent counts the messages sent from one node to another.
+	Sent uint64 `json:"sent"`
+	// Delivered counts the messages which reached their destination.
+	Delivered uint64 `json:"delivered"`
+	// Dropped counts the messages lost to DropRate or partitions, or
+	// discarded because the receiver's queue was full.
+	Dropped uint64 `json:"dropped"`
+}
+
+// RoundResult describes how a single round was finalized.
+type RoundResult struct {
+	Round basics.Round `json:"round"`
+
+	// Periods is the number of periods it took to certify the block.
+	Periods uint64 `json:"periods"`
+
+	// FirstFinalized and AllFinalized are the simulated times at which the
+	// first and the last node wrote the block to their ledger.
+	FirstFinalized time.Duration `json:"first-finalized"`
+	AllFinalized   time.Duration `json:"all-finalized,omitempty"`
+
+	// Duration is the time between the first finalization of the previous
+	// round (or the start of the simulation) and the first finalization of
+	// this one.
+	Duration time.Duration `json:"duration"`
+
+	finalized int
+}
+
+// Result summarizes a simulation.
+type Result struct {
+	Rounds []RoundResult `json:"rounds"`
+
+	// Duration is the simulated time the run took.
+	Duration time.Duration `json:"duration"`
+
+	// Stalled is set if some node did not finalize all rounds within
+	// MaxDuration.
+	Stalled bool `json:"stalled"`
+
+	// Forks lists the nodes and rounds at which a node was asked to write a
+	// block which conflicted with the one already in its ledger.
+	Forks []string `json:"forks,omitempty"`
+
+	// Messages counts the messages by type.
+	Messages map[protocol.Tag]MessageStats `json:"messages"`
+}
+
+// A Simulator runs agreement services over a simulated network.
+type Simulator struct {
+	cfg   Config
+	nodes []*node
+	now   atomic.Int64
+
+	accessors []db.Accessor
+
+	mu       deadlock.Mutex // guards the fields below
+	queue    envelopeQueue
+	seq      uint64
+	messages map[protocol.Tag]*MessageStats
+	blocks   map[crypto.Digest]bookkeeping.Block
+	rounds   map[basics.Round]*RoundResult
+	forks    []string
+}
+
+var simulatorCount atomic.Uint64
+
+// MakeSimulator creates the nodes of a simulation.
+func MakeSimulator(cfg Config) (*Simulator, error) {
+	if len(cfg.Stakes) == 0 {
+		return nil, errors.New("agreementsim: no nodes configured")
+	}
+	if cfg.Rounds <= 0 {
+		return nil, errors.New("agreementsim: the number of rounds must be positive")
+	}
+	if cfg.DropRate < 0 || cfg.DropRate >= 1 {
+		return nil, fmt.Errorf("agreementsim: drop rate %v is not in [0, 1)", cfg.DropRate)
+	}
+	if cfg.ConsensusVersion == "" {
+		cfg.ConsensusVersion = protocol.ConsensusCurrentVersion
+	}
+	proto, ok := config.Consensus[cfg.ConsensusVersion]
+	if !ok {
+		return nil, fmt.Errorf("agreementsim: unknown consensus version %v", cfg.ConsensusVersion)
+	}
+	if cfg.MaxDuration == 0 {
+		cfg.MaxDuration = time.Duration(cfg.Rounds) * DefaultMaxRoundDuration
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = logging.NewLogger()
+		cfg.Logger.SetOutput(io.Discard)
+	}
+
+	s := &Simulator{
+		cfg:      cfg,
+		messages: make(map[protocol.Tag]*MessageStats),
+		blocks:   make(map[crypto.Digest]bookkeeping.Block),
+		rounds:   make(map[basics.Round]*RoundResult),
+	}
+	for _, tag := range []protocol.Tag{protocol.AgreementVoteTag, protocol.ProposalPayloadTag, protocol.VoteBundleTag} {
+		s.messages[tag] = &MessageStats{}
+	}
+
+	// keys for a round are taken from batch round/keyDilution
+	keyDilution := proto.DefaultKeyDilution
+	batches := uint64(cfg.Rounds)/keyDilution + 2
+	lastValid := basics.Round(batches*keyDilution - 1)
+
+	parts := make([]account.Participation, len(cfg.Stakes))
+	accounts := make(map[basics.Address]basics.OnlineAccountData, len(cfg.Stakes))
+	var circulation basics.MicroAlgos
+	for i, stake := range cfg.Stakes {
+		seed := sha256.Sum256([]byte(fmt.Sprintf("agreementsim-%d-%d", cfg.Seed, i)))
+		vrfPK, vrfSK := crypto.VrfKeygenFromSeed(seed)
+		parts[i] = account.Participation{
+			Parent:      basics.Address(crypto.Hash(seed[:])),
+			VRF:         &crypto.VRFSecrets{PK: vrfPK, SK: vrfSK},
+			Voting:      crypto.GenerateOneTimeSignatureSecretsRNG(0, batches, crypto.MakePRNG(seed[:])),
+			FirstValid:  0,
+			LastValid:   lastValid,
+			KeyDilution: keyDilution,
+		}
+		accounts[parts[i].Parent] = basics.OnlineAccountData{
+			MicroAlgosWithRewards: basics.MicroAlgos{Raw: stake},
+			VotingData: basics.VotingData{
+				VoteID:          parts[i].Voting.OneTimeSignatureVerifier,
+				SelectionID:     vrfPK,
+				VoteFirstValid:  0,
+				VoteLastValid:   lastValid,
+				VoteKeyDilution: keyDilution,
+			},
+		}
+		circulation.Raw += stake
+	}
+
+	instance := simulatorCount.Add(1)
+	for i := range cfg.Stakes {
+		n := makeNode(s, i, cfg.Seed+int64(i))
+		n.ledger = makeLedger(n, accounts, circulation, cfg.ConsensusVersion)
+
+		accessor, err := db.MakeAccessor(fmt.Sprintf("agreementsim-%d-%d-crash.db", instance, i), false, true)
+		if err != nil {
+			s.closeAccessors()
+			return nil, err
+		}
+		s.accessors = append(s.accessors, accessor)
+
+		local := config.GetDefaultLocal()
+		local.CadaverDirectory = cfg.CadaverDirectory
+
+		logger := cfg.Logger.WithFields(logging.Fields{"Source": fmt.Sprintf("node-%d", i)})
+		n.service, err = agreement.MakeService(agreement.Parameters{
+			Logger:                  logger,
+			Ledger:                  n.ledger,
+			Network:                 n,
+			KeyManager:              agreementtest.SimpleKeyManager(parts[i : i+1]),
+			BlockValidator:          blockValidator{sim: s},
+			BlockFactory:            blockFactory{sim: s},
+			Clock:                   n.clock,
+			Accessor:                accessor,
+			Local:                   local,
+			RandomSource:            n,
+			EventsProcessingMonitor: n,
+		})
+		if err != nil {
+			s.closeAccessors()
+			return nil, err
+		}
+		cadaverName := ""
+		if cfg.CadaverDirectory != "" {
+			cadaverName = fmt.Sprintf("node-%d", i)
+		}
+		n.service.SetTracerFilename(cadaverName)
+		s.nodes = append(s.nodes, n)
+	}
+	return s, nil
+}
+
+// Now returns the current simulated time.
+func (s *Simulator) Now() time.Duration {
+	return time.Duration(s.now.Load())
+}
+
+func (s *Simulator) closeAccessors() {
+	for _, a := range s.accessors {
+		a.Close()
+	}
+}
+
+// Run starts the nodes, drives them until every node has finalized
+// cfg.Rounds rounds or the simulation stalls, and shuts them down.
+//
+// A Simulator may only be run once.
+func (s *Simulator) Run() (res Result, err error) {
+	defer s.closeAccessors()
+
+	for _, n := range s.nodes {
+		n.service.Start()
+	}
+	defer func() {
+		for _, n := range s.nodes {
+			n.service.Shutdown()
+		}
+	}()
+	for _, n := range s.nodes {
+		if err = n.waitIdle(idleWaitLimit); err != nil {
+			return
+		}
+	}
+
+	target := basics.Round(s.cfg.Rounds + 1)
+	behindSince := make([]time.Duration, len(s.nodes))
+	for i := range behindSince {
+		behindSince[i] = -1
+	}
+
+	stalled := false
+	for {
+		lowest, highest := s.nodes[0].ledger.NextRound(), s.nodes[0].ledger.NextRound()
+		for _, n := range s.nodes[1:] {
+			r := n.ledger.NextRound()
+			lowest = min(lowest, r)
+			highest = max(highest, r)
+		}
+		if lowest >= target {
+			break
+		}
+
+		// track nodes which fell behind, for catchup
+		for i, n := range s.nodes {
+			if n.ledger.NextRound() >= highest {
+				behindSince[i] = -1
+			} else if behindSince[i] < 0 {
+				behindSince[i] = s.Now()
+			}
+		}
+
+		ev, ok := s.nextEvent(behindSince)
+		if !ok || ev.at > s.cfg.MaxDuration {
+			stalled = true
+			break
+		}
+		s.now.Store(int64(ev.at))
+		n := s.nodes[ev.node]
+
+		switch {
+		case ev.envelope != nil:
+			s.deliver(ev.envelope)
+		case ev.timeout != nil:
+			n.wakeup()
+			ev.clock.fire(ev.timeout)
+		default:
+			s.catchup(ev.node)
+			behindSince[ev.node] = -1
+		}
+		if err = n.waitIdle(idleWaitLimit); err != nil {
+			return
+		}
+	}
+
+	res = s.result(stalled)
+	return
+}
+
+// event is the next thing to happen in the simulation.
+type event struct {
+	at   time.Duration
+	node int
+
+	// exactly one of envelope, timeout, or neither (a catchup) is set
+	envelope *envelope
+	timeout  *timeout
+	clock    *clock
+}
+
+// nextEvent returns the earliest pending event. Message deliveries come
+// before timeouts, which come before catchups, and ties between timeouts or
+// catchups are broken by node index.
+func (s *Simulator) nextEvent(behindSince []time.Duration) (ev event, ok bool) {
+	s.mu.Lock()
+	if len(s.queue) > 0 {
+		e := s.queue[0]
+		ev, ok = event{at: e.at, node: e.dest, envelope: e}, true
+	}
+	s.mu.Unlock()
+
+	for i, n := range s.nodes {
+		c := n.currentClock()
+		t, has := c.pending()
+		if has && (!ok || t.at < ev.at) {
+			ev, ok = event{at: t.at, node: i, timeout: t, clock: c}, true
+		}
+	}
+
+	if s.cfg.CatchupDelay > 0 {
+		for i, since := range behindSince {
+			if since < 0 {
+				continue
+			}
+			at := since + s.cfg.CatchupDelay
+			if !ok || at < ev.at {
+				ev, ok = event{at: at, node: i}, true
+			}
+		}
+	}
+
+	if ok && ev.envelope != nil {
+		s.mu.Lock()
+		heap.Pop(&s.queue)
+		s.mu.Unlock()
+	}
+	if ok && ev.at < s.Now() {
+		// timeouts which were set in the past fire right away
+		ev.at = s.Now()
+	}
+	return
+}
+
+// partitioned reports whether nodes a and b cannot communicate at time t.
+func (s *Simulator) partitioned(a, b int, t time.Duration) bool {
+	group := func(p Partition, n int) int {
+		for g, nodes := range p.Groups {
+			for _, m := range nodes {
+				if m == n {
+					return g
+				}
+			}
+		}
+		return len(p.Groups)
+	}
+
+	for _, p := range s.cfg.Partitions {
+		if t < p.Start || t >= p.End {
+			continue
+		}
+		if group(p, a) != group(p, b) {
+			return true
+		}
+	}
+	return false
+}
+
+// send queues a message from source to every other node except exclude.
+func (s *Simulator) send(source, exclude int, tag protocol.Tag, data []byte) {
+	now := s.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.messages[tag]
+	if !ok {
+		stats = &MessageStats{}
+		s.messages[tag] = stats
+	}
+	for dest := range s.nodes {
+		if dest == source || dest == exclude {
+			continue
+		}
+		stats.Sent++
+		fate := s.fate(source, dest, tag, data)
+		drop := float64(binary.BigEndian.Uint64(fate[0:8])>>11) / (1 << 53)
+		if s.partitioned(source, dest, now) || drop < s.cfg.DropRate {
+			stats.Dropped++
+			continue
+		}
+		delay := s.cfg.Latency
+		if s.cfg.Jitter > 0 {
+			delay += time.Duration(binary.BigEndian.Uint64(fate[8:16]) % uint64(s.cfg.Jitter))
+		}
+		s.seq++
+		heap.Push(&s.queue, &envelope{at: now + delay, seq: s.seq, source: source, dest: dest, tag: tag, data: data, fate: fate})
+	}
+}
+
+// fate returns the randomness which decides whether a message is dropped and
+// how long it is delayed. It is derived from the message rather than drawn in
+// sequence, since a node may send several messages in response to a single
+// input in an order which depends on goroutine scheduling.
+func (s *Simulator) fate(source, dest int, tag protocol.Tag, data []byte) [32]byte {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d/%d/%d/%s/", s.cfg.Seed, source, dest, tag)
+	h.Write(data)
+	var fate [32]byte
+	h.Sum(fate[:0])
+	return fate
+}
+
+func (s *Simulator) deliver(e *envelope) {
+	delivered := s.nodes[e.dest].deliver(e.source, e.tag, e.data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if delivered {
+		s.messages[e.tag].Delivered++
+	} else {
+		s.messages[e.tag].Dropped++
+	}
+}
+
+// catchup brings a node's ledger up to date with the most advanced node.
+func (s *Simulator) catchup(nodeID int) {
+	leader := s.nodes[0]
+	for _, n := range s.nodes[1:] {
+		if n.ledger.NextRound() > leader.ledger.NextRound() {
+			leader = n
+		}
+	}
+
+	n := s.nodes[nodeID]
+	n.wakeup()
+	rounds, periods := n.ledger.catchup(leader.ledger)
+	for i, r := range rounds {
+		s.recordCommit(nodeID, r, periods[i])
+	}
+}
+
+func (s *Simulator) rememberBlock(b bookkeeping.Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blocks[b.Digest()] = b
+}
+
+func (s *Simulator) lookupBlock(d crypto.Digest) (bookkeeping.Block, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.blocks[d]
+	return b, ok
+}
+
+func (s *Simulator) recordCommit(nodeID int, r basics.Round, period uint64) {
+	now := s.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rr, ok := s.rounds[r]
+	if !ok {
+		rr = &RoundResult{Round: r, Periods: period + 1, FirstFinalized: now}
+		s.rounds[r] = rr
+	}
+	rr.finalized++
+	if rr.finalized == len(s.nodes) {
+		rr.AllFinalized = now
+	}
+}
+
+func (s *Simulator) reportFork(nodeID int, r basics.Round) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.forks = append(s.forks, fmt.Sprintf("node %d at round %d", nodeID, r))
+}
+
+func (s *Simulator) result(stalled bool) Result {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := Result{
+		Duration: s.Now(),
+		Stalled:  stalled,
+		Forks:    append([]string(nil), s.forks...),
+		Messages: make(map[protocol.Tag]MessageStats, len(s.messages)),
+	}
+	for tag, stats := range s.messages {
+		res.Messages[tag] = *stats
+	}
+	for _, rr := range s.rounds {
+		res.Rounds = append(res.Rounds, *rr)
+	}
+	sort.Slice(res.Rounds, func(i, j int) bool { return res.Rounds[i].Round < res.Rounds[j].Round })
+
+	prev := time.Duration(0)
+	for i := range res.Rounds {
+		res.Rounds[i].Duration = res.Rounds[i].FirstFinalized - prev
+		prev = res.Rounds[i].FirstFinalized
+	}
+	return res
+}
+
+// RegisterConsensusVersion registers a copy of the consensus parameters of
+// base, changed by modify, under a new consensus version, so that parameter
+// changes can be simulated before they are adopted.
+func RegisterConsensusVersion(name, base protocol.ConsensusVersion, modify func(*config.ConsensusParams)) error {
+	params, ok := config.Consensus[base]
+	if !ok {
+		return fmt.Errorf("agreementsim: unknown consensus version %v", base)
+	}
+	if _, exists := config.Consensus[name]; exists {
+		return fmt.Errorf("agreementsim: consensus version %v already exists", name)
+	}
+	modify(&params)
+	config.Consensus[name] = params
+	return nil
+}
+
+// envelope is a message in flight from one node to another.
+type envelope struct {
+	at     time.Duration
+	seq    uint64
+	source int
+	dest   int
+	tag    protocol.Tag
+	data   []byte
+	fate   [32]byte
+}
+
+// envelopeQueue is a heap of envelopes ordered by delivery time. Envelopes
+// due at the same time are ordered by their contents so that the order does
+// not depend on the order in which they were sent.
+type envelopeQueue []*envelope
+
+func (q envelopeQueue) Len() int { return len(q) }
+
+func (q envelopeQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	if q[i].dest != q[j].dest {
+		return q[i].dest < q[j].dest
+	}
+	if q[i].source != q[j].source {
+		return q[i].source < q[j].source
+	}
+	if c := bytes.Compare(q[i].fate[:], q[j].fate[:]); c != 0 {
+		return c < 0
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q envelopeQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *envelopeQueue) Push(x any) { *q = append(*q, x.(*envelope)) }
+
+func (q *envelopeQueue) Pop() any {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return e
+}
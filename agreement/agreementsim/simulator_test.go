@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreementsim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func equalStakes(n int) []uint64 {
+	stakes := make([]uint64, n)
+	for i := range stakes {
+		stakes[i] = 1_000_000_000_000
+	}
+	return stakes
+}
+
+func TestSimulatorFinalizesRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim, err := MakeSimulator(Config{
+		Stakes:  equalStakes(5),
+		Rounds:  3,
+		Seed:    1,
+		Latency: 50 * time.Millisecond,
+		Jitter:  20 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	res, err := sim.Run()
+	require.NoError(t, err)
+	require.False(t, res.Stalled)
+	require.Empty(t, res.Forks)
+	require.Len(t, res.Rounds, 3)
+	for i, rr := range res.Rounds {
+		require.EqualValues(t, i+1, rr.Round)
+		require.EqualValues(t, 1, rr.Periods)
+		require.NotZero(t, rr.AllFinalized)
+		require.GreaterOrEqual(t, rr.AllFinalized, rr.FirstFinalized)
+	}
+	require.NotZero(t, res.Messages[protocol.AgreementVoteTag].Delivered)
+	require.NotZero(t, res.Messages[protocol.ProposalPayloadTag].Delivered)
+}
+
+func TestSimulatorDeterministic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := Config{
+		Stakes:   equalStakes(4),
+		Rounds:   2,
+		Seed:     7,
+		Latency:  30 * time.Millisecond,
+		Jitter:   40 * time.Millisecond,
+		DropRate: 0.05,
+	}
+
+	run := func() Result {
+		sim, err := MakeSimulator(cfg)
+		require.NoError(t, err)
+		res, err := sim.Run()
+		require.NoError(t, err)
+		return res
+	}
+
+	first := run()
+	require.False(t, first.Stalled)
+	second := run()
+	require.Equal(t, first.Duration, second.Duration)
+	require.Equal(t, first.Rounds, second.Rounds)
+	require.Equal(t, first.Messages, second.Messages)
+}
+
+func TestSimulatorPartition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// no side holds a quorum until the partition heals
+	sim, err := MakeSimulator(Config{
+		Stakes:       equalStakes(4),
+		Rounds:       2,
+		Seed:         3,
+		Latency:      50 * time.Millisecond,
+		CatchupDelay: 10 * time.Second,
+		Partitions: []Partition{{
+			Start:  0,
+			End:    30 * time.Second,
+			Groups: [][]int{{0, 1}},
+		}},
+	})
+	require.NoError(t, err)
+
+	res, err := sim.Run()
+	require.NoError(t, err)
+	require.False(t, res.Stalled)
+	require.Empty(t, res.Forks)
+	require.Len(t, res.Rounds, 2)
+	require.Greater(t, res.Rounds[0].Periods, uint64(1))
+	require.GreaterOrEqual(t, res.Rounds[0].FirstFinalized, 30*time.Second)
+	require.NotZero(t, res.Messages[protocol.AgreementVoteTag].Dropped)
+}
+
+func TestSimulatorStalls(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim, err := MakeSimulator(Config{
+		Stakes:      equalStakes(3),
+		Rounds:      1,
+		Seed:        5,
+		MaxDuration: time.Minute,
+		Partitions: []Partition{{
+			End:    time.Hour,
+			Groups: [][]int{{0}, {1}},
+		}},
+	})
+	require.NoError(t, err)
+
+	res, err := sim.Run()
+	require.NoError(t, err)
+	require.True(t, res.Stalled)
+	require.Empty(t, res.Rounds)
+}
+
+func TestRegisterConsensusVersion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	name := protocol.ConsensusVersion("agreementsim-test-slow-filter")
+	err := RegisterConsensusVersion(name, protocol.ConsensusCurrentVersion, func(p *config.ConsensusParams) {
+		p.AgreementFilterTimeoutPeriod0 = 10 * time.Second
+		p.DynamicFilterTimeout = false
+	})
+	require.NoError(t, err)
+	defer delete(config.Consensus, name)
+	require.Error(t, RegisterConsensusVersion(name, protocol.ConsensusCurrentVersion, func(*config.ConsensusParams) {}))
+
+	sim, err := MakeSimulator(Config{
+		Stakes:           equalStakes(3),
+		Rounds:           1,
+		Seed:             2,
+		ConsensusVersion: name,
+	})
+	require.NoError(t, err)
+
+	res, err := sim.Run()
+	require.NoError(t, err)
+	require.False(t, res.Stalled)
+	require.Len(t, res.Rounds, 1)
+	require.GreaterOrEqual(t, res.Rounds[0].FirstFinalized, 10*time.Second)
+}
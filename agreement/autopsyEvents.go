@@ -0,0 +1,255 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/algorand/go-algorand/logging"
+)
+
+//msgp:ignore AutopsyEvent AutopsyPlayerState AutopsyEventFilter
+
+// An AutopsyEvent is a structured record of a single input event delivered to
+// the player state machine during an autopsy.
+type AutopsyEvent struct {
+	// Seq is the cadaver sequence (the "run") the event belongs to, and Index
+	// is the position of the event within that sequence.
+	Seq   int `json:"seq"`
+	Index int `json:"index"`
+
+	// Round, Period, and Step hold the position of the player when the event
+	// was delivered.
+	Round  uint64 `json:"round"`
+	Period uint64 `json:"period"`
+	Step   uint64 `json:"step"`
+
+	// Type is the name of the event type, e.g. "voteVerified".
+	Type string `json:"type"`
+
+	// Input describes the message or timeout carried by the event. It does
+	// not depend on the node which recorded the cadaver, so it may be used to
+	// match up inputs across cadavers from different nodes.
+	Input string `json:"input,omitempty"`
+
+	// Err is set if verification of the input failed.
+	Err string `json:"err,omitempty"`
+
+	// Actions holds the actions the player recorded in response to the event.
+	Actions []string `json:"actions,omitempty"`
+
+	// State holds the player state reconstructed after the event was handled.
+	State AutopsyPlayerState `json:"state"`
+}
+
+// AutopsyPlayerState is a snapshot of the player state machine reconstructed
+// while replaying a cadaver.
+type AutopsyPlayerState struct {
+	Round                uint64        `json:"round"`
+	Period               uint64        `json:"period"`
+	Step                 uint64        `json:"step"`
+	LastConcluding       uint64        `json:"last-concluding"`
+	Deadline             time.Duration `json:"deadline"`
+	Napping              bool          `json:"napping,omitempty"`
+	FastRecoveryDeadline time.Duration `json:"fast-recovery-deadline,omitempty"`
+	PendingProposals     int           `json:"pending-proposals,omitempty"`
+
+	// Pinned and Staging hold the digests of the pinned proposal of the
+	// round and the staged proposal of the period, if any.
+	Pinned  string `json:"pinned,omitempty"`
+	Staging string `json:"staging,omitempty"`
+
+	// FreshestBundle describes the freshest bundle observed in the round, if any.
+	FreshestBundle string `json:"freshest-bundle,omitempty"`
+
+	// Blocks lists the proposals received in the round, sorted by digest.
+	Blocks []string `json:"blocks,omitempty"`
+}
+
+// AutopsyEventFilter selects the events reported by Autopsy.Events.
+type AutopsyEventFilter struct {
+	Rounds AutopsyFilter
+
+	PeriodsEnabled bool   // do not filter on period if this is false
+	FirstPeriod    uint64 // inclusive
+	LastPeriod     uint64 // inclusive
+
+	// Types holds the names of the event types to report; if empty, all
+	// event types are reported.
+	Types []string
+}
+
+func (f AutopsyEventFilter) match(e AutopsyEvent) bool {
+	if f.Rounds.Enabled && (e.Round < uint64(f.Rounds.First) || e.Round > uint64(f.Rounds.Last)) {
+		return false
+	}
+	if f.PeriodsEnabled && (e.Period < f.FirstPeriod || e.Period > f.LastPeriod) {
+		return false
+	}
+	if len(f.Types) > 0 && !slices.Contains(f.Types, e.Type) {
+		return false
+	}
+	return true
+}
+
+// Events replays the autopsy and calls fn, in order, with every event which
+// passes the filter.
+//
+// The whole trace is replayed regardless of the filter so that the player
+// state reported with each event is accurate. If fn returns an error, no
+// further events are reported and the error is returned once the trace has
+// been consumed.
+func (a *Autopsy) Events(filter AutopsyEventFilter, fn func(AutopsyEvent) error) (version string, err error) {
+	var playerTracer tracer
+	playerTracer.log = serviceLogger{logging.Base()}
+	playerTracer.w = io.Discard
+	var router rootRouter // TODO this could become inaccurate with orphaned events
+
+	seq := 0
+	for cdv := range a.cdvs {
+		first := true
+		index := 0
+
+		for tr := range cdv {
+			if first {
+				first = false
+				version = tr.m.VersionCommitHash
+			}
+
+			player := tr.x
+			var p actor = checkedActor{actor: &player, actorContract: playerContract{}}
+			router.root = p
+
+			for pair := range tr.p {
+				ev := AutopsyEvent{
+					Seq:    seq,
+					Index:  index,
+					Round:  uint64(player.Round),
+					Period: uint64(player.Period),
+					Step:   uint64(player.Step),
+					Type:   pair.e.t().String(),
+				}
+				index++
+
+				player, _ = router.submitTop(&playerTracer, player, pair.e)
+				if err != nil || !filter.match(ev) {
+					continue
+				}
+
+				ev.Input, ev.Err = describeAutopsyInput(pair.e)
+				if pair.aok {
+					for _, act := range pair.a {
+						ev.Actions = append(ev.Actions, act.String())
+					}
+				}
+				ev.State = autopsyPlayerState(player, router)
+				err = fn(ev)
+			}
+		}
+		seq++
+	}
+	return
+}
+
+// describeAutopsyInput returns a node-independent description of the input
+// carried by e, along with its verification error, if any.
+func describeAutopsyInput(e event) (input string, verr string) {
+	switch e := e.(type) {
+	case messageEvent:
+		if e.Err != nil {
+			verr = e.Err.Error()
+		}
+		switch e.T {
+		case votePresent, voteVerified:
+			rv := e.Input.UnauthenticatedVote.R
+			input = fmt.Sprintf("vote (%d,%d,%d) from %v for %s", rv.Round, rv.Period, rv.Step, rv.Sender, autopsyProposalString(rv.Proposal))
+		case payloadPresent, payloadVerified:
+			up := e.Input.UnauthenticatedProposal
+			input = fmt.Sprintf("payload (%d,%d) from %v for %v", up.Round(), up.OriginalPeriod, up.OriginalProposer, up.Digest())
+		case bundlePresent, bundleVerified:
+			ub := e.Input.UnauthenticatedBundle
+			input = fmt.Sprintf("bundle (%d,%d,%d) for %s", ub.Round, ub.Period, ub.Step, autopsyProposalString(ub.Proposal))
+		}
+	case timeoutEvent:
+		input = fmt.Sprintf("%s (%d)", e.T, e.Round)
+	case roundInterruptionEvent:
+		input = fmt.Sprintf("%s (%d)", e.t(), e.Round)
+	}
+	return
+}
+
+func autopsyProposalString(pv proposalValue) string {
+	if pv == bottom {
+		return "bottom"
+	}
+	return pv.BlockDigest.String()
+}
+
+// autopsyPlayerState summarizes the state of the player and of the proposal
+// and vote machines of its current round.
+func autopsyPlayerState(p player, r rootRouter) AutopsyPlayerState {
+	s := AutopsyPlayerState{
+		Round:                uint64(p.Round),
+		Period:               uint64(p.Period),
+		Step:                 uint64(p.Step),
+		LastConcluding:       uint64(p.LastConcluding),
+		Deadline:             p.Deadline.Duration,
+		Napping:              p.Napping,
+		FastRecoveryDeadline: p.FastRecoveryDeadline,
+		PendingProposals:     len(p.Pending.Pending),
+	}
+
+	rRouter := r.Children[p.Round]
+	if rRouter == nil {
+		return s
+	}
+
+	if rRouter.proposalRoot != nil {
+		proposalStore := rRouter.proposalRoot.underlying().(*proposalStore)
+		if proposalStore.Pinned != bottom {
+			s.Pinned = proposalStore.Pinned.BlockDigest.String()
+		}
+		for _, assembler := range proposalStore.Assemblers {
+			if assembler.Assembled {
+				s.Blocks = append(s.Blocks, assembler.Payload.Digest().String()+" (assembled)")
+			} else if assembler.Filled {
+				s.Blocks = append(s.Blocks, assembler.Pipeline.Digest().String()+" (awaiting verification)")
+			}
+		}
+		slices.Sort(s.Blocks)
+
+		pRouter := rRouter.Children[p.Period]
+		if pRouter != nil && pRouter.proposalRoot != nil {
+			proposalTrack := pRouter.proposalRoot.underlying().(*proposalTracker)
+			if proposalTrack.Staging != bottom {
+				s.Staging = proposalTrack.Staging.BlockDigest.String()
+			}
+		}
+	}
+
+	if rRouter.voteRoot != nil {
+		voteRound := rRouter.voteRoot.underlying().(*voteTrackerRound)
+		if voteRound.Freshest.t() != none {
+			f := voteRound.Freshest
+			s.FreshestBundle = fmt.Sprintf("%s (%d,%d,%d) for %s", f.t(), f.Round, f.Period, f.Step, autopsyProposalString(f.Proposal))
+		}
+	}
+	return s
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// recordTestCadaver feeds a round's worth of proposals and votes to a player
+// and returns the cadaver recorded along the way.
+func recordTestCadaver(t *testing.T) (*bytes.Buffer, round, int) {
+	player, router, accs, f, ledger := testPlayerSetup()
+
+	var buf bytes.Buffer
+	var tr tracer
+	tr.log = serviceLogger{logging.TestingLog(t)}
+	tr.w = io.Discard
+	tr.cadaver.overrideSetup = true
+	tr.cadaver.out = &cadaverHandle{WriteCloser: nopWriteCloser{&buf}}
+	protocol.EncodeStream(tr.cadaver.out, cadaverMetaEntry)
+	protocol.EncodeStream(tr.cadaver.out, CadaverMetadata{VersionCommitHash: "test"})
+
+	voteBatch, payloadBatch, _ := generateProposalEvents(t, player, accs, f, ledger)
+	events := append(voteBatch, payloadBatch...)
+	for _, e := range events {
+		player, _ = router.submitTop(&tr, player, e)
+	}
+	protocol.EncodeStream(tr.cadaver.out, cadaverEOSEntry)
+	return &buf, player.Round, len(events)
+}
+
+func TestAutopsyEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	buf, rnd, numEvents := recordTestCadaver(t)
+	require.NotZero(t, numEvents)
+
+	var runs int
+	autopsy, err := PrepareAutopsyFromStream(io.NopCloser(bytes.NewReader(buf.Bytes())), func(int, AutopsyBounds) {}, func(n int, err error) {
+		runs = n
+		require.NoError(t, err)
+	})
+	require.NoError(t, err)
+
+	var events []AutopsyEvent
+	version, err := autopsy.Events(AutopsyEventFilter{}, func(e AutopsyEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test", version)
+	require.Equal(t, 1, runs)
+	require.Len(t, events, numEvents)
+
+	for i, e := range events {
+		require.Equal(t, 0, e.Seq)
+		require.Equal(t, i, e.Index)
+		require.Equal(t, uint64(rnd), e.Round)
+		require.Equal(t, uint64(rnd), e.State.Round)
+		require.NotEmpty(t, e.Input)
+		require.NotEmpty(t, e.Actions)
+	}
+	require.Equal(t, "voteVerified", events[0].Type)
+	require.True(t, strings.HasPrefix(events[0].Input, "vote ("))
+	require.Equal(t, "payloadVerified", events[numEvents-1].Type)
+	require.True(t, strings.HasPrefix(events[numEvents-1].Input, "payload ("))
+	require.NotEmpty(t, events[numEvents-1].State.Blocks)
+}
+
+func TestAutopsyEventsFilter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	buf, rnd, numEvents := recordTestCadaver(t)
+
+	count := func(filter AutopsyEventFilter) (n int) {
+		autopsy, err := PrepareAutopsyFromStream(io.NopCloser(bytes.NewReader(buf.Bytes())), func(int, AutopsyBounds) {}, func(int, error) {})
+		require.NoError(t, err)
+		_, err = autopsy.Events(filter, func(AutopsyEvent) error {
+			n++
+			return nil
+		})
+		require.NoError(t, err)
+		return
+	}
+
+	require.Equal(t, numEvents/2, count(AutopsyEventFilter{Types: []string{"payloadVerified"}}))
+	require.Equal(t, numEvents, count(AutopsyEventFilter{Rounds: AutopsyFilter{Enabled: true, First: rnd, Last: rnd}}))
+	require.Zero(t, count(AutopsyEventFilter{Rounds: AutopsyFilter{Enabled: true, First: rnd + 1, Last: rnd + 1}}))
+	require.Zero(t, count(AutopsyEventFilter{PeriodsEnabled: true, FirstPeriod: 1, LastPeriod: 1}))
+
+	// an error from the callback stops reporting but the trace is still consumed
+	autopsy, err := PrepareAutopsyFromStream(io.NopCloser(bytes.NewReader(buf.Bytes())), func(int, AutopsyBounds) {}, func(int, error) {})
+	require.NoError(t, err)
+	calls := 0
+	_, err = autopsy.Events(AutopsyEventFilter{}, func(AutopsyEvent) error {
+		calls++
+		return io.ErrShortWrite
+	})
+	require.ErrorIs(t, err, io.ErrShortWrite)
+	require.Equal(t, 1, calls)
+}
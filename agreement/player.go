@@ -498,6 +498,8 @@ func (p *player) enterRound(r routerHandle, source event, target round) []action
 		p.Deadline = Deadline{Duration: p.calculateFilterTimeout(source.Proto.Version, r.t), Type: TimeoutFilter}
 	}
 
+	r.t.recordRoundStart(*p)
+
 	// update tracer state to match player
 	r.t.setMetadata(tracerMetadata{p.Round, p.Period, p.Step})
 	r.t.resetTimingWithPipeline(target)
@@ -39,6 +39,7 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/committee"
@@ -168,7 +169,7 @@ func (i seedInput) ToBeHashed() (protocol.HashID, []byte) {
 	return protocol.ProposerSeed, protocol.Encode(&i)
 }
 
-func deriveNewSeed(address basics.Address, vrf *crypto.VRFSecrets, rnd round, period period, ledger LedgerReader, cparams config.ConsensusParams) (newSeed committee.Seed, seedProof crypto.VRFProof, reterr error) {
+func deriveNewSeed(address basics.Address, part *account.ParticipationRecordForRound, rnd round, period period, ledger LedgerReader, cparams config.ConsensusParams) (newSeed committee.Seed, seedProof crypto.VRFProof, reterr error) {
 	var ok bool
 	var vrfOut crypto.VrfOutput
 
@@ -180,9 +181,9 @@ func deriveNewSeed(address basics.Address, vrf *crypto.VRFSecrets, rnd round, pe
 	}
 
 	if period == 0 {
-		seedProof, ok = vrf.SK.Prove(prevSeed)
-		if !ok {
-			reterr = fmt.Errorf("could not make seed proof")
+		seedProof, err = part.ProveVRF(prevSeed)
+		if err != nil {
+			reterr = fmt.Errorf("could not make seed proof: %w", err)
 			return
 		}
 		vrfOut, ok = seedProof.Hash()
@@ -312,6 +313,16 @@ func payoutEligible(rnd basics.Round, proposer basics.Address, ledger LedgerRead
 }
 
 func proposalForBlock(address basics.Address, vrf *crypto.VRFSecrets, blk UnfinishedBlock, period period, ledger LedgerReader) (proposal, proposalValue, error) {
+	part := account.ParticipationRecordForRound{
+		ParticipationRecord: account.ParticipationRecord{Account: address, VRF: vrf},
+	}
+	return proposalForParticipation(&part, blk, period, ledger)
+}
+
+// proposalForParticipation creates a proposal for the given block, proving the
+// new seed with the selection key of the participation record.
+func proposalForParticipation(part *account.ParticipationRecordForRound, blk UnfinishedBlock, period period, ledger LedgerReader) (proposal, proposalValue, error) {
+	address := part.Account
 	rnd := blk.Round()
 
 	cparams, err := ledger.ConsensusParams(ParamsRound(rnd))
@@ -319,7 +330,7 @@ func proposalForBlock(address basics.Address, vrf *crypto.VRFSecrets, blk Unfini
 		return proposal{}, proposalValue{}, fmt.Errorf("proposalForBlock: no consensus parameters for round %d: %w", ParamsRound(rnd), err)
 	}
 
-	newSeed, seedProof, err := deriveNewSeed(address, vrf, rnd, period, ledger, cparams)
+	newSeed, seedProof, err := deriveNewSeed(address, part, rnd, period, ledger, cparams)
 	if err != nil {
 		return proposal{}, proposalValue{}, fmt.Errorf("proposalForBlock: could not derive new seed: %w", err)
 	}
@@ -314,8 +314,9 @@ func (n asyncPseudonode) makeProposals(round basics.Round, period period, accoun
 
 	votes := make([]unauthenticatedVote, 0, len(accounts))
 	proposals := make([]proposal, 0, len(accounts))
-	for _, acc := range accounts {
-		payload, proposal, pErr := proposalForBlock(acc.Account, acc.VRF, ve, period, n.ledger)
+	for i := range accounts {
+		acc := &accounts[i]
+		payload, proposal, pErr := proposalForParticipation(acc, ve, period, n.ledger)
 		if pErr != nil {
 			n.log.Errorf("pseudonode.makeProposals: could not create proposal for block (address %v): %v", acc.Account, pErr)
 			continue
@@ -323,7 +324,7 @@ func (n asyncPseudonode) makeProposals(round basics.Round, period period, accoun
 
 		// attempt to make the vote
 		rv := rawVote{Sender: acc.Account, Round: round, Period: period, Step: propose, Proposal: proposal}
-		uv, vErr := makeVote(rv, acc.VotingSigner(), acc.VRF, n.ledger)
+		uv, vErr := makeParticipationVote(rv, acc, n.ledger)
 		if vErr != nil {
 			n.log.Warnf("pseudonode.makeProposals: could not create vote: %v", vErr)
 			continue
@@ -341,9 +342,10 @@ func (n asyncPseudonode) makeProposals(round basics.Round, period period, accoun
 // round, period, and step.
 func (n asyncPseudonode) makeVotes(round basics.Round, period period, step step, proposal proposalValue, participation []account.ParticipationRecordForRound) []unauthenticatedVote {
 	votes := make([]unauthenticatedVote, 0)
-	for _, part := range participation {
+	for i := range participation {
+		part := &participation[i]
 		rv := rawVote{Sender: part.Account, Round: round, Period: period, Step: step, Proposal: proposal}
-		uv, err := makeVote(rv, part.VotingSigner(), part.VRF, n.ledger)
+		uv, err := makeParticipationVote(rv, part, n.ledger)
 		if err != nil {
 			n.log.Warnf("pseudonode.makeVotes: could not create vote: %v", err)
 			continue
@@ -135,6 +135,7 @@ func MakeService(p Parameters) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	s.tracer.timeline = makeTimelineRecorder(p.AgreementTimelineRounds)
 
 	s.persistenceLoop = makeAsyncPersistenceLoop(s.log, s.Accessor, s.Ledger)
 
@@ -143,6 +144,12 @@ func MakeService(p Parameters) (*Service, error) {
 	return s, nil
 }
 
+// RoundTimelines returns the agreement timelines of the most recent rounds, oldest first.
+// It is safe to call concurrently with the agreement protocol execution.
+func (s *Service) RoundTimelines() []RoundTimeline {
+	return s.tracer.timeline.timelines()
+}
+
 // SetTracerFilename updates the tracer filename used.
 func (s *Service) SetTracerFilename(filename string) {
 	s.tracer.cadaver.baseFilename = filename
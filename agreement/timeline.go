@@ -0,0 +1,212 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+//msgp:ignore RoundTimeline ProposalArrival ThresholdReached TimeoutFired timelineRecorder
+
+// RoundTimeline is a record of what the agreement service observed during a single round.
+// Arrival times are measured from the start of the round.
+type RoundTimeline struct {
+	Round basics.Round
+	// Start is the time at which the node entered the round.
+	Start time.Time
+	// End is the time at which the node entered the next round, or zero if the round is in progress.
+	End time.Time
+	// FilterTimeout is the filter timeout in effect in period 0.
+	FilterTimeout time.Duration
+	// DynamicFilterTimeout is the filter timeout estimated from the credential arrival history,
+	// or zero if not enough history was collected yet.
+	DynamicFilterTimeout time.Duration
+
+	Proposals  []ProposalArrival
+	Thresholds []ThresholdReached
+	Timeouts   []TimeoutFired
+}
+
+// ProposalArrival records a proposal-vote accepted by the proposal manager.
+type ProposalArrival struct {
+	Period     uint64
+	Sender     basics.Address
+	BlockHash  crypto.Digest
+	Credential crypto.Digest
+	Weight     uint64
+	Arrival    time.Duration
+}
+
+// ThresholdReached records a vote threshold reached from individual votes or from a bundle.
+type ThresholdReached struct {
+	Period    uint64
+	Step      uint64
+	Type      string
+	BlockHash crypto.Digest
+	Weight    uint64
+	Arrival   time.Duration
+}
+
+// TimeoutFired records an agreement timeout.
+type TimeoutFired struct {
+	Period   uint64
+	Step     uint64
+	Deadline time.Duration
+	Fast     bool
+	Arrival  time.Duration
+}
+
+// timelineRecorder keeps the timelines of the most recent rounds.
+// It is written by the agreement main loop through the tracer and read by the REST API,
+// so unlike the tracer it is safe for concurrent use.
+// A nil timelineRecorder records nothing.
+type timelineRecorder struct {
+	mu       deadlock.Mutex
+	capacity int
+	rounds   []*RoundTimeline // oldest first
+}
+
+func makeTimelineRecorder(capacity int) *timelineRecorder {
+	if capacity <= 0 {
+		return nil
+	}
+	return &timelineRecorder{capacity: capacity}
+}
+
+// at returns the timeline of round r, starting a new one if r is not retained.
+// Must be called with mu held.
+func (tr *timelineRecorder) at(r round, now time.Time) *RoundTimeline {
+	for i := len(tr.rounds) - 1; i >= 0; i-- {
+		if tr.rounds[i].Round == r {
+			return tr.rounds[i]
+		}
+	}
+	tl := &RoundTimeline{Round: r, Start: now}
+	tr.rounds = append(tr.rounds, tl)
+	if len(tr.rounds) > tr.capacity {
+		tr.rounds = tr.rounds[len(tr.rounds)-tr.capacity:]
+	}
+	return tl
+}
+
+func (tr *timelineRecorder) startRound(p player, now time.Time) {
+	if tr == nil {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	for _, tl := range tr.rounds {
+		if tl.Round < p.Round && tl.End.IsZero() {
+			tl.End = now
+		}
+	}
+	tl := tr.at(p.Round, now)
+	tl.FilterTimeout = p.Deadline.Duration
+	tl.DynamicFilterTimeout = p.dynamicFilterTimeout
+}
+
+func (tr *timelineRecorder) proposal(r round, pa ProposalArrival, now time.Time) {
+	if tr == nil {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tl := tr.at(r, now)
+	tl.Proposals = append(tl.Proposals, pa)
+}
+
+func (tr *timelineRecorder) threshold(e thresholdEvent, weight uint64, now time.Time) {
+	if tr == nil {
+		return
+	}
+	var typ string
+	switch e.T {
+	case softThreshold:
+		typ = "soft"
+	case certThreshold:
+		typ = "cert"
+	case nextThreshold:
+		typ = "next"
+	default:
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tl := tr.at(e.Round, now)
+	tl.Thresholds = append(tl.Thresholds, ThresholdReached{
+		Period:    uint64(e.Period),
+		Step:      uint64(e.Step),
+		Type:      typ,
+		BlockHash: e.Proposal.BlockDigest,
+		Weight:    weight,
+		Arrival:   now.Sub(tl.Start),
+	})
+}
+
+func (tr *timelineRecorder) timeout(p player, deadline time.Duration, fast bool, now time.Time) {
+	if tr == nil {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tl := tr.at(p.Round, now)
+	tl.Timeouts = append(tl.Timeouts, TimeoutFired{
+		Period:   uint64(p.Period),
+		Step:     uint64(p.Step),
+		Deadline: deadline,
+		Fast:     fast,
+		Arrival:  now.Sub(tl.Start),
+	})
+}
+
+// timelines returns copies of the retained timelines, oldest first.
+func (tr *timelineRecorder) timelines() []RoundTimeline {
+	if tr == nil {
+		return nil
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	res := make([]RoundTimeline, len(tr.rounds))
+	for i, tl := range tr.rounds {
+		res[i] = *tl
+		res[i].Proposals = append([]ProposalArrival(nil), tl.Proposals...)
+		res[i].Thresholds = append([]ThresholdReached(nil), tl.Thresholds...)
+		res[i].Timeouts = append([]TimeoutFired(nil), tl.Timeouts...)
+	}
+	return res
+}
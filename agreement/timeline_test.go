@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimelineRecorderDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tr := makeTimelineRecorder(0)
+	require.Nil(t, tr)
+	// a nil recorder ignores everything
+	tr.startRound(player{Round: 1}, time.Now())
+	tr.timeout(player{Round: 1}, time.Second, false, time.Now())
+	tr.threshold(thresholdEvent{T: softThreshold, Round: 1}, 1, time.Now())
+	tr.proposal(1, ProposalArrival{}, time.Now())
+	require.Empty(t, tr.timelines())
+}
+
+func TestTimelineRecorderRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tr := makeTimelineRecorder(3)
+	start := time.Now()
+	for r := round(1); r <= 5; r++ {
+		now := start.Add(time.Duration(r) * time.Second)
+		p := player{Round: r, Deadline: Deadline{Duration: 4 * time.Second, Type: TimeoutFilter}, dynamicFilterTimeout: 3 * time.Second}
+		tr.startRound(p, now)
+		tr.proposal(r, ProposalArrival{Period: 0, Weight: 1, Arrival: 100 * time.Millisecond}, now.Add(100*time.Millisecond))
+		tr.threshold(thresholdEvent{T: softThreshold, Round: r, Step: soft}, 10, now.Add(200*time.Millisecond))
+		tr.threshold(thresholdEvent{T: certThreshold, Round: r, Step: cert}, 20, now.Add(300*time.Millisecond))
+		// events that are not thresholds are ignored
+		tr.threshold(thresholdEvent{T: none, Round: r}, 20, now.Add(300*time.Millisecond))
+	}
+	tr.timeout(player{Round: 5, Period: 0, Step: soft}, 4*time.Second, false, start.Add(9*time.Second))
+
+	tls := tr.timelines()
+	require.Len(t, tls, 3)
+	for i, tl := range tls {
+		r := round(i + 3)
+		require.Equal(t, r, tl.Round)
+		require.Equal(t, start.Add(time.Duration(r)*time.Second), tl.Start)
+		if r < 5 {
+			require.Equal(t, start.Add(time.Duration(r+1)*time.Second), tl.End)
+		} else {
+			require.True(t, tl.End.IsZero())
+		}
+		require.Equal(t, 4*time.Second, tl.FilterTimeout)
+		require.Equal(t, 3*time.Second, tl.DynamicFilterTimeout)
+		require.Len(t, tl.Proposals, 1)
+		require.Len(t, tl.Thresholds, 2)
+		require.Equal(t, "soft", tl.Thresholds[0].Type)
+		require.Equal(t, 200*time.Millisecond, tl.Thresholds[0].Arrival)
+		require.Equal(t, "cert", tl.Thresholds[1].Type)
+		require.Equal(t, uint64(20), tl.Thresholds[1].Weight)
+	}
+	require.Len(t, tls[2].Timeouts, 1)
+	require.Equal(t, 4*time.Second, tls[2].Timeouts[0].Arrival)
+
+	// returned timelines are copies
+	tls[0].Proposals[0].Weight = 100
+	require.Equal(t, uint64(1), tr.timelines()[0].Proposals[0].Weight)
+}
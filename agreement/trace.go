@@ -86,6 +86,9 @@ type tracer struct {
 	verboseReports bool
 	// if timingReports is true, telemetrize more fine-grained agreement timing data
 	timingReports bool
+
+	// timeline retains a per-round record of key agreement events. Optional.
+	timeline *timelineRecorder
 }
 
 const cadaverSizeMinimum = 100 * 1024 // 100 KB
@@ -200,6 +203,7 @@ func (t *tracer) aoutTop(src, dest stateMachineTag, as []action, r round, p peri
 /* Ad-hoc logging */
 
 func (t *tracer) logTimeout(p player) {
+	t.timeline.timeout(p, p.Deadline.Duration, false, time.Now())
 	if !t.log.IsLevelEnabled(logging.Info) {
 		return
 	}
@@ -213,6 +217,7 @@ func (t *tracer) logTimeout(p player) {
 }
 
 func (t *tracer) logFastTimeout(p player) {
+	t.timeline.timeout(p, p.FastRecoveryDeadline, true, time.Now())
 	if !t.log.IsLevelEnabled(logging.Info) {
 		return
 	}
@@ -261,6 +266,11 @@ func (t *tracer) logPeriodConcluded(p player, target period, prop proposalValue)
 	})
 }
 
+// recordRoundStart records in the timeline that the player entered a new round.
+func (t *tracer) recordRoundStart(p player) {
+	t.timeline.startRound(p, time.Now())
+}
+
 func (t *tracer) logRoundStart(p player, target round) {
 	// Log timing telemetry.
 	if t.tR != nil && t.timingReports {
@@ -363,11 +373,20 @@ func (t *tracer) logProposalManagerResult(p player, input messageEvent, output e
 		t.log.with(logEvent).Infof("pipelined block for (%v, %v): %v", pipelinedRound, pipelinedPeriod, output.(payloadProcessedEvent).Err)
 
 	case proposalAccepted:
+		uv := input.Input.UnauthenticatedVote
+		pev := output.(proposalAcceptedEvent)
+		v := input.Input.Vote
+		t.timeline.proposal(pev.Round, ProposalArrival{
+			Period:     uint64(pev.Period),
+			Sender:     v.R.Sender,
+			BlockHash:  pev.Proposal.BlockDigest,
+			Credential: v.Cred.LowestOutputDigest(),
+			Weight:     v.Cred.Weight,
+			Arrival:    v.validatedAt,
+		}, time.Now())
 		if !t.log.IsLevelEnabled(logging.Info) {
 			return
 		}
-		uv := input.Input.UnauthenticatedVote
-		pev := output.(proposalAcceptedEvent)
 		logEvent := logspec.AgreementEvent{
 			Type:         logspec.ProposalAccepted,
 			Round:        uint64(p.Round),
@@ -461,6 +480,7 @@ func (t *tracer) logVoteAggregatorResult(input filterableMessageEvent, output ev
 		if input.t() != bundleVerified {
 			return
 		}
+		t.timeline.threshold(output.(thresholdEvent), 0, time.Now())
 		if !t.log.IsLevelEnabled(logging.Info) {
 			return
 		}
@@ -478,6 +498,9 @@ func (t *tracer) logVoteAggregatorResult(input filterableMessageEvent, output ev
 }
 
 func (t *tracer) logVoteTrackerResult(p player, input voteAcceptedEvent, output thresholdEvent, weight uint64, inputTotal uint64, outputTotal uint64, proto config.ConsensusParams) {
+	if output.T != none {
+		t.timeline.threshold(output, outputTotal, time.Now())
+	}
 	if !t.log.IsLevelEnabled(logging.Info) {
 		return
 	}
@@ -37,6 +37,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/logging"
@@ -166,6 +167,20 @@ func (uv unauthenticatedVote) verify(l LedgerReader) (vote, error) {
 //
 // makeVote returns an error if it fails.
 func makeVote(rv rawVote, voting crypto.OneTimeSigner, selection *crypto.VRFSecrets, l Ledger) (unauthenticatedVote, error) {
+	part := account.ParticipationRecordForRound{
+		ParticipationRecord: account.ParticipationRecord{
+			Account:     rv.Sender,
+			KeyDilution: voting.OptionalKeyDilution,
+			VRF:         selection,
+			Voting:      voting.OneTimeSignatureSecrets,
+		},
+	}
+	return makeParticipationVote(rv, &part, l)
+}
+
+// makeParticipationVote creates a vote signed with the given participation keys,
+// which may be held by a remote signer.
+func makeParticipationVote(rv rawVote, part *account.ParticipationRecordForRound, l Ledger) (unauthenticatedVote, error) {
 	m, err := membership(l, rv.Sender, rv.Round, rv.Period, rv.Step)
 	if err != nil {
 		return unauthenticatedVote{}, fmt.Errorf("makeVote: could not get membership parameters: %v", err)
@@ -175,6 +190,10 @@ func makeVote(rv rawVote, voting crypto.OneTimeSigner, selection *crypto.VRFSecr
 	if err != nil {
 		return unauthenticatedVote{}, fmt.Errorf("makeVote: could not get consensus params for round %d: %v", ParamsRound(rv.Round), err)
 	}
+	cv, err := l.ConsensusVersion(ParamsRound(rv.Round))
+	if err != nil {
+		return unauthenticatedVote{}, fmt.Errorf("makeVote: could not get consensus version for round %d: %v", ParamsRound(rv.Round), err)
+	}
 
 	switch rv.Step {
 	case propose, soft, cert, late, redo:
@@ -187,13 +206,25 @@ func makeVote(rv rawVote, voting crypto.OneTimeSigner, selection *crypto.VRFSecr
 		}
 	}
 
-	ephID := basics.OneTimeIDForRound(rv.Round, voting.KeyDilution(proto.DefaultKeyDilution))
-	sig := voting.Sign(ephID, rv)
+	ephID := basics.OneTimeIDForRound(rv.Round, part.VotingSigner().KeyDilution(proto.DefaultKeyDilution))
+	sig, err := part.SignOneTime(ephID, cv, rv)
+	if err != nil {
+		return unauthenticatedVote{}, fmt.Errorf("makeVote: could not sign vote: %w", err)
+	}
 	if (sig == crypto.OneTimeSignature{}) {
 		return unauthenticatedVote{}, fmt.Errorf("makeVote: got back empty signature for vote")
 	}
 
-	cred := committee.MakeCredential(&selection.SK, m.Selector)
+	var cred committee.UnauthenticatedCredential
+	if part.Signer == nil {
+		cred = committee.MakeCredential(&part.VRF.SK, m.Selector)
+	} else {
+		proof, err := part.ProveVRF(m.Selector)
+		if err != nil {
+			return unauthenticatedVote{}, fmt.Errorf("makeVote: could not prove credential: %w", err)
+		}
+		cred = committee.UnauthenticatedCredential{Proof: proof}
+	}
 	return unauthenticatedVote{R: rv, Cred: cred, Sig: sig}, nil
 }
 
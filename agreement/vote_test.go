@@ -40,9 +40,11 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/partsigner"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -227,6 +229,56 @@ func TestVoteMakeVote(t *testing.T) {
 
 }
 
+func TestVoteRemoteSigner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, otSecrets := readOnlyFixture10()
+	signer, err := partsigner.MakeSoftSigner("", logging.TestingLog(t))
+	require.NoError(t, err)
+	defer signer.Close()
+
+	for i := range addresses {
+		signer.AddParticipation(account.PersistedParticipation{Participation: account.Participation{
+			Parent:      addresses[i],
+			VRF:         vrfSecrets[i],
+			Voting:      otSecrets[i].OneTimeSignatureSecrets,
+			LastValid:   basics.Round(1000),
+			KeyDilution: otSecrets[i].OptionalKeyDilution,
+		}})
+	}
+	keys := signer.Keys()
+	require.Len(t, keys, len(addresses))
+
+	round := ledger.NextRound()
+	var proposal proposalValue
+	proposal.BlockDigest = randomBlockHash()
+
+	verified := 0
+	for _, key := range keys {
+		part := account.ParticipationRecordForRound{ParticipationRecord: key, Signer: signer}
+		rv := rawVote{Sender: key.Account, Round: round, Period: 0, Step: soft, Proposal: proposal}
+		uv, err := makeParticipationVote(rv, &part, ledger)
+		require.NoError(t, err)
+		if _, err := uv.verify(ledger); err == nil {
+			verified++
+		}
+
+		// signing the same vote again is allowed
+		_, err = makeParticipationVote(rv, &part, ledger)
+		require.NoError(t, err)
+
+		// but a conflicting vote for the same slot is refused
+		rv.Proposal.BlockDigest = randomBlockHash()
+		_, err = makeParticipationVote(rv, &part, ledger)
+		require.ErrorIs(t, err, partsigner.ErrDoubleSign)
+
+		rv.Step = cert
+		_, err = makeParticipationVote(rv, &part, ledger)
+		require.NoError(t, err)
+	}
+	require.NotZero(t, verified)
+}
+
 func makeVotePanicWrapper(t *testing.T, message string, rv rawVote, voting crypto.OneTimeSigner, selection *crypto.VRFSecrets, l Ledger) (uav unauthenticatedVote, err error) {
 	logging.Base().SetOutput(nullWriter{})
 	require.Panics(t, func() { uav, err = makeVote(rv, voting, selection, l) })
@@ -313,6 +313,8 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 	// download balances file.
 	lf := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
 	attemptsCount := 0
+	// prefer the peers advertising this catchpoint through the DHT, if any
+	ps := withCatchpointProviders(cs.net, label, cs.blocksDownloadPeerSelector)
 
 	for {
 		attemptsCount++
@@ -324,7 +326,7 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 			}
 			return cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err0))
 		}
-		psp, err0 := cs.blocksDownloadPeerSelector.getNextPeer()
+		psp, err0 := ps.getNextPeer()
 		if err0 != nil {
 			err0 = fmt.Errorf("processStageLedgerDownload: catchpoint catchup was unable to obtain a list of peers to retrieve the catchpoint file from")
 			return cs.abort(err0)
@@ -341,9 +343,9 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 				break
 			}
 			// failed to build the merkle trie for the above catchpoint file.
-			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankInvalidDownload)
+			ps.rankPeer(psp, peerRankInvalidDownload)
 		} else {
-			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankDownloadFailed)
+			ps.rankPeer(psp, peerRankDownloadFailed)
 		}
 
 		// instead of testing for err == cs.ctx.Err() , we'll check on the context itself.
@@ -676,7 +678,9 @@ func (cs *CatchpointCatchupService) processStageBlocksDownload() (err error) {
 // The method return stop=true if the caller should exit the current operation
 // If the method return a nil block, the caller is expected to retry the operation, increasing the retry counter as needed.
 func (cs *CatchpointCatchupService) fetchBlock(round basics.Round, retryCount uint64) (blk *bookkeeping.Block, cert *agreement.Certificate, downloadDuration time.Duration, psp *peerSelectorPeer, stop bool, err error) {
-	psp, err = cs.blocksDownloadPeerSelector.getNextPeer()
+	// prefer the peers advertising this round through the DHT, moving on to the next one on every retry
+	ps := withBlockProviders(cs.net, round, int(retryCount-1), cs.blocksDownloadPeerSelector)
+	psp, err = ps.getNextPeer()
 	if err != nil {
 		if errors.Is(err, errPeerSelectorNoPeerPoolsAvailable) {
 			cs.log.Infof("fetchBlock: unable to obtain a list of peers to retrieve the latest block from; will retry shortly.")
@@ -834,8 +838,9 @@ func (cs *CatchpointCatchupService) checkLedgerDownload() error {
 		return fmt.Errorf("failed to parse catchpoint label : %v", err)
 	}
 	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
+	ps := withCatchpointProviders(cs.net, cs.stats.CatchpointLabel, cs.blocksDownloadPeerSelector)
 	for i := 0; i < cs.config.CatchupLedgerDownloadRetryAttempts; i++ {
-		psp, peerError := cs.blocksDownloadPeerSelector.getNextPeer()
+		psp, peerError := ps.getNextPeer()
 		if peerError != nil {
 			return err
 		}
@@ -844,7 +849,7 @@ func (cs *CatchpointCatchupService) checkLedgerDownload() error {
 			return nil
 		}
 		// a non-nil error means that the catchpoint is not available, so we should rank it accordingly
-		cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankNoCatchpointForRound)
+		ps.rankPeer(psp, peerRankNoCatchpointForRound)
 	}
 	return fmt.Errorf("checkLedgerDownload(): catchpoint '%s' unavailable from peers: %s", cs.stats.CatchpointLabel, err)
 }
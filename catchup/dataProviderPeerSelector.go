@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/network"
+)
+
+// peerClassDataProvider is the peer class of the peers found through network.DataProviderFinder.
+// Unlike the other peer classes, it is not a network.GossipNode.GetPeers option.
+const peerClassDataProvider network.PeerOption = -1
+
+// dataProviderPeerSelector is a peerSelector offering first the peers advertising the requested data, and falling
+// back to the wrapped peerSelector once they are exhausted. A provider that fails is not offered again, and is
+// reported to the network so that later lookups skip it, while a provider that succeeds keeps being offered first.
+type dataProviderPeerSelector struct {
+	peerSelector
+
+	mu        deadlock.Mutex
+	providers []network.Peer
+	failed    func(network.Peer)
+}
+
+// withBlockProviders returns a peerSelector offering first the peers advertising the block of the given round,
+// skipping the first skip providers, or ps itself when the network does not know of any such provider.
+func withBlockProviders(net network.GossipNode, round basics.Round, skip int, ps peerSelector) peerSelector {
+	finder, ok := net.(network.DataProviderFinder)
+	if !ok {
+		return ps
+	}
+	failed := func(peer network.Peer) { finder.BlockProviderFailed(round, peer) }
+	return makeDataProviderPeerSelector(ps, finder.BlockProviders(round), skip, failed)
+}
+
+// withCatchpointProviders returns a peerSelector offering first the peers advertising the given catchpoint,
+// or ps itself when the network does not know of any such provider.
+func withCatchpointProviders(net network.GossipNode, label string, ps peerSelector) peerSelector {
+	finder, ok := net.(network.DataProviderFinder)
+	if !ok {
+		return ps
+	}
+	failed := func(peer network.Peer) { finder.CatchpointProviderFailed(label, peer) }
+	return makeDataProviderPeerSelector(ps, finder.CatchpointProviders(label), 0, failed)
+}
+
+func makeDataProviderPeerSelector(ps peerSelector, providers []network.Peer, skip int, failed func(network.Peer)) peerSelector {
+	if skip >= len(providers) {
+		return ps
+	}
+	return &dataProviderPeerSelector{
+		peerSelector: ps,
+		providers:    append([]network.Peer(nil), providers[skip:]...),
+		failed:       failed,
+	}
+}
+
+func (ps *dataProviderPeerSelector) getNextPeer() (psp *peerSelectorPeer, err error) {
+	ps.mu.Lock()
+	if len(ps.providers) > 0 {
+		peer := ps.providers[0]
+		ps.providers = ps.providers[1:]
+		ps.mu.Unlock()
+		return &peerSelectorPeer{Peer: peer, peerClass: peerClassDataProvider}, nil
+	}
+	ps.mu.Unlock()
+	return ps.peerSelector.getNextPeer()
+}
+
+func (ps *dataProviderPeerSelector) rankPeer(psp *peerSelectorPeer, rank int) (int, int) {
+	if psp == nil || psp.peerClass != peerClassDataProvider {
+		return ps.peerSelector.rankPeer(psp, rank)
+	}
+	if rank < peerRankNoBlockForRound {
+		// the provider served the data, offer it again first
+		ps.mu.Lock()
+		ps.providers = append([]network.Peer{psp.Peer}, ps.providers...)
+		ps.mu.Unlock()
+	} else {
+		ps.failed(psp.Peer)
+	}
+	return -1, -1
+}
+
+func (ps *dataProviderPeerSelector) peerDownloadDurationToRank(psp *peerSelectorPeer, blockDownloadDuration time.Duration) (rank int) {
+	if psp == nil || psp.peerClass != peerClassDataProvider {
+		return ps.peerSelector.peerDownloadDurationToRank(psp, blockDownloadDuration)
+	}
+	return downloadDurationToRank(blockDownloadDuration, lowBlockDownloadThreshold, highBlockDownloadThreshold, peerRank0LowBlockTime, peerRank0HighBlockTime)
+}
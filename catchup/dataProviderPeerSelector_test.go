@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type dataProviderFinderStub struct {
+	network.GossipNode
+	blockProviders      map[basics.Round][]network.Peer
+	catchpointProviders map[string][]network.Peer
+	failed              []network.Peer
+}
+
+func (f *dataProviderFinderStub) BlockProviders(round basics.Round) []network.Peer {
+	return f.blockProviders[round]
+}
+
+func (f *dataProviderFinderStub) CatchpointProviders(label string) []network.Peer {
+	return f.catchpointProviders[label]
+}
+
+func (f *dataProviderFinderStub) BlockProviderFailed(round basics.Round, peer network.Peer) {
+	f.failed = append(f.failed, peer)
+}
+
+func (f *dataProviderFinderStub) CatchpointProviderFailed(label string, peer network.Peer) {
+	f.failed = append(f.failed, peer)
+}
+
+func TestDataProviderPeerSelector(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	fallbackPeer := &mockHTTPPeer{address: "fallback"}
+	fallbackRanks := 0
+	fallback := mockPeerSelector{
+		mockGetNextPeer: func() (*peerSelectorPeer, error) {
+			return &peerSelectorPeer{Peer: fallbackPeer, peerClass: network.PeersPhonebookRelays}, nil
+		},
+		mockRankPeer: func(psp *peerSelectorPeer, rank int) (int, int) {
+			fallbackRanks++
+			return 0, rank
+		},
+		mockPeerDownloadDurationToRank: func(psp *peerSelectorPeer, blockDownloadDuration time.Duration) int {
+			return peerRankInitialSecondPriority
+		},
+	}
+
+	p1, p2 := &mockHTTPPeer{address: "p1"}, &mockHTTPPeer{address: "p2"}
+	net := &dataProviderFinderStub{
+		blockProviders:      map[basics.Round][]network.Peer{10: {p1, p2}},
+		catchpointProviders: map[string][]network.Peer{"10#ABC": {p2}},
+	}
+
+	// no providers, or no provider finder in the network
+	require.IsType(t, fallback, withBlockProviders(net, 11, 0, fallback))
+	require.IsType(t, fallback, withBlockProviders(&dataProviderFinderStub{}, 10, 0, fallback))
+	require.IsType(t, fallback, withBlockProviders(net, 10, 2, fallback))
+	require.IsType(t, fallback, withCatchpointProviders(net, "20#ABC", fallback))
+
+	// a provider that serves the data is offered again, one that fails is not
+	ps := withBlockProviders(net, 10, 0, fallback)
+	psp, err := ps.getNextPeer()
+	require.NoError(t, err)
+	require.Equal(t, p1, psp.Peer)
+	rank := ps.peerDownloadDurationToRank(psp, time.Millisecond)
+	require.Less(t, rank, peerRankInitialSecondPriority)
+	ps.rankPeer(psp, rank)
+	psp, err = ps.getNextPeer()
+	require.NoError(t, err)
+	require.Equal(t, p1, psp.Peer)
+	ps.rankPeer(psp, peerRankNoBlockForRound)
+	psp, err = ps.getNextPeer()
+	require.NoError(t, err)
+	require.Equal(t, p2, psp.Peer)
+	ps.rankPeer(psp, peerRankDownloadFailed)
+	require.Zero(t, fallbackRanks)
+	require.Equal(t, []network.Peer{p1, p2}, net.failed)
+
+	// once the providers are exhausted, the wrapped selector takes over
+	psp, err = ps.getNextPeer()
+	require.NoError(t, err)
+	require.Equal(t, fallbackPeer, psp.Peer)
+	require.Equal(t, peerRankInitialSecondPriority, ps.peerDownloadDurationToRank(psp, time.Millisecond))
+	ps.rankPeer(psp, peerRankDownloadFailed)
+	require.Equal(t, 1, fallbackRanks)
+
+	// skipping providers on retries
+	ps = withBlockProviders(net, 10, 1, fallback)
+	psp, err = ps.getNextPeer()
+	require.NoError(t, err)
+	require.Equal(t, p2, psp.Peer)
+
+	ps = withCatchpointProviders(net, "10#ABC", fallback)
+	psp, err = ps.getNextPeer()
+	require.NoError(t, err)
+	require.Equal(t, p2, psp.Peer)
+}
@@ -133,6 +133,10 @@ type Service struct {
 	// unsupportedRoundMonitor goroutine, after detecting
 	// an unsupported block.
 	onceUnsupportedRound sync.Once
+
+	// networkLatestRound is the highest round that peers have served or
+	// reported as their latest, used to estimate how far behind the node is.
+	networkLatestRound atomic.Uint64
 }
 
 // A BlockAuthenticator authenticates blocks given a certificate.
@@ -238,6 +242,22 @@ func (s *Service) SynchronizingTime() time.Duration {
 	return time.Duration(timeInNS - startNS)
 }
 
+// NetworkLatestRound returns the highest round that peers have served or reported as their latest,
+// or 0 if no peer has been heard from yet.
+func (s *Service) NetworkLatestRound() basics.Round {
+	return basics.Round(s.networkLatestRound.Load())
+}
+
+// observeNetworkRound raises networkLatestRound to rnd if it is higher.
+func (s *Service) observeNetworkRound(rnd basics.Round) {
+	for {
+		cur := s.networkLatestRound.Load()
+		if uint64(rnd) <= cur || s.networkLatestRound.CompareAndSwap(cur, uint64(rnd)) {
+			return
+		}
+	}
+}
+
 // errLedgerAlreadyHasBlock is returned by innerFetch in case the local ledger already has the requested block.
 var errLedgerAlreadyHasBlock = errors.New("ledger already has block")
 
@@ -262,6 +282,12 @@ func (s *Service) innerFetch(ctx context.Context, r basics.Round, peer network.P
 		}
 	}()
 	blk, cert, ddur, err = fetcher.fetchBlock(ctx, r, peer)
+	var nbfe noBlockForRoundError
+	if err == nil {
+		s.observeNetworkRound(r)
+	} else if errors.As(err, &nbfe) {
+		s.observeNetworkRound(nbfe.latest)
+	}
 	// check to see if we aborted due to ledger.
 	if err != nil {
 		select {
@@ -288,6 +314,9 @@ func (s *Service) fetchAndWrite(ctx context.Context, r basics.Round, prevFetchCo
 		return false
 	}
 
+	// prefer the peers advertising this round through the DHT, if any
+	peerSelector = withBlockProviders(s.net, r, 0, peerSelector)
+
 	// peerErrors tracks occurrences of errNoBlockForRound in order to quit earlier without making
 	// repeated requests for a block that most likely does not exist yet
 	peerErrors := map[network.Peer]int{}
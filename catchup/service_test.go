@@ -415,6 +415,9 @@ func TestServiceFetchBlocksOneBlock(t *testing.T) {
 	// Asserts that the last block is the one we expect
 	require.Equal(t, lastRoundAtStart+basics.Round(numBlocks), local.LastRound())
 
+	// The peer served every round we now have
+	require.GreaterOrEqual(t, s.NetworkLatestRound(), local.LastRound())
+
 	// Get the same block we wrote
 	block, _, _, err := makeUniversalBlockFetcher(logging.Base(),
 		net,
@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+)
+
+var agreementRound uint64
+var agreementJSON bool
+
+func init() {
+	agreementCmd.Flags().Uint64VarP(&agreementRound, "round", "r", 0, "Only show the timeline of this round")
+	agreementCmd.Flags().BoolVar(&agreementJSON, "json", false, "Print the timelines as JSON")
+}
+
+var agreementCmd = &cobra.Command{
+	Use:   "agreement",
+	Short: "Show what agreement did in the most recent rounds",
+	Long:  "Show the agreement timeline of the most recent rounds: proposals received with their credentials, vote thresholds reached, timeouts fired and the filter timeout in effect. The number of retained rounds is set by AgreementTimelineRounds in the node configuration.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			var round *uint64
+			if cmd.Flags().Changed("round") {
+				round = &agreementRound
+			}
+			resp, err := client.GetAgreementTimeline(round)
+			if err != nil {
+				reportErrorf(errorAgreementTimeline, err)
+			}
+			if agreementJSON {
+				out, err := json.MarshalIndent(resp, "", "  ")
+				if err != nil {
+					reportErrorf(errorAgreementTimeline, err)
+				}
+				fmt.Println(string(out))
+				return
+			}
+			if len(resp.Rounds) == 0 {
+				reportInfoln(infoNoAgreementTimeline)
+				return
+			}
+			for _, tl := range resp.Rounds {
+				fmt.Print(formatRoundTimeline(tl))
+			}
+		})
+	},
+}
+
+type timelineEntry struct {
+	arrival time.Duration
+	text    string
+}
+
+func shortDigest(s string) string {
+	if len(s) > 8 {
+		return s[:8]
+	}
+	return s
+}
+
+// formatRoundTimeline renders a round timeline with its events ordered by arrival time.
+func formatRoundTimeline(tl model.AgreementRoundTimeline) string {
+	var b strings.Builder
+
+	duration := "in progress"
+	if tl.EndTime != nil {
+		duration = time.Duration(*tl.EndTime - tl.StartTime).String()
+	}
+	fmt.Fprintf(&b, "Round %d (%s), filter timeout %s", tl.Round, duration, time.Duration(tl.FilterTimeout))
+	if tl.DynamicFilterTimeout != nil {
+		fmt.Fprintf(&b, ", dynamic filter timeout %s", time.Duration(*tl.DynamicFilterTimeout))
+	}
+	b.WriteString("\n")
+
+	var entries []timelineEntry
+	for _, p := range tl.Proposals {
+		text := fmt.Sprintf("proposal  period %d  block %s  sender %s  weight %d  credential %s", p.Period, shortDigest(p.BlockHash), p.Sender, p.Weight, shortDigest(p.Credential))
+		entries = append(entries, timelineEntry{time.Duration(p.Arrival), text})
+	}
+	for _, th := range tl.Thresholds {
+		text := fmt.Sprintf("%-8s  period %d  step %d  block %s", th.Type, th.Period, th.Step, shortDigest(th.BlockHash))
+		if th.Weight != nil {
+			text += fmt.Sprintf("  weight %d", *th.Weight)
+		} else {
+			text += "  (bundle)"
+		}
+		entries = append(entries, timelineEntry{time.Duration(th.Arrival), text})
+	}
+	for _, to := range tl.Timeouts {
+		kind := "timeout"
+		if to.Fast {
+			kind = "fast timeout"
+		}
+		text := fmt.Sprintf("%s  period %d  step %d  deadline %s", kind, to.Period, to.Step, time.Duration(to.Deadline))
+		entries = append(entries, timelineEntry{time.Duration(to.Arrival), text})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].arrival < entries[j].arrival })
+	for _, e := range entries {
+		fmt.Fprintf(&b, "  %+10.3fs  %s\n", e.arrival.Seconds(), e.text)
+	}
+	return b.String()
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestFormatRoundTimeline(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	weight := uint64(2500)
+	end := uint64(4 * time.Second)
+	dynamic := uint64(2 * time.Second)
+	tl := model.AgreementRoundTimeline{
+		Round:                42,
+		StartTime:            0,
+		EndTime:              &end,
+		FilterTimeout:        uint64(3 * time.Second),
+		DynamicFilterTimeout: &dynamic,
+		Proposals: []model.AgreementProposalArrival{
+			{Period: 0, Sender: "SENDER", BlockHash: "BLOCKHASHBLOCKHASH", Credential: "CREDENTIALDIGEST", Weight: 1, Arrival: uint64(500 * time.Millisecond)},
+		},
+		Thresholds: []model.AgreementThreshold{
+			{Period: 0, Step: 2, Type: "cert", BlockHash: "BLOCKHASHBLOCKHASH", Arrival: uint64(3500 * time.Millisecond)},
+			{Period: 0, Step: 1, Type: "soft", BlockHash: "BLOCKHASHBLOCKHASH", Weight: &weight, Arrival: uint64(3200 * time.Millisecond)},
+		},
+		Timeouts: []model.AgreementTimeout{
+			{Period: 0, Step: 0, Deadline: uint64(3 * time.Second), Arrival: uint64(3 * time.Second)},
+		},
+	}
+
+	out := formatRoundTimeline(tl)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 5)
+	require.Equal(t, "Round 42 (4s), filter timeout 3s, dynamic filter timeout 2s", lines[0])
+	// events are ordered by arrival
+	require.Contains(t, lines[1], "+0.500s  proposal  period 0  block BLOCKHAS  sender SENDER  weight 1  credential CREDENTI")
+	require.Contains(t, lines[2], "+3.000s  timeout  period 0  step 0  deadline 3s")
+	require.Contains(t, lines[3], "+3.200s  soft      period 0  step 1  block BLOCKHAS  weight 2500")
+	require.Contains(t, lines[4], "+3.500s  cert      period 0  step 2  block BLOCKHAS  (bundle)")
+
+	tl.EndTime = nil
+	tl.DynamicFilterTimeout = nil
+	require.True(t, strings.HasPrefix(formatRoundTimeline(tl), "Round 42 (in progress), filter timeout 3s\n"))
+}
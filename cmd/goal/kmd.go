@@ -33,6 +33,10 @@
 package main
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/algorand/go-algorand/cmd/util/datadir"
@@ -40,12 +44,25 @@ import (
 	"github.com/algorand/go-algorand/util"
 )
 
-var kmdTimeoutSecs uint64
+var (
+	kmdTimeoutSecs uint64
+
+	auditAddress  string
+	auditOp       string
+	auditAfterSeq uint64
+	auditLimit    uint64
+)
 
 func init() {
 	kmdCmd.AddCommand(startKMDCmd)
 	startKMDCmd.Flags().Uint64VarP(&kmdTimeoutSecs, "timeout", "t", 0, "Number of seconds after which to shut down kmd if there are no requests; 0 means no timeout")
 	kmdCmd.AddCommand(stopKMDCmd)
+	kmdCmd.AddCommand(auditKMDCmd)
+	auditKMDCmd.Flags().StringVarP(&walletName, "wallet", "w", "", "Only show operations on this wallet")
+	auditKMDCmd.Flags().StringVarP(&auditAddress, "address", "a", "", "Only show operations on this key or sender address")
+	auditKMDCmd.Flags().StringVar(&auditOp, "op", "", "Only show this operation, e.g. sign_transaction or export_key")
+	auditKMDCmd.Flags().Uint64Var(&auditAfterSeq, "after", 0, "Only show entries after this sequence number")
+	auditKMDCmd.Flags().Uint64Var(&auditLimit, "limit", 0, "Maximum number of entries to show; 0 means no limit")
 }
 
 var kmdCmd = &cobra.Command{
@@ -120,3 +137,82 @@ var stopKMDCmd = &cobra.Command{
 		})
 	},
 }
+
+var auditKMDCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Show the kmd audit log",
+	Long:  "Show the entries of kmd's audit log of signing, key export, import and delete operations, oldest first. The log is hash-chained, and a warning is printed if it has been tampered with.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+
+		wallets, err := client.ListWallets()
+		if err != nil {
+			reportErrorf(errorKMDAuditQuery, err)
+		}
+		walletNames := make(map[string]string, len(wallets))
+		for _, wallet := range wallets {
+			walletNames[wallet.ID] = wallet.Name
+		}
+
+		var walletID []byte
+		if walletName != "" {
+			var dup bool
+			walletID, dup, err = client.FindWalletIDByName([]byte(walletName))
+			if err != nil {
+				reportErrorf(errFindingWallet, err)
+			}
+			if dup {
+				reportErrorf(errWalletNameAmbiguous, walletName)
+			}
+			if len(walletID) == 0 {
+				reportErrorf(errWalletNotFound, walletName)
+			}
+		}
+
+		entries, chainErr, err := client.ListAudit(walletID, auditAddress, auditOp, auditAfterSeq, auditLimit)
+		if err != nil {
+			reportErrorf(errorKMDAuditQuery, err)
+		}
+		if chainErr != "" {
+			reportWarnf(warnKMDAuditTampered, chainErr)
+		}
+
+		for _, e := range entries {
+			wallet := e.WalletID
+			if name, ok := walletNames[e.WalletID]; ok {
+				wallet = name
+			}
+			fields := []string{
+				fmt.Sprintf("%d", e.Seq),
+				time.Unix(e.Time, 0).UTC().Format(time.RFC3339),
+				e.Operation,
+				"wallet=" + wallet,
+			}
+			for _, f := range []struct{ name, value string }{
+				{"address", e.Address},
+				{"txid", e.TxID},
+				{"sender", e.Sender},
+				{"type", e.TxType},
+				{"receiver", e.Receiver},
+				{"program", e.ProgramHash},
+			} {
+				if f.value != "" {
+					fields = append(fields, f.name+"="+f.value)
+				}
+			}
+			if e.Amount != 0 {
+				fields = append(fields, fmt.Sprintf("amount=%d", e.Amount))
+			}
+			if e.AssetID != 0 {
+				fields = append(fields, fmt.Sprintf("asset=%d", e.AssetID))
+			}
+			fields = append(fields, "origin="+e.Origin)
+			if e.Error != "" {
+				fields = append(fields, fmt.Sprintf("error=%q", e.Error))
+			}
+			fmt.Println(strings.Join(fields, " "))
+		}
+	},
+}
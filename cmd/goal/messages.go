@@ -69,6 +69,8 @@ const (
 	infoKMDStarted        = "Successfully started kmd"
 	errorKMDFailedToStart = "Failed to start kmd: %s"
 	errorKMDFailedToStop  = "Failed to stop kmd: %s"
+	errorKMDAuditQuery    = "Couldn't query the kmd audit log: %s"
+	warnKMDAuditTampered  = "The kmd audit log has been tampered with: %s"
 
 	// Node
 	infoNodeStart                           = "Algorand node successfully started!"
@@ -108,6 +110,8 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	errorAgreementTimeline                  = "Cannot get agreement timeline: %s"
+	infoNoAgreementTimeline                 = "No agreement timeline retained, AgreementTimelineRounds may be set to 0"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -204,6 +208,14 @@ const (
 	errorBadRecoveredKey         = "Recovered invalid key"
 	errorFailedToReadResponse    = "Couldn't read response: %s"
 	errorFailedToReadPassword    = "Couldn't read password: %s"
+	errorCouldntBackupWallet     = "Couldn't back up wallet: %s"
+	errorCouldntRestoreWallet    = "Couldn't restore wallet: %s"
+
+	infoChooseBackupPasswordPrompt   = "Please choose a password for the backup: "
+	infoWroteWalletBackup            = "Wrote encrypted wallet backup to %s"
+	infoBackupPasswordPrompt         = "Please enter the password for backup '%s': "
+	infoChooseRestoredPasswordPrompt = "Please choose a password for the restored wallet: "
+	infoRestoredWallet               = "Restored wallet '%s'"
 
 	// Commands
 	infoPasswordPrompt       = "Please enter the password for wallet '%s': "
@@ -50,17 +50,27 @@ import (
 var (
 	recoverWallet     bool
 	defaultWalletName string
+	backupFilename    string
 )
 
 func init() {
 	walletCmd.AddCommand(newWalletCmd)
 	walletCmd.AddCommand(listWalletsCmd)
+	walletCmd.AddCommand(backupWalletCmd)
+	walletCmd.AddCommand(restoreWalletCmd)
 
 	// Default wallet to use when -w not specified
 	walletCmd.Flags().StringVarP(&defaultWalletName, "default", "f", "", "Set the wallet with this name to be the default wallet")
 
 	// Should we recover the wallet?
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
+
+	backupWalletCmd.Flags().StringVarP(&walletName, "wallet", "w", "", "Set the wallet to back up")
+	backupWalletCmd.Flags().StringVarP(&backupFilename, "outfile", "o", "", "Filename for writing the encrypted backup")
+	backupWalletCmd.MarkFlagRequired("outfile")
+
+	restoreWalletCmd.Flags().StringVarP(&backupFilename, "infile", "i", "", "Encrypted backup produced by `goal wallet backup`")
+	restoreWalletCmd.MarkFlagRequired("infile")
 }
 
 var walletCmd = &cobra.Command{
@@ -200,6 +210,90 @@ var newWalletCmd = &cobra.Command{
 	},
 }
 
+var backupWalletCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Write an encrypted backup of a wallet to a file",
+	Long:  "Write the keys, multisig preimages and metadata of a wallet to a single file encrypted with a backup password. Unlike the backup phrase, the backup includes imported keys. Restore it on any host with `goal wallet restore`.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
+
+		// Fetch a password for the backup
+		fmt.Printf(infoChooseBackupPasswordPrompt)
+		backupPassword := ensurePassword()
+		fmt.Printf(infoPasswordConfirmation)
+		passwordConfirmation := ensurePassword()
+		if !bytes.Equal(backupPassword, passwordConfirmation) {
+			reportErrorln(errorPasswordConfirmation)
+		}
+
+		backup, err := client.BackupWallet(wh, pw, backupPassword)
+		if err != nil {
+			reportErrorf(errorCouldntBackupWallet, err)
+		}
+		err = writeFile(backupFilename, backup, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, backupFilename, err)
+		}
+		reportInfof(infoWroteWalletBackup, backupFilename)
+	},
+}
+
+var restoreWalletCmd = &cobra.Command{
+	Use:   "restore [wallet name]",
+	Short: "Restore a wallet from an encrypted backup",
+	Long:  "Create a wallet from a backup written by `goal wallet backup`. The wallet keeps its original name unless a new one is given.",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		client := ensureKmdClient(dataDir)
+
+		var newWalletName []byte
+		if len(args) > 0 {
+			newWalletName = []byte(args[0])
+		}
+
+		backup, err := readFile(backupFilename)
+		if err != nil {
+			reportErrorf(fileReadError, backupFilename, err)
+		}
+
+		fmt.Printf(infoBackupPasswordPrompt, backupFilename)
+		backupPassword := ensurePassword()
+
+		// Fetch a password for the restored wallet
+		fmt.Printf(infoChooseRestoredPasswordPrompt)
+		walletPassword := ensurePassword()
+		fmt.Printf(infoPasswordConfirmation)
+		passwordConfirmation := ensurePassword()
+		if !bytes.Equal(walletPassword, passwordConfirmation) {
+			reportErrorln(errorPasswordConfirmation)
+		}
+
+		walletID, err := client.RestoreWallet(backup, backupPassword, newWalletName, walletPassword)
+		if err != nil {
+			reportErrorf(errorCouldntRestoreWallet, err)
+		}
+		restoredName, _, err := client.FindWalletNameByID(walletID)
+		if err != nil {
+			reportErrorf(errGettingWalletName, walletID, err)
+		}
+		reportInfof(infoRestoredWallet, restoredName)
+
+		// We are the only wallet -- make us the default
+		wallets, err := client.ListWallets()
+		if err != nil {
+			reportErrorf(errorCouldntListWallets, err)
+		}
+		if len(wallets) == 1 {
+			accountList.setDefaultWalletID(walletID)
+		}
+	},
+}
+
 var listWalletsCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List wallets managed by kmd",
@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/partsigner"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var (
+	keysDir      string
+	socketPath   string
+	protectionDB string
+)
+
+func init() {
+	rootCmd.Flags().StringVarP(&keysDir, "keys-dir", "k", "", "Directory holding the participation key files (*.partkey) to sign with")
+	rootCmd.Flags().StringVarP(&socketPath, "socket", "s", "", "Path of the Unix domain socket to listen on")
+	rootCmd.Flags().StringVarP(&protectionDB, "protection-db", "p", "", "Path of the database recording signed messages (default: partsigner.sqlite in keys-dir)")
+	rootCmd.MarkFlagRequired("keys-dir")
+	rootCmd.MarkFlagRequired("socket")
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "partsigner",
+	Short: "Participation key signer",
+	Long: `partsigner holds participation keys outside of algod and signs votes,
+heartbeats, VRF proofs and state proof messages on its behalf. Every vote,
+heartbeat and state proof message signed is recorded, and a conflicting
+message for the same slot is refused. One-time voting keys are deleted from
+the key files once used. Point algod's ParticipationSignerSocket at the socket
+to use it.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := run(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+// loadKeys adds the participation keys in keysDir to signer and returns the
+// opened key files, which must stay open while the signer deletes used keys.
+func loadKeys(signer *partsigner.SoftSigner, log logging.Logger) ([]db.Accessor, error) {
+	files, err := os.ReadDir(keysDir)
+	if err != nil {
+		return nil, err
+	}
+	var handles []db.Accessor
+	for _, info := range files {
+		if !config.IsPartKeyFilename(info.Name()) {
+			continue
+		}
+		filename := filepath.Join(keysDir, info.Name())
+		handle, err := db.MakeAccessor(filename, false, false)
+		if err != nil {
+			return handles, fmt.Errorf("cannot open %s: %w", filename, err)
+		}
+		part, err := account.RestoreParticipationWithSecrets(handle)
+		if err != nil {
+			handle.Close()
+			return handles, fmt.Errorf("cannot load participation key from %s: %w", filename, err)
+		}
+		handles = append(handles, handle)
+		id := signer.AddParticipation(part)
+		log.Infof("loaded participation key %s for %s from %s", id, part.Parent, info.Name())
+	}
+	return handles, nil
+}
+
+func run() error {
+	log := logging.NewLogger()
+	log.SetLevel(logging.Info)
+
+	if protectionDB == "" {
+		protectionDB = filepath.Join(keysDir, "partsigner.sqlite")
+	}
+	signer, err := partsigner.MakeSoftSigner(protectionDB, log)
+	if err != nil {
+		return err
+	}
+	defer signer.Close()
+
+	handles, err := loadKeys(signer, log)
+	for i := range handles {
+		defer handles[i].Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	server := partsigner.MakeServer(signer, log)
+	err = server.Start(socketPath)
+	if err != nil {
+		return err
+	}
+	log.Infof("listening on %s", socketPath)
+
+	kill := make(chan os.Signal, 1)
+	signal.Notify(kill, os.Interrupt, syscall.SIGTERM)
+	<-kill
+	server.Stop()
+	return nil
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -89,6 +89,9 @@ const StateProofFileName = "stateproof.sqlite"
 // It is used for tracking participation key metadata.
 const ParticipationRegistryFilename = "partregistry.sqlite"
 
+// SyncCursorsFilename is the name of the file where a follower node keeps its named sync cursors.
+const SyncCursorsFilename = "synccursors.json"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus protocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
@@ -60,7 +60,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31" version[32]:"32" version[33]:"33" version[34]:"34" version[35]:"35"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31" version[32]:"32" version[33]:"33" version[34]:"34" version[35]:"35" version[36]:"36"`
 
 	// Archival nodes retain a full copy of the block history. Non-Archival nodes will delete old blocks and only retain what's need to properly validate blockchain messages (the precise number of recent blocks depends on the consensus parameters. Currently the last 1321 blocks are required). This means that non-Archival nodes require significantly less storage than Archival nodes.  If setting this to true for the first time, the existing ledger may need to be deleted to get the historical values stored as the setting only affects current blocks forward. To do this, shutdown the node and delete all .sqlite files within the data/testnet-version directory, except the crash.sqlite file. Restart the node and wait for the node to sync.
 	Archival bool `version[0]:"false"`
@@ -644,6 +644,165 @@ type Local struct {
 	// EnableDHT will turn on the hash table for use with capabilities advertisement
 	EnableDHTProviders bool `version[34]:"false"`
 
+	// P2PGossipScoreInvalidMessageWeight is the gossipsub peer score weight applied to the squared count of invalid messages
+	// delivered by a peer on a topic. A message is invalid when the topic validator rejects it, e.g. when a transaction group
+	// fails signature verification. The value must be non-positive; zero disables the penalty.
+	P2PGossipScoreInvalidMessageWeight int `version[36]:"-1000"`
+
+	// P2PGossipScoreInvalidMessageDecaySec sets the time, in seconds, after which the invalid message counter of a peer decays to zero.
+	P2PGossipScoreInvalidMessageDecaySec int `version[36]:"3600"`
+
+	// P2PGossipScoreMeshDeliveriesThreshold sets the minimal number of messages a mesh peer is expected to deliver on a topic
+	// within P2PGossipScoreMeshDeliveriesWindowSec. Mesh peers delivering fewer messages are penalized with P2PGossipScoreMeshDeliveriesWeight.
+	// Zero disables mesh delivery expectations.
+	P2PGossipScoreMeshDeliveriesThreshold int `version[36]:"0"`
+
+	// P2PGossipScoreMeshDeliveriesWeight is the gossipsub peer score weight applied to the squared mesh delivery deficit.
+	// The value must be non-positive and is only used when P2PGossipScoreMeshDeliveriesThreshold is set.
+	P2PGossipScoreMeshDeliveriesWeight int `version[36]:"-1"`
+
+	// P2PGossipScoreMeshDeliveriesWindowSec sets the time, in seconds, a mesh peer must be in the mesh before mesh delivery expectations apply to it.
+	P2PGossipScoreMeshDeliveriesWindowSec int `version[36]:"60"`
+
+	// P2PGossipScoreIPColocationFactorThreshold sets the number of peers sharing a single IP address that are tolerated
+	// before the IP colocation penalty is applied.
+	P2PGossipScoreIPColocationFactorThreshold int `version[36]:"10"`
+
+	// P2PGossipScoreIPColocationFactorWeight is the gossipsub peer score weight applied to the squared number of peers
+	// over P2PGossipScoreIPColocationFactorThreshold sharing a single IP address. The value must be non-positive; zero disables the penalty.
+	P2PGossipScoreIPColocationFactorWeight int `version[36]:"0"`
+
+	// PeerAllowlistFile enables private network mode when set to the path of a file listing the only peers this node
+	// connects to or accepts connections from, one libp2p peer ID per line. It applies to both websocket and P2P networks.
+	// Websocket peers are identified by identity challenges signed with their P2P private key, so P2PPersistPeerID or
+	// P2PPrivateKeyLocation should be set on every node, and PublicAddress must be set on nodes accepting websocket connections.
+	// The file is re-read when modified, and connected peers removed from the list are disconnected.
+	PeerAllowlistFile string `version[36]:""`
+
+	// PeerAllowlistReloadIntervalSec sets how often, in seconds, PeerAllowlistFile is checked for modifications.
+	PeerAllowlistReloadIntervalSec int `version[36]:"10"`
+
+	// P2PEnableNATTraversal lets a p2p node behind a NAT be reached by its peers: when AutoNAT finds the node is not
+	// publicly reachable, it reserves slots on circuit relays from the phonebook and attempts hole punching to upgrade
+	// relayed connections to direct ones. Hole punching requires the node to listen on NetAddress, or P2PHybridNetAddress in hybrid mode.
+	P2PEnableNATTraversal bool `version[36]:"false"`
+
+	// P2PEnableCircuitRelayService makes a publicly reachable p2p node serve as a circuit relay v2 for nodes behind
+	// a NAT, and answer their AutoNAT reachability probes.
+	P2PEnableCircuitRelayService bool `version[36]:"false"`
+
+	// OutgoingBandwidthLimit caps the outgoing gossip traffic of the websocket and p2p networks, in bytes per second,
+	// including the transactions gossipsub forwards on behalf of other peers.
+	// When the budget runs low, transaction messages are dropped first, then proposals; agreement votes are never dropped
+	// but still consume the budget. Dropped messages are counted in the algod_network_outgoing_throttled metrics.
+	// Zero disables the global cap.
+	OutgoingBandwidthLimit uint64 `version[36]:"0"`
+
+	// OutgoingProposalBandwidthLimit caps the outgoing proposal payload traffic, in bytes per second. Zero disables the cap.
+	OutgoingProposalBandwidthLimit uint64 `version[36]:"0"`
+
+	// OutgoingTxnBandwidthLimit caps the outgoing transaction gossip traffic, in bytes per second. Zero disables the cap.
+	OutgoingTxnBandwidthLimit uint64 `version[36]:"0"`
+
+	// OutgoingTxnBandwidthReservePercent is the percentage of the OutgoingBandwidthLimit budget that transaction gossip
+	// may not consume, keeping it available for agreement votes and proposals.
+	OutgoingTxnBandwidthReservePercent uint64 `version[36]:"25"`
+
+	// AgreementTimelineRounds is the number of most recent rounds for which the agreement service keeps a timeline of
+	// proposal arrivals, vote thresholds, timeouts and filter timeouts, served by the admin agreement timeline endpoint.
+	// Setting it to 0 disables the timeline.
+	AgreementTimelineRounds int `version[36]:"20"`
+
+	// EnableParticipationMonitor makes the node evaluate the health of the accounts it holds participation keys for
+	// after every round, raising telemetry events, metrics and, if ParticipationAlertWebhookURL is set, webhook calls
+	// when a key nears expiry, an online account stops voting, or an account goes offline or is suspended.
+	EnableParticipationMonitor bool `version[36]:"false"`
+
+	// ParticipationKeyExpiryWarningRounds is the number of rounds before the registered participation key of an online
+	// account expires at which the participation monitor starts warning about it.
+	ParticipationKeyExpiryWarningRounds uint64 `version[36]:"100000"`
+
+	// ParticipationVoteInactivityRounds is the number of rounds an online account may go without a recorded vote
+	// before the participation monitor warns about it. Accounts with little stake are rarely selected to vote, so
+	// their operators may need to raise it. Zero disables the check.
+	ParticipationVoteInactivityRounds uint64 `version[36]:"5000"`
+
+	// ParticipationAlertWebhookURL, if set, is the URL to which the participation monitor POSTs a JSON description of
+	// every alert it raises or clears.
+	ParticipationAlertWebhookURL string `version[36]:""`
+
+	// ParticipationKeyRotationFile, if set, is the path of a JSON file (relative to the data directory) listing the
+	// accounts whose participation keys the node renews before they expire, and where the keys authorizing their keyreg
+	// transactions are found. See the partrotation package for its format.
+	ParticipationKeyRotationFile string `version[36]:""`
+
+	// ParticipationSignerSocket, if set, is the path of a Unix domain socket (relative to the data directory) on which
+	// an external participation signer is listening. Votes, VRF proofs and state proof signatures for the keys held by
+	// that signer are requested from it, so their secrets never reside in the node.
+	ParticipationSignerSocket string `version[36]:""`
+
+	// RestRateLimitCostPerSecond is the request cost each REST API client, identified by its scoped token name or
+	// IP address, may spend per second. Cheap endpoints such as /health cost 1, while simulation, dryrun and requests
+	// for large accounts cost more. Requests above the limit are rejected with 429 Too Many Requests and a Retry-After
+	// header. Zero disables the limit.
+	RestRateLimitCostPerSecond uint64 `version[36]:"0"`
+
+	// RestRateLimitBurstCost is the cost a REST API client may spend at once after being idle. It defaults to
+	// RestRateLimitCostPerSecond when zero, and is raised to the cost of the most expensive endpoint if lower.
+	RestRateLimitBurstCost uint64 `version[36]:"0"`
+
+	// EnableConnectAPI serves the core operations of the REST API, defined by the algod.v1.AlgodService protobuf service
+	// in daemon/algod/api/algod.proto, over the Connect protocol on the REST API address. It uses the same tokens as
+	// the public REST API.
+	EnableConnectAPI bool `version[36]:"false"`
+
+	// EnableHistoricalStateIndex makes an Archival node record every change to accounts, application and asset
+	// resources, boxes and creators in a separate history database as blocks are committed, so that state queries
+	// can be answered at any round since the index was enabled. It has no effect on non-Archival nodes.
+	EnableHistoricalStateIndex bool `version[36]:"false"`
+
+	// EnableTransactionActivityIndex makes an Archival node index, as blocks are added, the transactions touching each
+	// account, application and asset, including through inner transactions, so that they can be listed by the
+	// /v2/accounts/{address}/transactions, /v2/applications/{application-id}/transactions and
+	// /v2/assets/{asset-id}/transactions endpoints. It has no effect on non-Archival nodes.
+	EnableTransactionActivityIndex bool `version[36]:"false"`
+
+	// EnableLedgerEntryProofs makes a node that tracks catchpoints keep the pages of the catchpoint merkle trie, and the
+	// accounts, resources and boxes, that changed since the accounts round of the last catchpoint in a separate
+	// database, so that the /v2/accounts/{address}/proof and /v2/applications/{application-id}/box/proof endpoints
+	// can prove entries against the trie root committed to by the last catchpoint label. The database grows with the
+	// changes made within a catchpoint interval, up to the size of the trie and accounts. It has no effect unless
+	// CatchpointTracking and CatchpointInterval enable catchpoint tracking.
+	EnableLedgerEntryProofs bool `version[36]:"false"`
+
+	// HealthNetworkLagWarnRounds is the number of rounds the node may trail the latest round reported by its peers
+	// before the network-lag check of /health/checks warns. Zero disables the warning.
+	HealthNetworkLagWarnRounds uint64 `version[36]:"2"`
+
+	// HealthNetworkLagFailRounds is the number of rounds the node may trail the latest round reported by its peers
+	// before the network-lag check of /health/checks fails. Zero disables the failure.
+	HealthNetworkLagFailRounds uint64 `version[36]:"10"`
+
+	// HealthMinOutgoingPeers is the number of outgoing peer connections below which the peers check of /health/checks
+	// warns. The check fails when the node has no peers at all.
+	HealthMinOutgoingPeers uint64 `version[36]:"2"`
+
+	// HealthDiskFreeWarnMB is the free space, in megabytes, below which the disk check of /health/checks warns about
+	// the hot or cold data directory. Zero disables the warning.
+	HealthDiskFreeWarnMB uint64 `version[36]:"10240"`
+
+	// HealthDiskFreeFailMB is the free space, in megabytes, below which the disk check of /health/checks fails.
+	// Zero disables the failure.
+	HealthDiskFreeFailMB uint64 `version[36]:"1024"`
+
+	// HealthTrackerCommitLagWarnRounds is the number of rounds the ledger trackers may hold in memory, uncommitted
+	// to the tracker database, before the tracker-commit check of /health/checks warns. Zero disables the warning.
+	HealthTrackerCommitLagWarnRounds uint64 `version[36]:"256"`
+
+	// HealthTrackerCommitLagFailRounds is the number of uncommitted rounds above which the tracker-commit check of
+	// /health/checks fails. Zero disables the failure.
+	HealthTrackerCommitLagFailRounds uint64 `version[36]:"1024"`
+
 	// P2PPersistPeerID will write the private key used for the node's PeerID to the P2PPrivateKeyLocation.
 	// This is only used when P2PEnable is true. If P2PPrivateKey is not specified, it uses the default location.
 	P2PPersistPeerID bool `version[29]:"false"`
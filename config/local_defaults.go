@@ -36,12 +36,13 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    35,
+	Version:                                    36,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AgreementIncomingBundlesQueueLength:        15,
 	AgreementIncomingProposalsQueueLength:      50,
 	AgreementIncomingVotesQueueLength:          20000,
+	AgreementTimelineRounds:                    20,
 	AnnounceParticipationKey:                   true,
 	Archival:                                   false,
 	BaseLoggerDebugLevel:                       4,
@@ -80,19 +81,23 @@ var defaultLocal = Local{
 	EnableAgreementTimeMetrics:                 false,
 	EnableAssembleStats:                        false,
 	EnableBlockService:                         false,
+	EnableConnectAPI:                           false,
 	EnableDHTProviders:                         false,
 	EnableDeveloperAPI:                         false,
 	EnableExperimentalAPI:                      false,
 	EnableFollowMode:                           false,
 	EnableGossipBlockService:                   true,
 	EnableGossipService:                        true,
+	EnableHistoricalStateIndex:                 false,
 	EnableIncomingMessageFilter:                false,
+	EnableLedgerEntryProofs:                    false,
 	EnableLedgerService:                        false,
 	EnableMetricReporting:                      false,
 	EnableNetDevMetrics:                        false,
 	EnableOutgoingNetworkMessageFiltering:      true,
 	EnableP2P:                                  false,
 	EnableP2PHybridMode:                        false,
+	EnableParticipationMonitor:                 false,
 	EnablePingHandler:                          true,
 	EnablePrivateNetworkAccessHeader:           false,
 	EnableProcessBlockStats:                    false,
@@ -100,6 +105,7 @@ var defaultLocal = Local{
 	EnableRequestLogger:                        false,
 	EnableRuntimeMetrics:                       false,
 	EnableTopAccountsReporting:                 false,
+	EnableTransactionActivityIndex:             false,
 	EnableTxBacklogAppRateLimiting:             true,
 	EnableTxBacklogRateLimiting:                true,
 	EnableTxnEvalTracer:                        false,
@@ -111,6 +117,13 @@ var defaultLocal = Local{
 	ForceRelayMessages:                         false,
 	GoMemLimit:                                 0,
 	GossipFanout:                               4,
+	HealthDiskFreeFailMB:                       1024,
+	HealthDiskFreeWarnMB:                       10240,
+	HealthMinOutgoingPeers:                     2,
+	HealthNetworkLagFailRounds:                 10,
+	HealthNetworkLagWarnRounds:                 2,
+	HealthTrackerCommitLagFailRounds:           1024,
+	HealthTrackerCommitLagWarnRounds:           256,
 	HeartbeatUpdateInterval:                    600,
 	HotDataDir:                                 "",
 	IncomingConnectionsLimit:                   2400,
@@ -135,13 +148,33 @@ var defaultLocal = Local{
 	NodeExporterListenAddress:                  ":9100",
 	NodeExporterPath:                           "./node_exporter",
 	OptimizeAccountsDatabaseOnStartup:          false,
+	OutgoingBandwidthLimit:                     0,
 	OutgoingMessageFilterBucketCount:           3,
 	OutgoingMessageFilterBucketSize:            128,
+	OutgoingProposalBandwidthLimit:             0,
+	OutgoingTxnBandwidthLimit:                  0,
+	OutgoingTxnBandwidthReservePercent:         25,
+	P2PEnableCircuitRelayService:               false,
+	P2PEnableNATTraversal:                      false,
+	P2PGossipScoreIPColocationFactorThreshold:  10,
+	P2PGossipScoreIPColocationFactorWeight:     0,
+	P2PGossipScoreInvalidMessageDecaySec:       3600,
+	P2PGossipScoreInvalidMessageWeight:         -1000,
+	P2PGossipScoreMeshDeliveriesThreshold:      0,
+	P2PGossipScoreMeshDeliveriesWeight:         -1,
+	P2PGossipScoreMeshDeliveriesWindowSec:      60,
 	P2PHybridIncomingConnectionsLimit:          1200,
 	P2PHybridNetAddress:                        "",
 	P2PPersistPeerID:                           false,
 	P2PPrivateKeyLocation:                      "",
+	ParticipationAlertWebhookURL:               "",
+	ParticipationKeyExpiryWarningRounds:        100000,
+	ParticipationKeyRotationFile:               "",
 	ParticipationKeysRefreshInterval:           60000000000,
+	ParticipationSignerSocket:                  "",
+	ParticipationVoteInactivityRounds:          5000,
+	PeerAllowlistFile:                          "",
+	PeerAllowlistReloadIntervalSec:             10,
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      0,
 	PriorityPeers:                              map[string]bool{},
@@ -151,6 +184,8 @@ var defaultLocal = Local{
 	ReservedFDs:                                256,
 	RestConnectionsHardLimit:                   2048,
 	RestConnectionsSoftLimit:                   1024,
+	RestRateLimitBurstCost:                     0,
+	RestRateLimitCostPerSecond:                 0,
 	RestReadTimeoutSeconds:                     15,
 	RestWriteTimeoutSeconds:                    120,
 	RunHosted:                                  false,
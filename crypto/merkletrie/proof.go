@@ -0,0 +1,218 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// ErrProofDecodingFailure is returned if decoding a serialized proof has failed.
+var ErrProofDecodingFailure = errors.New("error encountered while decoding proof")
+
+// ErrProofVerificationFailure is returned if a proof does not prove the given element against the given root.
+var ErrProofVerificationFailure = errors.New("proof does not match the element and root hash")
+
+// ProofSibling is a child of a node along the path to a proven element, other than the child the path goes through.
+type ProofSibling struct {
+	// HashIndex is the byte of the element keys under the sibling at the depth of the sibling's parent.
+	HashIndex byte
+	// Leaf is set if the sibling is a leaf node.
+	Leaf bool
+	// Hash is the remainder of the element key for a leaf sibling, or the node hash otherwise.
+	Hash []byte
+}
+
+// Proof is a Merkle inclusion proof of an element of the trie. It holds, for every node on the path from the
+// root to the element's leaf, the siblings of the child the path goes through, in increasing HashIndex order.
+type Proof struct {
+	Steps [][]ProofSibling
+}
+
+// Prove returns a proof of the inclusion of the given element in the trie, or false if the trie does not contain it.
+func (mt *Trie) Prove(d []byte) (proof Proof, found bool, err error) {
+	if mt.root == storedNodeIdentifierNull || len(d) != mt.elementLength {
+		return Proof{}, false, nil
+	}
+	if mt.cache.modified {
+		if _, err = mt.Commit(); err != nil {
+			return Proof{}, false, err
+		}
+	}
+	pnode, err := mt.cache.getNode(mt.root)
+	if err != nil {
+		return Proof{}, false, err
+	}
+	for depth := 0; !pnode.leaf(); depth++ {
+		if !pnode.childrenMask.Bit(d[depth]) {
+			return Proof{}, false, nil
+		}
+		siblings := make([]ProofSibling, 0, len(pnode.children)-1)
+		var next *node
+		for _, child := range pnode.children {
+			childNode, err := mt.cache.getNode(child.id)
+			if err != nil {
+				return Proof{}, false, err
+			}
+			if child.hashIndex == d[depth] {
+				next = childNode
+				continue
+			}
+			siblings = append(siblings, ProofSibling{
+				HashIndex: child.hashIndex,
+				Leaf:      childNode.leaf(),
+				Hash:      append([]byte{}, childNode.hash...),
+			})
+		}
+		proof.Steps = append(proof.Steps, siblings)
+		pnode = next
+	}
+	if !bytes.Equal(pnode.hash, d[len(proof.Steps):]) {
+		return Proof{}, false, nil
+	}
+	return proof, true, nil
+}
+
+// VerifyProof checks that the proof proves the inclusion of the element d in a trie with the given root hash.
+func VerifyProof(root crypto.Digest, d []byte, proof Proof) error {
+	if len(proof.Steps) > len(d) {
+		return ErrProofVerificationFailure
+	}
+	// start from the element's leaf, which holds the remainder of the element past its parent's path
+	hash := d[len(proof.Steps):]
+	leaf := true
+	var accumulator []byte
+	for depth := len(proof.Steps) - 1; depth >= 0; depth-- {
+		// recompute the parent's hash the way node.calculateHash does
+		accumulator = append(accumulator[:0], byte(depth))
+		accumulator = append(accumulator, d[:depth]...)
+		inserted := false
+		prevIndex := -1
+		for _, sibling := range proof.Steps[depth] {
+			if int(sibling.HashIndex) <= prevIndex || sibling.HashIndex == d[depth] {
+				return ErrProofVerificationFailure
+			}
+			prevIndex = int(sibling.HashIndex)
+			if !inserted && sibling.HashIndex > d[depth] {
+				accumulator = appendProofChild(accumulator, d[depth], leaf, hash)
+				inserted = true
+			}
+			accumulator = appendProofChild(accumulator, sibling.HashIndex, sibling.Leaf, sibling.Hash)
+		}
+		if !inserted {
+			accumulator = appendProofChild(accumulator, d[depth], leaf, hash)
+		}
+		digest := crypto.Hash(accumulator)
+		hash = digest[:]
+		leaf = false
+	}
+	var computed crypto.Digest
+	if leaf {
+		computed = crypto.Hash(append([]byte{0}, hash...))
+	} else {
+		computed = crypto.Hash(append([]byte{1}, hash...))
+	}
+	if computed != root {
+		return ErrProofVerificationFailure
+	}
+	return nil
+}
+
+func appendProofChild(accumulator []byte, hashIndex byte, leaf bool, hash []byte) []byte {
+	if leaf {
+		accumulator = append(accumulator, byte(0))
+	} else {
+		accumulator = append(accumulator, byte(1))
+	}
+	accumulator = append(accumulator, byte(len(hash)), hashIndex)
+	return append(accumulator, hash...)
+}
+
+// Serialize encodes the proof into a byte array.
+func (p Proof) Serialize() []byte {
+	buf := binary.AppendUvarint(nil, uint64(len(p.Steps)))
+	for _, siblings := range p.Steps {
+		buf = binary.AppendUvarint(buf, uint64(len(siblings)))
+		for _, sibling := range siblings {
+			buf = append(buf, sibling.HashIndex)
+			if sibling.Leaf {
+				buf = append(buf, 0)
+			} else {
+				buf = append(buf, 1)
+			}
+			buf = binary.AppendUvarint(buf, uint64(len(sibling.Hash)))
+			buf = append(buf, sibling.Hash...)
+		}
+	}
+	return buf
+}
+
+// DeserializeProof decodes a proof encoded by Proof.Serialize.
+func DeserializeProof(buf []byte) (p Proof, err error) {
+	readUvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return 0, ErrProofDecodingFailure
+		}
+		buf = buf[n:]
+		return v, nil
+	}
+	stepCount, err := readUvarint()
+	if err != nil {
+		return Proof{}, err
+	}
+	// every step takes at least one byte, which bounds the allocations below by the input size
+	if stepCount > uint64(len(buf)) {
+		return Proof{}, ErrProofDecodingFailure
+	}
+	p.Steps = make([][]ProofSibling, stepCount)
+	for i := range p.Steps {
+		siblingCount, err := readUvarint()
+		if err != nil {
+			return Proof{}, err
+		}
+		if siblingCount > uint64(len(buf)) {
+			return Proof{}, ErrProofDecodingFailure
+		}
+		siblings := make([]ProofSibling, siblingCount)
+		for j := range siblings {
+			if len(buf) < 2 || buf[1] > 1 {
+				return Proof{}, ErrProofDecodingFailure
+			}
+			siblings[j].HashIndex = buf[0]
+			siblings[j].Leaf = buf[1] == 0
+			buf = buf[2:]
+			hashLength, err := readUvarint()
+			if err != nil {
+				return Proof{}, err
+			}
+			if hashLength > uint64(len(buf)) {
+				return Proof{}, ErrProofDecodingFailure
+			}
+			siblings[j].Hash = append([]byte{}, buf[:hashLength]...)
+			buf = buf[hashLength:]
+		}
+		p.Steps[i] = siblings
+	}
+	if len(buf) != 0 {
+		return Proof{}, ErrProofDecodingFailure
+	}
+	return p, nil
+}
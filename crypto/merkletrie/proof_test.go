@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestProveAndVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var memoryCommitter InMemoryCommitter
+	mt, err := MakeTrie(&memoryCommitter, defaultTestMemoryConfig)
+	require.NoError(t, err)
+
+	// an empty trie proves nothing
+	_, found, err := mt.Prove(make([]byte, crypto.DigestSize))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// a single element trie has a leaf root
+	single := crypto.Hash([]byte{0xff})
+	_, err = mt.Add(single[:])
+	require.NoError(t, err)
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+	proof, found, err := mt.Prove(single[:])
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Empty(t, proof.Steps)
+	require.NoError(t, VerifyProof(root, single[:], proof))
+
+	hashes := make([]crypto.Digest, 3000)
+	for i := range hashes {
+		hashes[i] = crypto.Hash([]byte{byte(i % 256), byte(i / 256)})
+	}
+	// elements that differ in their last byte only, so that their leaves hold no key remainder
+	// and their parents are long chains of single child nodes
+	hashes[1][crypto.DigestSize-1] = hashes[0][crypto.DigestSize-1] + 1
+	copy(hashes[1][:crypto.DigestSize-1], hashes[0][:])
+	for _, h := range hashes {
+		_, err = mt.Add(h[:])
+		require.NoError(t, err)
+	}
+	_, err = mt.Delete(single[:])
+	require.NoError(t, err)
+	_, err = mt.Commit()
+	require.NoError(t, err)
+
+	// reload the trie from its pages, as the ledger does
+	mt, err = MakeTrie(&memoryCommitter, defaultTestMemoryConfig)
+	require.NoError(t, err)
+	root, err = mt.RootHash()
+	require.NoError(t, err)
+
+	for i, h := range hashes {
+		proof, found, err := mt.Prove(h[:])
+		require.NoError(t, err)
+		require.True(t, found, "i=%d", i)
+		require.NoError(t, VerifyProof(root, h[:], proof), "i=%d", i)
+
+		decoded, err := DeserializeProof(proof.Serialize())
+		require.NoError(t, err)
+		require.Equal(t, proof, decoded)
+	}
+	proof, _, err = mt.Prove(hashes[0][:])
+	require.NoError(t, err)
+	require.Len(t, proof.Steps, crypto.DigestSize)
+
+	// elements that are not in the trie
+	_, found, err = mt.Prove(single[:])
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = mt.Prove(hashes[0][:10])
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// a proof only proves its own element, against its own root
+	proof, _, err = mt.Prove(hashes[7][:])
+	require.NoError(t, err)
+	require.ErrorIs(t, VerifyProof(root, hashes[8][:], proof), ErrProofVerificationFailure)
+	require.ErrorIs(t, VerifyProof(crypto.Hash([]byte("root")), hashes[7][:], proof), ErrProofVerificationFailure)
+	tampered := proof
+	tampered.Steps = append([][]ProofSibling{}, proof.Steps...)
+	tampered.Steps[0] = append([]ProofSibling{}, proof.Steps[0]...)
+	tampered.Steps[0][0].Hash = append([]byte{}, proof.Steps[0][0].Hash...)
+	tampered.Steps[0][0].Hash[0]++
+	require.ErrorIs(t, VerifyProof(root, hashes[7][:], tampered), ErrProofVerificationFailure)
+	require.NoError(t, VerifyProof(root, hashes[7][:], proof))
+
+	// after an update, old proofs no longer verify against the new root
+	_, err = mt.Delete(hashes[8][:])
+	require.NoError(t, err)
+	newRoot, err := mt.RootHash()
+	require.NoError(t, err)
+	require.ErrorIs(t, VerifyProof(newRoot, hashes[7][:], proof), ErrProofVerificationFailure)
+	proof, found, err = mt.Prove(hashes[7][:])
+	require.NoError(t, err)
+	require.True(t, found)
+	require.NoError(t, VerifyProof(newRoot, hashes[7][:], proof))
+}
+
+func TestDeserializeProofErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, buf := range [][]byte{
+		nil,
+		{0x80},             // truncated uvarint
+		{0xff, 0xff, 0x01}, // more steps than bytes
+		{1, 1, 7, 2, 0},    // invalid leaf flag
+		{1, 1, 7, 0, 5, 1}, // truncated hash
+		{0, 0},             // trailing bytes
+	} {
+		_, err := DeserializeProof(buf)
+		require.ErrorIs(t, err, ErrProofDecodingFailure, "%v", buf)
+	}
+	p, err := DeserializeProof([]byte{0})
+	require.NoError(t, err)
+	require.Empty(t, p.Steps)
+}
@@ -294,6 +294,12 @@ func (client RestClient) post(response interface{}, path string, params interfac
 	return client.submitForm(response, path, params, body, "POST", true /* encodeJSON */, true /* decodeJSON */, expectNoContent)
 }
 
+// put sends a PUT request to the given path with the given request object.
+// when expectNoContent is true, then no content is expected to be returned from the endpoint
+func (client RestClient) put(response interface{}, path string, params interface{}, expectNoContent bool) error {
+	return client.submitForm(response, path, params, nil, "PUT", true /* encodeJSON */, true /* decodeJSON */, expectNoContent)
+}
+
 // Status retrieves the StatusResponse from the running node
 // the StatusResponse includes data like the consensus version and current round
 // Not supported
@@ -358,6 +364,13 @@ func (client RestClient) HealthCheck() error {
 	return client.get(nil, "/health", nil)
 }
 
+// HealthChecks returns the result of each of the node's health checks. A node
+// with a failed check answers with an error
+func (client RestClient) HealthChecks() (response model.HealthChecksResponse, err error) {
+	err = client.get(&response, "/health/checks", nil)
+	return
+}
+
 // ReadyCheck does a readiness check on the potentially running node,
 // returning an error if the node is not ready (caught up and healthy)
 func (client RestClient) ReadyCheck() error {
@@ -424,6 +437,10 @@ type catchupParams struct {
 	Min uint64 `url:"min"`
 }
 
+type agreementTimelineParams struct {
+	Round *uint64 `url:"round,omitempty"`
+}
+
 // PendingTransactionsByAddr returns all the pending transactions for an addr.
 func (client RestClient) PendingTransactionsByAddr(addr string, max uint64) (response model.PendingTransactionsResponse, err error) {
 	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/transactions/pending", addr), pendingTransactionsByAddrParams{max})
@@ -451,6 +468,16 @@ func (client RestClient) ApplicationInformation(index uint64) (response model.Ap
 	return
 }
 
+type roundParams struct {
+	Round uint64 `url:"round"`
+}
+
+// ApplicationInformationAtRound gets the model.Application associated with the passed application index, as it was at the given round
+func (client RestClient) ApplicationInformationAtRound(index uint64, round uint64) (response model.Application, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d", index), roundParams{round})
+	return
+}
+
 type applicationBoxesParams struct {
 	Max uint64 `url:"max,omitempty"`
 }
@@ -461,6 +488,20 @@ func (client RestClient) ApplicationBoxes(appID uint64, maxBoxNum uint64) (respo
 	return
 }
 
+type applicationBoxesPageParams struct {
+	Prefix string `url:"prefix,omitempty"`
+	Next   string `url:"next,omitempty"`
+	Limit  uint64 `url:"limit,omitempty"`
+	Values bool   `url:"values"`
+}
+
+// ApplicationBoxesPage gets a page of the boxes of the passed application ID, in the order of their names. The prefix
+// is in the `encoding:value` form of box names, and an empty next token starts from the first box.
+func (client RestClient) ApplicationBoxesPage(appID uint64, prefix string, next string, limit uint64, values bool) (response model.BoxesResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/boxes", appID), applicationBoxesPageParams{prefix, next, limit, values})
+	return
+}
+
 type applicationBoxByNameParams struct {
 	Name string `url:"name"`
 }
@@ -471,6 +512,23 @@ func (client RestClient) GetApplicationBoxByName(appID uint64, name string) (res
 	return
 }
 
+type applicationBoxByNameAtRoundParams struct {
+	Name  string `url:"name"`
+	Round uint64 `url:"round"`
+}
+
+// GetApplicationBoxByNameAtRound gets the BoxResponse associated with the passed application ID and box name, as it was at the given round
+func (client RestClient) GetApplicationBoxByNameAtRound(appID uint64, name string, round uint64) (response model.BoxResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/box", appID), applicationBoxByNameAtRoundParams{name, round})
+	return
+}
+
+// GetApplicationBoxProof gets a proof of the value of the passed application ID and box name against the catchpoint merkle trie
+func (client RestClient) GetApplicationBoxProof(appID uint64, name string) (response model.LedgerEntryProofResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/box/proof", appID), applicationBoxByNameParams{name})
+	return
+}
+
 // AccountInformation gets the AccountData associated with the passed address
 func (client RestClient) AccountInformation(address string, includeCreatables bool) (response model.Account, err error) {
 	var infoParams accountInformationParams
@@ -483,6 +541,30 @@ func (client RestClient) AccountInformation(address string, includeCreatables bo
 	return
 }
 
+type accountInformationAtRoundParams struct {
+	Format  string `url:"format"`
+	Exclude string `url:"exclude"`
+	Round   uint64 `url:"round"`
+}
+
+// AccountInformationAtRound gets the AccountData, without assets and applications, associated with the passed address at the given round
+func (client RestClient) AccountInformationAtRound(address string, round uint64) (response model.Account, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s", address), accountInformationAtRoundParams{Format: "json", Exclude: "all", Round: round})
+	return
+}
+
+type accountProofParams struct {
+	AssetID       *uint64 `url:"asset-id,omitempty"`
+	ApplicationID *uint64 `url:"application-id,omitempty"`
+}
+
+// AccountProof gets a proof of the account data associated with the passed address, or of one of its asset or
+// application resources, against the catchpoint merkle trie
+func (client RestClient) AccountProof(address string, assetID, applicationID *uint64) (response model.LedgerEntryProofResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/proof", address), accountProofParams{assetID, applicationID})
+	return
+}
+
 // Blob represents arbitrary blob of data satisfying RawResponse interface
 type Blob []byte
 
@@ -529,6 +611,12 @@ func (client RestClient) AccountApplicationInformation(accountAddress string, ap
 	return
 }
 
+// AccountApplicationInformationAtRound gets account information about a given app, as it was at the given round.
+func (client RestClient) AccountApplicationInformationAtRound(accountAddress string, applicationID uint64, round uint64) (response model.AccountApplicationResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/applications/%d", accountAddress, applicationID), roundParams{round})
+	return
+}
+
 // RawAccountApplicationInformation gets account information about a given app.
 func (client RestClient) RawAccountApplicationInformation(accountAddress string, applicationID uint64) (response []byte, err error) {
 	var blob Blob
@@ -565,6 +653,42 @@ func (client RestClient) RawAccountAssetsInformation(accountAddress string, next
 	return
 }
 
+// AccountCreatedAssetsInformation gets the assets created by an account, subject to pagination.
+func (client RestClient) AccountCreatedAssetsInformation(accountAddress string, next *string, limit *uint64) (response model.AccountCreatedAssetsInformationResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/created-assets", accountAddress), pageParams{next, limit})
+	return
+}
+
+// AccountCreatedApplicationsInformation gets the applications created by an account, subject to pagination.
+func (client RestClient) AccountCreatedApplicationsInformation(accountAddress string, next *string, limit *uint64) (response model.AccountCreatedApplicationsInformationResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/created-applications", accountAddress), pageParams{next, limit})
+	return
+}
+
+// AccountApplicationsInformation gets the local states of the applications an account is opted in to, subject to pagination.
+func (client RestClient) AccountApplicationsInformation(accountAddress string, next *string, limit *uint64) (response model.AccountApplicationsInformationResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/applications", accountAddress), pageParams{next, limit})
+	return
+}
+
+// AccountTransactions gets the transactions that touched an account, newest first, subject to pagination.
+func (client RestClient) AccountTransactions(accountAddress string, next *string, limit *uint64) (response model.TransactionActivityResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/accounts/%s/transactions", accountAddress), pageParams{next, limit})
+	return
+}
+
+// ApplicationTransactions gets the transactions that touched an application, newest first, subject to pagination.
+func (client RestClient) ApplicationTransactions(applicationID uint64, next *string, limit *uint64) (response model.TransactionActivityResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/transactions", applicationID), pageParams{next, limit})
+	return
+}
+
+// AssetTransactions gets the transactions that touched an asset, newest first, subject to pagination.
+func (client RestClient) AssetTransactions(assetID uint64, next *string, limit *uint64) (response model.TransactionActivityResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/assets/%d/transactions", assetID), pageParams{next, limit})
+	return
+}
+
 // SuggestedParams gets the suggested transaction parameters
 func (client RestClient) SuggestedParams() (response model.TransactionParametersResponse, err error) {
 	err = client.get(&response, "/v2/transactions/params", nil)
@@ -784,6 +908,12 @@ func (client RestClient) GetParticipationKeys() (response model.ParticipationKey
 	return
 }
 
+// GetAgreementTimeline gets the agreement timelines of the most recent rounds, or of a single round if round is not nil
+func (client RestClient) GetAgreementTimeline(round *uint64) (response model.AgreementTimelineResponse, err error) {
+	err = client.get(&response, "/v2/agreement/timeline", agreementTimelineParams{Round: round})
+	return
+}
+
 // GetParticipationKeyByID gets a single participation key
 func (client RestClient) GetParticipationKeyByID(participationID string) (response model.ParticipationKeyResponse, err error) {
 	err = client.get(&response, fmt.Sprintf("/v2/participation/%s", participationID), nil)
@@ -816,6 +946,30 @@ func (client RestClient) GetSyncRound() (response model.GetSyncRoundResponse, er
 	return
 }
 
+// ListSyncCursors retrieves the named sync cursors
+func (client RestClient) ListSyncCursors() (response model.SyncCursorsResponse, err error) {
+	err = client.get(&response, "/v2/ledger/sync/cursors", nil)
+	return
+}
+
+// RegisterSyncCursor registers a named sync cursor at the given round
+func (client RestClient) RegisterSyncCursor(name string, round uint64) (err error) {
+	err = client.post(nil, fmt.Sprintf("/v2/ledger/sync/cursors/%s/%d", url.PathEscape(name), round), nil, nil, true)
+	return
+}
+
+// AdvanceSyncCursor moves a named sync cursor forward to the given round
+func (client RestClient) AdvanceSyncCursor(name string, round uint64) (err error) {
+	err = client.put(nil, fmt.Sprintf("/v2/ledger/sync/cursors/%s/%d", url.PathEscape(name), round), nil, true)
+	return
+}
+
+// DeleteSyncCursor removes a named sync cursor
+func (client RestClient) DeleteSyncCursor(name string) (err error) {
+	err = client.delete(nil, fmt.Sprintf("/v2/ledger/sync/cursors/%s", url.PathEscape(name)), nil, true)
+	return
+}
+
 // GetLedgerStateDelta retrieves the ledger state delta for the round
 func (client RestClient) GetLedgerStateDelta(round uint64) (response ledgercore.StateDelta, err error) {
 	// Note: this endpoint gets the StateDelta as JSON, meaning some string fields with non-UTF-8 data will lose
@@ -108,6 +108,53 @@ func HealthCheck(ctx lib.ReqContext, context echo.Context) {
 	json.NewEncoder(w).Encode(nil)
 }
 
+// HealthChecks is an httpHandler for route GET /health/checks
+// it reports the result of each of the node's health checks, and fails if any of them failed.
+func HealthChecks(ctx lib.ReqContext, context echo.Context) {
+	// swagger:operation GET /health/checks HealthChecks
+	//---
+	//     Summary: Returns the result of each of the node's health checks.
+	//     Description: Reports the catchup state, lag behind the network, peer counts, free disk space, tracker commit lag and participation key health of the node, each as pass, warn or fail against the thresholds of the node configuration.
+	//     Produces:
+	//     - application/json
+	//     Schemes:
+	//     - http
+	//     Responses:
+	//       200:
+	//         description: No check failed.
+	//         schema: {$ref: '#/definitions/HealthChecks'}
+	//       503:
+	//         description: At least one check failed.
+	//         schema: {$ref: '#/definitions/HealthChecks'}
+	//       default: { description: Unknown Error }
+	w := context.Response().Writer
+	w.Header().Set("Content-Type", "application/json")
+
+	report := ctx.Node.HealthChecks()
+	response := HealthChecksResponse{
+		Body: common.HealthChecks{
+			Status: string(report.Status),
+			Round:  uint64(report.Round),
+			Checks: make([]common.HealthCheck, len(report.Checks)),
+		},
+	}
+	for i, c := range report.Checks {
+		response.Body.Checks[i] = common.HealthCheck{
+			Name:    c.Name,
+			Status:  string(c.Status),
+			Message: c.Message,
+			Details: c.Details,
+		}
+	}
+
+	code := http.StatusOK
+	if report.Status == node.HealthFail {
+		code = http.StatusServiceUnavailable
+	}
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(response.Body)
+}
+
 // Ready is a httpHandler for route GET /ready
 // it serves "readiness" probe on if the node is healthy and fully caught-up.
 func Ready(ctx lib.ReqContext, context echo.Context) {
@@ -47,3 +47,11 @@ type VersionsResponse struct {
 func (r VersionsResponse) GetError() error {
 	return nil
 }
+
+// HealthChecksResponse is the response to 'GET /health/checks'
+//
+// swagger:response HealthChecksResponse
+type HealthChecksResponse struct {
+	// in: body
+	Body common.HealthChecks
+}
@@ -57,6 +57,13 @@ var Routes = lib.Routes{
 		HandlerFunc: HealthCheck,
 	},
 
+	lib.Route{
+		Name:        "healthchecks",
+		Method:      "GET",
+		Path:        "/health/checks",
+		HandlerFunc: HealthChecks,
+	},
+
 	lib.Route{
 		Name:        "ready",
 		Method:      "GET",
@@ -33,6 +33,7 @@
 package test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -43,6 +44,7 @@ import (
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server/common"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
+	spec "github.com/algorand/go-algorand/daemon/algod/api/spec/common"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -102,3 +104,38 @@ func TestReadyEndpoint(t *testing.T) {
 	mockNodeInstance.catchupStatus = StoppedAtUnsupported
 	readyEndpointTestHelper(t, mockNodeInstance, http.StatusInternalServerError)
 }
+
+func TestHealthChecksEndpoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	healthChecks := func(n *mockNode) (int, spec.HealthChecks) {
+		reqCtx := lib.ReqContext{
+			Node:     n,
+			Log:      logging.NewLogger(),
+			Shutdown: make(chan struct{}),
+		}
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/health/checks", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		common.HealthChecks(reqCtx, c)
+		var body spec.HealthChecks
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		return rec.Code, body
+	}
+
+	code, body := healthChecks(makeMockNode(CaughtUpAndReady))
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "pass", body.Status)
+	require.EqualValues(t, 1, body.Round)
+	require.Len(t, body.Checks, 1)
+	require.Equal(t, node.HealthCheckCatchup, body.Checks[0].Name)
+	require.Empty(t, body.Checks[0].Message)
+
+	code, body = healthChecks(makeMockNode(CatchingUpFast))
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, "fail", body.Status)
+	require.Equal(t, "fail", body.Checks[0].Status)
+	require.NotEmpty(t, body.Checks[0].Message)
+}
@@ -150,6 +150,21 @@ func (m *mockNode) Status() (s node.StatusReport, err error) {
 	return
 }
 
+func (m *mockNode) HealthChecks() node.HealthReport {
+	s, err := m.Status()
+	if err != nil {
+		return node.HealthReport{Status: node.HealthFail}
+	}
+	check := node.HealthCheck{Name: node.HealthCheckCatchup, Status: node.HealthPass}
+	switch {
+	case s.StoppedAtUnsupportedRound:
+		check.Status, check.Message = node.HealthFail, "stopped at an unsupported round"
+	case s.Catchpoint != "":
+		check.Status, check.Message = node.HealthFail, "fast catchup in progress"
+	}
+	return node.HealthReport{Status: check.Status, Round: s.LastRound, Checks: []node.HealthCheck{check}}
+}
+
 func (m *mockNode) GenesisID() string { panic("not implemented") }
 
 func (m *mockNode) GenesisHash() crypto.Digest { panic("not implemented") }
@@ -50,6 +50,7 @@ type NodeInterface interface {
 	GenesisHash() crypto.Digest
 	GenesisID() string
 	Status() (s node.StatusReport, err error)
+	HealthChecks() node.HealthReport
 }
 
 // HandlerFunc defines a wrapper for http.HandlerFunc that includes a context
@@ -0,0 +1,332 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package connect serves RPCs over the Connect protocol
+// (https://connectrpc.com/docs/protocol) with echo, for unary and server
+// streaming methods whose messages are encoded with protobuf or JSON.
+package connect
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Code is a Connect error code
+type Code string
+
+// The Connect error codes used by algod
+const (
+	CodeCanceled           Code = "canceled"
+	CodeInvalidArgument    Code = "invalid_argument"
+	CodeDeadlineExceeded   Code = "deadline_exceeded"
+	CodeNotFound           Code = "not_found"
+	CodeFailedPrecondition Code = "failed_precondition"
+	CodeUnimplemented      Code = "unimplemented"
+	CodeInternal           Code = "internal"
+	CodeUnavailable        Code = "unavailable"
+)
+
+// httpStatus returns the HTTP status of unary responses failing with code
+func (c Code) httpStatus() int {
+	switch c {
+	case CodeCanceled:
+		return 499
+	case CodeInvalidArgument, CodeFailedPrecondition:
+		return http.StatusBadRequest
+	case CodeDeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeUnimplemented:
+		return http.StatusNotImplemented
+	case CodeUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Error is an RPC failure reported to the client
+type Error struct {
+	Code    Code   `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Errorf makes an Error with a formatted message
+func Errorf(code Code, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// asError converts err to the Error reported to the client. Errors that
+// aren't an Error are internal, and so are reported without their details.
+func asError(ctx context.Context, err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	switch ctx.Err() {
+	case context.Canceled:
+		return &Error{Code: CodeCanceled}
+	case context.DeadlineExceeded:
+		return &Error{Code: CodeDeadlineExceeded}
+	}
+	return &Error{Code: CodeInternal, Message: "internal error"}
+}
+
+// Content types of unary and streaming requests
+const (
+	contentTypeProto       = "application/proto"
+	contentTypeJSON        = "application/json"
+	contentTypeStreamProto = "application/connect+proto"
+	contentTypeStreamJSON  = "application/connect+json"
+)
+
+// MaxMessageBytes is the largest request message accepted
+const MaxMessageBytes = 10 * 1024 * 1024
+
+// StreamMessageWriteTimeout is how long writing a single message of a
+// streaming response may take. Streams are meant to outlive the write timeout
+// of the server, which is replaced by this deadline for every message.
+const StreamMessageWriteTimeout = time.Minute
+
+// Flags of the envelopes wrapping streamed messages
+const (
+	flagCompressed = 0x01
+	flagEndStream  = 0x02
+)
+
+type codec struct {
+	marshal   func(proto.Message) ([]byte, error)
+	unmarshal func([]byte, proto.Message) error
+}
+
+var protoCodec = codec{
+	marshal:   proto.Marshal,
+	unmarshal: proto.Unmarshal,
+}
+
+var jsonCodec = codec{
+	marshal:   protojson.Marshal,
+	unmarshal: protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal,
+}
+
+// requestCodec returns the codec for the request's content type, among those
+// of unary or streaming requests
+func requestCodec(r *http.Request, stream bool) (codec, string, bool) {
+	contentType, _, err := mime.ParseMediaType(r.Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return codec{}, "", false
+	}
+	switch {
+	case !stream && contentType == contentTypeProto, stream && contentType == contentTypeStreamProto:
+		return protoCodec, contentType, true
+	case !stream && contentType == contentTypeJSON, stream && contentType == contentTypeStreamJSON:
+		return jsonCodec, contentType, true
+	}
+	return codec{}, "", false
+}
+
+// requestContext applies the timeout the client may have asked for
+func requestContext(r *http.Request) (context.Context, context.CancelFunc, error) {
+	timeout := r.Header.Get("Connect-Timeout-Ms")
+	if timeout == "" {
+		ctx, cancel := context.WithCancel(r.Context())
+		return ctx, cancel, nil
+	}
+	ms, err := strconv.ParseUint(timeout, 10, 63)
+	if err != nil || len(timeout) > 10 {
+		return nil, nil, Errorf(CodeInvalidArgument, "invalid Connect-Timeout-Ms %q", timeout)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), time.Duration(ms)*time.Millisecond)
+	return ctx, cancel, nil
+}
+
+// newMessage allocates a message of type M, which must be a pointer to a
+// generated message struct
+func newMessage[M proto.Message]() M {
+	var m M
+	return m.ProtoReflect().Type().New().Interface().(M)
+}
+
+// Unary makes an echo handler serving the unary RPC implemented by handle
+func Unary[Req, Resp proto.Message](handle func(context.Context, Req) (Resp, error)) echo.HandlerFunc {
+	return func(ectx echo.Context) error {
+		r := ectx.Request()
+		c, contentType, ok := requestCodec(r, false)
+		if !ok {
+			return ectx.NoContent(http.StatusUnsupportedMediaType)
+		}
+		ctx, cancel, err := requestContext(r)
+		if err != nil {
+			return writeUnaryError(ectx, asError(r.Context(), err))
+		}
+		defer cancel()
+		if encoding := r.Header.Get(echo.HeaderContentEncoding); encoding != "" && encoding != "identity" {
+			return writeUnaryError(ectx, Errorf(CodeUnimplemented, "unsupported content encoding %q", encoding))
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, MaxMessageBytes+1))
+		if err != nil {
+			return writeUnaryError(ectx, Errorf(CodeInvalidArgument, "reading request: %v", err))
+		}
+		if len(body) > MaxMessageBytes {
+			return writeUnaryError(ectx, Errorf(CodeInvalidArgument, "request larger than %d bytes", MaxMessageBytes))
+		}
+		req := newMessage[Req]()
+		err = c.unmarshal(body, req)
+		if err != nil {
+			return writeUnaryError(ectx, Errorf(CodeInvalidArgument, "decoding request: %v", err))
+		}
+
+		resp, err := handle(ctx, req)
+		if err != nil {
+			return writeUnaryError(ectx, asError(ctx, err))
+		}
+		data, err := c.marshal(resp)
+		if err != nil {
+			return writeUnaryError(ectx, asError(ctx, err))
+		}
+		return ectx.Blob(http.StatusOK, contentType, data)
+	}
+}
+
+func writeUnaryError(ectx echo.Context, e *Error) error {
+	return ectx.JSON(e.Code.httpStatus(), e)
+}
+
+// ServerStream makes an echo handler serving the server streaming RPC
+// implemented by handle, which calls send for every message of the response
+func ServerStream[Req, Resp proto.Message](handle func(ctx context.Context, req Req, send func(Resp) error) error) echo.HandlerFunc {
+	return func(ectx echo.Context) error {
+		r := ectx.Request()
+		c, contentType, ok := requestCodec(r, true)
+		if !ok {
+			return ectx.NoContent(http.StatusUnsupportedMediaType)
+		}
+
+		// From here on, errors are reported at the end of the stream
+		w := ectx.Response()
+		w.Header().Set(echo.HeaderContentType, contentType)
+		w.WriteHeader(http.StatusOK)
+		rc := http.NewResponseController(w.Writer)
+		write := func(flags byte, data []byte) error {
+			// not every ResponseWriter supports deadlines; those that don't have no write timeout to replace
+			_ = rc.SetWriteDeadline(time.Now().Add(StreamMessageWriteTimeout))
+			return writeEnvelope(w, flags, data)
+		}
+		end := func(err error) error {
+			var endStream struct {
+				Error *Error `json:"error,omitempty"`
+			}
+			if err != nil {
+				endStream.Error = asError(r.Context(), err)
+			}
+			data, err := json.Marshal(endStream)
+			if err != nil {
+				return err
+			}
+			return write(flagEndStream, data)
+		}
+
+		ctx, cancel, err := requestContext(r)
+		if err != nil {
+			return end(err)
+		}
+		defer cancel()
+		if encoding := r.Header.Get("Connect-Content-Encoding"); encoding != "" && encoding != "identity" {
+			return end(Errorf(CodeUnimplemented, "unsupported content encoding %q", encoding))
+		}
+
+		flags, body, err := readEnvelope(r.Body)
+		if err != nil {
+			return end(err)
+		}
+		if flags&flagCompressed != 0 {
+			return end(Errorf(CodeInvalidArgument, "compressed message without content encoding"))
+		}
+		req := newMessage[Req]()
+		err = c.unmarshal(body, req)
+		if err != nil {
+			return end(Errorf(CodeInvalidArgument, "decoding request: %v", err))
+		}
+
+		err = handle(ctx, req, func(resp Resp) error {
+			data, err := c.marshal(resp)
+			if err != nil {
+				return err
+			}
+			return write(0, data)
+		})
+		if err != nil {
+			return end(asError(ctx, err))
+		}
+		return end(nil)
+	}
+}
+
+// readEnvelope reads an enveloped message of a streaming request
+func readEnvelope(r io.Reader) (flags byte, data []byte, err error) {
+	var prefix [5]byte
+	_, err = io.ReadFull(r, prefix[:])
+	if err != nil {
+		return 0, nil, Errorf(CodeInvalidArgument, "reading request: %v", err)
+	}
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if size > MaxMessageBytes {
+		return 0, nil, Errorf(CodeInvalidArgument, "request larger than %d bytes", MaxMessageBytes)
+	}
+	data = make([]byte, size)
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return 0, nil, Errorf(CodeInvalidArgument, "reading request: %v", err)
+	}
+	return prefix[0], data, nil
+}
+
+// writeEnvelope writes an enveloped message of a streaming response, and
+// flushes it to the client
+func writeEnvelope(w *echo.Response, flags byte, data []byte) error {
+	var prefix [5]byte
+	prefix[0] = flags
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(data)))
+	_, err := w.Write(prefix[:])
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	if err != nil {
+		return err
+	}
+	w.Flush()
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package connect
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func echoHandler(ctx context.Context, req *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	switch req.Value {
+	case "missing":
+		return nil, Errorf(CodeNotFound, "no %s", req.Value)
+	case "broken":
+		return nil, errors.New("disk on fire")
+	}
+	return wrapperspb.String("echo " + req.Value), nil
+}
+
+func serve(handler echo.HandlerFunc, contentType string, body []byte, header ...string) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.POST("/test.Service/Method", handler)
+	req := httptest.NewRequest(http.MethodPost, "/test.Service/Method", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, contentType)
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestUnary(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler := Unary(echoHandler)
+
+	body, err := proto.Marshal(wrapperspb.String("hi"))
+	require.NoError(t, err)
+	rec := serve(handler, "application/proto", body)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/proto", rec.Header().Get(echo.HeaderContentType))
+	var resp wrapperspb.StringValue
+	require.NoError(t, proto.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "echo hi", resp.Value)
+
+	rec = serve(handler, "application/json; charset=utf-8", []byte(`"hi"`))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `"echo hi"`, rec.Body.String())
+
+	// Errors are reported with their code, and internal ones without details
+	rec = serve(handler, "application/json", []byte(`"missing"`))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.JSONEq(t, `{"code":"not_found","message":"no missing"}`, rec.Body.String())
+	rec = serve(handler, "application/json", []byte(`"broken"`))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.JSONEq(t, `{"code":"internal","message":"internal error"}`, rec.Body.String())
+
+	rec = serve(handler, "application/json", []byte(`{`))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(handler, "application/json", []byte(`"hi"`), "Connect-Timeout-Ms", "soon")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(handler, "application/json", []byte(`"hi"`), echo.HeaderContentEncoding, "gzip")
+	require.Equal(t, http.StatusNotImplemented, rec.Code)
+	rec = serve(handler, "application/connect+proto", body)
+	require.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+}
+
+func TestServerStream(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler := ServerStream(func(ctx context.Context, req *wrapperspb.UInt64Value, send func(*wrapperspb.UInt64Value) error) error {
+		for i := uint64(0); i < req.Value; i++ {
+			if err := send(wrapperspb.UInt64(i)); err != nil {
+				return err
+			}
+		}
+		if req.Value > 2 {
+			return Errorf(CodeInvalidArgument, "too many")
+		}
+		return nil
+	})
+
+	stream := func(contentType string, req proto.Message) [][]byte {
+		data, err := proto.Marshal(req)
+		require.NoError(t, err)
+		body := append(binary.BigEndian.AppendUint32([]byte{0}, uint32(len(data))), data...)
+		rec := serve(handler, contentType, body)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var envelopes [][]byte
+		out := rec.Body.Bytes()
+		for len(out) > 0 {
+			require.GreaterOrEqual(t, len(out), 5)
+			size := binary.BigEndian.Uint32(out[1:5])
+			envelopes = append(envelopes, out[:5+size])
+			out = out[5+size:]
+		}
+		return envelopes
+	}
+
+	envelopes := stream("application/connect+proto", wrapperspb.UInt64(2))
+	require.Len(t, envelopes, 3)
+	for i, envelope := range envelopes[:2] {
+		require.Zero(t, envelope[0])
+		var msg wrapperspb.UInt64Value
+		require.NoError(t, proto.Unmarshal(envelope[5:], &msg))
+		require.Equal(t, uint64(i), msg.Value)
+	}
+	require.Equal(t, byte(flagEndStream), envelopes[2][0])
+	require.JSONEq(t, `{}`, string(envelopes[2][5:]))
+
+	envelopes = stream("application/connect+proto", wrapperspb.UInt64(3))
+	require.Len(t, envelopes, 4)
+	require.Equal(t, byte(flagEndStream), envelopes[3][0])
+	require.JSONEq(t, `{"error":{"code":"invalid_argument","message":"too many"}}`, string(envelopes[3][5:]))
+
+	rec := serve(handler, "application/proto", nil)
+	require.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+}
+
+func TestServerStreamOutlivesWriteTimeout(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const writeTimeout = 200 * time.Millisecond
+	e := echo.New()
+	e.POST("/test.Service/Method", ServerStream(func(ctx context.Context, req *wrapperspb.UInt64Value, send func(*wrapperspb.UInt64Value) error) error {
+		for i := uint64(0); i < req.Value; i++ {
+			time.Sleep(writeTimeout / 2)
+			if err := send(wrapperspb.UInt64(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	srv := httptest.NewUnstartedServer(e)
+	srv.Config.WriteTimeout = writeTimeout
+	srv.Start()
+	defer srv.Close()
+
+	data, err := proto.Marshal(wrapperspb.UInt64(6))
+	require.NoError(t, err)
+	body := append(binary.BigEndian.AppendUint32([]byte{0}, uint32(len(data))), data...)
+	resp, err := http.Post(srv.URL+"/test.Service/Method", "application/connect+proto", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	out, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var flags []byte
+	for len(out) > 0 {
+		require.GreaterOrEqual(t, len(out), 5)
+		size := binary.BigEndian.Uint32(out[1:5])
+		flags = append(flags, out[0])
+		out = out[5+size:]
+	}
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, flagEndStream}, flags)
+}
@@ -58,10 +58,23 @@ type AuthMiddleware struct {
 
 	// Tokens is the set of tokens which can be set to allow access.
 	tokens [][]byte
+
+	// scoped holds the named tokens which allow access to some endpoints only.
+	scoped *ScopedTokens
+
+	// admin is set if the middleware guards admin endpoints.
+	admin bool
 }
 
 // MakeAuth constructs the auth middleware function
 func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
+	return MakeScopedAuth(header, tokens, nil, false)
+}
+
+// MakeScopedAuth constructs an auth middleware function which, besides the
+// passed tokens, accepts scoped tokens whose scopes cover the endpoint. admin
+// is set for admin endpoints, which default to needing ScopeAdmin.
+func MakeScopedAuth(header string, tokens []string, scoped *ScopedTokens, admin bool) echo.MiddlewareFunc {
 	apiTokenBytes := make([][]byte, 0)
 	for _, token := range tokens {
 		apiTokenBytes = append(apiTokenBytes, []byte(token))
@@ -70,6 +83,8 @@ func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
 	auth := AuthMiddleware{
 		header: header,
 		tokens: apiTokenBytes,
+		scoped: scoped,
+		admin:  admin,
 	}
 
 	return auth.handler
@@ -94,6 +109,9 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			}
 		}
 
+		// Determine the scope of the endpoint before the path is rewritten
+		scope := endpointScope(ctx.Request().Method, ctx.Path(), auth.admin)
+
 		// Handle debug routes with /urlAuth/:token prefix.
 		if ctx.Param(TokenPathParam) != "" {
 			// For debug routes, we place the apiToken in the path itself
@@ -117,6 +135,18 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			}
 		}
 
+		// Check the scoped tokens, which may also be rejected for this endpoint
+		if auth.scoped != nil {
+			name, err := auth.scoped.authorize(providedToken, scope)
+			if name != "" {
+				ctx.Set(TokenNameKey, name)
+			}
+			if err != nil {
+				return err
+			}
+			return next(ctx)
+		}
+
 		return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
 	}
 }
@@ -69,10 +69,16 @@ func (logger *LoggerMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc
 			ctx.Error(err)
 		}
 
+		// Name the scoped token that made the request, if any, as the user
+		user := "-"
+		if name, ok := ctx.Get(TokenNameKey).(string); ok {
+			user = name
+		}
+
 		logger.log.Infof("%s %s %s [%v] \"%s %s %s\" %d %s \"%s\" %s",
 			req.RemoteAddr,
 			"-",
-			"-",
+			user,
 			start,
 			req.Method,
 			req.RequestURI,
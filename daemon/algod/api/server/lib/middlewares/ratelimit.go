@@ -0,0 +1,255 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// RateLimitedMessage is returned to clients that exceeded their request cost budget
+const RateLimitedMessage = "request rate limit exceeded"
+
+const (
+	// responseCostBytes is the response size charged as one more unit of cost,
+	// so that requests for large accounts, blocks or deltas cost more
+	responseCostBytes = 64 * 1024
+	// rateLimitPrunePeriod is how often clients whose budget has been fully
+	// replenished are forgotten
+	rateLimitPrunePeriod = time.Minute
+)
+
+// endpointCosts maps endpoints, as registered with echo, to the cost charged
+// for calling them. Endpoints that aren't listed cost 1.
+var endpointCosts = map[string]float64{
+	"POST /v2/transactions/simulate":                    20,
+	"POST /v2/teal/dryrun":                              20,
+	"POST /v2/teal/compile":                             5,
+	"POST /v2/teal/disassemble":                         2,
+	"GET /v2/accounts/:address":                         5,
+	"GET /v2/accounts/:address/assets":                  5,
+	"GET /v2/accounts/:address/created-assets":          5,
+	"GET /v2/accounts/:address/applications":            5,
+	"GET /v2/accounts/:address/created-applications":    5,
+	"GET /v2/accounts/:address/proof":                   5,
+	"GET /v2/applications/:application-id/boxes":        5,
+	"GET /v2/applications/:application-id/box/proof":    5,
+	"GET /v2/blocks/:round":                             5,
+	"GET /v2/blocks/:round/logs":                        10,
+	"GET /v2/blocks/:round/transactions/:txid/proof":    5,
+	"GET /v2/blocks/:round/lightheader/proof":           5,
+	"GET /v2/deltas/:round":                             10,
+	"GET /v2/deltas/:round/txn/group":                   10,
+	"GET /v2/deltas/txn/group/:id":                      5,
+	"GET /v2/stateproofs/:round":                        10,
+	"GET /v2/transactions/pending":                      5,
+	"GET /v2/accounts/:address/transactions/pending":    2,
+	"GET /v2/accounts/:address/transactions":            10,
+	"GET /v2/applications/:application-id/transactions": 10,
+	"GET /v2/assets/:asset-id/transactions":             10,
+
+	"POST /algod.v1.AlgodService/GetAccount":           5,
+	"POST /algod.v1.AlgodService/GetBlock":             5,
+	"POST /algod.v1.AlgodService/GetStateDelta":        10,
+	"POST /algod.v1.AlgodService/SimulateTransactions": 20,
+	"POST /algod.v1.AlgodService/StreamBlocks":         5,
+}
+
+var restRateLimitedRequests = metrics.MakeCounter(metrics.MetricName{Name: "algod_rest_rate_limited_requests_total", Description: "Number of REST API requests rejected for exceeding their client's cost budget"})
+var restRequestCost = metrics.MakeCounter(metrics.MetricName{Name: "algod_rest_request_cost_total", Description: "Cost charged to REST API clients, by endpoint"})
+var restRateLimitClients = metrics.MakeGauge(metrics.MetricName{Name: "algod_rest_rate_limit_clients", Description: "Number of REST API clients tracked by the rate limiter"})
+
+// endpointCost returns the cost charged up front for a call to the endpoint
+// registered at path
+func endpointCost(method string, path string) float64 {
+	if cost, ok := endpointCosts[method+" "+path]; ok {
+		return cost
+	}
+	return 1
+}
+
+// tokenBucket replenishes rate tokens per second, holding up to burst tokens
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func makeTokenBucket(rate float64, burst float64, now time.Time) *tokenBucket {
+	if burst == 0 {
+		burst = math.Max(1, math.Ceil(rate))
+	}
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: now}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// take removes cost tokens from the bucket if it holds enough of them
+func (b *tokenBucket) take(now time.Time, cost float64) bool {
+	b.refill(now)
+	if b.tokens < cost {
+		return false
+	}
+	b.tokens -= cost
+	return true
+}
+
+// charge removes cost tokens from the bucket even if it doesn't hold enough,
+// delaying the next requests. The debt is bounded by the burst.
+func (b *tokenBucket) charge(cost float64) {
+	b.tokens = math.Max(-b.burst, b.tokens-cost)
+}
+
+// wait returns how long it takes until the bucket holds cost tokens
+func (b *tokenBucket) wait(cost float64) time.Duration {
+	if b.tokens >= cost {
+		return 0
+	}
+	return time.Duration((cost - b.tokens) / b.rate * float64(time.Second))
+}
+
+// full reports whether the bucket holds as many tokens as it can
+func (b *tokenBucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= b.burst
+}
+
+type rateLimiter struct {
+	rate  float64
+	burst float64
+	clock func() time.Time
+
+	mu        deadlock.Mutex
+	clients   map[string]*tokenBucket
+	lastPrune time.Time
+}
+
+// MakeRateLimiter makes an echo middleware that lets every client spend up
+// to costPerSecond on requests, with bursts of up to burstCost. Endpoints
+// cost more the more work they take, and responses cost more the larger they
+// are. Clients are told by the scoped token that authorized the request, or
+// by their IP address. Requests exceeding the budget are returned the 429 Too
+// Many Requests http error with a Retry-After header.
+func MakeRateLimiter(costPerSecond uint64, burstCost uint64) echo.MiddlewareFunc {
+	return makeRateLimiter(costPerSecond, burstCost, time.Now).handler
+}
+
+func makeRateLimiter(costPerSecond uint64, burstCost uint64, clock func() time.Time) *rateLimiter {
+	burst := float64(burstCost)
+	if burst == 0 {
+		burst = float64(costPerSecond)
+	}
+	// Let a rested client call every endpoint
+	for _, cost := range endpointCosts {
+		burst = math.Max(burst, cost)
+	}
+	return &rateLimiter{
+		rate:      float64(costPerSecond),
+		burst:     burst,
+		clock:     clock,
+		clients:   make(map[string]*tokenBucket),
+		lastPrune: clock(),
+	}
+}
+
+// rateLimitClient identifies the client making the request
+func rateLimitClient(ctx echo.Context) string {
+	if name, ok := ctx.Get(TokenNameKey).(string); ok && name != "" {
+		return "token:" + name
+	}
+	// RemoteAddr rather than the X-Forwarded-For header, which clients control
+	host, _, err := net.SplitHostPort(ctx.Request().RemoteAddr)
+	if err != nil {
+		host = ctx.Request().RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// bucket returns the client's bucket, forgetting idle clients from time to
+// time. rl.mu must be held.
+func (rl *rateLimiter) bucket(client string, now time.Time) *tokenBucket {
+	if now.Sub(rl.lastPrune) >= rateLimitPrunePeriod {
+		for c, b := range rl.clients {
+			if b.full(now) {
+				delete(rl.clients, c)
+			}
+		}
+		rl.lastPrune = now
+		restRateLimitClients.Set(uint64(len(rl.clients)))
+	}
+	b, ok := rl.clients[client]
+	if !ok {
+		b = makeTokenBucket(rl.rate, rl.burst, now)
+		rl.clients[client] = b
+		restRateLimitClients.Set(uint64(len(rl.clients)))
+	}
+	return b
+}
+
+func (rl *rateLimiter) handler(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		// OPTIONS requests are never limited
+		if ctx.Request().Method == http.MethodOptions {
+			return next(ctx)
+		}
+
+		endpoint := map[string]string{"endpoint": ctx.Request().Method + " " + ctx.Path()}
+		cost := endpointCost(ctx.Request().Method, ctx.Path())
+		client := rateLimitClient(ctx)
+
+		now := rl.clock()
+		rl.mu.Lock()
+		b := rl.bucket(client, now)
+		if !b.take(now, cost) {
+			wait := b.wait(cost)
+			rl.mu.Unlock()
+			restRateLimitedRequests.Inc(endpoint)
+			retryAfter := int(math.Ceil(wait.Seconds()))
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+			ctx.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			return echo.NewHTTPError(http.StatusTooManyRequests, RateLimitedMessage)
+		}
+		rl.mu.Unlock()
+
+		err := next(ctx)
+
+		if extra := float64(ctx.Response().Size / responseCostBytes); extra > 0 {
+			rl.mu.Lock()
+			b.charge(extra)
+			rl.mu.Unlock()
+			cost += extra
+		}
+		restRequestCost.AddUint64(uint64(cost), endpoint)
+		return err
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTokenBucket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	now := time.Now()
+	b := makeTokenBucket(2, 0, now)
+	require.True(t, b.take(now, 1))
+	require.True(t, b.take(now, 1))
+	require.False(t, b.take(now, 1))
+	require.False(t, b.take(now.Add(100*time.Millisecond), 1))
+	require.True(t, b.take(now.Add(600*time.Millisecond), 1))
+	require.False(t, b.take(now.Add(600*time.Millisecond), 1))
+	// Idle time doesn't accumulate past the burst
+	later := now.Add(time.Hour)
+	require.True(t, b.take(later, 1))
+	require.True(t, b.take(later, 1))
+	require.False(t, b.take(later, 1))
+}
+
+func TestTokenBucketCost(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Costly events need as many tokens, and debts delay the next events
+	now := time.Now()
+	b := makeTokenBucket(10, 20, now)
+	require.False(t, b.take(now, 21))
+	require.True(t, b.take(now, 15))
+	require.False(t, b.take(now, 10))
+	require.Equal(t, 500*time.Millisecond, b.wait(10))
+	b.charge(100)
+	require.Equal(t, -20.0, b.tokens)
+	require.Equal(t, 3*time.Second, b.wait(10))
+	require.False(t, b.full(now.Add(3*time.Second)))
+	require.True(t, b.full(now.Add(4*time.Second)))
+}
+
+func TestEndpointCost(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, 1.0, endpointCost("GET", "/v2/status"))
+	require.Equal(t, 20.0, endpointCost("POST", "/v2/transactions/simulate"))
+	require.Equal(t, 5.0, endpointCost("GET", "/v2/accounts/:address"))
+	require.Equal(t, 1.0, endpointCost("GET", "/v2/transactions/simulate"))
+}
+
+func TestRateLimiter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	now := time.Now()
+	rl := makeRateLimiter(10, 25, func() time.Time { return now })
+	require.Equal(t, 25.0, rl.burst)
+
+	responseSize := 0
+	handler := rl.handler(func(ctx echo.Context) error {
+		return ctx.String(http.StatusOK, strings.Repeat("a", responseSize))
+	})
+	call := func(method, path, remoteAddr, tokenName string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		ctx.SetPath(path)
+		if tokenName != "" {
+			ctx.Set(TokenNameKey, tokenName)
+		}
+		return rec, handler(ctx)
+	}
+	requireLimited := func(err error, retryAfter string, rec *httptest.ResponseRecorder) {
+		t.Helper()
+		require.Error(t, err)
+		require.Equal(t, http.StatusTooManyRequests, err.(*echo.HTTPError).Code)
+		require.Equal(t, retryAfter, rec.Header().Get("Retry-After"))
+	}
+
+	// A simulation costs 20, leaving 5 for cheaper requests
+	_, err := call("POST", "/v2/transactions/simulate", "10.0.0.1:4160", "")
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		_, err = call("GET", "/v2/status", "10.0.0.1:4161", "")
+		require.NoError(t, err)
+	}
+	rec, err := call("GET", "/v2/status", "10.0.0.1:4160", "")
+	requireLimited(err, "1", rec)
+	rec, err = call("POST", "/v2/transactions/simulate", "10.0.0.1:4160", "")
+	requireLimited(err, "2", rec)
+
+	// Other addresses and tokens have their own budget, even from the same address
+	_, err = call("POST", "/v2/transactions/simulate", "10.0.0.2:4160", "")
+	require.NoError(t, err)
+	_, err = call("POST", "/v2/transactions/simulate", "10.0.0.1:4160", "indexer")
+	require.NoError(t, err)
+	rec, err = call("POST", "/v2/transactions/simulate", "10.0.0.3:4160", "indexer")
+	requireLimited(err, "2", rec)
+
+	// The budget is replenished over time
+	now = now.Add(2 * time.Second)
+	_, err = call("POST", "/v2/transactions/simulate", "10.0.0.1:4160", "")
+	require.NoError(t, err)
+
+	// Large responses are charged after the fact
+	now = now.Add(time.Hour)
+	responseSize = 30 * responseCostBytes
+	_, err = call("GET", "/v2/accounts/:address", "10.0.0.1:4160", "")
+	require.NoError(t, err)
+	rec, err = call("GET", "/v2/status", "10.0.0.1:4160", "")
+	requireLimited(err, "2", rec)
+	responseSize = 0
+
+	// OPTIONS requests are free
+	_, err = call("OPTIONS", "/v2/status", "10.0.0.1:4160", "")
+	require.NoError(t, err)
+
+	// Clients whose budget was replenished are forgotten, and the others kept
+	rl.mu.Lock()
+	require.Len(t, rl.clients, 1)
+	require.Contains(t, rl.clients, "ip:10.0.0.1")
+	rl.mu.Unlock()
+	now = now.Add(rateLimitPrunePeriod)
+	_, err = call("GET", "/v2/status", "10.0.0.4:4160", "")
+	require.NoError(t, err)
+	rl.mu.Lock()
+	require.Len(t, rl.clients, 1)
+	require.Contains(t, rl.clients, "ip:10.0.0.4")
+	rl.mu.Unlock()
+}
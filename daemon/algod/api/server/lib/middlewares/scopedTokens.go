@@ -0,0 +1,296 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+// Scope is a class of API endpoints that a scoped token may be allowed to call
+type Scope string
+
+const (
+	// ScopeRead allows read-only ledger and node queries
+	ScopeRead Scope = "read"
+	// ScopeSubmit allows submitting transactions
+	ScopeSubmit Scope = "submit"
+	// ScopeSimulate allows simulating transactions and compiling, disassembling
+	// and dry-running programs
+	ScopeSimulate Scope = "simulate"
+	// ScopeParticipation allows managing participation keys
+	ScopeParticipation Scope = "participation"
+	// ScopeDebug allows profiling and debug settings
+	ScopeDebug Scope = "debug"
+	// ScopeAdmin allows every endpoint, like the admin token
+	ScopeAdmin Scope = "admin"
+)
+
+var knownScopes = []Scope{ScopeRead, ScopeSubmit, ScopeSimulate, ScopeParticipation, ScopeDebug, ScopeAdmin}
+
+// Messages for requests with a scoped token that may not proceed
+const (
+	ExpiredTokenMessage      = "API token expired"
+	ForbiddenTokenMessage    = "API token not authorized for this endpoint"
+	TokenRateLimitedMessage  = "API token rate limit exceeded"
+	scopedTokensReloadPeriod = time.Second
+)
+
+// TokenNameKey is the echo context key holding the name of the scoped token
+// that authorized the request
+const TokenNameKey = "algod-token-name"
+
+// endpointScopes maps endpoints, as registered with echo, to the scope they
+// require. Endpoints that aren't listed require ScopeRead if they are GET
+// endpoints of the public API, and ScopeAdmin otherwise.
+var endpointScopes = map[string]Scope{
+	"POST /v2/transactions":          ScopeSubmit,
+	"POST /v2/transactions/async":    ScopeSubmit,
+	"POST /v2/transactions/simulate": ScopeSimulate,
+	"POST /v2/teal/compile":          ScopeSimulate,
+	"POST /v2/teal/disassemble":      ScopeSimulate,
+	"POST /v2/teal/dryrun":           ScopeSimulate,
+
+	"POST /algod.v1.AlgodService/GetStatus":            ScopeRead,
+	"POST /algod.v1.AlgodService/GetAccount":           ScopeRead,
+	"POST /algod.v1.AlgodService/GetAsset":             ScopeRead,
+	"POST /algod.v1.AlgodService/GetApplication":       ScopeRead,
+	"POST /algod.v1.AlgodService/GetBlock":             ScopeRead,
+	"POST /algod.v1.AlgodService/GetStateDelta":        ScopeRead,
+	"POST /algod.v1.AlgodService/StreamBlocks":         ScopeRead,
+	"POST /algod.v1.AlgodService/SubmitTransactions":   ScopeSubmit,
+	"POST /algod.v1.AlgodService/SimulateTransactions": ScopeSimulate,
+}
+
+// endpointScopePrefixes maps groups of endpoints to the scope they require,
+// whatever their method
+var endpointScopePrefixes = []struct {
+	prefix string
+	scope  Scope
+}{
+	{"/v2/participation", ScopeParticipation},
+	{"/debug/", ScopeDebug},
+	{URLAuthPrefix + "/debug/", ScopeDebug},
+	{"/v2/agreement/", ScopeDebug},
+}
+
+// endpointScope returns the scope needed to call the endpoint registered at
+// path. Endpoints behind the admin token default to ScopeAdmin.
+func endpointScope(method string, path string, admin bool) Scope {
+	if scope, ok := endpointScopes[method+" "+path]; ok {
+		return scope
+	}
+	for _, p := range endpointScopePrefixes {
+		if strings.HasPrefix(path, p.prefix) {
+			return p.scope
+		}
+	}
+	if !admin && (method == http.MethodGet || method == http.MethodHead) {
+		return ScopeRead
+	}
+	return ScopeAdmin
+}
+
+// ScopedToken is a named API token restricted to some scopes, as configured
+// in the scoped tokens file
+type ScopedToken struct {
+	Name   string  `json:"name"`
+	Token  string  `json:"token"`
+	Scopes []Scope `json:"scopes"`
+	// RateLimit is the sustained number of requests per second the token
+	// may make, and Burst how many it may make at once. Zero means no limit.
+	RateLimit float64 `json:"rate_limit,omitempty"`
+	Burst     int     `json:"burst,omitempty"`
+	// Expires is when the token stops being accepted, if set
+	Expires *time.Time `json:"expires,omitempty"`
+}
+
+// ScopedTokensFile is the format of the scoped tokens file
+type ScopedTokensFile struct {
+	Tokens []ScopedToken `json:"tokens"`
+}
+
+// Validate checks the token definitions
+func (f ScopedTokensFile) Validate() error {
+	names := make(map[string]bool, len(f.Tokens))
+	for i, t := range f.Tokens {
+		if t.Name == "" {
+			return fmt.Errorf("token %d has no name", i)
+		}
+		if names[t.Name] {
+			return fmt.Errorf("token %s is defined twice", t.Name)
+		}
+		names[t.Name] = true
+		err := tokens.ValidateAPIToken(t.Token)
+		if err != nil {
+			return fmt.Errorf("token %s: %w", t.Name, err)
+		}
+		if len(t.Scopes) == 0 {
+			return fmt.Errorf("token %s has no scopes", t.Name)
+		}
+		for _, scope := range t.Scopes {
+			if !slices.Contains(knownScopes, scope) {
+				return fmt.Errorf("token %s has unknown scope %s", t.Name, scope)
+			}
+		}
+		if t.RateLimit < 0 || t.Burst < 0 {
+			return fmt.Errorf("token %s has a negative rate limit", t.Name)
+		}
+	}
+	return nil
+}
+
+type scopedToken struct {
+	ScopedToken
+	limiter *tokenBucket
+}
+
+func (t *scopedToken) allows(scope Scope) bool {
+	return slices.Contains(t.Scopes, scope) || slices.Contains(t.Scopes, ScopeAdmin)
+}
+
+// ScopedTokens holds the scoped API tokens defined in a file. The file is
+// reloaded when it changes, so tokens can be added and revoked while algod
+// is running.
+type ScopedTokens struct {
+	path string
+	log  logging.Logger
+
+	mu        deadlock.Mutex
+	lastCheck time.Time
+	modTime   time.Time
+	size      int64
+	tokens    []*scopedToken
+}
+
+// MakeScopedTokens loads the scoped tokens defined in the file at path. The
+// file need not exist.
+func MakeScopedTokens(path string, log logging.Logger) *ScopedTokens {
+	st := &ScopedTokens{path: path, log: log}
+	st.mu.Lock()
+	st.reloadLocked(time.Now())
+	st.mu.Unlock()
+	return st
+}
+
+// reloadLocked reloads the file if it changed since it was last loaded. An
+// invalid file is ignored, keeping the previous tokens.
+func (st *ScopedTokens) reloadLocked(now time.Time) {
+	st.lastCheck = now
+
+	info, err := os.Stat(st.path)
+	if errors.Is(err, os.ErrNotExist) {
+		if len(st.tokens) > 0 {
+			st.log.Infof("scoped API tokens file %s was removed, revoking all scoped tokens", st.path)
+		}
+		st.tokens = nil
+		st.modTime = time.Time{}
+		st.size = 0
+		return
+	}
+	if err != nil {
+		st.log.Warnf("unable to check scoped API tokens file %s: %v", st.path, err)
+		return
+	}
+	if info.ModTime().Equal(st.modTime) && info.Size() == st.size {
+		return
+	}
+
+	data, err := os.ReadFile(st.path)
+	if err != nil {
+		st.log.Warnf("unable to read scoped API tokens file %s: %v", st.path, err)
+		return
+	}
+	var file ScopedTokensFile
+	err = json.Unmarshal(data, &file)
+	if err == nil {
+		err = file.Validate()
+	}
+	if err != nil {
+		st.log.Errorf("invalid scoped API tokens file %s, keeping the previous tokens: %v", st.path, err)
+		return
+	}
+	st.modTime = info.ModTime()
+	st.size = info.Size()
+
+	// Keep the rate limiter state of tokens whose limits did not change
+	previous := make(map[string]*scopedToken, len(st.tokens))
+	for _, t := range st.tokens {
+		previous[t.Name] = t
+	}
+	st.tokens = make([]*scopedToken, 0, len(file.Tokens))
+	for _, t := range file.Tokens {
+		token := &scopedToken{ScopedToken: t}
+		if t.RateLimit > 0 {
+			if prev, ok := previous[t.Name]; ok && prev.limiter != nil && prev.RateLimit == t.RateLimit && prev.Burst == t.Burst {
+				token.limiter = prev.limiter
+			} else {
+				token.limiter = makeTokenBucket(t.RateLimit, float64(t.Burst), now)
+			}
+		}
+		st.tokens = append(st.tokens, token)
+	}
+	st.log.Infof("loaded %d scoped API tokens from %s", len(st.tokens), st.path)
+}
+
+// authorize checks whether the provided token is a scoped token that may
+// call an endpoint requiring scope. It returns the name of the token if it
+// is one, and the error to respond with if the call may not proceed.
+func (st *ScopedTokens) authorize(provided []byte, scope Scope) (string, error) {
+	now := time.Now()
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if now.Sub(st.lastCheck) >= scopedTokensReloadPeriod {
+		st.reloadLocked(now)
+	}
+
+	var token *scopedToken
+	for _, t := range st.tokens {
+		if subtle.ConstantTimeCompare(provided, []byte(t.Token)) == 1 {
+			token = t
+			break
+		}
+	}
+	if token == nil {
+		return "", echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
+	}
+	if token.Expires != nil && !now.Before(*token.Expires) {
+		return token.Name, echo.NewHTTPError(http.StatusUnauthorized, ExpiredTokenMessage)
+	}
+	if !token.allows(scope) {
+		return token.Name, echo.NewHTTPError(http.StatusForbidden, ForbiddenTokenMessage)
+	}
+	if token.limiter != nil && !token.limiter.take(now, 1) {
+		return token.Name, echo.NewHTTPError(http.StatusTooManyRequests, TokenRateLimitedMessage)
+	}
+	return token.Name, nil
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func writeScopedTokens(t *testing.T, path string, tokens ...ScopedToken) {
+	data, err := json.Marshal(ScopedTokensFile{Tokens: tokens})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	// Make sure the change is noticed even on coarse file systems
+	mtime := time.Now().Add(time.Duration(len(data)) * time.Second)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+}
+
+func scopedTestToken(name string) string {
+	return name + strings.Repeat("x", 64)
+}
+
+func TestEndpointScope(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tests := []struct {
+		method string
+		path   string
+		admin  bool
+		scope  Scope
+	}{
+		{"GET", "/v2/accounts/:address", false, ScopeRead},
+		{"GET", "/v1/status", false, ScopeRead},
+		{"POST", "/v2/transactions", false, ScopeSubmit},
+		{"POST", "/v2/transactions/async", false, ScopeSubmit},
+		{"POST", "/v2/transactions/simulate", false, ScopeSimulate},
+		{"POST", "/v2/teal/compile", false, ScopeSimulate},
+		{"POST", "/v2/devmode/blocks/offset/:offset", false, ScopeAdmin},
+		{"POST", "/v2/ledger/sync/:round", false, ScopeAdmin},
+		{"GET", "/v2/participation", true, ScopeParticipation},
+		{"DELETE", "/v2/participation/:participation-id", true, ScopeParticipation},
+		{"GET", "/debug/pprof/*", true, ScopeDebug},
+		{"GET", URLAuthPrefix + "/debug/pprof/*", true, ScopeDebug},
+		{"PUT", "/debug/settings/pprof", true, ScopeDebug},
+		{"POST", "/v2/shutdown", true, ScopeAdmin},
+		{"GET", "/v2/catchup/:catchpoint", true, ScopeAdmin},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.scope, endpointScope(test.method, test.path, test.admin), "%s %s", test.method, test.path)
+	}
+}
+
+func TestScopedTokensValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	valid := ScopedToken{Name: "a", Token: scopedTestToken("a"), Scopes: []Scope{ScopeRead}}
+	require.NoError(t, ScopedTokensFile{Tokens: []ScopedToken{valid}}.Validate())
+
+	noName := valid
+	noName.Name = ""
+	shortToken := valid
+	shortToken.Token = "short"
+	noScopes := valid
+	noScopes.Scopes = nil
+	badScope := valid
+	badScope.Scopes = []Scope{"everything"}
+	negativeRate := valid
+	negativeRate.RateLimit = -1
+	for _, tokens := range [][]ScopedToken{{noName}, {shortToken}, {noScopes}, {badScope}, {negativeRate}, {valid, valid}} {
+		require.Error(t, ScopedTokensFile{Tokens: tokens}.Validate())
+	}
+}
+
+func TestScopedAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path := filepath.Join(t.TempDir(), "algod_tokens.json")
+	expired := time.Now().Add(-time.Hour)
+	writeScopedTokens(t, path,
+		ScopedToken{Name: "reader", Token: scopedTestToken("reader"), Scopes: []Scope{ScopeRead}},
+		ScopedToken{Name: "submitter", Token: scopedTestToken("submitter"), Scopes: []Scope{ScopeRead, ScopeSubmit}, RateLimit: 0.001, Burst: 2},
+		ScopedToken{Name: "operator", Token: scopedTestToken("operator"), Scopes: []Scope{ScopeAdmin}},
+		ScopedToken{Name: "expired", Token: scopedTestToken("expired"), Scopes: []Scope{ScopeAdmin}, Expires: &expired},
+	)
+	scoped := MakeScopedTokens(path, logging.TestingLog(t))
+
+	public := MakeScopedAuth(testAPIHeader, []string{"token1"}, scoped, false)(success)
+	admin := MakeScopedAuth(testAPIHeader, []string{"admin1"}, scoped, true)(success)
+
+	call := func(handler echo.HandlerFunc, method, path, token string) (any, error) {
+		req, _ := http.NewRequest(method, "N/A", nil)
+		req.Header.Set(testAPIHeader, token)
+		ctx := e.NewContext(req, nil)
+		ctx.SetPath(path)
+		err := handler(ctx)
+		return ctx.Get(TokenNameKey), err
+	}
+	status := func(err error) int {
+		if err == errSuccess {
+			return http.StatusOK
+		}
+		return err.(*echo.HTTPError).Code
+	}
+
+	// The regular tokens keep working
+	name, err := call(public, "POST", "/v2/transactions", "token1")
+	require.Equal(t, errSuccess, err)
+	require.Nil(t, name)
+	_, err = call(admin, "POST", "/v2/shutdown", "admin1")
+	require.Equal(t, errSuccess, err)
+
+	tests := []struct {
+		handler echo.HandlerFunc
+		method  string
+		path    string
+		token   string
+		status  int
+	}{
+		{public, "GET", "/v2/status", "reader", http.StatusOK},
+		{public, "POST", "/v2/transactions", "reader", http.StatusForbidden},
+		{admin, "GET", "/v2/participation", "reader", http.StatusForbidden},
+		{public, "POST", "/v2/transactions", "submitter", http.StatusOK},
+		{public, "POST", "/v2/transactions/simulate", "submitter", http.StatusForbidden},
+		{admin, "POST", "/v2/shutdown", "operator", http.StatusOK},
+		{public, "GET", "/v2/status", "expired", http.StatusUnauthorized},
+		{public, "GET", "/v2/status", "unknown", http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		name, err := call(test.handler, test.method, test.path, scopedTestToken(test.token))
+		require.Equal(t, test.status, status(err), "%s %s with %s", test.method, test.path, test.token)
+		if test.token != "unknown" {
+			require.Equal(t, test.token, name)
+		}
+	}
+
+	// The submitter used one of its two requests above
+	_, err = call(public, "GET", "/v2/status", scopedTestToken("submitter"))
+	require.Equal(t, errSuccess, err)
+	_, err = call(public, "GET", "/v2/status", scopedTestToken("submitter"))
+	require.Equal(t, http.StatusTooManyRequests, status(err))
+
+	// Revoking a token takes effect once the file is reloaded
+	writeScopedTokens(t, path,
+		ScopedToken{Name: "submitter", Token: scopedTestToken("submitter"), Scopes: []Scope{ScopeRead, ScopeSubmit}, RateLimit: 0.001, Burst: 2},
+	)
+	scoped.mu.Lock()
+	scoped.lastCheck = time.Time{}
+	scoped.mu.Unlock()
+	_, err = call(public, "GET", "/v2/status", scopedTestToken("reader"))
+	require.Equal(t, http.StatusUnauthorized, status(err))
+	// and the rate limit of unchanged tokens carries over
+	_, err = call(public, "GET", "/v2/status", scopedTestToken("submitter"))
+	require.Equal(t, http.StatusTooManyRequests, status(err))
+
+	// An invalid file keeps the previous tokens
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0600))
+	scoped.mu.Lock()
+	scoped.lastCheck = time.Time{}
+	scoped.mu.Unlock()
+	_, err = call(public, "GET", "/v2/status", scopedTestToken("reader"))
+	require.Equal(t, http.StatusUnauthorized, status(err))
+	scoped.mu.Lock()
+	require.Len(t, scoped.tokens, 1)
+	scoped.mu.Unlock()
+
+	// Removing the file revokes all scoped tokens
+	require.NoError(t, os.Remove(path))
+	scoped.mu.Lock()
+	scoped.lastCheck = time.Time{}
+	scoped.mu.Unlock()
+	_, err = call(public, "POST", "/v2/transactions", scopedTestToken("submitter"))
+	require.Equal(t, http.StatusUnauthorized, status(err))
+}
@@ -90,13 +90,15 @@ func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx l
 }
 
 // NewRouter builds and returns a new router with our REST handlers registered.
-func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
+// Besides the API and admin tokens, requests may use the scopedTokens, if set,
+// to call the endpoints their scopes allow.
+func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan struct{}, apiToken string, adminAPIToken string, scopedTokens *middlewares.ScopedTokens, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
 	// check admin token and init admin middleware
 	if err := tokens.ValidateAPIToken(adminAPIToken); err != nil {
 		logger.Errorf("Invalid adminAPIToken was passed to NewRouter ('%s'): %v", adminAPIToken, err)
 	}
 	adminMiddleware := []echo.MiddlewareFunc{
-		middlewares.MakeAuth(TokenHeader, []string{adminAPIToken}),
+		middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken}, scopedTokens, true),
 	}
 
 	// check public api tokens and init public middleware
@@ -109,9 +111,13 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 		if err := tokens.ValidateAPIToken(apiToken); err != nil {
 			logger.Errorf("Invalid apiToken was passed to NewRouter ('%s'): %v", apiToken, err)
 		}
-		publicMiddleware = append(publicMiddleware, middlewares.MakeAuth(TokenHeader, []string{adminAPIToken, apiToken}))
+		publicMiddleware = append(publicMiddleware, middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken, apiToken}, scopedTokens, false))
 
 	}
+	// The rate limiter comes after authentication, which names the scoped token of the request
+	if cfg := node.Config(); cfg.RestRateLimitCostPerSecond > 0 {
+		publicMiddleware = append(publicMiddleware, middlewares.MakeRateLimiter(cfg.RestRateLimitCostPerSecond, cfg.RestRateLimitBurstCost))
+	}
 
 	e := echo.New()
 
@@ -169,6 +175,10 @@ func NewRouter(logger logging.Logger, node APINodeInterface, shutdown <-chan str
 		experimental.RegisterHandlers(e, &v2Handler, publicMiddleware...)
 	}
 
+	if node.Config().EnableConnectAPI {
+		v2.RegisterConnectHandlers(e, &v2Handler, publicMiddleware...)
+	}
+
 	return e
 }
 
@@ -0,0 +1,342 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/connect"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/algodv1"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+)
+
+// ConnectServicePath is the path prefix of the algod.v1.AlgodService methods.
+const ConnectServicePath = "/algod.v1.AlgodService/"
+
+// RegisterConnectHandlers registers the algod.v1.AlgodService methods, served
+// over the Connect protocol by the REST handlers, to the given router.
+func RegisterConnectHandlers(router *echo.Echo, v2 *Handlers, m ...echo.MiddlewareFunc) {
+	s := connectService{v2}
+	router.POST(ConnectServicePath+"GetStatus", connect.Unary(s.GetStatus), m...)
+	router.POST(ConnectServicePath+"GetAccount", connect.Unary(s.GetAccount), m...)
+	router.POST(ConnectServicePath+"GetAsset", connect.Unary(s.GetAsset), m...)
+	router.POST(ConnectServicePath+"GetApplication", connect.Unary(s.GetApplication), m...)
+	router.POST(ConnectServicePath+"GetBlock", connect.Unary(s.GetBlock), m...)
+	router.POST(ConnectServicePath+"GetStateDelta", connect.Unary(s.GetStateDelta), m...)
+	router.POST(ConnectServicePath+"SubmitTransactions", connect.Unary(s.SubmitTransactions), m...)
+	router.POST(ConnectServicePath+"SimulateTransactions", connect.Unary(s.SimulateTransactions), m...)
+	router.POST(ConnectServicePath+"StreamBlocks", connect.ServerStream(s.StreamBlocks), m...)
+}
+
+// connectService implements algod.v1.AlgodService.
+type connectService struct {
+	v2 *Handlers
+}
+
+// fail logs an internal error while returning the error reported to the client.
+func (s connectService) fail(code connect.Code, internal error, external string) error {
+	s.v2.Log.Info(internal)
+	return &connect.Error{Code: code, Message: external}
+}
+
+// GetStatus returns the current node status.
+func (s connectService) GetStatus(ctx context.Context, req *algodv1.GetStatusRequest) (*algodv1.GetStatusResponse, error) {
+	stat, err := s.v2.nodeStatus()
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, errFailedRetrievingNodeStatus)
+	}
+	return &algodv1.GetStatusResponse{
+		LastRound:                 stat.LastRound,
+		LastVersion:               stat.LastVersion,
+		NextVersion:               stat.NextVersion,
+		NextVersionRound:          stat.NextVersionRound,
+		NextVersionSupported:      stat.NextVersionSupported,
+		TimeSinceLastRoundNs:      stat.TimeSinceLastRound,
+		CatchupTimeNs:             stat.CatchupTime,
+		StoppedAtUnsupportedRound: stat.StoppedAtUnsupportedRound,
+		Catchpoint:                nilToZero(stat.Catchpoint),
+		LastCatchpoint:            nilToZero(stat.LastCatchpoint),
+	}, nil
+}
+
+// GetAccount returns an account, without its assets and applications.
+func (s connectService) GetAccount(ctx context.Context, req *algodv1.GetAccountRequest) (*algodv1.GetAccountResponse, error) {
+	addr, err := basics.UnmarshalChecksumAddress(req.Address)
+	if err != nil {
+		return nil, s.fail(connect.CodeInvalidArgument, err, errFailedToParseAddress)
+	}
+
+	myLedger := s.v2.Node.LedgerForAPI()
+	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, errFailedLookingUpLedger)
+	}
+	consensus, err := myLedger.ConsensusParams(lastRound)
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, fmt.Sprintf("could not retrieve consensus information for last round (%d)", lastRound))
+	}
+	account, err := basicAccountToModel(addr, record, lastRound, &consensus, amountWithoutPendingRewards)
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, errInternalFailure)
+	}
+
+	resp := &algodv1.Account{
+		Address:                     account.Address,
+		Round:                       account.Round,
+		Amount:                      account.Amount,
+		AmountWithoutPendingRewards: account.AmountWithoutPendingRewards,
+		PendingRewards:              account.PendingRewards,
+		Rewards:                     account.Rewards,
+		MinBalance:                  account.MinBalance,
+		Status:                      account.Status,
+		AuthAddr:                    nilToZero(account.AuthAddr),
+		TotalAssetsOptedIn:          account.TotalAssetsOptedIn,
+		TotalCreatedAssets:          account.TotalCreatedAssets,
+		TotalAppsOptedIn:            account.TotalAppsOptedIn,
+		TotalCreatedApps:            account.TotalCreatedApps,
+		TotalBoxes:                  nilToZero(account.TotalBoxes),
+		TotalBoxBytes:               nilToZero(account.TotalBoxBytes),
+		IncentiveEligible:           nilToZero(account.IncentiveEligible),
+		LastProposed:                nilToZero(account.LastProposed),
+		LastHeartbeat:               nilToZero(account.LastHeartbeat),
+	}
+	if p := account.Participation; p != nil {
+		resp.Participation = &algodv1.AccountParticipation{
+			VoteParticipationKey:      p.VoteParticipationKey,
+			SelectionParticipationKey: p.SelectionParticipationKey,
+			StateProofKey:             nilToZero(p.StateProofKey),
+			VoteFirstValid:            p.VoteFirstValid,
+			VoteLastValid:             p.VoteLastValid,
+			VoteKeyDilution:           p.VoteKeyDilution,
+		}
+	}
+	return &algodv1.GetAccountResponse{Account: resp}, nil
+}
+
+// GetAsset returns the parameters of an asset.
+func (s connectService) GetAsset(ctx context.Context, req *algodv1.GetAssetRequest) (*algodv1.GetAssetResponse, error) {
+	assetIdx := basics.AssetIndex(req.AssetId)
+	creator, assetParams, err := s.v2.lookupAssetParams(assetIdx)
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, errFailedLookingUpLedger)
+	}
+	if assetParams == nil {
+		return nil, s.fail(connect.CodeNotFound, errors.New(errAssetDoesNotExist), errAssetDoesNotExist)
+	}
+
+	asset := AssetParamsToAsset(creator.String(), assetIdx, assetParams)
+	params := asset.Params
+	return &algodv1.GetAssetResponse{Asset: &algodv1.Asset{
+		Id:            asset.Index,
+		Creator:       params.Creator,
+		Total:         params.Total,
+		Decimals:      params.Decimals,
+		DefaultFrozen: nilToZero(params.DefaultFrozen),
+		UnitName:      nilToZero(params.UnitNameB64),
+		Name:          nilToZero(params.NameB64),
+		Url:           nilToZero(params.UrlB64),
+		MetadataHash:  nilToZero(params.MetadataHash),
+		Manager:       nilToZero(params.Manager),
+		Reserve:       nilToZero(params.Reserve),
+		Freeze:        nilToZero(params.Freeze),
+		Clawback:      nilToZero(params.Clawback),
+	}}, nil
+}
+
+// GetApplication returns the parameters and global state of an application.
+func (s connectService) GetApplication(ctx context.Context, req *algodv1.GetApplicationRequest) (*algodv1.GetApplicationResponse, error) {
+	appIdx := basics.AppIndex(req.ApplicationId)
+	creator, appParams, err := s.v2.lookupAppParams(appIdx)
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, errFailedLookingUpLedger)
+	}
+	if appParams == nil {
+		return nil, s.fail(connect.CodeNotFound, errors.New(errAppDoesNotExist), errAppDoesNotExist)
+	}
+
+	app := &algodv1.Application{
+		Id:                uint64(appIdx),
+		Creator:           creator.String(),
+		ApprovalProgram:   appParams.ApprovalProgram,
+		ClearStateProgram: appParams.ClearStateProgram,
+		ExtraProgramPages: uint64(appParams.ExtraProgramPages),
+		LocalStateSchema: &algodv1.StateSchema{
+			NumUint:      appParams.LocalStateSchema.NumUint,
+			NumByteSlice: appParams.LocalStateSchema.NumByteSlice,
+		},
+		GlobalStateSchema: &algodv1.StateSchema{
+			NumUint:      appParams.GlobalStateSchema.NumUint,
+			NumByteSlice: appParams.GlobalStateSchema.NumByteSlice,
+		},
+	}
+	for key, value := range appParams.GlobalState {
+		app.GlobalState = append(app.GlobalState, &algodv1.TealKeyValue{
+			Key:   []byte(key),
+			Type:  uint64(value.Type),
+			Bytes: []byte(value.Bytes),
+			Uint:  value.Uint,
+		})
+	}
+	sort.Slice(app.GlobalState, func(i, j int) bool {
+		return bytes.Compare(app.GlobalState[i].Key, app.GlobalState[j].Key) < 0
+	})
+	return &algodv1.GetApplicationResponse{Application: app}, nil
+}
+
+// GetBlock returns the block of a round.
+func (s connectService) GetBlock(ctx context.Context, req *algodv1.GetBlockRequest) (*algodv1.GetBlockResponse, error) {
+	return s.block(basics.Round(req.Round))
+}
+
+func (s connectService) block(round basics.Round) (*algodv1.GetBlockResponse, error) {
+	blockbytes, err := rpcs.RawBlockBytes(s.v2.Node.LedgerForAPI(), round)
+	if err != nil {
+		var errNoEntry ledgercore.ErrNoEntry
+		if errors.As(err, &errNoEntry) {
+			return nil, s.fail(connect.CodeNotFound, err, errFailedLookingUpLedger)
+		}
+		return nil, s.fail(connect.CodeInternal, err, err.Error())
+	}
+	return &algodv1.GetBlockResponse{Round: uint64(round), Block: blockbytes}, nil
+}
+
+// GetStateDelta returns the ledger state delta of a round.
+func (s connectService) GetStateDelta(ctx context.Context, req *algodv1.GetStateDeltaRequest) (*algodv1.GetStateDeltaResponse, error) {
+	sDelta, err := s.v2.Node.LedgerForAPI().GetStateDeltaForRound(basics.Round(req.Round))
+	if err != nil {
+		return nil, s.fail(connect.CodeNotFound, err, fmt.Sprintf(errFailedRetrievingStateDelta, err))
+	}
+	data, err := encode(protocol.CodecHandle, sDelta)
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, errFailedToEncodeResponse)
+	}
+	return &algodv1.GetStateDeltaResponse{Round: req.Round, Delta: data}, nil
+}
+
+// SubmitTransactions broadcasts a transaction group to the network.
+func (s connectService) SubmitTransactions(ctx context.Context, req *algodv1.SubmitTransactionsRequest) (*algodv1.SubmitTransactionsResponse, error) {
+	stat, err := s.v2.Node.Status()
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, errFailedRetrievingNodeStatus)
+	}
+	if stat.Catchpoint != "" {
+		return nil, s.fail(connect.CodeUnavailable, errors.New("SubmitTransactions failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	txgroup, err := decodeTxGroup(bytes.NewReader(req.SignedTransactions), proto.MaxTxGroupSize)
+	if err != nil {
+		return nil, s.fail(connect.CodeInvalidArgument, err, err.Error())
+	}
+	err = s.v2.Node.BroadcastSignedTxGroup(txgroup)
+	if err != nil {
+		return nil, s.fail(connect.CodeInvalidArgument, err, err.Error())
+	}
+	return &algodv1.SubmitTransactionsResponse{TxId: txgroup[0].ID().String()}, nil
+}
+
+// SimulateTransactions simulates transaction groups against the latest ledger.
+func (s connectService) SimulateTransactions(ctx context.Context, req *algodv1.SimulateTransactionsRequest) (*algodv1.SimulateTransactionsResponse, error) {
+	stat, err := s.v2.Node.Status()
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, errFailedRetrievingNodeStatus)
+	}
+	if stat.Catchpoint != "" {
+		return nil, s.fail(connect.CodeUnavailable, errors.New("SimulateTransactions failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	simulateRequest, err := decodeSimulateRequest(req.Request, proto.MaxTxGroupSize)
+	if err != nil {
+		return nil, s.fail(connect.CodeInvalidArgument, err, err.Error())
+	}
+	simulationResult, err := s.v2.Node.Simulate(convertSimulationRequest(simulateRequest))
+	if err != nil {
+		var invalidTxErr simulation.InvalidRequestError
+		if errors.As(err, &invalidTxErr) {
+			return nil, s.fail(connect.CodeInvalidArgument, invalidTxErr, invalidTxErr.Error())
+		}
+		return nil, s.fail(connect.CodeInternal, err, err.Error())
+	}
+	response := convertSimulationResult(simulationResult)
+	data, err := encode(protocol.CodecHandle, &response)
+	if err != nil {
+		return nil, s.fail(connect.CodeInternal, err, errFailedToEncodeResponse)
+	}
+	return &algodv1.SimulateTransactionsResponse{Result: data}, nil
+}
+
+// MaxStreamBlocksBacklog is how many rounds before the latest one a block
+// stream may start from. Clients further behind should catch up with GetBlock.
+const MaxStreamBlocksBacklog = 1000
+
+// streamBlocksFromRound returns the first round of a block stream starting at
+// fromRound, when latest is the latest round of the ledger
+func streamBlocksFromRound(fromRound uint64, latest basics.Round) (basics.Round, error) {
+	if fromRound == 0 {
+		return latest + 1, nil
+	}
+	round := basics.Round(fromRound)
+	if latest.SubSaturate(round) > MaxStreamBlocksBacklog {
+		return 0, connect.Errorf(connect.CodeInvalidArgument, "from_round %d is more than %d rounds behind the latest round %d", round, MaxStreamBlocksBacklog, latest)
+	}
+	return round, nil
+}
+
+// StreamBlocks sends the blocks of every round from req.FromRound on, as they
+// are added to the ledger.
+func (s connectService) StreamBlocks(ctx context.Context, req *algodv1.StreamBlocksRequest, send func(*algodv1.GetBlockResponse) error) error {
+	ledger := s.v2.Node.LedgerForAPI()
+	round, err := streamBlocksFromRound(req.FromRound, ledger.Latest())
+	if err != nil {
+		return err
+	}
+	for {
+		ledgerWaitCh, cancelLedgerWait := ledger.WaitWithCancel(round)
+		select {
+		case <-ledgerWaitCh:
+		case <-ctx.Done():
+			cancelLedgerWait()
+			return ctx.Err()
+		case <-s.v2.Shutdown:
+			cancelLedgerWait()
+			return &connect.Error{Code: connect.CodeUnavailable, Message: errServiceShuttingDown}
+		}
+		cancelLedgerWait()
+
+		resp, err := s.block(round)
+		if err != nil {
+			return err
+		}
+		err = send(resp)
+		if err != nil {
+			return err
+		}
+		round++
+	}
+}
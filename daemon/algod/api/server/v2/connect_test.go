@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/connect"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestStreamBlocksFromRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, tc := range []struct {
+		from   uint64
+		latest basics.Round
+		want   basics.Round
+	}{
+		{0, 5000, 5001},
+		{4000, 5000, 4000},
+		{5000, 5000, 5000},
+		{6000, 5000, 6000},
+		{1, 1000, 1},
+	} {
+		round, err := streamBlocksFromRound(tc.from, tc.latest)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, round)
+	}
+
+	_, err := streamBlocksFromRound(3999, 5000)
+	var cerr *connect.Error
+	require.ErrorAs(t, err, &cerr)
+	require.Equal(t, connect.CodeInvalidArgument, cerr.Code)
+}
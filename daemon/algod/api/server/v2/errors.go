@@ -47,6 +47,7 @@ var (
 	errFailedSettingTimeStampOffset            = "failed to set timestamp offset on the node: %v"
 	errFailedRetrievingSyncRound               = "failed retrieving sync round from ledger"
 	errFailedSettingSyncRound                  = "failed to set sync round on the ledger"
+	errFailedUpdatingSyncCursor                = "failed to update sync cursor: %v"
 	errFailedParsingFormatOption               = "failed to parse the format option"
 	errFailedToParseAddress                    = "failed to parse the address"
 	errFailedToParseExclude                    = "failed to parse exclude"
@@ -64,5 +65,13 @@ var (
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
+	errHistoricalStateUnavailable              = "state at the requested round is not available: %v"
+	errRoundRequiresExcludeAll                 = "account assets and applications are only available at the latest round, round requires exclude=all"
+	errTransactionActivityIndexDisabled        = "the transaction activity index is not enabled, it requires Archival and EnableTransactionActivityIndex in the configuration file"
 	errFailedRetrievingTracer                  = "failed retrieving the expected tracer from ledger"
+	errFailedRetrievingAgreementTimeline       = "failed retrieving agreement timeline"
+	errAgreementTimelineRoundNotFound          = "agreement timeline for the given round is not retained"
+	errTrieProofsUnavailable                   = "ledger entry proofs are not available, they require EnableLedgerEntryProofs, CatchpointTracking and a non-zero CatchpointInterval in the configuration file, and a catchpoint made since"
+	errLedgerEntryNotFound                     = "ledger entry not found"
+	errAssetAndApplicationID                   = "only one of asset-id and application-id can be given"
 )
@@ -27,6 +27,18 @@ type ServerInterface interface {
 	// Returns the minimum sync round the ledger is keeping in cache.
 	// (GET /v2/ledger/sync)
 	GetSyncRound(ctx echo.Context) error
+	// Returns the named sync cursors holding the ledger back.
+	// (GET /v2/ledger/sync/cursors)
+	ListSyncCursors(ctx echo.Context) error
+	// Removes a named sync cursor.
+	// (DELETE /v2/ledger/sync/cursors/{name})
+	DeleteSyncCursor(ctx echo.Context, name string) error
+	// Registers a named sync cursor at the given round.
+	// (POST /v2/ledger/sync/cursors/{name}/{round})
+	RegisterSyncCursor(ctx echo.Context, name string, round uint64) error
+	// Moves a named sync cursor forward to the given round.
+	// (PUT /v2/ledger/sync/cursors/{name}/{round})
+	AdvanceSyncCursor(ctx echo.Context, name string, round uint64) error
 	// Given a round, tells the ledger to keep that round in its cache.
 	// (POST /v2/ledger/sync/{round})
 	SetSyncRound(ctx echo.Context, round uint64) error
@@ -59,6 +71,87 @@ func (w *ServerInterfaceWrapper) GetSyncRound(ctx echo.Context) error {
 	return err
 }
 
+// ListSyncCursors converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSyncCursors(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListSyncCursors(ctx)
+	return err
+}
+
+// DeleteSyncCursor converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSyncCursor(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteSyncCursor(ctx, name)
+	return err
+}
+
+// RegisterSyncCursor converts echo context to params.
+func (w *ServerInterfaceWrapper) RegisterSyncCursor(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RegisterSyncCursor(ctx, name, round)
+	return err
+}
+
+// AdvanceSyncCursor converts echo context to params.
+func (w *ServerInterfaceWrapper) AdvanceSyncCursor(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AdvanceSyncCursor(ctx, name, round)
+	return err
+}
+
 // SetSyncRound converts echo context to params.
 func (w *ServerInterfaceWrapper) SetSyncRound(ctx echo.Context) error {
 	var err error
@@ -107,6 +200,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.DELETE(baseURL+"/v2/ledger/sync", wrapper.UnsetSyncRound, m...)
 	router.GET(baseURL+"/v2/ledger/sync", wrapper.GetSyncRound, m...)
+	router.GET(baseURL+"/v2/ledger/sync/cursors", wrapper.ListSyncCursors, m...)
+	router.DELETE(baseURL+"/v2/ledger/sync/cursors/:name", wrapper.DeleteSyncCursor, m...)
+	router.POST(baseURL+"/v2/ledger/sync/cursors/:name/:round", wrapper.RegisterSyncCursor, m...)
+	router.PUT(baseURL+"/v2/ledger/sync/cursors/:name/:round", wrapper.AdvanceSyncCursor, m...)
 	router.POST(baseURL+"/v2/ledger/sync/:round", wrapper.SetSyncRound, m...)
 
 }
@@ -114,227 +211,264 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9/5PbNrIg/q+g9F6VY3/EGdtx8jb+1Na7iZ1k5+IkLo+TvfdsXwKRLQk7FMAFwBkp",
-	"Pv/vV+gGSJAEJWpm4iRX+5M9Ir40Go1Gf0P3+1muNpWSIK2ZPX0/q7jmG7Cg8S+e56qWNhOF+6sAk2tR",
-	"WaHk7Gn4xozVQq5m85lwv1bcrmfzmeQbaNu4/vOZhn/WQkMxe2p1DfOZydew4W5gu6tc62akbbZSmR/i",
-	"jIY4fz77sOcDLwoNxgyh/EGWOyZkXtYFMKu5NDx3nwy7FnbN7FoY5jszIZmSwNSS2XWnMVsKKAtzEhb5",
-	"zxr0Llqln3x8SR9aEDOtShjC+UxtFkJCgAoaoJoNYVaxApbYaM0tczM4WENDq5gBrvM1Wyp9AFQCIoYX",
-	"ZL2ZPX0zMyAL0LhbOYgr/O9SA/wKmeV6BXb2bp5a3NKCzqzYJJZ27rGvwdSlNQzb4hpX4gokc71O2He1",
-	"sWwBjEv26utn7NNPP/3CLWTDrYXCE9noqtrZ4zVR99nTWcEthM9DWuPlSmkui6xp/+rrZzj/hV/g1Fbc",
-	"GEgfljP3hZ0/H1tA6JggISEtrHAfOtTveiQORfvzApZKw8Q9ocZ3uinx/L/rruTc5utKCWkT+8LwK6PP",
-	"SR4Wdd/HwxoAOu0rhyntBn3zMPvi3ftH80cPP/zbm7Psv/2fn336YeLynzXjHsBAsmFeaw0y32UrDRxP",
-	"y5rLIT5eeXowa1WXBVvzK9x8vkFW7/sy15dY5xUva0cnItfqrFwpw7gnowKWvC4tCxOzWpaOTbnRPLUz",
-	"YVil1ZUooJg77nu9Fvma5dzQENiOXYuydDRYGyjGaC29uj2H6UOMEgfXjfCBC/rjIqNd1wFMwBa5QZaX",
-	"ykBm1YHrKdw4XBYsvlDau8ocd1mx12tgOLn7QJct4k46mi7LHbO4rwXjhnEWrqY5E0u2UzW7xs0pxSX2",
-	"96txWNswhzTcnM496g7vGPoGyEggb6FUCVwi8sK5G6JMLsWq1mDY9Rrs2t95GkylpAGmFv+A3Lpt/58X",
-	"P3zPlGbfgTF8BS95fslA5qqA4oSdL5lUNiINT0uIQ9dzbB0ertQl/w+jHE1szKri+WX6Ri/FRiRW9R3f",
-	"ik29YbLeLEC7LQ1XiFVMg621HAOIRjxAihu+HU76Wtcyx/1vp+3Ico7ahKlKvkOEbfj2rw/nHhzDeFmy",
-	"CmQh5IrZrRyV49zch8HLtKplMUHMsW5Po4vVVJCLpYCCNaPsgcRPcwgeIY+DpxW+InDCIKPgNLMcAEfC",
-	"NkEz7nS7L6ziK4hI5oT96JkbfrXqEmRD6Gyxw0+VhiuhatN0GoERp94vgUtlIas0LEWCxi48OhyDoTae",
-	"A2+8DJQrabmQUDjmjEArC8SsRmGKJtyv7wxv8QU38PmTsTu+/Tpx95eqv+t7d3zSbmOjjI5k4up0X/2B",
-	"TUtWnf4T9MN4biNWGf082Eixeu1um6Uo8Sb6h9u/gIbaIBPoICLcTUasJLe1hqdv5QP3F8vYheWy4Lpw",
-	"v2zop+/q0ooLsXI/lfTTC7US+YVYjSCzgTWpcGG3Df3jxkuzY7tN6hUvlLqsq3hBeUdxXezY+fOxTaYx",
-	"jyXMs0bbjRWP19ugjBzbw26bjRwBchR3FXcNL2GnwUHL8yX+s10iPfGl/tX9U1Wl622rZQq1jo79lYzm",
-	"A29WOKuqUuTcIfGV/+y+OiYApEjwtsUpXqhP30cgVlpVoK2gQXlVZaXKeZkZyy2O9O8alrOns387be0v",
-	"p9TdnEaTv3C9LrCTE1lJDMp4VR0xxksn+pg9zMIxaPyEbILYHgpNQtImOlISjgWXcMWlPWlVlg4/aA7w",
-	"Gz9Ti2+SdgjfPRVsFOGMGi7AkARMDe8ZFqGeIVoZohUF0lWpFs0Pn5xVVYtB/H5WVYQPlB5BoGAGW2Gs",
-	"uY/L5+1Jiuc5f37CvonHRlFcyXLnLgcSNdzdsPS3lr/FGtuSX0M74j3DcDuVPnFbE9DgxPy7oDhUK9aq",
-	"dFLPQVpxjf/m28Zk5n6f1PnPQWIxbseJCxUtjznScfCXSLn5pEc5Q8Lx5p4TdtbvezOycaPsIRhz3mLx",
-	"rokHfxEWNuYgJUQQRdTkt4drzXczLyRmKOwNyeRHA0QhFV8JidDOnfok2YZf0n4oxLsjBDCNXkS0RBJk",
-	"Y0L1MqdH/cnAzvInoNbUxgZJ1EmqpTAW9WpszNZQouDMZSDomFRuRBkTNnzPIhqYrzWviJb9FxK7hER9",
-	"nhoRrLe8eCfeiUmYI3YfbTRCdWO2fJB1JiFBrtGD4ctS5Zd/42Z9Byd8EcYa0j5Ow9bAC9Bszc06cXB6",
-	"tN2ONoW+XUOkWbaIpjppl4h/39kicbQDyyy45dEyPexpaTaCcQQR9G0KKr5MIuCFWpk7WH6pjuHdVfWM",
-	"l6Wbesize6vEgSdxsrJkrjGDjUCPgdecycVACij7iudrJxexnJflvLWVqSor4QpKpjQTUoKeM7vmtuV+",
-	"OHJQ7JCRGHDc3gKLVuPtbGhj1I0xRgPbcLyCN06dq8pun+YKMXwDPTEQRQJVoxkl0rTOn4fVwRVIZMrN",
-	"0Ah+s0Y0V8WDn7i5/SecWSpaHJlAbfBfNvhrGGYHaNe6FShkO4XSBRntrftNaJYrTUOQiOMnd/8BrtvO",
-	"dDw/qTRkfgjNr0AbXrrV9RZ1vyHfuzq5v9WZnc9y0Akz1Q/4H14y99mJcY6SWuoRKI2pyJ9ckGTiUEUz",
-	"uQZocFZsQ7ZcVvH88igon7WTp9nLpJP3FZmP/Rb6RTQ79HorCnNX24SDje1V94SQ8S6wo4EwtpfpRHNN",
-	"QcBrVTFiHz0QiFPgaIQQtb3ze/1LtU1ye7Ud3OlqC3eyE26cycz+S7V97iFT+jDmcexJ15naMsk3YPB6",
-	"lzHjdLO0jsmzhdI3E6d6F4xkrbuVcTdqJE3Oe0jCpnWV+bOZcNlQg95AbYTLfimoP3wKYx0sXFj+G2DB",
-	"uFHvAgvdge4aC2pTiRLugPTXSSl2wQ18+phd/O3ss0ePf3782eeOJCutVppv2GJnwbBPvF2SGbsr4X5S",
-	"PUTpIj3650+Ck647bmoco2qdw4ZXw6HI+UfqPzVjrt0Qa10046obACdxRHBXG6GdkV/bgfYcFvXqAqx1",
-	"qv5LrZZ3zg0HM6Sgw0YvK+0EC9N1lHpp6bRwTU5hazU/rbAlyIICLdw6hHFK8GZxJ0Q1tvFFO0vBPEYL",
-	"OHgojt2mdppdvFV6p+u7sO+A1konr+BKK6tyVWZOzhMqYaF56Vsw3yJsV9X/naBl19wwNze6b2tZjBhi",
-	"7FZOv79o6Ndb2eJm7w1G602szs87ZV+6yG+1kAp0ZreSIXV27ENLrTaMswI7oqzxDViSv8QGLizfVD8s",
-	"l3dj7lU4UMKQJTZg3EyMWjjpx0CuJEUzHrBZ+VGnoKePmOBms+MAeIxc7GSOvsK7OLbj5ryNkBi4YHYy",
-	"j2x7DsYSilWHLG9vwxtDB011zyTAceh4gZ/RWfEcSsu/Vvp1K75+o1Vd3Tl77s85dTncL8a7QwrXN9jB",
-	"hVyV3QjalYP9JLXG32VBzxojAq0BoUeKfCFWaxvpiy+1+g3uxOQsKUDxA1nLStdnaDP7XhWOmdja3IEo",
-	"2Q7WcjhHtzFf4wtVW8aZVAXg5tcmLWSOxFxisBfGqNlYbkX7hDBsAY66cl671dYVwwiswX3Rdsx4Tic0",
-	"Q9SYkfiTJnCIWtF0FM9XauDFji0AJFMLH+Thw09wkRzDx2wQ07yIm+AXHbgqrXIwBorM2+IPghba0dVh",
-	"9+AJAUeAm1mYUWzJ9a2Bvbw6COcl7DIMdjTsk29/Mvd/B3itsrw8gFhsk0Jv3542hHra9PsIrj95THZk",
-	"qSOqdeKtYxAlWBhD4VE4Gd2/PkSDXbw9Wq5AY0zNb0rxYZLbEVAD6m9M77eFtq5GQvi9mu4kPLdhkksV",
-	"BKvUYCU3NjvEll2jji3BrSDihClOjAOPCF4vuLEUByZkgTZNuk5wHhLC3BTjAI+qIW7kn4IGMhw7d/eg",
-	"NLVp1BFTV5XSForUGtAlPTrX97Bt5lLLaOxG57GK1QYOjTyGpWh8jyyvAeMf3DYOaO/SHi4OgwrcPb9L",
-	"orIDRIuIfYBchFYRduMw5hFAhGkRTYQjTI9ymtjp+cxYVVWOW9islk2/MTRdUOsz+2Pbdkhc5OSge7tQ",
-	"YNCB4tt7yK8JsxTAvuaGeThCjAGacyhgbQizO4yZETKHbB/lo4rnWsVH4OAhrauV5gVkBZR8l4iOoM+M",
-	"Pu8bAHe8VXeVhYwikdOb3lJyCPzcM7TC8UxKeGT4heXuCDpVoCUQ3/vAyAXg2Cnm5OnoXjMUzpXcojAe",
-	"Lpu2OjEi3oZXyrod9/SAIHuOPgXgETw0Q98cFdg5a3XP/hT/BcZP0MgRx0+yAzO2hHb8oxYwYgv2j7yi",
-	"89Jj7z0OnGSbo2zsAB8ZO7IjhumXXFuRiwp1nW9hd+eqX3+CpOOcFWC5KKFg0QdSA6u4P6MY2v6YN1MF",
-	"J9nehuAPjG+J5YQ4pS7wl7BDnfslPc6ITB13ocsmRnX3E5cMAQ0h304Ej5vAlue23DlBza5hx65BAzP1",
-	"gkIYhv4Uq6osHiDpn9kzo/fOJn2je93FFzhUtLxUsB3pBPvhe91TDDro8LpApVQ5wUI2QEYSgkmxI6xS",
-	"bteFf/8VXgAFSuoA6Zk2uuab6/+e6aAZV8D+S9Us5xJVrtpCI9MojYICCpBuBieCNXP66MwWQ1DCBkiT",
-	"xC8PHvQX/uCB33Nh2BKuw6NJ17CPjgcP0I7zUhnbOVx3YA91x+08cX2g48pdfF4L6fOUwyFffuQpO/my",
-	"N3jj7XJnyhhPuG75t2YAvZO5nbL2mEamhbvhuJN8Od34oMG6cd8vxKYuub0LrxVc8TJTV6C1KOAgJ/cT",
-	"CyW/uuLlD003fBAKuaPRHLIcnzFOHAteuz708tGNI6RwB5hePUwFCM6p1wV1OqBitqG6YrOBQnAL5Y5V",
-	"GnKgB39OcjTNUk8YPQXI11yuUGHQql756F4aBxl+bcg0o2s5GCIpVNmtzNDInboAfJhaePPpxCngTqXr",
-	"W8hJgbnmzXz+me+Umznag77HIOkkm89GNV6H1KtW4yXkdB+uTrgMOvJehJ924omuFESdk32G+Iq3xR0m",
-	"t7m/jcm+HToF5XDiKOS5/TgW9ezU7XJ3B0IPDcQ0VBoMXlGxmcrQV7WMH6mHUMGdsbAZWvKp688jx+/V",
-	"qL6oZCkkZBslYZfMyyIkfIcfk8cJr8mRziiwjPXt6yAd+HtgdeeZQo23xS/udv+E9j1W5mul78olSgNO",
-	"Fu8neCAPutv9lDf1k/KyTLgW/RPWPgMw8yZYV2jGjVG5QJntvDBzHxVM3kj/3rWL/pfNw5w7OHv9cXs+",
-	"tDg7AtqIoawYZ3kp0IKspLG6zu1bydFGFS01EcQVlPFxq+Wz0CRtJk1YMf1QbyXHAL7GcpUM2FhCwkzz",
-	"NUAwXpp6tQJje7rOEuCt9K2EZLUUFufauOOS0XmpQGMk1Qm13PAdWzqasIr9ClqxRW270j++0DZWlKV3",
-	"6LlpmFq+ldyyErix7DshX29xuOD0D0dWgr1W+rLBQvp2X4EEI0yWDjb7hr7iwwa//LV/5IDh7vQ5BJ22",
-	"KSNmbpmdLDH/+5P/fPrmLPtvnv36MPvi/zt99/7Jh/sPBj8+/vDXv/6f7k+ffvjr/f/899ROBdhT74c9",
-	"5OfPvWZ8/hzVnyhUvw/7R7P/b4TMkkQWR3P0aIt9grkyPAHd7xrH7BreSruVjpCueCkKx1tuQg79G2Zw",
-	"Ful09KimsxE9Y1hY65FKxS24DEswmR5rvLEUNYzPTL/UR6ekf3yP52VZS9rKIH3TQ9QQX6aW8yYbAyVq",
-	"e8rwqf6ahyBP/+fjzz6fzdsn9s332Xzmv75LULIotqlECgVsU7pi/EjinmEV3xmwae6BsCdD6Si2Ix52",
-	"A5sFaLMW1cfnFMaKRZrDhTdb3ua0leeSAvzd+UEX5857TtTy48NtNUABlV2nEjh1BDVs1e4mQC/spNLq",
-	"CuSciRM46dt8Cqcv+qC+EvgyBKZqpaZoQ805IEILVBFhPV7IJMNKin56zxv85W/uXB3yA6fg6s+Ziui9",
-	"981Xr9mpZ5jmHuX0oKGjLAwJVdq/Hu0EJDluFr8peyvfyuewROuDkk/fyoJbfrrgRuTmtDagv+Qllzmc",
-	"rBR7Gh6kPueWv5UDSWs0s2T0apxV9aIUObuMFZKWPClb2HCEt2/f8HKl3r59N4jNGKoPfqokf6EJMicI",
-	"q9pmPtdRpuGa65TvyzS5bnBkSma2b1YSslVNBtKQS8mPn+Z5vKpMP+fFcPlVVbrlR2RofEYHt2XMWNW8",
-	"R3MCin/T7Pb3e+UvBs2vg12lNmDYLxtevRHSvmPZ2/rhw0/xZV+bBOIXf+U7mtxVMNm6MpqTo29UwYWT",
-	"Womx6lnFVykX29u3byzwCncf5eUN2jjKkmG3zqvD8MAAh2oX0LzxHt0AguPo19G4uAvqFfJappeAn3AL",
-	"uy/Qb7VfUQKBG2/XgSQEvLbrzJ3t5KqMI/GwM026u5UTskI0hhEr1FZ9ZsAFsHwN+aVP2Qabyu7mne4h",
-	"4McLmoF1CEPJ/OiFIaaTQgfFAlhdFdyL4lzu+nl9DL2owEFfwSXsXqs2G9UxiXy6eWXM2EFFSo2kS0es",
-	"8bH1Y/Q330eVhYemPj0LPt4MZPG0oYvQZ/wgk8h7B4c4RRSdvCdjiOA6gQgi/hEU3GChbrxbkX5qeULm",
-	"IK24ggxKsRKLVB7ivw/9YQFWR5U+9aKPQm4GNEwsmVPlF3SxevVec7kCdz27K1UZXlJa2WTQBupDa+Da",
-	"LoDbvXZ+GWfkCNChSnmNL6/Rwjd3S4Ct229h0WIn4dppFWgoojY+evlkPP6MAIfihvCE7q2mcDKq63rU",
-	"JVIuhlu5wW6j1vrQvJjOEC76vgHM2aqu3b44KJRPN0pZbaL7pTZ8BSO6S+y9m5gQpOPxw0EOSSRJGUQt",
-	"+6LGQBJIgkyNM7fm5BkG98UdYlQzewGZYSZyEHufEWYR9whblCjANpGrtPdcd7yolBZ5DLQ0awEtW1Ew",
-	"gNHFSHwc19yE44gJYwOXnSSd/YZ5b/bl5juPYgmjrLBN5r1wG/Y56EDv9xn6Qlq+kIsvVvon5NVzuhc+",
-	"X0hth5IomhZQwooWTo0DobQZo9oNcnD8sFwib8lSYYmRgToSAPwc4DSXB4yRb4RNHiFFxhHYGPiAA7Pv",
-	"VXw25eoYIKXPeMXD2HhFRH9D+mEfBeo7YVRV7nIVI/7GPHAAn4qilSx6EdU4DBNyzhybu+KlY3NeF28H",
-	"GaSIQ4WilxDOh97cH1M09rim6Mo/ak0kJNxkNbE0G4BOi9p7IF6obUYvlJO6yGK7cPSefLuA76VTB5OS",
-	"8d0zbKG2GM6FVwvFyh+AZRyOAEZke9kKg/SK/cbkLAJm37T75dwUFRokGW9obchlTNCbMvWIbDlGLp9E",
-	"+fVuBEDPDNUWq/BmiYPmg654MrzM21tt3uaNDc/CUsd/7Agld2kEf0P7WDcj3t/azIfj2dXCifooqQCH",
-	"lqXbpGikzhWlXTwmQ2OfHDpA7MHqy74cmERrN9ari9cIaylW4pjv0Ck5RJuBElAJzjqiaXaZihRwujzg",
-	"PX4RukXGOtw9Lnf3owBCDSthLLROoxAX9HuY4znmj1ZqOb46W+mlW98rpZrLn9zm2LGzzI++AozAXwpt",
-	"bIYet+QSXKOvDRqRvnZN0xJoN0SRqi2IIs1xcdpL2GWFKOs0vfp5v33upv2+uWhMvcBbTEgK0FpgdZBk",
-	"4PKeqSm2fe+CX9CCX/A7W++00+Cauom1I5fuHH+Sc9FjYPvYQYIAU8Qx3LVRlO5hkNGD8yF3jKTRKKbl",
-	"ZJ+3YXCYijD2wSi18Ox97OankZJridIApl8IqtUKipDeLPjDZJRErlRyFZWxqqp9OfNOGKWuw8xze5LW",
-	"+TB8GAvCj8T9TMgCtmnoY60AIW9f1mHCPZxkBZLSlaTNQknUxCH+2CKy1X1kX2j/AUAyCPp1z5ndRifT",
-	"LjXbiRtQAi+8TmIgrG//sRxuiEfdfCx8upP6df8RwgGRpoSNKrsM0xCMMGBeVaLY9hxPNOqoEYwfZV0e",
-	"kbaQtfjBDmCgGwSdJLhOLnEfau0N7Keo8546rYxir31gsaNvnvsH+EWt0YPRiWweJq5vdLWJa//2pwur",
-	"NF+B90JlBNKthsDlHIOGKC28YVZQOEkhlkuIvS/mJp6DDnADG3sxgXQTRJZ20dRC2s+fpMjoAPW0MB5G",
-	"WZpiErQw5pN/PfRyBZk+MiU1V0K0NTdwVSWf638Lu+wnXtZOyRDatOG53u3UvXyP2PWrzbeww5EPRr06",
-	"wA7sClqeXgHSYMrS33wyUQbve6ZT4wDVy84WHrFTZ+lduqOt8VUpxom/vWU6VRu6S7nNwWiDJBwsU3bj",
-	"Ih2b4E4PdBHfJ+VDmyCKwzJIJO/HUwkTangOr6ImF8Uh2n0NvAzEi8uZfZjPbhcJkLrN/IgHcP2yuUCT",
-	"eMZIU/IMdwJ7jkQ5ryqtrniZ+XiJsctfqyt/+WPzEF7xkTWZNGW//ursxUsP/of5LC+B66yxBIyuCttV",
-	"f5pVUR2L/VcJZfv2hk6yFEWb32RkjmMsrjGzd8/YNKgK08bPREfRx1ws0wHvB3mfD/WhJe4J+YGqifhp",
-	"fZ4U8NMN8uFXXJTB2RigHQlOx8VNKy2U5ArxALcOFopivrI7ZTeD050+HS11HeBJONcPmJoyrXFIn7gS",
-	"WZEP/uF3Lj19rXSH+fuXicngod9OrHJCNuFxJFY7FPDsC1MnjASvX1a/uNP44EF81B48mLNfSv8hAhB/",
-	"X/jfUb948CDpPUyasRyTQCuV5Bu437yyGN2Ij6uAS7iedkGfXW0ayVKNk2FDoRQFFNB97bF3rYXHZ+F/",
-	"KaAE99PJFCU93nRCdwzMlBN0MfYSsQky3VDNUMOU7MdU4yNYR1rI7H1JBnLGDo+QrDfowMxMKfJ0aIdc",
-	"GMdeJQVTusYMG49Ya92ItRiJzZW1iMZyzabkTO0BGc2RRKZJpm1tcbdQ/njXUvyzBiYKp9UsBWi813pX",
-	"XVAOcNSBQJq2i/mByU/VDn8bO8gef1OwBe0zguz13z1vfEphoamqR0dGgMczDhj3nuhtTx+emuk127ob",
-	"gjlNj5lSOz4wOu+sG5kjWQtemGyp1a+QdoSg/yiRCCM4PgWaeX8FmYrc67OUxqnclrRvZz+03dN147GN",
-	"v7UuHBbdlF27yWWaPtXHbeRNlF6TTtfskTymhMURBt2nASOsBY9XFAyLZVBC9BGXdJ4oC0TnhVn6VMZv",
-	"OU9p/PZUepgH719Lfr3gqRoxThdyMEXb24mTsoqFzmEDTJPjgGZnUQR301ZQJrkKdOuDGGalvaFeQ9NO",
-	"1mhaBQYpKlZd5hSmUBqVGKaW11xSGXXXj/iV722AXPCu17XSmAfSpEO6CsjFJmmOffv2TZEPw3cKsRJU",
-	"Ibw2EJWg9gMxSjaJVOTLeDeZOzxqzpfs4Tyqg+93oxBXwohFCdjiEbVYcIPXZeMOb7q45YG0a4PNH09o",
-	"vq5loaGwa0OINYo1uicKeU1g4gLsNYBkD7Hdoy/YJxiSacQV3HdY9ELQ7OmjLzCghv54mLplfYX3fSy7",
-	"QJ4dgrXTdIwxqTSGY5J+1HT09VID/Arjt8Oe00Rdp5wlbOkvlMNnacMlX0H6fcbmAEzUF3cT3fk9vEjy",
-	"BoCxWu2YsOn5wXLHn0befDv2R2CwXG02wm584J5RG0dPbX1pmjQMh4XIQr2oAFf4iPGvVQj/69m6PrIa",
-	"wzcjb7YwSvl79NHGaJ0zTsk/S9FGpoeCpew85BbGAlpN3SzCjZvLLR1lSQxUX7JKC2nR/lHbZfYXpxZr",
-	"njv2dzIGbrb4/EmiEFW3Vos8DvCPjncNBvRVGvV6hOyDzOL7sk+kktnGcZTifptjITqVo4G66ZDMsbjQ",
-	"/UNPlXzdKNkoudUdcuMRp74V4ck9A96SFJv1HEWPR6/so1NmrdPkwWu3Qz++euGljI3SqYIB7XH3EocG",
-	"qwVc4Yu59Ca5MW+5F7qctAu3gf73jX8KImckloWznFQEIo/mvsfyTor/6bs28zk6VuklYs8GqHTC2unt",
-	"dh852vA4q1vff0sBY/htBHOT0YajDLEyEn1P4fVNn98jXqgPEu15x+D46BemnQ6OcvyDBwj0gwdzLwb/",
-	"8rj7mdj7gwfpBMRJk5v7tcXCbTRi7Jvawy9VwgAWqhY2AUU+P0LCADl2SbkPjgku/FBz1q0Q9/GliLt5",
-	"35WONk2fgrdv3+CXgAf8o4+I35lZ4ga2rxTGD3u3QmaSZIrmexTnztmXajuVcHp3UCCePwCKRlAy0TyH",
-	"KxlUAE266w/Gi0Q06kZdQKmckhkXBYrt+X8ePLvFz/dguxZl8VOb2613kWgu83UySnjhOv5MMnrnCiZW",
-	"mawzsuZSQpkcjnTbn4MOnNDS/6GmzrMRcmLbfgVaWm5vcS3gXTADUGFCh15hSzdBjNVu2qwmLUO5UgXD",
-	"edqiFi1zHJZyTpXQTLxvxmE3tfVxq/gW3CccWooSwzDTfmNsmWluRxJoYb3zUF/IjYPlxw2ZGWh00IyL",
-	"DV7Mhm+qEvBkXoHmK+yqJPS6Ywo1HDmqWMFM5T5hS0xYoZittWRquYyWAdIKDeVuzipuDA3y0C0Ltjj3",
-	"7Omjhw+TZi/EzoSVEhbDMn9ol/LoFJvQF19kiUoBHAXsYVg/tBR1zMYOCcfXlPxnDcameCp+oJer6CV1",
-	"tzbVk2xqn56wbzDzkSPiTqp7NFeGJMLdhJp1VSpezDG58euvzl4wmpX6UAl5qme5Qmtdl/yT7pXpCUZD",
-	"ZqeRzDnTx9mfysOt2tisKT+Zyk3oWrQFMkUv5gbteDF2TthzMqE2BfxpEoYpsvUGiqjaJSnxSBzuP9by",
-	"fI22yY4ENM4rpxdiDeys9dxErw+b6kfIsB3cvhYrlWKdM2XXoK+FAXyRD1fQTYfY5Ab1tvGQHrG7PF1L",
-	"SZRycoQw2tQ6OhbtATiSZENQQRKyHuKPtExRPeZj69JeYK/0W4xekdue1z8k1wspttl33rmQc6mkyLEU",
-	"QkqSxtRt09yUE6pGpP2LZuZPaOJwJUvrNm+BPRZHi+0GRugRN3T5R1/dphJ10J8Wtr7k2gqs8ZwNinmo",
-	"dO0dYkIa8NWsHBHFfFLpRFBT8iFEE0BxJBlhVqYRC+fX7tv33v6NSTEuhURLl0eb18/IZVUagZ5pyYRl",
-	"KwXGr6f7mse8cX1OMEtjAdt3Jy/USuQXYoVjUBidWzbFjA6HOgsRpD5i07V95tr63PnNz51wMJr0rKr8",
-	"pON10JOCpN3KUQSn4pZCIEmE3Gb8eLQ95LY39BvvU0docIVRa1DhPTwgjKaWdneUr5xuSRSFLRi9qEwm",
-	"0BUyAcYLIYMLNX1B5MkrATcGz+tIP5Nrbkl3mMTTXgMvRx5A4Atl8sHfdqh+5QCHElxjmGN8G9sy4COM",
-	"o2nQSvxc7lg4FI66I2HiGS+b0OlEUW+UqrwQVeDjol6Z7xTjcIw7C08mO+g6+Hyv6Y7VOI69icZyFC7q",
-	"YgU240WRSm31JX5l+DU8EoMt5HVThKp5HdjNUT6kNj9RrqSpN3vmCg1uOV1UNz9BDXHt/rDDmGlnscN/",
-	"UxWYxnfGB00f/So3REgXxyXmH74yTkm9jqYzI1bZdEzgnXJ7dLRT34zQ2/53Sunhue4f4jVuj8vFe5Ti",
-	"b1+5iyNO3DuIT6erpcmri7HgCr+HhEdNRsguV8KrbFBnDKMecPMSW9YDPjRMAn7Fy5GX8LGvhO5X8h+M",
-	"vYfPR9M3cOvTc1nO9rKg0ZRHFCvc874MXYhj8cEUHnx3Xgu/1r0IHffdfdvx1FGMWMssRj10N3OitRt8",
-	"rBft26uxFAmhTgd+j+uB+CieuU8DD1dC1SH6KsRAB5WQfvUpeDp1P0bWn3xZ8Ht7LUZ9LK99/VpaptfJ",
-	"v/2JvLAMpNW7P4DHZbDp/aIyCWmXzFNtE9aUPpxUCrFzK06pYZMql+Jlw2ArI9bSoaVB+ZkBWT2fIg4M",
-	"8PFhPjsvjrowUyV3ZjRK6ti9EKu1xYz9fwNegH55oCJBW4UAj1iljGgrkJZuMJ8Cdo3DnUx9bOAIWMQV",
-	"FYZjhSDUK8gtlp1tg+s0wDH1Fdxkwenzr8oE4+p08ybDFyTYV4VgWGv2wB0/SJwUJf+iOp0n03PunzUh",
-	"1PQC7JqbNl1L78305JebyyXkmBV5b6Kqv69BRkmQ5sEug7Aso7xVonnHhHm9j7c6tgDtyyO1F56ovs6t",
-	"wRl7x34Ju3uGdaghWTi0ecR3k8TBiAFygYUc0mOGZB81JkxDGYiFEBLsUzG3xTFGcz5HadduOFcgSXdx",
-	"tKnY9kyZLno+aS7X9ai0j/gkZyyX1bBm8rj+8RxLVBsfIMebxMOxls7Oh4Vzrn3iYkwr1vhOQgpjMOG3",
-	"kEOQZinFpa8fgFghT9U110VocSdJoehuEmmgl83Mon3AMQxySJRiwLdQeamcGJGNPSjrvploAg7vGYoM",
-	"bRP4IFxL0BqKxiVSKgOZVeHBxz449qGCwl9vhAQzWv6IgBtNff2qze2NZeA4prrmPuo1XiDTsOEOOh1l",
-	"4B6fcx+yn9H38Ag/lAE7aGFq6PVwPdrwdEeYARJjql8yf1seftx/E2OTkBJ0FjxP/XTcspuRDfNuFnVO",
-	"F3R8MBqD3OTcOXtYSdJOkw9X2dMRokfyl7A7JSUoFPINOxgDTZITgR4lHO1t8p2a30wK7tWdgPf75pGr",
-	"lCqzEWfH+TCHeJ/iL0V+CZgDsAlxH6nRzj5BG3vjzb5e70LO7KoCCcX9E8bOJD0qCo7tbnnB3uTynt03",
-	"/xZnLWpK6++NaidvZfp1Bibc17fkZmGY/TzMgGN1t5yKBjmQoXorx0JurjE5f7eK58lUrXzoau5XkW+J",
-	"iqBIySQX5LF6hgc9ZTjCFAhRrg50ZHLmPV3MlCoVy3uTNA1uqDSm4skQIAtySraABgo/eBIBybroiVNI",
-	"qe980ju1ZBpaJ/JNs/8NS7inNPr+zM0sXX63VBo6xdhdb8r02Tx8wTSa+J+FsJrr3U1y9A1KyA+sJ6NY",
-	"PhiO1URitQtpo7GGOCxLdZ0hs8qaOhcp1da1M93LOBRda/u5U72AKK6LGy+o7diaFyxXWkMe90i/9ySo",
-	"NkpDVioM80p5oJfWyd0bfOQlWalWTFW5KoDqxaQpaGyuWkqOYhNEUTVJFBDt4Gth6hPR8cQp3Z1KfqQM",
-	"Ra3VEbXzc6CX621WJ1p0Rr7MkYhlMD6Lk8cQNR7Cu6f2f5o3L8UW6QZ06sgvmdU1zJlv0a+R7Q8+18A2",
-	"whgCpaGla1GW+HBcbCPPaxO4kEbtiNh7jmGVVwJjb7pJBEgartyd12RWiHnARZz2iNm1VvVqHSWYbuAM",
-	"Kq+uvUIcj/KjqTE8Cl+QuSmesI0y1muaNFK75Dbk7JNcSatVWXaNUiSir7yl/Tu+Pctz+0KpywXPL++j",
-	"XiuVbVZazMP76n5wYDuT7qUW617AGZUzP5yql9phqJwn2skMssfiji7sHoH57jAHPWxzPxsurL+uLjNN",
-	"qzFnknGrNiJPn6k/V7TdaIxcikUlc5ZRbUXKMoHN8LDHl1UTXIEscohmkDxZHO6MeUbgnczIbtx/UQLv",
-	"j8uW4BnNyEU5ZC5eisryUVmvBwBCSk+fba2pIGMsiTVcRa0oVQK6yPuATrxVMBLpdrC5Ee4cKAu3AmoQ",
-	"/dgA+AkZH+aUW44iKRdqG77fb5PP3Qj4D/upvMM8xkK8LlrS0hTkFRLVjHCEdIrrvfFQr/HZ+2JqVFRT",
-	"PHfiDR8BMB4n1YFhUrTUsWAsuSihyFK1F88bG9U80rT906x+SXRhPCfPeR1KH7qxaw0+cQqJ+Lrr/6q4",
-	"IyXVNB9akmUBW6B3Hb+CVlTTcB75X6Ckkoc9Y4CqshKuoBM+5rO51ChqiisIfU3TmRUAFXoj+zayVFxU",
-	"fJf3DCd+7VkUWTMFu0lLCiGWdoodMJMkjTpbmdExMVOPkoPoShQ17+DPHCtydM2A7ignUDXQEbKgR06d",
-	"5kca4VUY4Cz0T4kyARPvpvGho1lQGnX7GNDBOMnajJ16mQ6TjFMVNQ4WnK1oHLFE4i3fMBW/luMGySHJ",
-	"t+rWxH0SSkaI/WoLOUo1Xt+Bwms8I04Kn/UEqV0CFKQVuC4Ja/saJJMqKjF5zU2jqrQ5FMMPNDE2EtJr",
-	"0zdwKrfRjLffWYaDMdNLpjaqSOiGTm9unv9dTuLegzg6XopGDPjnf3vsX4G6vdqBDbCUt3T76WR/LNLo",
-	"bzHPxedsUYeBylJdU83IWA99DsEPStQXXEBeLBfNtRyiNuc+vWff1CGiePUN3zGl8R+ndf6z5qVY7pDP",
-	"EPihGzNr7kjIO14pIsBHgbqJ94tX8wBYsLaoMBWtW0wdMxpu50aJgHYXeSjuo9iGX0K8DRjsQPwzt45x",
-	"mnqBlgt3Zfe2c4gFv/iQomXDi1jTx0SR3TLqIXWw6/3/t2/h4qlCfreq5HmoEOpLFHX5DFYBDsRl17DZ",
-	"/1hyyNcCCTSVhVui1eF1fXEDk+mRrCv1AmGs/EoH7EHF1UHlmVstY6Llt1djY88z00lLuetdmBp1MwA6",
-	"rtN4CPy4bOXHwX8yh+vYMqaA/0fB+0ih2hheqkn7EbDcycCRgJWs1Qu1zTQszaEAEzJXO3Vet7k7golV",
-	"yFwDNxRxc/6DVzzbFKVCOkWYYkIbn2YzSgFLIVtmKWRV24Qeg5lK5S5CWGz0R7SOuNDGpAQnTF7x8ocr",
-	"0FoUYxvnTgeVdIxLRARHh++bMGE0d+pwAGFaHQ7fZ7Zm9LiZu8CpCBWFaxrLZcF1ETcXkuWg3b3PrvnO",
-	"3Nyj1DgHDvmUeCTNdLMGRN4lJG0CpNx5p/At/T0NgPwOHT8THDYYF5xw1pBpx6oR/8wQhj+Fw2bDt1mp",
-	"VviKcORA+Ny06OEjFVBJNIOTfDZt3WEeI36F/dNgWn7PiKzCWadMsf/c/4BbiWrkj1LYvSefbJT9Z50U",
-	"d0sHMyBVrtrgfyKW4XlMvcT1yVfi17hB2AxPVQLtQbSJMOIf6trFR3YRwyD8M+7YCD693Fk30iL13pcs",
-	"AxlaDMye8H4wbSg7z3141tCUNjA1EFLm/rX0kZY2ss+He2kEPKpN7896d9omZMaNc0yNuP3vo7NKVVk+",
-	"JeaTKncU3k3gIe3COEIfkRNgZN1NeIxpatl08h51itocWyZvtKjOIW9Xle9T+sfMRCMcveuCUEvkZVS5",
-	"Ha1b+JKnMabM+2/MumawhkkwzjTktUYz8TXfHS47NpIx+uJvZ589evzz488+Z64BK8QKTJt1vFe2q40L",
-	"FLJv9/m4kYCD5dn0JoTsA4S44H8Mj6qaTfFnjbitaVOKDoqWHWNfTlwAieOYKBd1o73CcdrQ/j/WdqUW",
-	"eec7lkLBb79nWpVluupDI1clHCip3YpcKE4DqUAbYaxjhF0PqLBtRLRZo3kQc/9eUTYZJXMI9mNPBcKO",
-	"hFylFjIWUIv8DN92e68Rg21Vel5Fnp596/J6GlnoUGjEqJgFsEpVXrQXS5aCCF8Q6ehlrTd8okU8ipFt",
-	"mC1Fy6YI0Ueep0kvLpi9n9t3i7naNKd3m5gQL8KhvAFpjvknxvMW3ISTtKb9Pwz/SCRiuDOu0Sz3t+AV",
-	"Sf3gZkX5J4E2fJSfIA8EYOS1beedZPRQLEpErMlLgP6E4EDuix/ftY7lg89CEJLQ4QB48fPZtl3zksGD",
-	"8ztn9P2uQUq0lHdjlNBZ/qEXuYH1NhdJtEXeaGItGGJLaigWRs+tzbPmFfOIVjJ47KyVssxppmWZeCRN",
-	"dhw8UzHhOJVAX/Hy43ONr4U29gzxAcWr8adR8UvZGMmESnOzPH0v+KS5o1exdze1fIkPs/8Obo+S95wf",
-	"yjvhB7cZGnewYv0q3Ar01ptd45gUZPXoc7bwxTYqDbkwfef+dRBOmoehoMXSB7TC1h54iXponT8pewsy",
-	"XoZIHPZ95N5qfPYewvaI/s5MZeTkJqk8RX0DskjgL8Wj4uK8B66LWxZmuFnalyiB25FpX4Zlh6cuj1Kb",
-	"uEunNjBc5+TbuoPbxEXdrm1qzqLJ9R3evn1jF1NSDaVrMbjumOvoTooyHFWS4TfIckQ48mP4eVMU89NY",
-	"3lvK7TqSm7u3H7UoDwasdDKtf5jPViDBCIO5xH/2tWM+7l0aIKDMC8OjSrDeJl0MISax1s7k0VRRDvUJ",
-	"6dN9t0TOa3zVmNda2B3WDQ4GNPFzMh/TN01uD58bpvGl+bvPqktoare3mUBqE27XbxQv8T4iF590t5Aq",
-	"T9hXlOHbH5S/3lv8B3z6lyfFw08f/cfiLw8/e5jDk8++ePiQf/GEP/ri00fw+C+fPXkIj5aff7F4XDx+",
-	"8njx5PGTzz/7Iv/0yaPFk8+/+I97jg85kAnQkNr/6ex/ZWflSmVnL8+z1w7YFie8Et+C2xvUlZcK61o6",
-	"pOZ4EmHDRTl7Gn76H+GEneRq0w4ffp35+kyztbWVeXp6en19fRJ3OV3h0//Mqjpfn4Z5sNpgR155ed7E",
-	"6FMcDu5oaz3GTfWkcIbfXn118ZqdvTw/aQlm9nT28OThySNf2lrySsyezj7Fn/D0rHHfTzG/5qnxqfNP",
-	"m7daH+aDb1VFifXdJ0+j/q818BIT7Lg/NmC1yMMnDbzY+f+ba75agT7B1xv009Xj0yCNnL73mRM+7Pt2",
-	"GkeGnL7vJJgoDvQMkQ+Hmpy+D6Vz9w/YKZvqY86iDhMB3dfsdIHlcqY2hXh140tBNcacvkdBfPT3U29N",
-	"GflIh2zsM+pL1OY05HEZaUkv9tMfOxh+b7dunfuHc22i8XJu83Vdnb7H/+CZihZMCUBP7Vaeon/59H0H",
-	"T/7zAE/d39vucYurjSogAKeWSypHvO/z6Xv6N5oIthVo4YRVTLrjf6XkaKdYlW43/HknvTe0hFRKmx+l",
-	"AVKmQ0GCnczbl3ENmzkvQuOLncyDVB1CJpF5PH74kKZ/gv+Z+apNvcQvp/64z0xTpn6vTaeTchNZc8+c",
-	"18BL7//AnswQhkcfD4ZzSWGSjlfTnfJhPvvsY2LhXDrxh5cMW9L0n37ETQB9JXJgr2FTKc21KHfsR9lE",
-	"ekY1dFMUeCnVtQyQO4Gk3my43qGgv1FXYJgvzxsRJ9PgRCuKBsEIgZaG8Ubkjo+8mVX1ohT5bE4JVt+h",
-	"MGdTck2wMQ1nCva1dvDuqfjm4JmYvgtdcXlPRptJcB7IdUDDD2X94f6Gve97aGmqe6kNmv2LEfyLEdwh",
-	"I7C1lqNHNLq/MC0bVP4FbM7zNezjB8PbMrrgZ5VK5Z242MMsfPGTMV5x0eUVbSTi7OmbabUBvVOE7N0F",
-	"GHeYT4Ku4wT5VhXRDUcKZx5dstFe7yt7/uHdH+J+f8ZlOM+dHSevJ9elAN1QAZfDejT/4gL/z3ABKqzF",
-	"aV/nzEJZmvjsW4VnnxxEPtumJMfdRD7QSY7aCtOdn0+DWSOlonZbvu/82VW7zLq2hbqOZkGHAHmzhlqG",
-	"+1ib/t+n11zYbKm0z8nJlxb0sLMFXp76Ajy9X9uc94MvmMg/+jF+bZr89ZR7dSP1DXndWMeBupz66lW+",
-	"kUYhSDp8bo1ysZEL+Wxj3nrzznE5rNHuWXBrs3l6eoqvZtbK2NPZh/n7nj0n/viuIaxQWnRWaXGFJRDe",
-	"zWfbTGmxEpKXmTd6tFXEZo9PHs4+/N8AAAD//0eSWkr2CQEA",
+	"H4sIAAAAAAAC/+y9e5fbtpI4+FVwNHOOE6/UbTtO5sZ77pnt2Hl44yQ+7k5mZ2PvvRBZknCbAngBsFtK",
+	"1t/9d6oAkCAJUlS34uTOL3/ZLeJRKBQKhXr+OsvUtlQSpDWzZ7/OSq75Fixo+otnmaqkXYgc/8rBZFqU",
+	"Vig5exa+MWO1kOvZfCbw15LbzWw+k3wLs2dx//lMwz8roSGfPbO6gvnMZBvYchzY7ktsXY+0W6zVwg9x",
+	"4YZ4+WL2fuQDz3MNxvSh/EEWeyZkVlQ5MKu5NDzDT4bdCrthdiMM852ZkExJYGrF7KbVmK0EFLk5C4v8",
+	"ZwV6H63STz68pPcNiAutCujD+Vxtl0JCgApqoOoNYVaxHFbUaMMtwxkQ1tDQKmaA62zDVkofANUBEcML",
+	"strOnv08MyBz0LRbGYgb+u9KA/wCC8v1Guzs3Ty1uJUFvbBim1jaS499DaYqrGHUlta4FjcgGfY6Y99V",
+	"xrIlMC7Zm6+es08++eRzXMiWWwu5J7LBVTWzx2ty3WfPZjm3ED73aY0Xa6W5zBd1+zdfPaf5L/0Cp7bi",
+	"xkD6sFzgF/byxdACQscECQlpYU370KJ+7JE4FM3PS1gpDRP3xDU+6abE8/+uu5Jxm21KJaRN7Aujr8x9",
+	"TvKwqPsYD6sBaLUvEVMaB/350eLzd78+nj9+9P7ffr5Y/L/+z08/eT9x+c/rcQ9gINkwq7QGme0Xaw2c",
+	"TsuGyz4+3nh6MBtVFTnb8BvafL4lVu/7MuzrWOcNLyqkE5FpdVGslWHck1EOK14VloWJWSULMIZG89TO",
+	"hGGlVjcih3zOhGS3G5FtWMaNG4LasVtRFEiDlYF8iNbSqxs5TO9jlCBcd8IHLeiPi4xmXQcwATviBous",
+	"UAYWVh24nsKNw2XO4guluavMcZcVu9oAo8nxg7tsCXcSaboo9szSvuaMG8ZZuJrmTKzYXlXsljanENfU",
+	"368GsbZliDTanNY9iod3CH09ZCSQt1SqAC4JeeHc9VEmV2JdaTDsdgN24+88DaZU0gBTy39AZnHb/+/L",
+	"H75nSrPvwBi+htc8u2YgM5VDfsZerphUNiINT0uEQ+w5tA4PV+qS/4dRSBNbsy55dp2+0QuxFYlVfcd3",
+	"Ylttmay2S9C4peEKsYppsJWWQwC5EQ+Q4pbv+pNe6UpmtP/NtC1ZDqlNmLLge0LYlu/++mjuwTGMFwUr",
+	"QeZCrpndyUE5Duc+DN5Cq0rmE8Qci3saXaymhEysBOSsHmUEEj/NIXiEPA6eRviKwBHyADhCTgNHwi5B",
+	"M3i68Qsr+RoikjljP3rmRl+tugZZEzpb7ulTqeFGqMrUnQZgpKnHJXCpLCxKDSuRoLFLjw5kMK6N58Bb",
+	"LwNlSlouJORMSAe0suCY1SBM0YTj753+Lb7kBj57Ont/6OvE3V+p7q6P7vik3aZGC3ckE1cnfvUHNi1Z",
+	"tfpPeB/GcxuxXrifexsp1ld426xEQTfRP3D/AhoqQ0yghYhwNxmxltxWGp69lQ/xL7Zgl5bLnOscf9m6",
+	"n76rCisuxRp/KtxPr9RaZJdiPYDMGtbkg4u6bd0/OF6aHdtd8l3xSqnrqowXlLUerss9e/liaJPdmMcS",
+	"5kX92o0fHle78Bg5tofd1Rs5AOQg7kqODa9hrwGh5dmK/tmtiJ74Sv+C/5Rlgb1tuUqhFunYX8mkPvBq",
+	"hYuyLETGEYlv/Gf8ikwA3EOCNy3O6UJ99msEYqlVCdoKNygvy0WhMl4sjOWWRvp3DavZs9m/nTf6l3PX",
+	"3ZxHk7/CXpfUCUVWJwYteFkeMcZrFH3MCLNABk2fiE04tkdCk5BuE5GUhGEaCrjh0p7N5qkz2Rzgn/1M",
+	"Db6dtOPw3XmCDSKcuYZLME4Cdg0fGBahnhFaGaGVBNJ1oZb1Dx9dlGWDQfp+UZYOHyQ9giDBDHbCWPMx",
+	"LZ83Jyme5+WLM/Z1PDaJ4grVS0vwogbeDSt/a/lbrNYt+TU0Iz4wjLYTlTXv5wk0mJcN9k9Dg6ZLhF1m",
+	"0qDSK8GiCYxXljk4cUGqtP42VMRiLGzNnUnb0wnXmu9nXpRYkEjQB/RHA45US74Wkgabo5At2ZZfI4Pn",
+	"UtG+IkWCqaVntwIatFG0ecnEvw7Oeq/xf5ljk6KXILUYxlkhjKU3WPr0GPomwwa3iNIYsKcgQXrrblSB",
+	"ovhBSsHG3/i2Me/D3yd1/tfgezFuhzketmIec+7hTb9EL+6POuysz828DvKMXXT73o2X4SgDXAw/nZp/",
+	"xcRDv0xjOBFEETX9yW7uTq2TGQ01Zhso6DWX5i3P3bH+7e69SGZK2IdeN4cgdeX5zu4xGt9+9LyivS/L",
+	"IHPk3PK7XIR/0uOd6XEa9Ry4BVvbPE6lvwFfa91r0yiUWg7R5nQKxGH+pL17094dWOJBersTXU24BkeW",
+	"U4N9q3npbnj/xWlIhCTVu2vkYF1rgC1IeyW2UAgJJzgNtAuJUxCmqA+BBq+Ncz3mTBU50ttKaHPEGQhL",
+	"IG1VmKR/KFK0YqYRS5iBWT96vYStIs1Uhh/9iIjVe2oeJl86CVibz/FBIqju/ASYwIISkOCHLgxfFCq7",
+	"/oabzQnobBnG6pMaTcM2wHPQbMPNJsGYOvTQjDaFJLAhMQO2jKY6a5ZIf59skTTagWUG0aUNe1qdF8E4",
+	"gAj3bQoqvkgi4JVamxMsv1DHvBPK8jkvCpz64PGngScd/qJg2JjBVljbmA6cj4XTwLMvebYhMTLjRTFv",
+	"jIWqXBRwAwVTmgkpQc/R1myba4VGDpptYs8G8Da1wKLVeEMjGVl1bY3SwLacnntb1GeXRbtPfUUbvoWO",
+	"Hoyen6oiO1Kkan75IqwObkDSbVcPTeDXayR7XTz4GbuoP9HMUrnFORtwELcb/NXXUAtobN08XmUzhdK5",
+	"81qw+JvQLFPaDVGL8Dg5/ge4bjq74/lRqWHhh9D8BrThBa6us6iPa/I91cn9rc7sfJaBTtjpfqD/8ILh",
+	"Z1QZkCKwph5BL38VOdTlTvJDVLmZsAFZ3BXbOmM2QwvzUVA+byZPs5dJJ+9LZz/3W+gXUe/Q1U7k5lTb",
+	"RIMN7VX7hJiWYNITdkeZTjTXFARcqZI59tEBwXEKGs0hRO1Ofq9/oXZJbq92vTtd7eAkO6F27j+TmP0X",
+	"avfCQ6b0n0+gmsQIifMjnkK4o2ioq5XHba1G44p2sVT6bvJj50aVrHGwYxxHjR4l8+7bGptW5cIzo4ST",
+	"jmvQGajxaR4X+7rDpzDWwsKl5b8BFozlEfD3wEJ7oFNjQW1LUZzijbhJiu3oEvHJE3b5zcWnj5/87cmn",
+	"nyFJllqtNd+y5d6CYR95SzQzdl/Ax8nDRuJUevTPnga3rPa4qXGMqnQGW172h3LuXu4J6JoxbNfHWhvN",
+	"tOoawElXAOBd7tDOnCcjgvYCltX6EqxFPfprrVYnZ/+9GVLQUaPXpUZJyrRd47x4eJ5jk3PYWc3PS2oJ",
+	"Mieap3UIw42B7fIkRDW08XkzS848RnM4eCiO3aZmmn28VXqvq1MoGUFrpZMyR6mVVZkqFijYCpW47177",
+	"Fsy3CNtVdn930LJbbhjOTRquSuYD1xp64k2+sN3QVzvZ4GZUWHLrTazOzztlX9rIb55dJeiF3UlG1Nm6",
+	"bVdabRlnOXUk4eprsE7gFFu4tHxb/rBancaWqmigtKLM4EzMtWBCMgOZki5+5YAE4Eedgp4uYoJjlR0G",
+	"wGPkci8z0redSl+YFo62QpKrqtnLLJKUEMYC8jXoCfiYLgkNocNN9cAkwEF0fAO8sJvnG8iuzcn5cDx4",
+	"UhGVmDzFjB98/eUVO99Q6/OMmj9A4F/R2r6UVu9fa6VWJ9hPepcs0jc8burG686a57n/I5KHas/Gxl1y",
+	"byHFg8ZCQ3C2gi+hCDMU3NhoGue1XuK6WcZlbT63ujLI+ZTMvMjvRhGGEeogp+cxjuPCzZZeke25R+jv",
+	"RIM5aVuswnt8K2y4GrVSlvFCyXXzlHCPjggPTdRab+GAezZwbJxXeC3qBJOn0kgTBBSpHubMH6al2rnQ",
+	"h3ntLgCSWS2AXcMe172sRGFpfZN2RclCSFj4ic1EanC9AriGbYSxSu+7xOE2w2HTMKvm7BfQijgDfTJO",
+	"T1RqyLkFJuwxIDuPVnrOHQk29XQPwQ8MOlFwGk4DWvBC/AI5+w70NSnysqKiy566NbGSbrMnTYi0O/Sc",
+	"VbaFnGjxWwcAzcT9GXcEt+QFlxkY1/0AziZCOHip1OTlNizNGub1cxwYnbQQ3AOS8eRDfD4jH6wFYRWF",
+	"FacBE0pOJCPq7jcl7k5H9cMQklWWF+Y4puL6ME76g6l8O3lHe7oKrC3Q9bwdjxddLzXAh3A/wJBGDv0U",
+	"eeF1OD/ciwieUviaC2nsCPmfNVcveU++gMLyr5S+avR8X2tVlScXJ7pzThWD6hU6Is2xb3BOE3JdtGOt",
+	"1wh7co2/y4Ke19YWtwaCniTZV2K9sZFi/e4i0CiMqVkOERT26RsXv1c5PkJsZU6ggmoGa15GSLPxe4gv",
+	"VWUZZ1LljkNVJq2cGhHBXDRjzF0dc0KxApC6Ml7haquSUazeiIy34JnjGgtCzQCrakLMXCs3nYv8LDTw",
+	"HKU8kI3c5gOVaJGcAg1t6/6qyiTDj+AqtcrAGMhrFnMItNDOCY12BE8EOAFcz8KMYiuu7w3s9c1BOK9h",
+	"vyDZ0LCPvv3JfPw7wEtc/gBiqU0KvV3DYx/qadOPEVx38pjsnEnTUa1/BZQFWBhC4VE4Gdy/LkS9Xbw/",
+	"Wtw1+xtTfJjkfgRUg/ob0/t9oa3KgWQPXr2PmiHcMMmlCgqZ1GAozC4Ov4xbEi8zuIKIE6Y4MQ08IFu/",
+	"4sb5YDEhczL+mkaypj40xTDAg+pLHPkn9zE1dqakAWkqU6sxTVWWSlvIU2sgw+DgXN/Drp5LraKxa12p",
+	"VawycGjkISxF43tkGa8OwD+4jd4dOFxiceTpj/f8PonKFhANIsYAuQytIuzGAe8DgAjTILp2YW1TTh1l",
+	"P5+VT8qFBp5t+FIUwiZUF6+fvH4TNagxE//mTxLO0hzMsloWImOIBC3B4rHLwYLehsv9orLq+4urZ77l",
+	"nJVa3JBrhGaVvJbqVp4xyjNEGRo2IJmupJd8yyclk2Bvlb5OKmKMVWWJbNAuKlkjZGj/L13rC/tj07Z/",
+	"arhtlpkrMORC49u7L3DrSMblcNhwwzwcwYRN9i0Xs9nfDOQyCyNkBouxI006b2wVn+2D3Kcq15rnsMih",
+	"4Ilt/tF9Zu7z2ABEyo3+X1lYuGD8NDU3RzS8J0eGVjRe4jb4XjH6wjLkLfjGaSjf9z4wcg40dorr+gPy",
+	"oB6K5kpuURiPlj3oU0vX/I1CM12gBwLZX1VTAB7AQz303VFBnRfNQ787xX+D8ROENneYZA9maAnN+Ect",
+	"YMA47vMcReelc291rpbkfTDInw/wkaEjO2Cpf821FZko6RH3LexP/qbtTpB0nUQuzAVaXaMP7n1bxv2Z",
+	"CyPvjnm3N+4kY2Qf/J41MrGcEALQBv4a9qRMeO3yk0Q6nFM80hOjMuHSDiGgIesB5O10KrDjmS32eBXa",
+	"DezZLWhgplo6J9a+gwm6qsYDJB1WRmb0/nlJ77hRh8FLGipaXsqXyz12xuG76rx4Wujwj5xSqWKCybCH",
+	"jCQEk7yHWalw14VPgRSS4ARKagHpmXaxD+D6qyJGM62A/beqyGCFlF1ZqIU1pUlQwL40gzDRnD4WtMEQ",
+	"FBTVUGPn4cPuwh8+9HsuDFvBbcgb9vBhHx0PH5KC6rUytnW4TmBQxOP2MnF9kCYaLz4vFHZ5ymGnfz/y",
+	"JGVvZ/AwKZ0pYzzh4vLvzQA6J3M3Ze0xjUwLeLC7iSu/anuI99ZN+34ptlXB7SnceOCGFwt1A1qLHA5y",
+	"cj+xUPLLG178UHejnGiQIY1msMgok9fEseAK+7jkXziOkMKKkHNhKkDw0vW6dJ0OvJ0bT1Cx3UIuuIVi",
+	"z0oNGeTODUEYZuqlnjEalmUbLtf0YNCqWnvnUTcOMfzKOJ2TrmRviKRQZXdyQdr71AXgAxU8qydxCp9m",
+	"fdW/e8Dc8no+yFv3wsQ96JpCkl5D89ngUx6RetM85R1y2rnbJlwGLXkvwk8z8UTfEkLditwDuviKtwUP",
+	"E27ub2OLaIZOQdmfOAolbD4ORROiHqHYn0DocQMxDaUGQ1dUrH8z7qtaxXkaQ7DI3ljY9k0UruvfBo7f",
+	"m8H3ojcQbpWEfTI1sZDwHX1M9XbX5EBnEliG+nbfIC34O2C155lCjffFr9vtvcyeV9oofYprLnMjpU8x",
+	"OVz5FnMXC+QUO5JvYTprqQE+6HwYoLmP25iX3hDEvLUCnD1ibxeZFTfC7k+DRNzBsVsGeWutj83UTcBk",
+	"V4jgHiomZA67s2FV4x8kxKL7OJj2DOw9rupNmEAhEao78x8pVgWNn6qyDeRRPPm8FbentAs+n6P2LwqU",
+	"bpMT3ZZds7j5SulT+Wu6ASfjeIKbw0Fk+ynvehoxMLDvv+AzKnYvYzOv6U4QwlUm6P30MjdzH6PpXB68",
+	"s0sb/U2uhxPcg91xO4b6OFkvGaKgKBlnWSHITKWksbrK7FvJSV8cLTURYRIUY8OmkeehSdoWkzCV+KHe",
+	"SucdVGuRkyd4BQmV6VdQu7Oaar0G8qts5fUHeCt9KyFZJYWlubZ4dS3c3VWCpjCPM9cSo2ZXSBNWOa+p",
+	"ZWXbL3FKGGosGlqc1wBOw9TqreSWFcCNZd8J9GXH4YJHcrg+va2gxkKad65BghFDLoZfu68UZu6XH7uK",
+	"+c4hBLDvbtUkLf//PvrPZ5isnC9+ebT4/P84f/fr0/cfP+z9+OT9X//6/7d/+uT9Xz/+z39P7VSAXeSD",
+	"kL984bVUL1+QKiIKnO7C/sGMjJgDN0lksat5h7bYR5S62RPQx21Ftd3AW4lxBFah+6wgV7u7kEP3bumd",
+	"RXc6OlTT2oiOYjqs9cib6B5chiWYTIc1nsjBHBefThyLGxlywWIrtqqk28rwEnZpW4KXs1rN6+TArm7I",
+	"M0aZYzc8RKD5P598+tlsXmctbb7P5jP/9V2CkkW+S+X1zWGX0tvEIesPDCv53sCAq+mAx2/tQBYPuwVU",
+	"+JmNKD88pzBWLNMcLmTQ8PrfnXwpXbg1nh/yo9h7K6ZafXi4rQbIobSbVD2B1qOJWjW7CdDxbXM+w3Mm",
+	"zuCsq3/NUXfjYwYK4Ks4NGACm6jPgSO0xl+2xnq8kKM8WjtkGQeb+8v/9KEufuAUXN05hyNcPMPE4Jb3",
+	"Id9wnBQ4odaq88FFXo/IzeIMH2/lW/kCVqQJVPLZW5lzy8+X3IjMnFcG9BfOg/1srdizkHTpBbf8rexJ",
+	"WoOFjqJ8kcGl4Rr2KfJ0xSv6I7x9+zNaWN6+fddzAOs/5f1USf7iJligIKwqu/Cp9xcabrlO2aFNnXqd",
+	"Rqbeo7M6IVtVzljhx2d+/DTPO5z9FpdflgUuv5Ubjjo5N3pjVZ0dJEq4hvv7vfIXg+a3QcdZGTDs71te",
+	"/iykfccWb6tHjz6hPCtN6tu/+ysfaXJfwunz6NLCnYqHAmkXmITfJJdvgZe0+yQvb0nfWBSMurXekiH6",
+	"mYZqFhAloBvYAAfH0bmqaHGXrlcos5ReAn2iLWznnrzXfkWpQ++8XQfSj/LKbhZ4tpOrMkjiYWfq6is+",
+	"UMC5fKFRFQ+BL1SzhBBsRgUxYFva/bzVXa1agmaU1plqy7h8LxRBRsZCrDlT5tyL4lzuu2nmjQv3pkHf",
+	"wDXsr1RTHOGYvPLtNOdm6KASpZat3Ij3zNwZyOJZTRehz/BBdiLvCQ5xiigOZYYkRHCdQMR4gsjjF4rj",
+	"3Yv0U8sTMgNpxQ0soBBrsUyVxfuvvm06wIpU6SsB+VCHekCD5mphTQgN8897jfYuRoFNqlSGF67KWdKB",
+	"it5DG+DaLoHbUZubjJOvBOiwP7vFk+W07RQtCTvcb2FJe45quNwrilwbHyJxNuzk6gCH/I7whO7NS+Fs",
+	"8K3rUZeoABRu5Rq79bPWa4JjOrva1N8pCeJaq1vcF0MRso5SXT7r6H6pDF/DwNsltqRPTHrZsr7TIIck",
+	"kqQMgr47bVGjJwkkQXaNF7jm5BkG/IKHmJ6ZHa/vMJNz1vD2W3I29QhbFiTA1u7xbu+5bnk0yPUYaGnW",
+	"Alo2omAAo42R+DhuuAnHMZ9HXHaSdPYbpjgaKxXzMnJYjoqU1YVgwm3Y5aC9d78vGBOqxITSMPGjf0KZ",
+	"FxeIWKW3Q0kSTXMoYO0W7hoHQmlyxTcbhHD8sFoRb1mkXIQjBXUkAPg5AF8uDxlzdko2eYQUGUdgkxMS",
+	"Dcy+V/HZlOtjgJQ+1z0PY9MVEf0NZ4NBqiRYLKh8xUIM2P6zwAF8YsC4DEYrbCNUwZgzZHM3vABZh+k3",
+	"g/RqLtCDolOfxLvBfTz00BgxE7sr/6g1UY87rSaWZgPQaVF7BOKl2i1c+qTkW2S5WyK9JwOksFfyYLra",
+	"MA8M5SRA10q6WlxAzgFYhuEIYDQAUH0FXDv1G5KzHDBj047LuSkqNOyjWupsyGUkE/jBqQdkyyFy+Siq",
+	"rHEnADpqqKZ2sldLHFQftMWT/mXe3GrzpoxZiD1NHf+hI5TcpQH89fVj7VoY3zQ1T4brKvhGH6YISF+z",
+	"dJ/iLK4zAWKOqs3SJYcWECNYfd2VA5NobbXq4DXCWoqVMCETRsk+2gwUQI/gRUs0XVzDPv2WB7rHL0O3",
+	"SFlHu8fl/uPImVfDWhgLjdEo+Oj9Hur4JkvC4OpsqVe4vjdK2VRuiniZH3wFFA1Dfg8Lsrgll4CNvjKk",
+	"RPoKm6Yl0NZmM1f8V+RpjkvTYmRoLooqTa9+3m9f4LTf1xeNqZZ0iwnpnCWXLmtQKohgZGoXZzK64Fdu",
+	"wa/4ydY77TRgU5xYI7m05/gXORcdBjbGDhIEmCKO/q4NonSEQUZZLfrcMZJGI5+WszFrQ+8w5WHsgx6j",
+	"IbfG0M3vRkquJRRPeO01NhcaYy+LxJJqpQ7FcyEXh9K2szMk1jc0HMVKt+Jp28Oje3Iw2ufzTlwjo0Cr",
+	"wPqit3zHeyU6MsekPau1OMNOEJmG3AWOpIfLxRpMDVehyCHspzdfMVXZsrKdmTRrxktOV4IWauD97r61",
+	"R+QpP4a0VrgS0rpCtwZkso7BVV+pHeC+g+r5FjB3SnoW920acqatqhtR4vBYr7WTr6iZaFYDOq9pePT8",
+	"tMub9E9PaOdy96Nkb0DftI8PyyvtgpodITN/GF3GR1I5bYGbyr9c4wNRB1tj02ZAkI6Dtw5H+4Tme8m3",
+	"Ilu4ar4URqmqgf1xbZhvw8BYsaVnST19g0Hm0RZyrXXP8BkqJyigu5JWFAwkxUj0BzAuWCJTBTJ+GDjf",
+	"IPOB5Aw/SrFzaOngrB3N30UXObX6fQiQ3m5EAQ0yvdY7hHKnAbsDXoVksFpR7XoZjvejHv6GPE/o+Juk",
+	"90nDX03Dv4MOpKaP4+r6dC+OhB3ioPLRPfzvwrKQ+59230fuELvRYPDplMDuT87cGRq4tAj3x+5VGDGF",
+	"V08vA8Wc8AtbCX0CINxo04o2zVq70qP/mERbGI2WM8poG4wkRJSb1i7Um3AqEaUZ+ZbXW/yBhRNqS//z",
+	"51iTU2OdLJQs0b4V9UPpfamsVdto8ruIFvXq73RQoUzPgV9OMcOIy2G9a9jmGTNqZedUggVxhnw+iZgx",
+	"QcUpTNviSkiVwLNNnam5Xk90hYBsf4tpKbibLSuZF3A6aQex78fqiDyThJurocvror6zakbDQ6f7nbR4",
+	"2BOcsBx4npbMSFr3X2s6dLNPu3JX3Nhhu7r1VX04W3FXjA6DevZhirRtfMKZjIzQHWyd/nSecK4DFFpv",
+	"k0frAfqMKooloS/Ueg15qJQUnDllVI+qneAZfx8pv3XGXBUsKmI1Uv/K53OAoWwOka1qQfFbA2+uppmD",
+	"vNkEqt1Fk6xBukIAaZ8GtT6QK4JaRALBB3bk7Uo0yWj6q44ndhPm7nap3k7agAJ47g1qBsL6xnVK/Q3x",
+	"qJsPxeG3qkiO639oQKIpYU2kFO+RxYD2kJelyHcdr8mxuL+JRoJmsAFTAenF/GAHMNCOpk8SXNP4gWE+",
+	"Zt97h52TwfYcTYq+UL6LUEf65plPUenfxu0Q+d65agyNE9f+7U+XVmm+Bu9CuXAg3WsIWs4xaKAO9dqF",
+	"i4XIxWoFseuguYvbWwu47nFLUdzFBCI7iseLg+engfEwytIUk6CFIYfyhDbLt439IOorIdqaOyi7kgkt",
+	"v4X94ie0mLOSC22aOG/vM9nWHB+x6zfbb2FPIx98qiFgB3aF3CbeANFgyk2t/mSikNgHJsaYs422tvCI",
+	"nbpI79KJtoaX5TjxN7dMvKLOUu5zMBoPf4Rlym5cph3r8fRAG/FdUj60CSI/LINExqp4KkEO6umrqM7W",
+	"eoh2sURTIF5azqyOD7mrG3vqNvMjHsD16/oCTeKZwiSdW3MrKuVIlPMSg494sfDO/kOXv1Y3/vKn5iE2",
+	"4AOb4dKUffXlxavXHny0ihTA9aI2Yw+uitqV/zKr0sCtOmAYcYWDvZeOc3OINr8u7hoHCNxSkeCOpwTK",
+	"iZ64GhbaHS8EDKzS0doHeZ+PU3FLHIlXgbIOV2kcdqlzJ0KF33BRBE/ZAO1AZDUtrokROporxAPcO9Il",
+	"ClhanJTd9E53+nQ01HWAJ9FcP1DRt/SLQ/qScMSKfOQKP7n09JXSLebvU1wlI19+O7EKhWyHxwGtn/cz",
+	"7glTZ8wJXn9f/x1P48OH8VF7+HDO/l74DxGA9PvS/07vi4cP+0C72y7NJMjFQvItfFynCBjciA/7AJdw",
+	"O+2CvrjZ1pKlGibDmkJdCEtA963H3q0WHp+5/yWHAvCnsymP9HjTHbpjYKacoMuhlFZ1hOSW7zDTgGFK",
+	"dgOCKZsakhYxe1/d3XkS94+QrLbkfbswhcjScQlyaZC9ShcJiI0ZNR5QNOKIlRgILJWViMbCZlOqEXaA",
+	"jOZIItMkCyI2uFsqf7wrKf5ZARNkyl0J0HSvda668DigUXsCaVov5gemPtHw99GDjDhLBl3QmBJk1Pn0",
+	"Re0QGRZae3Fz2QrjOSJ8OZ6xx7hHQo89fXhqdqlYNnAnY4vzIk2qD7z7a2B03tN0YI61WiBbDP1comFh",
+	"FiutfoG0Fx85PyYyqvqJ6DlCvVOq9S5LqT2iw3ri2Q9t9/S38dDG3/stHBZNv5l2PorJl2n6VB+3kXd5",
+	"9Jp0IdT5LD6SabjcR9aOax9gLXS8okhOssCF0Bku3Xly6URb6VHSpzJqYc7d+M2p9DB3dzUr+O2SZ9fp",
+	"txDCFG1vK8jHKhY6hw0wdbJMNzuLwo/rtsKVJChBNzaIlA/bnd41btrJL5rmAYMdW08XV3uTF0Ylhqnk",
+	"LZcWgg++41e+twHnP469bpWmSikmHY+UQya2SXXs27c/51k/9iQXa5zJ1RFhfGV9mQ0/EHPlWIiKcmHK",
+	"gu/rFLAeNS9X6K5Tn8mwG7m4EQajcKnF41Bb0dB1WRsx6y64PJB2Y6j5kwnNN5XMNeR2YxxijWL125OE",
+	"vDqqbgn2FkCyR9Tu8efsI1938gY+Rix6IWj27PHnFA3i/niUtqyueFXYMZadE88OFtE0HTs/BhoDmaQf",
+	"NW0eXWmAX2D4dhg5Ta7rlLNELf2Fcvgsbbnka0gnF9gegMn1pd0kX/QOXlx12hyM1WrvS0X25wfLkT8N",
+	"OJEg+3Ng+KKUWx91ZtQW6Skw0nDYwnCuBK/j6TVc4SMFb5Yhdq2j6/rAzxi+TdMDpxDb78lGG6N1zrgr",
+	"j1OIxj/IM8Qz9jJU36KSw7XLhsMNzoVLJ1kStxCjBbSQlvQflV0t/oLPYs0zCzpdHBiHWCw/e9oH+Qtu",
+	"4LOndRVPeRzgHxzvGsiLNYl6PUD2QWbxfTGFm1xsBbL6j5sEgdGpHIwyTU5rh4Iax4eeKvniKItBcqta",
+	"5MYjTn0vwpMjA96TFOv1HEWPR6/sg1NmpdPkwSvcoR/fvPJSxlbpVEnN5rh7iUOD1QJuIB/cJBzznnuh",
+	"i0m7cB/of9/gnSByRmJZOMvJh0Bk0RzL9IZS/E/fNbUBybDq0uh0dIBKJ7SdXm/3gUPljtO6de23LtqJ",
+	"vg1gbjLaaJQ+VgZCx+nnps/v4S/UBcnteUvh+PjvTOMbnOT4hw8JaNQ7uqZ/f9L+7Nj7w4fpSlZJlRv+",
+	"2mDhPi9i6pvawy9UQgH2hdo5Lhwcinxyv/7+DV5S+AGZ4NIPNWfLFn/58FLEaZKTpEMl06cAIyPxS8AD",
+	"/dFFxO/MLGkDmxD74cP+hdq98KtTOk0yef09CtLm7Au1m0o4nTsoEM+HDzFOb2gCPL+ndEtrsJWWkDfX",
+	"NX2tFWouz0QoBkx5rI8uk0/oGNifibpCglutOvdW0nfgoPNKdGBw1CWgr6tp1fCOjQt/4E3vG4Jm8xFs",
+	"V6LIf2qypHduNc1ltknG2y6x49/cg6ElDzi+ncIamj8lFMnh3EP7b+FBnlAZ/ENNnWcr5MS2HVz55XYW",
+	"1wDeBjMAFSZE9Apb4AQxVtsJqOsEh8Va5YzmaWrQNpz6bJbYqxewrNaXLrGhea1TSZPdsNvKeidail7w",
+	"qXtXosD/DRixqeVCczvg9a8pI9CqGdGFZjqdhxsdNONiS1KC4VgYnE7mDaCzInZVEjrdKRk5jdwKWCjx",
+	"E7Wk1I+KITtiarWKlgHSCg3Ffs5Kbowb5BEuC3Y09+zZ40ePkjo4ws6ElToshmX+0Czl8Tk1cV88G3QF",
+	"Lo8C9jCs7xuKOmZj+4Sj97qSbxyvTvFU+uByQGFnEiFy6sRA5qTDPWNfUw5hJOJWuQ+Epi6N1S5NUZWF",
+	"4vmcSnahmxBzs7o+7pJhORL1GuHvkH/S1jO9VEfIkTyQg3b6OONJMV0JGgrYM5Zvy1SWf2xxFRow0XEA",
+	"IqVijJ0z9sLpc03QFrpJGBV+01vIWT2d1ygQceB/rHWRUVa1xLFhXtlUJB6qlPHatwjsrDEjRXl8bsJH",
+	"YtgIt/M0AFbJHPScUX2cW4FFuDbcwg20CwsEMOqa2L7QQHt5oY61kGdHSMZ1afJj0R6Ao3FrD4ckZB3E",
+	"HxtRpCqdwXSadOf5knqlA0M6dYM6LgghTX0oHMe+85aOjEslRUYFPlNiPSVBn2YznVALNW3sNDN/QhOH",
+	"K0GvjcgfsOjX/26QEXrE9f0Poq+4qY463J8WA9zJvLcGazxng3xOGixRgLfOCWnAF59HIor5pNIJD6tk",
+	"VEbtzXEkGVF+4wF161f47XuvjMcjyK6FJLWbR1uIvSP7GWaERGqXTFi2VmD8etqhReZn7HNG9Q5y2L07",
+	"e6XWIrsUaxrD+fThsp0Da3+oi+DO6t1Hse1zbOsrQtY/t3zT3KQXZeknTXECU+9w7xNWPRxCcMqJKni1",
+	"RMitx49HGyG3UT90uk+R0LBUqIsgxHu4Rxigdeq5ioVCK0dR1IK53EQppKQjOl8JGey56QsiS14JtDF0",
+	"Xgf6mUxzm21abOiQ9+pANAbFyGfXpxiqs8E+erLMZmGO4W282klft3OAcdQNGomfyz0LhwKpOxImMBaz",
+	"9gsmIaitmpZ5LUTlFOnkg52dWJZmHMi4FyF+s4Wug7GEdXeqMXvsTTSU7X9Z5WuwmEk+lST6C/rK6GuI",
+	"WMM6t1VdWr0OVWxX+0rkBHATZUqaajsyV2hwz+lyYbgxsF0WCR/WF/VHyOsdRkpDMw/+m6orPrwz3oP7",
+	"6PxWwV07P67EXT9fV0rqRZpeYCbj6ZigO+X+6GimvhuhN/1PSukhdvgPERrc4XLxHqX425daKx2XwOk5",
+	"y7urpa5QQ47pir6H1MF1bYVOviTuiLY3p9+8xJZ1gA8Nk4Df8GIgp1xsuHH3qzNmDGWWywYTIXLrE11b",
+	"zkZZ0GDyYOe43DEF9e2ZQ87Kzlf5dCYUv9ZRhA4bEr9tmQ2dw1rDLAbNhXez6DUbfKxJ7xvghd08x6Ij",
+	"A1qeuoi1alJZ+DK3G+rsSpaYBFGD5aIIGQmFOwavW01Snl+9ct5Op47z0kyU2gScPEf28jkzVbZh3LQS",
+	"P5k5KwE08wn2lSbnK7aFNScSS2qAouPWzbyxjyDIRe7ct7gZ9sVJ47NxvvGDPWMZCoBVOQ9v5kXB1w54",
+	"M2e5MNdzvGmza9ALp1dlVGI3yip5NjsiK/3VBjpJ6D0cuJo5u+VaEra4KM6mmpP8XAcozIyRmDmOuLJ6",
+	"wEnXUkznxyUTaxVPboPolHhpNjeG/a0ylhlU7iR3wtxtK+ps2eGh71GU2pRvb4ZyjIZCt/S9W976Gnw5",
+	"olLDjVBV8AANcRhBE+R+9TmsW4VzB9heMrrp97acDpoF6RzDrV+m37pvf3KeIAyk1fs/gNW3t+ndqsyJ",
+	"Ry61iO4pr/nqscoBXVZLGJ5SBDpVb9g/CYOK3EkULVrq1W/ukdWLKa+AHj7ez2cv86Pk5FTN6pkbJXXs",
+	"XmFqLyp5+Q3wHPTrAyU9mzKedMRKZUT9CGMFDhZystFwZ1MDnpCARVyStD9WcIS/gcwq3XLw1QDHFCjF",
+	"yYKt98/SnsNatDouzFf0HCvjOZ+1cuR/C/vRlfF+5vEoe/5gDsOhopUXdRiHi0JFgaxOGdXJ2zA5epxy",
+	"k4qbdqLtVAY0GWURn9ep0CxlPG0Sv4s6lpIK4x1vbGgAKvgd4Sn46cAZyqVxDfsHhrWo4eWLaPxeIPFd",
+	"Km8RBpzlOyTaHLIfeVcYYWrKICyEsATXHZrqsoNF06K6BXecK5AkXhxNLYORKW+UhTvOhV2PqptCYYFD",
+	"yeBfu+Io0XU5rHZ44V5Z3kmX15W7YuUc2hm6ladvfeUvystfm0xDDTAw4bdQhMPNUojrOFexM1Bj3ZbQ",
+	"4iSJ6agZquhTQK/qmUUTRNb3bervsYvHzAqFYsRiKKi1HbdVOz0/MM47vUkiRnCtQGvIa0tooQwsrApB",
+	"Z2NwjKHCkAv+nZBgBuuHO+AGa8e9aYrjbUWmFadacdx73scLZBq2HKHTUQm74TnHkP3cfQ+JQEId/YOK",
+	"5ZpeFwfdPEP4oDA9JMZUv2L+tjycYOQuOmYhJehFMDh369nJdlZIKlyTV5m7oOODUevhJ+fvGmElSfVs",
+	"1l9l540QJeq4hv25ewT5lB31DsZAO8nJgR5V7Ols8km17iYF9/ok4P2+uSxLpYrFgI3zZb8IX5firwX6",
+	"ijFVNWE2XpcRt8RJ2EdkWqudWG43+1B0rixBQv7xGWMX0gU2Bn+WuAxgb3L5wI7Nv6NZ88rVxfS69LO3",
+	"Mh0hRhUr9T25WRhmnIe5qg73nMoNMj6R3ckhT7tbqm4JeYzTs6mv8r6HSTdhbkNUDoqUTHLpDNXP6aCn",
+	"FEeUhiXKF0T+C5x5AzczhUrFE9wlVQwOlcZUPBkBZEFOyVhSQ+EHTyLAO+8dSEvqP4fEm2rFNDS+I3fN",
+	"QOqTejrWbIZe9N2Z61na/G6lNMQzkm+qyzZcB98hwyGPLb0UVnO9v0ue0DaqUtqTQSwf9MKsHTCbhTRO",
+	"mH0cFoW6XRCzWtSFYlNPW2xn2pexL2nYFJglz7clRO6c3HhBbc82PGeZ0hqyuEc65txBtVUaFphVOpnt",
+	"5ZVYWZS7txRoKjH3MFNlpnJwBZfTFDQ0VyVRY58vapocRIGjHVyp7xPR8cQp8U515uMFiVoH6xOGzb/C",
+	"Pi57RpNZzi164VwYBgIVwPhMch5DrnEfXiIcl3qpq0scKriyI7oBnTryK2Y1RoX4FjR6i4To4FOpHWGM",
+	"A6WmpVtRFJS8QuwafgC1v1IatQNi70vypr4R5HLXTmRCPViJd16d3SXmAZdx6jVmN5oq5zQVPmo4w5NX",
+	"V/5BHI/yo6nIK5LiY3CKp87a4V6abqRmyY2n6UeZklarougUL3J04zXt3/HdRZbZV0pdY0KSj+ldK5Wt",
+	"V5rPQ46Hrk9wM9NYKZadXBANmMPpwl07nCVwgckMssPiekrxQ1rmCMx3hznoYZ37RX9h3XW1mWn6GYNZ",
+	"la3aiix9pv61nGwHXWNTLCqFCtfDHXxHxHTY48uq9qkiFtlHM0gk2NR+eUbgfUuI3eB/SQLvjstWwG1v",
+	"7uii7DMXL0UtskFZrwMAQSrk2gcr4P9akljNVdTapWshz5guoBNvFXJAvB9sOMLJgbJwL6B6Ts81gB85",
+	"5cPc5bd0DtQYNOe/f9wkwLwT8O/HqbzFPIY8Oy8b0vIOIyFZ1gBHSKfZH3WDvKLUG8upzpAmWLAm3vAR",
+	"AMPukS0YJjlJHgsG2vqxQLUduNxJRzWPXto+IjMaPRTmollYxt2FjfYRLopKg0/e5ER83bZ/ldxuwtWJ",
+	"zfuaZNRK+qjWX0ArsnPn88j+AoWrSdhRBqhyUcANtLxGHS2bikRNcQOhr6k7sxygJGtkV0eWcoeM7/KO",
+	"4sSvfTHo4ZPGblKT4hDrdoodUJMklTo7uXDHxEw9SgjRjcgr3sKfOVbkaKsB8SgnUNV7IyzCO3LqND+6",
+	"Ed6EAS5C/5QoEzDxbhofOpoFpVE3xoAOukdXZujUy7R3dJwurTaw0Gx5bYh1JN7wDVPyWzmskOyTfPPc",
+	"mrhPQskIsV/uICOpxr93IPcvngEjhc+8RNQuAXL3KsAuCW37BiSTqnn2kDYyPFWaPK7hBzcxNRLSv6bv",
+	"YFRunJjvv7OMBmOmk9Bx8CGhazq9u3r+dzmJowdxcLwUjRjwUb8j+q9A3f7ZQQ1UVeRM4n6i7L/hNxBu",
+	"Mc/F52xZhYFQW+GKxMXv0BcQ7KBKxiYgt6KQCdE5HxK63Q3WV3WIKEwFLfhK0z9SWfbPihditSc+48AP",
+	"3ZjZcCQhb3iti7UJKAjScfFqHgDzIOQqTOXWLaaOGQ23x1EioPEiDwXGFNvya4i3gZwdHP/MLDJOUy1J",
+	"c4FXdmc7+1jwiw9porY8j1/6lKx23+IOIX059v4/mxDYeKqQY7IseAZ5q0xam8+gMFQTl93AdjxGus/X",
+	"AgmEVhHR6pBUI7+DyvRI1pUKPBoqAdUCO3pGtCtAnWYZEzW/nTo/I9Hlk5Zy6l04rv5nBDSZ7kOizwPg",
+	"uwTNvu0HwX8yj/TQMqaA/0fBe12AbRheavIhsNxKvJOA1Wmrl2q30LAyhxxMqDU953WTsieoWIXMNHDj",
+	"PG5e/uAfnk2aZCFZiKxobJr1KDmshGyYpZBlZRPvGMqWLPcRwmKlP6F1wIQ2JCWgMHnDix9uQGuRD20c",
+	"ng61ipM6IyTB0OH7JlQY9Z3aH0CY5g1HYdmNGj1uhhe4K4Tn3DWN5TLnOo+bC0nFfDmWKeV7c3eLUm0c",
+	"OGRT4pE0004WElmXiLQdIMXeG4Xvae+pAeQnNPxMMNhcbcBTf9tY41Q7Vg3YZ/ow/EsYbLZ8hzY+Ch4e",
+	"Cgtx+bHJwkfNKOQKpSiSz6atO8xjxC8wPg2VBvGMyCqadcoU4+f+B9pKekb+KIUdPflOR9mN5nZ+t+5g",
+	"BqTKdeP874ilfx7LLD1Z2Q7CryNtfKhKoD2INhEG7ENtvfjALpIbhM/eECvBp5dcbHtapML8nWZgQRoD",
+	"M+Le36SiI1wbr0rquZt1VQ0OKXOfJOFITZvTz4d7aQA8H9Tnznp72tplBsc5pk7leFqERanKRTbF59NV",
+	"D8odAAHSNozDMV8wTh21e4yp62nF1NgurHVsqc7Bwl6HrF1lNvboH1ITDXD0tglCrYiX0RF2yjGlY2XK",
+	"vBtj1laD1UyCcaYhqzSpiW/5/nDpw4Gs9ZffXHz6+Mnfnnz6GcMGWJkBTFP5oFM6sPELFLKr9/mwnoC9",
+	"5dn0JoSkIw5xwf4YgqrqTfFnzXFb06Q17hVOPEa/nLgAEscxUbLuTntF4zSu/X+s7Uot8uQ7lkLBb79n",
+	"6KaRrjxTy1UJA0pqtyITCr5AStBGGIuMsG0BFbbxiDYbUg9S/vEbl0RKyQyC/thTgbADLlephQw51BI/",
+	"w0+h2D+DXVl4XnUbIsIH1+XfaU5DR0IjecWgFkuVXrQXK5aCiCKIdBRZ6xWfpBGPfGRrZuu8ZVOE6D3P",
+	"06QXF+0f5/btgtI2zelxExPiRTiUdyDNIfvEcLqSu3CSRrX/h+EfifwrJ+Ma9XJ/C16RfB+MxBxf9Pwe",
+	"6twjk0Dr5+JIkAcBMBBt24qTjALFomTo2lkJyJ4QDMhd8eO7xrB8MCyEIAkdDoAXh8827epIBg/O75xV",
+	"/LsaKdFS3g1RQmv5hyJyA+utL5Joi7zSxFowji2pvlgYhVub53UU88CrpBfsrJWyTEnUjSSCpJ0eh85U",
+	"TDhCWtA3vPjwXOMroY29IHxA/mY4NCqOlI2R7FBp7pae8xWfNHfBf4Op5WsKzP4vwD1K3nN+KG+E791m",
+	"pNzhhXOvXtXWaJDslsaknWaPP2NLX/Cn1JAJ0zXu3wbhpA4MBY3WMZoCc2OOR6IeWudPyt6DjFfBE4d9",
+	"H5m3apu9h7A5or8zUxk4uUkqT1FfjywS+EvyqL3MnlfapETCCxZUohaJR0l8uhulnYvASpH+Vg8E1E/L",
+	"CmT2MvOjnh1Z2CI+1rcbZfxd6pMnOd+HWAOGgEuA3Nw3V1arqkQKp3HR9QNX8D0L7twtg1aUC/PIDFr9",
+	"cvJTl0froIu8MtBf52QJqIXbhPDTrG1q+rfJdXuwNNpySta2dI0d7E5p405SbOeoUju/QcI4hyM/hp83",
+	"RTE/DaUQd2myB8ocdPYDKyIctFTGRSswiBkkGGGoLMPffE2wDyufBAhcNov+UXWw3icFj0NMYq2tyaOp",
+	"onIUEypR+G6J5HEUKZpVWtg91YMPSknxt2SOq6/rfCk+305tn/TyhFXXIIMPTZNdpTJBYvla8YLueGc2",
+	"lXizq+KMfemKJfiD8tcHy/+AT/7yNH/0yeP/WP7l0aePMnj66eePHvHPn/LHn3/yGJ785dOnj+Dx6rPP",
+	"l0/yJ0+fLJ8+efrZp59nnzx9vHz62ef/8QD5EILsAA1VUp7N/p/FRbFWi4vXLxdXCGyDE14KTEnz/j3p",
+	"H1YKl09IzegkwpaLYvYs/PR/hRN2lqltM3z4debr7s021pbm2fn57e3tWdzlfE3pFBZWVdnmPMzTyyV4",
+	"8fplHffgfJtoRxuN/NmsIYUL+vbmy8srdvH65VlDMLNns0dnj84e4/iqBMlLMXs2+4R+otOzoX0/p1TF",
+	"58ZXITmv49/ez3vfytLVKMFPnkb9Xy4HXuuP8zrxHf62BatFFv7SwPO9/7+55WtMTkdRMu6nmyfnQeo7",
+	"/9VnqHg/9u089sCZ3PD811bGj/zAFMEV5VCT819DPfXxAX0yjMVk2OsOhyEJ2WiGW7Scs6c2PPe+ilGH",
+	"tQbyhD+3Ygs+I3n4NhHXY83Ol2p3RNPewg91ADO18QDCRra8+2lgCHqnm/NfSSZ9P/T7uVcXDnx0HG/o",
+	"MykEXJsuhrotXUqK9McWKfxqd7im8eGwTTSeTyB6/iv9hxhctGD3BDi3O3lODhTnv4q8/7mHp/bvTfe4",
+	"xc1W5RCAU6uVq/k/9vn8V/dvNBHsStACiZ0Xza8u+985lX7d93/eS2/uLyCVs+lHacBpi1wH96iqQz9r",
+	"nv8yD43x0ReejcEnmDj5k0eP3PRP6T8zXxqxk9no3PPZmZO9DiotW6mk6Z7s6KtreF2AK9izGcHw+MPB",
+	"8FI6P2C8ON0F/34++/RDYuGltKAlLxi1dNN/8gE3AfSNyIBdwbZUmmtR7NmPsnZljgrVpyjwWqpbGSBH",
+	"6bDabrne06trq27AMF8DPyJOpsHgje7COrXaRjRM4glHPvLzrKyWhchmc5c4/B1J1jYlZAYlan+moEBu",
+	"Bm+fiq8Pnonpu9B+u4woMCbBeSCZx5ASor+/Ye+7LghuqgepDZr9yQj+ZAQnZAS20nLwiEb3F+UdhNKH",
+	"eGc828AYP+jfludO3UcHMMkrXgnjmYXTcEZqSBNluKzre3kNZ5tp4CCN+tSclm9EK+hzjhjcuXP2dp5l",
+	"uJrpds4a9oOKhgDNfRiNz33VR/ifR/x/4hFPHCwfHhIf9aV3ezz+bJ//ijO8HxOMX9DvhvE+MHOqec1r",
+	"/ZI3HQhLTicB0ABd+9y7YaPTM581/vazZz8fafMgdRPqUhptULAw1CeQHI2i3e/akt4Ny/G/97l6+ujp",
+	"BxYggq1HWZc59OzP431aUT5xnu51hqP3+KxU6Txo7kpOz82u/PXCcgXGiY74ueTe4zhkYupaCdF+2ThG",
+	"RcJI8ERrsS9j0a3NmRDZZfyFa0wPWlrGM62MoYcN19b0eUdYyB+Ke8xPbl5NQKXrt9UQWF4onD1LVT1+",
+	"94fQVAQOF9bLtzAPf/CCVNKtBOlKewwC14Wg+rZc9uvI/vnK+R/EI0c4FfNeXWuqmt0kZxtUdJRVyr8n",
+	"v+EU8zhdrvHHNFCh54eVLMAYxiVFoQcYIzZXx5S3mZgH4E8e9i/Jw55zSVck3uWMt8jTO1rHVHI2+1OK",
+	"/FOKPCWH/G5IhkTtJ1VXsOoYLpkQLw/Kk5cjumKv8xlSFV+2VcUH+V6j2PXJyxquzDX+1yAn+d+B4Riw",
+	"MZ4TzOZP8eh/+uH/ms40d/s6ZxYw8jvSB1lFql/nAO9oQkgXmDBRDdwq/tTYUls/nwcXo5RrSLvlr60/",
+	"29Z5s6lsrm6jWcjh2Xnr943MdZXE1t/nt1xYdLfzNYf4yoLud7bAC9pJUUDn16aUb+8L1SeOfkw6D8S/",
+	"nnNvbU59I1431LHn1pH66i3+A41CEojwuXGQix3OiM/WrmY/v0MuZ0DfBBbc+E89Oz+nrEAbZez57P08",
+	"/mY6H9/VhBVcl2elFjcIDX7bLZQWayExK71zNlo0PlJPzh7N3v+vAQDvFS/RpkgBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
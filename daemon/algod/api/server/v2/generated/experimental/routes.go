@@ -130,232 +130,263 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9f5PbtpIo+lVQ2q1y7CfO2I6TPfGrU/smdpIzL07i8jjZt2v7JRDZknCGAngAcEaK",
-	"r7/7LXQDJEiCEjUzsZOq+5c9In40Go1Go3++n+VqUykJ0prZ0/ezimu+AQsa/+J5rmppM1G4vwowuRaV",
-	"FUrOnoZvzFgt5Go2nwn3a8XtejafSb6Bto3rP59p+FctNBSzp1bXMJ+ZfA0b7ga2u8q1bkbaZiuV+SHO",
-	"aIjz57MPez7wotBgzBDKn2S5Y0LmZV0As5pLw3P3ybBrYdfMroVhvjMTkikJTC2ZXXcas6WAsjAnYZH/",
-	"qkHvolX6yceX9KEFMdOqhCGcz9RmISQEqKABqtkQZhUrYImN1twyN4ODNTS0ihngOl+zpdIHQCUgYnhB",
-	"1pvZ0zczA7IAjbuVg7jC/y41wO+QWa5XYGfv5qnFLS3ozIpNYmnnHvsaTF1aw7AtrnElrkAy1+uE/VAb",
-	"yxbAuGSvvn3GPv/886/cQjbcWig8kY2uqp09XhN1nz2dFdxC+DykNV6ulOayyJr2r759hvNf+AVObcWN",
-	"gfRhOXNf2PnzsQWEjgkSEtLCCvehQ/2uR+JQtD8vYKk0TNwTanynmxLP/0l3Jec2X1dKSJvYF4ZfGX1O",
-	"8rCo+z4e1gDQaV85TGk36JuH2Vfv3j+aP3r44d/enGX/4//84vMPE5f/rBn3AAaSDfNaa5D5Lltp4Hha",
-	"1lwO8fHK04NZq7os2Jpf4ebzDbJ635e5vsQ6r3hZOzoRuVZn5UoZxj0ZFbDkdWlZmJjVsnRsyo3mqZ0J",
-	"wyqtrkQBxdxx3+u1yNcs54aGwHbsWpSlo8HaQDFGa+nV7TlMH2KUOLhuhA9c0J8XGe26DmACtsgNsrxU",
-	"BjKrDlxP4cbhsmDxhdLeVea4y4q9XgPDyd0HumwRd9LRdFnumMV9LRg3jLNwNc2ZWLKdqtk1bk4pLrG/",
-	"X43D2oY5pOHmdO5Rd3jH0DdARgJ5C6VK4BKRF87dEGVyKVa1BsOu12DX/s7TYColDTC1+Cfk1m37/3vx",
-	"049MafYDGMNX8JLnlwxkrgooTtj5kkllI9LwtIQ4dD3H1uHhSl3y/zTK0cTGrCqeX6Zv9FJsRGJVP/Ct",
-	"2NQbJuvNArTb0nCFWMU02FrLMYBoxAOkuOHb4aSvdS1z3P922o4s56hNmKrkO0TYhm///nDuwTGMlyWr",
-	"QBZCrpjdylE5zs19GLxMq1oWE8Qc6/Y0ulhNBblYCihYM8oeSPw0h+AR8jh4WuErAicMMgpOM8sBcCRs",
-	"EzTjTrf7wiq+gohkTtjPnrnhV6suQTaEzhY7/FRpuBKqNk2nERhx6v0SuFQWskrDUiRo7MKjwzEYauM5",
-	"8MbLQLmSlgsJhWPOCLSyQMxqFKZowv3vneEtvuAGvnwydse3Xyfu/lL1d33vjk/abWyU0ZFMXJ3uqz+w",
-	"acmq03/C+zCe24hVRj8PNlKsXrvbZilKvIn+6fYvoKE2yAQ6iAh3kxEryW2t4elb+cD9xTJ2YbksuC7c",
-	"Lxv66Ye6tOJCrNxPJf30Qq1EfiFWI8hsYE0+uLDbhv5x46XZsd0m3xUvlLqsq3hBeefhutix8+djm0xj",
-	"HkuYZ81rN354vN6Gx8ixPey22cgRIEdxV3HX8BJ2Ghy0PF/iP9sl0hNf6t/dP1VVut62WqZQ6+jYX8mo",
-	"PvBqhbOqKkXOHRJf+c/uq2MCQA8J3rY4xQv16fsIxEqrCrQVNCivqqxUOS8zY7nFkf5dw3L2dPZvp63+",
-	"5ZS6m9No8heu1wV2ciIriUEZr6ojxnjpRB+zh1k4Bo2fkE0Q20OhSUjaREdKwrHgEq64tCftk6XDD5oD",
-	"/MbP1OKbpB3Cd+8JNopwRg0XYEgCpob3DItQzxCtDNGKAumqVIvmh8/OqqrFIH4/qyrCB0qPIFAwg60w",
-	"1tzH5fP2JMXznD8/Yd/FY6MormS5c5cDiRrublj6W8vfYo1uya+hHfGeYbidSp+4rQlocGL+XVAcPivW",
-	"qnRSz0FacY3/4dvGZOZ+n9T5r0FiMW7HiQsfWh5z9MbBX6LHzWc9yhkSjlf3nLCzft+bkY0bZQ/BmPMW",
-	"i3dNPPiLsLAxBykhgiiiJr89XGu+m3khMUNhb0gmPxsgCqn4SkiEdu6eT5Jt+CXth0K8O0IA07yLiJZI",
-	"gmxUqF7m9Kg/GehZ/gLUmtrYIIk6SbUUxuK7GhuzNZQoOHMZCDomlRtRxoQN37OIBuZrzSuiZf+FxC4h",
-	"8T1PjQjWW168E+/EJMwRu482GqG6MVs+yDqTkCDX6MHwdanyy39ws76DE74IYw1pH6dha+AFaLbmZp04",
-	"OD3abkebQt+uIdIsW0RTnbRLxL/vbJE42oFlFtzyaJke9rQ0G8E4ggj6NgUVXycR8EKtzB0sv1TH8O6q",
-	"esbL0k095Nm9VeLAkzhZWTLXmMFGoMXAv5zJxEAPUPYNz9dOLmI5L8t5qytTVVbCFZRMaSakBD1nds1t",
-	"y/1w5PCwQ0ZiwHF7CyxajdezoY5RN8oYDWzD8QreuOdcVXb7NFeI4RvoiYEoEqga1SjRS+v8eVgdXIFE",
-	"ptwMjeA3a0R1VTz4iZvbf8KZpaLFkQrUBvtlg7+GYXaAdq1bgUK2UyhdkNLeut+EZrnSNASJOH5y9x/g",
-	"uu1Mx/OzSkPmh9D8CrThpVtdb1H3G/K9q5P7R53Z+SwHnVBT/YT/4SVzn50Y5yippR6B0piK7MkFSSYO",
-	"VTSTa4AKZ8U2pMtlFc8vj4LyWTt5mr1MOnnfkPrYb6FfRLNDr7eiMHe1TTjY2F51Twgp7wI7Gghje5lO",
-	"NNcUBLxWFSP20QOBOAWORghR2zu/179W2yS3V9vBna62cCc74caZzOy/VtvnHjKlD2Mex550naktk3wD",
-	"Bq93GTNON0trmDxbKH0zcap3wUjWmlsZd6NG0uS8hyRsWleZP5sJkw016A3Uerjsl4L6w6cw1sHCheV/",
-	"ABaMG/UusNAd6K6xoDaVKOEOSH+dlGIX3MDnj9nFP86+ePT418dffOlIstJqpfmGLXYWDPvM6yWZsbsS",
-	"7iefhyhdpEf/8kkw0nXHTY1jVK1z2PBqOBQZ/+j5T82YazfEWhfNuOoGwEkcEdzVRmhnZNd2oD2HRb26",
-	"AGvdU/+lVss754aDGVLQYaOXlXaChekaSr20dFq4JqewtZqfVtgSZEGOFm4dwrhH8GZxJ0Q1tvFFO0vB",
-	"PEYLOHgojt2mdppdvFV6p+u70O+A1konr+BKK6tyVWZOzhMqoaF56Vsw3yJsV9X/naBl19wwNzeab2tZ",
-	"jChi7FZOv79o6Ndb2eJm7w1G602szs87ZV+6yG9fIRXozG4lQ+rs6IeWWm0YZwV2RFnjO7Akf4kNXFi+",
-	"qX5aLu9G3atwoIQiS2zAuJkYtXDSj4FcSfJmPKCz8qNOQU8fMcHMZscB8Bi52MkcbYV3cWzH1XkbIdFx",
-	"wexkHun2HIwlFKsOWd5ehzeGDprqnkmA49DxAj+jseI5lJZ/q/TrVnz9Tqu6unP23J9z6nK4X4w3hxSu",
-	"b9CDC7kqux60Kwf7SWqNn2RBzxolAq0BoUeKfCFWaxu9F19q9QfciclZUoDiB9KWla7PUGf2oyocM7G1",
-	"uQNRsh2s5XCObmO+xheqtowzqQrAza9NWsgc8blEZy/0UbOx3Ir6CWHYAhx15bx2q60rhh5Yg/ui7Zjx",
-	"nE5ohqgxI/4njeMQtaLpyJ+v1MCLHVsASKYW3snDu5/gIjm6j9kgpnkRN8EvOnBVWuVgDBSZ18UfBC20",
-	"o6vD7sETAo4AN7Mwo9iS61sDe3l1EM5L2GXo7GjYZ9//Yu5/Anitsrw8gFhsk0JvX582hHra9PsIrj95",
-	"THakqSOqdeKtYxAlWBhD4VE4Gd2/PkSDXbw9Wq5Ao0/NH0rxYZLbEVAD6h9M77eFtq5GXPj9M91JeG7D",
-	"JJcqCFapwUpubHaILbtGHV2CW0HECVOcGAceEbxecGPJD0zIAnWadJ3gPCSEuSnGAR59hriRfwkvkOHY",
-	"ubsHpalN8xwxdVUpbaFIrQFN0qNz/QjbZi61jMZu3jxWsdrAoZHHsBSN75HlX8D4B7eNAdqbtIeLQ6cC",
-	"d8/vkqjsANEiYh8gF6FVhN3YjXkEEGFaRBPhCNOjnMZ3ej4zVlWV4xY2q2XTbwxNF9T6zP7cth0SFxk5",
-	"6N4uFBg0oPj2HvJrwiw5sK+5YR6O4GOA6hxyWBvC7A5jZoTMIdtH+fjEc63iI3DwkNbVSvMCsgJKvkt4",
-	"R9BnRp/3DYA73j53lYWMPJHTm95ScnD83DO0wvFMSnhk+IXl7gi6p0BLIL73gZELwLFTzMnT0b1mKJwr",
-	"uUVhPFw2bXViRLwNr5R1O+7pAUH2HH0KwCN4aIa+OSqwc9a+PftT/DcYP0EjRxw/yQ7M2BLa8Y9awIgu",
-	"2Ad5Reelx957HDjJNkfZ2AE+MnZkRxTTL7m2IhcVvnW+h92dP/36EyQN56wAy0UJBYs+0DOwivsz8qHt",
-	"j3mzp+Ak3dsQ/IHyLbGc4KfUBf4SdvjmfknBGZGq4y7esolR3f3EJUNAg8u3E8HjJrDluS13TlCza9ix",
-	"a9DATL0gF4ahPcWqKosHSNpn9szorbNJ2+hec/EFDhUtL+VsR2+C/fC97j0MOujwb4FKqXKChmyAjCQE",
-	"k3xHWKXcrgsf/xUigAIldYD0TBtN8831f8900IwrYP+tapZziU+u2kIj0yiNggIKkG4GJ4I1c3rvzBZD",
-	"UMIG6CWJXx486C/8wQO/58KwJVyHoEnXsI+OBw9Qj/NSGds5XHegD3XH7TxxfaDhyl18/hXS5ymHXb78",
-	"yFN28mVv8Mba5c6UMZ5w3fJvzQB6J3M7Ze0xjUxzd8NxJ9lyuv5Bg3Xjvl+ITV1yexdWK7jiZaauQGtR",
-	"wEFO7icWSn5zxcufmm4YEAq5o9EcshzDGCeOBa9dH4p8dOMIKdwBpqiHqQDBOfW6oE4Hnpitq67YbKAQ",
-	"3EK5Y5WGHCjgz0mOplnqCaNQgHzN5QofDFrVK+/dS+Mgw68NqWZ0LQdDJIUqu5UZKrlTF4B3Uwsxn06c",
-	"Au6edH0NOT1grnkznw/znXIzR3vQtxgkjWTz2eiL1yH1qn3xEnK6gasTLoOOvBfhp514oikFUedknyG+",
-	"4m1xh8lt7h+jsm+HTkE5nDhyeW4/jnk9u+d2ubsDoYcGYhoqDQavqFhNZeirWsZB6sFVcGcsbIaafOr6",
-	"68jxezX6XlSyFBKyjZKwS+ZlERJ+wI/J44TX5EhnFFjG+vbfIB34e2B155lCjbfFL+52/4T2LVbmW6Xv",
-	"yiRKA04W7ydYIA+a2/2UN7WT8rJMmBZ9CGufAZh546wrNOPGqFygzHZemLn3CiZrpI937aL/ZROYcwdn",
-	"rz9uz4YWZ0dAHTGUFeMsLwVqkJU0Vte5fSs56qiipSacuMJjfFxr+Sw0SatJE1pMP9RbydGBr9FcJR02",
-	"lpBQ03wLEJSXpl6twNjeW2cJ8Fb6VkKyWgqLc23cccnovFSg0ZPqhFpu+I4tHU1YxX4Hrdiitl3pHyO0",
-	"jRVl6Q16bhqmlm8lt6wEbiz7QcjXWxwuGP3DkZVgr5W+bLCQvt1XIMEIk6Wdzb6jrxjY4Je/9kEO6O5O",
-	"n4PTaZsyYuaW2ckS8/9/9p9P35xl/8Oz3x9mX/1fp+/eP/lw/8Hgx8cf/v73/9X96fMPf7//n/+e2qkA",
-	"eyp+2EN+/ty/jM+f4/MnctXvw/7R9P8bIbMkkcXeHD3aYp9hrgxPQPe7yjG7hrfSbqUjpCteisLxlpuQ",
-	"Q/+GGZxFOh09qulsRE8ZFtZ65KPiFlyGJZhMjzXeWIoa+memI/XRKOmD7/G8LGtJWxmkbwpEDf5lajlv",
-	"sjFQoranDEP11zw4efo/H3/x5Wzehtg332fzmf/6LkHJotimEikUsE29FeMgiXuGVXxnwKa5B8KedKUj",
-	"34542A1sFqDNWlQfn1MYKxZpDhditrzOaSvPJTn4u/ODJs6dt5yo5ceH22qAAiq7TiVw6ghq2KrdTYCe",
-	"20ml1RXIORMncNLX+RTuveid+krgy+CYqpWa8hpqzgERWqCKCOvxQiYpVlL00wtv8Je/ufPnkB84BVd/",
-	"zpRH773vvnnNTj3DNPcopwcNHWVhSDylffRoxyHJcbM4puytfCufwxK1D0o+fSsLbvnpghuRm9PagP6a",
-	"l1zmcLJS7GkISH3OLX8rB5LWaGbJKGqcVfWiFDm7jB8kLXlStrDhCG/fvuHlSr19+27gmzF8PvipkvyF",
-	"JsicIKxqm/lcR5mGa65Tti/T5LrBkSmZ2b5ZSchWNSlIQy4lP36a5/GqMv2cF8PlV1Xplh+RofEZHdyW",
-	"MWNVE4/mBBQf0+z290flLwbNr4NepTZg2G8bXr0R0r5j2dv64cPPMbKvTQLxm7/yHU3uKpisXRnNydFX",
-	"quDC6VmJvupZxVcpE9vbt28s8Ap3H+XlDeo4ypJht07UYQgwwKHaBTQx3qMbQHAcHR2Ni7ugXiGvZXoJ",
-	"+Am3sBuBfqv9ihII3Hi7DiQh4LVdZ+5sJ1dlHImHnWnS3a2ckBW8MYxY4WvVZwZcAMvXkF/6lG2wqexu",
-	"3ukeHH68oBlYhzCUzI8iDDGdFBooFsDqquBeFOdy18/rYyiiAgd9BZewe63abFTHJPLp5pUxYwcVKTWS",
-	"Lh2xxsfWj9HffO9VFgJNfXoWDN4MZPG0oYvQZ/wgk8h7B4c4RRSdvCdjiOA6gQgi/hEU3GChbrxbkX5q",
-	"eULmIK24ggxKsRKLVB7i/xrawwKsjip96kXvhdwMaJhYMveUX9DF6p/3mssVuOvZXanK8JLSyiadNvA9",
-	"tAau7QK43avnl3FGjgAdPimvMfIaNXxztwTYuv0WFjV2Eq7dqwIVRdTGey+fjPufEeBQ3BCe0L19KZyM",
-	"vnU96hIpF8Ot3GC3edZ617yYzhAu+r4BzNmqrt2+OCiUTzdKWW2i+6U2fAUjb5fYejcxIUjH4oeDHJJI",
-	"kjKIWvZFjYEkkASZGmduzckzDO6LO8T4zOw5ZIaZyEDsbUaYRdwjbFGiANt4rtLec92xolJa5DHQ0qwF",
-	"tGxFwQBGFyPxcVxzE44jJowNXHaSdPYH5r3Zl5vvPPIljLLCNpn3wm3Y56CDd7/P0BfS8oVcfPGjf0Je",
-	"Pff2wvCF1HYoiaJpASWsaOHUOBBKmzGq3SAHx0/LJfKWLOWWGCmoIwHAzwHu5fKAMbKNsMkjpMg4Ahsd",
-	"H3Bg9qOKz6ZcHQOk9BmveBgbr4job0gH9pGjvhNGVeUuVzFib8wDB/CpKFrJoudRjcMwIefMsbkrXjo2",
-	"59/i7SCDFHH4oOglhPOuN/fHHhp7TFN05R+1JhISbrKaWJoNQKdF7T0QL9Q2owjl5FtksV04ek/GLmC8",
-	"dOpgUjK+e4Yt1BbdufBqIV/5A7CMwxHAiHQvW2GQXrHfmJxFwOybdr+cm6JCgyTjFa0NuYwJelOmHpEt",
-	"x8jlsyi/3o0A6Kmh2mIVXi1xUH3QFU+Gl3l7q83bvLEhLCx1/MeOUHKXRvA31I91M+L9o818OJ5dLZyo",
-	"j5IKcKhZuk2KRupcUdrFYzI09smhA8QerL7sy4FJtHZ9vbp4jbCWYiWO+Q6NkkO0GSgBH8FZRzTNLlOe",
-	"Au4tD3iPX4RukbIOd4/L3f3IgVDDShgLrdEo+AV9CnU8x/zRSi3HV2crvXTre6VUc/mT2Rw7dpb50VeA",
-	"HvhLoY3N0OKWXIJr9K1BJdK3rmlaAu26KFK1BVGkOS5Oewm7rBBlnaZXP+/3z920PzYXjakXeIsJSQ5a",
-	"C6wOknRc3jM1+bbvXfALWvALfmfrnXYaXFM3sXbk0p3jL3IuegxsHztIEGCKOIa7NorSPQwyCjgfcsdI",
-	"Go18Wk72WRsGh6kIYx/0Ugth72M3P42UXEuUBjAdIahWKyhCerNgD5NRErlSyVVUxqqq9uXMO2GUug4z",
-	"z+1JWufd8GHMCT8S9zMhC9imoY9fBQh5G1mHCfdwkhVISleSVgslURO7+GOLSFf3kW2h/QCApBP0654x",
-	"u/VOpl1qthM3oARe+DeJgbC+/cdyuCEedfMx9+lO6tf9RwgHRJoSNqrsMkxDMMKAeVWJYtszPNGoo0ow",
-	"fpR2eUTaQtbiBzuAga4TdJLgOrnEvau1V7Cf4pv31L3KyPfaOxY7+ua5D8Avao0WjI5n8zBxffNWm7j2",
-	"73+5sErzFXgrVEYg3WoIXM4xaIjSwhtmBbmTFGK5hNj6Ym5iOegAN9CxFxNIN0FkaRNNLaT98kmKjA5Q",
-	"TwvjYZSlKSZBC2M2+ddDK1eQ6SNVUnMlRFtzA1NVMlz/e9hlv/Cydo8MoU3rnuvNTt3L94hdv9p8Dzsc",
-	"+aDXqwPswK6g5ukVIA2mNP3NJxNl8L5nOjUO8HnZ2cIjduosvUt3tDW+KsU48be3TKdqQ3cptzkYrZOE",
-	"g2XKblykfRPc6YEu4vukfGgTRHFYBonk/XgqYUINz+FV1OSiOES7r4GXgXhxObMP89ntPAFSt5kf8QCu",
-	"XzYXaBLP6GlKluGOY8+RKOdVpdUVLzPvLzF2+Wt15S9/bB7cKz7ySyZN2a+/OXvx0oP/YT7LS+A6azQB",
-	"o6vCdtVfZlVUx2L/VULZvr2ikzRF0eY3GZljH4trzOzdUzYNqsK0/jPRUfQ+F8u0w/tB3uddfWiJe1x+",
-	"oGo8flqbJzn8dJ18+BUXZTA2BmhHnNNxcdNKCyW5QjzArZ2FIp+v7E7ZzeB0p09HS10HeBLO9ROmpky/",
-	"OKRPXImsyDv/8DuXnr5VusP8fWRi0nnojxOrnJBNeBzx1Q4FPPvC1Akjweu31W/uND54EB+1Bw/m7LfS",
-	"f4gAxN8X/nd8Xzx4kLQeJtVYjkmglkryDdxvoixGN+LjPsAlXE+7oM+uNo1kqcbJsKFQ8gIK6L722LvW",
-	"wuOz8L8UUIL76WTKIz3edEJ3DMyUE3QxFonYOJluqGaoYUr2faoxCNaRFjJ7X5KBjLHDIyTrDRowM1OK",
-	"PO3aIRfGsVdJzpSuMcPGI9paN2ItRnxzZS2isVyzKTlTe0BGcySRaZJpW1vcLZQ/3rUU/6qBicK9apYC",
-	"NN5rvasuPA5w1IFAmtaL+YHJTtUOfxs9yB57U9AF7VOC7LXfPW9sSmGhqapHR3qAxzMOGPce721PH56a",
-	"KZpt3XXBnPaOmVI7PjA6b6wbmSNZC16YbKnV75A2hKD9KJEIIxg+Bap5fweZ8tzrs5TGqNyWtG9nP7Td",
-	"09/GYxt/67dwWHRTdu0ml2n6VB+3kTd59Jp0umaP5LFHWOxh0A0NGGEteLwiZ1gsgxK8j7ik80RZIDoR",
-	"ZulTGcdyntL47an0MA/iX0t+veCpGjHuLeRgira34ydlFQudwwaYJscBzc4iD+6mraBMchXo1gYxzEp7",
-	"w3cNTTv5RdM+YJCi4qfLnNwUSqMSw9Tymksqo+76Eb/yvQ2QCd71ulYa80CatEtXAbnYJNWxb9++KfKh",
-	"+04hVoIqhNcGohLUfiBGySaRinwZ7yZzh0fN+ZI9nEd18P1uFOJKGLEoAVs8ohYLbvC6bMzhTRe3PJB2",
-	"bbD54wnN17UsNBR2bQixRrHm7YlCXuOYuAB7DSDZQ2z36Cv2GbpkGnEF9x0WvRA0e/roK3SooT8epm5Z",
-	"X+F9H8sukGcHZ+00HaNPKo3hmKQfNe19vdQAv8P47bDnNFHXKWcJW/oL5fBZ2nDJV5COz9gcgIn64m6i",
-	"Ob+HF0nWADBWqx0TNj0/WO7400jMt2N/BAbL1WYj7MY77hm1cfTU1pemScNwWIgs1IsKcIWP6P9aBfe/",
-	"nq7rIz9j+GYkZgu9lH9EG22M1jnjlPyzFK1neihYys5DbmEsoNXUzSLcuLnc0lGWREf1Jau0kBb1H7Vd",
-	"Zn9zz2LNc8f+TsbAzRZfPkkUourWapHHAf7R8a7BgL5Ko16PkH2QWXxf9plUMts4jlLcb3MsRKdy1FE3",
-	"7ZI55he6f+ipkq8bJRslt7pDbjzi1LciPLlnwFuSYrOeo+jx6JV9dMqsdZo8eO126OdXL7yUsVE6VTCg",
-	"Pe5e4tBgtYArjJhLb5Ib85Z7octJu3Ab6D+t/1MQOSOxLJzl5EMgsmjuC5Z3UvwvP7SZz9GwSpGIPR2g",
-	"0gltp9fbfWRvw+O0bn37LTmM4bcRzE1GG44yxMqI9z251zd9PoW/UB8k2vOOwvHRb0y7NzjK8Q8eINAP",
-	"Hsy9GPzb4+5nYu8PHqQTECdVbu7XFgu3eRFj39Qefq0SCrBQtbBxKPL5ERIKyLFLyn1wTHDhh5qzboW4",
-	"jy9F3E18V9rbNH0K3r59g18CHvCPPiI+MbPEDWyjFMYPe7dCZpJkiuZ75OfO2ddqO5VwendQIJ4/AYpG",
-	"UDJRPYcrGVQATZrrD/qLRDTqRl1AqdwjMy4KFOvz/zp4douf78F2Lcrilza3W+8i0Vzm66SX8MJ1/JVk",
-	"9M4VTKwyWWdkzaWEMjkcvW1/DW/gxCv9n2rqPBshJ7btV6Cl5fYW1wLeBTMAFSZ06BW2dBPEWO2mzWrS",
-	"MpQrVTCcpy1q0TLHYSnnVAnNRHwzDruprfdbxVhwn3BoKUp0w0zbjbFlprkdSaCF9c5DfSE3DpYfN6Rm",
-	"oNFBMy42eDEbvqlKwJN5BZqvsKuS0OuOKdRw5KhiBTOV+4QtMWGFYrbWkqnlMloGSCs0lLs5q7gxNMhD",
-	"tyzY4tyzp48ePkyqvRA7E1ZKWAzL/KldyqNTbEJffJElKgVwFLCHYf3QUtQxGzskHF9T8l81GJviqfiB",
-	"IlfRSupubaon2dQ+PWHfYeYjR8SdVPeorgxJhLsJNeuqVLyYY3Lj19+cvWA0K/WhEvJUz3KF2rou+SfN",
-	"K9MTjIbMTiOZc6aPsz+Vh1u1sVlTfjKVm9C1aAtkip7PDerxYuycsOekQm0K+NMkDFNk6w0UUbVLesQj",
-	"cbj/WMvzNeomOxLQOK+cXog1sLPWchNFHzbVj5BhO7h9LVYqxTpnyq5BXwsDGJEPV9BNh9jkBvW68ZAe",
-	"sbs8XUtJlHJyhDDa1Do6Fu0BOJJkg1NBErIe4o/UTFE95mPr0l5gr3QsRq/Ibc/qH5LrhRTb7AdvXMi5",
-	"VFLkWAohJUlj6rZpZsoJVSPS9kUz8yc0cbiSpXWbWGCPxdFiu4EResQNTf7RV7epRB30p4WtL7m2Ams8",
-	"Z4NiHipde4OYkAZ8NStHRDGfVDrh1JQMhGgcKI4kI8zKNKLh/NZ9+9HrvzEpxqWQqOnyaPPvMzJZlUag",
-	"ZVoyYdlKgfHr6UbzmDeuzwlmaSxg++7khVqJ/EKscAxyo3PLJp/R4VBnwYPUe2y6ts9cW587v/m54w5G",
-	"k55VlZ90vA56UpC0WzmK4JTfUnAkiZDbjB+Ptofc9rp+433qCA2u0GsNKryHB4TR1NLujvKNe1sSRWEL",
-	"RhGVyQS6QibAeCFkMKGmL4g8eSXgxuB5Helncs0tvR0m8bTXwMuRAAiMUCYb/G2H6lcOcCjBNYY5xrex",
-	"LQM+wjiaBq3Ez+WOhUPhqDsSJp7xsnGdThT1RqnKC1EFBhf1ynynGIdj3FkImeyg62D4XtMdq3EcexON",
-	"5Shc1MUKbMaLIpXa6mv8yvBrCBKDLeR1U4SqiQ7s5igfUpufKFfS1Js9c4UGt5wuqpufoIa4dn/YYcy0",
-	"s9jhv6kKTOM7452mj47KDR7SxXGJ+YdRximp19F0ZsQqm44JvFNuj4526psRetv/Tik9hOv+KaJxe1wu",
-	"3qMUf/vGXRxx4t6BfzpdLU1eXfQFV/g9JDxqMkJ2uRJeZYM6Y+j1gJuX2LIe8KFhEvArXo5Ewse2Erpf",
-	"yX4wFg+fj6Zv4Nan57Kc7WVBoymPyFe4Z30ZmhDH/IPJPfjurBZ+rXsROm67+75jqSMfsZZZjFrobmZE",
-	"azf4WCva91djKRJCnQ78HtcD8V48c58GHq6EqoP3VfCBDk9C+tWn4OnU/RhZfzKy4FNbLUZtLK99/Vpa",
-	"pn+Tf/8LWWEZSKt3fwKLy2DT+0VlEtIuqafaJqwpfTipFGLnVpxSwyZVLsXLhkFXRqylQ0uD8jMDsno+",
-	"RRwY4OPDfHZeHHVhpkruzGiU1LF7IVZrixn7/wG8AP3yQEWCtgoBHrFKGdFWIC3dYD4F7BqHO5kabOAI",
-	"WMQVFYZjBSfUK8gtlp1tnes0wDH1FdxkwejzfyoTjD+nm5gMX5BgXxWCYa3ZA3f8IHFSlPyL6nSeTM+5",
-	"f9a4UFME2DU3bbqWXsz05MjN5RJyzIq8N1HVf61BRkmQ5kEvg7Aso7xVooljwrzex2sdW4D25ZHaC09U",
-	"X+fW4IzFsV/C7p5hHWpIFg5tgvhukjgYMUAmsJBDekyR7L3GhGkoA7EQXIJ9Kua2OMZozuco7doN5wok",
-	"6S6ONhXbninTRc8nzeW6HpX2EUNyxnJZDWsmj78/nmOJauMd5HiTeDh+pbPzYeGca5+4GNOKNbaTkMIY",
-	"TPgt5BCkWUpx6esHIFbIUnXNdRFa3ElSKLqbRBroZTOzaAM4hk4OiVIMGAuVl8qJEdlYQFk3ZqJxOLxn",
-	"yDO0TeCDcC1Baygak0ipDGRWhYCPfXDsQwW5v94ICWa0/BEBN5r6+lWb2xvLwHFMdc2912u8QKZhwx10",
-	"OsrAPT7nPmQ/o+8hCD+UATuoYWro9XA92hC6I8wAiTHVL5m/LQ8H999E2SSkBJ0Fy1M/HbfsZmTDvJtF",
-	"ndMFHR+MRiE3OXfOHlaS1NPkw1X23ghRkPwl7E7pERQK+YYdjIEmyYlAjxKO9jb5TtVvJgX36k7A+7R5",
-	"5CqlymzE2HE+zCHep/hLkV8C5gBsXNxHarSzz1DH3lizr9e7kDO7qkBCcf+EsTNJQUXBsN0tL9ibXN6z",
-	"++bf4qxFTWn9vVLt5K1MR2dgwn19S24WhtnPwww4VnfLqWiQAxmqt3LM5eYak/N3q3ieTH2VD03N/Sry",
-	"LVERFCmZ5IIsVs/woKcUR5gCIcrVgYZMzryli5lSpXx5b5KmwQ2VxlQ8GQJkQU7JFtBA4QdPIiBZFz1x",
-	"Cin1nU96p5ZMQ2tEvmn2v2EJ99SLvj9zM0uX3y2Vhk4xdtebMn02gS+YRhP/sxBWc727SY6+QQn5gfZk",
-	"FMsH3bEaT6x2Ia031hCHZamuM2RWWVPnIvW0de1M9zIORdfafu5ULyDy6+LGC2o7tuYFy5XWkMc90vGe",
-	"BNVGachKhW5eKQv00jq5e4NBXpKVasVUlasCqF5MmoLG5qql5Cg2QeRVk0QB0Q5GC1OfiI4nTunuVLIj",
-	"ZShqrY6onZ8DRa63WZ1o0RnZMkc8lsH4LE4eQ9R4CO+e2v9p3rwUW6Qb0Kkjv2RW1zBnvkW/RrY/+FwD",
-	"2whjCJSGlq5FWWLguNhGltfGcSGN2hGx9xzdKq8E+t50kwiQNFy5O6/JrBDzgIs47RGza63q1TpKMN3A",
-	"GZ68uvYP4niUn02N7lEYQeameMI2ylj/0qSR2iW3Lmef5Uparcqyq5QiEX3lNe0/8O1ZntsXSl0ueH55",
-	"H9+1UtlmpcU8xFf3nQPbmXQvtVj3As6onPnhVL3UDl3lPNFOZpA9Fnd0YfcIzHeHOehhnfvZcGH9dXWZ",
-	"afoZcyYZt2oj8vSZ+mt52436yKVYVDJnGdVWpCwT2AwPe3xZNc4VyCKHaAbJk8XhzphnBN7IjOzG/Rcl",
-	"8P64bAme0YxclEPm4qWoLB+V9XoAIKQU+mxrTQUZY0ms4SpqRakS0ETeB3TirYKeSLeDzY1w50BZuBVQ",
-	"A+/HBsDPSPkwp9xy5Em5UNvw/X6bfO5GwH/YT+Ud5jHm4nXRkpYmJ6+QqGaEI6RTXO/1h3qNYe+LqV5R",
-	"TfHciTd8BMC4n1QHhkneUseCseSihCJL1V48b3RU8+il7UOz+iXRhfGcPOd1KH3oxq41+MQpJOLrrv2r",
-	"4o6UVNN8qEmWBWyB4jp+B62opuE8sr9ASSUPe8oAVWUlXEHHfcxnc6lR1BRXEPqapjMrACq0RvZ1ZCm/",
-	"qPgu7ylO/NqzyLNmCnaTmhRCLO0UO6AmSSp1tjKjY2KmHiUH0ZUoat7BnzlW5OiqAd1RTqBq8EbIwjty",
-	"6jQ/0wivwgBnoX9KlAmYeDeNDx3NgtKo28eADvpJ1mbs1Mu0m2ScqqgxsOBsRWOIJRJv+Yap+LUcV0gO",
-	"Sb59bk3cJ6FkhNhvtpCjVOPfO1D4F8+IkcJnPUFqlwAFvQpcl4S2fQ2SSRWVmLzmpnmqtDkUww80MTYS",
-	"0r+mb2BUbr0Zb7+zDAdjppdMbfQhoRs6vbl6/pOcxL0HcXS8FI0Y8OF/e/Rfgbr9swMbYClv6fbTyf5Y",
-	"pNHfYp6Lz9miDgOVpbqmmpHxO/Q5BDsoUV8wAXmxXDTXcvDanPv0nn1Vh4j81Td8x5TGf9yr8181L8Vy",
-	"h3yGwA/dmFlzR0Le8EoeAd4L1E28X7yaB8CCtkWFqWjdYuqY0XA7N0oEtLvIQ3EfxTb8EuJtQGcH4p+5",
-	"dYzT1AvUXLgru7edQyz4xYcULRtexC99TBTZLaMeUge73v93GwsXTxXyu1Ulz0OFUF+iqMtnsApwIC67",
-	"hs3+YMkhXwsk0FQWbolWh+j64gYq0yNZVyoCYaz8SgfsQcXVQeWZWy1joua3V2NjT5jppKXc9S5M9boZ",
-	"AB3XaTwEfly28uPgP5nDdWwZU8D/s+B9pFBtDC/VpP0IWO5k4EjAStrqhdpmGpbmkIMJqavdc163uTuC",
-	"ilXIXAM35HFz/pN/eLYpSoV0D2HyCW1sms0oBSyFbJmlkFVtE+8YzFQqdxHCYqU/onXEhDYmJThh8oqX",
-	"P12B1qIY2zh3OqikY1wiIhg6fN+ECqO5U4cDCNO+4TA+s1Wjx83cBU5FqMhd01guC66LuLmQLAft7n12",
-	"zXfm5halxjhwyKbEI2mmmzUgsi4haRMg5c4bhW9p72kA5Hdo+JlgsEG/4ISxhlQ7Vo3YZ4Yw/CUMNhu+",
-	"zUq1wijCkQPhc9OihY+egEqiGpzks2nrDvMY8TvsnwbT8ntGZBXOOmWK/ef+J9xKfEb+LIXde/JJR9kP",
-	"6yS/WzqYAaly1Tr/E7EMz2MqEtcnX4mjcYOwGUJVAu1BtIkwYh/q6sVHdhHdIHwYd6wEn17urOtpkYr3",
-	"Jc1AhhoDs8e9H0zrys5z7541VKUNVA2ElLmPlj5S00b6+XAvjYBHten9We9O27jMuHGOqRG3Pz46q1SV",
-	"5VN8PqlyR+HNBB7SLowj9BEZAUbW3bjHmKaWTSfvUaeozbFl8kaL6hyydlX5vkf/mJpohKN3TRBqibyM",
-	"KrejdgsjeRplyrwfY9ZVgzVMgnGmIa81qomv+e5w2bGRjNEX/zj74tHjXx9/8SVzDVghVmDarOO9sl2t",
-	"X6CQfb3Px/UEHCzPpjchZB8gxAX7YwiqajbFnzXitqZNKTooWnaMfjlxASSOY6Jc1I32CsdpXfv/XNuV",
-	"WuSd71gKBX/8nmlVlumqD41clTCgpHYrMqG4F0gF2ghjHSPsWkCFbT2izRrVg5j794qyySiZQ9AfeyoQ",
-	"dsTlKrWQMYda5GcY2+2tRgy2Vel5FVl69q3Lv9NIQ4dCI3rFLIBVqvKivViyFEQYQaSjyFqv+ESNeOQj",
-	"2zBb8pZNEaL3PE+TXlwwez+37xZztWlO7zYxIV6EQ3kD0hyzT4znLbgJJ2lV+38a/pFIxHBnXKNZ7h/B",
-	"K5Lvg5sV5Z8E2jAoP0EeCMBItG0nTjIKFIsSEWuyEqA9IRiQ++LHD61h+WBYCEISOhwALw6fbds1kQwe",
-	"nE+c0feHBinRUt6NUUJn+YcicgPrbS6SaIu80sRaMMSW1FAsjMKtzbMminnkVTIIdtZKWeZepmWZCJIm",
-	"PQ6eqZhw3JNAX/Hy43ONb4U29gzxAcWr8dCoOFI2RjKh0twsT98LPmnuKCr27qaWLzEw+7/A7VHynvND",
-	"eSP84DZD5Q5WrF+FW4Fivdk1jklOVo++ZAtfbKPSkAvTN+5fB+GkCQwFLZbeoRW29kAk6qF1/qLsLch4",
-	"GTxx2I+Reaux2XsI2yP6iZnKyMlNUnmK+gZkkcBfikfFxXkPXBe3LMxws7QvUQK3I9O+DMsOT10epTZx",
-	"l05tYLjOybd1B7eJi7pd29ScRZPrO7x9+8YupqQaStdicN0x19GdFGU4qiTDH5DliHDkx/Dzpijml7G8",
-	"t5TbdSQ3d28/alEedFjpZFr/MJ+tQIIRBnOJ/+prx3zcuzRAQJkXhkeVYL1NuhhCTGKtncmjqaIc6hPS",
-	"p/tuiZzXGNWY11rYHdYNDgo08WsyH9N3TW4PnxumsaX5u8+qS2hqt7eZQGoTbtfvFC/xPiITn3S3kCpP",
-	"2DeU4dsflL/fW/wHfP63J8XDzx/9x+JvD794mMOTL756+JB/9YQ/+urzR/D4b188eQiPll9+tXhcPH7y",
-	"ePHk8ZMvv/gq//zJo8WTL7/6j3uODzmQCdCQ2v/p7P/LzsqVys5enmevHbAtTnglvge3N/hWXiqsa+mQ",
-	"muNJhA0X5exp+On/CSfsJFebdvjw68zXZ5qtra3M09PT6+vrk7jL6QpD/zOr6nx9GubBaoMdeeXleeOj",
-	"T344uKOt9hg31ZPCGX579c3Fa3b28vykJZjZ09nDk4cnj3xpa8krMXs6+xx/wtOzxn0/xfyap8anzj9t",
-	"YrU+zAffqooS67tPnkb9X2vgJSbYcX9swGqRh08aeLHz/zfXfLUCfYLRG/TT1ePTII2cvveZEz7s+3Ya",
-	"e4acvu8kmCgO9Gw8H5I2yRdKXaJJPMhH90zPj+Mkrsx9Xjj0U0t0vjDnLSMM5ZXR5jx7+iale/E+lFW9",
-	"KEXO6PpG+nWbE5FXkzakZR+oaJu1pf1bZugY3MPsq3fvv/jbh5SQ1QfkB28QbC0g3iUXo7wwQOEkwPWv",
-	"GvSuBQyt9bMYjKG5MJ09bWtZ5Qsf+NlO2M/e0wG/Ek9pPEJ9UFiTeC50GgHMDZGCq8HCO6zxh65/SA6P",
-	"Hz4MJ9/L1RFZnXpqjdHdtT0M/IKOSWfQKXydEIrcYjLEx5BifzaUcslhU0hOXvXobrvhl2R1QYc6pn3c",
-	"rMeo99FFJDfxI35bAnP/A0saTQjKppmGQsmHIbccOYHBlTZWjJWC1H7evSlVu/rDfPbkSGrYq6Dq5A9N",
-	"gP8DLx3IUIS0MQTBo48Hwbkkj0937dD1+GE+++Jj4uBcOubFS4Yto/K7CYqXl1Jdy9DSyTL1ZsP1DiUV",
-	"O2WPfZYjtCWGdkT3dLFyd4bfzIgtYyGSCrRwD0Zezt59OHS9nL4PZdf3X0adktveXznqMPGS29fsdIGl",
-	"1qY2BRM1Hl8KqsDM6Xs8oaO/n3pN/MhHEtDGPqOujdqchhxgIy0p20v6YwfD7+3WrXP/cK5NNF7Obb6u",
-	"q9P3+B+Ux6IFU/LoU7uVp+ibdPq+gyf/eYCn7u9t97jF1UYVEIBTyyWVst/3+fQ9/RtN1KHbVubpyi/f",
-	"RI2erSG/nKWvxl5m/agXI3GVL0ooiHc9mdBBKht3utF5f4XSiWE/fc/EkkF/CmHCDEcca8o7eooFX3ct",
-	"LsPPO5knfxxucyfn4sjPp+G1lJJ8uy3fd/7snkizrm2hrqNZUM9ISvIhZO5jbfp/n15zYbOl0j7VH1aI",
-	"H3a2wMtTX9ej92ubSnvwBfODRz/GQWzJX0+5R/WsUiZBtq/4dWQcPMPGJECAsV8rfHCMXV7bbCEkUlB8",
-	"gbXqBfo4FJ0H15YTe9CPLlhohml6MFeIVrzIucHK5L5EzkCY/5A8dh9bGPmaFyykWMlYK5qc+UdsZ2l/",
-	"DkElyW6ewxWUjmKY0uwQ7/nEos4XDz//eNNfgL4SObDXsKmU5lqUO/azbOJzbsyKv0Xy1jy/xCdAQ/Lk",
-	"vKn5dTfkR6eTTnRrSIUcJMDslq25LEofpq9qLI7naBNtsiryCnJXWKihVimNAFBySijIT8KcsIvGiwR9",
-	"MurwiiqIbNBogimXaRKOHiZkZZxwlcxn28zxgxXIzHOkbKGKna8+NNP82m4p9H7A9kgMHeGJAyEx9dUL",
-	"OiONglt5+NyqMWO1IOorGoXgm3fuvYxV7b0qo9VyPT09xTijtTL2dOae+10NWPzxXYO5UIx1VmlxhUUj",
-	"EGlKC/eKLTOvJmrrrs0enzycffjfAQAA//+9FZMHKAsBAA==",
+	"H4sIAAAAAAAC/+y9e3Mbt7Ig/lVQvLfKsX+kZDtO7ol/dequYuehjZ24LCV378beE3CmSeJoCMwBMBIZ",
+	"r777VjeAGcwMhhxKtJNTdf6yxcGj0Wg0Gv38MMnUulQSpDWT5x8mJdd8DRY0/cWzTFXSzkSOf+VgMi1K",
+	"K5ScPA/fmLFayOVkOhH4a8ntajKdSL6GyfO4/3Si4R+V0JBPnltdwXRishWsOQ5styW2rkfazJZq5oc4",
+	"c0Ocv5zc7vjA81yDMX0of5LFlgmZFVUOzGouDc/wk2E3wq6YXQnDfGcmJFMSmFowu2o1ZgsBRW5OwiL/",
+	"UYHeRqv0kw8v6bYBcaZVAX04X6j1XEgIUEENVL0hzCqWw4IarbhlOAPCGhpaxQxwna3YQuk9oDogYnhB",
+	"VuvJ818nBmQOmnYrA3FN/11ogN9hZrlegp28n6YWt7CgZ1asE0s799jXYKrCGkZtaY1LcQ2SYa8T9roy",
+	"ls2BccnefvuCff7551/hQtbcWsg9kQ2uqpk9XpPrPnk+ybmF8LlPa7xYKs1lPqvbv/32Bc1/4Rc4thU3",
+	"BtKH5Qy/sPOXQwsIHRMkJKSFJe1Di/qxR+JQND/PYaE0jNwT1/iomxLP/4fuSsZttiqVkDaxL4y+Mvc5",
+	"ycOi7rt4WA1Aq32JmNI46K+PZ1+9//Bk+uTx7b/9ejb73/7PLz6/Hbn8F/W4ezCQbJhVWoPMtrOlBk6n",
+	"ZcVlHx9vPT2YlaqKnK34NW0+XxOr930Z9nWs85oXFdKJyLQ6K5bKMO7JKIcFrwrLwsSskgUYQ6N5amfC",
+	"sFKra5FDPmVCspuVyFYs48YNQe3YjSgKpMHKQD5Ea+nV7ThMtzFKEK474YMW9OdFRrOuPZiADXGDWVYo",
+	"AzOr9lxP4cbhMmfxhdLcVeawy4pdroDR5PjBXbaEO4k0XRRbZmlfc8YN4yxcTVMmFmyrKnZDm1OIK+rv",
+	"V4NYWzNEGm1O6x7FwzuEvh4yEsibK1UAl4S8cO76KJMLsaw0GHazArvyd54GUyppgKn53yGzuO3/8+Kn",
+	"H5nS7DUYw5fwhmdXDGSmcshP2PmCSWUj0vC0RDjEnkPr8HClLvm/G4U0sTbLkmdX6Ru9EGuRWNVrvhHr",
+	"as1ktZ6Dxi0NV4hVTIOttBwCyI24hxTXfNOf9FJXMqP9b6ZtyXJIbcKUBd8SwtZ889fHUw+OYbwoWAky",
+	"F3LJ7EYOynE4937wZlpVMh8h5ljc0+hiNSVkYiEgZ/UoOyDx0+yDR8jD4GmErwgcIfeAI+Q4cCRsEjSD",
+	"pxu/sJIvISKZE/azZ2701aorkDWhs/mWPpUaroWqTN1pAEaaercELpWFWalhIRI0duHRgQzGtfEceO1l",
+	"oExJy4WEnAnpgFYWHLMahCmacPd7p3+Lz7mBL59Nbvd9Hbn7C9Xd9Z07Pmq3qdHMHcnE1Ylf/YFNS1at",
+	"/iPeh/HcRixn7ufeRorlJd42C1HQTfR33L+AhsoQE2ghItxNRiwlt5WG5+/kI/yLzdiF5TLnOsdf1u6n",
+	"11VhxYVY4k+F++mVWorsQiwHkFnDmnxwUbe1+wfHS7Nju0m+K14pdVWV8YKy1sN1vmXnL4c22Y15KGGe",
+	"1a/d+OFxuQmPkUN72E29kQNADuKu5NjwCrYaEFqeLeifzYLoiS/07/hPWRbY25aLFGqRjv2VTOoDr1Y4",
+	"K8tCZByR+NZ/xq/IBMA9JHjT4pQu1OcfIhBLrUrQVrhBeVnOCpXxYmYstzTSv2tYTJ5P/u200b+cuu7m",
+	"NJr8Ffa6oE4osjoxaMbL8oAx3qDoY3YwC2TQ9InYhGN7JDQJ6TYRSUkYpqGAay7tyWSaOpPNAf7Vz9Tg",
+	"20k7Dt+dJ9ggwplrOAfjJGDX8IFhEeoZoZURWkkgXRZqXv/w2VlZNhik72dl6fBB0iMIEsxgI4w1D2n5",
+	"vDlJ8TznL0/Yd/HYJIorVC/NwYsaeDcs/K3lb7Fat+TX0Iz4wDDaTlTW3E4TaDDnDfaPQ4OmS4RdZtKg",
+	"0ivBogmMV5Y5OHFBqrT+NlTEYiyszZ1J29MJ15pvJ16UmJFI0Af0ZwOOVEu+FJIGm6KQLdmaXyGD51LR",
+	"viJFgqmlZ7cCGrRRtHnJxL8OTnqv8X+aY5OilyC1GMZZIYylN1j69Bj6JsMGt4jSGLDHIEF6665UgaL4",
+	"XkrBxt/7tjHvw99Hdf7n4Hsxboc5HrZiHnPu4U2/RC/uzzrsrM/NvA7yhJ11+96Nl+EoA1wMPx2bf8XE",
+	"Q7+MYzgRRBE1/Yvd3J1aRzMaasxWUNBrLs1bXrhj/fHuvUhmStiH3jSHIHXl+c7uMRrffvS8or0vyyBz",
+	"5Nzyu1yE/6LHO9PjOOrZcwu2tnk3lX4Evta618ZRKLUcos3xFIjD/Iv27k17d2CJe+ntTnQ14hrcsZwa",
+	"7BvNS3fD+y9OQyIkqd5dIwfrUgOsQdpLsYZCSDjCaaBdSJyCMEV9CDR4bZzrMWWqyJHeFkKbA85AWAJp",
+	"q8Ik/UORohUzjljCDMz60eslrBVppjL86EdErN5T8zD60knA2nyODxJBdecnwAgWlIAEP3Rh+LpQ2dX3",
+	"3KyOQGfzMFaf1GgatgKeg2YrblYJxtShh2a0MSSBDYkZsHk01UmzRPr7aIuk0fYsM4gubdjT6rwIxgFE",
+	"uG9jUPF1EgGv1NIcYfmFOuSdUJYveFHg1HuPPw086vAXBcPGDNbC2sZ04HwsnAaefcOzFYmRGS+KaWMs",
+	"VOWsgGsomNJMSAl6irZm21wrNHLQbBN7NoC3qQUWrcYbGsnIqmtrlAa25vTcW6M+uyzafeor2vA1dPRg",
+	"9PxUFdmRIlXz+cuwOrgGSbddPTSBX6+R7HXx4CfsrP5EM0vlFudswEHcbvBXX0MtoLF183iVzRRK585r",
+	"weJvQrNMaTdELcLj5Pgf4Lrp7I7nZ6WGmR9C82vQhhe4us6iHtbke6yT+7HO7HSSgU7Y6X6i//CC4WdU",
+	"GZAisKYeQS9/FTnU5U7yQ1S5mbABWdwVWztjNkML80FQvmgmT7OXUSfvG2c/91voF1Hv0OVG5OZY20SD",
+	"De1V+4SYlmDSE3Z3Mp1orjEIuFQlc+yjA4LjFDSaQ4jaHP1e/1ptktxebXp3utrAUXZCbdx/RjH7r9Xm",
+	"pYdM6X89gWoSIyROD3gK4Y6ioa5WHre1Go0r2tlc6bvJj50bVbLGwY5xHDV6lEy7b2tsWpUzz4wSTjqu",
+	"QWegxqd5t9jXHT6FsRYWLiz/CFgwlkfA3wML7YGOjQW1LkVxjDfiKim2o0vE50/ZxfdnXzx5+renX3yJ",
+	"JFlqtdR8zeZbC4Z95i3RzNhtAQ+Th43EqfToXz4LblntcVPjGFXpDNa87A/l3L3cE9A1Y9iuj7U2mmnV",
+	"NYCjrgDAu9yhnTlPRgTtJcyr5QVYi3r0N1otjs7+ezOkoKNGb0qNkpRpu8Z58fA0xyansLGan5bUEmRO",
+	"NE/rEIYbA+v5UYhqaOPzZpaceYzmsPdQHLpNzTTbeKv0VlfHUDKC1konZY5SK6syVcxQsBUqcd+98S2Y",
+	"bxG2q+z+7qBlN9wwnJs0XJXMB6419MQbfWG7oS83ssHNTmHJrTexOj/vmH1pI795dpWgZ3YjGVFn67Zd",
+	"aLVmnOXUkYSr78A6gVOs4cLydfnTYnEcW6qigdKKMoMzMdeCCckMZEq6+JU9EoAfdQx6uogJjlV2GACP",
+	"kYutzEjfdix9YVo4WgtJrqpmK7NIUkIYC8iXoEfgY7wkNIQON9UDkwAH0fE98MKuXqwguzJH58Px4ElF",
+	"VGLyFDN+8N03l+x0Ra1PM2r+AIF/RWv7Rlq9faOVWhxhP+ldMkvf8LipK687a57n/o9IHqo9Gxt3ya2F",
+	"FA/aFRqCsxV8DkWYoeDGRtM4r/US180yLmvzudWVQc6nZOZFfjeKMIxQBzk9j3EcF24294pszz1Cfyca",
+	"TEnbYhXe42thw9WolbKMF0oum6eEe3REeGii1noLB9yzgWPjvMJrUSeYPJVGmiCgSPUwZf4wzdXGhT5M",
+	"a3cBkMxqAewKtrjueSUKS+sbtStKFkLCzE9sRlKD6xXANWwljFV62yUOtxkOm4ZZNWW/g1bEGeiTcXqi",
+	"UkPOLTBhDwHZebTSc+5AsKmnewh+YtCJgtNwGtCCF+J3yNlr0FekyMuKii576tbESrrNHjUh0u7Qc1bZ",
+	"FnKixa8dADQT92fcEdycF1xmYFz3PTgbCeHgpVKTl9uwNGuY1s9xYHTSQnAPSMaTD/HphHywZoRVFFac",
+	"BkwoOZKMqLvflLg7HdVPQ0hWWV6Yw5iK68M46Q/G8u3kHe3pKrC2QNfTdjxedL3UAO/D/QBD2nHox8gL",
+	"b8L54V5E8JTCl1xIY3eQ/0lz9ZL35EsoLP9W6ctGz/edVlV5dHGiO+dYMaheoSPSHPsG5zQhl0U71nqJ",
+	"sCfX+Ics6EVtbXFrIOhJkn0llisbKdbvLgLthDE1yz6Cwj594+KPKsdHiK3MEVRQzWDNywhpNn4P8bmq",
+	"LONMqtxxqMqklVM7RDAXzRhzV8ecUKwApK6MV7jaqmQUq7dDxpvxzHGNGaFmgFU1IWaulZvORX4WGniO",
+	"Uh7IRm7zgUq0SE6BhrZ1f1VlkuFHcJVaZWAM5DWL2QdaaOeERrsDTwQ4AVzPwoxiC67vDezV9V44r2A7",
+	"I9nQsM9++MU8/APgJS6/B7HUJoXeruGxD/W46XcRXHfymOycSdNRrX8FlAVYGELhQTgZ3L8uRL1dvD9a",
+	"3DX7kSk+THI/AqpB/cj0fl9oq3Ig2YNX76NmCDdMcqmCQiY1GAqzs/0v45bEywyuIOKEKU5MAw/I1q+4",
+	"cT5YTMicjL+mkaypD00xDPCg+hJH/sV9TI2dKWlAmsrUakxTlaXSFvLUGsgwODjXj7Cp51KLaOxaV2oV",
+	"qwzsG3kIS9H4HlnGqwPwD26jdwcOl1gcefrjPb9NorIFRIOIXYBchFYRduOA9wFAhGkQXbuwtimnjrKf",
+	"Tsqn5UwDz1Z8LgphE6qLN0/fvI0a1JiJf/MnCWdpDmZZzQuRMUSClmDx2OVgQa/D5X5WWfXj2eVz33LK",
+	"Si2uyTVCs0peSXUjTxjlGaIMDSuQTFfSS77l05JJsDdKXyUVMcaqskQ2aGeVrBEytP8XrvWZ/blp2z81",
+	"3DbLzBUYcqHx7d0XuHEk43I4rLhhHo5gwib7lovZ7G8GcpmZETKD2a4jTTpvbBWf7b3cpyqXmucwy6Hg",
+	"iW3+2X1m7vOuAYiUG/2/sjBzwfhpam6OaHhP7hha0XiJ2+BHxegLy5C34BunoXzfe8/IOdDYKa7rD8iD",
+	"eiiaK7lFYTxa9qBPLV3z1wrNdIEeCGR/VY0BeAAP9dB3RwV1njUP/e4U/w3GTxDa3GGSLZihJTTjH7SA",
+	"AeO4z3MUnZfOvdW5WpL3wSB/3sNHho7sgKX+DddWZKKkR9wPsD36m7Y7QdJ1ErkwF2h1jT64920Z92cu",
+	"jLw75t3euKOMkX3we9bIxHJCCEAb+CvYkjLhjctPEulwjvFIT4zKhEs7hICGrAeQt9OpwIZnttjiVWhX",
+	"sGU3oIGZau6cWPsOJuiqGg+QdFjZMaP3z0t6x+10GLygoaLlpXy53GNnN3yXnRdPCx3+kVMqVYwwGfaQ",
+	"kYRglPcwKxXuuvApkEISnEBJLSA90y62AVx/VcRophWw/1YVGayQsisLtbCmNAkK2JdmECaa08eCNhiC",
+	"gqIaauw8etRd+KNHfs+FYQu4CXnDHj3qo+PRI1JQvVHGtg7XEQyKeNzOE9cHaaLx4vNCYZen7Hf69yOP",
+	"UvZ2Bg+T0pkyxhMuLv/eDKBzMjdj1h7TyLiAB7sZufLLtod4b9207xdiXRXcHsONB655MVPXoLXIYS8n",
+	"9xMLJb+55sVPdTfKiQYZ0mgGs4wyeY0cCy6xj0v+heMIKawIORfGAgTnrteF67Tn7dx4gor1GnLBLRRb",
+	"VmrIIHduCMIwUy/1hNGwLFtxuaQHg1bV0juPunGI4VfG6Zx0JXtDJIUqu5Ez0t6nLgAfqOBZPYlT+DTr",
+	"q/7dA+aG1/NB3roXRu5B1xSS9BqaTgaf8ojU6+Yp75DTzt024jJoyXsRfpqJR/qWEOoW5B7QxVe8LXiY",
+	"cHM/ji2iGToFZX/iKJSw+TgUTYh6hGJ7BKHHDcQ0lBoMXVGx/s24r2oR52kMwSJbY2HdN1G4rn8bOH5v",
+	"B9+L3kC4VhK2ydTEQsJr+pjq7a7Jgc4ksAz17b5BWvB3wGrPM4Ya74tft9tbmb2otFH6GNdc5kZKn2Jy",
+	"uPItpi4WyCl2JF/DeNZSA7zX+TBAcx+3MS+9IYh5awU4e8TezjIrroXdHgeJuIO7bhnkrbU+NlPXAZNd",
+	"IYJ7qJiQOWxOhlWNf5IQi+7jYNwzsPe4qjdhBIVEqO7Mf6BYFTR+qspWkEfx5NNW3J7SLvh8itq/KFC6",
+	"TU50W3bN4uZbpY/lr+kGHI3jEW4Oe5Htp7zracTAwL7/gs+o2L2MzbSmO0EIV5mg99N5bqY+RtO5PHhn",
+	"lzb6m1wPR7gHu+N2DPVxsl4yREFRMs6yQpCZSkljdZXZd5KTvjhaaiLCJCjGhk0jL0KTtC0mYSrxQ72T",
+	"zjuo1iInT/ACEirTb6F2ZzXVcgnkV9nK6w/wTvpWQrJKCktzrfHqmrm7qwRNYR4nriVGzS6QJqxyXlPz",
+	"yrZf4pQw1Fg0tDivAZyGqcU7yS0rgBvLXgv0ZcfhgkdyuD69raDGQpp3LkGCEUMuht+5rxRm7pcfu4r5",
+	"ziEEsO9u1SQt/z+f/edzTFbOZ78/nn31/52+//Ds9uGj3o9Pb//61//b/unz278+/M9/T+1UgF3kg5Cf",
+	"v/RaqvOXpIqIAqe7sH8yIyPmwE0SWexq3qEt9hmlbvYE9LCtqLYreCcxjsAqdJ8V5Gp3F3Lo3i29s+hO",
+	"R4dqWhvRUUyHtR54E92Dy7AEk+mwxiM5mOPi04ljcSNDLlhsxRaVdFsZXsIubUvwclaLaZ0c2NUNec4o",
+	"c+yKhwg0/+fTL76cTOuspc33yXTiv75PULLIN6m8vjlsUnqbOGT9gWEl3xoYcDUd8PitHcjiYdeACj+z",
+	"EuWn5xTGinmaw4UMGl7/u5Hn0oVb4/khP4qtt2KqxaeH22qAHEq7StUTaD2aqFWzmwAd3zbnMzxl4gRO",
+	"uvrXHHU3PmagAL6IQwNGsIn6HDhCa/xla6zHCznIo7VDlnGwub/8jx/q4gdOwdWdczjCxTNMDG65DfmG",
+	"46TACbVWnQ8u8npEbhZn+Hgn38mXsCBNoJLP38mcW34650Zk5rQyoL92HuwnS8Weh6RLL7nl72RP0hos",
+	"dBTliwwuDVewTZGnK17RH+Hdu1/RwvLu3fueA1j/Ke+nSvIXN8EMBWFV2ZlPvT/TcMN1yg5t6tTrNDL1",
+	"3jmrE7JV5YwVfnzmx0/zvP3Zb3H5ZVng8lu54aiTc6M3VtXZQaKEa7i/Pyp/MWh+E3SclQHDflvz8lch",
+	"7Xs2e1c9fvw55VlpUt/+5q98pMltCcfPo0sLdyoeCqSdYRJ+k1y+BV7S7pO8vCZ9Y1Ew6tZ6S4boZxqq",
+	"WUCUgG5gAxwcB+eqosVduF6hzFJ6CfSJtrCde/Je+xWlDr3zdu1JP8oru5rh2U6uyiCJh52pq6/4QAHn",
+	"8oVGVTwEvlDNHEKwGRXEgHVpt9NWd7VoCZpRWmeqLePyvVAEGRkLseZMmXMvinO57aaZNy7cmwZ9C1ew",
+	"vVRNcYRD8sq305yboYNKlFq2ciPeM3NnIIvnNV2EPsMH2Ym8RzjEKaLYlxmSEMF1AhG7E0QevlAc716k",
+	"n1qekBlIK65hBoVYinmqLN5/9W3TAVakSl8JyIc61AMaNFcLa0JomH/ea7R3MQpsUqUyvHBVzpIOVPQe",
+	"WgHXdg7c7rS5yTj5SoAO+7MbPFlO207RkrDB/RaWtOeohsu9osi18SESJ8NOrg5wyO8IT+jevBROBt+6",
+	"HnWJCkDhVq6xWz9rvSY4prPLVf2dkiAutbrBfTEUIeso1eWzju6XyvAlDLxdYkv6yKSXLes7DbJPIknK",
+	"IOi70xY1epJAEmTXeIZrTp5hwC94iOmZ2fH6DjM5Zw1vvyVnU4+weUECbO0e7/ae65ZHg1zuAi3NWkDL",
+	"RhQMYLQxEh/HFTfhOObTiMuOks4+YoqjXaViziOH5ahIWV0IJtyGXQ7ae/f7gjGhSkwoDRM/+keUeXGB",
+	"iFV6O5Qk0TSHApZu4a5xIJQmV3yzQQjHT4sF8ZZZykU4UlBHAoCfA/Dl8ogxZ6dko0dIkXEENjkh0cDs",
+	"RxWfTbk8BEjpc93zMDZdEdHfcDIYpEqCxYzKV8zEgO0/CxzAJwaMy2C0wjZCFYwpQzZ3zQuQdZh+M0iv",
+	"5gI9KDr1Sbwb3MOhh8YOM7G78g9aE/W402piaTYAnRa1d0A8V5uZS5+UfIvMN3Ok92SAFPZKHkxXG+aB",
+	"oZwE6FpJV4sLyNkDyzAcAYwGAKqvgGunfkNylgNm17S75dwUFRr2WS11NuSyIxP43qkHZMshcvksqqxx",
+	"JwA6aqimdrJXS+xVH7TFk/5l3txq06aMWYg9TR3/oSOU3KUB/PX1Y+1aGN83NU+G6yr4Rp+mCEhfs3Sf",
+	"4iyuMwFiDqrN0iWHFhA7sPqmKwcm0dpq1cFrhLUUK2FCJoySfbQZKIAewbOWaDq7gm36LQ90j1+EbpGy",
+	"jnaPy+3DyJlXw1IYC43RKPjo/RHq+CZLwuDqbKkXuL63StlUbop4mZ98BRQNQ34PM7K4JZeAjb41pET6",
+	"FpumJdDWZjNX/FfkaY5L02JkaC6KKk2vft4fXuK0P9YXjanmdIsJ6Zwl5y5rUCqIYMfULs5k54JfuQW/",
+	"4kdb77jTgE1xYo3k0p7jn+RcdBjYLnaQIMAUcfR3bRClOxhklNWizx0jaTTyaTnZZW3oHaY8jL3XYzTk",
+	"1hi6+d1IybWE4glvvMbmTGPsZZFYUq3UoXgu5OJQ2nZ2hsT6hoajWOlWPG17eHRPDkb7fNqJa2QUaBVY",
+	"X/SW73ivREfmkLRntRZn2Aki05C7wJH0cLlYgqnhKhQ5hP3y9lumKltWtjOTZs14yelK0EINvN/dt/aI",
+	"POXHkNYKV0JaV+jWgEzWMbjsK7UD3HdQPd8A5k5Jz+K+jUPOuFV1I0ocHuu1dvIVNRNNakCnNQ3vPD/t",
+	"8ib90xPaudz9KNkb0Nft48PySrugZkfIzB9Gl/GRVE5r4KbyL9f4QNTB1ti0GRCk4+Ctw9E+oflW8rXI",
+	"Zq6aL4VRqmpgf1wb5tswMFas6VlST99gkHm0hVxr3TN8gsoJCuiupBUFA0kxEv0BjAuWyFSBjB8GzjfI",
+	"fCA5w89SbBxaOjhrR/N30UVOrX4fAqQ3K1FAg0yv9Q6h3GnA7oBXIRksFlS7Xobj/biHvyHPEzr+Jul9",
+	"0vBX0/DvoAOp6eOwuj7diyNhh9irfHQP/7uwLOT+x933HXeIXWkw+HRKYPcXZ+4MDVxahPtj9zKMmMKr",
+	"p5eBYk74hS2EPgIQbrRxRZsmrV3p0X9Moi2MRsvZyWgbjCRElOvWLtSbcCwRpRn5htdb/ImFE2pL//Pn",
+	"WJNTY50slCzRvhX1Q+l9rqxV62jyu4gW9ervdFChTM+BX44xww6Xw3rXsM1zZtTCTqkEC+IM+XwSMbsE",
+	"FacwbYsrIVUCz1Z1puZ6PdEVArL9Laal4G42r2RewPGkHcS+H6sj8owSbi6HLq+z+s6qGQ0Pne530uJh",
+	"j3DCcuB5WjIjad1/renQzT7uyl1wY4ft6tZX9eFswV0xOgzq2YYp0rbxEWcyMkJ3sHX803nEufZQaL1N",
+	"Hq176DOqKJaEvlDLJeShUlJw5pRRPap2gmf8fUf5rRPmqmBREasd9a98PgcYyuYQ2apmFL818OZqmjnI",
+	"m02g2l00yRKkKwSQ9mlQyz25IqhFJBB8YkferkSTjKa/7HhiN2Hubpfq7aQNKIDn3qBmIKxvt06pvyEe",
+	"ddOhOPxWFcnd+h8akGhKWBMpxXtkMaA95GUp8k3Ha3JX3N9II0Ez2ICpgPRifrA9GGhH0ycJrmn8wDAf",
+	"s++9w07JYHuKJkVfKN9FqCN988ynqPRv43aIfO9cNYbGkWv/4ZcLqzRfgnehnDmQ7jUELecQNFCHeu3C",
+	"xULkYrGA2HXQ3MXtrQVc97ilKO5sBJEdxOPF3vPTwLgfZWmKSdDCkEN5Qpvl28Z+EPWVEG3NHZRdyYSW",
+	"P8B29gtazFnJhTZNnLf3mWxrjg/Y9ev1D7Clkfc+1RCwPbtCbhNvgWgw5aZWfzJRSOwDE2PM2UZbW3jA",
+	"Tp2ld+lIW8PLcjfxN7dMvKLOUu5zMBoPf4RlzG5cpB3r8fRAG/FdUt63CSLfL4NExqp4KkEO6umrqM7W",
+	"uo92sURTIF5azqSOD7mrG3vqNvMj7sH1m/oCTeKZwiSdW3MrKuVAlPMSg494MfPO/kOXv1bX/vKn5iE2",
+	"4BOb4dKUffnN2as3Hny0ihTA9aw2Yw+uitqV/zSr0sCt2mMYcYWDvZeOc3OINr8u7hoHCNxQkeCOpwTK",
+	"iZ64GhbaHS8EDCzS0dp7eZ+PU3FL3BGvAmUdrtI47FLnToQKv+aiCJ6yAdqByGpaXBMjdDBXiAe4d6RL",
+	"FLA0Oyq76Z3u9OloqGsPT6K5fqKib+kXh/Ql4YgV+cgVfnTp6VulW8zfp7hKRr58PLEKhWyHxwGtn/cz",
+	"7glTJ8wJXr8tf8PT+OhRfNQePZqy3wr/IQKQfp/73+l98ehRH2h326WZBLlYSL6Gh3WKgMGN+LQPcAk3",
+	"4y7os+t1LVmqYTKsKdSFsAR033js3Wjh8Zn7X3IoAH86GfNIjzfdoTsGZswJuhhKaVVHSK75BjMNGKZk",
+	"NyCYsqkhaRGz99XdnSdx/wjJak3etzNTiCwdlyDnBtmrdJGA2JhR4wFFI45YiYHAUlmJaCxsNqYaYQfI",
+	"aI4kMk2yIGKDu7nyx7uS4h8VMEGm3IUATfda56oLjwMatSeQpvVifmDqEw1/Hz3IDmfJoAvapQTZ6Xz6",
+	"snaIDAutvbi5bIXxHBC+HM/YY9w7Qo89fXhqdqlYVnAnY4vzIk2qD7z7a2B03tN0YI6lmiFbDP1comFh",
+	"Zgutfoe0Fx85PyYyqvqJ6DlCvVOq9S5LqT2iw3ri2fdt9/i38dDG3/stHBZNv5l2PorRl2n6VB+2kXd5",
+	"9Jp0IdTpJD6SabjcR9aOax9gLXS8okhOssCF0Bku3Xly6URb6VHSpzJqYU7d+M2p9DB3dzUr+M2cZ1fp",
+	"txDCFG1vK8jHKhY6hw0wdbJMNzuLwo/rtsKVJChBNzaIlA/bnd41btrRL5rmAYMdW08XV3uTF0Ylhqnk",
+	"DZcWgg++41e+twHnP469bpSmSikmHY+UQybWSXXsu3e/5lk/9iQXS5zJ1RFhfGF9mQ0/EHPlWIiKcmHK",
+	"gm/rFLAeNecLdNepz2TYjVxcC4NRuNTiSaitaOi6rI2YdRdcHki7MtT86Yjmq0rmGnK7Mg6xRrH67UlC",
+	"Xh1VNwd7AyDZY2r35Cv2ma87eQ0PEYteCJo8f/IVRYO4Px6nLasLXhV2F8vOiWcHi2iajp0fA42BTNKP",
+	"mjaPLjTA7zB8O+w4Ta7rmLNELf2Fsv8srbnkS0gnF1jvgcn1pd0kX/QOXlx12hyM1WrrS0X25wfLkT8N",
+	"OJEg+3Ng+KKUax91ZtQa6Skw0nDYwnCuBK/j6TVc4SMFb5Yhdq2j6/rEzxi+TtMDpxDbH8lGG6N1yrgr",
+	"j1OIxj/IM8QTdh6qb1HJ4dplw+EG58KlkyyJW4jRAlpIS/qPyi5mf8FnseaZBZ0uDoxDzOZfPuuD/DU3",
+	"8OWzuoqnPAzwT453DeTFmkS9HiD7ILP4vpjCTc7WAln9wyZBYHQqB6NMk9PaoaDG3UOPlXxxlNkguVUt",
+	"cuMRp74X4ckdA96TFOv1HESPB6/sk1NmpdPkwSvcoZ/fvvJSxlrpVEnN5rh7iUOD1QKuIR/cJBzznnuh",
+	"i1G7cB/o/9jgnSByRmJZOMvJh0Bk0dyV6Q2l+F9eN7UBybDq0uh0dIBKJ7SdXm/3iUPlDtO6de23LtqJ",
+	"vg1gbjTaaJQ+VgZCx+nnps8f4S/UBcnteUvh+OQ3pvENTnL8o0cENOodXdPfnrY/O/b+6FG6klVS5Ya/",
+	"Nli4z4uY+qb28GuVUIB9rTaOCweHIp/cr79/g5cUfkAmOPdDTdm8xV8+vRRxnOQk6VDJ9CnAyEj8EvBA",
+	"f3QR8QczS9rAJsR++LB/rTYv/eqUTpNMXn+PgrQ5+1ptxhJO5w4KxPPpQ4zTG5oAz+8p3dIabKUl5M11",
+	"TV9rhZrLMxGKAVMe64PL5BM6BvZnpK6Q4FaLzr2V9B3Y67wSHRgcdQ7o62paNbxj48KfeNP7hqDJdAe2",
+	"K1HkvzRZ0ju3muYyWyXjbefY8W/uwdCSBxzfTmENzZ8SiuRw7qH9t/AgT6gM/q7GzrMWcmTbDq78cjuL",
+	"awBvgxmAChMieoUtcIIYq+0E1HWCw2KpckbzNDVoG059Mkns1UuYV8sLl9jQvNGppMlu2HVlvRMtRS/4",
+	"1L0LUeD/BozY1HKmuR3w+teUEWjRjOhCM53Ow40OmnGxJinBcCwMTifzGtBZEbsqCZ3ulIycRm4FLJT4",
+	"iVpS6kfFkB0xtVhEywBphYZiO2UlN8YN8hiXBRuae/L8yePHSR0cYWfESh0WwzJ/apby5JSauC+eDboC",
+	"lwcBux/W24aiDtnYPuHora7kW8erUzyVPrgcUNiZRIicOjGQOelwT9h3lEMYibhV7gOhqUtjtUtTVGWh",
+	"eD6lkl3oJsTcrK6Pu2RYjkS9RPg75J+09Ywv1RFyJA/koB0/zu6kmK4EDQXsGcvXZSrLP7a4DA2Y6DgA",
+	"kVIxxs4Je+n0uSZoC90kjAq/6TXkrJ7OaxSIOPA/1rrIKKta4tgwr2wqEg9VynjjWwR21piRojw+1+Ej",
+	"MWyE23kaAKtkDnrKqD7OjcAiXCtu4RrahQUCGHVNbF9ooL28UMdayJMDJOO6NPmhaA/A0bi1h0MSsg7i",
+	"D40oUpXOYDxNuvN8Qb3SgSGdukEdF4SQpj4UjmOvvaUj41JJkVGBz5RYT0nQx9lMR9RCTRs7zcSf0MTh",
+	"StBrI/IHLPr1vx9khB5xff+D6CtuqqMO96fFAHcy7y3BGs/ZIJ+SBksU4K1zQhrwxeeRiGI+qXTCwyoZ",
+	"lVF7cxxIRpTfeEDd+i1++9Er4/EIsishSe3m0RZi78h+hhkhkdolE5YtFRi/nnZokfkV+5xQvYMcNu9P",
+	"XqmlyC7EksZwPn24bOfA2h/qLLizevdRbPsC2/qKkPXPLd80N+lZWfpJU5zA1Dvc+4RVD4cQnHKiCl4t",
+	"EXLr8ePRdpDbTj90uk+R0LBUqIsgxHu4Rxigdeq5ioVCK0dR1IK53EQppKQjOl8JGey56QsiS14JtDF0",
+	"Xgf6mUxzm61abGif9+pANAbFyGdXxxiqs8E+erLMJmGO4W283Ehft3OAcdQNGomfyy0LhwKpOxImMBaz",
+	"9gsmIaitmpZ5LUTlFOnkg52dWJZmHMi4ZyF+s4WuvbGEdXeqMXvoTTSU7X9e5UuwmEk+lST6a/rK6GuI",
+	"WMM6t1VdWr0OVWxX+0rkBHATZUqaar1jrtDgntPlwnBjYD0vEj6sL+uPkNc7jJSGZh78N1VXfHhnvAf3",
+	"wfmtgrt2fliJu36+rpTUizQ9w0zG4zFBd8r90dFMfTdCb/ofldJD7PCfIjS4w+XiPUrxt2+0VjougdNz",
+	"lndXS12hhhzTFX0PqYPr2gqdfEncEW1vTr95iS3rAB8aJgG/5sVATrnYcOPuV2fMGMoslw0mQuTWJ7q2",
+	"nO1kQYPJg53jcscU1LdnDjkrO1/l45lQ/Fp3InTYkPhDy2zoHNYaZjFoLrybRa/Z4ENNet8DL+zqBRYd",
+	"GdDy1EWsVZPKwpe5XVFnV7LEJIgaLBdFyEgo3DF402qS8vzqlfN2OnWcl2ai1Cbg5Dmyl0+ZqbIV46aV",
+	"+MlMWQmgmU+wrzQ5X7E1LDmRWFIDFB23buaNbQRBLnLnvsXNsC9OGp+N840f7DnLUACsyml4M88KvnTA",
+	"mynLhbma4k2bXYGeOb0qoxK7UVbJk8kBWekvV9BJQu/hwNVM2Q3XkrDFRXEy1pzk59pDYWYXiZnDiCur",
+	"Bxx1LcV0flgysVbx5DaITomXZnO7sL9WxjKDyp3kTpi7bUWdLTs89D2KUpvyw/VQjtFQ6Ja+d8tbX4Ev",
+	"R1RquBaqCh6gIQ4jaILcrz6Hdatw7gDbS0Y3/dGW00GzIJ1juPHL9Fv3wy/OE4SBtHr7J7D69ja9W5U5",
+	"8cilFtE95TVfPVY5oMtqCcNjikCn6g37J2FQkTuJokVLvfrNPbJ6OeYV0MPH7XRynh8kJ6dqVk/cKKlj",
+	"9wpTe1HJy++B56Df7Cnp2ZTxpCNWKiPqRxgrcLCQk42GOxkb8IQELOKSpP2xgiP8NWRW6ZaDrwY4pEAp",
+	"ThZsvf8q7TmsRavjwnxFz11lPKeTVo78H2C7c2W8n3k8yp4/mMNwqGjlWR3G4aJQUSCrU0Z18jaMjh6n",
+	"3KTiup1oO5UBTUZZxKd1KjRLGU+bxO+ijqWkwniHGxsagAp+R3gKfjxwhnJpXMH2gWEtajh/GY3fCyS+",
+	"S+UtwoCzfIdEm0P2I+8KI0xNGYSFEJbgukNTXXawaFpUt+COcwWSxIujqWWwY8prZeGOc2HXg+qmUFjg",
+	"UDL4N644SnRdDqsdXrpXlnfS5XXlrlg5h3aGbuXpG1/5i/Ly1ybTUAMMTPgtFOFwsxTiKs5V7AzUWLcl",
+	"tDhKYjpqhir6FNCLembRBJH1fZv6e+ziMbNCoRgxGwpqbcdt1U7PD4zzTm+SiBFcC9Aa8toSWigDM6tC",
+	"0NkuOHahwpAL/p2QYAbrhzvgBmvHvW2K461FphWnWnHce97HC2Qa1hyh01EJu+E5dyH7hfseEoGEOvp7",
+	"Fcs1vc72unmG8EFhekiMqX7B/G25P8HIXXTMQkrQs2Bw7tazk+2skFS4Jq8yd0HHB6PWw4/O37WDlSTV",
+	"s1l/lZ03QpSo4wq2p+4R5FN21DsYA+0kJwd6VLGns8lH1bqbFNzLo4D3x+ayLJUqZgM2zvN+Eb4uxV8J",
+	"9BVjqmrCbLwuI26Jk7DPyLRWO7HcrLah6FxZgoT84QljZ9IFNgZ/lrgMYG9y+cDumn9Ds+aVq4vpdekn",
+	"72Q6QowqVup7crMwzG4e5qo63HMqN8juiexGDnna3VB1S8hjnJ6MfZX3PUy6CXMbonJQpGSSC2eofkEH",
+	"PaU4ojQsUb4g8l/gzBu4mSlUKp7gLqlicKg0puLJCCALckzGkhoKP3gSAd55b09aUv85JN5UC6ah8R25",
+	"awZSn9TTsWYz9KLvzlzP0uZ3C6UhnpF8U1224Tr4DhkOeWzpubCa6+1d8oS2UZXSngxiea8XZu2A2Syk",
+	"ccLs47Ao1M2MmNWsLhSbetpiO9O+jH1Jw6bALHm+zSFy5+TGC2pbtuI5y5TWkMU90jHnDqq10jDDrNLJ",
+	"bC+vxMKi3L2mQFOJuYeZKjOVgyu4nKagobkqiRr7fFbT5CAKHO3gSn2fiI5HTol3qjMfz0jU2lufMGz+",
+	"JfZx2TOazHJu0TPnwjAQqADGZ5LzGHKN+/AS4bjUS11d4lDBlQ3RDejUkV8wqzEqxLeg0VskRAefSu0I",
+	"YxwoNS3diKKg5BVi0/ADqP2V0qgdEHvPyZv6WpDLXTuRCfVgJd55dXaXmAdcxKnXmF1pqpzTVPio4QxP",
+	"Xl35B3E8ys+mIq9Iio/BKZ45a4d7abqRmiU3nqafZUparYqiU7zI0Y3XtL/mm7Mss6+UusKEJA/pXSuV",
+	"rVeaT0OOh65PcDPTrlIsGzkjGjD704W7djhL4AKjGWSHxfWU4vu0zBGY7/dz0P0697P+wrrrajPT9DMG",
+	"sypbtRZZ+kz9cznZDrrGplhUChWuhzv4jojpsMeXVe1TRSyyj2aQSLCp/fKMwPuWELvB/5IE3h2XLYDb",
+	"3tzRRdlnLl6KmmWDsl4HAIJUyKUPVsD/tSSxmquopUvXQp4xXUBH3irkgHg/2HCEowNl4V5A9ZyeawA/",
+	"c8qHqctv6RyoMWjOf3/YJMC8E/C3u6m8xTyGPDsvGtLyDiMhWdYAR0in2d/pBnlJqTfmY50hTbBgjbzh",
+	"IwCG3SNbMIxykjwUDLT1Y4FqO3C5k45qGr20fURmNHoozEWzsIy7CxvtI1wUlQafvMmJ+Lpt/yq5XYWr",
+	"E5v3NcmolfRRrb+DVmTnzqeR/QUKV5OwowxQ5ayAa2h5jTpaNhWJmuIaQl9Td2Y5QEnWyK6OLOUOGd/l",
+	"HcWJX/ts0MMnjd2kJsUh1u0U26MmSSp1NnLmjokZe5QQomuRV7yFP3OoyNFWA+JRTqCq90aYhXfk2Gl+",
+	"diO8DQOchf4pUSZg4v04PnQwC0qjbhcD2useXZmhUy/T3tFxurTawEKz5bUh1pF4wzdMyW/ksEKyT/LN",
+	"c2vkPgklI8R+s4GMpBr/3oHcv3gGjBQ+8xJRuwTI3asAuyS07SuQTKrm2UPayPBUafK4hh/cxNRISP+a",
+	"voNRuXFivv/OMhqMmU5Cx8GHhK7p9O7q+T/kJO48iIPjpWjEgI/63aH/CtTtnx3UQFVFziTuJ8r+K34N",
+	"4RbzXHzK5lUYCLUVrkhc/A59CcEOqmRsAnIrCpkQnfMhodvdYH1Vh4jCVNCCrzT9I5Vl/6h4IRZb4jMO",
+	"/NCNmRVHEvKG17pYm4CCIN0tXk0DYB6EXIWp3LrF2DGj4bY4SgQ0XuShwJhia34F8TaQs4Pjn5lFxmmq",
+	"OWku8MrubGcfC37xIU3UmufxS5+S1W5b3CGkL8fe/38TAhtPFXJMlgXPIG+VSWvzGRSGauKyK1jvjpHu",
+	"87VAAqFVRLQ6JNXI76AyPZB1pQKPhkpAtcCOnhHtClDHWcZIzW+nzs+O6PJRSzn2LhxW/zMCmkz3IdHn",
+	"HvBdgmbf9pPgP5lHemgZY8D/s+C9LsA2DC81+RRYbiXeScDqtNVztZlpWJh9DibUGoFvADa1ilXITAM3",
+	"zuPm/Cf/8GzSJAvJQmRFY9OsR8lhIWTDLIUsK5t4x1C2ZLmNEBYr/QmtAya0ISkBhclrXvx0DVqLfGjj",
+	"8HSoRbdMTTB0+L4JFUZ9p/YHEKZ5w1FYdqNGj5vhBe4K4Tl3TWO5zLnO4+ZCUjFfjmVK+dbc3aJUGwf2",
+	"2ZR4JM20k4VE1iUibQdIsfVG4Xvae2oA+RENPyMMNpcr8NTfNtY41Y5VA/aZPgz/FAabNd+gjY+Ch4fC",
+	"Qlx+bLLwUTMKucq4dPLZuHWHeYz4HXZPQ6VBPCOyimYdM8Xuc/8TbSU9I3+Wwu48+U5H2Y3mdn637mAG",
+	"pMpl4/zviKV/HsssPVnZDsKvI218qEqgPYg2EQbsQ229+MAukhuEz94QK8HHl1xse1qkwvydZmBGGgOz",
+	"w72/SUVHuDZeldRzN+uqGhxSpj5JwoGaNqefD/fSAHg+qM+d9fa0tcsMjnNIncrdaRFmpSpn2RifT1c9",
+	"KHcABEjbMA7HfMFu6qjdY0xdTyumxnZhrUNLdQ4W9tpn7SqzXY/+ITXRAEdvmyDUgngZHWGnHFM6VqZM",
+	"uzFmbTVYzSQYZxqySpOa+IZv95c+HMhaf/H92RdPnv7t6RdfMmzAcrEE01Q+6JQObPwChezqfT6tJ2Bv",
+	"eTa9CSHpCH2u7Y8hqKreFH/WHLc1TVrjXuHEQ/TLiQsgcRwTJevutFc0TuPa/+fartQij75jKRR8/D1D",
+	"N4105ZlarkoYUFK7FZlQ8AVSgjbCWJC2YwEVtvGINitSD1L+8WuXRErJDIL+2FOBsAMuV6mFDDnUEj/D",
+	"T6HYP4NNWXhedRMiwgfX5d9pTkNHQiN5xaAWS5VetBcLloKIIoh0FFnrFZ+kEY98ZGtm67xlU4ToPc/T",
+	"pBcX7d/N7dsFpW2a0+MmJsSLcCjvQJpD9onhdCV34SSNav9Pwz8S+VeOxjXq5X4MXpF8H+yIOT7r+T3U",
+	"uUdGgdbPxZEgDwJgINq2FScZBYpFydC1sxKQPSEYkLvix+vGsLw3LIQgCR32gBeHzzbt6kgGD84fnFX8",
+	"dY2UaCnvhyihtfx9EbmB9dYXSbRFXmliLRjHllRfLIzCrc2LOop54FXSC3bWSlmmJOpGEkHSTo9DZyom",
+	"HCEt6GtefHqu8a3Qxp4RPiB/OxwaFUfKxkh2qDR3S8/5io+au+AfYWr5hgKz/wtwj5L3nB/KG+F7txkp",
+	"d3jh3KsXtTUaJLuhMWmn2ZMv2dwX/Ck1ZMJ0jfs3QTipA0NBo3WMpoCN3ROJum+dvyh7DzJeBE8c9mNk",
+	"3qpt9h7C5oj+wUxl4OQmqTxFfT2ySOAvyaO2MntRaZMSCc9YUIlaJB4l8elulHYuAgtF+ls9EFA/LiuQ",
+	"2crMj3pyYGGL+FjfrJTxd6lPnuR8H2INGAIuAXJz31xZraoSKZzGRdf3XMH3LLhztwxaUS7MAzNo9cvJ",
+	"j10erYMu8spAf52jJaAWbhPCT7O2senfRtftwdJo8zFZ29I1drA7pY07SrGdg0rtfISEcQ5Hfgw/b4pi",
+	"fhlKIe7SZA+UOejsB1ZE2GupjItWYBAzSDDCUFmGv/maYJ9WPgkQuGwW/aPqYL1PCh6HmMRaW5NHU0Xl",
+	"KEZUovDdEsnjKFI0q7SwW6oHH5SS4m/JHFff1flSfL6d2j7p5QmrrkAGH5omu0plgsTyneIF3fHObCqB",
+	"WaWKE/aNK5bgD8pfH8z/Az7/y7P88edP/mP+l8dfPM7g2RdfPX7Mv3rGn3z1+RN4+pcvnj2GJ4svv5o/",
+	"zZ8+ezp/9vTZl198lX3+7Mn82Zdf/ccD5EMIsgM0VEl5Pvlfs7NiqWZnb85nlwhsgxNeCkxJc3tL+oeF",
+	"wuUTUjM6ibDmopg8Dz/9j3DCTjK1boYPv0583b3JytrSPD89vbm5OYm7nC4pncLMqipbnYZ5erkEz96c",
+	"13EPzreJdrTRyJ9MGlI4o29vv7m4ZGdvzk8agpk8nzw+eXzyBMdXJUheisnzyef0E52eFe37KaUqPjW+",
+	"CslpHf92O+19K0tXowQ/eRr1f7kceK0/TuvEd/jbGqwWWfhLA8+3/v/mhi8xOR1Fybifrp+eBqnv9IPP",
+	"UHG769tp7IEzuuHph1bGj3zPFLUrStJIjLFe5KMQBNYHpuNYg3tT7+F5jnvnWpI3jDlvuGiouY+HzEye",
+	"/5pShrmurKzmhciYu/uJ+HFnI9qs87g0vIc0nxPHe3EhDSdF7vh49tX7D1/85TYl9XYBee0ttI1JKqRI",
+	"tMpHjJwEuP5Rgd42gJH7xCQGo2+/Taez21hW+gI0fjaM5oPmXeAYUu2iO9+2MwGGTgOA4RApuGosvJ9O",
+	"nJbFOM759PHjwDb8Qyciq1NP1jG628agnqPWIfklYkeqlESFi5kRPvoU+7NxObAQm0JyF+ZA/s9rfuXM",
+	"YOThGGqCBYx6p2lCch3Q47cl3Awfsc7diCj5Ien+ts9qB05g8G2ONZWFcHpY72+2QmU5+Yg2yRJup5Nn",
+	"B1LDTo1hK49zAvzXvECQIQ95fBwETz4dBOfSueDineXu1tvp5ItPiYNzaUFLXjBqGdVkT1C8vJLqRoaW",
+	"KAhV6zXXWxJz7Jg99mmnyLgb2jm6d7cyxzP868SxZSoIVYIW+ILHIq+3+66X0w8+59Key8gnUpqNvvfq",
+	"DuEWG24aMpkNt2gF9oxteOr93KMOSw0URXVqxRp8NYvwbeQ9vavZ6VxtDmjaW/i+DmDGNh5A2I4t734a",
+	"GIJ0vOb0A3G826HfT72paeCjk5aHPpMy2bXpYqjb0qUzSn9skcIHu8E17R4O20Tj+eTTpx/oPyQcRwt2",
+	"6qNTu5Gn5Hx3+kHk/c89PLV/b7rHLa7XKocAnFosDNg9n08/uH+jiVp8oJEh2/LgN1EjlwE6LWp0KsZE",
+	"vZh7O2D8Qu7ugmcjOkhl40534p9vSdoz7Kcf0FQM3SmECTMcwCZdYt1Tqqq+bXAZft7KLPnjqVMYmp0f",
+	"Tz+gvHc7pk2fbOK2vY+tDKYDP5+Gd3LqfdNu+aH1Z5tNmFVlc3UTzUJae2dy6kNWp/pu/X16w4VFnZFP",
+	"nMkXFnS/swVenPriWJ1fm3oUvS9UZCP6McnF4l9Pud/XSalM4oy85TeRqf2MGjvpD4z9WuXbHZLHZjYX",
+	"ksg1lj4axZL72H/3JLP9k1dqsHf2k15R5h2teJ5xY/EPnzO/9xK7TZ7xTy1Jfs1zFhIWzVgjV5559UVr",
+	"aX8OKTPJ215i5DZSDFOa7WN0f7Cc+sXjzz/d9Begr0UG7BLWpdJci2LLfpZ1tNud+f63RN5Y9YHebzXJ",
+	"O1dozW9alKN0wk/eu9E2hRhDRh9gdsNWXOYF6DoQoQSNtInjU8KeVkECn+e1VJoAcKleIXdeR+aEXdQ+",
+	"WeThVIUncO7IhkyQOISfhJO/lrPZj7i3UAmP/GAJcuY50myu8q0v4TfR/MZuXCKLHttzb4gBntgTnVNf",
+	"vVQ10CgEaYTPjQI7VgiTsqlWBf/6HpUdBvR10EM1+s3np6cUtbdSxp5ObqfxN9P5+L7GXDAtTkotrhGa",
+	"W0Ka0gJVEMXMKwOb4qWTpyePJ7f/bwD54fO7RjgBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
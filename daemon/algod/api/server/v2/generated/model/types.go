@@ -82,12 +82,30 @@ const (
 	AccountAssetInformationParamsFormatMsgpack AccountAssetInformationParamsFormat = "msgpack"
 )
 
+// Defines values for GetAccountTransactionsParamsFormat.
+const (
+	GetAccountTransactionsParamsFormatJson    GetAccountTransactionsParamsFormat = "json"
+	GetAccountTransactionsParamsFormatMsgpack GetAccountTransactionsParamsFormat = "msgpack"
+)
+
 // Defines values for GetPendingTransactionsByAddressParamsFormat.
 const (
 	GetPendingTransactionsByAddressParamsFormatJson    GetPendingTransactionsByAddressParamsFormat = "json"
 	GetPendingTransactionsByAddressParamsFormatMsgpack GetPendingTransactionsByAddressParamsFormat = "msgpack"
 )
 
+// Defines values for GetApplicationTransactionsParamsFormat.
+const (
+	GetApplicationTransactionsParamsFormatJson    GetApplicationTransactionsParamsFormat = "json"
+	GetApplicationTransactionsParamsFormatMsgpack GetApplicationTransactionsParamsFormat = "msgpack"
+)
+
+// Defines values for GetAssetTransactionsParamsFormat.
+const (
+	GetAssetTransactionsParamsFormatJson    GetAssetTransactionsParamsFormat = "json"
+	GetAssetTransactionsParamsFormatMsgpack GetAssetTransactionsParamsFormat = "msgpack"
+)
+
 // Defines values for GetBlockParamsFormat.
 const (
 	GetBlockParamsFormatJson    GetBlockParamsFormat = "json"
@@ -303,6 +321,93 @@ type AccountStateDelta struct {
 	Delta StateDelta `json:"delta"`
 }
 
+// AgreementProposalArrival A proposal-vote accepted by the node.
+type AgreementProposalArrival struct {
+	// Arrival Time at which the proposal-vote was validated, in nanoseconds since the start of the round.
+	Arrival uint64 `json:"arrival"`
+
+	// BlockHash The hash of the proposed block.
+	BlockHash string `json:"block-hash"`
+
+	// Credential The digest of the lowest VRF output of the proposer credential.
+	Credential string `json:"credential"`
+
+	// Period The period of the proposal.
+	Period uint64 `json:"period"`
+
+	// Sender The address of the proposer.
+	Sender string `json:"sender"`
+
+	// Weight The weight of the proposer credential.
+	Weight uint64 `json:"weight"`
+}
+
+// AgreementRoundTimeline Agreement events observed by the node during a round. Arrival times are measured in nanoseconds from the time the node entered the round.
+type AgreementRoundTimeline struct {
+	// DynamicFilterTimeout The filter timeout estimated from the credential arrival history, in nanoseconds. Not set until enough credential arrivals were collected.
+	DynamicFilterTimeout *uint64 `json:"dynamic-filter-timeout,omitempty"`
+
+	// EndTime Unix time in nanoseconds at which the node entered the next round. Not set while the round is in progress.
+	EndTime *uint64 `json:"end-time,omitempty"`
+
+	// FilterTimeout The filter timeout in effect in period 0, in nanoseconds.
+	FilterTimeout uint64 `json:"filter-timeout"`
+
+	// Proposals Proposal-votes accepted in this round.
+	Proposals []AgreementProposalArrival `json:"proposals"`
+
+	// Round The round number.
+	Round uint64 `json:"round"`
+
+	// StartTime Unix time in nanoseconds at which the node entered the round.
+	StartTime uint64 `json:"start-time"`
+
+	// Thresholds Vote thresholds reached in this round.
+	Thresholds []AgreementThreshold `json:"thresholds"`
+
+	// Timeouts Timeouts fired in this round.
+	Timeouts []AgreementTimeout `json:"timeouts"`
+}
+
+// AgreementThreshold A vote threshold reached by the node.
+type AgreementThreshold struct {
+	// Arrival Time at which the threshold was reached, in nanoseconds since the start of the round.
+	Arrival uint64 `json:"arrival"`
+
+	// BlockHash The hash of the block the votes are for, or the empty block hash for bottom.
+	BlockHash string `json:"block-hash"`
+
+	// Period The period of the threshold.
+	Period uint64 `json:"period"`
+
+	// Step The step of the threshold.
+	Step uint64 `json:"step"`
+
+	// Type The threshold type: soft, cert or next.
+	Type string `json:"type"`
+
+	// Weight The total weight of the votes reaching the threshold. Not set when the threshold was reached from a bundle.
+	Weight *uint64 `json:"weight,omitempty"`
+}
+
+// AgreementTimeout A timeout fired in agreement.
+type AgreementTimeout struct {
+	// Arrival Time at which the timeout fired, in nanoseconds since the start of the round.
+	Arrival uint64 `json:"arrival"`
+
+	// Deadline The deadline of the timeout, in nanoseconds.
+	Deadline uint64 `json:"deadline"`
+
+	// Fast Whether this is a fast recovery timeout.
+	Fast bool `json:"fast"`
+
+	// Period The period in which the timeout fired.
+	Period uint64 `json:"period"`
+
+	// Step The step in which the timeout fired.
+	Step uint64 `json:"step"`
+}
+
 // AppCallLogs The logged messages from an app call along with the app ID and outer transaction ID. Logs appear in the same order that they were emitted.
 type AppCallLogs struct {
 	// ApplicationIndex The application from which the logs were generated
@@ -547,6 +652,9 @@ type Box struct {
 type BoxDescriptor struct {
 	// Name Base64 encoded box name
 	Name []byte `json:"name"`
+
+	// Value Base64 encoded box value, only returned when the values of the boxes are requested
+	Value *[]byte `json:"value,omitempty"`
 }
 
 // BoxReference References a box of an application.
@@ -669,6 +777,32 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// HealthCheck The result of one of the node's health checks.
+type HealthCheck struct {
+	// Details The values the check was evaluated on, such as round numbers, peer counts or free megabytes.
+	Details *map[string]uint64 `json:"details,omitempty"`
+
+	// Message Why the check did not pass.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the check: catchup, network-lag, peers, disk, tracker-commit or participation.
+	Name string `json:"name"`
+
+	// Status The status of the check: pass, warn or fail.
+	Status string `json:"status"`
+}
+
+// HealthChecks The results of the node's health checks.
+type HealthChecks struct {
+	Checks []HealthCheck `json:"checks"`
+
+	// Round The latest round of the node's ledger.
+	Round uint64 `json:"round"`
+
+	// Status The most severe status of the checks: pass, warn or fail.
+	Status string `json:"status"`
+}
+
 // KvDelta A single Delta containing the key, the previous value and the current value for a single round.
 type KvDelta struct {
 	// Key The key, base64 encoded.
@@ -1003,6 +1137,15 @@ type StateProofMessage struct {
 	VotersCommitment []byte `json:"VotersCommitment"`
 }
 
+// SyncCursor A named retention cursor of a follower node.
+type SyncCursor struct {
+	// Name The name of the sync cursor.
+	Name string `json:"name"`
+
+	// Round The first round whose deltas the consumer of the cursor needs.
+	Round uint64 `json:"round"`
+}
+
 // TealKeyValue Represents a key-value pair in an application store.
 type TealKeyValue struct {
 	Key string `json:"key"`
@@ -1112,6 +1255,18 @@ type AccountApplicationResponse struct {
 	Round uint64 `json:"round"`
 }
 
+// AccountApplicationsInformationResponse defines model for AccountApplicationsInformationResponse.
+type AccountApplicationsInformationResponse struct {
+	// AppsLocalState Local state of the applications this account is opted in to.
+	AppsLocalState *[]ApplicationLocalState `json:"apps-local-state,omitempty"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
 // AccountAssetResponse defines model for AccountAssetResponse.
 type AccountAssetResponse struct {
 	// AssetHolding Describes an asset held by an account.
@@ -1143,12 +1298,42 @@ type AccountAssetsInformationResponse struct {
 	Round uint64 `json:"round"`
 }
 
+// AccountCreatedApplicationsInformationResponse defines model for AccountCreatedApplicationsInformationResponse.
+type AccountCreatedApplicationsInformationResponse struct {
+	// CreatedApps Parameters of the applications created by this account including app global data.
+	CreatedApps *[]Application `json:"created-apps,omitempty"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
+// AccountCreatedAssetsInformationResponse defines model for AccountCreatedAssetsInformationResponse.
+type AccountCreatedAssetsInformationResponse struct {
+	// CreatedAssets Parameters of the assets created by this account.
+	CreatedAssets *[]Asset `json:"created-assets,omitempty"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
+}
+
 // AccountResponse Account information at a given round.
 //
 // Definition:
 // data/basics/userBalance.go : AccountData
 type AccountResponse = Account
 
+// AgreementTimelineResponse defines model for AgreementTimelineResponse.
+type AgreementTimelineResponse struct {
+	// Rounds Timelines of the retained rounds, oldest first.
+	Rounds []AgreementRoundTimeline `json:"rounds"`
+}
+
 // ApplicationResponse Application index and its parameters
 type ApplicationResponse = Application
 
@@ -1193,6 +1378,12 @@ type BoxResponse = Box
 // BoxesResponse defines model for BoxesResponse.
 type BoxesResponse struct {
 	Boxes []BoxDescriptor `json:"boxes"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+
+	// Round The round for which this information is relevant.
+	Round uint64 `json:"round"`
 }
 
 // CatchpointAbortResponse An catchpoint abort response.
@@ -1249,6 +1440,42 @@ type GetSyncRoundResponse struct {
 	Round uint64 `json:"round"`
 }
 
+// HealthChecksResponse The results of the node's health checks.
+type HealthChecksResponse = HealthChecks
+
+// LedgerEntryProofResponse defines model for LedgerEntryProofResponse.
+type LedgerEntryProofResponse struct {
+	// BlockHash The hash of the block of the catchpoint round.
+	BlockHash []byte `json:"block-hash"`
+
+	// Catchpoint The label of the last catchpoint. The proof can only be trusted once this label is checked to match one obtained from a trusted source, and to commit to the root along with the other catchpoint fields.
+	Catchpoint string `json:"catchpoint"`
+
+	// Entry The msgpack encoded account or resource data, or the box value, the proven trie key is built from.
+	Entry []byte `json:"entry"`
+
+	// OnlineAccountsHash The hash of the online accounts history the catchpoint label commits to, zero for labels that predate it.
+	OnlineAccountsHash []byte `json:"online-accounts-hash"`
+
+	// OnlineRoundParamsHash The hash of the online round params history the catchpoint label commits to, zero for labels that predate it.
+	OnlineRoundParamsHash []byte `json:"online-round-params-hash"`
+
+	// Proof The serialized Merkle inclusion proof of the trie key.
+	Proof []byte `json:"proof"`
+
+	// Root The root hash of the catchpoint merkle trie at round, the balances root the catchpoint label commits to.
+	Root []byte `json:"root"`
+
+	// Round The accounts round of the last catchpoint, which the entry is proven at.
+	Round uint64 `json:"round"`
+
+	// StateProofVerificationHash The hash of the state proof verification data the catchpoint label commits to, zero for labels that predate it.
+	StateProofVerificationHash []byte `json:"state-proof-verification-hash"`
+
+	// Totals The msgpack encoded account totals as of round.
+	Totals []byte `json:"totals"`
+}
+
 // LedgerStateDeltaForTransactionGroupResponse Ledger StateDelta object
 type LedgerStateDeltaForTransactionGroupResponse = LedgerStateDelta
 
@@ -1308,6 +1535,9 @@ type NodeStatusResponse struct {
 	// NextVersionSupported NextVersionSupported indicates whether the next consensus version is supported by this node
 	NextVersionSupported bool `json:"next-version-supported"`
 
+	// P2pReachability P2PReachability is the reachability of the node from the public internet as determined by AutoNAT: public, private or unknown. Only set when running a p2p network.
+	P2pReachability *string `json:"p2p-reachability,omitempty"`
+
 	// StoppedAtUnsupportedRound StoppedAtUnsupportedRound indicates that the node does not support the new rounds and has stopped making progress
 	StoppedAtUnsupportedRound bool `json:"stopped-at-unsupported-round"`
 
@@ -1402,6 +1632,22 @@ type SupplyResponse struct {
 	TotalMoney uint64 `json:"total-money"`
 }
 
+// SyncCursorsResponse defines model for SyncCursorsResponse.
+type SyncCursorsResponse struct {
+	// Cursors The sync cursors, ordered by name.
+	Cursors []SyncCursor `json:"cursors"`
+}
+
+// TransactionActivityResponse defines model for TransactionActivityResponse.
+type TransactionActivityResponse struct {
+	// CurrentRound The latest round covered by the transaction activity index.
+	CurrentRound uint64 `json:"current-round"`
+
+	// NextToken Used for pagination, when making another request provide this token with the next parameter.
+	NextToken    *string                      `json:"next-token,omitempty"`
+	Transactions []PendingTransactionResponse `json:"transactions"`
+}
+
 // TransactionGroupLedgerStateDeltasForRoundResponse defines model for TransactionGroupLedgerStateDeltasForRoundResponse.
 type TransactionGroupLedgerStateDeltasForRoundResponse struct {
 	Deltas []LedgerStateDeltaForTransactionGroup `json:"Deltas"`
@@ -1469,6 +1715,9 @@ type AccountInformationParams struct {
 
 	// Exclude When set to `all` will exclude asset holdings, application local state, created asset parameters, any created application parameters. Defaults to `none`.
 	Exclude *AccountInformationParamsExclude `form:"exclude,omitempty" json:"exclude,omitempty"`
+
+	// Round Return the state at the given round instead of the latest round. Requires exclude to be set to `all`, as asset holdings, application local state, created asset parameters and created application parameters are only available at the latest round. Rounds older than the ones held in memory can only be queried on archival nodes with EnableHistoricalStateIndex enabled.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // AccountInformationParamsFormat defines parameters for AccountInformation.
@@ -1477,10 +1726,22 @@ type AccountInformationParamsFormat string
 // AccountInformationParamsExclude defines parameters for AccountInformation.
 type AccountInformationParamsExclude string
 
+// AccountApplicationsInformationParams defines parameters for AccountApplicationsInformation.
+type AccountApplicationsInformationParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
 // AccountApplicationInformationParams defines parameters for AccountApplicationInformation.
 type AccountApplicationInformationParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *AccountApplicationInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Round Return the state at the given round instead of the latest round. Rounds older than the ones held in memory can only be queried on archival nodes with EnableHistoricalStateIndex enabled.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // AccountApplicationInformationParamsFormat defines parameters for AccountApplicationInformation.
@@ -1499,11 +1760,62 @@ type AccountAssetsInformationParams struct {
 type AccountAssetInformationParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *AccountAssetInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Round Return the state at the given round instead of the latest round. Rounds older than the ones held in memory can only be queried on archival nodes with EnableHistoricalStateIndex enabled.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // AccountAssetInformationParamsFormat defines parameters for AccountAssetInformation.
 type AccountAssetInformationParamsFormat string
 
+// AccountCreatedApplicationsInformationParams defines parameters for AccountCreatedApplicationsInformation.
+type AccountCreatedApplicationsInformationParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
+// AccountCreatedAssetsInformationParams defines parameters for AccountCreatedAssetsInformation.
+type AccountCreatedAssetsInformationParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
+// GetAccountProofParams defines parameters for GetAccountProof.
+type GetAccountProofParams struct {
+	// AssetId Prove the account's params and holding of this asset.
+	AssetId *uint64 `form:"asset-id,omitempty" json:"asset-id,omitempty"`
+
+	// ApplicationId Prove the account's params and local state of this application.
+	ApplicationId *uint64 `form:"application-id,omitempty" json:"application-id,omitempty"`
+}
+
+// GetAccountTransactionsParams defines parameters for GetAccountTransactions.
+type GetAccountTransactionsParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetAccountTransactionsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAccountTransactionsParamsFormat defines parameters for GetAccountTransactions.
+type GetAccountTransactionsParamsFormat string
+
 // GetPendingTransactionsByAddressParams defines parameters for GetPendingTransactionsByAddress.
 type GetPendingTransactionsByAddressParams struct {
 	// Max Truncated number of transactions to display. If max=0, returns all pending txns.
@@ -1516,18 +1828,93 @@ type GetPendingTransactionsByAddressParams struct {
 // GetPendingTransactionsByAddressParamsFormat defines parameters for GetPendingTransactionsByAddress.
 type GetPendingTransactionsByAddressParamsFormat string
 
+// GetAgreementTimelineParams defines parameters for GetAgreementTimeline.
+type GetAgreementTimelineParams struct {
+	// Round Only return the timeline of this round.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+}
+
+// GetApplicationByIDParams defines parameters for GetApplicationByID.
+type GetApplicationByIDParams struct {
+	// Round Return the state at the given round instead of the latest round. Rounds older than the ones held in memory can only be queried on archival nodes with EnableHistoricalStateIndex enabled.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+}
+
 // GetApplicationBoxByNameParams defines parameters for GetApplicationBoxByName.
 type GetApplicationBoxByNameParams struct {
 	// Name A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
 	Name string `form:"name" json:"name"`
+
+	// Round Return the state at the given round instead of the latest round. Rounds older than the ones held in memory can only be queried on archival nodes with EnableHistoricalStateIndex enabled.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+}
+
+// GetApplicationBoxProofParams defines parameters for GetApplicationBoxProof.
+type GetApplicationBoxProofParams struct {
+	// Name A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	Name string `form:"name" json:"name"`
 }
 
 // GetApplicationBoxesParams defines parameters for GetApplicationBoxes.
 type GetApplicationBoxesParams struct {
 	// Max Max number of box names to return. If max is not set, or max == 0, returns all box-names.
 	Max *uint64 `form:"max,omitempty" json:"max,omitempty"`
+
+	// Prefix Only return the boxes whose names start with this prefix, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// Limit Maximum number of boxes to return. Defaults to 1000, and cannot exceed 1000 or the MaxAPIBoxPerApplication limit of the node.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Values Also return the values of the boxes.
+	Values *bool `form:"values,omitempty" json:"values,omitempty"`
 }
 
+// GetApplicationTransactionsParams defines parameters for GetApplicationTransactions.
+type GetApplicationTransactionsParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetApplicationTransactionsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApplicationTransactionsParamsFormat defines parameters for GetApplicationTransactions.
+type GetApplicationTransactionsParamsFormat string
+
+// GetAssetTransactionsParams defines parameters for GetAssetTransactions.
+type GetAssetTransactionsParams struct {
+	// Limit Maximum number of results to return.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Next The next page of results. Use the next token provided by the previous results.
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// MinRound Include results at or after the specified min-round.
+	MinRound *uint64 `form:"min-round,omitempty" json:"min-round,omitempty"`
+
+	// MaxRound Include results at or before the specified max-round.
+	MaxRound *uint64 `form:"max-round,omitempty" json:"max-round,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetAssetTransactionsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAssetTransactionsParamsFormat defines parameters for GetAssetTransactions.
+type GetAssetTransactionsParamsFormat string
+
 // GetBlockParams defines parameters for GetBlock.
 type GetBlockParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
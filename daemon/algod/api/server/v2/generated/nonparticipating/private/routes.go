@@ -184,233 +184,264 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5PbtpI4+lVQ2q3yY8UZ23GyJ751au/ETnLmxklcHid7d23fE4hsSThDATwAOCPF",
-	"19/9V+gGSJAEJWpm4iS1+5c9Ih6NRqPR6OeHWa42lZIgrZk9+zCruOYbsKDxL57nqpY2E4X7qwCTa1FZ",
-	"oeTsWfjGjNVCrmbzmXC/VtyuZ/OZ5Bto27j+85mGf9ZCQzF7ZnUN85nJ17DhbmC7q1zrZqRttlKZH+KM",
-	"hjh/Mfu45wMvCg3GDKH8UZY7JmRe1gUwq7k0PHefDLsWds3sWhjmOzMhmZLA1JLZdacxWwooC3MSFvnP",
-	"GvQuWqWffHxJH1sQM61KGML5XG0WQkKAChqgmg1hVrEClthozS1zMzhYQ0OrmAGu8zVbKn0AVAIihhdk",
-	"vZk9ezszIAvQuFs5iCv871ID/AqZ5XoFdvZ+nlrc0oLOrNgklnbusa/B1KU1DNviGlfiCiRzvU7Y97Wx",
-	"bAGMS/b6m+fss88++9ItZMOthcIT2eiq2tnjNVH32bNZwS2Ez0Na4+VKaS6LrGn/+pvnOP+FX+DUVtwY",
-	"SB+WM/eFnb8YW0DomCAhIS2scB861O96JA5F+/MClkrDxD2hxne6KfH8v+uu5Nzm60oJaRP7wvAro89J",
-	"HhZ138fDGgA67SuHKe0Gffso+/L9h8fzx48+/svbs+y//Z+ff/Zx4vKfN+MewECyYV5rDTLfZSsNHE/L",
-	"msshPl57ejBrVZcFW/Mr3Hy+QVbv+zLXl1jnFS9rRyci1+qsXCnDuCejApa8Li0LE7Nalo5NudE8tTNh",
-	"WKXVlSigmDvue70W+Zrl3NAQ2I5di7J0NFgbKMZoLb26PYfpY4wSB9eN8IEL+uMio13XAUzAFrlBlpfK",
-	"QGbVgesp3DhcFiy+UNq7yhx3WbE3a2A4uftAly3iTjqaLssds7ivBeOGcRaupjkTS7ZTNbvGzSnFJfb3",
-	"q3FY2zCHNNyczj3qDu8Y+gbISCBvoVQJXCLywrkbokwuxarWYNj1Guza33kaTKWkAaYW/4Dcum3/fy5+",
-	"/IEpzb4HY/gKXvH8koHMVQHFCTtfMqlsRBqelhCHrufYOjxcqUv+H0Y5mtiYVcXzy/SNXoqNSKzqe74V",
-	"m3rDZL1ZgHZbGq4Qq5gGW2s5BhCNeIAUN3w7nPSNrmWO+99O25HlHLUJU5V8hwjb8O1fH809OIbxsmQV",
-	"yELIFbNbOSrHubkPg5dpVctigphj3Z5GF6upIBdLAQVrRtkDiZ/mEDxCHgdPK3xF4IRBRsFpZjkAjoRt",
-	"gmbc6XZfWMVXEJHMCfvJMzf8atUlyIbQ2WKHnyoNV0LVpuk0AiNOvV8Cl8pCVmlYigSNXXh0OAZDbTwH",
-	"3ngZKFfSciGhcMwZgVYWiFmNwhRNuP+9M7zFF9zAF0/H7vj268TdX6r+ru/d8Um7jY0yOpKJq9N99Qc2",
-	"LVl1+k94H8ZzG7HK6OfBRorVG3fbLEWJN9E/3P4FNNQGmUAHEeFuMmIlua01PHsnH7q/WMYuLJcF14X7",
-	"ZUM/fV+XVlyIlfuppJ9eqpXIL8RqBJkNrMkHF3bb0D9uvDQ7ttvku+KlUpd1FS8o7zxcFzt2/mJsk2nM",
-	"YwnzrHntxg+PN9vwGDm2h902GzkC5CjuKu4aXsJOg4OW50v8Z7tEeuJL/av7p6pK19tWyxRqHR37KxnV",
-	"B16tcFZVpci5Q+Jr/9l9dUwA6CHB2xaneKE++xCBWGlVgbaCBuVVlZUq52VmLLc40r9qWM6ezf7ltNW/",
-	"nFJ3cxpN/tL1usBOTmQlMSjjVXXEGK+c6GP2MAvHoPETsglieyg0CUmb6EhJOBZcwhWX9qR9snT4QXOA",
-	"3/qZWnyTtEP47j3BRhHOqOECDEnA1PCeYRHqGaKVIVpRIF2VatH8cP+sqloM4vezqiJ8oPQIAgUz2Apj",
-	"zQNcPm9PUjzP+YsT9m08NoriSpY7dzmQqOHuhqW/tfwt1uiW/BraEe8Zhtup9InbmoAGJ+bfBcXhs2Kt",
-	"Sif1HKQV1/hvvm1MZu73SZ3/HCQW43acuPCh5TFHbxz8JXrc3O9RzpBwvLrnhJ31+96MbNwoewjGnLdY",
-	"vGviwV+EhY05SAkRRBE1+e3hWvPdzAuJGQp7QzL5yQBRSMVXQiK0c/d8kmzDL2k/FOLdEQKY5l1EtEQS",
-	"ZKNC9TKnR/3JQM/yJ6DW1MYGSdRJqqUwFt/V2JitoUTBmctA0DGp3IgyJmz4nkU0MF9rXhEt+y8kdgmJ",
-	"73lqRLDe8uKdeCcmYY7YfbTRCNWN2fJB1pmEBLlGD4avSpVf/o2b9R2c8EUYa0j7OA1bAy9AszU368TB",
-	"6dF2O9oU+nYNkWbZIprqpF0i/n1ni8TRDiyz4JZHy/Swp6XZCMYRRNC3Kaj4KomAl2pl7mD5pTqGd1fV",
-	"c16Wbuohz+6tEgeexMnKkrnGDDYCLQb+5UwmBnqAsq95vnZyEct5Wc5bXZmqshKuoGRKMyEl6Dmza25b",
-	"7ocjh4cdMhIDjttbYNFqvJ4NdYy6UcZoYBuOV/DGPeeqstunuUIM30BPDESRQNWoRoleWucvwurgCiQy",
-	"5WZoBL9ZI6qr4sFP3Nz+E84sFS2OVKA22C8b/DUMswO0a90KFLKdQumClPbW/SY0y5WmIUjE8ZO7/wDX",
-	"bWc6nvcrDZkfQvMr0IaXbnW9RT1oyPeuTu5vdWbnsxx0Qk31I/6Hl8x9dmKco6SWegRKYyqyJxckmThU",
-	"0UyuASqcFduQLpdVPL88Csrn7eRp9jLp5H1N6mO/hX4RzQ692YrC3NU24WBje9U9IaS8C+xoIIztZTrR",
-	"XFMQ8EZVjNhHDwTiFDgaIURt7/xe/0ptk9xebQd3utrCneyEG2cys/9KbV94yJQ+jHkce9J1prZM8g0Y",
-	"vN5lzDjdLK1h8myh9M3Eqd4FI1lrbmXcjRpJk/MekrBpXWX+bCZMNtSgN1Dr4bJfCuoPn8JYBwsXlv8G",
-	"WDBu1LvAQnegu8aC2lSihDsg/XVSil1wA589YRd/O/v88ZO/P/n8C0eSlVYrzTdssbNg2H2vl2TG7kp4",
-	"kHweonSRHv2Lp8FI1x03NY5Rtc5hw6vhUGT8o+c/NWOu3RBrXTTjqhsAJ3FEcFcboZ2RXduB9gIW9eoC",
-	"rHVP/VdaLe+cGw5mSEGHjV5V2gkWpmso9dLSaeGanMLWan5aYUuQBTlauHUI4x7Bm8WdENXYxhftLAXz",
-	"GC3g4KE4dpvaaXbxVumdru9CvwNaK528giutrMpVmTk5T6iEhuaVb8F8i7BdVf93gpZdc8Pc3Gi+rWUx",
-	"ooixWzn9/qKh32xli5u9NxitN7E6P++Ufekiv32FVKAzu5UMqbOjH1pqtWGcFdgRZY1vwZL8JTZwYfmm",
-	"+nG5vBt1r8KBEoossQHjZmLUwkk/BnIlyZvxgM7KjzoFPX3EBDObHQfAY+RiJ3O0Fd7FsR1X522ERMcF",
-	"s5N5pNtzMJZQrDpkeXsd3hg6aKp7JgGOQ8dL/IzGihdQWv6N0m9a8fVbrerqztlzf86py+F+Md4cUri+",
-	"QQ8u5KrsetCuHOwnqTX+Lgt63igRaA0IPVLkS7Fa2+i9+Eqr3+BOTM6SAhQ/kLasdH2GOrMfVOGYia3N",
-	"HYiS7WAth3N0G/M1vlC1ZZxJVQBufm3SQuaIzyU6e6GPmo3lVtRPCMMW4Kgr57VbbV0x9MAa3Bdtx4zn",
-	"dEIzRI0Z8T9pHIeoFU1H/nylBl7s2AJAMrXwTh7e/QQXydF9zAYxzYu4CX7RgavSKgdjoMi8Lv4gaKEd",
-	"XR12D54QcAS4mYUZxZZc3xrYy6uDcF7CLkNnR8Puf/ezefA7wGuV5eUBxGKbFHr7+rQh1NOm30dw/clj",
-	"siNNHVGtE28dgyjBwhgKj8LJ6P71IRrs4u3RcgUafWp+U4oPk9yOgBpQf2N6vy20dTXiwu+f6U7Ccxsm",
-	"uVRBsEoNVnJjs0Ns2TXq6BLcCiJOmOLEOPCI4PWSG0t+YEIWqNOk6wTnISHMTTEO8OgzxI38c3iBDMfO",
-	"3T0oTW2a54ipq0ppC0VqDWiSHp3rB9g2c6llNHbz5rGK1QYOjTyGpWh8jyz/AsY/uG0M0N6kPVwcOhW4",
-	"e36XRGUHiBYR+wC5CK0i7MZuzCOACNMimghHmB7lNL7T85mxqqoct7BZLZt+Y2i6oNZn9qe27ZC4yMhB",
-	"93ahwKABxbf3kF8TZsmBfc0N83AEHwNU55DD2hBmdxgzI2QO2T7KxyeeaxUfgYOHtK5WmheQFVDyXcI7",
-	"gj4z+rxvANzx9rmrLGTkiZze9JaSg+PnnqEVjmdSwiPDLyx3R9A9BVoC8b0PjFwAjp1iTp6O7jVD4VzJ",
-	"LQrj4bJpqxMj4m14pazbcU8PCLLn6FMAHsFDM/TNUYGds/bt2Z/iv8D4CRo54vhJdmDGltCOf9QCRnTB",
-	"PsgrOi899t7jwEm2OcrGDvCRsSM7oph+xbUVuajwrfMd7O786defIGk4ZwVYLkooWPSBnoFV3J+RD21/",
-	"zJs9BSfp3obgD5RvieUEP6Uu8Jewwzf3KwrOiFQdd/GWTYzq7icuGQIaXL6dCB43gS3Pbblzgppdw45d",
-	"gwZm6gW5MAztKVZVWTxA0j6zZ0ZvnU3aRveaiy9wqGh5KWc7ehPsh+9N72HQQYd/C1RKlRM0ZANkJCGY",
-	"5DvCKuV2Xfj4rxABFCipA6Rn2miab67/e6aDZlwB+y9Vs5xLfHLVFhqZRmkUFFCAdDM4EayZ03tnthiC",
-	"EjZAL0n88vBhf+EPH/o9F4Yt4ToETbqGfXQ8fIh6nFfK2M7hugN9qDtu54nrAw1X7uLzr5A+Tzns8uVH",
-	"nrKTr3qDN9Yud6aM8YTrln9rBtA7mdspa49pZJq7G447yZbT9Q8arBv3/UJs6pLbu7BawRUvM3UFWosC",
-	"DnJyP7FQ8usrXv7YdMOAUMgdjeaQ5RjGOHEseOP6UOSjG0dI4Q4wRT1MBQjOqdcFdTrwxGxddcVmA4Xg",
-	"FsodqzTkQAF/TnI0zVJPGIUC5GsuV/hg0Kpeee9eGgcZfm1INaNrORgiKVTZrcxQyZ26ALybWoj5dOIU",
-	"cPek62vI6QFzzZv5fJjvlJs52oO+xSBpJJvPRl+8DqlX7YuXkNMNXJ1wGXTkvQg/7cQTTSmIOif7DPEV",
-	"b4s7TG5zfxuVfTt0CsrhxJHLc/txzOvZPbfL3R0IPTQQ01BpMHhFxWoqQ1/VMg5SD66CO2NhM9TkU9e/",
-	"jxy/16PvRSVLISHbKAm7ZF4WIeF7/Jg8TnhNjnRGgWWsb/8N0oG/B1Z3ninUeFv84m73T2jfYmW+Ufqu",
-	"TKI04GTxfoIF8qC53U95UzspL8uEadGHsPYZgJk3zrpCM26MygXKbOeFmXuvYLJG+njXLvpfNYE5d3D2",
-	"+uP2bGhxdgTUEUNZMc7yUqAGWUljdZ3bd5KjjipaasKJKzzGx7WWz0OTtJo0ocX0Q72THB34Gs1V0mFj",
-	"CQk1zTcAQXlp6tUKjO29dZYA76RvJSSrpbA418Ydl4zOSwUaPalOqOWG79jS0YRV7FfQii1q25X+MULb",
-	"WFGW3qDnpmFq+U5yy0rgxrLvhXyzxeGC0T8cWQn2WunLBgvp230FEowwWdrZ7Fv6ioENfvlrH+SA7u70",
-	"OTidtikjZm6ZnSwx/9/9/3j29iz7b579+ij78t9O3394+vHBw8GPTz7+9a//f/enzz7+9cF//GtqpwLs",
-	"qfhhD/n5C/8yPn+Bz5/IVb8P+yfT/2+EzJJEFntz9GiL3cdcGZ6AHnSVY3YN76TdSkdIV7wUheMtNyGH",
-	"/g0zOIt0OnpU09mInjIsrPXIR8UtuAxLMJkea7yxFDX0z0xH6qNR0gff43lZ1pK2MkjfFIga/MvUct5k",
-	"Y6BEbc8YhuqveXDy9H8++fyL2bwNsW++z+Yz//V9gpJFsU0lUihgm3orxkES9wyr+M6ATXMPhD3pSke+",
-	"HfGwG9gsQJu1qD49pzBWLNIcLsRseZ3TVp5LcvB35wdNnDtvOVHLTw+31QAFVHadSuDUEdSwVbubAD23",
-	"k0qrK5BzJk7gpK/zKdx70Tv1lcCXwTFVKzXlNdScAyK0QBUR1uOFTFKspOinF97gL39z588hP3AKrv6c",
-	"KY/ee99+/YadeoZp7lFODxo6ysKQeEr76NGOQ5LjZnFM2Tv5Tr6AJWoflHz2Thbc8tMFNyI3p7UB/RUv",
-	"uczhZKXYsxCQ+oJb/k4OJK3RzJJR1Dir6kUpcnYZP0ha8qRsYcMR3r17y8uVevfu/cA3Y/h88FMl+QtN",
-	"kDlBWNU287mOMg3XXKdsX6bJdYMjUzKzfbOSkK1qUpCGXEp+/DTP41Vl+jkvhsuvqtItPyJD4zM6uC1j",
-	"xqomHs0JKD6m2e3vD8pfDJpfB71KbcCwXza8eiukfc+yd/WjR59hZF+bBOIXf+U7mtxVMFm7MpqTo69U",
-	"wYXTsxJ91bOKr1Imtnfv3lrgFe4+yssb1HGUJcNunajDEGCAQ7ULaGK8RzeA4Dg6OhoXd0G9Ql7L9BLw",
-	"E25hNwL9VvsVJRC48XYdSELAa7vO3NlOrso4Eg8706S7WzkhK3hjGLHC16rPDLgAlq8hv/Qp22BT2d28",
-	"0z04/HhBM7AOYSiZH0UYYjopNFAsgNVVwb0ozuWun9fHUEQFDvoaLmH3RrXZqI5J5NPNK2PGDipSaiRd",
-	"OmKNj60fo7/53qssBJr69CwYvBnI4llDF6HP+EEmkfcODnGKKDp5T8YQwXUCEUT8Iyi4wULdeLci/dTy",
-	"hMxBWnEFGZRiJRapPMT/ObSHBVgdVfrUi94LuRnQMLFk7im/oIvVP+81lytw17O7UpXhJaWVTTpt4Hto",
-	"DVzbBXC7V88v44wcATp8Ul5j5DVq+OZuCbB1+y0sauwkXLtXBSqKqI33Xj4Z9z8jwKG4ITyhe/tSOBl9",
-	"63rUJVIuhlu5wW7zrPWueTGdIVz0fQOYs1Vdu31xUCifbpSy2kT3S234CkbeLrH1bmJCkI7FDwc5JJEk",
-	"ZRC17IsaA0kgCTI1ztyak2cY3Bd3iPGZ2XPIDDORgdjbjDCLuEfYokQBtvFcpb3numNFpbTIY6ClWQto",
-	"2YqCAYwuRuLjuOYmHEdMGBu47CTp7DfMe7MvN9955EsYZYVtMu+F27DPQQfvfp+hL6TlC7n44kf/hLx6",
-	"7u2F4Qup7VASRdMCSljRwqlxIJQ2Y1S7QQ6OH5dL5C1Zyi0xUlBHAoCfA9zL5SFjZBthk0dIkXEENjo+",
-	"4MDsBxWfTbk6BkjpM17xMDZeEdHfkA7sI0d9J4yqyl2uYsTemAcO4FNRtJJFz6Mah2FCzpljc1e8dGzO",
-	"v8XbQQYp4vBB0UsI511vHow9NPaYpujKP2pNJCTcZDWxNBuATovaeyBeqG1GEcrJt8hiu3D0noxdwHjp",
-	"1MGkZHz3DFuoLbpz4dVCvvIHYBmHI4AR6V62wiC9Yr8xOYuA2Tftfjk3RYUGScYrWhtyGRP0pkw9IluO",
-	"kcv9KL/ejQDoqaHaYhVeLXFQfdAVT4aXeXurzdu8sSEsLHX8x45QcpdG8DfUj3Uz4v2tzXw4nl0tnKhP",
-	"kgpwqFm6TYpG6lxR2sVjMjT2yaEDxB6svurLgUm0dn29uniNsJZiJY75Do2SQ7QZKAEfwVlHNM0uU54C",
-	"7i0PeI9fhG6Rsg53j8vdg8iBUMNKGAut0Sj4Bf0e6niO+aOVWo6vzlZ66db3Wqnm8iezOXbsLPOTrwA9",
-	"8JdCG5uhxS25BNfoG4NKpG9c07QE2nVRpGoLokhzXJz2EnZZIco6Ta9+3u9euGl/aC4aUy/wFhOSHLQW",
-	"WB0k6bi8Z2rybd+74Je04Jf8ztY77TS4pm5i7cilO8ef5Fz0GNg+dpAgwBRxDHdtFKV7GGQUcD7kjpE0",
-	"Gvm0nOyzNgwOUxHGPuilFsLex25+Gim5ligNYDpCUK1WUIT0ZsEeJqMkcqWSq6iMVVXty5l3wih1HWae",
-	"25O0zrvhw5gTfiTuZ0IWsE1DH78KEPI2sg4T7uEkK5CUriStFkqiJnbxxxaRru4T20L7AQBJJ+g3PWN2",
-	"651Mu9RsJ25ACbzwbxIDYX37j+VwQzzq5mPu053Ur/uPEA6INCVsVNllmIZghAHzqhLFtmd4olFHlWD8",
-	"KO3yiLSFrMUPdgADXSfoJMF1col7V2uvYD/FN++pe5WR77V3LHb0zXMfgF/UGi0YHc/mYeL65q02ce3f",
-	"/XxhleYr8FaojEC61RC4nGPQEKWFN8wKcicpxHIJsfXF3MRy0AFuoGMvJpBugsjSJppaSPvF0xQZHaCe",
-	"FsbDKEtTTIIWxmzyb4ZWriDTR6qk5kqItuYGpqpkuP53sMt+5mXtHhlCm9Y915udupfvEbt+tfkOdjjy",
-	"Qa9XB9iBXUHN02tAGkxp+ptPJsrgfc90ahzg87KzhUfs1Fl6l+5oa3xVinHib2+ZTtWG7lJuczBaJwkH",
-	"y5TduEj7JrjTA13E90n50CaI4rAMEsn78VTChBqew6uoyUVxiHbfAC8D8eJyZh/ns9t5AqRuMz/iAVy/",
-	"ai7QJJ7R05Qswx3HniNRzqtKqyteZt5fYuzy1+rKX/7YPLhXfOKXTJqy33x99vKVB//jfJaXwHXWaAJG",
-	"V4Xtqj/NqqiOxf6rhLJ9e0UnaYqizW8yMsc+FteY2bunbBpUhWn9Z6Kj6H0ulmmH94O8z7v60BL3uPxA",
-	"1Xj8tDZPcvjpOvnwKy7KYGwM0I44p+PippUWSnKFeIBbOwtFPl/ZnbKbwelOn46Wug7wJJzrR0xNmX5x",
-	"SJ+4ElmRd/7hdy49faN0h/n7yMSk89BvJ1Y5IZvwOOKrHQp49oWpE0aC1y+rX9xpfPgwPmoPH87ZL6X/",
-	"EAGIvy/87/i+ePgwaT1MqrEck0AtleQbeNBEWYxuxKd9gEu4nnZBn11tGslSjZNhQ6HkBRTQfe2xd62F",
-	"x2fhfymgBPfTyZRHerzphO4YmCkn6GIsErFxMt1QzVDDlOz7VGMQrCMtZPa+JAMZY4dHSNYbNGBmphR5",
-	"2rVDLoxjr5KcKV1jho1HtLVuxFqM+ObKWkRjuWZTcqb2gIzmSCLTJNO2trhbKH+8ayn+WQMThXvVLAVo",
-	"vNd6V114HOCoA4E0rRfzA5Odqh3+NnqQPfamoAvapwTZa7970diUwkJTVY+O9ACPZxww7j3e254+PDVT",
-	"NNu664I57R0zpXZ8YHTeWDcyR7IWvDDZUqtfIW0IQftRIhFGMHwKVPP+CjLluddnKY1RuS1p385+aLun",
-	"v43HNv7Wb+Gw6Kbs2k0u0/SpPm4jb/LoNel0zR7JY4+w2MOgGxowwlrweEXOsFgGJXgfcUnnibJAdCLM",
-	"0qcyjuU8pfHbU+lhHsS/lvx6wVM1YtxbyMEUbW/HT8oqFjqHDTBNjgOanUUe3E1bQZnkKtCtDWKYlfaG",
-	"7xqadvKLpn3AIEXFT5c5uSmURiWGqeU1l1RG3fUjfuV7GyATvOt1rTTmgTRpl64CcrFJqmPfvXtb5EP3",
-	"nUKsBFUIrw1EJaj9QIySTSIV+TLeTeYOj5rzJXs0j+rg+90oxJUwYlECtnhMLRbc4HXZmMObLm55IO3a",
-	"YPMnE5qva1loKOzaEGKNYs3bE4W8xjFxAfYaQLJH2O7xl+w+umQacQUPHBa9EDR79vhLdKihPx6lbllf",
-	"4X0fyy6QZwdn7TQdo08qjeGYpB817X291AC/wvjtsOc0UdcpZwlb+gvl8FnacMlXkI7P2ByAifribqI5",
-	"v4cXSdYAMFarHRM2PT9Y7vjTSMy3Y38EBsvVZiPsxjvuGbVx9NTWl6ZJw3BYiCzUiwpwhY/o/1oF97+e",
-	"rusTP2P4ZiRmC72Uf0AbbYzWOeOU/LMUrWd6KFjKzkNuYSyg1dTNIty4udzSUZZER/Ulq7SQFvUftV1m",
-	"f3HPYs1zx/5OxsDNFl88TRSi6tZqkccB/snxrsGAvkqjXo+QfZBZfF92XyqZbRxHKR60ORaiUznqqJt2",
-	"yRzzC90/9FTJ142SjZJb3SE3HnHqWxGe3DPgLUmxWc9R9Hj0yj45ZdY6TR68djv00+uXXsrYKJ0qGNAe",
-	"dy9xaLBawBVGzKU3yY15y73Q5aRduA30v6//UxA5I7EsnOXkQyCyaO4LlndS/M/ft5nP0bBKkYg9HaDS",
-	"CW2n19t9Ym/D47RuffstOYzhtxHMTUYbjjLEyoj3PbnXN31+D3+hPki05x2F4+NfmHZvcJTjHz5EoB8+",
-	"nHsx+Jcn3c/E3h8+TCcgTqrc3K8tFm7zIsa+qT38SiUUYKFqYeNQ5PMjJBSQY5eU++CY4MIPNWfdCnGf",
-	"Xoq4m/iutLdp+hS8e/cWvwQ84B99RPzOzBI3sI1SGD/s3QqZSZIpmu+RnztnX6ntVMLp3UGBeP4AKBpB",
-	"yUT1HK5kUAE0aa4/6C8S0agbdQGlco/MuChQrM//8+DZLX6+B9u1KIuf29xuvYtEc5mvk17CC9fx7ySj",
-	"d65gYpXJOiNrLiWUyeHobfv38AZOvNL/oabOsxFyYtt+BVpabm9xLeBdMANQYUKHXmFLN0GM1W7arCYt",
-	"Q7lSBcN52qIWLXMclnJOldBMxDfjsJvaer9VjAX3CYeWokQ3zLTdGFtmmtuRBFpY7zzUF3LjYPlxQ2oG",
-	"Gh0042KDF7Phm6oEPJlXoPkKuyoJve6YQg1HjipWMFO5T9gSE1YoZmstmVouo2WAtEJDuZuzihtDgzxy",
-	"y4Itzj179vjRo6TaC7EzYaWExbDMH9ulPD7FJvTFF1miUgBHAXsY1o8tRR2zsUPC8TUl/1mDsSmeih8o",
-	"chWtpO7WpnqSTe3TE/YtZj5yRNxJdY/qypBEuJtQs65KxYs5Jjd+8/XZS0azUh8qIU/1LFeoreuSf9K8",
-	"Mj3BaMjsNJI5Z/o4+1N5uFUbmzXlJ1O5CV2LtkCm6PncoB4vxs4Je0Eq1KaAP03CMEW23kARVbukRzwS",
-	"h/uPtTxfo26yIwGN88rphVgDO2stN1H0YVP9CBm2g9vXYqVSrHOm7Br0tTCAEflwBd10iE1uUK8bD+kR",
-	"u8vTtZREKSdHCKNNraNj0R6AI0k2OBUkIesh/kjNFNVjPrYu7QX2Ssdi9Irc9qz+IbleSLHNvvfGhZxL",
-	"JUWOpRBSkjSmbptmppxQNSJtXzQzf0IThytZWreJBfZYHC22GxihR9zQ5B99dZtK1EF/Wtj6kmsrsMZz",
-	"NijmodK1N4gJacBXs3JEFPNJpRNOTclAiMaB4kgywqxMIxrOb9y3H7z+G5NiXAqJmi6PNv8+I5NVaQRa",
-	"piUTlq0UGL+ebjSPeev6nGCWxgK2709eqpXIL8QKxyA3Ords8hkdDnUWPEi9x6Zr+9y19bnzm5877mA0",
-	"6VlV+UnH66AnBUm7laMITvktBUeSCLnN+PFoe8htr+s33qeO0OAKvdagwnt4QBhNLe3uKF+7tyVRFLZg",
-	"FFGZTKArZAKMl0IGE2r6gsiTVwJuDJ7XkX4m19zS22EST3sDvBwJgMAIZbLB33aofuUAhxJcY5hjfBvb",
-	"MuAjjKNp0Er8XO5YOBSOuiNh4jkvG9fpRFFvlKq8EFVgcFGvzHeKcTjGnYWQyQ66DobvNd2xGsexN9FY",
-	"jsJFXazAZrwoUqmtvsKvDL+GIDHYQl43Raia6MBujvIhtfmJciVNvdkzV2hwy+miuvkJaohr94cdxkw7",
-	"ix3+m6rANL4z3mn66Kjc4CFdHJeYfxhlnJJ6HU1nRqyy6ZjAO+X26Ginvhmht/3vlNJDuO4fIhq3x+Xi",
-	"PUrxt6/dxREn7h34p9PV0uTVRV9whd9DwqMmI2SXK+FVNqgzhl4PuHmJLesBHxomAb/i5UgkfGwrofuV",
-	"7Adj8fD5aPoGbn16LsvZXhY0mvKIfIV71pehCXHMP5jcg+/OauHXuheh47a77zqWOvIRa5nFqIXuZka0",
-	"doOPtaJ9dzWWIiHU6cDvcT0Q78Uz92ng4UqoOnhfBR/o8CSkX30Knk7dj5H1JyMLfm+rxaiN5Y2vX0vL",
-	"9G/y734mKywDafXuD2BxGWx6v6hMQtol9VTbhDWlDyeVQuzcilNq2KTKpXjZMOjKiLV0aGlQfmZAVi+m",
-	"iAMDfHycz86Loy7MVMmdGY2SOnYvxWptMWP/34AXoF8dqEjQViHAI1YpI9oKpKUbzKeAXeNwJ1ODDRwB",
-	"i7iiwnCs4IR6BbnFsrOtc50GOKa+gpssGH3+tzLB+HO6icnwBQn2VSEY1po9cMcPEidFyb+oTufJ9Jz7",
-	"Z40LNUWAXXPTpmvpxUxPjtxcLiHHrMh7E1X95xpklARpHvQyCMsyylslmjgmzOt9vNaxBWhfHqm98ET1",
-	"dW4Nzlgc+yXs7hnWoYZk4dAmiO8miYMRA2QCCzmkxxTJ3mtMmIYyEAvBJdinYm6LY4zmfI7Srt1wrkCS",
-	"7uJoU7HtmTJd9HzSXK7rUWkfMSRnLJfVsGby+PvjBZaoNt5BjjeJh+NXOjsfFs659omLMa1YYzsJKYzB",
-	"hN9CDkGapRSXvn4AYoUsVddcF6HFnSSFortJpIFeNjOLNoBj6OSQKMWAsVB5qZwYkY0FlHVjJhqHw3uG",
-	"PEPbBD4I1xK0hqIxiZTKQGZVCPjYB8c+VJD7642QYEbLHxFwo6mvX7e5vbEMHMdU19x7vcYLZBo23EGn",
-	"owzc43PuQ/Zz+h6C8EMZsIMapoZeD9ejDaE7wgyQGFP9kvnb8nBw/02UTUJK0FmwPPXTcctuRjbMu1nU",
-	"OV3Q8cFoFHKTc+fsYSVJPU0+XGXvjRAFyV/C7pQeQaGQb9jBGGiSnAj0KOFob5PvVP1mUnCv7gS83zeP",
-	"XKVUmY0YO86HOcT7FH8p8kvAHICNi/tIjXZ2H3XsjTX7er0LObOrCiQUD04YO5MUVBQM293ygr3J5T27",
-	"b/4tzlrUlNbfK9VO3sl0dAYm3Ne35GZhmP08zIBjdbecigY5kKF6K8dcbq4xOX+3iufJ1Ff50NTcryLf",
-	"EhVBkZJJLshi9RwPekpxhCkQolwdaMjkzFu6mClVypf3Jmka3FBpTMWTIUAW5JRsAQ0UfvAkApJ10ROn",
-	"kFLf+aR3ask0tEbkm2b/G5ZwT73o+zM3s3T53VJp6BRjd70p02cT+IJpNPE/C2E117ub5OgblJAfaE9G",
-	"sXzQHavxxGoX0npjDXFYluo6Q2aVNXUuUk9b1850L+NQdK3t5071AiK/Lm68oLZja16wXGkNedwjHe9J",
-	"UG2UhqxU6OaVskAvrZO7NxjkJVmpVkxVuSqA6sWkKWhsrlpKjmITRF41SRQQ7WC0MPWJ6HjilO5OJTtS",
-	"hqLW6oja+TlQ5Hqb1YkWnZEtc8RjGYzP4uQxRI2H8O6p/Z/mzUuxRboBnTryS2Z1DXPmW/RrZPuDzzWw",
-	"jTCGQGlo6VqUJQaOi21keW0cF9KoHRF7z9Gt8kqg7003iQBJw5W785rMCjEPuIjTHjG71qperaME0w2c",
-	"4cmra/8gjkf5ydToHoURZG6Kp2yjjPUvTRqpXXLrcnY/V9JqVZZdpRSJ6Cuvaf+eb8/y3L5U6nLB88sH",
-	"+K6VyjYrLeYhvrrvHNjOpHupxboXcEblzA+n6qV26CrniXYyg+yxuKMLu0dgvj/MQQ/r3M+GC+uvq8tM",
-	"08+YM8m4VRuRp8/Un8vbbtRHLsWikjnLqLYiZZnAZnjY48uqca5AFjlEM0ieLA53xjwj8EZmZDfuvyiB",
-	"98dlS/CMZuSiHDIXL0Vl+ais1wMAIaXQZ1trKsgYS2INV1ErSpWAJvI+oBNvFfREuh1sboQ7B8rCrYAa",
-	"eD82AN4n5cOccsuRJ+VCbcP3B23yuRsB/3E/lXeYx5iL10VLWpqcvEKimhGOkE5xvdcf6g2GvS+mekU1",
-	"xXMn3vARAON+Uh0YJnlLHQvGkosSiixVe/G80VHNo5e2D83ql0QXxnPynNeh9KEbu9bgE6eQiK+79q+K",
-	"O1JSTfOhJlkWsAWK6/gVtKKahvPI/gIllTzsKQNUlZVwBR33MZ/NpUZRU1xB6GuazqwAqNAa2deRpfyi",
-	"4ru8pzjxa88iz5op2E1qUgixtFPsgJokqdTZyoyOiZl6lBxEV6KoeQd/5liRo6sGdEc5garBGyEL78ip",
-	"0/xEI7wOA5yF/ilRJmDi/TQ+dDQLSqNuHwM66CdZm7FTL9NuknGqosbAgrMVjSGWSLzlG6bi13JcITkk",
-	"+fa5NXGfhJIRYr/eQo5SjX/vQOFfPCNGCp/1BKldAhT0KnBdEtr2NUgmVVRi8pqb5qnS5lAMP9DE2EhI",
-	"/5q+gVG59Wa8/c4yHIyZXjK10YeEbuj05ur53+Uk7j2Io+OlaMSAD//bo/8K1O2fHdgAS3lLt59O9sci",
-	"jf4W81x8zhZ1GKgs1TXVjIzfoS8g2EGJ+oIJyIvlormWg9fm3Kf37Ks6ROSvvuE7pjT+416d/6x5KZY7",
-	"5DMEfujGzJo7EvKGV/II8F6gbuL94tU8ABa0LSpMResWU8eMhtu5USKg3UUeivsotuGXEG8DOjsQ/8yt",
-	"Y5ymXqDmwl3Zve0cYsEvPqRo2fAifuljoshuGfWQOtj1/r/aWLh4qpDfrSp5HiqE+hJFXT6DVYADcdk1",
-	"bPYHSw75WiCBprJwS7Q6RNcXN1CZHsm6UhEIY+VXOmAPKq4OKs/cahkTNb+9Ght7wkwnLeWud2Gq180A",
-	"6LhO4yHw47KVnwb/yRyuY8uYAv4fBe8jhWpjeKkm7SfAcicDRwJW0lYv1DbTsDSHHExIXe2e87rN3RFU",
-	"rELmGrghj5vzH/3Ds01RKqR7CJNPaGPTbEYpYClkyyyFrGqbeMdgplK5ixAWK/0RrSMmtDEpwQmTV7z8",
-	"8Qq0FsXYxrnTQSUd4xIRwdDh+yZUGM2dOhxAmPYNh/GZrRo9buYucCpCRe6axnJZcF3EzYVkOWh377Nr",
-	"vjM3tyg1xoFDNiUeSTPdrAGRdQlJmwApd94ofEt7TwMgv0PDzwSDDfoFJ4w1pNqxasQ+M4ThT2Gw2fBt",
-	"VqoVRhGOHAifmxYtfPQEVBLV4CSfTVt3mMeIX2H/NJiW3zMiq3DWKVPsP/c/4lbiM/InKezek086yn5Y",
-	"J/nd0sEMSJWr1vmfiGV4HlORuD75ShyNG4TNEKoSaA+iTYQR+1BXLz6yi+gG4cO4YyX49HJnXU+LVLwv",
-	"aQYy1BiYPe79YFpXdp5796yhKm2gaiCkzH209JGaNtLPh3tpBDyqTe/PenfaxmXGjXNMjbj98dFZpaos",
-	"n+LzSZU7Cm8m8JB2YRyhj8gIMLLuxj3GNLVsOnmPOkVtji2TN1pU55C1q8r3PfrH1EQjHL1rglBL5GVU",
-	"uR21WxjJ0yhT5v0Ys64arGESjDMNea1RTXzNd4fLjo1kjL7429nnj5/8/cnnXzDXgBViBabNOt4r29X6",
-	"BQrZ1/t8Wk/AwfJsehNC9gFCXLA/hqCqZlP8WSNua9qUooOiZcfolxMXQOI4JspF3WivcJzWtf+PtV2p",
-	"Rd75jqVQ8NvvmVZlma760MhVCQNKarciE4p7gVSgjTDWMcKuBVTY1iParFE9iLl/ryibjJI5BP2xpwJh",
-	"R1yuUgsZc6hFfoax3d5qxGBblZ5XkaVn37r8O400dCg0olfMAlilKi/aiyVLQYQRRDqKrPWKT9SIRz6y",
-	"DbMlb9kUIXrP8zTpxQWz93P7bjFXm+b0bhMT4kU4lDcgzTH7xHjegptwkla1/4fhH4lEDHfGNZrl/ha8",
-	"Ivk+uFlR/kmgDYPyE+SBAIxE23biJKNAsSgRsSYrAdoTggG5L3583xqWD4aFICShwwHw4vDZtl0TyeDB",
-	"+Z0z+n7fICVayvsxSugs/1BEbmC9zUUSbZFXmlgLhtiSGoqFUbi1ed5EMY+8SgbBzlopy9zLtCwTQdKk",
-	"x8EzFROOexLoK15+eq7xjdDGniE+oHg9HhoVR8rGSCZUmpvl6XvJJ80dRcXe3dTyFQZm/ye4PUrec34o",
-	"b4Qf3Gao3MGK9atwK1CsN7vGMcnJ6vEXbOGLbVQacmH6xv3rIJw0gaGgxdI7tMLWHohEPbTOn5W9BRkv",
-	"gycO+yEybzU2ew9he0R/Z6YycnKTVJ6ivgFZJPCX4lFxcd4D18UtCzPcLO1LlMDtyLQvw7LDU5dHqU3c",
-	"pVMbGK5z8m3dwW3iom7XNjVn0eT6Du/evbWLKamG0rUYXHfMdXQnRRmOKsnwG2Q5Ihz5Mfy8KYr5eSzv",
-	"LeV2HcnN3duPWpQHHVY6mdY/zmcrkGCEwVzif/e1Yz7tXRogoMwLw6NKsN4mXQwhJrHWzuTRVFEO9Qnp",
-	"0323RM5rjGrMay3sDusGBwWa+HsyH9O3TW4PnxumsaX5u8+qS2hqt7eZQGoTbtdvFS/xPiITn3S3kCpP",
-	"2NeU4dsflL/eW/w7fPaXp8Wjzx7/++Ivjz5/lMPTz7989Ih/+ZQ//vKzx/DkL58/fQSPl198uXhSPHn6",
-	"ZPH0ydMvPv8y/+zp48XTL77893uODzmQCdCQ2v/Z7P/NzsqVys5enWdvHLAtTnglvgO3N/hWXiqsa+mQ",
-	"muNJhA0X5exZ+On/DifsJFebdvjw68zXZ5qtra3Ms9PT6+vrk7jL6QpD/zOr6nx9GubBaoMdeeXVeeOj",
-	"T344uKOt9hg31ZPCGX57/fXFG3b26vykJZjZs9mjk0cnj31pa8krMXs2+wx/wtOzxn0/xfyap8anzj9t",
-	"Y7WSdrvX6LIehHO9goLdb6Ju/q2x3JoHIXhnKUq8Mv5hiBibVZwXSFy+RukMq66hMxaC9eTRo7AXXtKJ",
-	"LpxTjP549mHW1rbvCxMDpL5pAU5C1tZ8HC76J3kp1bVkmAyQDlC92XC9oxV0sBENjtvEVwaV7FpccQuz",
-	"9653H+dV5QsWjKEcq1x1T3nojATSZLx3J4wS4fuyAyaF8mGxhFtif29yyMFkid3BRq8czCF9TpNQ0RuE",
-	"PM7QZkwIa84IqR0GiJ7PqjqBzq8xsMbsw9k8SsJP0KiyaDA+wOir+n8IRh3p+rtp9uyD+2sNvMTEWu6P",
-	"jSPUPHzSwIud/7+55qsV6BO/TvfT1ZPT8Ao5/eAzpnzc9+009gg7/dBJLFMc6Bk8ng41Of0QSmbvH7BT",
-	"Ltn7mkYdJgK6r9npAstkTW0K8erGl4I0b04/4AN89PdTr0Ud+UiX69hn1JNQm9OQv2mkJWXqSH/sYPiD",
-	"3bp17h/OtYnGy7nN13V1+gH/g1T9kZhBCalET1TBg7O2+ZwJy/hCaSzQbPO1YxahMqwwUcsBRzhzvZ4T",
-	"BKHQPnofzZ69HYaH4UAsjIQSjLueWwGjM1MrQ6K1JeIZjYTcad/KyW8fZV++//B4/vjRx39xcrD/8/PP",
-	"Pk50rn/ejMsuGiF3YsP3t2SIA5VOu0japIa/Dd8gnhbGw3/8VvUGYg0yDpR/7A0/fEohf356h1dANy1x",
-	"gv1/xQsWsijg3I8/3dznklzInRxL8vbH+ezzT7n6c+lInpdBYruhbHdGhz9mCsxvdkq2m8+kklGuRbki",
-	"KUSlMlmM8Btj+Q34zYXr9b/8ptNwYATEMD1Sxvra75HbD10mTak7CAloQ+gBL664zEOsVhs8gftFgrkn",
-	"jMY/tzawrMuQpaQqxZJK6itVholMXVWO4yy5aSjLR2y49zQlWWiGZrXMlSTPKgyOCfZhTJaANmZzKapO",
-	"F7F0VOWLvVOg1knY9H/WoHftrm+EexgPnlSt799vycIJj3fAwrsD3TELf3IkG/3zr/h/9qX19NFfPh0E",
-	"IbfRG7EBVds/66V5QTfYrS5NL8NTeY5Tu5Wn6P19+qHzmvGfB6+Z7u9t97jF1UYVEJ4Qark0qHnZ9/n0",
-	"A/0bTQTbCrTYgKSq/f5XujlOsWb8bvjzTubJH4fr6KRtHvn5NChcU4/obssPnT+7D0Ozrm2hrqnMaVJe",
-	"weuTl2zDJV9RjH+jo3T3oB+gzSjNfqyai8qH9jKO1flUbVslMkW6+Hj/xsyPN1rj7LUSEidAey3Owpeu",
-	"K48ucF8gc6hivPCQ/aAKGMpGqYvQw9i5DJujkCpF+f5ulJcR4/143EFBuzI5RQzJyH2sTf/v02surJOg",
-	"fGpnxOiwswVenvo6br1f29Ipgy9YDyb6MU5akPz1lHfPRVfB4rZsrONA+5L66jUII41CrE343Np2YlsJ",
-	"kktjJXn73u26AX0VKKlV/T87PcXgy7Uy9hQl0a5ZIP74vtnoUKG62XD3bZspLVZC8jLzOrS2GOXsycmj",
-	"2cf/EwAA//96SivwPRABAA==",
+	"H4sIAAAAAAAC/+y9+5MbN9Ig+K8g+G2ELS3ZLcmyv7EuJvbakj2js2wr1LL39izdDFiVJPF1EagBUN1N",
+	"6/S/X2TiUagqFFnspuWZ2PlJahYeiUQikcjnh1mhtrWSIK2ZPfswq7nmW7Cg6S9eFKqRdiFK/KsEU2hR",
+	"W6Hk7Fn4xozVQq5n85nAX2tuN7P5TPItzJ6l/eczDf9ohIZy9szqBuYzU2xgy3Fgu6uxdRzpdrFWCz/E",
+	"hRvi5YvZxz0feFlqMGYI5U+y2jEhi6opgVnNpeEFfjLsRtgNsxthmO/MhGRKAlMrZjedxmwloCrNWVjk",
+	"PxrQu2SVfvLxJX1sQVxoVcEQzudquxQSAlQQgYobwqxiJayo0YZbhjMgrKGhVcwA18WGrZQ+AKoDIoUX",
+	"ZLOdPft1ZkCWoGm3ChDX9N+VBvgNFpbrNdjZ+3lucSsLemHFNrO0lx77GkxTWcOoLa1xLa5BMux1xn5o",
+	"jGVLYFyyN989Z1988cXXuJAttxZKT2Sjq2pnT9fkus+ezUpuIXwe0hqv1kpzWS5i+zffPaf5L/0Cp7bi",
+	"xkD+sFzgF/byxdgCQscMCQlpYU370KF+7JE5FO3PS1gpDRP3xDU+6aak8/+hu1JwW2xqJaTN7Aujr8x9",
+	"zvKwpPs+HhYB6LSvEVMaB/310eLr9x8ezx8/+vgfv14s/h//55dffJy4/Odx3AMYyDYsGq1BFrvFWgOn",
+	"07LhcoiPN54ezEY1Vck2/Jo2n2+J1fu+DPs61nnNqwbpRBRaXVRrZRj3ZFTCijeVZWFi1sgKjKHRPLUz",
+	"YVit1bUooZwzIdnNRhQbVnDjhqB27EZUFdJgY6Aco7X86vYcpo8pShCuO+GDFvTPi4x2XQcwAbfEDRZF",
+	"pQwsrDpwPYUbh8uSpRdKe1eZ4y4r9nYDjCbHD+6yJdxJpOmq2jFL+1oybhhn4WqaM7FiO9WwG9qcSlxR",
+	"f78axNqWIdJoczr3KB7eMfQNkJFB3lKpCrgk5IVzN0SZXIl1o8Gwmw3Yjb/zNJhaSQNMLf8LCovb/n9d",
+	"/vQjU5r9AMbwNbzmxRUDWagSyjP2csWksglpeFoiHGLPsXV4uHKX/H8ZhTSxNeuaF1f5G70SW5FZ1Q/8",
+	"VmybLZPNdgkatzRcIVYxDbbRcgwgN+IBUtzy2+Gkb3UjC9r/dtqOLIfUJkxd8R0hbMtv//xo7sExjFcV",
+	"q0GWQq6ZvZWjchzOfRi8hVaNLCeIORb3NLlYTQ2FWAkoWRxlDyR+mkPwCHkcPK3wlYAj5AFwhJwGjoTb",
+	"DM3g6cYvrOZrSEjmjP3smRt9teoKZCR0ttzRp1rDtVCNiZ1GYKSp90vgUllY1BpWIkNjlx4dyGBcG8+B",
+	"t14GKpS0XEgomZAOaGXBMatRmJIJ9793hrf4khv46uns46GvE3d/pfq7vnfHJ+02NVq4I5m5OvGrP7B5",
+	"yarTf8L7MJ3biPXC/TzYSLF+i7fNSlR0E/0X7l9AQ2OICXQQEe4mI9aS20bDs3fyIf7FFuzScllyXeIv",
+	"W/fTD01lxaVY40+V++mVWoviUqxHkBlhzT64qNvW/YPj5dmxvc2+K14pddXU6YKKzsN1uWMvX4xtshvz",
+	"WMK8iK/d9OHx9jY8Ro7tYW/jRo4AOYq7mmPDK9hpQGh5saJ/bldET3ylf8N/6rrC3rZe5VCLdOyvZFIf",
+	"eLXCRV1XouCIxDf+M35FJgDuIcHbFud0oT77kIBYa1WDtsINyut6UamCVwtjuaWR/puG1ezZ7D/OW/3L",
+	"uetuzpPJX2GvS+qEIqsTgxa8ro8Y4zWKPmYPs0AGTZ+ITTi2R0KTkG4TkZSEYRoquObSns3muTPZHuBf",
+	"/Uwtvp204/Dde4KNIpy5hkswTgJ2DT8zLEE9I7QyQisJpOtKLeMPn1/UdYtB+n5R1w4fJD2CIMEMboWx",
+	"5gEtn7cnKZ3n5Ysz9pd0bBLFFaqXluBFDbwbVv7W8rdY1C35NbQjfmYYbScqaz7OM2gwL1vsn4YGTZ8I",
+	"+8ykRaVXgiUTGK8sc3DiglRt/W2oiMVY2Jo7k7anE6413828KLEgkWAI6M8GHKnWfC0kDTZHIVuyLb9C",
+	"Bs+lon1FigQTpWe3Ahq0VbR5ycS/Ds4Gr/F/mWOTo5cgtRjGWSWMpTdY/vQY+ibDBneI0hiwpyBBeutu",
+	"VIWi+EFKwcZ/9W1T3oe/T+r8r8H3UtyOczxsxTzm3MObfkle3J/32NmQm3kd5Bm76Pe9Gy/DUUa4GH46",
+	"Nf9KiYd+mcZwEogSavo3u7k7tU5mNNSYbaCi11yetzx3x/r3u/cSmSljH3rdHoLclec7u8doevvR84r2",
+	"vq6DzFFyy+9yEf6bHu9Mj9Oo58At2Nnm/VT6O/C1zr02jUKp5RhtTqdAHObftHdv2rsDSzxIb3eiqwnX",
+	"4J7lRLBvNK/dDe+/OA2JkKR6d40crGsNsAVp34otVELCCU4D7ULmFIQp4iHQ4LVxrsecqapEelsJbY44",
+	"A2EJpK0KkwwPRY5WzDRiCTMw60ePS9gq0kwV+NGPiFi9p+Zh8qWTgbX9nB4kgurOT4AJLCgDCX7ow/BN",
+	"pYqrv3KzOQGdLcNYQ1KjadgGeAmabbjZZBhTjx7a0aaQBDYkZsCWyVRn7RLp75MtkkY7sMwgunRhz6vz",
+	"EhhHEOG+TUHFN1kEvFJrc4LlV+qYd0JdP+dVhVMfPP408KTDX1UMGzPYCmtb04HzsXAaePYtLzYkRha8",
+	"quatsVDViwquoWJKMyEl6Dnamm17rdDIQbNN7NkA3qYWWLIab2gkI6uO1igNbMvpubdFfXZddfvEK9rw",
+	"LfT0YPT8VA3ZkRJV88sXYXVwDZJuuzg0gR/XSPa6dPAzdhE/0cxSucU5G3AQt1v8xWuoAzS2bh+vsp1C",
+	"6dJ5LVj8TWhWKO2GiCI8To7/Aa7bzu54fl5rWPghNL8GbXiFq+st6kEk31Od3N/rzM5nBeiMne4n+g+v",
+	"GH5GlQEpAiP1CHr5q8ShrnSSH6LKzYQNyOKu2NYZsxlamI+C8nk7eZ69TDp53zr7ud9Cv4i4Q29vRWlO",
+	"tU002NhedU+I6QgmA2F3L9NJ5pqCgLeqZo599EBwnIJGcwhRtye/179Rt1lur24Hd7q6hZPshLp1/5nE",
+	"7L9Rty88ZEr/+wkUSYyQOD/iKYQ7ioa6qDzuajVaV7SLpdJ3kx97N6pkrYMd4zhq8iiZ99/W2LSpF54Z",
+	"ZZx0XIPeQK1P836xrz98DmMdLFxa/jtgwVieAH8PLHQHOjUW1LYW1SneiJus2I4uEV88YZd/vfjy8ZO/",
+	"PfnyKyTJWqu15lu23Fkw7HNviWbG7ip4kD1sJE7lR//qaXDL6o6bG8eoRhew5fVwKOfu5Z6ArhnDdkOs",
+	"ddFMq44ATroCAO9yh3bmPBkRtBewbNaXYC3q0V9rtTo5+x/MkIOOGr2uNUpSpusa58XD8xKbnMOt1fy8",
+	"ppYgS6J5Wocw3BjYLk9CVGMbX7azlMxjtISDh+LYbWqn2aVbpXe6OYWSEbRWOitz1FpZVahqgYKtUJn7",
+	"7rVvwXyLsF11/3cHLbvhhuHcpOFqZDlyraEn3uQL2w399la2uNkrLLn1Zlbn552yL13kt8+uGvTC3kpG",
+	"1Nm5bVdabRlnJXUk4eovYJ3AKbZwafm2/mm1Oo0tVdFAeUWZwZmYa8GEZAYKJV38ygEJwI86BT19xATH",
+	"KjsOgMfI5U4WpG87lb4wLxxthSRXVbOTRSIpIYwVlGvQE/AxXRIaQ4eb6jOTAQfR8Vfgld0830BxZU7O",
+	"h9PBs4qozOQ5ZvzZX759y8431Pq8oOafIfCvaG3fSqt3r7VSqxPsJ71LFvkbHjd143Vn7fPc/5HIQ9Gz",
+	"sXWX3FnI8aB9oSE4W8WXUIUZKm5sMo3zWq9x3azgMprPrW4Mcj4lCy/yu1GEYYQ6KOl5jOO4cLOlV2R7",
+	"7hH6O9FgTtoWq/Ae3wobrkatlGW8UnLdPiXcoyPBQxu1Nlg44J6NHBvnFR5FnWDyVBppgoAi1cOc+cO0",
+	"VLcu9GEe3QVAMqsFsCvY4bqXjagsrW/SrihZCQkLP7GZSA2uVwDXsI0wVuldnzjcZjhsGmbVnP0GWhFn",
+	"oE/G6YlqDSW3wIQ9BmTn0UrPuSPBpp7uIfiJQScKzsNpQAteid+gZD+AviJFXlE1dNlTtzZW0m32pAmR",
+	"dsees8p2kJMsfusAoJm4P+OO4Ja84rIA47ofwNlECEcvlUhebsPyrGEen+PA6KSF4B6QjGcf4vMZ+WAt",
+	"CKsorDgNmFByIhlRd78paXc6qp+GkKyyvDLHMRXXh3HSH0zl29k72tNVYG2BrufdeLzkeokAH8L9CEPa",
+	"c+inyAuvw/nhXkTwlMLXXEhj95D/WXv1kvfkC6gs/07pt62e7y9aNfXJxYn+nFPFoLhCR6Ql9g3OaUKu",
+	"q26s9Rphz67xD1nQ82htcWsg6EmSfSXWG5so1u8uAu2FMTfLIYLCPkPj4o+qxEeIbcwJVFDtYO3LCGk2",
+	"fQ/xpWos40yq0nGoxuSVU3tEMBfNmHJXx5xQrACkroI3uNqmZhSrt0fGW/DCcY0FoWaEVbUhZq6Vm85F",
+	"flYaeIlSHshWbvOBSrRIToGGtnN/NXWW4Sdw1VoVYAyUkcUcAi20c0Kj3YMnApwAjrMwo9iK63sDe3V9",
+	"EM4r2C1INjTs8+9/MQ/+AHiJyx9ALLXJobdveBxCPW36fQTXnzwlO2fSdFTrXwF1BRbGUHgUTkb3rw/R",
+	"YBfvjxZ3zf7OFB8muR8BRVB/Z3q/L7RNPZLswav3UTOEGya5VEEhkxsMhdnF4ZdxR+JlBleQcMIcJ6aB",
+	"R2TrV9w4HywmZEnGX9NK1tSHphgHeFR9iSP/4j7mxi6UNCBNY6Ia0zR1rbSFMrcGMgyOzvUj3Ma51CoZ",
+	"O+pKrWKNgUMjj2EpGd8jy3h1AP7BbfLuwOEyiyNPf7znd1lUdoBoEbEPkMvQKsFuGvA+AogwLaKjC2uX",
+	"cmKU/XxWP6kXGnix4UtRCZtRXbx+8vpN0iBiJv3NnyScpT2YdbOsRMEQCVqCxWNXggW9DZf7RWPVjxdv",
+	"n/mWc1ZrcU2uEZo18kqqG3nGKM8QZWjYgGS6kV7yrZ/UTIK9Ufoqq4gxVtU1skG7aGREyNj+X7rWF/bn",
+	"tu3w1HDbLrNUYMiFxrd3X+DGkYzL4bDhhnk4ggmb7FsuZnO4GchlFkbIAhb7jjTpvLFVerYPcp+mXmte",
+	"wqKEime2+Wf3mbnP+wYgUm71/8rCwgXj56m5PaLhPblnaEXjZW6DHxWjL6xA3oJvnJbyfe8DI5dAY+e4",
+	"rj8gn8WhaK7sFoXxaNmjPrV0zV8rNNMFeiCQ/VU1BeARPMSh744K6rxoH/r9Kf4XGD9BaHOHSXZgxpbQ",
+	"jn/UAkaM4z7PUXJeevdW72rJ3gej/PkAHxk7siOW+tdcW1GImh5x38Pu5G/a/gRZ10nkwlyg1TX54N63",
+	"ddqfuTDy/ph3e+NOMkYOwR9YIzPLCSEAXeCvYEfKhNcuP0miwznFIz0zKhMu7RACGrIeQNlNpwK3vLDV",
+	"Dq9Cu4EduwENzDRL58Q6dDBBV9V0gKzDyp4ZvX9e1jtur8PgJQ2VLC/ny+UeO/vhe9t78XTQ4R85tVLV",
+	"BJPhABlZCCZ5D7Na4a4LnwIpJMEJlNQB0jPtahfA9VdFimZaAftfqiGDFVJ2YyEKa0qToIB9aQZhkjl9",
+	"LGiLIagoqiFi5+HD/sIfPvR7LgxbwU3IG/bw4RAdDx+Sguq1MrZzuE5gUMTj9jJzfZAmGi8+LxT2ecph",
+	"p38/8iRlb2/wMCmdKWM84eLy780AeifzdsraUxqZFvBgbyeu/G3XQ3ywbtr3S7FtKm5P4cYD17xaqGvQ",
+	"WpRwkJP7iYWS317z6qfYjXKiQYE0WsCioExeE8eCt9jHJf/CcYQUVoScC1MBgpeu16XrdODt3HqCiu0W",
+	"SsEtVDtWayigdG4IwjATl3rGaFhWbLhc04NBq2btnUfdOMTwG+N0TrqRgyGyQpW9lQvS3ucuAB+o4Fk9",
+	"iVP4NBuq/t0D5obH+aDs3AsT96BvCsl6Dc1no095ROp1+5R3yOnmbptwGXTkvQQ/7cQTfUsIdStyD+jj",
+	"K90WPEy4ub+PLaIdOgflcOIklLD9OBZNiHqEancCoccNxDTUGgxdUan+zbivapXmaQzBIjtjYTs0Ubiu",
+	"fxs5fm9G34veQLhVEnbZ1MRCwg/0MdfbXZMjnUlgGevbf4N04O+B1Z1nCjXeF79ut3eyeN5oo/QprrnC",
+	"jZQ/xeRw5VvMXSyQU+xIvoXprCUCfND5MEBzH7cxL70hiGVnBTh7wt4uCiuuhd2dBom4g/tuGeStUR9b",
+	"qOuAyb4QwT1UTMgSbs/GVY3/JCEW/cfBtGfg4HEVN2EChSSo7s1/pFgVNH6qKTZQJvHk807cntIu+HyO",
+	"2r8kULpLTnRb9s3i5julT+Wv6QacjOMJbg4Hke2nvOtpxMDAof+Cz6jYv4zNPNKdIISrQtD76WVp5j5G",
+	"07k8eGeXLvrbXA8nuAf74/YM9WmyXjJEQVUzzopKkJlKSWN1U9h3kpO+OFlqJsIkKMbGTSPPQ5O8LSZj",
+	"KvFDvZPOOyhqkbMneAUZlel3EN1ZTbNeA/lVdvL6A7yTvpWQrJHC0lxbvLoW7u6qQVOYx5lriVGzK6QJ",
+	"q5zX1LKx3Zc4JQw1Fg0tzmsAp2Fq9U5yyyrgxrIfBPqy43DBIzlcn95WELGQ551rkGDEmIvhX9xXCjP3",
+	"y09dxXznEAI4dLdqk5b/v5//j2eYrJwvfnu0+Pq/n7//8PTjg4eDH598/POf/7/uT198/POD//HfcjsV",
+	"YBflKOQvX3gt1csXpIpIAqf7sH8yIyPmwM0SWepq3qMt9jmlbvYE9KCrqLYbeCcxjsAqdJ8V5Gp3F3Lo",
+	"3y2Ds+hOR49qOhvRU0yHtR55E92Dy7AMk+mxxhM5mOPi84ljcSNDLlhsxVaNdFsZXsIubUvwclareUwO",
+	"7OqGPGOUOXbDQwSa//PJl1/N5jFraft9Np/5r+8zlCzK21xe3xJuc3qbNGT9M8NqvjMw4mo64vEbHcjS",
+	"YbeACj+zEfWn5xTGimWew4UMGl7/eytfShdujeeH/Ch23oqpVp8ebqsBSqjtJldPoPNoolbtbgL0fNuc",
+	"z/CciTM46+tfS9Td+JiBCvgqDQ2YwCbiOXCE1vrLRqynCznKo7VHlmmwub/8Tx/q4gfOwdWfczzCxTNM",
+	"DG75GPINp0mBM2qtmA8u8XpEbpZm+Hgn38kXsCJNoJLP3smSW36+5EYU5rwxoL9xHuxna8WehaRLL7jl",
+	"7+RA0hotdJTkiwwuDVewy5GnK14xHOHdu1/RwvLu3fuBA9jwKe+nyvIXN8ECBWHV2IVPvb/QcMN1zg5t",
+	"Yup1Gpl6753VCdmqccYKPz7z4+d53uHst7j8uq5w+Z3ccNTJudEbq2J2kCThGu7vj8pfDJrfBB1nY8Cw",
+	"v295/auQ9j1bvGsePfqC8qy0qW//7q98pMldDafPo0sLdyoeCqRdYBJ+k12+BV7T7pO8vCV9Y1Ux6tZ5",
+	"S4boZxqqXUCSgG5kAxwcR+eqosVdul6hzFJ+CfSJtrCbe/Je+5WkDr3zdh1IP8obu1ng2c6uyiCJh52J",
+	"1Vd8oIBz+UKjKh4CX6hmCSHYjApiwLa2u3mnu1p1BM0krTPVlnH5XiiCjIyFWHOmLrkXxbnc9dPMGxfu",
+	"TYO+gSvYvVVtcYRj8sp305ybsYNKlFp3ciPeM3NnIItnkS5Cn/GD7ETeExziHFEcygxJiOA6g4j9CSKP",
+	"XyiOdy/Szy1PyAKkFdewgEqsxTJXFu9/Dm3TAVakSl8JyIc6xAENmquFNSE0zD/vNdq7GAU2qVoZXrkq",
+	"Z1kHKnoPbYBruwRu99rcZJp8JUCH/dkNniynbadoSbjF/RaWtOeohiu9osi18SESZ+NOrg5wKO8IT+je",
+	"vhTORt+6HnWZCkDhVo7Yjc9arwlO6eztJn6nJIhrrW5wXwxFyDpKdfmsk/ulMXwNI2+X1JI+Mellx/pO",
+	"gxySSLIyCPrudEWNgSSQBdk1XuCas2cY8AseYnpm9ry+w0zOWcPbb8nZ1CNsWZEAG93j3d5z3fFokOt9",
+	"oOVZC2jZioIBjC5G0uO44SYcx3KecNlJ0tnvmOJoX6mYl4nDclKkLBaCCbdhn4MO3v2+YEyoEhNKw6SP",
+	"/gllXlwgYpPfDiVJNC2hgrVbuGscCKXNFd9uEMLx02pFvGWRcxFOFNSJAODnAHy5PGTM2SnZ5BFyZJyA",
+	"TU5INDD7UaVnU66PAVL6XPc8jE1XRPI3nI0GqZJgsaDyFQsxYvsvAgfwiQHTMhidsI1QBWPOkM1d8wpk",
+	"DNNvBxnUXKAHRa8+iXeDezD20NhjJnZX/lFroh53Wk0qzQag86L2HoiX6nbh0idl3yLL2yXSezZACntl",
+	"D6arDfOZoZwE6FpJV4sLyDkAyzgcAYwWAKqvgGunfmNylgNm37T75dwcFRr2eZQ6W3LZkwn84NQjsuUY",
+	"uXyeVNa4EwA9NVRbO9mrJQ6qD7riyfAyb2+1eVvGLMSe5o7/2BHK7tII/ob6sW4tjL+2NU/G6yr4Rp+m",
+	"CMhQs3Sf4iyuMwFijqrN0ieHDhB7sPq6Lwdm0dpp1cNrgrUcK2FCZoySQ7QZqIAewYuOaLq4gl3+LQ90",
+	"j1+GbomyjnaPy92DxJlXw1oYC63RKPjo/RHq+DZLwujqbK1XuL43Stlcbop0mZ98BRQNQ34PC7K4ZZeA",
+	"jb4zpET6DpvmJdDOZjNX/FeUeY5L02JkaCmqJk+vft7vX+C0P8aLxjRLusWEdM6SS5c1KBdEsGdqF2ey",
+	"d8Gv3IJf8ZOtd9ppwKY4sUZy6c7xL3IuegxsHzvIEGCOOIa7NorSPQwyyWox5I6JNJr4tJztszYMDlMZ",
+	"xj7oMRpya4zd/G6k7FpC8YTXXmNzoTH2ssosKSp1KJ4LuTjUtpudIbO+seEoVroTT9sdHt2Tg9G+nPfi",
+	"GhkFWgXWl7zle94ryZE5Ju1Z1OKMO0EUGkoXOJIfrhRrMBGuSpFD2C9vvmOqsXVjezNp1o6Xna4GLdTI",
+	"+919647Ic34Mea1wI6R1hW4NyGwdg7dDpXaA+w6q5xvA3Cn5Wdy3aciZtqp+RInDY1xrL19RO9EsAjqP",
+	"NLz3/HTLmwxPT2jncvejZG9AX3ePDysb7YKaHSEzfxhdxkdSOW2Bm8a/XNMDEYOtsWk7IEjHwTuHo3tC",
+	"y53kW1EsXDVfCqNUzcj+uDbMt2FgrNjSsyRO32KQebSFXGv9M3yGygkK6G6kFRUDSTESwwGMC5YoVIWM",
+	"H0bON8hyJDnDz1LcOrT0cNaN5u+ji5xa/T4ESG82ooIWmV7rHUK584DdAa9CMlitqHa9DMf70QB/Y54n",
+	"dPxN1vuk5a+m5d9BBxLp47i6Pv2LI2OHOKh8dA//u7As5P6n3fc9d4jdaDD4dMpg9xdn7gwNXFqE+2P3",
+	"bRgxh1dPLyPFnPALWwl9AiDcaNOKNs06uzKg/5REOxhNlrOX0bYYyYgo151diJtwKhGlHfmGxy3+xMIJ",
+	"taX/+XOsyakxJgslS7RvRf1Qel8qa9U2mfwuokVc/Z0OKtT5OfDLKWbY43IYdw3bPGNGreycSrAgzpDP",
+	"ZxGzT1BxCtOuuBJSJfBiEzM1x/UkVwjI7reUloK72bKRZQWnk3YQ+36snsgzSbh5O3Z5XcQ7KzIaHjrd",
+	"76Slw57ghJXAy7xkRtK6/xrp0M0+7cpdcWPH7erWV/XhbMVdMToM6tmFKfK28QlnMjFC97B1+tN5wrkO",
+	"UGjcJo/WA/SZVBTLQl+p9RrKUCkpOHPKpB5VN8Ez/r6n/NYZc1WwqIjVnvpXPp8DjGVzSGxVC4rfGnlz",
+	"tc0c5O0mUO0ummQN0hUCyPs0qPWBXBHUIhEIPrEjb1+iyUbTv+15Yrdh7m6X4nbSBlTAS29QMxDWt1+n",
+	"NNwQj7r5WBx+p4rkfv0PDUg0JaxJlOIDshjRHvK6FuVtz2tyX9zfRCNBO9iIqYD0Yn6wAxjoRtNnCa5t",
+	"/JlhPmbfe4edk8H2HE2KvlC+i1BH+uaFT1Hp38bdEPnBuWoNjRPX/v0vl1ZpvgbvQrlwIN1rCFrOMWig",
+	"DnHtwsVClGK1gtR10NzF7a0DXP+45SjuYgKRHcXjxcHz08J4GGV5isnQwphDeUab5dumfhDxSki25g7K",
+	"rmxCy+9ht/gFLeas5kKbNs7b+0x2NcdH7Pr19nvY0cgHn2oI2IFdIbeJN0A0mHNTi59MEhL7mUkx5myj",
+	"nS08Yqcu8rt0oq3hdb2f+NtbJl1Rbyn3ORithz/CMmU3LvOO9Xh6oIv4Pikf2gRRHpZBEmNVOpUgB/X8",
+	"VRSztR6iXSzRFIiXljOL8SF3dWPP3WZ+xAO4fh0v0CyeKUzSuTV3olKORDmvMfiIVwvv7D92+Wt17S9/",
+	"ah5iAz6xGS5P2W+/vXj12oOPVpEKuF5EM/boqqhd/S+zKg3cqgOGEVc42HvpODeHZPNjcdc0QOCGigT3",
+	"PCVQTvTE1bLQ/nghYGCVj9Y+yPt8nIpb4p54FahjuErrsEudexEq/JqLKnjKBmhHIqtpcW2M0NFcIR3g",
+	"3pEuScDS4qTsZnC686ejpa4DPInm+omKvuVfHNKXhCNW5CNX+Mmlp++U7jB/n+IqG/ny+4lVKGQ7PI5o",
+	"/byf8UCYOmNO8Pr7+u94Gh8+TI/aw4dz9vfKf0gApN+X/nd6Xzx8OATa3XZ5JkEuFpJv4UFMETC6EZ/2",
+	"AS7hZtoFfXG9jZKlGifDSKEuhCWg+8Zj70YLj8/S/1JCBfjT2ZRHerrpDt0pMFNO0OVYSqsYIbnlt5hp",
+	"wDAl+wHBlE0NSYuYva/u7jyJh0dINlvyvl2YShT5uAS5NMhepYsExMaMGo8oGnHERowElspGJGNhsynV",
+	"CHtAJnNkkWmyBRFb3C2VP96NFP9ogAky5a4EaLrXelddeBzQqAOBNK8X8wNTn2T4++hB9jhLBl3QPiXI",
+	"XufTF9EhMiw0enFz2QnjOSJ8OZ1xwLj3hB57+vDU7FKxbOBOxhbnRZpVH3j318DovKfpyBxrtUC2GPq5",
+	"RMPCLFZa/QZ5Lz5yfsxkVPUT0XOEeudU632WEj2iw3rS2Q9t9/S38djG3/stHBZNv5luPorJl2n+VB+3",
+	"kXd59Jp8IdT5LD2SebjcR9aNax9hLXS8kkhOssCF0Bku3Xly6UQ76VHypzJpYc7d+O2p9DD3d7Wo+M2S",
+	"F1f5txDClGxvJ8jHKhY6hw0wMVmmm50l4cexrXAlCWrQrQ0i58N2p3eNm3byi6Z9wGDHztPF1d7klVGZ",
+	"YRp5w6WF4IPv+JXvbcD5j2OvG6WpUorJxyOVUIhtVh377t2vZTGMPSnFGmdydUQYX1lfZsMPxFw5FqKi",
+	"Upi64ruYAtaj5uUK3XXimQy7UYprYTAKl1o8DrUVDV2X0YgZu+DyQNqNoeZPJjTfNLLUUNqNcYg1isW3",
+	"Jwl5MapuCfYGQLJH1O7x1+xzX3fyGh4gFr0QNHv2+GuKBnF/PMpbVle8qew+ll0Szw4W0TwdOz8GGgOZ",
+	"pB81bx5daYDfYPx22HOaXNcpZ4la+gvl8FnacsnXkE8usD0Ak+tLu0m+6D28uOq0JRir1c6XihzOD5Yj",
+	"fxpxIkH258DwRSm3PurMqC3SU2Ck4bCF4VwJXsfTI1zhIwVv1iF2rafr+sTPGL7N0wOnENsfyUabonXO",
+	"uCuPU4nWP8gzxDP2MlTfopLD0WXD4QbnwqWTLIlbiNECWkhL+o/GrhZ/wmex5oUFnS8OjEMsll89HYL8",
+	"DTfw1dNYxVMeB/gnx7sG8mLNol6PkH2QWXxfTOEmF1uBrP5BmyAwOZWjUabZae1YUOP+oadKvjjKYpTc",
+	"mg658YRT34vw5J4B70mKcT1H0ePRK/vklNnoPHnwBnfo5zevvJSxVTpXUrM97l7i0GC1gGsoRzcJx7zn",
+	"Xuhq0i7cB/o/NngniJyJWBbOcvYhkFg092V6Qyn+lx/a2oBkWHVpdHo6QKUz2k6vt/vEoXLHad369lsX",
+	"7UTfRjA3GW00yhArI6Hj9HPb54/wF+qD5Pa8o3B8/Hem8Q1OcvzDhwQ06h1d078/6X527P3hw3wlq6zK",
+	"DX9tsXCfFzH1ze3hNyqjAPtG3TouHByKfHK/4f6NXlL4AZng0g81Z8sOf/n0UsRpkpPkQyXzpwAjI/FL",
+	"wAP90UfEH8wsaQPbEPvxw/6Nun3hV6d0nmTK+D0J0ubsG3U7lXB6d1Agnk8fYpzf0Ax4fk/pltZgGy2h",
+	"bK9r+hoVai7PRCgGTHmsjy6TT+gY2Z+JukKCW61691bWd+Cg80pyYHDUJaCvq+nU8E6NC//Emz40BM3m",
+	"e7DdiKr8pc2S3rvVNJfFJhtvu8SOf3MPho484Ph2Dmto/pRQZYdzD+2/hQd5RmXwX2rqPFshJ7bt4cov",
+	"t7e4FvAumAGoMCGiV9gKJ0ix2k1AHRMcVmtVMpqnrUHbcuqzWWavXsCyWV+6xIbmtc4lTXbDbhvrnWgp",
+	"esGn7l2JCv83YsSmlgvN7YjXv6aMQKt2RBea6XQebnTQjIstSQmGY2FwOpnXgM6K2FVJ6HWnZOQ0cidg",
+	"ocZP1JJSPyqG7Iip1SpZBkgrNFS7Oau5MW6QR7gsuKW5Z88eP3qU1cERdias1GExLPOndimPz6mJ++LZ",
+	"oCtweRSwh2H92FLUMRs7JBy9041843h1jqfSB5cDCjuTCFFSJwayJB3uGfsL5RBGIu6U+0BoYmmsbmmK",
+	"pq4UL+dUsgvdhJib1fVxlwwrkajXCH+P/LO2numlOkKO5JEctNPH2Z8U05WgoYA9Y/m2zmX5xxZvQwMm",
+	"eg5ApFRMsXPGXjh9rgnaQjcJo8Jvegsli9N5jQIRB/7HWhcZZVVHHBvnlW1F4rFKGa99i8DOWjNSksfn",
+	"Onwkho1wO08DYI0sQc8Z1ce5EViEa8MtXEO3sEAAI9bE9oUGussLdayFPDtCMo6lyY9FewCOxo0eDlnI",
+	"eog/NqJINbqA6TTpzvMl9coHhvTqBvVcEEKa+lA4jv3gLR0Fl0qKggp85sR6SoI+zWY6oRZq3thpZv6E",
+	"Zg5Xhl5bkT9g0a///Sgj9Igb+h8kX3FTHXW4Py0GuJN5bw3WeM4G5Zw0WKICb50T0oAvPo9ElPJJpTMe",
+	"VtmojOjNcSQZUX7jEXXrd/jtR6+MxyPIroQktZtHW4i9I/sZZoREapdMWLZWYPx6uqFF5lfsc0b1Dkq4",
+	"fX/2Sq1FcSnWNIbz6cNlOwfW4VAXwZ3Vu49i2+fY1leEjD93fNPcpBd17SfNcQITd3jwCasejiE450QV",
+	"vFoS5Mbx09H2kNteP3S6T5HQsFSoiyDEe3hAGKB17rmKhUIbR1HUgrncRDmk5CM6XwkZ7Ln5C6LIXgm0",
+	"MXReR/qZQnNbbDps6JD36kg0BsXIF1enGKq3wT56si5mYY7xbXx7K33dzhHGERu0Ej+XOxYOBVJ3Ikxg",
+	"LGb0CyYhqKualmUUokqKdPLBzk4syzMOZNyLEL/ZQdfBWMLYnWrMHnsTjWX7XzblGixmks8lif6GvjL6",
+	"GiLWsM5tE0urx1DFbrWvTE4AN1GhpGm2e+YKDe45XSkMNwa2yyrjw/oifoQy7jBSGpp58N9cXfHxnfEe",
+	"3Efntwru2uVxJe6G+bpyUi/S9AIzGU/HBN0p90dHO/XdCL3tf1JKD7HD/xShwT0ul+5Rjr99q7XSaQmc",
+	"gbO8u1pihRpyTFf0PaQOjrUVevmSuCPawZx+8zJb1gM+NMwCfs2rkZxyqeHG3a/OmDGWWa4YTYTIrU90",
+	"bTnby4JGkwc7x+WeKWhozxxzVna+yqczofi17kXouCHx+47Z0Dmstcxi1Fx4N4teu8HHmvT+Cryym+dY",
+	"dGREyxOLWKs2lYUvc7uhzq5kickQNVguqpCRULhj8LrTJOf5NSjn7XTqOC/NRKlNwMlzZC+fM9MUG8ZN",
+	"J/GTmbMaQDOfYF9pcr5iW1hzIrGsBig5bv3MG7sEglKUzn2Lm3FfnDw+W+cbP9gzVqAA2NTz8GZeVHzt",
+	"gDdzVgpzNcebtrgCvXB6VUYldpOskmezI7LSv91ALwm9hwNXM2c3XEvCFhfV2VRzkp/rAIWZfSRmjiOu",
+	"Ig446VpK6fy4ZGKd4sldEJ0SL8/m9mF/q4xlBpU72Z0wd9uKmC07PPQ9inKb8v31WI7RUOiWvvfLW1+B",
+	"L0dUa7gWqgkeoCEOI2iC3K8+h3WncO4I28tGN/3RltNRsyCdY7jxy/Rb9/0vzhOEgbR6909g9R1ser8q",
+	"c+aRSy2Se8prvgasckSX1RGGpxSBztUb9k/CoCJ3EkWHlgb1mwdk9WLKK2CAj4/z2cvyKDk5V7N65kbJ",
+	"HbtXmNqLSl7+FXgJ+vWBkp5tGU86YrUyIj7CWIWDhZxsNNzZ1IAnJGCRliQdjhUc4a+hsEp3HHw1wDEF",
+	"SnGyYOv9d2nPcS1ajAvzFT33lfGczzo58r+H3d6V8WHm8SR7/mgOw7GilRcxjMNFoaJAFlNG9fI2TI4e",
+	"p9yk4rqbaDuXAU0mWcTnMRWapYynbeJ3EWMpqTDe8caGFqCK3xGeip8OnLFcGlew+8ywDjW8fJGMPwgk",
+	"vkvlLcKAs3yHRJtj9iPvCiNMpAzCQghLcN2hrS47WjQtqVtwx7kCSeLF0dYy2DPltbJwx7mw61F1Uygs",
+	"cCwZ/GtXHCW5LsfVDi/cK8s76fJYuStVzqGdoV95+sZX/qK8/NFkGmqAgQm/hSIcbpZKXKW5ip2BGuu2",
+	"hBYnSUxHzVBFnwN6FWcWbRDZ0LdpuMcuHrOoFIoRi7Gg1m7cVnR6/sw47/Q2iRjBtQKtoYyW0EoZWFgV",
+	"gs72wbEPFYZc8O+EBDNaP9wBN1o77k1bHG8rCq041Yrj3vM+XSDTsOUInU5K2I3PuQ/Zz933kAgk1NE/",
+	"qFiO9Lo46OYZwgeFGSAxpfoV87fl4QQjd9ExCylBL4LBuV/PTnazQlLhmrIp3AWdHoyoh5+cv2sPK8mq",
+	"Z4vhKntvhCRRxxXszt0jyKfsiDuYAu0kJwd6UrGnt8kn1bqbHNzrk4D3x+ayrJWqFiM2zpfDInx9ir8S",
+	"6CvGVNOG2XhdRtoSJ2Gfk2ktOrHcbHah6Fxdg4TywRljF9IFNgZ/lrQM4GBy+ZndN/8tzVo2ri6m16Wf",
+	"vZP5CDGqWKnvyc3CMPt5mKvqcM+p3CD7J7K3cszT7oaqW0KZ4vRs6qt86GHST5jbEpWDIieTXDpD9XM6",
+	"6DnFEaVhSfIFkf8CZ97AzUylcvEEd0kVg0PlMZVORgBZkFMylkQo/OBZBHjnvQNpSf3nkHhTrZiG1nfk",
+	"rhlIfVJPx5rN2Iu+P3OcpcvvVkpDOiP5prpswzH4DhkOeWzppbCa691d8oR2UZXTnoxi+aAXZnTAbBfS",
+	"OmEOcVhV6mZBzGoRC8XmnrbYznQvY1/SsC0wS55vS0jcObnxgtqObXjJCqU1FGmPfMy5g2qrNCwwq3Q2",
+	"28srsbIod28p0FRi7mGm6kKV4Aou5ylobK5Gosa+XESaHEWBox1cqe+T0PHEKfFOdebjBYlaB+sThs1/",
+	"i31c9ow2s5xb9MK5MIwEKoDxmeQ8hlzjIbxEOC71Ul+XOFZw5ZboBnTuyK+Y1RgV4lvQ6B0SooNPpXaE",
+	"MQ6USEs3oqooeYW4bfkBRH+lPGpHxN6X5E19LcjlrpvIhHqwGu+8mN0l5QGXaeo1ZjeaKue0FT4inOHJ",
+	"qxv/IE5H+dk05BVJ8TE4xVNn7XAvTTdSu+TW0/TzQkmrVVX1ihc5uvGa9h/47UVR2FdKXWFCkgf0rpXK",
+	"xpWW85Djoe8T3M60rxTLrVwQDZjD6cJdO5wlcIHJDLLH4gZK8UNa5gTM94c56GGd+8VwYf11dZlp/hmD",
+	"WZWt2ooif6b+tZxsR11jcywqhwrXwx18R8R02NPLKvpUEYscohkkEmxuvzwj8L4lxG7wvySB98dlK+B2",
+	"MHdyUQ6Zi5eiFsWorNcDgCAVcu2DFfB/HUkschW1dulayDOmD+jEW4UcEO8HG45wcqAs3AuogdNzBPBz",
+	"p3yYu/yWzoEag+b89wdtAsw7Af9xP5V3mMeYZ+dlS1reYSQkyxrhCPk0+3vdIN9S6o3lVGdIEyxYE2/4",
+	"BIBx98gODJOcJI8FA239WKDajlzupKOaJy9tH5GZjB4Kc9EsrODuwkb7CBdVo8Enb3Iivu7av2puN+Hq",
+	"xOZDTTJqJX1U62+gFdm5y3lif4HK1STsKQNUvajgGjpeo46WTUOipriG0NfEzqwEqMka2deR5dwh07u8",
+	"pzjxa1+MevjksZvVpDjEup1iB9QkWaXOrVy4Y2KmHiWE6FqUDe/gzxwrcnTVgHiUM6gavBEW4R05dZqf",
+	"3QhvwgAXoX9OlAmYeD+NDx3NgvKo28eADrpHN2bs1Mu8d3SaLi0aWGi2MhpiHYm3fMPU/EaOKySHJN8+",
+	"tybuk1AyQey3t1CQVOPfO1D6F8+IkcJnXiJqlwClexVgl4y2fQOSSdU+e0gbGZ4qbR7X8IObmBoJ6V/T",
+	"dzAqt07M999ZRoMx00voOPqQ0JFO766e/0NO4t6DODpejkYM+KjfPfqvQN3+2UENVFOVTOJ+ouy/4dcQ",
+	"bjHPxeds2YSBUFvhisSl79AXEOygSqYmILeikAnROR8Sut0NNlR1iCRMBS34StM/Uln2j4ZXYrUjPuPA",
+	"D92Y2XAkIW94jcXaBFQE6X7xah4A8yCUKkzl1i2mjpkMt8NREqDxIg8FxhTb8itIt4GcHRz/LCwyTtMs",
+	"SXOBV3ZvO4dY8IsPaaK2vExf+pSsdtfhDiF9Ofb+P9oQ2HSqkGOyrngBZadMWpfPoDAUictuYLs/RnrI",
+	"1wIJhFYJ0eqQVKO8g8r0SNaVCzwaKwHVATt5RnQrQJ1mGRM1v706P3uiyyct5dS7cFz9zwRoMt2HRJ8H",
+	"wHcJmn3bT4L/bB7psWVMAf+fBe+xANs4vNTkU2C5k3gnA6vTVi/V7ULDyhxyMKHW9JzXbcqeoGIVstDA",
+	"jfO4efmTf3i2aZKFZCGyorVpxlFKWAnZMksh68Zm3jGULVnuEoSlSn9C64gJbUxKQGHymlc/XYPWohzb",
+	"ODwdapUmdUZIgqHD982oMOKdOhxAmPYNR2HZrRo9bYYXuCuE59w1jeWy5LpMmwtJxXw5linlO3N3i1I0",
+	"DhyyKfFEmukmC0msS0TaDpBq543C97T3RAD5CQ0/Eww2bzfgqb9rrHGqHatG7DNDGP4lDDZbfos2Pgoe",
+	"HgsLcfmxycJHzSjkCqUoks+mrTvMY8RvsH8aKg3iGZFVNOuUKfaf+59oK+kZ+bMUdu/JdzrKfjS387t1",
+	"BzMgVa5b539HLMPzWBf5yepuEH6MtPGhKoH2INlEGLEPdfXiI7tIbhA+e0OqBJ9ecrHraZEL83eagQVp",
+	"DMwe9/42FR3h2nhV0sDdrK9qcEiZ+yQJR2ranH4+3Esj4PmgPnfWu9NGlxkc55g6lfvTIixqVS+KKT6f",
+	"rnpQ6QAIkHZhHI/5gv3UEd1jTKynlVJjt7DWsaU6Rwt7HbJ21cW+R/+YmmiEo3dNEGpFvIyOsFOOKZ0q",
+	"U+b9GLOuGiwyCcaZhqLRpCa+4bvDpQ9HstZf/vXiy8dP/vbky68YNsDKDGDayge90oGtX6CQfb3Pp/UE",
+	"HCzP5jchJB1xiAv2xxBUFTfFnzXHbU2b1nhQOPEY/XLmAsgcx0zJujvtFY3Tuvb/c21XbpEn37EcCn7/",
+	"PUM3jXzlmShXZQwoud1KTCj4AqlBG2EsMsKuBVTY1iPabEg9SPnHr10SKSULCPpjTwXCjrhc5RYy5lBL",
+	"/Aw/hWL/DG7ryvOqmxARProu/05zGjoSGskrBrVYqvaivVixHEQUQaSTyFqv+CSNeOIjG5mt85bNEaL3",
+	"PM+TXlq0fz+37xaUtnlOj5uYES/CobwDaY7ZJ8bTldyFk7Sq/X8a/pHJv3IyrhGX+3vwiuz7YE/M8cXA",
+	"7yHmHpkE2jAXR4Y8CICRaNtOnGQSKJYkQ9fOSkD2hGBA7osfP7SG5YNhIQRJ6HAAvDR8tm0XIxk8OH9w",
+	"VvEfIlKSpbwfo4TO8g9F5AbWGy+SZIu80sRaMI4tqaFYmIRbm+cxinnkVTIIdtZKWaYk6kYyQdJOj0Nn",
+	"KiUcIS3oa159eq7xndDGXhA+oHwzHhqVRsqmSHaoNHdLz/mKT5q74r/D1PI1BWb/T8A9yt5zfihvhB/c",
+	"ZqTc4ZVzr15FazRIdkNj0k6zx1+xpS/4U2sohOkb92+CcBIDQ0GjdYymwNyY+yNRD63zF2XvQcar4InD",
+	"fkzMW9Fm7yFsj+gfzFRGTm6WynPUNyCLDP6yPGoni+eNNjmR8IIFlahF4lESn+5GaecisFKkv9UjAfXT",
+	"sgKZnSz8qGdHFrZIj/XNRhl/l/rkSc73IdWAIeASoDT3zZXVqSqRw2ladP3AFXzPgjt3y6CV5MI8MoPW",
+	"sJz81OXROugibwwM1zlZAurgNiP8tGubmv5tct0eLI22nJK1LV9jB7tT2riTFNs5qtTO75AwzuHIj+Hn",
+	"zVHML2MpxF2a7JEyB739wIoIBy2VadEKDGIGCUYYKsvwN18T7NPKJwECl81ieFQdrPdJweMQk1lrZ/Jk",
+	"qqQcxYRKFL5bJnkcRYoWjRZ2R/Xgg1JS/C2b4+ovMV+Kz7cT7ZNenrDqCmTwoWmzqzQmSCx/UbyiO96Z",
+	"TSXe7Ko6Y9+6Ygn+oPz5s+V/whd/elo++uLxfy7/9OjLRwU8/fLrR4/410/546+/eAxP/vTl00fwePXV",
+	"18sn5ZOnT5ZPnzz96suviy+ePl4+/err//wM+RCC7AANVVKezf7vxUW1VouL1y8XbxHYFie8FpiS5uNH",
+	"0j+sFC6fkFrQSYQtF9XsWfjp/wwn7KxQ23b48OvM192bbaytzbPz85ubm7O0y/ma0iksrGqKzXmYZ5BL",
+	"8OL1yxj34HybaEdbjfzZrCWFC/r25tvLt+zi9cuzlmBmz2aPzh6dPcbxVQ2S12L2bPYF/USnZ0P7fk6p",
+	"is+Nr0Jy3sa/ZW2hbygMIDx4NLqFfh4jmf57tIabByEgCsuI4JWBQTAIXVzFy5KIy9eens1n7ulqHDk+",
+	"efQo7IWXHpML5xwHw98c/8jlHM0maPQAZyFra/kOF/2zvJLqRjLKq+oOULPdcr1zK+hgIxmctomvDRku",
+	"tLjmFmbvsXcf53Xta7+MoZyqF3ZPeehMBBKLh3AZaor4Ci4mh/Jh3Zl7Yn9vnt3BZJndoUavEeaQkijA",
+	"E4xsHmdkh3cIi2eEdmSI6PmsbjLo/JaClcw+nM2TeiYOGlWVEeMDjL5u/jfBKJKuv5tmzz7gXy73ZeeP",
+	"85jwEn/bIvEW4S8NvNz5/5sbvsaklH7t+NP1k/Pw2jv/4DPTfNz37TxBopnc8PxDJ9NPeWCK4IJ2qMn5",
+	"B58v58CAPgnOYjLsscNhSEIWqvEWnaCMqQ3PvY9y0mGtgSJgzq3Ygq9EEL5NxPW+ZudLdXtE08HCD3UA",
+	"M7XxCML2bHn/08gQxIDM+Qd6i34c+/3cmwlGPjpJZ+wzKQJdmz6G+i1dKpr8xw4pfLC3uKb9w2GbZDyf",
+	"OPj8A/2HWMxHx5kryGUyc5WpOGubz9G0xpdKW+N+Rc4dyq8Lk7QcsOcL7PXcQUCST3Cvmz37dag1oYFY",
+	"GInESZSVWmmvM1Mr0JM5MWHg8bnSad8+Wn59tPj6/YfH88ePPv4HPkr8n19+8XFi9MjzOC67jC+OiQ3f",
+	"3/N2Gugs20W6TYqXTSYfstuJ8fg2v1W9gVhExoEay73hh+9auiyfnvA+7qbbz9zF3/CShTQhNPfjTzf3",
+	"S+liJPBR4R4/H+ezLz/l6l9KJHleBfH5joL2hTv8KVNgfrNzgvZ8JpVMkonKtRMJlbGT+Y2x/A785hJ7",
+	"/ZvfdBoOrNwUh+qsDVshyc2z9Wtzl0ks4Qohw3KIreHlNZdFCEZso4Nov6hDIIzogN4YWDVVSMNTYyCQ",
+	"s8OpKkxkmrpGjrPixrap7smZueDSZxGJQ7NGFkqGYgHVLjpAUDYQcqIwV6LudBErpCpKJRYiEc/Cpv+j",
+	"Ab1rd30r5Gw+fN+2zq2/Jwt3eDwBC+8OdGIW/uRINvqvv+L/vS+tp4/+9Okg8CtnWOZTNfZf9dK8dDfY",
+	"vS5NL8M7A925vZXnFN5w/qHz6vGfB6+Z7u9t97TF9VaVEJ4QarUyYA98Pv/g/k0mgtsatMAnKa/aX93N",
+	"cY68vdoNf97JIvvjubM5mr0fzz8gs/44pc0QL2nbwcdOEvSRn8+Dqj2nKum2/ND5s/taNZvGlupGUmRC",
+	"Vjiiu5pXbMslX7uMGVE7jZeuH6DNz85+quOt6APlGacSt6qxrfnAxY357BnRaYauz+g6uRaSJiDvB5qF",
+	"r7ArT6QFX2V6qFy+9JD9qEoYCmK5W9fD2Ll547l7ND/9LTzk8h+PO5XkpeFcjIZkFEu7dP4+v+HCorjm",
+	"E6UTRoedLfDq3BdD7f3a1h8bfKGiasmPWc1H+us57x7CzjfasrGOA51U7qtXV4w0CpFr4XNr1UutZEQu",
+	"0T7263vcdQP6OlBSa/R5dn5OocwbZew5ib1dg1D68X3c6OBvETccv90ulBZrITGVptOUthWdZ0/OHs0+",
+	"/v8DAPB9lv5bPQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -24,24 +24,48 @@ type ServerInterface interface {
 	// Get account information.
 	// (GET /v2/accounts/{address})
 	AccountInformation(ctx echo.Context, address string, params AccountInformationParams) error
+	// Get a list of application local states of an account.
+	// (GET /v2/accounts/{address}/applications)
+	AccountApplicationsInformation(ctx echo.Context, address string, params AccountApplicationsInformationParams) error
 	// Get account information about a given app.
 	// (GET /v2/accounts/{address}/applications/{application-id})
 	AccountApplicationInformation(ctx echo.Context, address string, applicationId uint64, params AccountApplicationInformationParams) error
 	// Get account information about a given asset.
 	// (GET /v2/accounts/{address}/assets/{asset-id})
 	AccountAssetInformation(ctx echo.Context, address string, assetId uint64, params AccountAssetInformationParams) error
+	// Get a list of applications created by an account.
+	// (GET /v2/accounts/{address}/created-applications)
+	AccountCreatedApplicationsInformation(ctx echo.Context, address string, params AccountCreatedApplicationsInformationParams) error
+	// Get a list of assets created by an account.
+	// (GET /v2/accounts/{address}/created-assets)
+	AccountCreatedAssetsInformation(ctx echo.Context, address string, params AccountCreatedAssetsInformationParams) error
+	// Get a proof of an account or account resource against the catchpoint merkle trie.
+	// (GET /v2/accounts/{address}/proof)
+	GetAccountProof(ctx echo.Context, address string, params GetAccountProofParams) error
+	// Get the transactions that touched an account.
+	// (GET /v2/accounts/{address}/transactions)
+	GetAccountTransactions(ctx echo.Context, address string, params GetAccountTransactionsParams) error
 	// Get application information.
 	// (GET /v2/applications/{application-id})
-	GetApplicationByID(ctx echo.Context, applicationId uint64) error
+	GetApplicationByID(ctx echo.Context, applicationId uint64, params GetApplicationByIDParams) error
 	// Get box information for a given application.
 	// (GET /v2/applications/{application-id}/box)
 	GetApplicationBoxByName(ctx echo.Context, applicationId uint64, params GetApplicationBoxByNameParams) error
+	// Get a proof of a box against the catchpoint merkle trie.
+	// (GET /v2/applications/{application-id}/box/proof)
+	GetApplicationBoxProof(ctx echo.Context, applicationId uint64, params GetApplicationBoxProofParams) error
 	// Get all box names for a given application.
 	// (GET /v2/applications/{application-id}/boxes)
 	GetApplicationBoxes(ctx echo.Context, applicationId uint64, params GetApplicationBoxesParams) error
+	// Get the transactions that touched an application.
+	// (GET /v2/applications/{application-id}/transactions)
+	GetApplicationTransactions(ctx echo.Context, applicationId uint64, params GetApplicationTransactionsParams) error
 	// Get asset information.
 	// (GET /v2/assets/{asset-id})
 	GetAssetByID(ctx echo.Context, assetId uint64) error
+	// Get the transactions that touched an asset.
+	// (GET /v2/assets/{asset-id}/transactions)
+	GetAssetTransactions(ctx echo.Context, assetId uint64, params GetAssetTransactionsParams) error
 	// Get the block for the given round.
 	// (GET /v2/blocks/{round})
 	GetBlock(ctx echo.Context, round uint64, params GetBlockParams) error
@@ -141,11 +165,52 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountInformation(ctx, address, params)
 	return err
 }
 
+// AccountApplicationsInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountApplicationsInformation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountApplicationsInformationParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountApplicationsInformation(ctx, address, params)
+	return err
+}
+
 // AccountApplicationInformation converts echo context to params.
 func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context) error {
 	var err error
@@ -176,6 +241,13 @@ func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountApplicationInformation(ctx, address, applicationId, params)
 	return err
@@ -211,11 +283,175 @@ func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountAssetInformation(ctx, address, assetId, params)
 	return err
 }
 
+// AccountCreatedApplicationsInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountCreatedApplicationsInformation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountCreatedApplicationsInformationParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountCreatedApplicationsInformation(ctx, address, params)
+	return err
+}
+
+// AccountCreatedAssetsInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountCreatedAssetsInformation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountCreatedAssetsInformationParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AccountCreatedAssetsInformation(ctx, address, params)
+	return err
+}
+
+// GetAccountProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountProofParams
+	// ------------- Optional query parameter "asset-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "application-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountProof(ctx, address, params)
+	return err
+}
+
+// GetAccountTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountTransactions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountTransactionsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountTransactions(ctx, address, params)
+	return err
+}
+
 // GetApplicationByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 	var err error
@@ -229,8 +465,17 @@ func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationByIDParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetApplicationByID(ctx, applicationId)
+	err = w.Handler.GetApplicationByID(ctx, applicationId, params)
 	return err
 }
 
@@ -256,11 +501,45 @@ func (w *ServerInterfaceWrapper) GetApplicationBoxByName(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetApplicationBoxByName(ctx, applicationId, params)
 	return err
 }
 
+// GetApplicationBoxProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationBoxProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationBoxProofParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationBoxProof(ctx, applicationId, params)
+	return err
+}
+
 // GetApplicationBoxes converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApplicationBoxes(ctx echo.Context) error {
 	var err error
@@ -283,11 +562,94 @@ func (w *ServerInterfaceWrapper) GetApplicationBoxes(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
 	}
 
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", ctx.QueryParams(), &params.Prefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "values" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "values", ctx.QueryParams(), &params.Values)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter values: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetApplicationBoxes(ctx, applicationId, params)
 	return err
 }
 
+// GetApplicationTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationTransactions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationTransactionsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationTransactions(ctx, applicationId, params)
+	return err
+}
+
 // GetAssetByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 	var err error
@@ -306,6 +668,61 @@ func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 	return err
 }
 
+// GetAssetTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetTransactions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "asset-id", runtime.ParamLocationPath, ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetTransactionsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetTransactions(ctx, assetId, params)
+	return err
+}
+
 // GetBlock converts echo context to params.
 func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 	var err error
@@ -744,12 +1161,20 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/v2/accounts/:address", wrapper.AccountInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/applications", wrapper.AccountApplicationsInformation, m...)
 	router.GET(baseURL+"/v2/accounts/:address/applications/:application-id", wrapper.AccountApplicationInformation, m...)
 	router.GET(baseURL+"/v2/accounts/:address/assets/:asset-id", wrapper.AccountAssetInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/created-applications", wrapper.AccountCreatedApplicationsInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/created-assets", wrapper.AccountCreatedAssetsInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/proof", wrapper.GetAccountProof, m...)
+	router.GET(baseURL+"/v2/accounts/:address/transactions", wrapper.GetAccountTransactions, m...)
 	router.GET(baseURL+"/v2/applications/:application-id", wrapper.GetApplicationByID, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/box", wrapper.GetApplicationBoxByName, m...)
+	router.GET(baseURL+"/v2/applications/:application-id/box/proof", wrapper.GetApplicationBoxProof, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/boxes", wrapper.GetApplicationBoxes, m...)
+	router.GET(baseURL+"/v2/applications/:application-id/transactions", wrapper.GetApplicationTransactions, m...)
 	router.GET(baseURL+"/v2/assets/:asset-id", wrapper.GetAssetByID, m...)
+	router.GET(baseURL+"/v2/assets/:asset-id/transactions", wrapper.GetAssetTransactions, m...)
 	router.GET(baseURL+"/v2/blocks/:round", wrapper.GetBlock, m...)
 	router.GET(baseURL+"/v2/blocks/:round/hash", wrapper.GetBlockHash, m...)
 	router.GET(baseURL+"/v2/blocks/:round/header", wrapper.GetBlockHeader, m...)
@@ -777,309 +1202,372 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9/XfbtrIo+q9g6d618nFFOUnTnt281XWfm4/Wt0maFbvd55wmr4XIkYRtCuAGQFlq",
-	"Xv73uzAASJAEJcqWnaT1T4lFEhgMBjOD+fwwSsWyEBy4VqMnH0YFlXQJGiT+RdNUlFwnLDN/ZaBSyQrN",
-	"BB898c+I0pLx+Wg8YubXgurFaDzidAn1O+b78UjCv0smIRs90bKE8UilC1hSM7DeFObtaqR1MheJG+LY",
-	"DnHybPRxywOaZRKU6kL5M883hPE0LzMgWlKuaGoeKXLB9ILoBVPEfUwYJ4IDETOiF42XyYxBnqmJX+S/",
-	"S5CbYJVu8v4lfaxBTKTIoQvnU7GcMg4eKqiAqjaEaEEymOFLC6qJmcHA6l/UgiigMl2QmZA7QLVAhPAC",
-	"L5ejJ7+NFPAMJO5WCmyF/51JgD8h0VTOQY/ej2OLm2mQiWbLyNJOHPYlqDLXiuC7uMY5WwEn5qsJeVUq",
-	"TaZAKCdvXzwlX3311bdmIUuqNWSOyHpXVc8ersl+PnoyyqgG/7hLazSfC0l5llTvv33xFOc/dQsc+hZV",
-	"CuKH5dg8ISfP+hbgP4yQEOMa5rgPDeo3X0QORf3zFGZCwsA9sS8fdFPC+T/prqRUp4tCMK4j+0LwKbGP",
-	"ozws+HwbD6sAaLxfGExJM+hvD5Jv3394OH744OP/+O04+W/359dffRy4/KfVuDswEH0xLaUEnm6SuQSK",
-	"p2VBeRcfbx09qIUo84ws6Ao3ny6R1btvifnWss4VzUtDJyyV4jifC0WoI6MMZrTMNfETk5Lnhk2Z0Ry1",
-	"E6ZIIcWKZZCNDfe9WLB0QVKq7BD4HrlgeW5osFSQ9dFafHVbDtPHECUGrkvhAxf0+SKjXtcOTMAauUGS",
-	"5kJBosUO8eQlDuUZCQVKLavUfsKKnC2A4OTmgRW2iDtuaDrPN0TjvmaEKkKJF01jwmZkI0pygZuTs3P8",
-	"3q3GYG1JDNJwcxpy1BzePvR1kBFB3lSIHChH5Plz10UZn7F5KUGRiwXohZN5ElQhuAIipv+CVJtt/z+n",
-	"P78mQpJXoBSdwxuanhPgqcggm5CTGeFCB6ThaAlxaL7sW4eDKybk/6WEoYmlmhc0PY9L9JwtWWRVr+ia",
-	"Lcsl4eVyCtJsqRchWhAJupS8DyA74g5SXNJ1d9IzWfIU97+etqHLGWpjqsjpBhG2pOvvHowdOIrQPCcF",
-	"8IzxOdFr3qvHmbl3g5dIUfJsgJqjzZ4GglUVkLIZg4xUo2yBxE2zCx7G94OnVr4CcPwgveBUs+wAh8M6",
-	"QjPmdJsnpKBzCEhmQn5xzA2fanEOvCJ0Mt3go0LCiolSVR/1wIhTb9fAudCQFBJmLEJjpw4dhsHYdxwH",
-	"XjodKBVcU8YhM8wZgRYaLLPqhSmYcPt9pyvFp1TBN4/7ZHz9dODuz0R717fu+KDdxpcSeyQjotM8dQc2",
-	"rlk1vh9wPwznVmye2J87G8nmZ0bazFiOkuhfZv88GkqFTKCBCC+bFJtzqksJT97x++YvkpBTTXlGZWZ+",
-	"WdqfXpW5Zqdsbn7K7U8vxZylp2zeg8wK1uiFCz9b2n/MeHF2rNfRe8VLIc7LIlxQ2ri4Tjfk5FnfJtsx",
-	"9yXM4+q2G148ztb+MrLvF3pdbWQPkL24K6h58Rw2Egy0NJ3hP+sZ0hOdyT/NP0WRm691MYuh1tCxE8lo",
-	"PnBmheOiyFlKDRLfusfmqWECYC8StH7jCAXqkw8BiIUUBUjN7KC0KJJcpDRPlKYaR/qfEmajJ6P/cVTb",
-	"X47s5+oomPyl+eoUPzIqq1WDEloUe4zxxqg+aguzMAwaHyGbsGwPlSbG7SYaUmKGBeewolxP6itLgx9U",
-	"B/g3N1ONb6vtWHy3rmC9CCf2xSkoqwHbF+8oEqCeIFoJohUV0nkuptUPd4+LosYgPj8uCosP1B6BoWIG",
-	"a6a0uofLp/VJCuc5eTYhP4RjoyoueL4xwsGqGkY2zJzUclKssi25NdQj3lEEt1PIidkajwaj5h+C4vBa",
-	"sRC50Xp20op5+Uf3bkhm5vdBH38ZJBbitp+48KLlMGfvOPhLcLm526KcLuE4c8+EHLe/vRzZmFG2EIw6",
-	"qbF4aOLBX5iGpdpJCQFEATW57aFS0s3IKYkJKntdMvlFgaWQgs4ZR2jH5vrEyZKe2/0QiHdDCKCqe5Gl",
-	"JatBViZUp3M61E86dpYvgFpjG+s1UaOp5kxpvFfjy2QBOSrOlHuCDknlUpQxYMO3LKKC+ULSwtKye2LV",
-	"LsbxPm9fsrBeUfAOlIlRmAN2H2w0QnVptryTdUYhQa7RguH7XKTnP1K1OMAJn/qxurSP05AF0AwkWVC1",
-	"iBycFm3Xow2hb/Mi0iyZBlNN6iXi3wdbJI62Y5kZ1TRYpoM9rs0GMPYgwj4bgorvowh4KebqAMvPxT68",
-	"uyie0jw3U3d5dmuVOPAgTpbnxLxMYMnQY+BuztbFYC+g5DlNF0YvIinN83FtKxNFksMKciIkYZyDHBO9",
-	"oLrmfjiyv9ghI1FguL0GEqzG2dnQxigrY4wEsqQogpfmOlfkzW8qEaLoElpqIKoEokQzSnDTOnnmVwcr",
-	"4MiUq6ER/GqNaK4KB5+Yud0jnJkLuzhrAtXef1nhr2KYDaDN27VCwesphMys0V6b35gkqZB2CKviuMnN",
-	"f4DK+mN7PO8WEhI3hKQrkIrmZnWtRd2ryPdQJ/e6zux4lIKMmKl+xv/QnJjHRo0zlFRTD0NtTAT+5Mxq",
-	"JgZVdibzAhqcBVlaWy4paHq+F5RP68nj7GXQyXtuzcduC90iqh06W7NMHWqbcLC+vWqeEGu88+yoo4xt",
-	"ZTrBXEMQcCYKYtlHCwTLKXA0ixCxPrhc/16so9xerDsyXazhIDthxhnM7L8X62cOMiF3Yx7HHiTOxJpw",
-	"ugSF4p2HjNPMUjsmj6dCXk6dagkYTmp3K6Fm1ECbHLeQhK+WReLOZsRlY19oDVRHuGzXgtrDxzDWwMKp",
-	"pteABWVGPQQWmgMdGgtiWbAcDkD6i6gWO6UKvnpETn88/vrho98fff2NIclCirmkSzLdaFDkrrNLEqU3",
-	"OdyLXg9Ru4iP/s1j76RrjhsbR4lSprCkRXco6/yz13/7GjHvdbHWRDOuugJwEEcEI9os2on1axvQnsG0",
-	"nJ+C1uaq/0aK2cG5YWeGGHT40ptCGsVCNR2lTls6yswrR7DWkh4V+CbwzAZamHUwZS7By+lBiKpv47N6",
-	"low4jGaw81Dsu031NJtwq+RGloew74CUQkZFcCGFFqnIE6PnMRGx0LxxbxD3ht+uov27hZZcUEXM3Oi+",
-	"LXnWY4jRaz5cftmhz9a8xs1WCWbXG1mdm3fIvjSRX99CCpCJXnOC1NmwD82kWBJKMvwQdY0fQFv9iy3h",
-	"VNNl8fNsdhhzr8CBIoYstgRlZiL2DaP9KEgFt9GMO2xWbtQh6GkjxrvZdD8ADiOnG56ir/AQx7bfnLdk",
-	"HAMX1IangW3PwJhDNm+Q5dVteH3osFPdURFwDDpe4mN0VjyDXNMXQp7V6usPUpTFwdlze86hy6FuMc4d",
-	"kplvvR2c8XnejKCdG9gnsTV+kgU9rYwIdg0IPVLkSzZf6OC++EaKa5CJ0VligOIDay3LzTddm9lrkRlm",
-	"okt1AFWyHqzmcIZuQ75Gp6LUhBIuMsDNL1VcyeyJucRgL4xR06HeivYJpsgUDHWltDSrLQuCEVgdeVF/",
-	"mNDUntAEUaN64k+qwCH7lp3OxvPlEmi2IVMATsTUBXm48BNcJMXwMe3VNKfiRvhFA65CihSUgixxtvid",
-	"oPn3rOjQW/CEgCPA1SxECTKj8srAnq92wnkOmwSDHRW5+9Ov6t4ngFcLTfMdiMV3Yuht29O6UA+bfhvB",
-	"tScPyc5a6izVGvXWMIgcNPShcC+c9O5fG6LOLl4dLSuQGFNzrRTvJ7kaAVWgXjO9XxXasugJ4XfXdKPh",
-	"mQ3jlAuvWMUGy6nSyS62bF5q2BLMCgJOGOPEOHCP4vWSKm3jwBjP0KZpxQnOY5UwM0U/wL3XEDPyr/4G",
-	"0h07NXKQq1JV1xFVFoWQGrLYGtAl3TvXa1hXc4lZMHZ159GClAp2jdyHpWB8hyx3A8Y/qK4c0M6l3V0c",
-	"BhUYOb+JorIBRI2IbYCc+rcC7IZhzD2AMFUj2hIOUy3KqWKnxyOlRVEYbqGTklff9aHp1L59rH+p3+0S",
-	"l3VyWLmdCVDoQHHvO8gvLGZtAPuCKuLg8DEGaM6xAWtdmM1hTBTjKSTbKB+veOat8AjsPKRlMZc0gySD",
-	"nG4i0RH2MbGPtw2AO15fd4WGxEYixze9pmQf+LllaIHjqZjySPAJSc0RNFeBmkDc1ztGzgDHjjEnR0d3",
-	"qqFwrugW+fFw2XarIyOiNFwJbXbc0QOC7Dj6EIB78FANfXlU4MdJffdsT/FfoNwElR6x/yQbUH1LqMff",
-	"awE9tmCX5BWclxZ7b3HgKNvsZWM7+Ejfke0xTL+hUrOUFXjX+Qk2B7/6tSeIOs5JBpqyHDISPLDXwCL8",
-	"ntgY2vaYl7sKDrK9dcHvGN8iy/FxSk3gz2GDd+43NjkjMHUc4i4bGdXIJ8oJAupDvo0KHr4Ca5rqfGMU",
-	"Nb2ADbkACUSVUxvC0PWnaFEk4QBR/8yWGZ13Nuob3eouPsWhguXFgu3snWA7fGeti0EDHe4uUAiRD7CQ",
-	"dZARhWBQ7AgphNl15vK/fAaQp6QGkI5po2u+Ev93VAPNuALyX6IkKeV45So1VDqNkKgooAJpZjAqWDWn",
-	"i86sMQQ5LMHeJPHJ/fvthd+/7/acKTKDC580aV5so+P+fbTjvBFKNw7XAeyh5ridRMQHOq6M4HO3kDZP",
-	"2R3y5UYespNvWoNX3i5zppRyhGuWf2UG0DqZ6yFrD2lkWLgbjjvIl9OMD+qsG/f9lC3LnOpDeK1gRfNE",
-	"rEBKlsFOTu4mZoI/X9H85+ozTAiF1NBoCkmKaYwDx4Iz843NfDTjMM7MAbZZD0MBghP71an9aMcVsw7V",
-	"ZcslZIxqyDekkJCCTfgzmqOqljohNhUgXVA+xwuDFOXcRffacZDhl8qaZmTJO0NElSq95gkauWMCwIWp",
-	"+ZxPo04BNVe6toXcXmAuaDWfS/MdIpmDPWh7DKJOsvGo98ZrkLqqb7wWOc3E1QHCoKHvBfipJx7oSkHU",
-	"Gd2ni69wW8xhMpt7PSb7eugYlN2Jg5Dn+mFf1LO5buebAyg9diAioZCgUESFZipln4pZmKTuQwU3SsOy",
-	"a8m3n/7ec/ze9t4XBc8Zh2QpOGyidVkYh1f4MHqcUEz2fIwKS9+37TtIA/4WWM15hlDjVfGLu90+oW2P",
-	"lXoh5KFconbAwer9AA/kTne7m/KyflKa5xHXokthbTMANa6CdZkkVCmRMtTZTjI1dlHB1hvp8l2b6H9T",
-	"JeYc4Oy1x2350MLqCGgjhrwglKQ5Qwuy4ErLMtXvOEUbVbDUSBCXv4z3Wy2f+lfiZtKIFdMN9Y5TDOCr",
-	"LFfRgI0ZRMw0LwC88VKV8zko3brrzADecfcW46TkTONcS3NcEnteCpAYSTWxby7phswMTWhB/gQpyLTU",
-	"Te0fM7SVZnnuHHpmGiJm7zjVJAeqNHnF+Nkah/NOf39kOegLIc8rLMSl+xw4KKaSeLDZD/YpJja45S9c",
-	"kgOGu9vHPui0LhkxMstsVIn5/+7+7ye/HSf/TZM/HyTf/q+j9x8ef7x3v/Pjo4/ffff/N3/66uN39/73",
-	"/4ztlIc9lj/sID955m7GJ8/w+hOE6rdhvzH7/5LxJEpkYTRHi7bIXayV4QjoXtM4phfwjus1N4S0ojnL",
-	"DG+5DDm0JUznLNrT0aKaxka0jGF+rXteKq7AZUiEybRY46W1qG58ZjxTH52SLvkez8us5HYrvfZtE1F9",
-	"fJmYjatqDLZQ2xOCqfoL6oM83Z+Pvv5mNK5T7Kvno/HIPX0foWSWrWOFFDJYx+6KYZLEHUUKulGg49wD",
-	"YY+G0tnYjnDYJSynINWCFTfPKZRm0ziH8zlbzua05ifcBvib84Muzo3znIjZzcOtJUAGhV7ECjg1FDV8",
-	"q95NgFbYSSHFCviYsAlM2jafzNwXXVBfDnTmA1OlEENuQ9U5sITmqSLAeriQQYaVGP200huc8FcHvw65",
-	"gWNwteeMRfTe+eH5GTlyDFPdsTU97NBBFYbIVdpljzYCkgw3C3PK3vF3/BnM0Pog+JN3PKOaHk2pYqk6",
-	"KhXI72lOeQqTuSBPfELqM6rpO97RtHorSwZZ46QopzlLyXl4IanJ01YL647w7t1vNJ+Ld+/ed2IzutcH",
-	"N1WUv9gJEqMIi1InrtZRIuGCypjvS1W1bnBkW8xs26xWyRalNZD6Wkpu/DjPo0Wh2jUvussvitwsPyBD",
-	"5So6mC0jSosqH80oKC6n2ezva+EEg6QX3q5SKlDkjyUtfmNcvyfJu/LBg68ws68uAvGHE/mGJjcFDLau",
-	"9NbkaBtVcOH2Womx6klB5zEX27t3v2mgBe4+6stLtHHkOcHPGlmHPsEAh6oXUOV4926AhWPv7Ghc3Kn9",
-	"yte1jC8BH+EWNjPQr7RfQQGBS2/XjiIEtNSLxJzt6KqUIXG/M1W5u7lRsnw0hmJzvK26yoBTIOkC0nNX",
-	"sg2Whd6MG5/7gB+naHrWwZQt5mczDLGcFDoopkDKIqNOFad8067ro2xGBQ76Fs5hcybqalT7FPJp1pVR",
-	"fQcVKTXQLg2xhsfWjdHefBdV5hNNXXkWTN70ZPGkogv/Tf9BtirvAQ5xjCgadU/6EEFlBBGW+HtQcImF",
-	"mvGuRPqx5TGeAtdsBQnkbM6msTrE/+z6wzyshipd6UUXhVwNqAibEXOVn1rB6q73kvI5GPFsRKpQNLdl",
-	"ZaNBG3gfWgCVegpUb7Xz87Aih4cOr5QXmHmNFr6xWQKszX4zjRY7DhfmVoGGIvuOi16e9MefWcAhuyQ8",
-	"/vP6pjDpves61EVKLnqpXGG3uta60LyQzhAu+3wJWLNVXJh9MVAIV27UVrUJ5Eup6Bx67i6h925gQZCG",
-	"xw8H2aWRRHUQMWurGh1NIAqyfTkxa46eYTBPzCHGa2YrINPPZB3EzmeEVcQdwqY5KrBV5KrdeyobXlRb",
-	"FrkPtDhrAclrVdCD0cRIeBwXVPnjiAVjPZcdpJ1dY92bbbX5ToJYwqAqbFV5z0vDNgft3PtdhT5fls/X",
-	"4gsv/QPq6pm7F6YvxLZDcFRNM8hhbhduX/aEUleMqjfIwPHzbIa8JYmFJQYG6kABcHOAubncJ8T6Rsjg",
-	"EWJkHICNgQ84MHktwrPJ5/sAyV3FK+rHRhER/A3xxD4bqG+UUVEY4cp6/I2p5wCuFEWtWbQiqnEYwviY",
-	"GDa3orlhc+4uXg/SKRGHF4pWQTgXenOv76KxxTVlRf5ea7JKwmVWE2qzHui4qr0F4qlYJzZDOXoXma6n",
-	"ht6juQuYLx07mLYY3x1FpmKN4VwoWmys/A5Y+uHwYAS2lzVTSK/4XZ+eZYHZNu12PTdGhQpJxhlaK3Lp",
-	"U/SGTN2jW/aRy92gvt6lAGiZoepmFc4ssdN80FRPusK8lmrjum6sTwuLHf++IxTdpR78de1jzYp4P9aV",
-	"D/urq/kTdSOlALuWpauUaLQfF7bs4j4VGtvk0ABiC1bftPXAKFqbsV5NvAZYi7ESw3y7Tsku2hTkgJfg",
-	"pKGaJuexSAFzlweU46f+s8BYh7tH+eZeEEAoYc6Uhtpp5OOCPoU5nmL9aCFm/avThZyZ9b0VohL+1m2O",
-	"HzaWeeMrwAj8GZNKJ+hxiy7BvPRCoRHphXk1roE2QxRttwWWxTkuTnsOmyRjeRmnVzfvT8/MtK8rQaPK",
-	"KUoxxm2A1hS7g0QDl7dMbWPbty74pV3wS3qw9Q47DeZVM7E05NKc4ws5Fy0Gto0dRAgwRhzdXetF6RYG",
-	"GSScd7ljoI0GMS2Tbd6GzmHK/Ng7o9R82nuf5LcjRdcSlAGMZwiK+RwyX97M+8N4UEQuF3wetLEqim01",
-	"8ybElq7DynNbita5MHzoC8IP1P2E8QzWcejDWwFCXmfWYcE9nGQO3JYriZuFoqgJQ/zxjcBWd8O+0HYC",
-	"QDQI+qzlzK6jk+0uVduJG5ADzdydRIFf3/Zj2d0Qh7pxX/h0o/Tr9iOEAyJNMR10dumWIehhwLQoWLZu",
-	"OZ7sqL1GMLqXdblH20LW4gbbgYFmEHSU4Bq1xF2otTOwH+Gd98jcymzstQssNvRNU5eAn5USPRiNyOZu",
-	"4frqrjZw7T/9eqqFpHNwXqjEgnSlIXA5+6AhKAuviGY2nCRjsxmE3hd1Gc9BA7iOjT0bQLoRIou7aErG",
-	"9TePY2S0g3pqGHejLE4xEVro88mfdb1cXqcPTEmVSAi25hKuqmi6/k+wSX6leWkuGUyqOjzXuZ2awneP",
-	"XV8tf4INjrwz6tUAtmNX0PL0FpAGY5b+6pEKKnjfUY0eB3i9bGzhHjt1HN+lA22N60rRT/y1lGl0bWgu",
-	"5SoHow6SMLAM2Y3TeGyCOT3QRHyblHdtAst26yCBvh9OxZTv4dkVRVUtil20ewY098SLyxl9HI+uFgkQ",
-	"k2ZuxB24flMJ0CieMdLUeoYbgT17opwWhRQrmicuXqJP+EuxcsIfX/fhFTd8k4lT9tnz45dvHPgfx6M0",
-	"ByqTyhLQuyp8r/hiVmX7WGwXJbbatzN0WktRsPlVReYwxuICK3u3jE2drjB1/ExwFF3MxSwe8L6T97lQ",
-	"H7vELSE/UFQRP7XP0wb8NIN86Iqy3DsbPbQ9wem4uGGthaJcIRzgysFCQcxXclB20znd8dNRU9cOnoRz",
-	"/YylKeM3Du4KVyIrcsE/9ODa0wshG8zfZSZGg4euT60ySrbFY0+stm/g2VamJsQqXn/M/zCn8f798Kjd",
-	"vz8mf+TuQQAg/j51v+P94v79qPcwasYyTAKtVJwu4V6VZdG7ETd7AedwMUxAH6+WlWYp+smwolAbBeTR",
-	"feGwdyGZw2fmfskgB/PTZMglPdx0i+4QmCEn6LQvE7EKMl3anqGKCN6OqcYkWENayOxdSwbrjO0eIV4u",
-	"0YGZqJyl8dAOPlWGvXIbTGleJvhyj7XWjFiynthcXrJgLPPakJqpLSCDOaLIVNGyrTXupsId75Kzf5dA",
-	"WGZuNTMGEuVaS9T5ywGO2lFI43YxN7D1U9XDX8UOssXf5G1B24wgW/13zyqfkl9orOvRnhHg4Ywdxr0l",
-	"etvRh6Nmm822aIZgDrvHDOkd7xmdc9b1zBHtBc9UMpPiT4g7QtB/FCmE4R2fDM28fwKPRe61WUrlVK5b",
-	"2tez79ru4Xfjvo2/8l3YL7pqu3YZYRo/1ftt5GUuvSpertkhue8SFkYYNFMDelgLHq8gGBbboPjoI8rt",
-	"ebJVIBoZZvFTGeZyHtnx61PpYO7kv+b0YkpjPWLMXcjAFGxvI05KC+I/9hugqhoHdnYSRHBX7zJbSa4A",
-	"WfsgulVpL3mvsdMOvtHUFxikqPDqMrZhCrkSkWFKfkG5baNuvrP8yn2twLrgzVcXQmIdSBUP6cogZcuo",
-	"Ofbdu9+ytBu+k7E5sx3CSwVBC2o3ELHFJpGKXBvvqnKHQ83JjDwYB33w3W5kbMUUm+aAbzy0b0ypQnFZ",
-	"ucOrT8zygOuFwtcfDXh9UfJMQqYXyiJWCVLdPVHJqwITp6AvADh5gO89/JbcxZBMxVZwz2DRKUGjJw+/",
-	"xYAa+8eDmJR1Hd63sewMebYP1o7TMcak2jEMk3SjxqOvZxLgT+iXDltOk/10yFnCN51A2X2WlpTTOcTz",
-	"M5Y7YLLf4m6iO7+FF269AaC0FBvCdHx+0NTwp56cb8P+LBgkFcsl00sXuKfE0tBT3V/aTuqHw0Zkvl+U",
-	"h8s/xPjXwof/tWxdN3yNocuenC2MUn6NPtoQrWNCbfHPnNWR6b5hKTnxtYWxgVbVN8vixsxllo66JAaq",
-	"z0ghGddo/yj1LPmHuRZLmhr2N+kDN5l+8zjSiKrZq4XvB/iN412CArmKo172kL3XWdy35C4XPFkajpLd",
-	"q2ssBKeyN1A3HpLZFxe6feihmq8ZJeklt7JBbjTg1FciPL5lwCuSYrWevehx75XdOGWWMk4etDQ79Mvb",
-	"l07LWAoZaxhQH3encUjQksEKM+bim2TGvOJeyHzQLlwF+k8b/+RVzkAt82c5ehEIPJrbkuWNFv/rq7ry",
-	"OTpWbSZiywYoZMTa6ex2NxxtuJ/Vre2/tQFj+KwHc4PRhqN0sdITfW/D66tvPkW8UBsku+cNg+PDP4g0",
-	"d3DU4+/fR6Dv3x87NfiPR83Hlr3fvx8vQBw1uZlfayxc5UaM38b28HsRMYD5roVVQJGrjxAxQPYJKfPA",
-	"MMGpG2pMmh3ibl6LOEx+VzzaNH4K3r37DZ94POAfbUR8YmaJG1hnKfQf9maHzCjJZNXzIM6dku/Feijh",
-	"tGSQJ57PAEU9KBlonsOVdDqARt31O+NFAho1o04hF+aSGTYFCu35Xw6ezeLHW7Bdsjz7ta7t1hIkkvJ0",
-	"EY0SnpoPf7c6ekMEW1YZ7TOyoJxDHh3O3m1/93fgyC39X2LoPEvGB77b7kBrl9taXA14E0wPlJ/QoJfp",
-	"3EwQYrVZNqsqy5DPRUZwnrqpRc0cu62cYy00I/nNOOyy1C5uFXPBXcGhGcsxDDPuN8Y3E0l1TwEt7Hfu",
-	"+wuZcbD9uLJmBjs6SELZEgWzossiBzyZK5B0jp8KDq3PsYQajhx0rCCqMI/wTSxYIYguJSdiNguWAVwz",
-	"CflmTAqqlB3kgVkWrHHu0ZOHDx5EzV6InQErtVj0y/y5XsrDI3zFPnFNlmwrgL2A3Q3rx5qi9tnYLuG4",
-	"npL/LkHpGE/FBzZzFb2kRmrbfpJV79MJ+QErHxkibpS6R3OlLyLcLKhZFrmg2RiLG589P35J7Kz2G9tC",
-	"3vaznKO1rkn+UffK8AKjvrJTT+Wc4eNsL+VhVq10UrWfjNUmNG/UDTJZK+YG7XghdibkmTWhVg387SQE",
-	"S2TLJWRBt0t7iUfiMP/RmqYLtE02NKB+Xjm8EatnZ7XnJsg+rLofIcM2cLterLYV65gIvQB5wRRgRj6s",
-	"oFkOsaoN6mzjvjxic3my5NxSymQPZbTqdbQv2j1wVpP1QQVRyFqI39MyZfsx79uX9hS/iuditJrctrz+",
-	"vrieL7FNXjnnQkq54CzFVggxTRpLtw1zUw7oGhH3L6qRO6GRwxVtrVvlAjss9jbb9YzQIa7r8g+emk21",
-	"1GH/1LB2LdfmoJXjbJCNfadr5xBjXIHrZmWIKOSTQkaCmqKJEFUAxZ5khFWZeiycL8yz187+jUUxzhlH",
-	"S5dDm7ufWZdVrhh6pjlhmswFKLeeZjaP+s18M8EqjRms309eijlLT9kcx7BhdGbZNma0O9SxjyB1EZvm",
-	"3afmXVc7v/q5EQ5mJz0uCjdpfx/0qCKp17wXwbG4JR9IEiC3Gj8cbQu5bQ39RnlqCA1WGLUGBcrhDmFU",
-	"vbSbozw3d0tLUfgGsRmV0QK6jEfAeMm4d6HGBUQaFQm4MXhee75TqaTa3h0G8bQzoHlPAgRmKFsf/FWH",
-	"ancOMCjBNfo5+rexbgPewziqF2qNn/IN8YfCUHegTDyleRU6HWnqjVqVU6IyTC5qtfmOMQ7DuBOfMtlA",
-	"1870vepz7MaxryTqq1E4LbM56IRmWay01ff4lOBTnyQGa0jLqglVlR3YrFHepTY3USq4Kpdb5vIvXHG6",
-	"oG9+hBrC3v1+h7HSznSD/8Y6MPXvjAua3jsr10dIZ/sV5u9mGce0XkPTiWLzZDgmUKZcHR311Jcj9Pr7",
-	"g1K6T9f9LLJxW1wu3KMYf3tuBEdYuLcTn25FS1VXF2PBBT73BY+qipBNroSirNNnDKMecPMiW9YC3r8Y",
-	"BXxF855M+NBXYuWr9R/05cOnveUbqHbluTQlW1lQb8kjGyvc8r50XYh98cE2PPhwXgu31q0I7ffd/dTw",
-	"1NkYsZpZ9HroLudEqzd4Xy/aT6u+Egm+Twc+D/uBuCiesSsDDysmSh995WOg/ZXQ/upK8DT6fvSsP5pZ",
-	"8Km9Fr0+ljPXv9Yu093Jf/rVemEJcC03n4HHpbPp7aYyEW3XmqfqV0jV+nBQK8SGVBzSwybWLsXpht5W",
-	"ZllLg5Y67Wc6ZPVsiDrQwcfH8egk20tgxlrujOwosWP3ks0XGiv2/wg0A/lmR0eCugsBHrFCKFZ3IM3N",
-	"YK4E7AKHmwxNNjAEzMKOCt2xfBDqClKNbWfr4DoJsE9/BTOZd/rcdibov05XORmuIcG2LgTdXrM7ZHyn",
-	"cFJQ/Mv26ZwMr7l/XIVQ2wywC6rqci2tnOnBmZuzGaRYFXlroap/LoAHRZDG3i6DsMyCulWsymPCut77",
-	"Wx1rgLbVkdoKT9Bf58rg9OWxn8PmjiINaog2Dq2S+C5TOBgxYF1gvoZ0nyHZRY0xVVEGYsGHBLtSzHVz",
-	"jN6az0HZtUvO5UnSCI66FNuWKeNNzwfNZT7dq+wjpuT01bLq9kzuv388wxbVygXI0arwcHhLJyfdxjkX",
-	"rnAxlhWrfCe+hDEo/5uvIWhnydm56x+AWLGeqgsqM//GQYpCWdnE4kDPqplZncDRDXKItGLAXKg0F0aN",
-	"SPoSypo5E1XA4R1lI0PrAj4I1wykhKxyieRCQaKFT/jYBsc2VNjw10shQfW2P7LA9Za+flvX9sY2cBRL",
-	"XVMX9RoukEhYUgOdDCpw98+5DdlP7XOfhO/bgO20MFX0ursfrU/dYaqDxJDqZ8RJy93J/ZcxNjHOQSbe",
-	"89Qux82bFdmw7mZWplZAhwejMsgNrp2zhZVE7TRpd5WtO0KQJH8OmyN7CfKNfP0OhkBbzcmCHhQcbW3y",
-	"Qc1vKgb3/CDgfdo6coUQedLj7Djp1hBvU/w5S88BawBWIe49PdrJXbSxV97si8XG18wuCuCQ3ZsQcsxt",
-	"UpF3bDfbC7Ym53f0tvnXOGtW2rL+zqg2ecfj2RlYcF9ekZv5YbbzMAWG1V1xKjvIjgrVa94XcnOBxfmb",
-	"XTwnQ2/lXVdzu4t8TVQWiphOcmo9Vk/xoMcMR1gCIajVgY5MSpyni6hcxGJ5L1OmwQwVx1Q4GQKkgQ+p",
-	"FlBB4QaPIiDaFz1yCm3pO1f0TsyIhNqJfNnqf90W7rEbfXvmapYmv5sJCY1m7OZrW+mzSnzBMpr4nynT",
-	"ksrNZWr0dVrId6wnvVjeGY5VRWLVC6mjsbo4zHNxkSCzSqo+F7GrrXlPNYWxb7pWf2dO9RSCuC6qnKK2",
-	"IQuakVRICWn4RTzf00K1FBKSXGCYV8wDPdNG715ikhcnuZgTUaQiA9svJk5BfXOVnFNUmyCIqomiwNIO",
-	"ZgvbbwI6HjilkanWj5SgqjXfo3d+CjZzva7qZBedWF9mT8QyKFfFyWHIvtyFd0vv/zhvnrE10g3I2JGf",
-	"ES1LGBP3RrtHtjv4VAJZMqUsKBUtXbA8x8Rxtg48r1XgQhy1PWrvCYZVrhjG3jSLCFhtuDAyr6qsEPKA",
-	"07DsEdELKcr5IigwXcHpr7yydBficJRfVInhUZhBZqZ4TJZCaXfTtCPVS65Dzu6mgmsp8rxplLIq+txZ",
-	"2l/R9XGa6pdCnE9pen4P77Vc6Gql2djnV7eDA+uZZKu0WFMAJ7ad+e5SvfY9DJVzRDuYQbZY3N6N3QMw",
-	"3+/moLtt7sfdhbXX1WSm8WvMMSdUiyVL42fqy4q2642Ri7GoaM0y21vRVpnA1/Cwh8KqCq5AFtlFM3Aa",
-	"bQ53TBwjcE5mZDfmv6iBt8clM3CMpkdQdpmL06KStFfXawGAkNrUZ11K25Ax1MQqriLmtlQCusjbgA6U",
-	"KhiJdDXYzAgHB0rDlYDqRD9WAN61xoexrS1nIymnYu2f36uLz10K+I/bqbzBPPpCvE5r0pI2yMsXqunh",
-	"CPES11vjoc4w7X06NCqqap47UMIHAPTHSTVgGBQttS8YM8pyyJJY78WTykY1Dm7aLjWr3RKdKcfJU1r6",
-	"1odm7FKCK5xiVXzZ9H8V1JCSqF7vWpJ5BmuweR1/ghS2p+E48L9AblsetowBokhyWEEjfMxVcylR1WQr",
-	"8N+q6mOSARTojWzbyGJxUaEsbxlO3NqTILJmCHajlhSLWLtTZIeZJGrUWfPEHhM19CgZiFYsK2kDf2pf",
-	"laNpBjRHOYKqzh0h8ffIodP8Ykd46wc49t/HVBmPiffD+NDeLCiOum0MaGecZKn6Tj2Ph0mGpYoqBwvO",
-	"llWOWEviNd9QBb3g/QbJLsnX162B+8QEDxD7fA0pajXuvgOZu/H0OClc1ROkdg6Q2VuB+SRibV8AJ1wE",
-	"LSYvqKquKnUNRf+DnRhfYtzdpi/hVK6jGa++swQHI6pVTK33IiErOr28ef6TnMStB7F3vBiNKHDpf1vs",
-	"X5663bUDX8BW3tzsp9H9sUmjk2KOi4/JtPQD5bm4sD0jw3voM/B+UEt93gXk1HJWiWUftTl25T3bpg4W",
-	"xKsv6YYIif+YW+e/S5qz2Qb5jAXff0bUghoSco5XGxHgokDNxNvVq7EHzFtbhJ/KrpsNHTMYbmNGCYA2",
-	"gtw39xFkSc8h3AYMdrD8M9WGcapyipYLI7Jb29nFglu8L9GypFl408dCkc026r50sPn6/6lz4cKpfH23",
-	"Iqep7xDqWhQ1+Qx2AfbEpRew3J4s2eVrngSqzsI10UqfXZ9dwmS6J+uKZSD0tV9pgN3puNrpPHOlZQy0",
-	"/LZ6bGxJMx20lEPvwtComw7QYZ/GXeCHbStvBv/RGq59yxgC/ueC955GtSG8tiftDWC5UYEjAqu1Vk/F",
-	"OpEwU7sCTKy52lznZV27w5tYGU8lUGUjbk5+dhfPukQp4+YibGNCK59mNUoGM8ZrZsl4UerIPQYrlfJN",
-	"gLDQ6I9o7XGh9WkJRplc0fznFUjJsr6NM6fDtnQMW0R4R4f7NmLCqGRqdwCm6jsc5mfWZvTwNSPAbRMq",
-	"G66pNOUZlVn4OuMkBWnkPrmgG3V5j1LlHNjlU6KBNtOsGhB4l5C0LSD5xjmFr+jvqQCkB3T8DHDYYFxw",
-	"xFljTTta9PhnujB8EQ6bJV0nuZhjFmHPgXC1adHDZ6+AgqMZ3Opnw9bt51HsT9g+DZbld4xIC5x1yBTb",
-	"z/3PuJV4jfyFM7315FsbZTut08bd2oPpkcrndfC/JZbueYxl4rriK2E2rlc2faqKpz0INhF6/ENNu3jP",
-	"LmIYhEvjDo3gw9udNSMtYvm+1jKQoMVAbQnvB1WHstPUhWd1TWkdU4NFythlS+9pabP2eS+XesCzvend",
-	"WW9OW4XMmHH26RG3PT86KUSRpENiPm3njsy5CRykTRh76CNwAvSsuwqPUVUvm0bdo0ZTm33b5PU21dnl",
-	"7SrSbZf+PjNRD0dvuiDEDHmZ7dyO1i3M5KmMKeN2jlnTDFYxCUKJhLSUaCa+oJvdbcd6Kkaf/nj89cNH",
-	"vz/6+htiXiAZm4Oqq4632nbVcYGMt+0+NxsJ2Fmejm+Crz5gEef9jz6pqtoUd9Yst1V1SdFO07J97MsR",
-	"ARA5jpF2UZfaKxynDu3/vLYrtsiD71gMBde/Z1LkebzrQ6VXRRwosd0KXCjmBlKAVExpwwibHlCm64ho",
-	"tUDzINb+XdlqMoKn4O3HjgqY7gm5ii2kL6AW+RnmdjuvEYF1kTteZT0929bl7mnWQodKI0bFTIEUonCq",
-	"PZuRGESYQSSDzFpn+ESLeBAjWzFbGy0bI0QXeR4nvbBh9nZu32zmquOc3mxiRL3wh/ISpNnnn+ivW3AZ",
-	"TlKb9j8b/hEpxHAwrlEt9zp4RfR+cLmm/INA6yblR8gDAejJtm3kSQaJYkEhYmm9BOhP8A7ktvrxqnYs",
-	"70wLQUj8BzvAC9Nn6/eqTAYHzieu6PuqQkqwlPd9lNBY/q6MXM96K0ESbJEzmmgNyrIl0VULg3Rr9bTK",
-	"Yu65lXSSnaUQmpibaZ5HkqStHQfPVEg45kogVzS/ea7xgkmljxEfkL3tT40KM2VDJFtUqsvV6XtJB80d",
-	"ZMUebmr+BhOz/wlmj6Jyzg3lnPAdaYbGHexYP/dSweZ6kwsc0wZZPfyGTF2zjUJCylTbuX/hlZMqMRQk",
-	"m7mAVljrHZmou9b5q9BXIOOZj8QhrwP3VuWzdxDWR/QTM5Wekxul8hj1dcgigr8Yjwqb8+4QF1dszHC5",
-	"si9BAbc9y7502w4PXZ4tbWKETqmgu87B0rqB24igrtc2tGbR4P4O7979pqdDSg3FezGYz7HW0UGaMuzV",
-	"kuEaqhxZHLkx3Lwxivm1r+6tre3aU5u7tR8ly3cGrDQqrX8cj+bAQTGFtcR/d71jblaWeghs5YXuUbWw",
-	"XqVcjEVMZK2NyYOpghrqA8qnu88iNa8xqzEtJdMb7BvsDWjs92g9ph+q2h6uNkzlS3OyT4tzqHq315VA",
-	"SuWl6w+C5iiPrIuPGykk8gl5bit8u4Py3Z3pf8BX/3icPfjq4X9M//Hg6wcpPP762wcP6LeP6cNvv3oI",
-	"j/7x9eMH8HD2zbfTR9mjx4+mjx89/ubrb9OvHj+cPv7m2/+4Y/iQAdkC6kv7Pxn9Z3Kcz0Vy/OYkOTPA",
-	"1jihBfsJzN7gXXkmsK+lQWqKJxGWlOWjJ/6n/9efsEkqlvXw/teR6880WmhdqCdHRxcXF5Pwk6M5pv4n",
-	"WpTp4sjPg90GG/rKm5MqRt/G4eCO1tZj3FRHCsf47O3z0zNy/OZkUhPM6MnoweTB5KFrbc1pwUZPRl/h",
-	"T3h6FrjvR1hf80i50vlHVa7Wx3HnWVHYwvrmkaNR99cCaI4FdswfS9CSpf6RBJpt3P/VBZ3PQU4we8P+",
-	"tHp05LWRow+ucsJHA1jUbWjrrAfFtX0gYlFOc5b6GmVMWfuxDbBXYXNZZ1kv1ZhMbf9hH8TLMwxRstUI",
-	"VNiD+yQziLbfn9TMzrdQRr/y6MlvkXJWPvPDd/YNg86CcLT/c/rzayIkcdeiNzQ9r7JefJpTndoVZjmZ",
-	"Lyee7v9dgtzUdOk45nikqvbgwMulYT4ufWap5kWzsmutjcWsRR1k+5kNOQUHoip0UjM8NA0GkNTs27Dk",
-	"B8m37z98/Y+PowGAYNUdBdjo8Q+a539Y8xqsMbK2FXkz7ouJGteFM/CDeifHaMmqngaf1+80C6L/wQWH",
-	"P/q2wQEW3Qea5+ZFwSG2B++xFSESC57VRw8eeAbl1P8AuiN3qIJZBvUAsN6FahRPEpcYqMvI7KO3VW1M",
-	"SQt7GI99/PCmqPw79qWJ4VePD7jQZgXPKy+3PVxn0d/TjEiXv4xLefjFLuWE21hQI5Cs4Pw4Hn39Be/N",
-	"CTc8h+YE3wz6/HYlzS/8nIsL7t80SlO5XFK5QZVIV7yw3ZiGzhU6VZFF2rMdlF/j89H7j71i7ygMejz6",
-	"0KidlF1JKFovS6Ot02452cM5cSybleZ+uHtcFBjzeVo9Py4K2zYc4wiAofSDNVNa3ZuQH8KvG84RC4n1",
-	"jTSSAnwXbd+bu+ErD/pxRoV2oyrBrfz+tPL7uGkkYRlwzWYMFfYYMI1TsBWmTrTSVQVoN0koqJG0b0B0",
-	"VR/bqRaJ6702cAzXhf9wjQUHlEaxM72PXSF3Mupb3PXgrk9NCuCtNKa6q+HNsGZfareSJA2RcY2M+wtX",
-	"+l7R3NBJsNxWS5uTZ7fK4N9KGaxKcs6tdlYUB1APfebGrleOPrgyk4fQGvF6PEhfDG/ewbdB8P3dFse5",
-	"NyHH7Xcux1Zcmc6dmqB571YH/Bx0QFvndJf25+j4k+p9Yd7XPmlYDYXF/D7o4y9c0fsbI6tXszOQ7tbp",
-	"LsE+O/qaY9bXxlb/knqaQ9qthva31tCq4tlX0tHC2NcjV4Yg0NiuZOBrG/CYrjSxZgH1gLNhvRFMyLdH",
-	"eFzH+RsWYwOYXeiyGvvLI3pq7b3Sbta4c7Xsqlg/QHiH/X5z8myXdvUFmYIG90GOSIH43lw3L416Jt7e",
-	"jGdiGG96/ODxzUEQ7sJrockLlOLXzCGvlaXFyWpfFraNIx1NxXoXV+IttlRVqDOHtsGjqkKk4+C5edsG",
-	"gNzFlN9m56x7E/K9e7UuA+JS2ufCMCqfKkbl3H5keJ1BBrnj/3yC49+ZkBeYAKnVGOPYMLMCX2RcP3n4",
-	"6KvH7hVJL2yYWPu96TePnxx/9517rZCMawwZsPeczutKyycLyHPhPnAyojuuefDkP//rvyeTyZ2dbFWs",
-	"v9+8tq12PxfeOo6VPKwIoG+3vvBNit3WXQvknai7EQ//92IdlQJifSuFPpkUMtj/S0ifaZOM3EW0MnY2",
-	"mvEcUBrZY7KPPBo7+YNZHJUwmZDXwvVFK3MqbYEYrKGryLykknINkE08pWIKnrKV7NKcYe0ASRTIFchE",
-	"sapWdSmhqmJSSFhh+H1d5bUBwW5Gj0G6ny2Tf0XXQd78tBLTWrglo9lzSdcEG31ookCPbQm1NfnuO/Jg",
-	"XN9e8twMkFSIiTHXJV2PbtDqVxHb0LpAzxx2hNwd+4tjD7Eg1dpPVWCyvmr83Tn3F6u5W3J3G3sgzrm3",
-	"46d27IR2BNd9bKsFwSp2Gsshq7Io8k1dCNdoeV6FirM4M8NQ48Bn7CPYaZqOXkLb6L09xLdGgCuxkjZB",
-	"7ck2MKFVHX3Ae3nIMzrnFhPy/l7u0sB3JMXSO48EmYFOFy4XuIX6CHuSLh+xnzctGWdLA+WD8bVrNbiL",
-	"3QLIYfPnjNoM/CH9xYI0TXTggYwQ8c/4H5pjUT02s7XdfccPX84QXVOuPHbVcdVevm0PZhfy71OGC9ro",
-	"ILsbyqf15F2FDNFyCP/nLYL3Q3CHOT535Q7s8XKL+CskBfirZEJeizoj3d6g/pKux+uU7Ne9oNeCg/Wx",
-	"G83X0uKtO7VSOwzjsEjxpUjs/aXur3VZFeTIl/DZqof8aF7aoYsMkd5YDuhLFOE/RgsdNaSMWdtkZ52F",
-	"erQhzNm8aBsihJVQJp/yFvNJ+OlneLX5FBzrZlgMHlLPZ5xawA/MdKyCtZPt+MTyqzMee0avnfWM/1I3",
-	"tOtgpNXOX4fCHmW29tnBLhuf0wq6jPomxcStFn+rxd9q8ZcSsZZLXK+QxRJ6dqajwtc77JO3L83LASey",
-	"VQUHS14tqlhviNTuI1PIBZ+rz1Pf30YfcbxE6MRWirTNyzrrn/wNFeSnrrOYdrU9XL1GxXgKRIkloJA0",
-	"mo9r+2Ah/MfNQajZEjIiSiw6GdSQ+MQq/NcPvrq56U9BrlgK5AyWhZBUsnxDfuFVB7Gr8DtFqNvz0OUa",
-	"YQ6MY0hHs65nGhYhvAITFPMtISzOOVxXJlb2DiFKDdLWpG01imQdJh1zuiLDeGmmPsDdJRfzL81m4rE+",
-	"tJXCU5rniK5dkRw48KBUoDy3+wlLpnXdmCmUruQ5TRfV3o7rC1rVPtd38Bi3aj7jyK6Xqq2no8DsswYS",
-	"rCZwCYCEmcC+iCABeyJNgSzLXLMib35T9ZfGfnuRWF9Lm2GrnpNnfnU2AkrM6qHb9Ov7fbjBJ2Zu9whn",
-	"5sIujkpA3l05MFotHCcNoG3nSZ/jFPQLdF0PXTlhJlv1nesA1aIAKuuPLeXfLSQkbghJVyAVxcPaWtS9",
-	"W3vY52EPW7uGAp+JNSwaCHRVXn95UdRIVfqg1yz7uFsvD2ry76mSMx6o5CG7sGft8rr4bqPXWWvGk2dh",
-	"NqioqlZ6BaEHFIOiPROi/9doYJwBVkITM2fsLLkF1BeSdhqrS9UUs3GVDGFuuGL2hLzj94laUN/nwP35",
-	"6OtveuxwZh5X/7VriasHMo/tMEMCJm6Ni5XGUeH3yU3v9n6bOB6xbB1p5sIzWAf9w6qjE8rDO4oUdOPT",
-	"Jjv1jIt4T4PqYhoOuwQjptSCFTdfN19pNo03DvHurlNstXi25if8+8rraYu7G62h+BT10scjLQEyKPRi",
-	"ZxsFfKveTXANFZhyre9ssfsxYROY2FrwdYvSbA5OMFGSA51VvUaFGJIsH/AZQ2ieKgKshwsZoklH6Qd1",
-	"XiTKm3dG1knlVtB55LWV4k+qhOlPpYQlLS2siZZPp5Nh06RxEN5cSKFFKnKbq1AWhZC6Ot1qMsjyAH2K",
-	"XsPw0Ee4V1Lm1ixTOx2YZ/jWAWwATcpWX0zcxJlHU8xNFVvUJYu713MNYWlnoiD2gt8C4ZPytdtLZYyf",
-	"tfxJX3qIhe4lvQM7g1Kq00VZHH3A/2Bx+491YQxs+6WO9JofYaPnow9bU1iQpeZGN5G2Y1jDpNtpGx1N",
-	"RHmJn9fdyV4IGVxufzDf7UxRaSFt3Bb6tmk15rpE2OP13Cb/1pewra6z1oZf3VkbGbFzXqu6T0Gr24p2",
-	"g553vpSTbXQdIeHb4ILPa0G1P3HGeEZosI0tW5OQNSO4Zp/idS/6U7gobz6i4usv+Jy9FpqcLIsclsA1",
-	"ZFfLLiNtDuelx1Zxu59i4ER/NwWtK/NDie8TZytdZKeA3+PeE5QKBD8dlVi7z8jq21jNv6Mkf1p5W0My",
-	"vJXLX45clj7d91YEf/4i+KsvdjXXGMM0UCRfwjncFMP1TXxPgdxRBpwNq2U42OZXxqt3e5XqhZC+s+ut",
-	"FP9CnaJ2JwcHYg2x0OyyxLopD5Ft8VlBP8zOkOcRS0PfQR1XsV4MiyKLlGELvJNMjV1QmTVOuFN8q/h8",
-	"1opPsNe3es+t6eELMz30aDnu1p/nQxSNfRWg1VJk4B2rYjZzTQj6tJ9m22VDnkrTZUHsl5PeOOwztoRT",
-	"8+bPdoqDitga7JZa1ALPIEtBKnimBkRxuFEvK4fQ0dQPwI17Nqsd8LC48oSTS5Ps26DGcYcSSBv5Cttl",
-	"+2YMDhkZrIghwMkByPbog/0XzWmFUJHVnHoC7mzMXbcttruEHbcBIHmDSqhtU+G/EjPywDaZKDlWklkw",
-	"12cfY1m13BhF1dfUlUBzkjYqSFRwdE/Oae/J2XkV6KyuZ03xu4CoT+ghIxha1Xt+uvED8JRyR/JdBGlB",
-	"KOEwp5qtwLv8J7cVHy8tzVy9xS0McExoltnTWG8CrEBuiCqnyug6vJmjdEc1z8seDAPWBUhmRDTNawe8",
-	"vSYc2XKO2+KITu0bVxRaLV5ki0jKZtSil6yuxKSYkVcsleI4n4sqFl5tlIalDSsMpKD79PeepkDekNCN",
-	"WRU8ZxySpeCwiZxUfPoKH8a+xpKYfR+fmYd937bkbRP+FljNeYbI5Kvi9zM5/VcKdGmtVkIhpLndTjc2",
-	"/wLpf8+j5A/Nhqfdk7ThaeDUcg+DgRBfsZ+PfDpC3Vam780PjT9d2Vf3plqUOhMXwSxoA7DhjEMqPqLy",
-	"vWeSR21za2ZPMnW9Vrfr9DYFeIidrepppM99/bC/1f3fNAnbOWdCInE5jSuQqnWRu83E/ktlYg/e9724",
-	"sRmyVLs4WqkOq7u8FhnYcet0XHP0Y53GuMiAKA9ES2WpwiLjKUNeftXvtZI4UlrOF5qUBdEili5Sf5jQ",
-	"1DLZxF6E4hMGtf3tdQmnW9AVEJpLoJm5vAInYmoWXUtSXCRV2F3B55y44M+o0hTAVUiRglKQJb6z2i7Q",
-	"/Hs2VF1vwRMCjgBXsxAlyIzKKwN7vtoJ5zlsErwMK3L3p1/N1frG4bVK43bE2pruEfS20667UA+bfhvB",
-	"tScPyc4mdFuqxRQ5sSxycElyERTuhZPe/WtD1NnFq6MFs8jYNVO8n+RqBFSBes30flVoyyIx8rsL4lP7",
-	"9IwtURPjlAtvgYwNllOlk11s2bwUrkWZFQScMMaJceCeq+lLqvRbly+dYS1lK05wHqtjmyn6ATZS1N4t",
-	"IiP/ah/Gxk6NPOSqVMSN4HOgIIutgcN6y1yvYV3NhbVT/NhVkpW1Be4auQ9LwfgOWUF7OUJ14Pc3w0UW",
-	"h5ZK6kwZXVQ2gKgRsQ2QU/9WgN3Q4d8DCFM1oi3hYLuckHKmQuRAuc1VFUVhuIVOSl5914emU/v2sf6l",
-	"frdLXLYWhpXbmQAVJsA5yC8sZhWachdUEQcHWdJzlyM3d+3CuzCbw5hgmaVkG+Wjcde8FR6BnYe0LOaS",
-	"ZpBkkNOI0eUX+5jYx9sGwB335JmshIZkijVS4pteU7LsNSZVQwscT8WUR4JPSGqOoLk81wTivt4xcgY4",
-	"dow5OTq6Uw2Fc0W3yI+Hy7Zb3WPAMmOYHXf0gCA7jj4E4B48VENfHhX4cVKbD9pT/BcoN0GlR+w/yQZU",
-	"3xLq8fdaQNvwFwqwhqRosfcWB46yzV42toOP9B3ZmKnxi3QLtKOcrjHJrmlqDS6Ak8tcbo8uKNPJTEir",
-	"SCd0pkHuDJ3/J2Xece7Td4WrukJwBCc33TjI5MOmrY6LWBCIExeGRFwlKSPDKHlIloyX2j4RpR7bHhMS",
-	"aLowSntog7UjYdt9V6RJwpzKLMeW7LNKbgppiz7ploBHoCP5iM0bv1n3CyEHda5plo6kTJOSa5YH3fuq",
-	"e/vnZ728tUjcWiRuLRK3Folbi8StReLWInFrkbi1SNxaJG4tErcWib+vReJTlUlKvMbhKzZywZN2MOVt",
-	"LOVfqqp8Jaq8gQStExeUIVsKqhT02y32MARpoDnigOXQH91tg07Pnh+/JEqUMgWSGggZJ0VOzdUA1tr3",
-	"3ydTquCbxz7V0IpOuiTTjeEdRr6aF756RE5/PPYVRxeuMmbz3bvHNl6NKL3J4Z7rPQo8s5qob0IK3CDd",
-	"9SClXiSkLk/SGihmLMfIeEWe49vPYAW5KEDaYoZEyxK6Fp8zoPlTh5sdBp9/msldqO0fZrQ/xg2jl0Pb",
-	"khZezfdrpYpQm3FJngU5mH/MaK7gj740TDvekhajSO3iSvBZUxAyk+9FtmmdELNrR7iBzbNR1x1lnMpN",
-	"pEpUNwWiTRpaGHblCKtry/p48Oq4XaLtktkuCotp67YMfnz0PiqPloWtNqwzlE3UnbXoZBTLMW3XQh1V",
-	"AA4qDIhpEnZPyFv73actA4gQuSNWM/PPJoqx+WbFNPBdc4lwrOdLzSXwiI+eXjz7Y0PYWZkCYVoRX2B3",
-	"t3gZj9aJGWkOPHEMKJmKbJM02NeoIYUypqhSsJzulkQh/8QTVwkf82S7nPo0YuRZsLhtPDkkmnXiGHAP",
-	"d95oGMybK2zhiI49Bxi/bhbdx0ZDEIjjTzGjUov37cv06mk2t4zvlvEFp7GlETDuCpK3mcjkGhmf3MiS",
-	"9/O852tISwNceJLvonUeXXKw1g0nawbTcj43t4Wujw7b6OB4TPBPxArtcodywf0oyA7+1sfYXzVJvT1c",
-	"l7sEeeN3fWXGe7gdlG/QmbEsKN94ly8kii3L3OLQtlE9LKO1NcNjJaZr21+fVfuNN/kFtlsnapu/W7SQ",
-	"C6qI3V/ISMkzl/HUqW295sPrnNihz9a8ZtNba5rY9UZW5+YdIiL8LjdTzRUpQCZ6ze2Bahwm18HAntxP",
-	"Wkv7VmzcnNiwierQw2C71fhrhnAg6SEDvobiI+i5VCfmNTox0WY6YeMZWjT6U1zC5kz2zYMGlnSGb8aX",
-	"1OYW5z+FvCCUpDlD76rgSssy1e84Rf9NsLBJN/bEG6r7ed9T/0rchRjx8Lmh3nGKQUaVVyfKA2cQcWG8",
-	"APAsVpXzOSjDR0MCmgG84+4txknJzS1MzMiSpVIkNrXWnC+ju0zsm0u6ITOsaCLInyAFmRqpH+y6tSUr",
-	"zfLcBbuYaYiYveNUkxyo0uQVMxzYDOfLKVQhZ6AvhDyvsBDv1TMHDoqpJG6Y+cE+xXY4bvneAIjGTPu4",
-	"bmNxs31wPOws64X85BnGqGE15pypsP9iG/Yb840vGU+iRHa2AOLCxdq0Re5iDThHQPeajiO9gHfcSD8t",
-	"CHJ8qi9HDm0PUOcs2tPRoprGRrQcRX6tg65/B+EyJMJkbt0uf6EU0oAOvGcTN97W12/t/Z4ulobIBWwN",
-	"2ieQ7VPXPrHnJXeBaBjJWgVu3BtnDZC3+i++/LKSh79LejQe7DbZHbDLrpoN8hBvfsPHhOaCz21dRXO7",
-	"FLhPjBelxgDw6zTgwYrmiViBlCwDNXClTPDnK5r/XH32cTyCNaSJljSFxFoUhmLtzHxj6RQbDXKmGc0T",
-	"vFUPBQhO7Fen9qMd8jjoNrpcQsaohnxDCgkpZLYQGVOkvs9PbIEGki4on6PolqKcL+xrdpwLkFA1ZjRX",
-	"6PYQ8UIwa57YonRdGI9do+awbi/QdBFpHIMCztzZPUFljZ5UA/egUXK075I+HvUq2gapqzp0ziKnyWYG",
-	"aBENfSDATz3xIWq03hL9LdF/6UQfK6mIqJu1rBUWX+G2XLNZ67oLiN6gleyTVBe+LdH/Vy/R7zmQIpRI",
-	"2riDxHvDUUWYJhdYFmkKxMivEq3zruGeu69jpl1w1F2lTeXa86ULyrirqVPlNSAc5kq8XDKtfXvaazFs",
-	"WmaGFk2DDkhLyfQGby20YL+fg/n/e6P2K5Arf6EpZT56MlpoXTw5OspFSvOFUPpo9HEcPlOth+8r+D/4",
-	"u0gh2crcrz4i2EKyOeNG5l7Q+RxkbUIcPZo8GH38vwEAAP//fOeJ6HDHAQA=",
+	"H4sIAAAAAAAC/+y9fXMbt5Mg/FVQvK2K7SUp23Gyv/ip1D6KnRddbMdlKdnb+zmXgDMgifUQmAUwEpmc",
+	"v/sVugEMZgZDDiVKthP+ZYuDl0aj0d1o9Mufo0yuSimYMHr09M9RSRVdMcMU/EWzTFbCTHhu/8qZzhQv",
+	"DZdi9NR/I9ooLhaj8YjbX0tqlqPxSNAVGz2N+49Hiv13xRXLR0+Nqth4pLMlW1E7sNmUtnUYaT1ZyIkb",
+	"4hSHOHs+er/lA81zxbTuQvmTKDaEi6yockaMokLTzH7S5IqbJTFLronrTLggUjAi58QsG43JnLMi11O/",
+	"yP+umNpEq3ST9y/pfQ3iRMmCdeF8JlczLpiHigWgwoYQI0nO5tBoSQ2xM1hYfUMjiWZUZUsyl2oHqAhE",
+	"DC8T1Wr09J8jzUTOFOxWxvgl/HeuGPuDTQxVC2ZGv45Ti5sbpiaGrxJLO3PYV0xXhdEE2sIaF/ySCWJ7",
+	"TcnLShsyY4QK8ua7Z+Tzzz//yi5kRY1huSOy3lXVs8drwu6jp6OcGuY/d2mNFgupqMgnof2b757B/Odu",
+	"gUNbUa1Z+rCc2i/k7HnfAnzHBAlxYdgC9qFB/bZH4lDUP8/YXCo2cE+w8UE3JZ7/g+5KRk22LCUXJrEv",
+	"BL4S/JzkYVH3bTwsANBoX1pMKTvoPx9Ovvr1z0fjRw/f/49/nk7+t/vzi8/fD1z+szDuDgwkG2aVUkxk",
+	"m8lCMQqnZUlFFx9vHD3opayKnCzpJWw+XQGrd32J7Yus85IWlaUTnil5WiykJtSRUc7mtCoM8ROTShRM",
+	"axjNUTvhmpRKXvKc5WPCBbla8mxJMqpxCGhHrnhRWBqsNMv7aC29ui2H6X2MEgvXtfABC/p4kVGvawcm",
+	"2Bq4wSQrpGYTI3eIJy9xqMhJLFBqWaX3E1bkYskITG4/oLAF3AlL00WxIQb2NSdUE0q8aBoTPicbWZEr",
+	"2JyCv4P+bjUWaytikQab05Cj9vD2oa+DjATyZlIWjApAnj93XZSJOV9UimlytWRm6WSeYrqUQjMiZ//F",
+	"MmO3/X+e//SKSEVeMq3pgr2m2TvCRCZzlk/J2ZwIaSLScLQEOLQ9+9bh4EoJ+f/S0tLESi9Kmr1LS/SC",
+	"r3hiVS/pmq+qFRHVasaU3VIvQowkiplKiT6AcMQdpLii6+6kF6oSGex/PW1Dl7PUxnVZ0A0gbEXXXz8c",
+	"O3A0oUVBSiZyLhbErEWvHmfn3g3eRMlK5APUHGP3NBKsumQZn3OWkzDKFkjcNLvg4WI/eGrlKwKHix3g",
+	"cDEMHMHWCZqxp9t+ISVdsIhkpuRnx9zgq5HvmAiETmYb+FQqdsllpUOnHhhh6u0auJCGTUrF5jxBY+cO",
+	"HZbBYBvHgVdOB8qkMJQLlhMuEGhpGDKrXpiiCbffd7pSfEY1+/LJ6P2urwN3fy7bu751xwftNjSa4JFM",
+	"iE771R3YtGbV6D/gfhjPrfligj93NpIvLqy0mfMCJNF/2f3zaKg0MIEGIrxs0nwhqKkUe/pWPLB/kQk5",
+	"N1TkVOX2lxX+9LIqDD/nC/tTgT+9kAuenfNFDzIDrMkLF3Rb4T92vDQ7NuvkveKFlO+qMl5Q1ri4zjbk",
+	"7HnfJuOY+xLmabjtxhePi7W/jOzbw6zDRvYA2Yu7ktqG79hGMQstzebwz3oO9ETn6g/7T1kWtrcp5ynU",
+	"Wjp2IhnMB86scFqWBc+oReIb99l+tUyA4UWC1i1OQKA+/TMCsVSyZMpwHJSW5aSQGS0m2lADI/2LYvPR",
+	"09H/OKntLyfYXZ9Ek7+wvc6hk1VZUQ2a0LLcY4zXVvXRW5iFZdDwCdgEsj1QmrjATbSkxDVRrGCXVJjp",
+	"aJw6k/UB/qebqcY3ajuI79YVrBfhBBvOmEYNGBt+pkmEegJoJYBWUEgXhZyFH+6dlmWNQfh+WpaID9Ae",
+	"GQfFjK25Nvo+LJ/WJyme5+z5lHwfjw2quLTmpRlzqoaVDXMntZwUC7Ylt4Z6xM80ge20xpr34wQa9FmN",
+	"/cPQoG4TYZuZ1Kh0RrBoAu2MZQinXZAsjZOGEliMYSt9bdJ2dEKVopuRUyUmoBJ0Af1ZMyTVki64gMHG",
+	"VskWZEXfWQZPhYR9tRTJdNCecQUwaG1oc5qJux1MO7fxT+bYpOjFay2aUFJwbeAOlj49Gr4Jv8ENotSa",
+	"mUOQINx1l7KwqvhOSrGNf3BtY95nfx/U+dPgezFu+zmebUUc5vDiDb9EN+57LXbW5WbOBjklp+2+1+Nl",
+	"dpQeLmY/HZp/xcQDvwxjOBFEETUd2c31qXUwo4HGZMkKuM2lecszPNa3J/cinSnxPvS6PgQpkec642U0",
+	"ln5wvYK9L0uvc+TU0OsIwiM9Xpseh1HPDinY2ObtVHoLfK0h14ZRKLTso83hFGiHOdLejWnvGixxJ71d",
+	"i64GiMEtywlgXylaooR3X9BCwgWY3rERwrpQjK2YMBd8xQou2AFOA+xC4hT4KcIhUMxZ47DHmMgit/Q2",
+	"50rvcQb8EsBa5SfpHooUrehhxOJnIMaNHpawkmCZyuxHN6LF6g0tD4OFTgLW+nN8kACqa18BBrCgBCT2",
+	"QxuGbwqZvfuB6uUB6Gzmx+qSGkxDlozmTJEl1csEY2rRQz3aEJKwDYEZkFk01bReIvx9sEXCaDuW6VWX",
+	"Juxpc14EYw8i8NsQVHyTRMALudAHWH4h97knlOUzWhR26p3HHwYedPiLgtjGhK24MfXTAfpYoAWefEuz",
+	"JaiRGS2Kcf1YKMtJwS5ZQaQiXAimxvat2dRiBUb2lm1gz5pZaWoYiVbjHhrhkVWF1yjFyIrCdW9l7dll",
+	"0ewTRLSmK9ayg8H1U1bwjhSZms+e+9WxSyZA2oWhAfywRniviwefktPwCWYWEheHb8Be3a7xF8RQA2jb",
+	"ur68inoKqXL0WjD2N65IJhUOEVR4O7n9D6Oq7ozH816p2MQNoeglU5oWdnWtRd0P5Huok3tbZ3Y8yphK",
+	"vNP9BP+hBbGfrckADIGBejjc/GXkUJej5mdRhTPZBvDiLskKH7OJfWHeC8pn9eRp9jLo5H2L7+duC90i",
+	"wg5drHmuD7VNMFjfXjVPiG4oJh1ldyvTieYagoALWRJkHy0QkFPAaIgQuT64XP9GrpPcXq47Ml2u2UF2",
+	"Qq7xP4OY/Tdy/dxBJtXxChRIDJA43uMqZHfUPtQF43HTqlG7op3OpLqe/tiSqILUDnaE2lGjS8m4fbe2",
+	"Taty4phRwkkHG7QGqn2at6t97eFTGGtg4dzQW8CCNjQC/gZYaA50aCzIVcmLQ9wRl0m13bpEfP6YnP9w",
+	"+sWjx789/uJLS5KlkgtFV2S2MUyTe+4lmmizKdj95GEDdSo9+pdPvFtWc9zUOFpWKmMrWnaHQncvvAJi",
+	"M2LbdbHWRDOsOgA4SAQwK8sR7QQ9GS1oz9msWpwzY6wd/bWS84Oz/84MKeig0etSWU1KN13jnHp4ktsm",
+	"J2xtFD0poSUTOdA8rINrqjVbzQ5CVH0bn9ez5MRhNGc7D8W+21RPs4m3Sm1UdQgjI1NKqqTOUSppZCaL",
+	"iVVsuUzIu9euBXEt/HaV7d8RWnJFNbFzg4WrEnmPWLOeeIMFNg59sRY1brYqS7jexOrcvEP2pYn8+tpV",
+	"MjUxa0GAOhvSdq7kilCSQ0dQrr5nBhVOvmLnhq7Kn+bzw7ylShgobSjTdiaCLQgXRLNMCoxf2aEBuFGH",
+	"oKeNGO9YZfoBcBg534gM7G2HshemlaMVF+CqqjciizQlC2PB8gVTA/AxXBPqQwdO9ZlOgGPR8QOjhVk+",
+	"W7LsnT44H44HTxqiEpOnmPFn3397QU6W0Pokg+afWeBfwNq+FUZtXisp5wfYT7iXTNIS3m7q0tnO6uu5",
+	"+yPSh4JnY+0uuTEsxYO2hYbY2Qo6Y4WfoaDaRNOg13pp100yKsLzuVGVtpxPisyp/DgK1wRQx3K4Httx",
+	"MNxs5gzZjnv4/qgajMHaYqSV4ytuvGhUUhpCCykW9VUCLx0RHuqotc7Cmd2znmODXuFB1fFPnlJZmgCg",
+	"wPQwJu4wzeQaQx/GwV2ACWIUZ+Qd29h1zypeGFjfoF2RouCCTdzEeiA1YC8PriZLro1UmzZx4GYgNjUx",
+	"ckz+YEoCZ4BPGu1EpWI5NYxwsw/I6NEK17k9wYaeeBG8Y9CBgtNwaqY4LfgfLCcvmXoHhrysqEDYQ7c6",
+	"VhI3e9CElnb7rrPSNJATLX6FAMBM1J1xJLgZLajImMbuO3A2EMJeoRLICzcszRrG4TrOCJw0H9zDBKHJ",
+	"i/h4BD5YE8CqVVbQAsalGEhG0N1tStwdjurdEJKRhhZ6P6aCfQgF+8FQvp2U0Y6uPGvzdD1uxuNF4iUA",
+	"vAv3PQxpy6Efoi+89ueHOhXBUQpdUC602UL+01r0gvfkc1YY+p1UF7Wd73slq/Lg6kR7zqFqUFghEmlu",
+	"+3rnNC4WRTPWemFhT67xgyzoWXhtwTUA9KDJvuCLpYkM69dXgbbCmJplF0HZPt3HxVcyt5cQU+kDmKDq",
+	"weqbkaXZ+D5EZ7IyhBIhc+RQlU4bp7aoYBjNGHNXZE5WrWCWujJa2dVWJYFYvS063oRmyDUmgJoeVlWH",
+	"mGErnA4jPwvFaG61PCZqvc0FKsEiKQQamob8qsokw4/gKpXMmNYsDyxmF2i+HSqNZgueAHAAOMxCtCRz",
+	"qm4M7LvLnXC+Y5sJ6Iaa3PvxF33/A8ALXH4HYqFNCr3th8cu1MOm30Zw7cljssMnTaRadwsoC2ZYHwr3",
+	"wknv/rUh6uzizdGCYvaWKd5PcjMCCqDeMr3fFNqq7En24Mz71jJkN0xQIb1BJjWYVWYnu2/GDY2XaLuC",
+	"iBOmODEM3KNbv6AafbAIFzk8/upas4Y+MEU/wL3mSzvyL/gxNXYmhWZCVzqYMXVVllIZlqfWAA+DvXO9",
+	"Yuswl5xHYwdbqZGk0mzXyH1YisZ3yNLOHGD/oCa6d9jhEosDT38r5zdJVDaAqBGxDZBz3yrCbhzw3gMI",
+	"1zWigwtrk3JClP14VD4uJ4rRbElnvOAmYbp4/fj1m6hBwEz8mztJdpb6YJbVrOAZsUhQghl77HJmmFp5",
+	"4X5aGfnq9OKpazkmpeKX4BqhSCXeCXklpgTyDEGGhiUTRFXCab7l45IIZq6kepc0xGgjy9KyQTOpREBI",
+	"3/6fY+tT83PdtntqqKmXmUumwYXGtccv7ApJBnM4LKkmDg7/hA3vWxiz2d0My2UmmouMTbYdabB521bx",
+	"2d7JfapyoWjOJjkraGKbf8bPBD9vGwBIubb/S8MmGIyfpub6iPr75JahJYyXkAavJIEvJLO8xd5xasp3",
+	"vXeMnDMYO8V13QH5LAwFcyW3yI8Hy+71qQUxfyntM52nBwDZiaohAPfgIQx9fVRA50l90W9P8Z9Muwl8",
+	"m2tMsmG6bwn1+HstoOdx3OU5is5LS261REtSHvTy5x18pO/I9rzUv6bK8IyXcIn7kW0OfqdtT5B0nbRc",
+	"mHL76hp9wPttGfcnGEbeHvN6d9xBj5Fd8DuvkYnl+BCAJvDv2AaMCa8xP0lkwznEJT0xKuGYdsgC6rMe",
+	"sLyZToWtaWaKjRWFZsk25IopRnQ1QyfWroOJdVWNB0g6rGyZ0fnnJb3jtjoMnsNQ0fJSvlx42dkO30Xr",
+	"xtNAh7vklFIWA54MO8hIQjDIe5iU0u46dymQfBIcT0kNIB3TLjYeXCcqYjTDCsh/ygoerCxlV4YFZU0q",
+	"UBRsX5iB62hOFwtaY4gVENUQsPPgQXvhDx64PeeazNmVzxv24EEXHQ8egIHqtdSmcbgO8KBoj9tZQnyA",
+	"JdoKPqcUtnnKbqd/N/IgY29rcD8pnCmtHeHa5d+YAbRO5nrI2mMaGRbwYNYDV37R9BDvrBv2/ZyvqoKa",
+	"Q7jxsEtaTOQlU4rnbCcndxNzKb69pMVPoRvkRGOZpdGMTTLI5DVwLHZh+2DyLzsOF9xwn3NhKEDsDHud",
+	"Y6cdd+faE5SvVizn1LBiQ0rFMpajGwLXRIelTgkMS7IlFQu4MChZLZzzKI4DDL/SaHNSlegMkVSqzFpM",
+	"wHqfEgAuUMGxelCn7NWsa/rHC8wVDfOxvCEXBu5B+ykk6TU0HvVe5S1SL+urPCKnmbttgDBo6HsRfuqJ",
+	"B/qWAOrm4B7Qxle8LfYw2c29nbeIeugUlN2Jo1DC+mNfNKG1IxSbAyg9OBBRrFRMg4iK7W8av8p5nKfR",
+	"B4tstGGr7hMFdv2t5/i96b0vugfClRRsk0xNzAV7CR9TvVFM9nQGhaWvb/sO0oC/BVZzniHUeFP84m5v",
+	"RPasUlqqQ4i5DEdKn2JwuHItxhgLhIYdQVdsOGsJAO90PvTQ3MRtzGlvFsS8sQI7e8TeTjPDL7nZHAaJ",
+	"dge3SRnLW4M9NpOXHpNtJYI6qAgXOVtP+02NH0mIRftyMOwa2LlchU0YQCERqlvz76lWeYufrLIly6N4",
+	"8nEjbk8qDD4fW+tfFCjdJCeQlu1ncf2dVIfy18QBB+N4gJvDTmS7Ka97Gm1gYNd/wWVUbAtjPQ50xwHh",
+	"MuNwfzrL9djFaKLLg3N2aaK/zvVwADnYHrf1UB8n64WHKFaUhJKs4PBMJYU2qsrMW0HBXhwtNRFh4g1j",
+	"/U8jz3yT9FtM4qnEDfVWoHdQsCInT/CcJUym37HgzqqrxYKBX2Ujrz9jb4VrxQWpBDcw18qKrgnKrpIp",
+	"CPOYYksbNTu3NGEkek3NKtO8iUPCUG3sQwt6DdhpiJy/FdSQglFtyEtufdntcN4j2YtP91YQsJDmnQsm",
+	"mOZ9Lobf41cIM3fLj13FXGcfAth1t6qTlv+fe//+1CYrp5M/Hk6++teTX/988v7+g86Pj99//fX/bf70",
+	"+fuv7//7v6R2ysPO817Iz547K9XZczBFRIHTbdjv7JHR5sBNElnsat6iLXIPUjc7ArrfNFSbJXsrbByB",
+	"kdZ9loOr3XXIoS1bOmcRT0eLahob0TJM+7XuKYluwGVIgsm0WOOBHMzt4tOJY+1G+lywthWZVwK30t+E",
+	"MW2L93KW83FIDox1Q54SyBy7pD4Czf35+IsvR+OQtbT+PhqP3NdfE5TM83Uqr2/O1im7TRyy/pkmJd1o",
+	"1uNq2uPxGxzI4mFXzBr89JKXd88ptOGzNIfzGTSc/XctzgSGW9vzA34UG/eKKed3D7dRjOWsNMtUPYHG",
+	"pQla1bvJWMu3DX2Gx4RP2bRtf82t7cbFDBSMzuPQgAFsIpwDJLTaXzZgPV7IXh6tLbKMg82d8D98qIsb",
+	"OAVXe87+CBfHMG1wy3ufbzhOCpwwa4V8cJHXo+VmcYaPt+KteM7mYAmU4ulbkVNDT2ZU80yfVJqpb9CD",
+	"fbqQ5KlPuvScGvpWdDSt3kJHUb5I79Lwjm1S5InFK7ojvH37T/vC8vbtrx0HsO5V3k2V5C84wcQqwrIy",
+	"E5d6f6LYFVWpd2gdUq/DyNB766yoZMsKHyvc+MSNn+Z5u7Pf2uWXZWGX38gNB53QjV4bGbKDRAnX7P6+",
+	"kk4wKHrlbZyVZpr8vqLlP7kwv5LJ2+rhw88hz0qd+vZ3J/ItTW5Kdvg8urBwNPFAIO3EJuHXyeUbRkvY",
+	"fdCXV2BvLAoC3Rp3SR/9DEPVC4gS0PVsAMKxd64qWNw59vJlltJLgE+whc3ckzfaryh16LW3a0f6UVqZ",
+	"5cSe7eSqtCVxvzOh+ooLFECXL/uoag+BK1QzYz7YDApisFVpNuNGdzlvKJpRWmeoLYP5XiCCDB4Lbc2Z",
+	"MqdOFadi004zrzHcGwZ9w96xzYWsiyPsk1e+meZc9x1UoNSykRvxhpk7PVk8DXTh+/QfZFR5D3CIU0Sx",
+	"KzMkIIKqBCK2J4jcf6F2vBuRfmp5XGRMGH7JJqzgCz5LlcX7j+7btIfVUqWrBORCHcKA2j5Xc6N9aJi7",
+	"3iv73kUgsEmWUtMCq5wlHajgPrRkVJkZo2brm5uIk6946Gx/cmVPFlrbIVqSre1+cwPWc2uGy52hCNu4",
+	"EIlpv5MrAs7ya8Lju9c3hWnvXdehLlEByEvlgN1wrXWW4JjOLpbhOyRBXCh5ZfdFQ4QsUirms47kS6Xp",
+	"gvXcXeKX9IFJLxuv7zDILo0kqYNY352mqtHRBJIgY+OJXXPyDDP7xR5iuGa2vL79TOis4d5vwdnUIWxW",
+	"gAIb3ONx76lqeDSIxTbQ0qyFKVGrgh6MJkbi47ik2h/HfBxx2UHa2S2mONpWKuYscliOipSFQjBeGrY5",
+	"aOfe7wrG+CoxvjRMfOkfUOYFAxGr9HZIAappzgq2wIVjY08oda74eoMsHD/N58BbJikX4chAHSkAbg5m",
+	"by4PCMF3SjJ4hBQZR2CDExIMTF7J+GyKxT5ACpfrnvqxQUREf7Npb5AqKBYTKF8x4T1v/5nnAC4xYFwG",
+	"oxG24atgjIllc5e0YCKE6deDdGouwIWiVZ/EucHd77tobHkmRpG/15qgx7VWE2uzHui0qr0F4plcTzB9",
+	"UvIuMlvPLL0nA6Rsr+TBxNown2nISWBdK0G0YEDODlj64fBg1ABAfQW7dujXp2chMNum3a7npqhQk3tB",
+	"66zJZUsm8J1T9+iWfeRyL6qscS0AWmaounayM0vsNB801ZOuMK+l2rguY+ZjT1PHv+8IJXepB39d+1iz",
+	"FsYPdc2T/roKrtHdFAHpWpZuUpwFOwMgeq/aLG1yaACxBauv23pgEq2NVi28RlhLsRLCReJRsos2zQoG",
+	"l+BJQzWdvGOb9F2egRw/990iYx3sHhWb+5Ezr2ILrg2rH428j96HMMfXWRJ6V2dKNbfreyOlSeWmiJd5",
+	"5yuAaBjwe5jAi1tyCbbRdxqMSN/ZpmkNtLHZBIv/8jzNcWFaGxma86JK06ub98fndtpXQdDoagZSjAt0",
+	"lpxh1qBUEMGWqTHOZOuCX+CCX9CDrXfYabBN7cTKkktzjk/kXLQY2DZ2kCDAFHF0d60XpVsYZJTVossd",
+	"I2008mmZbntt6Bym3I+902PU59bok/w4UnItvnjCa2exOVU29rJILCkYdSCey3JxVppmdobE+vqGg1jp",
+	"Rjxtc3jrnuwf7fNxK66RQKCVZ33RXb7lvRIdmX3SngUrTr8TRKZYjoEj6eFyvmA6wFVIcAj75c13RFam",
+	"rExrJkXq8ZLTlUxx2XN/x2/NEWnKjyFtFa64MFjoVjORrGNw0TVqe7ivYXq+YjZ3SnoW/DYMOcNW1Y4o",
+	"QTyGtbbyFdUTjQKg40DDW89Ps7xJ9/T4dpi732r2mqnL5vEheaUwqBkJmbjDiBkfweS0YlRX7uYaH4gQ",
+	"bG2b1gMygRy8cTiaJzTfCLri2QSr+UIYpax69gfbENeGMG34Cq4lYfoag8Shzedaa5/hqTVOQEB3JQwv",
+	"CBMQI9EdQGOwRCYLy/hZz/lmIu9JzvCz4GtESwtnzWj+NrrAqdXtg4f0askLViPTWb19KHcasGvglQvC",
+	"5nOoXS/88X7YwV+f5wkcf530Pqn5q675t7eBBPrYr65PW3Ak3iF2Gh/x4n8dlmW5/2H3fYsMMUvFtL06",
+	"JbD7Cz53+gaYFuHm2L3wI6bw6uilp5iT/ULmXB0ACBxtWNGmUWNXOvQfk2gDo9FytjLaGiMJFeWysQth",
+	"Ew6lotQjX9GwxXesnEBb+J87xwqcGkOyUHiJdq2gn9XeZ9IYuYomv45qEVZ/rYPKyvQc9sshZtjichh2",
+	"zbZ5SrScmzGUYLE4s3w+iZhtigoaTJvqik+VQLNlyNQc1hOJECaa32Ja8u5ms0rkBTuctmOx78ZqqTyD",
+	"lJuLPuF1GmRWYDTUd7rZSYuHPcAJyxnN05oZaOvua6BDnH2YyJ1Tbfrf1Y2r6kPJnGIxOhvUs/FTpN/G",
+	"B5zJ6BG6ha3Dn84DzrWDQsM2ObTuoM+oolgS+kIuFiz3lZK8M6eI6lE1Ezzb37eU35oSrIIFRay21L9y",
+	"+RxYXzaH6K1qAvFbPXeuuhlCXm8C1O6CSRZMYCGAtE+DXOzIFQEtIoXgjh152xpNMpr+ouWJXYe54y6F",
+	"7YQNKBjN3YOaZn59221K3Q1xqBv3xeE3qkhut//AgEBT3OjIKN4hix7rIS1Lnq9bXpPb4v4GPhLUg/U8",
+	"FYBdzA22AwPNaPokwdWNP9PExew777ATeLA9sU+KrlA+Rqhb+qaZS1Hp7sbNEPnOuaofGgeu/cdfzo1U",
+	"dMGcC+UEQbrRELCcfdAAHcLaOcZC5Hw+Z7HroL6O21sDuPZxS1Hc6QAi24vH853np4ZxN8rSFJOghT6H",
+	"8oQ1y7WN/SCCSIi25hrGrmRCyx/ZZvKLfTEnJeVK13HezmeyaTneY9cvVz+yDYy886pmAduxK+A28YYB",
+	"Dabc1MInHYXEfqZjjOHbaGML99ip0/QuHWhraFluJ/5aysQrai3lJgej9vC3sAzZjfO0Y709PayJ+DYp",
+	"79oEnu/WQaLHqngqDg7qaVEUsrXuol1boskTLyxnFOJDruvGnpJmbsQduH4dBGgSzxAmiW7NjaiUPVFO",
+	"Sxt8RIuJc/bvE/5KXjrhD819bMAdP8OlKfvi29MXrx349lWkYFRNwjN276qgXfnJrEoxauSOhxEsHOy8",
+	"dNDNIdr8UNw1DhC4giLBLU8Jqyc64qpZaHs8HzAwT0dr7+R9Lk4Fl7glXoWVIVyldtiFzq0IFXpJeeE9",
+	"ZT20PZHVsLg6RmhvrhAPcONIlyhgaXJQdtM53enTUVPXDp4Ec/0ERd/SNw7hSsIBK3KRK/Tg2tN3UjWY",
+	"v0txlYx8uT21yirZiMceq5/zM+4oU1OCitfvi9/taXzwID5qDx6Mye+F+xABCL/P3O9wv3jwoAs0Srs0",
+	"kwAXC0FX7H5IEdC7EXd7ARfsapiAPr1cBc1S9pNhoFAMYfHovnLYu1Lc4TN3v+SsYPan6ZBLerzpiO4Y",
+	"mCEn6LwvpVWIkFzRtc00oIkU7YBgyKZmSQuYvavujp7E3SMkqhV43050wbN0XIKYacteBUYC2sYEGvcY",
+	"Gu2IFe8JLBUVj8ayzYZUI2wBGc2RRKZOFkSscTeT7nhXgv93xQiHp9w5ZwrkWkvU+csBjNpRSNN2MTcw",
+	"9ImGv4kdZIuzpLcFbTOCbHU+fR4cIv1Cgxc3FY0wnj3Cl+MZO4x7S+ixow9HzZiKZcmu9diCXqRJ84Fz",
+	"f/WMznma9syxkBPLFn0/TDTM9WSu5B8s7cUHzo+JjKpuIriOQO+Uab3NUoJHtF9PPPuu7R5+N+7b+Bvf",
+	"hf2i4TfdzEcxWJimT/V+G3mdS69OF0Idj+IjmYYLP5JmXHsPa4HjFUVywgucD52hAs8TphNtpEdJn8qo",
+	"hT7B8etT6WBu72pW0KsZzd6l70IWpmh7G0E+RhLf2W+ADskycXYShR+HthxLEpRM1W8QKR+2a91rcNrB",
+	"N5r6AmM7Nq4uWHuTFlomhqnEFRWGeR985Feut2boP257XUkFlVJ0Oh4pZxlfJc2xb9/+M8+6sSc5X9iZ",
+	"sI4IoXPjymy4gQiWYwEqyrkuC7oJKWAdas7m1l0nnEm/Gzm/5NpG4UKLR762ogZxGR4xQxe7PCbMUkPz",
+	"xwOaLyuRK5abpUbEaknC3ROUvBBVN2PmijFBHkK7R1+Re67u5CW7b7HolKDR00dfQTQI/vEw/bI6p1Vh",
+	"trHsHHi2fxFN0zH6McAYlkm6UdPPo3PF2B+sXzpsOU3YdchZgpZOoOw+Sysq6IKlkwusdsCEfWE3wRe9",
+	"hResTpszbZTcuFKR3fmZoZY/9TiRWPaHYLiilCsXdablytKTZ6T+sPnhsAQv8vQAl/8IwZulj11r2bru",
+	"+BpDV2l6oBBi+wreaGO0jgnF8jgFr/2DHEOckjNffQtKDgeXDcSNncsuHXRJu4U2WkBxYcD+UZn55B/2",
+	"WqxoZphKFwe2Q0xmXz7pgvwN1ezLJ6GKp9gP8DvHu2LgxZpEveohe6+zuL42hZuYrLhl9ffrBIHRqeyN",
+	"Mk1Oa/qCGrcPPVTztaNMesmtapAbjTj1jQhPbBnwhqQY1rMXPe69sjunzEqlyYNWdod+fvPCaRkrqVIl",
+	"Nevj7jQOxYzi7JLlvZtkx7zhXqhi0C7cBPoPG7zjVc5ILfNnOXkRiF40t2V6s1r8Ly/r2oDwsIppdFo2",
+	"QKkS1k5nt7vjULn9rG7t91uMdoJvPZgbjDYYpYuVntBx+Lnu8yH8hdog4Z43DI6PfifK3sFBj3/wAIC2",
+	"dkds+vvj5mdk7w8epCtZJU1u9tcaCze5EUPf1B5+IxMGsG/kGrmwdyhyyf26+9crpOwHywRnbqgxmTX4",
+	"y91rEYdJTpIOlUyfAhsZab94PMAfbUR8YGYJG1iH2Pcf9m/k+rlbnVRpksnD9yhIm5Jv5Hoo4bRkkCee",
+	"uw8xTm9oAjy3pyClFTOVEiyvxTV8DQY1zDPhiwFDHuu9y+QDOnr2Z6CtEOCW85bcSvoO7HReiQ6MHXXG",
+	"rK+rbtTwjh8XPuJN7z4EjcZbsF3xIv+lzpLekmqKimyZjLed2Y6/4YWhoQ8g305hzT5/ClYkh8OL9m/+",
+	"Qp4wGfyXHDrPiouBbVu4csttLa4GvAmmB8pPaNHLTWEniLHaTEAdEhwWC5kTmKeuQVtz6ukosVfP2axa",
+	"nGNiQ/1apZIm47CryjgnWohecKl757yw/+t5xIaWE0VNj9e/goxA83pEDM1EmweOzhShfAVagqa2MDic",
+	"zEtmnRVtVylYqzskI4eRGwELpf0ELSH1oySWHRE5n0fLYMJwxYrNmJRUaxzkoV0WW8Pco6ePHj5M2uAA",
+	"OwNWilj0y/ypXsqjE2iCXxwbxAKXewG7G9b3NUXts7FdwlEbVYk3yKtTPBU+YA4o2xlUiBw6ESZysOFO",
+	"yfeQQ9gScaPch4UmlMZqlqaoykLSfAwlu6ybEMFZsQ8KGZJbol5Y+Fvkn3zrGV6qw+dI7slBO3yc7Ukx",
+	"sQQNBOxpQ1dlKsu/bXHhGxDecgACo2KMnSl5jvZc7a2FOAmBwm9qxXISpnMWBSAO+x9jMDLKyIY61s8r",
+	"64rEfZUyXrsWnp3Vz0hRHp9L/xEYtoUbPQ0YqUTO1JhAfZwrbotwLalhl6xZWMCDEWpiu0IDzeX5OtZc",
+	"TPfQjENp8n3R7oGDcYOHQxKyFuL3jSiSlcrYcJrE83wOvdKBIa26QS0XBJ+m3heOIy/dS0dGhRQ8gwKf",
+	"KbUekqAPezMdUAs1/dipR+6EJg5Xgl5rld9j0a3/115G6BDX9T+IvtpNRerAP40NcIfnvQUz2nE2lo/B",
+	"gsUL5l7nuNDMFZ+3RBTzSakSHlbJqIzgzbEnGUF+4x5z63f22ytnjLdHkLzjAsxuDm0+9g7ez2xGSEvt",
+	"gnBDFpJpt55maJH+p+0zhXoHOVv/On0hFzw75wsYA3367LLRgbU71Kl3Z3Xuo7btM9vWVYQMPzd803DS",
+	"07J0k6Y4gQ473Plkqx72ITjlROW9WiLkhvHj0baQ21Y/dJCnltBsqVCMILRyuEMYTKnUddUWCq2QoqAF",
+	"wdxEKaSkIzpfcOHfc9MCIkuKBNgYOK89/XSmqMmWDTa0y3u1JxoDYuSzd4cYqrXBLnqyzEZ+jv5tvFgL",
+	"V7ezh3GEBrXGT8WG+ENhqTtSJmwsZvALBiWoaZoWeVCicoh0csHOqJalGYdl3BMfv9lA185YwtAdaszu",
+	"K4n6sv3PqnzBjM0kn0oS/Q18JfDVR6zZOrdVKK0eQhWb1b4SOQFwokwKXa22zOUb3HC6nGuqNVvNioQP",
+	"6/PwkeVhhy2l2Wce+2+qrnj/zjgP7r3zW3l37Xy/EnfdfF0prdfS9MRmMh6OCZApN0dHPfX1CL3uf1BK",
+	"97HDH0VocIvLxXuU4m/fKiVVXAKn4yyPoiVUqAHHdAnffergUFuhlS+JItF25nSbl9iyFvC+YRLwS1r0",
+	"5JSLH25QvuJjRl9muaw3ESI1LtG1oWQrC+pNHoyOy62noO57Zp+zMvoqH+4Jxa11K0L7HxJ/bDwbosNa",
+	"zSx6nwuv96JXb/C+T3o/MFqY5TNbdKTHyhOKWMs6lYUrc7uEzliyRCeImhnKC5+RkOMxeN1okvL86pTz",
+	"Rpu6nRdmgtQmDPU5eC8fE11lS0J1I/GTHpOSMUVcgn2pwPmKrNiCAoklLUDRcWtn3thEEOQ8R/ctqvt9",
+	"cdL4rJ1v3GBPSWYVwKoc+zvzpKALBF6PSc71u7GVtNk7piZoVyVQYjfKKjkd7ZGV/mLJWknoHRx2NWNy",
+	"RZUAbFFeTIc+J7m5dlCY3kZiej/iysKAg8RSTOf7JRNrFE9ugohGvDSb24b9ldSGaGvcSe6Evt5WhGzZ",
+	"/qLvUJTalB8v+3KM+kK38L1d3vodc+WISsUuuay8B6iPw/CWIPzV5bBuFM7tYXvJ6KYP/XLa+ywI55hd",
+	"uWW6rfvxF/QEIUwYtfkIXn07m96uypy45EKLSE45y1eHVfbYshrK8JAi0Kl6w+5K6E3kqFE0aKlTv7lD",
+	"Vs+H3AI6+Hg/Hp3le+nJqZrVIxwldexe2NReUPLyB0Zzpl7vKOlZl/GEI1ZKzcMljBR2MJ+TDYabDg14",
+	"sgTM45Kk3bG8I/wly4xUDQdfxdg+BUrtZP6t91jas9+KFuLCXEXPbWU8x6NGjvwf2Wbrymg383iUPb83",
+	"h2Ff0crTEMaBUahWIQspo1p5GwZHj0NuUn7ZTLSdyoAmoizi45AKzUDG0zrxOw+xlFAYb//Hhhqggl4T",
+	"noIeDpy+XBrv2OYzTRrUcPY8Gr8TSHydyluAAXz59ok2+96PnCsM14EyAAs+LAG7s7q6bG/RtKhuwTXn",
+	"8iRpBUddy2DLlJfSsGvOZbvuVTcFwgL7ksG/xuIokbjsNzs8x1uWc9KloXJXbJyz7wztytNXrvIX5OUP",
+	"T6a+BhjT/jdfhANnKfi7OFcxPlDbui2+xUES00Eza6JPAT0PM/M6iKzr29TdY4zHzApp1YhJX1BrM24r",
+	"OD1/ptE7vU4iBnDNmVIsDy+hhdRsYqQPOtsGxzZUaHDBvxYSdG/9cASut3bcm7o43opnSlKoFUed5328",
+	"QKLYilroVFTCrn/Obch+ht99IhBfR3+nYTnQ62Snm6cPH+S6g8SY6ufEScvdCUauY2PmQjA18Q/O7Xp2",
+	"opkVEgrX5FWGAjo+GMEOPzh/1xZWkjTPZt1Vtu4IUaKOd2xzgpcgl7Ij7GAMNGpOCHpUsae1yQe1uusU",
+	"3IuDgPdhc1mWUhaTnjfOs24RvjbFv+PWV4zIqg6zcbaMuKWdhNyDp7XgxHK13Piic2XJBMvvTwk5FRjY",
+	"6P1Z4jKAncnFZ2bb/GuYNa+wLqazpU/finSEGFSsVDfkZn6Y7TwMqzrccCocZPtEZi36PO2uoLoly2Oc",
+	"TofeyrseJu2EuTVRIRQpneQcH6qfwUFPGY4gDUuULwj8FyhxD9xEFzIVT3CdVDF2qDSm4skAIMPEkIwl",
+	"AQo3eBIBznlvR1pS99kn3pRzoljtO3LdDKQuqSeyZt13o2/PHGZp8ru5VCyeEXxTMdtwCL6zDAc8ttSM",
+	"G0XV5jp5QpuoSllPerG80wszOGDWC6mdMLs4LAp5NQFmNQmFYlNXW9tON4WxK2lYF5gFz7cZi9w5qXaK",
+	"2oYsaU4yqRTL4h7pmHOEaiUVm9is0slsLy/43Fi9ewWBpsLmHiayzGTOsOBymoL65qqEtdjnk0CTvShA",
+	"2rErdX0iOh44pZWp+Hw8AVVrZ31Cv/kXtg9mz6gzy+GiJ+jC0BOowLTLJOcwhI278ALhYOqlti2xr+DK",
+	"GuiGqdSRnxOjbFSIawGjN0gIDj6U2uFaIyiBlq54UUDyCr6u+QEL/kpp1PaovWfgTX3JweWumcgEepDS",
+	"yryQ3SXmAedx6jVilgoq59QVPgKc/sqrKnchjkf5WVfgFQnxMXaKJ/jagTdNHKlecu1pei+TwihZFK3i",
+	"RUg3ztL+kq5Ps8y8kPKdTUhyH+61Qpqw0nzsczy0fYLrmbaVYlmLCdCA3p0uHNvZWTwXGMwgWyyuYxTf",
+	"ZWWOwPx1NwfdbXM/7S6sva4mM01fY2xWZSNXPEufqU/LybbXNTbFolKowB548JGI4bDHwir4VAGL7KKZ",
+	"CUuwqf1yjMD5lgC7sf8FDbw9LpkzajpzR4Kyy1ycFjXJenW9FgAAKRcLF6xg/9fQxAJXkQtM1wKeMW1A",
+	"B0oVcEC8GWx2hIMDZdiNgOo4PQcA76HxYYz5LdGB2gbNue/36wSY1wL+/XYqbzCPPs/O85q0nMOIT5bV",
+	"wxHSafa3ukFeQOqN2VBnSO1fsAZK+AiAfvfIBgyDnCT3BcO+9dsC1aZHuIONahzdtF1EZjS6L8wFs5CM",
+	"osC27yOUF5ViLnkTqviq+f5VUrP0otM271qSrVXSRbX+wZSEd+58HL2/sAJrEraMAbKcFOySNbxGkZZ1",
+	"Baomv2S+rw6dSc5YCa+RbRtZyh0yluUtw4lb+6TXwyeN3aQlBRGLO0V2mEmSRp21mOAx0UOPkoXokucV",
+	"beBP76tyNM2A9ignUNW5I0z8PXLoND/jCG/8AKe+f0qV8Zj4dRgf2psFpVG3jQHtdI+udN+pF2nv6Dhd",
+	"Wnhggdny8BCLJF7zDV3SK9FvkOySfH3dGrhPXIoIsd+uWQZajbvvsNzdeHoeKVzmJaB2wViOtwLbJWFt",
+	"XzJBhKyvPWCN9FeVOo+r/wEnhkZcuNv0NR6Vayfmm+8sgcGIbiV07L1IqECn1zfPf5CTuPUg9o6XohHN",
+	"XNTvFvuXp2537YAGsipyIux+Wt1/SS+Zl2KOi4/JrPIDWWsFFomL76HPmX8HlSJ+AsIV+UyI6HwI6EYJ",
+	"1jV18ChMxb7gSwX/CGnIf1e04PMN8BkE33cjekktCbmH11CsjbMCIN2uXo09YA6EXPqpcN186JjRcBs7",
+	"SgS0FeS+wJgkK/qOxdsAzg7IPzNjGaeuZmC5sCK7tZ1dLLjF+zRRK5rHN31IVrtpcAefvtz2/v/qENh4",
+	"Kp9jsixoxvJGmbQmn7HKUCAus2Sr7THSXb7mScC3iohW+aQa+TVMpnuyrlTgUV8JqAbY0TWiWQHqMMsY",
+	"aPlt1fnZEl0+aCmH3oX96n9GQMPTvU/0uQN8TNDs2t4J/pN5pPuWMQT8jwXvoQBbP7zQ5C6w3Ei8k4AV",
+	"rdUzuZ4oNte7HEygtQW+BlgHEysXmWJUo8fN2U/u4lmnSeaC+MiK+k0zjJKzORc1s+SirEziHgPZksUm",
+	"Qlhs9Ae09jyh9WkJVpm8pMVPl0wpnvdtnD0dct4uU+MfOlzfhAkjyNTuAFzXdzgIy67N6HEzK8CxEB66",
+	"a2pDRU5VHjfnAor5UlumlG709V+UwuPArjclGmkzzWQh0esSkDYCUmzco/AN33sCgPSADz8DHmwulsxR",
+	"f/OxBk07Rva8z3Rh+CQebFZ0bd/4IHi4LywE82PDCx80g5CrjArUz4at28+j+R9s+zRQGsQxIiNh1iFT",
+	"bD/3P8FWwjXyZ8HN1pOPNsp2NDf63eLB9EgVi9r5H4mlex7LLD1Z2QzCD5E2LlTF0x6LNpH1vA817eI9",
+	"uwhuEC57Q2wEH15yselpkQrzR8vABCwGeot7f52KDnCtnSmp427WNjUgUsYuScKelja0z3u51AOeC+rD",
+	"s96cNrjM2HH2qVO5PS3CpJTlJBvi84nVg3IEwEPahLE/5ottp47gHqNDPa2YGpuFtfYt1dlb2GvXa1eZ",
+	"bbv095mJejh68wlCzoGXwRFG45hUsTFl3I4xa5rBApMglCiWVQrMxFd0s7v0YU/W+vMfTr949Pi3x198",
+	"SWwDW5mB6bryQat0YO0XyEXb7nO3noCd5Zn0JvikI/A5vD/6oKqwKe6sIbfVdVrjTuHEfezLCQGQOI6J",
+	"knXX2isYp3bt/7i2K7XIg+9YCgW3v2fWTSNdeSboVYkHlNRuRU8o9gZSMqW5NkyY1gsoN7VHtF6CeRDy",
+	"j19iEikpMubtx44KuOlxuUotpM+hFviZ/eSL/RO2LgvHq658RHjvutw9DS10oDSCV4y1YsnSqfZ8TlIQ",
+	"QQSRiiJrneETLOKRj2xgtugtmyJE53meJr24aP92bt8sKG3SnN5uYkK98IfyGqTZ9z7Rn67kOpykNu1/",
+	"NPwjkX/lYFwjLPc2eEXyfrAl5vi04/cQco8MAq2biyNBHgBAT7RtI04yChSLkqErfCWA9wT/gNxWP17W",
+	"D8s7w0IAEt9hB3hx+GzdLkQyOHA+cFbxlwEp0VJ+7aOExvJ3ReR61hsESbRFzmhiDNPIlmRXLYzCrfWz",
+	"EMXccyvpBDsrKQ2RwtpGEkHSaMeBMxUTDheGqUta3D3X+I4rbU4BHyx/0x8aFUfKxkhGVOrrped8QQfN",
+	"XdBbmFq8hsDs/2B2j5Jyzg3lHuE70gyMO7RA9+p5eI1mglzBmLDT5NGXZOYK/pSKZVy3H/evvHISAkOZ",
+	"sq9jMAVbmx2RqLvW+Ys0NyDjuffEIa+i563wZu8grI/oB2YqPSc3SeUp6uuQRQJ/SR61EdmzSumUSnhK",
+	"vEnUWOKRwl7dtVToIjCXYL9VPQH1w7IC6Y3I3KjTPQtbxMf6aim1k6UueRL6PsQWMAu4YCzXN82V1agq",
+	"kcJpXHR9hwi+YcGd62XQinJh7plBq1tOfujyYB0gyCvNuuscrAE1cJtQfuq1DU3/Nrhujy2NNhuStS1d",
+	"Y8d2h7RxBym2s1epnVtIGIc4cmO4eVMU80tfCnFMk91T5qC1H7Yiws6XyrhohQ1iZoJprqEsw2+uJtjd",
+	"6iceAsxm0T2qCOtNUvAgYhJrbUweTRWVoxhQicJ1SySPg0jRrFLcbKAevDdK8t+SOa6+D/lSXL6d8D7p",
+	"9Akj3zHhfWjq7CqV9hrL95IWIOPx2VQwYqQspuRbLJbgDsrXn83+jX3+jyf5w88f/dvsHw+/eJixJ198",
+	"9fAh/eoJffTV54/Y43988eQhezT/8qvZ4/zxk8ezJ4+ffPnFV9nnTx7Nnnz51b99ZvmQBRkB9VVSno7+",
+	"1+S0WMjJ6euzyYUFtsYJLblNSfP+Pdgf5tIuH5CawUlkK8qL0VP/0//vT9g0k6t6eP/ryNXdGy2NKfXT",
+	"k5Orq6tp3OVkAekUJkZW2fLEz9PJJXj6+izEPaBvE+xobZGfjmpSOIVvb749vyCnr8+mNcGMno4eTh9O",
+	"H9nxZckELfno6ehz+AlOzxL2/QRSFZ9oV4XkJMS/vR93vpUl1iixnxyNur8wB17jj5OQ+M7+tmJG8cz/",
+	"pRjNN+7/+ooubHI6iJLBny4fn3it7+RPl6HivQU2+TyLZSyi2gWuLymrWcEznwuOa7TTYyCDjguJuxeM",
+	"SttkaFBr3jtLixxcwTDrgx6NR2ETznKLfOx/VjNAXy4f3u9HT/+ZSBvmI2x8FffYuS9y+/uf5z+9IlIR",
+	"d/18bY1tPrrIh5PVIXRxNJntOfVn4b8rpjY1rSKgo/EIWS8QuahWliG5MKWVXpTNxNm11puyynWQ7We2",
+	"JFZPXCeUqZkgmGAjSGqWbtn0w8lXv/75xT/ejwYAAtmNNDN2+b/TovgdzZhsDR7MLQ+ncZ/v2bhOUAId",
+	"6p0cg8UwfI26122a9SZ+F1Kw3/u2wQGW3AdaFLahFGzQHrwBeo4MDs76sIBj4ZI6CW0YDVkh40yRU/IG",
+	"90MHbLmY4QibUNz0xkiEQ7Udh2AmAeNz7YdJTQpoCfGZsgDH0CVFBEjBNFk6P9MVW0m1AcHkzdl2FzjY",
+	"4QlV2ZLbFyR7A0LzCPkW4th+4NpIZeP54IIJcSQYB+UMw4n9DCUrwm52vBF+HY/8KQfG+/jhQy9t3P04",
+	"QsmJ44bRgINq47wfN0bxZ/kaA3WlEn56E3JGK1oiF3VfMNDdPYBio6kVPk8OuNBmZusbL7c9XGfR39Dc",
+	"l8TDpTz6ZJdyJtBZ2moXqAW9H4+++IT35kwYpgQtCLSMivF3VYSfxTshr4RvaTXgarWiagP6rQlCrF2w",
+	"jS40eB2AbEOmHOUnFIvRr+979ZWTaPW6V3mxIeJV6V/0Q3u4YXugNJGlf+mRLu8ZeBd4V4CgxTTYMG8a",
+	"I86eo2fptE+Lid439B4azUejA7x07mK1f4zP12yk0/r62Df4cm5n3+N0bt21FXALFs1mUwuw2kiJt6MQ",
+	"LzTbNNMS+049gNkhUnAFLNxUqnQ8U/QkSq21PalW7YkSe79z3UO51/bO763RYLEzAQQnjrzGDJ92e7ig",
+	"GMQJ0V0r+g6dfCB+w7N3v0W4Aty1EK7s9tnfe2+xiu+AHEB9tstekd1zsIMkj15jC45vzb3BGS500GeH",
+	"umXx3lnRS1pY+K3+iLyE+B1ekIhL3bKo/vCy9VaF4d5UcEhJefJnIw1nfqN7PzrsNITgblNAz6phLExw",
+	"4H64d1qWNYOC76dl+dqSoQaXVMaBw7A110bfn5Lv494NP5tQoJnPm/Glnsxdot6m2yXcpeQgiX40UXxo",
+	"9eS0qY7xnAljQ/FVDzCNU7AVpt26ys1tBH+763Y35r6lF11Lg3HWj4mrYD5wDGQpH4mWMeBad8Td9TW0",
+	"oJVhwxm7K/HkK1cEadoQm7covD5xE1FSJW0i72g6+luZjkKGexSvtCwPoCL7QOhdTU7+dFnbD6E525GG",
+	"6czx20DUN7Ls32txnPtTctpucz224rLe79SGbbujHvwx6MGw7zs1YEfHR933A+u+cSqJfTI7NJQ2+/ug",
+	"zp+4svs3Rlavdmsh3a3XXkOEdHRWJ7BuTbT8JXVVh7Sjlvq31lJDPZ4b6anRJf36j59uEMzA5WHf/0Hz",
+	"GQ5zfNf8279rRlSZ8A1/XUuJ1JNmRI3N101Ij+kyInvTS073iAiMKPP4vHlt9WL7MR/4ytnDdI4vnH+b",
+	"F85eCjiYSAy57HYKQ2i5TQw6lW2gAIThjqLv7y36ejIpJoRfh/pisbdfjsejWLuxWGsf3q0CbQvjOIqy",
+	"v7go27r3NxViobxxUna9cY8ClLxk6l3BvI+qFC7RgGMtM6oZqMhND6IxwbhkbjQSBoYKeFuQawsyr/W2",
+	"BqzBG4pbH+0vXOP1FjOrIiy8mdEsjjjPqMmWGHe1woUYxRmhuuVq6ytNBXuxNlHfcW1fooUUi5ojWXxr",
+	"v9KCzlgxJWfGx85BBL61GYf2CxmCOklhy7arEwsQrsNaG+iCjeusQjCiXR+EXrE8rFMK+1xhuQXLMWEh",
+	"JUZV2hIKZsx8Wley0kxd+tC9gDEhje8xJT81AzSkcJZtzHyhoGZNwEbD4P0CFvGtMGoDgfuaaGbcCmiM",
+	"fps+aEUxNr2p23zPjK/+7erPfxq6DAT4t2yeaWJHcevtMSkdInoa2Ue/2QFC0fbt5boT5p0Cpu2pdGcv",
+	"E1joPZ1HyZ53+yWwHts2cc5DlbV2fHE3UVro1JcuxJ6+NEuI+U/8SuQPoTvCXHfPMGS9N9lywCHGKjVG",
+	"uoQaIdOylKbNiVCLivAAyb90Ukli9rim1+yMniHvjz9qUtUlBiy/Bw4P2yDXGO87jjOXAJe1r87cFhvh",
+	"BWZVHbQrUhRcsImbWA+kBuxVs/MlPMRt2sSBm4HY1BB8AZWErFIInxzLKxXL7aHhZh+QgfImeP72Axt6",
+	"+pN7p6CX/amfNFOcFvwPlu9QAvxmD5rQ0m6ffi5NAzl9wtud8bHTQCC+V2P3HTgbCGHvFWKgtuDvF4zA",
+	"ScPk4HAuqNmWvxWwOkGtwfHfYWQUp4CKu6NqdieEZKTprRDRx1Swj9PGhvLt5DXM0ZVnbZ6uGzx+HIuX",
+	"APAu3PcwpC2HfsiV8LU/P9SpgY5SGpl5k+R/97c+GzL55m5CJodd7Z48fHJ3EJz6G00Qf1L1cSYtaKmX",
+	"0oByPQfC/KRvooHLR8GLMpSSrzEyhGpvel9t1J7rNblybULKqTkHU0U3uT5k63CFCd1bqd/knCuWmWKD",
+	"2o0vRMx4Knf5mAh2xbTB3Fe2rFG7BQS/2+s8y+PR0hUJETaudeXLByWuZf2eR1G60tPM8EtuNg3vo3HI",
+	"2+gqIWMCdM39XRPStsM1LfJX6rupXTQrAR6Nzx+t8bkD1hkWAQxrp3im58a5Q4aK1mTFxSRI5dTkocGe",
+	"mEmDMGNzqVgbBrreAQNdXwuGT9oZ9MAvCphnaqK2Zc6sHShJJi+Zqkk15mHUsR7kJmld92N6LmhLlUEP",
+	"IdsrJ27NEdZEdWv+Q7grHvfyo93Ljq520auZxJb02DrunftaqsdfISnLm79wUpbbvLDc9oIuejkCCD6n",
+	"Kx49M8O1qcVGe0/2Ya9EJ67obBRQtFAMSpqfGL5i1lYRfbtRfH47/p6bEEQUf2o8rQfXOud5Pa4rvkAS",
+	"MShl4YpY6LF3voseMXAnx52oyPQtpQbjm83Z8yE3lGMk918jcVrdM2nNSdPn0aZ2tza1aBdeSUO+++RN",
+	"ZWmy2pfFb+PKJzO53sWZO+70llnaBzp7Nht8OtiBxtF32xpT996DApgzqtmXT/xd9/6UfOOa1kWxXYHX",
+	"haRFXTiNqgV2svzeIoN85v98CuN/NiXfQTlAo8eQgdyOgQ25ME8fPf78iWui6BUm+G63m3355Onp11+7",
+	"ZqXiwoCNDK8DnebaqKdLVhTSdXAytDuu/fD0f/3n/55Op5/tFC1y/c3mFeaf/2jly2lMAH279YlvUtIw",
+	"hvuyE3W3mYf1KJmbxauTklCuj5L4g0lii/2/hASeNcnIxZGGfA2xy9MhJfKB3ChR6rqHtnoWu6qjo+Nf",
+	"ytGxoT0M9nc8Kg8fufJwdIM8ukEe3SCPbpBHN8ijG+TRDfLoBvmXvCj+HR0fQbAf3r9x172S6X1tvf7q",
+	"BvWCg6F2Sl5JgkBUBVUYXm6vSDaOrqKKCsOYKxkE/gSUFxqdNLKCM9R47LWKqYnmufOrtG5TtvAbcE7r",
+	"qcaENy3bsVsQQCknKjaudO2cr8fgzjHGEewMoDlBkia4VDmFiqEDpbexhaupFAx96qjdK/u66S8nllXi",
+	"Gp1I4AqhGKOGSEntsEIqYXhRS66SLpyiuaJrCwpfCKn63CAb9zimP+ZL3Eu6jlwe/YUudnq0Xmxu0fYA",
+	"48VWwU9ff00ejuvn3aKwA0xwZ/s98vb0xYNbuarNrbj3WEYWYdWGKuO1fK4DHf2dLqW45v2cPT9SH9Su",
+	"Jy5ueUSScem1Rw8fPsSjmVFhKZStM8Zy+N1fwF7S9enrM2tUYSp+X3Q8Zh6sRId08T0ttIwJ1/ExfzW3",
+	"a+qbDpum5ptJWTAqDm9V8GJlkGPcN3L93K0Vxen22qcw9hCVr35D7Jg7jyrdJ6sxoVxwG3sXtvfbDQep",
+	"JzuGhOwOCamxtW9YyAdUiY6RIMdIkGMkyDF64BgJctzLYyTIMRLkGAlyjAS580iQ61+O9i4NU5d+icM1",
+	"4McdgRro/gHvRERXZVlgLRnQzGhR27TSlwM7w9AYjFusInKrMQeYGDPh595G79HCcYwzuJGdpU1QN2Ub",
+	"t2xHQQ3laEHZZUGxeNrXdnKXJZeO9pKjveRoLznesY/2kuNeHu0lR3vJ0V5ytJd8CHvJNUqagbOrPvkT",
+	"eE1sJulo4d/Yln+vGrJRWQwlV95hW5I5Mzb+2yKkfdtMXDKC73HvDWPFhb0/jJ4+HN9JCvEu7cHmkiWj",
+	"OVOhpldTzoxH6xCzNgne19DxB+gHwTJMJSj7J/gPLYj9jJ7TnhRshAwH10aIjUH7GstRnHsHMLijEm3P",
+	"gCQrJBiIktsLymf15F0HnUI2aOL6etIRwfshuMMxv3Xu/9DDL+IvpIiQCXkFlmQ44M5mcNROPrIFvZKC",
+	"YdFde6FAWjzqIg1dBJHiLXxR2ocbqSAnPpZpqx7yA0bXbNVFhkhvO9knKcJ/SEZ8NaSMXdt0Z2RUPdoQ",
+	"5vyDCyejZBZN9UGjjT4IP/0IX3M+BMe6GxYDh9TzGfxJigMzHVSwdrIdbHYIxoNn9NZZz9E8vYuRhp2/",
+	"DYU9yWzx28EuGx/TCrqM+i7FxFGLP2rxRy3+WiIWucTtCtmCL5YGZ+okhurI2xe2ccSJBiUDqiWvkVF+",
+	"BZi4udIZs4lR9Mep72+jjzRediQP6Kx/+jdUkJ/JqshBa8GasS6MHb1RtFy5Sq1ckxXX2r39PXn4j7uD",
+	"0HDrOiQrY4+eqt+tPrAK/8XDz+9u+nObpCxj5IKtSqmo4sWG/CyCQ9JN+J0OqQviELwEc+ACgvlpI2MK",
+	"pkJZWQzciAnKxZbkBS5Y0KeMkQuNdwhZGabArxR9wnwwtw4h3jWTTrlQAcN4Yac+wN2lkItPzWbisT6s",
+	"kntZPqNFAeja9VYPAw+qcl4UuJ9sxY1heWLjpuRbmi3D3o7rC1rw3ZPOKXCMj3+hJDqM7L2euLCky+w+",
+	"G0ai1URPAkwFpyjFyIpCWrJVVRheFs0+wVNC0xVLZVBG2owdPM6e+9Vh7gs5r4du06+RjcGn5DR8gpmF",
+	"xMVRxYB3hwcMN4MnkmkDaNs6JMTgop4Cs18A8tDHMpMKhwCv7jpJQlkyqurOSPn3SsUmbghFL5nSFA5r",
+	"a1H3j/awj8MeZjV/S4AfiTUsGRh+U15/fVHUKJDxp1lbh+edennktrGnSs5FpJI3/MHgrF1fFx/mMdti",
+	"UFYFCNJtwQQDZukUhB5QLIr2LL73r6OBfga2kaUFNHZWAgGtNKaCdBpryNw6DinmpbDdnpK34gHRS/rF",
+	"o8e/Pf7iS//n4y++7LHD2XkAsJQlrh7IfsZhhjhMHI2LQeMI+H1617u93yaORzxfd4FEP/6QurE+OrE8",
+	"hHL6G+901PHh7MkaGS6m8bArZsWUXvIyldyvPlf/596/P7Vni07+eDj56l9Pfv3zyfv7Dzo/Pn7/9df/",
+	"t/nT5++/vv/v/5Ly79SGz9IZFP1z1zlfCJZfrMWZ+Ca8ekIWwI3VGgLPuFu4jWIsZ6VZpjJrl4ppJlxc",
+	"CrSqd5MxH/hBZswt4JKJMeFTNoU2dSSDzTuofd7ZgtF5nF42te8tBTniM5bQ6pyLAevxQvbKitgiS/cm",
+	"efePkS9pYTee5U7QeeS1leIPqoSZD6WETVpaWBMtH04nY7blOIroLJU0MpMFyB4bySmVCadbTwdZHtiO",
+	"nIloeOgj3Bspc2ue650PmBfQ6gA2gCZl60/Gb+LCoyn1TJValDcYdLnv1oRf9VyDHPZlSbrBeWfP9Qfl",
+	"a8dLZYqftd6TPnUXC9NLegd+DILMsFV58medIvZ9XXIxZ4Wh+sSsxclCSdtsa9Q+M3VOZOjaMOnGK4HR",
+	"kmGlWLICCgQ9t0N8J1V0uf3e9tsZaNpC2rgt9GF2cvY8zR5v5zb5t76EbX06a234zR9rEyN2zqs/y95Y",
+	"C2ZGT7v4wBBTsH14KliKhI/OBR/Xgur3xDkXOaHRNrZsTVLVjOCW3xRve9Ef4ony7j0qvviEz5nN5HG2",
+	"KgsobowBdDdI9d7mcF56bBW3+ykGTvR3Q9C6Mj+W+D6RatBFdgr4Pe49tXF4yfx0VNn/aq6iGoJHX82/",
+	"kSR/Fl5bYzI8yuVPRy4rn+HoKII/fhH8+Se7mlv0YRookq/xONwUw/VNfE+B3FEGnA2rZTjY9q4MV+/2",
+	"KvV3Ur1xqzpK8U/0URR3crAj1hALzS5LrJvyENEWHxX0w+wM1umsY2noO6jj4OvFFaFay4xTw3Jylusx",
+	"HmJnnHCn+Kj4fNSKT7TXR73naHr4xEwPPVqOu/UXxRBFY18F6HIlc+YfVuV8rpnZpv24nJ+YrQsKrWlD",
+	"VyXBntNeP+wLvmLntuVPOMVBRWwNdkstaoFnkaVZJkWj+m+fF4cb9bpyyOLJ9ANw5y+bYQc8LK6u2/Ta",
+	"JOtL2adWOiVt5OtGNWiHjJxdkpWrwHVTsj35E/8Fc1opdWI158ykwSX33Lbch7OG4zYAJK9BCcVUe76X",
+	"nJOH5IoXBamEhsdFrl3lfihPrTbEyFDXTTFakKyRQSLA0T05570nZ+dVoLO6njWl7wKyPqGH9GBoZe/5",
+	"8c4PwDMsFAf71EaQkVAIckENv2T+yX96rAB2bWnmUsxvYYBjmxkZT2O9CeySqQ3R1UxbXUc0Y5Q+083z",
+	"sgfDYOuSKW5FNC3qB3i8JpxgBvttfkTn2OKGQqvFi2BMoppei16yIkyWwbzkmZKnxUIGX3i90YatRuOW",
+	"FHRdf+vJVuoNCV2fVVeveSUFS9S+/wm+voSPqd5QBaCv84X92Nc3nQL0txDQFYPVnGeITL4pfj+S038j",
+	"R5fWahUrpTJ1glqk/z2Pkj80G5F1T9JGZCdZpbRUeuvHkz+tsHk/pE30SJZo2/kYQS1Fz88nPvbh5E9X",
+	"qqJvgJM/G3+6shqupV5WJpdX0SxgcEDfySHpJUHT3zOipDbwNUM1ub5dE99tPm1FeEgd5PA1qNlXipZ4",
+	"nuuPGF8A16EQH/e3jvh2L0ExkbgAykumdOvWeAz7/kuFfQ/e971Yvx2y0rs4WqUPqyi9kjnDcevYXyyk",
+	"UdcoojNLShTKdRDtgWjpR8EHMx2f5IVl3a4VMZLRyobNVyUxMhWbUnec0AyZ7ARvXekJo1LX0AqnW9JL",
+	"RmihGM3tTZkJImd20bXYhkVSDUX8fYCL8zRNamgRXKWSGdOa5ROXEn0naL4d+sWbLXgCwAHgMAvRksyp",
+	"ujGw7y53wvmObSau1va9H3/R9z8AvKihbkcstEmhtx3j3YV62PTbCK49eUx2GD2OVAvxeNIaNQ3rAWY/",
+	"nPTuXxuizi7eHC0QssZvmeL9JDcjoADqLdP7TaGtyomV310Qn+FXa7KyGyaokN7cmRqsoNpMdrFl2yhe",
+	"i7YriDhhihPDwD334BdUmzcuODu3MoihOIF5oA9M0Q+wlaJ4t0iM/At+TI2dSaGZ0JUmbgQfcMXy1Bqg",
+	"GkjvXK/YOswl59HYIaILDY+7Ru7DUjS+Q5aui1oRaiInAztcYnFgFqXObtJT7MQDUSNiGyDnvlWE3di7",
+	"oAcQrmtEI+Fw3aKcmZQFowIDY2VZWm5hJpUI/frQdI6tT83PddsucWHiDZiT5JLpONrOQX4VyoWJnCyp",
+	"Jg4OX96lVHKhmNZJmO1hnEBOp8k2ygdLsm0VH4Gdh7QqF4rmbJKzgiYsPD/jZ4Kftw0AO+7Jc3IpDZtg",
+	"lar0pteUrHotV2FoCeMlmOYrSeALyewRtJfnmkBc7x0j5wzGTjEnR0efhaFgruQW+fFg2bjVPdYyO4bd",
+	"cWyEIDuOPgTgHjyEoa+PCug8qc0H7Sn+k2k3gW9zjUk2TPctoR5/rwW0rYyxAGtIihZ7b3HgJNvsZWM7",
+	"+EjfkU3ZNT/JN4i2S9UtRvQ17brRBXB6ncvtyRXlxqaFRUV6AqX8dvrp/wfl/pXexwpLl+LFFQOEAYgb",
+	"B5i8il5PHRdBEIgTF5ZEXNoqwjWh5BFZcVEZ/CIr4wqAKUZDkU2PBjcS13VGKMUWVOUF09oqDF5uSgXC",
+	"iJuWgA8VDLf6MNp1fyfVoDI5zTyVlBtSCcMLB6DleOHe/vFZL48WiaNF4miROFokjhaJo0XiaJE4WiSO",
+	"FomjReJokThaJP6+FokPlZNp4jUOnx5SSDFpe24eHTf/Uinsg6jyBhKwTlgbgmVLUUqEfrvFHoYgw2gB",
+	"OOAF63clRw/Xi29PXxAtK5UxklkIuSBlQbkghq3N2Bk3yIxq9uUTH9eIopOuiM2YifLVNvj8MTn/4dSn",
+	"N126NJzNtvdO0V+NaLMp2H1X6JSJHDVRX/HUFZJG+xD1IiFzQZlooJjzAtzwNfkWWj+3CbFkyRRmTiRG",
+	"Vaxr8blgtHjmcLPD4PMfdnLn1/u7He33ccPo5dC2oqVX8/1aqSYUwzvJ8yjg8/c5LTT7vS/mE8db0XKU",
+	"SJQcBB+agoCZfCPzTeuE2F07gQ1sno06ySkXVG0SKam68RZt0jDSsitHWF1b1vuDp+LtEm2XzHZRWEpb",
+	"x5z76dH7qDw1Tr1hnaEwKnjeopNRKqC1nXh1FAAclIUQYjJwT8gb7PdB5RsBiNwRq5n5R+PF2GwZmAa0",
+	"vcMa9rf1oOARnzy9cPbHlrDzKmOEG00cxQ0QL7bunB1pwcTEMaDJTOabSYN9jRpSKOeaas1Ws92SKOaf",
+	"cOKC8DHLxHIacurDiJHn0eK28eSYaNYTx4B7uPPGsMG8OWALRnTsOcL4bbPoPjYag0Acf0oZlVq8b1+m",
+	"V0+zOTK+I+OLTmNLI+DCZT9vM5HpLTI+tVGV6Od5365ZVlng4pN8D6zz8CRnrTXxI2vOZtViYW8L3Tc6",
+	"uzQG49nCTh+GFeJyh3LB/SgIB3/jfexvGhHfHq7LXaIg9Xs+DeR92A4qNvCYsSqp2PgnX2t1WFUF4hBr",
+	"th6W0WKC8lQ+69r212fVfu1axLZbJ2qbvyNayBXVBPeX5aQSuQuvak9s1mJ4UhUc+mItaja9NYEKrjex",
+	"OjfvEBHhd7kZ165JydTErAUeqMZhcuUS8OR+0MTdR7Fxd2IDo+JZD4Ptpv6vGcKBpIeK+BqIj3qyKAQx",
+	"/vWENmMXG9/AotEf4hJXgsKWB3Us6Qzf9C+pzS3u/ZQVJaEkKzi8rkqhjaoy81ZQeL+JFjbt+p54Q3U/",
+	"73vmm6SfEBMvfG6ot4KCk1F41UnywDlLPGF8x5hnsbpaLJi2fDQmoDljb4VrxQWpBDcw14pnSk4wjtee",
+	"L6u7TLGlrfQ3h/QpkvzBlCSzysRjarQla2PfB9HZxU5D5PytoIYUjGpDXnLLge1wPndDcDlj5kqqdwEL",
+	"6cJACyaY5nqSNsx8j1+h9o5bvjcA2v+7znXNjLstuuNh53kv5Lb8oSYUUj8XXMfFHtuw39nb+IqLSZLI",
+	"7CO+cxdr0xa5BwnnHAHdbz4cmSV7K6z0M5IAx6fmeuTQfgHqnEU8HS2qaWxE66HIr3XQ9e8gXIYkmMzx",
+	"2eUvFEIa0YF/2YSNx2T+rb3f84mlIXIZ1CHtE8j41dVq7GnkLhANI1krm45rcdEAeev7xaefw/Lwd0mP",
+	"xoPdJrsDvh+nvPJiaW0k8Rs+JtTWtMckjvZ2KWGfuCgrAw7gt2nAY5e0mMhLphTPmR64Ui7Ft5e0+Cl0",
+	"ez8eWevDxCiasQlaFIZi7cL2QTq143DBDafFBG7VQwFiZ9jrHDvtkMdRadPViuWcGlZsSKlYxnLMesY1",
+	"qe/zU0zQQLIlFQsQ3UpWiyU2w3GumGKhCqS9QreHSMp2sxYTzIDXhfHUVYWOkwRbH/lElRoQcFc0zOey",
+	"Zwy5lSc4CuQ37bukj0e9irZF6mXtOofIabKZAVpEQx+I8FNPfIiEsEeiPxL9p070qfyNgLp5y1qB+Iq3",
+	"5ZbNWredrfQOrWQfJJXxsR7AX70egOdAmlCiaOMOki5ERzXhhlxBWqQZI1Z+VWCdd9X93H0dIu2io+7S",
+	"empXCzBbUi5cTp0Q1wBwGJLJ1Yob42vh3ophE5kZWDQtOlhWKW42cGuhJf/tHbP//9Wq/ZqpS3+hqVQx",
+	"ejpaGlM+PTkpZEaLpdTmZPR+HH/TrY+/Bvj/9HeRUvFLahh8W0+k4gsurMy9oosFU7UJcfR4+nD0/v8N",
+	"AMR9T+gRVgIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -30,6 +30,9 @@ type ServerInterface interface {
 
 	// (PUT /debug/settings/pprof)
 	PutDebugSettingsProf(ctx echo.Context) error
+	// Get the agreement timelines of the most recent rounds.
+	// (GET /v2/agreement/timeline)
+	GetAgreementTimeline(ctx echo.Context, params GetAgreementTimelineParams) error
 	// Return a list of participation keys
 	// (GET /v2/participation)
 	GetParticipationKeys(ctx echo.Context) error
@@ -88,6 +91,26 @@ func (w *ServerInterfaceWrapper) PutDebugSettingsProf(ctx echo.Context) error {
 	return err
 }
 
+// GetAgreementTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAgreementTimeline(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAgreementTimelineParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAgreementTimeline(ctx, params)
+	return err
+}
+
 // GetParticipationKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) GetParticipationKeys(ctx echo.Context) error {
 	var err error
@@ -236,6 +259,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/debug/settings/config", wrapper.GetConfig, m...)
 	router.GET(baseURL+"/debug/settings/pprof", wrapper.GetDebugSettingsProf, m...)
 	router.PUT(baseURL+"/debug/settings/pprof", wrapper.PutDebugSettingsProf, m...)
+	router.GET(baseURL+"/v2/agreement/timeline", wrapper.GetAgreementTimeline, m...)
 	router.GET(baseURL+"/v2/participation", wrapper.GetParticipationKeys, m...)
 	router.POST(baseURL+"/v2/participation", wrapper.AddParticipationKey, m...)
 	router.POST(baseURL+"/v2/participation/generate/:address", wrapper.GenerateParticipationKeys, m...)
@@ -248,236 +272,271 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9/5PbNpIo/q+gdFflLyfO2I6T2/hTW/eZ2El2Lk7i8ji5d2f77UJkS8IOBXABcEaK",
-	"n//3V+gGSJAEJWpm4mzq5Sd7RHxpNBqNRn/9MMvVplISpDWzZx9mFdd8AxY0/sXzXNXSZqJwfxVgci0q",
-	"K5ScPQvfmLFayNVsPhPu14rb9Ww+k3wDbRvXfz7T8I9aaChmz6yuYT4z+Ro23A1sd5Vr3Yy0zVYq80Oc",
-	"0RDnL2Yf93zgRaHBmCGUP8pyx4TMy7oAZjWXhufuk2HXwq6ZXQvDfGcmJFMSmFoyu+40ZksBZWFOwiL/",
-	"UYPeRav0k48v6WMLYqZVCUM4n6vNQkgIUEEDVLMhzCpWwBIbrbllbgYHa2hoFTPAdb5mS6UPgEpAxPCC",
-	"rDezZ29nBmQBGncrB3GF/11qgF8gs1yvwM7ez1OLW1rQmRWbxNLOPfY1mLq0hmFbXONKXIFkrtcJ+742",
-	"li2Acclef/OcffbZZ1+6hWy4tVB4IhtdVTt7vCbqPns2K7iF8HlIa7xcKc1lkTXtX3/zHOe/8Auc2oob",
-	"A+nDcua+sPMXYwsIHRMkJKSFFe5Dh/pdj8ShaH9ewFJpmLgn1PhONyWe/zfdlZzbfF0pIW1iXxh+ZfQ5",
-	"ycOi7vt4WANAp33lMKXdoG8fZV++//B4/vjRx395e5b9j//z888+Tlz+82bcAxhINsxrrUHmu2ylgeNp",
-	"WXM5xMdrTw9mreqyYGt+hZvPN8jqfV/m+hLrvOJl7ehE5FqdlStlGPdkVMCS16VlYWJWy9KxKTeap3Ym",
-	"DKu0uhIFFHPHfa/XIl+znBsaAtuxa1GWjgZrA8UYraVXt+cwfYxR4uC6ET5wQf+8yGjXdQATsEVukOWl",
-	"MpBZdeB6CjcOlwWLL5T2rjLHXVbszRoYTu4+0GWLuJOOpstyxyzua8G4YZyFq2nOxJLtVM2ucXNKcYn9",
-	"/Woc1jbMIQ03p3OPusM7hr4BMhLIWyhVApeIvHDuhiiTS7GqNRh2vQa79neeBlMpaYCpxd8ht27b//Pi",
-	"xx+Y0ux7MIav4BXPLxnIXBVQnLDzJZPKRqThaQlx6HqOrcPDlbrk/26Uo4mNWVU8v0zf6KXYiMSqvudb",
-	"sak3TNabBWi3peEKsYppsLWWYwDRiAdIccO3w0nf6FrmuP/ttB1ZzlGbMFXJd4iwDd/++dHcg2MYL0tW",
-	"gSyEXDG7laNynJv7MHiZVrUsJog51u1pdLGaCnKxFFCwZpQ9kPhpDsEj5HHwtMJXBE4YZBScZpYD4EjY",
-	"JmjGnW73hVV8BRHJnLCfPHPDr1ZdgmwInS12+KnScCVUbZpOIzDi1PslcKksZJWGpUjQ2IVHh2Mw1MZz",
-	"4I2XgXIlLRcSCsecEWhlgZjVKEzRhPvfO8NbfMENfPF07I5vv07c/aXq7/reHZ+029gooyOZuDrdV39g",
-	"05JVp/+E92E8txGrjH4ebKRYvXG3zVKUeBP93e1fQENtkAl0EBHuJiNWkttaw7N38qH7i2XswnJZcF24",
-	"Xzb00/d1acWFWLmfSvrppVqJ/EKsRpDZwJp8cGG3Df3jxkuzY7tNviteKnVZV/GC8s7DdbFj5y/GNpnG",
-	"PJYwz5rXbvzweLMNj5Fje9hts5EjQI7iruKu4SXsNDhoeb7Ef7ZLpCe+1L+4f6qqdL1ttUyh1tGxv5JR",
-	"feDVCmdVVYqcOyS+9p/dV8cEgB4SvG1xihfqsw8RiJVWFWgraFBeVVmpcl5mxnKLI/2rhuXs2exfTlv9",
-	"yyl1N6fR5C9drwvs5ERWEoMyXlVHjPHKiT5mD7NwDBo/IZsgtodCk5C0iY6UhGPBJVxxaU/aJ0uHHzQH",
-	"+K2fqcU3STuE794TbBThjBouwJAETA3vGRahniFaGaIVBdJVqRbND/fPqqrFIH4/qyrCB0qPIFAwg60w",
-	"1jzA5fP2JMXznL84Yd/GY6MormS5c5cDiRrublj6W8vfYo1uya+hHfGeYbidSp+4rQlocGL+XVAcPivW",
-	"qnRSz0FacY3/4tvGZOZ+n9T590FiMW7HiQsfWh5z9MbBX6LHzf0e5QwJx6t7TthZv+/NyMaNsodgzHmL",
-	"xbsmHvxFWNiYg5QQQRRRk98erjXfzbyQmKGwNySTnwwQhVR8JSRCO3fPJ8k2/JL2QyHeHSGAad5FREsk",
-	"QTYqVC9zetSfDPQsvwNqTW1skESdpFoKY/FdjY3ZGkoUnLkMBB2Tyo0oY8KG71lEA/O15hXRsv9CYpeQ",
-	"+J6nRgTrLS/eiXdiEuaI3UcbjVDdmC0fZJ1JSJBr9GD4qlT55V+4Wd/BCV+EsYa0j9OwNfACNFtzs04c",
-	"nB5tt6NNoW/XEGmWLaKpTtol4t93tkgc7cAyC255tEwPe1qajWAcQQR9m4KKr5IIeKlW5g6WX6pjeHdV",
-	"Pedl6aYe8uzeKnHgSZysLJlrzGAj0GLgX85kYqAHKPua52snF7Gcl+W81ZWpKivhCkqmNBNSgp4zu+a2",
-	"5X44cnjYISMx4Li9BRatxuvZUMeoG2WMBrbheAVv3HOuKrt9mivE8A30xEAUCVSNapTopXX+IqwOrkAi",
-	"U26GRvCbNaK6Kh78xM3tP+HMUtHiSAVqg/2ywV/DMDtAu9atQCHbKZQuSGlv3W9Cs1xpGoJEHD+5+w9w",
-	"3Xam43m/0pD5ITS/Am146VbXW9SDhnzv6uT+Wmd2PstBJ9RUP+J/eMncZyfGOUpqqUegNKYie3JBkolD",
-	"Fc3kGqDCWbEN6XJZxfPLo6B83k6eZi+TTt7XpD72W+gX0ezQm60ozF1tEw42tlfdE0LKu8COBsLYXqYT",
-	"zTUFAW9UxYh99EAgToGjEULU9s7v9a/UNsnt1XZwp6st3MlOuHEmM/uv1PaFh0zpw5jHsSddZ2rLJN+A",
-	"wetdxozTzdIaJs8WSt9MnOpdMJK15lbG3aiRNDnvIQmb1lXmz2bCZEMNegO1Hi77paD+8CmMdbBwYfmv",
-	"gAXjRr0LLHQHumssqE0lSrgD0l8npdgFN/DZE3bxl7PPHz/565PPv3AkWWm10nzDFjsLht33eklm7K6E",
-	"B8nnIUoX6dG/eBqMdN1xU+MYVescNrwaDkXGP3r+UzPm2g2x1kUzrroBcBJHBHe1EdoZ2bUdaC9gUa8u",
-	"wFr31H+l1fLOueFghhR02OhVpZ1gYbqGUi8tnRauySlsreanFbYEWZCjhVuHMO4RvFncCVGNbXzRzlIw",
-	"j9ECDh6KY7epnWYXb5Xe6fou9DugtdLJK7jSyqpclZmT84RKaGhe+RbMtwjbVfV/J2jZNTfMzY3m21oW",
-	"I4oYu5XT7y8a+s1WtrjZe4PRehOr8/NO2Zcu8ttXSAU6s1vJkDo7+qGlVhvGWYEdUdb4FizJX2IDF5Zv",
-	"qh+Xy7tR9yocKKHIEhswbiZGLZz0YyBXkrwZD+is/KhT0NNHTDCz2XEAPEYudjJHW+FdHNtxdd5GSHRc",
-	"MDuZR7o9B2MJxapDlrfX4Y2hg6a6ZxLgOHS8xM9orHgBpeXfKP2mFV+/1aqu7pw99+ecuhzuF+PNIYXr",
-	"G/TgQq7KrgftysF+klrjb7Kg540SgdaA0CNFvhSrtY3ei6+0+hXuxOQsKUDxA2nLStdnqDP7QRWOmdja",
-	"3IEo2Q7WcjhHtzFf4wtVW8aZVAXg5tcmLWSO+Fyisxf6qNlYbkX9hDBsAY66cl671dYVQw+swX3Rdsx4",
-	"Tic0Q9SYEf+TxnGIWtF05M9XauDFji0AJFML7+Th3U9wkRzdx2wQ07yIm+AXHbgqrXIwBorM6+IPghba",
-	"0dVh9+AJAUeAm1mYUWzJ9a2Bvbw6COcl7DJ0djTs/nc/mwe/AbxWWV4eQCy2SaG3r08bQj1t+n0E1588",
-	"JjvS1BHVOvHWMYgSLIyh8CicjO5fH6LBLt4eLVeg0afmV6X4MMntCKgB9Vem99tCW1cjLvz+me4kPLdh",
-	"kksVBKvUYCU3NjvEll2jji7BrSDihClOjAOPCF4vubHkByZkgTpNuk5wHhLC3BTjAI8+Q9zIP4cXyHDs",
-	"3N2D0tSmeY6YuqqUtlCk1oAm6dG5foBtM5daRmM3bx6rWG3g0MhjWIrG98jyL2D8g9vGAO1N2sPFoVOB",
-	"u+d3SVR2gGgRsQ+Qi9Aqwm7sxjwCiDAtoolwhOlRTuM7PZ8Zq6rKcQub1bLpN4amC2p9Zn9q2w6Ji4wc",
-	"dG8XCgwaUHx7D/k1YZYc2NfcMA9H8DFAdQ45rA1hdocxM0LmkO2jfHziuVbxETh4SOtqpXkBWQEl3yW8",
-	"I+gzo8/7BsAdb5+7ykJGnsjpTW8pOTh+7hla4XgmJTwy/MJydwTdU6AlEN/7wMgF4Ngp5uTp6F4zFM6V",
-	"3KIwHi6btjoxIt6GV8q6Hff0gCB7jj4F4BE8NEPfHBXYOWvfnv0p/huMn6CRI46fZAdmbAnt+EctYEQX",
-	"7IO8ovPSY+89Dpxkm6Ns7AAfGTuyI4rpV1xbkYsK3zrfwe7On379CZKGc1aA5aKEgkUf6BlYxf0Z+dD2",
-	"x7zZU3CS7m0I/kD5llhO8FPqAn8JO3xzv6LgjEjVcRdv2cSo7n7ikiGgweXbieBxE9jy3JY7J6jZNezY",
-	"NWhgpl6QC8PQnmJVlcUDJO0ze2b01tmkbXSvufgCh4qWl3K2ozfBfvje9B4GHXT4t0ClVDlBQzZARhKC",
-	"Sb4jrFJu14WP/woRQIGSOkB6po2m+eb6v2c6aMYVsP9WNcu5xCdXbaGRaZRGQQEFSDeDE8GaOb13Zosh",
-	"KGED9JLELw8f9hf+8KHfc2HYEq5D0KRr2EfHw4eox3mljO0crjvQh7rjdp64PtBw5S4+/wrp85TDLl9+",
-	"5Ck7+ao3eGPtcmfKGE+4bvm3ZgC9k7mdsvaYRqa5u+G4k2w5Xf+gwbpx3y/Epi65vQurFVzxMlNXoLUo",
-	"4CAn9xMLJb++4uWPTTcMCIXc0WgOWY5hjBPHgjeuD0U+unGEFO4AU9TDVIDgnHpdUKcDT8zWVVdsNlAI",
-	"bqHcsUpDDhTw5yRH0yz1hFEoQL7mcoUPBq3qlffupXGQ4deGVDO6loMhkkKV3coMldypC8C7qYWYTydO",
-	"AXdPur6GnB4w17yZz4f5TrmZoz3oWwySRrL5bPTF65B61b54CTndwNUJl0FH3ovw00480ZSCqHOyzxBf",
-	"8ba4w+Q299dR2bdDp6AcThy5PLcfx7ye3XO73N2B0EMDMQ2VBoNXVKymMvRVLeMg9eAquDMWNkNNPnX9",
-	"68jxez36XlSyFBKyjZKwS+ZlERK+x4/J44TX5EhnFFjG+vbfIB34e2B155lCjbfFL+52/4T2LVbmG6Xv",
-	"yiRKA04W7ydYIA+a2/2UN7WT8rJMmBZ9CGufAZh546wrNOPGqFygzHZemLn3CiZrpI937aL/VROYcwdn",
-	"rz9uz4YWZ0dAHTGUFeMsLwVqkJU0Vte5fSc56qiipSacuMJjfFxr+Tw0SatJE1pMP9Q7ydGBr9FcJR02",
-	"lpBQ03wDEJSXpl6twNjeW2cJ8E76VkKyWgqLc23cccnovFSg0ZPqhFpu+I4tHU1YxX4Brdiitl3pHyO0",
-	"jRVl6Q16bhqmlu8kt6wEbiz7Xsg3WxwuGP3DkZVgr5W+bLCQvt1XIMEIk6Wdzb6lrxjY4Je/9kEO6O5O",
-	"n4PTaZsyYuaW2ckS87/v/8ezt2fZ//Dsl0fZl/92+v7D048PHg5+fPLxz3/+P92fPvv45wf/8a+pnQqw",
-	"p+KHPeTnL/zL+PwFPn8iV/0+7J9M/78RMksSWezN0aMtdh9zZXgCetBVjtk1vJN2Kx0hXfFSFI633IQc",
-	"+jfM4CzS6ehRTWcjesqwsNYjHxW34DIswWR6rPHGUtTQPzMdqY9GSR98j+dlWUvayiB9UyBq8C9Ty3mT",
-	"jYEStT1jGKq/5sHJ0//55PMvZvM2xL75PpvP/Nf3CUoWxTaVSKGAbeqtGAdJ3DOs4jsDNs09EPakKx35",
-	"dsTDbmCzAG3Wovr0nMJYsUhzuBCz5XVOW3kuycHfnR80ce685UQtPz3cVgMUUNl1KoFTR1DDVu1uAvTc",
-	"TiqtrkDOmTiBk77Op3DvRe/UVwJfBsdUrdSU11BzDojQAlVEWI8XMkmxkqKfXniDv/zNnT+H/MApuPpz",
-	"pjx673379Rt26hmmuUc5PWjoKAtD4into0c7DkmOm8UxZe/kO/kClqh9UPLZO1lwy08X3IjcnNYG9Fe8",
-	"5DKHk5Viz0JA6gtu+Ts5kLRGM0tGUeOsqhelyNll/CBpyZOyhQ1HePfuLS9X6t279wPfjOHzwU+V5C80",
-	"QeYEYVXbzOc6yjRcc52yfZkm1w2OTMnM9s1KQraqSUEacin58dM8j1eV6ee8GC6/qkq3/IgMjc/o4LaM",
-	"GauaeDQnoPiYZre/Pyh/MWh+HfQqtQHD/rbh1Vsh7XuWvasfPfoMI/vaJBB/81e+o8ldBZO1K6M5OfpK",
-	"FVw4PSvRVz2r+CplYnv37q0FXuHuo7y8QR1HWTLs1ok6DAEGOFS7gCbGe3QDCI6jo6NxcRfUK+S1TC8B",
-	"P+EWdiPQb7VfUQKBG2/XgSQEvLbrzJ3t5KqMI/GwM026u5UTsoI3hhErfK36zIALYPka8kufsg02ld3N",
-	"O92Dw48XNAPrEIaS+VGEIaaTQgPFAlhdFdyL4lzu+nl9DEVU4KCv4RJ2b1SbjeqYRD7dvDJm7KAipUbS",
-	"pSPW+Nj6Mfqb773KQqCpT8+CwZuBLJ41dBH6jB9kEnnv4BCniKKT92QMEVwnEEHEP4KCGyzUjXcr0k8t",
-	"T8gcpBVXkEEpVmKRykP8X0N7WIDVUaVPvei9kJsBDRNL5p7yC7pY/fNec7kCdz27K1UZXlJa2aTTBr6H",
-	"1sC1XQC3e/X8Ms7IEaDDJ+U1Rl6jhm/ulgBbt9/CosZOwrV7VaCiiNp47+WTcf8zAhyKG8ITurcvhZPR",
-	"t65HXSLlYriVG+w2z1rvmhfTGcJF3zeAOVvVtdsXB4Xy6UYpq010v9SGr2Dk7RJb7yYmBOlY/HCQQxJJ",
-	"UgZRy76oMZAEkiBT48ytOXmGwX1xhxifmT2HzDATGYi9zQiziHuELUoUYBvPVdp7rjtWVEqLPAZamrWA",
-	"lq0oGMDoYiQ+jmtuwnHEhLGBy06Szn7FvDf7cvOdR76EUVbYJvNeuA37HHTw7vcZ+kJavpCLL370T8ir",
-	"595eGL6Q2g4lUTQtoIQVLZwaB0JpM0a1G+Tg+HG5RN6SpdwSIwV1JAD4OcC9XB4yRrYRNnmEFBlHYKPj",
-	"Aw7MflDx2ZSrY4CUPuMVD2PjFRH9DenAPnLUd8KoqtzlKkbsjXngAD4VRStZ9DyqcRgm5Jw5NnfFS8fm",
-	"/Fu8HWSQIg4fFL2EcN715sHYQ2OPaYqu/KPWRELCTVYTS7MB6LSovQfihdpmFKGcfIsstgtH78nYBYyX",
-	"Th1MSsZ3z7CF2qI7F14t5Ct/AJZxOAIYke5lKwzSK/Ybk7MImH3T7pdzU1RokGS8orUhlzFBb8rUI7Ll",
-	"GLncj/Lr3QiAnhqqLVbh1RIH1Qdd8WR4mbe32rzNGxvCwlLHf+wIJXdpBH9D/Vg3I95f2syH49nVwon6",
-	"JKkAh5ql26RopM4VpV08JkNjnxw6QOzB6qu+HJhEa9fXq4vXCGspVuKY79AoOUSbgRLwEZx1RNPsMuUp",
-	"4N7ygPf4RegWKetw97jcPYgcCDWshLHQGo2CX9BvoY7nmD9aqeX46myll259r5VqLn8ym2PHzjI/+QrQ",
-	"A38ptLEZWtySS3CNvjGoRPrGNU1LoF0XRaq2IIo0x8VpL2GXFaKs0/Tq5/3uhZv2h+aiMfUCbzEhyUFr",
-	"gdVBko7Le6Ym3/a9C35JC37J72y9006Da+om1o5cunP8Ts5Fj4HtYwcJAkwRx3DXRlG6h0FGAedD7hhJ",
-	"o5FPy8k+a8PgMBVh7INeaiHsfezmp5GSa4nSAKYjBNVqBUVIbxbsYTJKIlcquYrKWFXVvpx5J4xS12Hm",
-	"uT1J67wbPow54UfifiZkAds09PGrACFvI+sw4R5OsgJJ6UrSaqEkamIXf2wR6eo+sS20HwCQdIJ+0zNm",
-	"t97JtEvNduIGlMAL/yYxENa3/1gON8Sjbj7mPt1J/br/COGASFPCRpVdhmkIRhgwrypRbHuGJxp1VAnG",
-	"j9Iuj0hbyFr8YAcw0HWCThJcJ5e4d7X2CvZTfPOeulcZ+V57x2JH3zz3AfhFrdGC0fFsHiaub95qE9f+",
-	"3c8XVmm+Am+FygikWw2ByzkGDVFaeMOsIHeSQiyXEFtfzE0sBx3gBjr2YgLpJogsbaKphbRfPE2R0QHq",
-	"aWE8jLI0xSRoYcwm/2Zo5QoyfaRKaq6EaGtuYKpKhut/B7vsZ17W7pEhtGndc73ZqXv5HrHrV5vvYIcj",
-	"H/R6dYAd2BXUPL0GpMGUpr/5ZKIM3vdMp8YBPi87W3jETp2ld+mOtsZXpRgn/vaW6VRt6C7lNgejdZJw",
-	"sEzZjYu0b4I7PdBFfJ+UD22CKA7LIJG8H08lTKjhObyKmlwUh2j3DfAyEC8uZ/ZxPrudJ0DqNvMjHsD1",
-	"q+YCTeIZPU3JMtxx7DkS5byqtLriZeb9JcYuf62u/OWPzYN7xSd+yaQp+83XZy9fefA/zmd5CVxnjSZg",
-	"dFXYrvrdrIrqWOy/Sijbt1d0kqYo2vwmI3PsY3GNmb17yqZBVZjWfyY6it7nYpl2eD/I+7yrDy1xj8sP",
-	"VI3HT2vzJIefrpMPv+KiDMbGAO2IczoublppoSRXiAe4tbNQ5POV3Sm7GZzu9OloqesAT8K5fsTUlOkX",
-	"h/SJK5EVeecffufS0zdKd5i/j0xMOg/9emKVE7IJjyO+2qGAZ1+YOmEkeP1t9Td3Gh8+jI/aw4dz9rfS",
-	"f4gAxN8X/nd8Xzx8mLQeJtVYjkmglkryDTxooixGN+LTPsAlXE+7oM+uNo1kqcbJsKFQ8gIK6L722LvW",
-	"wuOz8L8UUIL76WTKIz3edEJ3DMyUE3QxFonYOJluqGaoYUr2faoxCNaRFjJ7X5KBjLHDIyTrDRowM1OK",
-	"PO3aIRfGsVdJzpSuMcPGI9paN2ItRnxzZS2isVyzKTlTe0BGcySRaZJpW1vcLZQ/3rUU/6iBicK9apYC",
-	"NN5rvasuPA5w1IFAmtaL+YHJTtUOfxs9yB57U9AF7VOC7LXfvWhsSmGhqapHR3qAxzMOGPce721PH56a",
-	"KZpt3XXBnPaOmVI7PjA6b6wbmSNZC16YbKnVL5A2hKD9KJEIIxg+Bap5fwGZ8tzrs5TGqNyWtG9nP7Td",
-	"09/GYxt/67dwWHRTdu0ml2n6VB+3kTd59Jp0umaP5LFHWOxh0A0NGGEteLwiZ1gsgxK8j7ik80RZIDoR",
-	"ZulTGcdyntL47an0MA/iX0t+veCpGjHuLeRgira34ydlFQudwwaYJscBzc4iD+6mraBMchXo1gYxzEp7",
-	"w3cNTTv5RdM+YJCi4qfLnNwUSqMSw9Tymksqo+76Eb/yvQ2QCd71ulYa80CatEtXAbnYJNWx7969LfKh",
-	"+04hVoIqhNcGohLUfiBGySaRinwZ7yZzh0fN+ZI9mkd18P1uFOJKGLEoAVs8phYLbvC6bMzhTRe3PJB2",
-	"bbD5kwnN17UsNBR2bQixRrHm7YlCXuOYuAB7DSDZI2z3+Et2H10yjbiCBw6LXgiaPXv8JTrU0B+PUres",
-	"r/C+j2UXyLODs3aajtEnlcZwTNKPmva+XmqAX2D8dthzmqjrlLOELf2FcvgsbbjkK0jHZ2wOwER9cTfR",
-	"nN/DiyRrABir1Y4Jm54fLHf8aSTm27E/AoPlarMRduMd94zaOHpq60vTpGE4LEQW6kUFuMJH9H+tgvtf",
-	"T9f1iZ8xfDMSs4Veyj+gjTZG65xxSv5ZitYzPRQsZechtzAW0GrqZhFu3Fxu6ShLoqP6klVaSIv6j9ou",
-	"sz+5Z7HmuWN/J2PgZosvniYKUXVrtcjjAP/keNdgQF+lUa9HyD7ILL4vuy+VzDaOoxQP2hwL0akcddRN",
-	"u2SO+YXuH3qq5OtGyUbJre6QG4849a0IT+4Z8Jak2KznKHo8emWfnDJrnSYPXrsd+un1Sy9lbJROFQxo",
-	"j7uXODRYLeAKI+bSm+TGvOVe6HLSLtwG+t/W/ymInJFYFs5y8iEQWTT3Bcs7Kf7n79vM52hYpUjEng5Q",
-	"6YS20+vtPrG34XFat779lhzG8NsI5iajDUcZYmXE+57c65s+v4W/UB8k2vOOwvHx35h2b3CU4x8+RKAf",
-	"Ppx7MfhvT7qfib0/fJhOQJxUublfWyzc5kWMfVN7+JVKKMBC1cLGocjnR0goIMcuKffBMcGFH2rOuhXi",
-	"Pr0UcTfxXWlv0/QpePfuLX4JeMA/+oj4jZklbmAbpTB+2LsVMpMkUzTfIz93zr5S26mE07uDAvH8E6Bo",
-	"BCUT1XO4kkEF0KS5/qC/SESjbtQFlMo9MuOiQLE+//eDZ7f4+R5s16Isfm5zu/UuEs1lvk56CS9cx7+S",
-	"jN65golVJuuMrLmUUCaHo7ftX8MbOPFK/7uaOs9GyIlt+xVoabm9xbWAd8EMQIUJHXqFLd0EMVa7abOa",
-	"tAzlShUM52mLWrTMcVjKOVVCMxHfjMNuauv9VjEW3CccWooS3TDTdmNsmWluRxJoYb3zUF/IjYPlxw2p",
-	"GWh00IyLDV7Mhm+qEvBkXoHmK+yqJPS6Ywo1HDmqWMFM5T5hS0xYoZittWRquYyWAdIKDeVuzipuDA3y",
-	"yC0Ltjj37NnjR4+Sai/EzoSVEhbDMn9sl/L4FJvQF19kiUoBHAXsYVg/thR1zMYOCcfXlPxHDcameCp+",
-	"oMhVtJK6W5vqSTa1T0/Yt5j5yBFxJ9U9qitDEuFuQs26KhUv5pjc+M3XZy8ZzUp9qIQ81bNcobauS/5J",
-	"88r0BKMhs9NI5pzp4+xP5eFWbWzWlJ9M5SZ0LdoCmaLnc4N6vBg7J+wFqVCbAv40CcMU2XoDRVTtkh7x",
-	"SBzuP9byfI26yY4ENM4rpxdiDeystdxE0YdN9SNk2A5uX4uVSrHOmbJr0NfCAEbkwxV00yE2uUG9bjyk",
-	"R+wuT9dSEqWcHCGMNrWOjkV7AI4k2eBUkISsh/gjNVNUj/nYurQX2Csdi9Erctuz+ofkeiHFNvveGxdy",
-	"LpUUOZZCSEnSmLptmplyQtWItH3RzPwJTRyuZGndJhbYY3G02G5ghB5xQ5N/9NVtKlEH/Wlh60uurcAa",
-	"z9mgmIdK194gJqQBX83KEVHMJ5VOODUlAyEaB4ojyQizMo1oOL9x337w+m9MinEpJGq6PNr8+4xMVqUR",
-	"aJmWTFi2UmD8errRPOat63OCWRoL2L4/ealWIr8QKxyD3OjcsslndDjUWfAg9R6bru1z19bnzm9+7riD",
-	"0aRnVeUnHa+DnhQk7VaOIjjltxQcSSLkNuPHo+0ht72u33ifOkKDK/Ragwrv4QFhNLW0u6N87d6WRFHY",
-	"glFEZTKBrpAJMF4KGUyo6QsiT14JuDF4Xkf6mVxzS2+HSTztDfByJAACI5TJBn/bofqVAxxKcI1hjvFt",
-	"bMuAjzCOpkEr8XO5Y+FQOOqOhInnvGxcpxNFvVGq8kJUgcFFvTLfKcbhGHcWQiY76DoYvtd0x2ocx95E",
-	"YzkKF3WxApvxokiltvoKvzL8GoLEYAt53RShaqIDuznKh9TmJ8qVNPVmz1yhwS2ni+rmJ6ghrt0fdhgz",
-	"7Sx2+G+qAtP4znin6aOjcoOHdHFcYv5hlHFK6nU0nRmxyqZjAu+U26OjnfpmhN72v1NKD+G6/xTRuD0u",
-	"F+9Rir997S6OOHHvwD+drpYmry76giv8HhIeNRkhu1wJr7JBnTH0esDNS2xZD/jQMAn4FS9HIuFjWwnd",
-	"r2Q/GIuHz0fTN3Dr03NZzvayoNGUR+Qr3LO+DE2IY/7B5B58d1YLv9a9CB233X3XsdSRj1jLLEYtdDcz",
-	"orUbfKwV7bursRQJoU4Hfo/rgXgvnrlPAw9XQtXB+yr4QIcnIf3qU/B06n6MrD8ZWfBbWy1GbSxvfP1a",
-	"WqZ/k3/3M1lhGUird/8EFpfBpveLyiSkXVJPtU1YU/pwUinEzq04pYZNqlyKlw2DroxYS4eWBuVnBmT1",
-	"Yoo4MMDHx/nsvDjqwkyV3JnRKKlj91Ks1hYz9v8FeAH61YGKBG0VAjxilTKirUBausF8Ctg1DncyNdjA",
-	"EbCIKyoMxwpOqFeQWyw72zrXaYBj6iu4yYLR54/KBOPP6SYmwxck2FeFYFhr9sAdP0icFCX/ojqdJ9Nz",
-	"7p81LtQUAXbNTZuupRczPTlyc7mEHLMi701U9V9rkFESpHnQyyAsyyhvlWjimDCv9/FaxxagfXmk9sIT",
-	"1de5NThjceyXsLtnWIcakoVDmyC+myQORgyQCSzkkB5TJHuvMWEaykAsBJdgn4q5LY4xmvM5Srt2w7kC",
-	"SbqLo03FtmfKdNHzSXO5rkelfcSQnLFcVsOayePvjxdYotp4BzneJB6OX+nsfFg459onLsa0Yo3tJKQw",
-	"BhN+CzkEaZZSXPr6AYgVslRdc12EFneSFIruJpEGetnMLNoAjqGTQ6IUA8ZC5aVyYkQ2FlDWjZloHA7v",
-	"GfIMbRP4IFxL0BqKxiRSKgOZVSHgYx8c+1BB7q83QoIZLX9EwI2mvn7d5vbGMnAcU11z7/UaL5Bp2HAH",
-	"nY4ycI/PuQ/Zz+l7CMIPZcAOapgaej1cjzaE7ggzQGJM9Uvmb8vDwf03UTYJKUFnwfLUT8ctuxnZMO9m",
-	"Ued0QccHo1HITc6ds4eVJPU0+XCVvTdCFCR/CbtTegSFQr5hB2OgSXIi0KOEo71NvlP1m0nBvboT8H7b",
-	"PHKVUmU2Yuw4H+YQ71P8pcgvAXMANi7uIzXa2X3UsTfW7Ov1LuTMriqQUDw4YexMUlBRMGx3ywv2Jpf3",
-	"7L75tzhrUVNaf69UO3kn09EZmHBf35KbhWH28zADjtXdcioa5ECG6q0cc7m5xuT83SqeJ1Nf5UNTc7+K",
-	"fEtUBEVKJrkgi9VzPOgpxRGmQIhydaAhkzNv6WKmVClf3pukaXBDpTEVT4YAWZBTsgU0UPjBkwhI1kVP",
-	"nEJKfeeT3qkl09AakW+a/W9Ywj31ou/P3MzS5XdLpaFTjN31pkyfTeALptHE/yyE1VzvbpKjb1BCfqA9",
-	"GcXyQXesxhOrXUjrjTXEYVmq6wyZVdbUuUg9bV07072MQ9G1tp871QuI/Lq48YLajq15wXKlNeRxj3S8",
-	"J0G1URqyUqGbV8oCvbRO7t5gkJdkpVoxVeWqAKoXk6agsblqKTmKTRB51SRRQLSD0cLUJ6LjiVO6O5Xs",
-	"SBmKWqsjaufnQJHrbVYnWnRGtswRj2UwPouTxxA1HsK7p/Z/mjcvxRbpBnTqyC+Z1TXMmW/Rr5HtDz7X",
-	"wDbCGAKloaVrUZYYOC62keW1cVxIo3ZE7D1Ht8orgb433SQCJA1X7s5rMivEPOAiTnvE7FqrerWOEkw3",
-	"cIYnr679gzge5SdTo3sURpC5KZ6yjTLWvzRppHbJrcvZ/VxJq1VZdpVSJKKvvKb9e749y3P7UqnLBc8v",
-	"H+C7VirbrLSYh/jqvnNgO5PupRbrXsAZlTM/nKqX2qGrnCfayQyyx+KOLuwegfn+MAc9rHM/Gy6sv64u",
-	"M00/Y84k41ZtRJ4+U78vb7tRH7kUi0rmLKPaipRlApvhYY8vq8a5AlnkEM0gebI43BnzjMAbmZHduP+i",
-	"BN4fly3BM5qRi3LIXLwUleWjsl4PAISUQp9trakgYyyJNVxFrShVAprI+4BOvFXQE+l2sLkR7hwoC7cC",
-	"auD92AB4n5QPc8otR56UC7UN3x+0yeduBPzH/VTeYR5jLl4XLWlpcvIKiWpGOEI6xfVef6g3GPa+mOoV",
-	"1RTPnXjDRwCM+0l1YJjkLXUsGEsuSiiyVO3F80ZHNY9e2j40q18SXRjPyXNeh9KHbuxag0+cQiK+7tq/",
-	"Ku5ISTXNh5pkWcAWKK7jF9CKahrOI/sLlFTysKcMUFVWwhV03Md8NpcaRU1xBaGvaTqzAqBCa2RfR5by",
-	"i4rv8p7ixK89izxrpmA3qUkhxNJOsQNqkqRSZyszOiZm6lFyEF2JouYd/JljRY6uGtAd5QSqBm+ELLwj",
-	"p07zE43wOgxwFvqnRJmAiffT+NDRLCiNun0M6KCfZG3GTr1Mu0nGqYoaAwvOVjSGWCLxlm+Yil/LcYXk",
-	"kOTb59bEfRJKRoj9egs5SjX+vQOFf/GMGCl81hOkdglQ0KvAdUlo29cgmVRRiclrbpqnSptDMfxAE2Mj",
-	"If1r+gZG5dab8fY7y3AwZnrJ1EYfErqh05ur53+Tk7j3II6Ol6IRAz78b4/+K1C3f3ZgAyzlLd1+Otkf",
-	"izT6W8xz8Tlb1GGgslTXVDMyfoe+gGAHJeoLJiAvlovmWg5em3Of3rOv6hCRv/qG75jS+I97df6j5qVY",
-	"7pDPEPihGzNr7kjIG17JI8B7gbqJ94tX8wBY0LaoMBWtW0wdMxpu50aJgHYXeSjuo9iGX0K8DejsQPwz",
-	"t45xmnqBmgt3Zfe2c4gFv/iQomXDi/ilj4kiu2XUQ+pg1/v/a2Ph4qlCfreq5HmoEOpLFHX5DFYBDsRl",
-	"17DZHyw55GuBBJrKwi3R6hBdX9xAZXok60pFIIyVX+mAPai4Oqg8c6tlTNT89mps7AkznbSUu96FqV43",
-	"A6DjOo2HwI/LVn4a/CdzuI4tYwr4/yx4HylUG8NLNWk/AZY7GTgSsJK2eqG2mYalOeRgQupq95zXbe6O",
-	"oGIVMtfADXncnP/oH55tilIh3UOYfEIbm2YzSgFLIVtmKWRV28Q7BjOVyl2EsFjpj2gdMaGNSQlOmLzi",
-	"5Y9XoLUoxjbOnQ4q6RiXiAiGDt83ocJo7tThAMK0bziMz2zV6HEzd4FTESpy1zSWy4LrIm4uJMtBu3uf",
-	"XfOdublFqTEOHLIp8Uia6WYNiKxLSNoESLnzRuFb2nsaAPkdGn4mGGzQLzhhrCHVjlUj9pkhDL8Lg82G",
-	"b7NSrTCKcORA+Ny0aOGjJ6CSqAYn+WzausM8RvwC+6fBtPyeEVmFs06ZYv+5/xG3Ep+RP0lh95580lH2",
-	"wzrJ75YOZkCqXLXO/0Qsw/OYisT1yVfiaNwgbIZQlUB7EG0ijNiHunrxkV1ENwgfxh0rwaeXO+t6WqTi",
-	"fUkzkKHGwOxx7wfTurLz3LtnDVVpA1UDIWXuo6WP1LSRfj7cSyPgUW16f9a70zYuM26cY2rE7Y+PzipV",
-	"ZfkUn0+q3FF4M4GHtAvjCH1ERoCRdTfuMaapZdPJe9QpanNsmbzRojqHrF1Vvu/RP6YmGuHoXROEWiIv",
-	"o8rtqN3CSJ5GmTLvx5h11WANk2CcachrjWria747XHZsJGP0xV/OPn/85K9PPv+CuQasECswbdbxXtmu",
-	"1i9QyL7e59N6Ag6WZ9ObELIPEOKC/TEEVTWb4s8acVvTphQdFC07Rr+cuAASxzFRLupGe4XjtK79/1zb",
-	"lVrkne9YCgW//p5pVZbpqg+NXJUwoKR2KzKhuBdIBdoIYx0j7FpAhW09os0a1YOY+/eKsskomUPQH3sq",
-	"EHbE5Sq1kDGHWuRnGNvtrUYMtlXpeRVZevaty7/TSEOHQiN6xSyAVaryor1YshREGEGko8har/hEjXjk",
-	"I9swW/KWTRGi9zxPk15cMHs/t+8Wc7VpTu82MSFehEN5A9Ics0+M5y24CSdpVfv/NPwjkYjhzrhGs9xf",
-	"g1ck3wc3K8o/CbRhUH6CPBCAkWjbTpxkFCgWJSLWZCVAe0IwIPfFj+9bw/LBsBCEJHQ4AF4cPtu2ayIZ",
-	"PDi/cUbf7xukREt5P0YJneUfisgNrLe5SKIt8koTa8EQW1JDsTAKtzbPmyjmkVfJINhZK2WZe5mWZSJI",
-	"mvQ4eKZiwnFPAn3Fy0/PNb4R2tgzxAcUr8dDo+JI2RjJhEpzszx9L/mkuaOo2LubWr7CwOz/ArdHyXvO",
-	"D+WN8IPbDJU7WLF+FW4FivVm1zgmOVk9/oItfLGNSkMuTN+4fx2EkyYwFLRYeodW2NoDkaiH1vmzsrcg",
-	"42XwxGE/ROatxmbvIWyP6G/MVEZObpLKU9Q3IIsE/lI8Ki7Oe+C6uGVhhpulfYkSuB2Z9mVYdnjq8ii1",
-	"ibt0agPDdU6+rTu4TVzU7dqm5iyaXN/h3bu3djEl1VC6FoPrjrmO7qQow1ElGX6FLEeEIz+GnzdFMT+P",
-	"5b2l3K4jubl7+1GL8qDDSifT+sf5bAUSjDCYS/yvvnbMp71LAwSUeWF4VAnW26SLIcQk1tqZPJoqyqE+",
-	"IX2675bIeY1RjXmthd1h3eCgQBN/TeZj+rbJ7eFzwzS2NH/3WXUJTe32NhNIbcLt+q3iJd5HZOKT7hZS",
-	"5Qn7mjJ8+4Py53uLf4fP/vS0ePTZ439f/OnR549yePr5l48e8S+f8sdffvYYnvzp86eP4PHyiy8XT4on",
-	"T58snj55+sXnX+afPX28ePrFl/9+z/EhBzIBGlL7P5v9r+ysXKns7NV59sYB2+KEV+I7cHuDb+WlwrqW",
-	"Dqk5nkTYcFHOnoWf/v9wwk5ytWmHD7/OfH2m2drayjw7Pb2+vj6Ju5yuMPQ/s6rO16dhHqw22JFXXp03",
-	"Pvrkh4M72mqPcVM9KZzht9dfX7xhZ6/OT1qCmT2bPTp5dPLYl7aWvBKzZ7PP8Cc8PWvc91PMr3lqfOr8",
-	"0zZWK2m3e40u60E41yso2P0m6ubfGsuteRCCd5aixCvj74aIsVnFeYHE5WuUzrDqGjpjIVhPHj0Ke+El",
-	"nejCOcXoj2cfZm1t+74wMUDqmxbgJGRtzcfhon+Sl1JdS4bJAOkA1ZsN1ztaQQcb0eC4TXxlUMmuxRW3",
-	"MHvvevdxXlW+YMEYyrHKVfeUh85IIE3Ge3fCKBG+LztgUigfFku4Jfb3JoccTJbYHWz0ysEc0uc0CRW9",
-	"QcjjDG3GhLDmjJDaYYDo+ayqE+j8GgNrzD6czaMk/ASNKosG4wOMvqr/H8GoI11/N82efXB/rYGXmFjL",
-	"/bFxhJqHTxp4sfP/N9d8tQJ94tfpfrp6chpeIacffMaUj/u+ncYeYacfOolligM9g8fToSanH0LJ7P0D",
-	"dsole1/TqMNEQPc1O11gmaypTSFe3fhSkObN6Qd8gI/+fuq1qCMf6XId+4x6EmpzGvI3jbSkTB3pjx0M",
-	"f7Bbt879w7k20Xg5t/m6rk4/4H+QqqMFU+LfU7uVp+hXcvqhgyf/eYCn7u9t97jF1UYVEIBTyyWVId/3",
-	"+fQD/RtNBNsKtHCPVEy25X+lpIinWI1yN/x5J/Pkj8N1dBLCHbjrMdmgCc5W3Txyyduln5zO3JYXTkt1",
-	"00+JN5S/h4LWvpV9nM+e3iHT7iYSTgDzFS9YyHuAcz/+dHOfS3L6dpInScgIwdNPB0Fn+9h3sGM/KMu+",
-	"QVXOx/ns80+5E+fSPSx5GeS9G0qG045P/5Z1onnTTK5IjlGUC6N71M6KYkD09MQEY79SePmOYWxjVpU3",
-	"4rZIa1/YQrolzKdJ1cPskpQDLcgZUhUwi9++Vtfw8ZY8oef+xbU9T2ic0XSCcSDLUL8/AjWZKrHvHEMj",
-	"D7Ujh0j4/EWYtA2f+IOn/MFTGp7y+aPPPt30F6CvRA7sDWwqpbkW5Y79JJu4nBvzuLOiSOaX7R79gzxu",
-	"PttmuSpgBTLzDCxbqGLnC/bMOhNcAinTBoLMaVA+dR4UI9wzqLVS0krrLT579jblNeGjH6t6UYqckeId",
-	"NU8Vt+tIMdQk/Owyv/kexcU8kVSeFaKsm2QP9lr5YOrhhRIpY6xi5h8aLx48iMLu2LWQhbp+cBLA/UcN",
-	"yOc9vGGaWQLAyAV4WD+ptSc6AAdgjc2Hhsgp2Nkz+Ut+s7lLfuzU739tFVWTUO8/L378IQpWJEUE+Qth",
-	"qByRLsY1aIX++tccHUapzuJzUhGVOwy6tdzWplPi7eSPe+gP3n973v9tk2GZirtZrNo0ZEnRXXAySeBN",
-	"8vYPnT+9WmNG3tqpvMjud8bZCgtzDi+oxY6dvxi8Xqlb/0r4aodNe7dCgt/3QTyK8Y+wl30ijVvIStnG",
-	"Z50W9YeQ+YeQeauH6+TDM+XtmtQsUblcPniPzUPl205cEOY2Rw+wAShT9E+/6fG9k40f6rZSuizKwQ4F",
-	"iz5Q8oo+mv9gEX+wiNuxiG8hcRjx1HqmkSC643RdUxkGZloqOh6YQeoIzeuS6yhe+JAK+wxHTD8FfxWu",
-	"8akVdklckb4OwwoE+dMmNvBudXh/sLw/WN7vh+WdHWY0XcHk1lqvS9hteNXousy6toW6jgzoCAv5wg9t",
-	"fPTw7/99es2FzZZK+4o+fGlBDztb4OWpL9/d+7WtmDn4gmVAox/jXHXJX09512jZtas71jvWcWB0T331",
-	"huORRiHFQvjcuvTFLnLI9hvnuLfvHcs2oK/CjdB6fD07PcWcO2tl7Ons4/xDzxss/vi+IY8PzT3iyeQj",
-	"0oXSYiUkLzPvOpG1Xl1PTh7NPv7fAAAA///tCFetNB4BAA==",
+	"H4sIAAAAAAAC/+y9+5PbttIo+K+gdG9VYl9pxnacfF+8deruxM7DN07isp3c/TbOngORLQlnKIAHAGdG",
+	"8fp/3+rGgyAJStSM4uTU+id7RDwajUaj0c93s0JtayVBWjN78m5Wc823YEHTX7woVCPtQpT4Vwmm0KK2",
+	"QsnZk/CNGauFXM/mM4G/1txuZvOZ5FuYPUn7z2ca/tUIDeXsidUNzGem2MCW48B2V2PrONLNYq0WfogL",
+	"N8TzZ7P3ez7wstRgzBDKn2S1Y0IWVVMCs5pLwwv8ZNi1sBtmN8Iw35kJyZQEplbMbjqN2UpAVZqzsMh/",
+	"NaB3ySr95ONLet+CuNCqgiGcT9V2KSQEqCACFTeEWcVKWFGjDbcMZ0BYQ0OrmAGuiw1bKX0AVAdECi/I",
+	"Zjt78uvMgCxB024VIK7ovysN8DssLNdrsLPf5rnFrSzohRXbzNKee+xrME1lDaO2tMa1uALJsNcZ+6Ex",
+	"li2BccleffOUffbZZ1/iQrbcWig9kY2uqp09XZPrPnsyK7mF8HlIa7xaK81luYjtX33zlOZ/7Rc4tRU3",
+	"BvKH5QK/sOfPxhYQOmZISEgLa9qHDvVjj8yhaH9ewkppmLgnrvFJNyWd/0/dlYLbYlMrIW1mXxh9Ze5z",
+	"locl3ffxsAhAp32NmNI46K8PFl/+9u7h/OGD9//t14vF/+3//Pyz9xOX/zSOewAD2YZFozXIYrdYa+B0",
+	"WjZcDvHxytOD2aimKtmGX9Hm8y2xet+XYV/HOq941SCdiEKri2qtDOOejEpY8aayLEzMGlmBMTSap3Ym",
+	"DKu1uhIllHMmJLveiGLDCm7cENSOXYuqQhpsDJRjtJZf3Z7D9D5FCcJ1K3zQgv66yGjXdQATcEPcYFFU",
+	"ysDCqgPXU7hxuCxZeqG0d5U57rJibzbAaHL84C5bwp1Emq6qHbO0ryXjhnEWrqY5Eyu2Uw27ps2pxCX1",
+	"96tBrG0ZIo02p3OP4uEdQ98AGRnkLZWqgEtCXjh3Q5TJlVg3Ggy73oDd+DtPg6mVNMDU8p9QWNz2//X6",
+	"px+Z0uwHMIav4SUvLhnIQpVQnrHnKyaVTUjD0xLhEHuOrcPDlbvk/2kU0sTWrGteXOZv9EpsRWZVP/Ab",
+	"sW22TDbbJWjc0nCFWMU02EbLMYDciAdIcctvhpO+0Y0saP/baTuyHFKbMHXFd4SwLb/524O5B8cwXlWs",
+	"BlkKuWb2Ro7KcTj3YfAWWjWynCDmWNzT5GI1NRRiJaBkcZQ9kPhpDsEj5HHwtMJXAo6QB8ARcho4Em4y",
+	"NIOnG7+wmq8hIZkz9rNnbvTVqkuQkdDZckefag1XQjUmdhqBkabeL4FLZWFRa1iJDI299uhABuPaeA68",
+	"9TJQoaTlQkLJhHRAKwuOWY3ClEy4/70zvMWX3MAXj2fvD32duPsr1d/1vTs+abep0cIdyczViV/9gc1L",
+	"Vp3+E96H6dxGrBfu58FGivUbvG1WoqKb6J+4fwENjSEm0EFEuJuMWEtuGw1P3sr7+BdbsNeWy5LrEn/Z",
+	"up9+aCorXos1/lS5n16otShei/UIMiOs2QcXddu6f3C8PDu2N9l3xQulLps6XVDRebgud+z5s7FNdmMe",
+	"S5gX8bWbPjze3ITHyLE97E3cyBEgR3FXc2x4CTsNCC0vVvTPzYroia/07/hPXVfY29arHGqRjv2VTOoD",
+	"r1a4qOtKFByR+Mp/xq/IBMA9JHjb4pwu1CfvEhBrrWrQVrhBeV0vKlXwamEstzTSf9ewmj2Z/bfzVv9y",
+	"7rqb82TyF9jrNXVCkdWJQQte10eM8RJFH7OHWSCDpk/EJhzbI6FJSLeJSErCMA0VXHFpz2bz3JlsD/Cv",
+	"fqYW307acfjuPcFGEc5cwyUYJwG7hp8YlqCeEVoZoZUE0nWllvGHTy/qusUgfb+oa4cPkh5BkGAGN8JY",
+	"c4+Wz9uTlM7z/NkZ+zYdm0RxheqlJXhRA++Glb+1/C0WdUt+De2InxhG24nKmvfzDBrM8xb7p6FB0yfC",
+	"PjNpUemVYMkExivLHJy4IFVbfxsqYjEWtubWpO3phGvNdzMvSixIJBgC+rMBR6o1XwtJg81RyJZsyy+R",
+	"wXOpaF+RIsFE6dmtgAZtFW1eMvGvg7PBa/zf5tjk6CVILYZxVglj6Q2WPz2GvsmwwR2iNAbsKUiQ3rob",
+	"VaEofpBSsPF3vm3K+/D3SZ3/PfheittxjoetmMece3jTL8mL+9MeOxtyM6+DPGMX/b6342U4yggXw0+n",
+	"5l8p8dAv0xhOAlFCTR/Zze2pdTKjocZsAxW95vK85ak71n/cvZfITBn70Mv2EOSuPN/ZPUbT24+eV7T3",
+	"dR1kjpJbfpuL8CM93poep1HPgVuws837qfQP4Gude20ahVLLMdqcToE4zEfauzPt3YIlHqS3W9HVhGtw",
+	"z3Ii2Nea1+6G91+chkRIUr27Rg7WtQbYgrRvxBYqIeEEp4F2IXMKwhTxEGjw2jjXY85UVSK9rYQ2R5yB",
+	"sATSVoVJhociRytmGrGEGZj1o8clbBVppgr86EdErN5R8zD50snA2n5ODxJBdesnwAQWlIEEP/Rh+KpS",
+	"xeV33GxOQGfLMNaQ1GgatgFegmYbbjYZxtSjh3a0KSSBDYkZsGUy1Vm7RPr7ZIuk0Q4sM4guXdjz6rwE",
+	"xhFEuG9TUPFVFgEv1NqcYPmVOuadUNdPeVXh1AePPw086fBXFcPGDLbC2tZ04HwsnAaefc2LDYmRBa+q",
+	"eWssVPWigiuomNJMSAl6jrZm214rNHLQbBN7NoC3qQWWrMYbGsnIqqM1SgPbcnrubVGfXVfdPvGKNnwL",
+	"PT0YPT9VQ3akRNX8/FlYHVyBpNsuDk3gxzWSvS4d/IxdxE80s1Rucc4GHMTtFn/xGuoAja3bx6tsp1C6",
+	"dF4LFn8TmhVKuyGiCI+T43+A67azO56f1hoWfgjNr0AbXuHqeou6F8n3VCf3jzqz81kBOmOn+4n+wyuG",
+	"n1FlQIrASD2CXv4qcagrneSHqHIzYQOyuCu2dcZshhbmo6B82k6eZy+TTt7Xzn7ut9AvIu7QmxtRmlNt",
+	"Ew02tlfdE2I6gslA2N3LdJK5piDgjaqZYx89EBynoNEcQtTNye/1r9RNlturm8Gdrm7gJDuhbtx/JjH7",
+	"r9TNMw+Z0h+fQJHECInzI55CuKNoqIvK465Wo3VFu1gqfTv5sXejStY62DGOoyaPknn/bY1Nm3rhmVHG",
+	"Scc16A3U+jTvF/v6w+cw1sHCa8v/ACwYyxPg74CF7kCnxoLa1qI6xRtxkxXb0SXis0fs9XcXnz989PdH",
+	"n3+BJFlrtdZ8y5Y7C4Z96i3RzNhdBfeyh43EqfzoXzwOblndcXPjGNXoAra8Hg7l3L3cE9A1Y9huiLUu",
+	"mmnVEcBJVwDgXe7QzpwnI4L2DJbN+jVYi3r0l1qtTs7+BzPkoKNGL2uNkpTpusZ58fC8xCbncGM1P6+p",
+	"JciSaJ7WIQw3BrbLkxDV2MaX7Swl8xgt4eChOHab2ml26VbpnW5OoWQErZXOyhy1VlYVqlqgYCtU5r57",
+	"6Vsw3yJsV93/3UHLrrlhODdpuBpZjlxr6Ik3+cJ2Q7+5kS1u9gpLbr2Z1fl5p+xLF/nts6sGvbA3khF1",
+	"dm7blVZbxllJHUm4+hasEzjFFl5bvq1/Wq1OY0tVNFBeUWZwJuZaMCGZgUJJF79yQALwo05BTx8xwbHK",
+	"jgPgMfJ6JwvSt51KX5gXjrZCkquq2ckikZQQxgrKNegJ+JguCY2hw031icmAg+j4DnhlN083UFyak/Ph",
+	"dPCsIiozeY4Zf/Lt12/Y+YZanxfU/BME/gWt7Wtp9e6lVmp1gv2kd8kif8Pjpm687qx9nvs/Enkoeja2",
+	"7pI7CzketC80BGer+BKqMEPFjU2mcV7rNa6bFVxG87nVjUHOp2ThRX43ijCMUAclPY9xHBdutvSKbM89",
+	"Qn8nGsxJ22IV3uNbYcPVqJWyjFdKrtunhHt0JHhoo9YGCwfcs5Fj47zCo6gTTJ5KI00QUKR6mDN/mJbq",
+	"xoU+zKO7AEhmtQB2CTtc97IRlaX1TdoVJSshYeEnNhOpwfUK4Bq2EcYqvesTh9sMh03DrJqz30Er4gz0",
+	"yTg9Ua2h5BaYsMeA7Dxa6Tl3JNjU0z0EPzDoRMF5OA1owSvxO5TsB9CXpMgrqoYue+rWxkq6zZ40IdLu",
+	"2HNW2Q5yksVvHQA0E/dn3BHckldcFmBc9wM4mwjh6KUSycttWJ41zONzHBidtBDcA5Lx7EN8PiMfrAVh",
+	"FYUVpwETSk4kI+ruNyXtTkf1wxCSVZZX5jim4vowTvqDqXw7e0d7ugqsLdD1vBuPl1wvEeBDuB9hSHsO",
+	"/RR54WU4P9yLCJ5S+JoLaewe8j9rr17ynnwGleXfKP2m1fN9q1VTn1yc6M85VQyKK3REWmLf4Jwm5Lrq",
+	"xlqvEfbsGv+UBT2N1ha3BoKeJNkXYr2xiWL99iLQXhhzsxwiKOwzNC7+qEp8hNjGnEAF1Q7WvoyQZtP3",
+	"EF+qxjLOpCodh2pMXjm1RwRz0Ywpd3XMCcUKQOoqeIOrbWpGsXp7ZLwFLxzXWBBqRlhVG2LmWrnpXORn",
+	"pYGXKOWBbOU2H6hEi+QUaGg791dTZxl+AletVQHGQBlZzCHQQjsnNNo9eCLACeA4CzOKrbi+M7CXVwfh",
+	"vITdgmRDwz79/hdz70+Al7j8AcRSmxx6+4bHIdTTpt9HcP3JU7JzJk1Htf4VUFdgYQyFR+FkdP/6EA12",
+	"8e5ocdfsH0zxYZK7EVAE9Q+m97tC29QjyR68eh81Q7hhkksVFDK5wVCYXRx+GXckXmZwBQknzHFiGnhE",
+	"tn7BjfPBYkKWZPw1rWRNfWiKcYBH1Zc48i/uY27sQkkD0jQmqjFNU9dKWyhzayDD4OhcP8JNnEutkrGj",
+	"rtQq1hg4NPIYlpLxPbKMVwfgH9wm7w4cLrM48vTHe36XRWUHiBYR+wB5HVol2E0D3kcAEaZFdHRh7VJO",
+	"jLKfz+pH9UIDLzZ8KSphM6qLl49evkoaRMykv/mThLO0B7NulpUoGCJBS7B47EqwoLfhcr9orPrx4s0T",
+	"33LOai2uyDVCs0ZeSnUtzxjlGaIMDRuQTDfSS771o5pJsNdKX2YVMcaqukY2aBeNjAgZ2//XrvWF/blt",
+	"Ozw13LbLLBUYcqHx7d0XuHYk43I4bLhhHo5gwib7lovZHG4GcpmFEbKAxb4jTTpvbJWe7YPcp6nXmpew",
+	"KKHimW3+2X1m7vO+AYiUW/2/srBwwfh5am6PaHhP7hla0XiZ2+BHxegLK5C34BunpXzf+8DIJdDYOa7r",
+	"D8gncSiaK7tFYTxa9qhPLV3zVwrNdIEeCGR/VU0BeAQPcejbo4I6L9qHfn+K/wLjJwhtbjHJDszYEtrx",
+	"j1rAiHHc5zlKzkvv3updLdn7YJQ/H+AjY0d2xFL/kmsrClHTI+572J38TdufIOs6iVyYC7S6Jh/c+7ZO",
+	"+zMXRt4f83Zv3EnGyCH4A2tkZjkhBKAL/CXsSJnw0uUnSXQ4p3ikZ0ZlwqUdQkBD1gMou+lU4IYXttrh",
+	"VWg3sGPXoIGZZumcWIcOJuiqmg6QdVjZM6P3z8t6x+11GHxNQyXLy/lyucfOfvje9F48HXT4R06tVDXB",
+	"ZDhARhaCSd7DrFa468KnQApJcAIldYD0TLvaBXD9VZGimVbA/ks1ZLBCym4sRGFNaRIUsC/NIEwyp48F",
+	"bTEEFUU1ROzcv99f+P37fs+FYSu4DnnD7t8fouP+fVJQvVTGdg7XCQyKeNyeZ64P0kTjxeeFwj5POez0",
+	"70eepOztDR4mpTNljCdcXP6dGUDvZN5MWXtKI9MCHuzNxJW/6XqID9ZN+/5abJuK21O48cAVrxbqCrQW",
+	"JRzk5H5ioeTXV7z6KXajnGhQII0WsCgok9fEseAN9nHJv3AcIYUVIefCVIDguev12nU68HZuPUHFdgul",
+	"4BaqHas1FFA6NwRhmIlLPWM0LCs2XK7pwaBVs/bOo24cYviNcTon3cjBEFmhyt7IBWnvcxeAD1TwrJ7E",
+	"KXyaDVX/7gFzzeN8UHbuhYl70DeFZL2G5rPRpzwi9ap9yjvkdHO3TbgMOvJegp924om+JYS6FbkH9PGV",
+	"bgseJtzcP8YW0Q6dg3I4cRJK2H4ciyZEPUK1O4HQ4wZiGmoNhq6oVP9m3Fe1SvM0hmCRnbGwHZooXNe/",
+	"jxy/V6PvRW8g3CoJu2xqYiHhB/qY6+2uyZHOJLCM9e2/QTrw98DqzjOFGu+KX7fbO1k8bbRR+hTXXOFG",
+	"yp9icrjyLeYuFsgpdiTfwnTWEgE+6HwYoLmL25iX3hDEsrMCnD1hbxeFFVfC7k6DRNzBfbcM8taojy3U",
+	"VcBkX4jgHiomZAk3Z+Oqxr9IiEX/cTDtGTh4XMVNmEAhCap78x8pVgWNn2qKDZRJPPm8E7entAs+n6P2",
+	"LwmU7pIT3ZZ9s7j5RulT+Wu6ASfjeIKbw0Fk+ylvexoxMHDov+AzKvYvYzOPdCcI4aoQ9H56Xpq5j9F0",
+	"Lg/e2aWL/jbXwwnuwf64PUN9mqyXDFFQ1YyzohJkplLSWN0U9q3kpC9OlpqJMAmKsXHTyNPQJG+LyZhK",
+	"/FBvpfMOilrk7AleQUZl+g1Ed1bTrNdAfpWdvP4Ab6VvJSRrpLA01xavroW7u2rQFOZx5lpi1OwKacIq",
+	"5zW1bGz3JU4JQ41FQ4vzGsBpmFq9ldyyCrix7AeBvuw4XPBIDtentxVELOR55xokGDHmYvit+0ph5n75",
+	"qauY7xxCAIfuVm3S8v/n0//5BJOV88XvDxZf/o/z3949fn/v/uDHR+//9rf/t/vTZ+//du9//vfcTgXY",
+	"RTkK+fNnXkv1/BmpIpLA6T7sH8zIiDlws0SWupr3aIt9SqmbPQHd6yqq7QbeSowjsArdZwW52t2GHPp3",
+	"y+AsutPRo5rORvQU02GtR95Ed+AyLMNkeqzxRA7muPh84ljcyJALFluxVSPdVoaXsEvbEryc1WoekwO7",
+	"uiFPGGWO3fAQgeb/fPT5F7N5zFrafp/NZ/7rbxlKFuVNLq9vCTc5vU0asv6JYTXfGRhxNR3x+I0OZOmw",
+	"W0CFn9mI+sNzCmPFMs/hQgYNr/+9kc+lC7fG80N+FDtvxVSrDw+31QAl1HaTqyfQeTRRq3Y3AXq+bc5n",
+	"eM7EGZz19a8l6m58zEAFfJWGBkxgE/EcOEJr/WUj1tOFHOXR2iPLNNjcX/6nD3XxA+fg6s85HuHiGSYG",
+	"t7wP+YbTpMAZtVbMB5d4PSI3SzN8vJVv5TNYkSZQySdvZcktP19yIwpz3hjQXzkP9rO1Yk9C0qVn3PK3",
+	"ciBpjRY6SvJFBpeGS9jlyNMVrxiO8Pbtr2hhefv2t4ED2PAp76fK8hc3wQIFYdXYhU+9v9BwzXXODm1i",
+	"6nUamXrvndUJ2apxxgo/PvPj53ne4ey3uPy6rnD5ndxw1Mm50RurYnaQJOEa7u+Pyl8Mml8HHWdjwLB/",
+	"bHn9q5D2N7Z42zx48BnlWWlT3/7DX/lIk7saTp9HlxbuVDwUSLvAJPwmu3wLvKbdJ3l5S/rGqmLUrfOW",
+	"DNHPNFS7gCQB3cgGODiOzlVFi3vteoUyS/kl0Cfawm7uyTvtV5I69NbbdSD9KG/sZoFnO7sqgyQediZW",
+	"X/GBAs7lC42qeAh8oZolhGAzKogB29ru5p3uatURNJO0zlRbxuV7oQgyMhZizZm65F4U53LXTzNvXLg3",
+	"DfoKLmH3RrXFEY7JK99Nc27GDipRat3JjXjHzJ2BLJ5Eugh9xg+yE3lPcIhzRHEoMyQhgusMIvYniDx+",
+	"oTjenUg/tzwhC5BWXMECKrEWy1xZvP89tE0HWJEqfSUgH+oQBzRorhbWhNAw/7zXaO9iFNikamV45aqc",
+	"ZR2o6D20Aa7tErjda3OTafKVAB32Z9d4spy2naIl4Qb3W1jSnqMarvSKItfGh0icjTu5OsChvCU8oXv7",
+	"Ujgbfet61GUqAIVbOWI3Pmu9Jjilszeb+J2SIK61usZ9MRQh6yjV5bNO7pfG8DWMvF1SS/rEpJcd6zsN",
+	"ckgiycog6LvTFTUGkkAWZNd4gWvOnmHAL3iI6ZnZ8/oOMzlnDW+/JWdTj7BlRQJsdI93e891x6NBrveB",
+	"lmctoGUrCgYwuhhJj+OGm3Acy3nCZSdJZ39giqN9pWKeJw7LSZGyWAgm3IZ9Djp49/uCMaFKTCgNkz76",
+	"J5R5cYGITX47lCTRtIQK1m7hrnEglDZXfLtBCMdPqxXxlkXORThRUCcCgJ8D8OVynzFnp2STR8iRcQI2",
+	"OSHRwOxHlZ5NuT4GSOlz3fMwNl0Ryd9wNhqkSoLFgspXLMSI7b8IHMAnBkzLYHTCNkIVjDlDNnfFK5Ax",
+	"TL8dZFBzgR4Uvfok3g3u3thDY4+Z2F35R62JetxqNak0G4DOi9p7IF6qm4VLn5R9iyxvlkjv2QAp7JU9",
+	"mK42zCeGchKgayVdLS4g5wAs43AEMFoAqL4Crp36jclZDph90+6Xc3NUaNinUepsyWVPJvCDU4/IlmPk",
+	"8mlSWeNWAPTUUG3tZK+WOKg+6Ionw8u8vdXmbRmzEHuaO/5jRyi7SyP4G+rHurUwvmtrnozXVfCNPkwR",
+	"kKFm6S7FWVxnAsQcVZulTw4dIPZg9WVfDsyitdOqh9cEazlWwoTMGCWHaDNQAT2CFx3RdHEJu/xbHuge",
+	"fx26Jco62j0ud/cSZ14Na2EstEaj4KP3Z6jj2ywJo6uztV7h+l4pZXO5KdJlfvAVUDQM+T0syOKWXQI2",
+	"+saQEukbbJqXQDubzVzxX1HmOS5Ni5GhpaiaPL36eb9/htP+GC8a0yzpFhPSOUsuXdagXBDBnqldnMne",
+	"Bb9wC37BT7beaacBm+LEGsmlO8e/ybnoMbB97CBDgDniGO7aKEr3MMgkq8WQOybSaOLTcrbP2jA4TGUY",
+	"+6DHaMitMXbzu5GyawnFE156jc2FxtjLKrOkqNSheC7k4lDbbnaGzPrGhqNY6U48bXd4dE8ORvty3otr",
+	"ZBRoFVhf8pbvea8kR+aYtGdRizPuBFFoKF3gSH64UqzBRLgqRQ5hv7z6hqnG1o3tzaRZO152uhq0UCPv",
+	"d/etOyLP+THktcKNkNYVujUgs3UM3gyV2gHuW6ierwFzp+Rncd+mIWfaqvoRJQ6Pca29fEXtRLMI6DzS",
+	"8N7z0y1vMjw9oZ3L3Y+SvQF91T0+rGy0C2p2hMz8YXQZH0nltAVuGv9yTQ9EDLbGpu2AIB0H7xyO7gkt",
+	"d5JvRbFw1XwpjFI1I/vj2jDfhoGxYkvPkjh9i0Hm0RZyrfXP8BkqJyigu5FWVAwkxUgMBzAuWKJQFTJ+",
+	"GDnfIMuR5Aw/S3Hj0NLDWTeav48ucmr1+xAgvd6IClpkeq13COXOA3YLvArJYLWi2vUyHO8HA/yNeZ7Q",
+	"8TdZ75OWv5qWfwcdSKSP4+r69C+OjB3ioPLRPfxvw7KQ+5923/fcIXajweDTKYPdX5y5MzRwaRHujt03",
+	"YcQcXj29jBRzwi9sJfQJgHCjTSvaNOvsyoD+UxLtYDRZzl5G22IkI6JcdXYhbsKpRJR25Gset/gDCyfU",
+	"lv7nz7Emp8aYLJQs0b4V9UPpfamsVdtk8tuIFnH1tzqoUOfnwC+nmGGPy2HcNWzzhBm1snMqwYI4Qz6f",
+	"Rcw+QcUpTLviSkiVwItNzNQc15NcISC731JaCu5my0aWFZxO2kHs+7F6Is8k4ebN2OV1Ee+syGh46HS3",
+	"k5YOe4ITVgIv85IZSev+a6RDN/u0K3fFjR23q1tf1YezFXfF6DCoZxemyNvGJ5zJxAjdw9bpT+cJ5zpA",
+	"oXGbPFoP0GdSUSwLfaXWayhDpaTgzCmTelTdBM/4+57yW2fMVcGiIlZ76l/5fA4wls0hsVUtKH5r5M3V",
+	"NnOQt5tAtbtokjVIVwgg79Og1gdyRVCLRCD4wI68fYkmG03/pueJ3Ya5u12K20kbUAEvvUHNQFjffp3S",
+	"cEM86uZjcfidKpL79T80INGUsCZRig/IYkR7yOtalDc9r8l9cX8TjQTtYCOmAtKL+cEOYKAbTZ8luLbx",
+	"J4b5mH3vHXZOBttzNCn6QvkuQh3pmxc+RaV/G3dD5AfnqjU0Tlz797+8tkrzNXgXyoUD6U5D0HKOQQN1",
+	"iGsXLhaiFKsVpK6D5jZubx3g+sctR3EXE4jsKB4vDp6fFsbDKMtTTIYWxhzKM9os3zb1g4hXQrI1t1B2",
+	"ZRNafg+7xS9oMWc1F9q0cd7eZ7KrOT5i16+238OORj74VEPADuwKuU28AqLBnJta/GSSkNhPTIoxZxvt",
+	"bOERO3WR36UTbQ2v6/3E394y6Yp6S7nLwWg9/BGWKbvxOu9Yj6cHuojvk/KhTRDlYRkkMValUwlyUM9f",
+	"RTFb6yHaxRJNgXhpObMYH3JbN/bcbeZHPIDrl/ECzeKZwiSdW3MnKuVIlPMag494tfDO/mOXv1ZX/vKn",
+	"5iE24AOb4fKU/ebrixcvPfhoFamA60U0Y4+uitrV/zar0sCtOmAYcYWDvZeOc3NINj8Wd00DBK6pSHDP",
+	"UwLlRE9cLQvtjxcCBlb5aO2DvM/Hqbgl7olXgTqGq7QOu9S5F6HCr7iogqdsgHYkspoW18YIHc0V0gHu",
+	"HOmSBCwtTspuBqc7fzpa6jrAk2iun6joW/7FIX1JOGJFPnKFn1x6+kbpDvP3Ka6ykS9/nFiFQrbD44jW",
+	"z/sZD4SpM+YEr3+s/4Gn8f799Kjdvz9n/6j8hwRA+n3pf6f3xf37Q6DdbZdnEuRiIfkW7sUUAaMb8WEf",
+	"4BKup13QF1fbKFmqcTKMFOpCWAK6rz32rrXw+Cz9LyVUgD+dTXmkp5vu0J0CM+UEvR5LaRUjJLf8BjMN",
+	"GKZkPyCYsqkhaRGz99XdnSfx8AjJZkvetwtTiSIflyCXBtmrdJGA2JhR4xFFI47YiJHAUtmIZCxsNqUa",
+	"YQ/IZI4sMk22IGKLu6Xyx7uR4l8NMEGm3JUATfda76oLjwMadSCQ5vVifmDqkwx/Fz3IHmfJoAvapwTZ",
+	"63z6LDpEhoVGL24uO2E8R4QvpzMOGPee0GNPH56aXSqWDdzK2OK8SLPqA+/+Ghid9zQdmWOtFsgWQz+X",
+	"aFiYxUqr3yHvxUfOj5mMqn4ieo5Q75xqvc9Sokd0WE86+6Htnv42Htv4O7+Fw6LpN9PNRzH5Ms2f6uM2",
+	"8jaPXpMvhDqfpUcyD5f7yLpx7SOshY5XEslJFrgQOsOlO08unWgnPUr+VCYtzLkbvz2VHub+rhYVv17y",
+	"4jL/FkKYku3tBPlYxULnsAEmJst0s7Mk/Di2Fa4kQQ26tUHkfNhu9a5x005+0bQPGOzYebq42pu8Mioz",
+	"TCOvubQQfPAdv/K9DTj/cex1rTRVSjH5eKQSCrHNqmPfvv21LIaxJ6VY40yujgjjK+vLbPiBmCvHQlRU",
+	"ClNXfBdTwHrUPF+hu048k2E3SnElDEbhUouHobaioesyGjFjF1weSLsx1PzRhOabRpYaSrsxDrFGsfj2",
+	"JCEvRtUtwV4DSPaA2j38kn3q605ewT3EoheCZk8efknRIO6PB3nL6oo3ld3Hskvi2cEimqdj58dAYyCT",
+	"9KPmzaMrDfA7jN8Oe06T6zrlLFFLf6EcPktbLvka8skFtgdgcn1pN8kXvYcXV522BGO12vlSkcP5wXLk",
+	"TyNOJMj+HBi+KOXWR50ZtUV6Cow0HLYwnCvB63h6hCt8pODNOsSu9XRdH/gZw7d5euAUYvsj2WhTtM4Z",
+	"d+VxKtH6B3mGeMaeh+pbVHI4umw43OBcuHSSJXELMVpAC2lJ/9HY1eI/8VmseWFB54sD4xCL5RePhyB/",
+	"xQ188ThW8ZTHAf7B8a6BvFizqNcjZB9kFt8XU7jJxVYgq7/XJghMTuVolGl2WjsW1Lh/6KmSL46yGCW3",
+	"pkNuPOHUdyI8uWfAO5JiXM9R9Hj0yj44ZTY6Tx68wR36+dULL2Vslc6V1GyPu5c4NFgt4ArK0U3CMe+4",
+	"F7qatAt3gf7PDd4JImciloWznH0IJBbNfZneUIr/5Ye2NiAZVl0anZ4OUOmMttPr7T5wqNxxWre+/dZF",
+	"O9G3EcxNRhuNMsTKSOg4/dz2+TP8hfoguT3vKBwf/oNpfIOTHH//PgGNekfX9B+Pup8de79/P1/JKqty",
+	"w19bLNzlRUx9c3v4lcoowL5SN44LB4cin9xvuH+jlxR+QCa49EPN2bLDXz68FHGa5CT5UMn8KcDISPwS",
+	"8EB/9BHxJzNL2sA2xH78sH+lbp751SmdJ5kyfk+CtDn7St1MJZzeHRSI58OHGOc3NAOe31O6pTXYRkso",
+	"2+uavkaFmsszEYoBUx7ro8vkEzpG9meirpDgVqvevZX1HTjovJIcGBx1Cejrajo1vFPjwl9404eGoNl8",
+	"D7YbUZW/tFnSe7ea5rLYZONtl9jx7+7B0JEHHN/OYQ3NnxKq7HDuof338CDPqAz+qabOsxVyYtservxy",
+	"e4trAe+CGYAKEyJ6ha1wghSr3QTUMcFhtVYlo3naGrQtpz6bZfbqGSyb9WuX2NC81LmkyW7YbWO9Ey1F",
+	"L/jUvStR4f9GjNjUcqG5HfH615QRaNWO6EIznc7DjQ6acbElKcFwLAxOJ/MK0FkRuyoJve6UjJxG7gQs",
+	"1PiJWlLqR8WQHTG1WiXLAGmFhmo3ZzU3xg3yAJcFNzT37MnDBw+yOjjCzoSVOiyGZf7ULuXhOTVxXzwb",
+	"dAUujwL2MKzvW4o6ZmOHhKN3upGvHK/O8VT64HJAYWcSIUrqxECWpMM9Y99SDmEk4k65D4QmlsbqlqZo",
+	"6krxck4lu9BNiLlZXR93ybASiXqN8PfIP2vrmV6qI+RIHslBO32c/UkxXQkaCtgzlm/rXJZ/bPEmNGCi",
+	"5wBESsUUO2fsmdPnmqAtdJMwKvymt1CyOJ3XKBBx4H+sdZFRVnXEsXFe2VYkHquU8dK3COysNSMleXyu",
+	"wkdi2Ai38zQA1sgS9JxRfZxrgUW4NtzCFXQLCwQwYk1sX2igu7xQx1rIsyMk41ia/Fi0B+Bo3OjhkIWs",
+	"h/hjI4pUowuYTpPuPL+mXvnAkF7doJ4LQkhTHwrHsR+8paPgUklRUIHPnFhPSdCn2Uwn1ELNGzvNzJ/Q",
+	"zOHK0Gsr8gcs+vX/NsoIPeKG/gfJV9xURx3uT4sB7mTeW4M1nrNBOScNlqjAW+eENOCLzyMRpXxS6YyH",
+	"VTYqI3pzHElGlN94RN36DX770Svj8QiySyFJ7ebRFmLvyH6GGSGR2iUTlq0VGL+ebmiR+RX7nFG9gxJu",
+	"fjt7odaieC3WNIbz6cNlOwfW4VAXwZ3Vu49i26fY1leEjD93fNPcpBd17SfNcQITd3jwCasejiE450QV",
+	"vFoS5Mbx09H2kNteP3S6T5HQsFSoiyDEe3hAGKB17rmKhUIbR1HUgrncRDmk5CM6XwgZ7Ln5C6LIXgm0",
+	"MXReR/qZQnNbbDps6JD36kg0BsXIF5enGKq3wT56si5mYY7xbXxzI33dzhHGERu0Ej+XOxYOBVJ3Ikxg",
+	"LGb0CyYhqKualmUUokqKdPLBzk4syzMOZNyLEL/ZQdfBWMLYnWrMHnsTjWX7XzblGixmks8lif6KvjL6",
+	"GiLWsM5tE0urx1DFbrWvTE4AN1GhpGm2e+YKDe44XSkMNwa2yyrjw/osfoQy7jBSGpp58N9cXfHxnfEe",
+	"3Efntwru2uVxJe6G+bpyUi/S9AIzGU/HBN0pd0dHO/XtCL3tf1JKD7HDf4nQ4B6XS/cox9++1lrptATO",
+	"wFneXS2xQg05piv6HlIHx9oKvXxJ3BHtYE6/eZkt6wEfGmYBv+LVSE651HDj7ldnzBjLLFeMJkLk1ie6",
+	"tpztZUGjyYOd43LPFDS0Z445Kztf5dOZUPxa9yJ03JD4fcds6BzWWmYxai68nUWv3eBjTXrfAa/s5ikW",
+	"HRnR8sQi1qpNZeHL3G6osytZYjJEDZaLKmQkFO4YvOw0yXl+Dcp5O506zkszUWoTcPIc2cvnzDTFhnHT",
+	"Sfxk5qwG0Mwn2FeanK/YFtacSCyrAUqOWz/zxi6BoBSlc9/iZtwXJ4/P1vnGD/aEFSgANvU8vJkXFV87",
+	"4M2clcJczvGmLS5BL5xelVGJ3SSr5NnsiKz0bzbQS0Lv4cDVzNk115KwxUV1NtWc5Oc6QGFmH4mZ44ir",
+	"iANOupZSOj8umVineHIXRKfEy7O5fdjfKmOZQeVOdifM7bYiZssOD32PotymfH81lmM0FLql7/3y1pfg",
+	"yxHVGq6EaoIHaIjDCJog96vPYd0pnDvC9rLRTX+25XTULEjnGK79Mv3Wff+L8wRhIK3e/QWsvoNN71dl",
+	"zjxyqUVyT3nN14BVjuiyOsLwlCLQuXrD/kkYVOROoujQ0qB+84Csnk15BQzw8X4+e14eJSfnalbP3Ci5",
+	"Y/cCU3tRycvvgJegXx4o6dmW8aQjVisj4iOMVThYyMlGw51NDXhCAhZpSdLhWMER/goKq3THwVcDHFOg",
+	"FCcLtt6PpT3HtWgxLsxX9NxXxnM+6+TI/x52e1fGh5nHk+z5ozkMx4pWXsQwDheFigJZTBnVy9swOXqc",
+	"cpOKq26i7VwGNJlkEZ/HVGiWMp62id9FjKWkwnjHGxtagCp+S3gqfjpwxnJpXMLuE8M61PD8WTL+IJD4",
+	"NpW3CAPO8h0SbY7Zj7wrjDCRMggLISzBdYe2uuxo0bSkbsEt5wokiRdHW8tgz5RXysIt58KuR9VNobDA",
+	"sWTwL11xlOS6HFc7PHOvLO+ky2PlrlQ5h3aGfuXpa1/5i/LyR5NpqAEGJvwWinC4WSpxmeYqdgZqrNsS",
+	"WpwkMR01QxV9DuhVnFm0QWRD36bhHrt4zKJSKEYsxoJau3Fb0en5E+O809skYgTXCrSGMlpCK2VgYVUI",
+	"OtsHxz5UGHLBvxUSzGj9cAfcaO24V21xvK0otOJUK457z/t0gUzDliN0OilhNz7nPmQ/dd9DIpBQR/+g",
+	"YjnS6+Kgm2cIHxRmgMSU6lfM35aHE4zcRscspAS9CAbnfj072c0KSYVryqZwF3R6MKIefnL+rj2sJKue",
+	"LYar7L0RkkQdl7A7d48gn7Ij7mAKtJOcHOhJxZ7eJp9U625ycK9PAt6fm8uyVqpajNg4nw+L8PUp/lKg",
+	"rxhTTRtm43UZaUuchH1KprXoxHK92YWic3UNEsp7Z4xdSBfYGPxZ0jKAg8nlJ3bf/Dc0a9m4uphel372",
+	"VuYjxKhipb4jNwvD7OdhrqrDHadyg+yfyN7IMU+7a6puCWWK07Opr/Khh0k/YW5LVA6KnEzy2hmqn9JB",
+	"zymOKA1Lki+I/Bc48wZuZiqViye4TaoYHCqPqXQyAsiCnJKxJELhB88iwDvvHUhL6j+HxJtqxTS0viO3",
+	"zUDqk3o61mzGXvT9meMsXX63UhrSGck31WUbjsF3yHDIY0svhdVc726TJ7SLqpz2ZBTLB70wowNmu5DW",
+	"CXOIw6pS1wtiVotYKDb3tMV2pnsZ+5KGbYFZ8nxbQuLOyY0X1HZsw0tWKK2hSHvkY84dVFulYYFZpbPZ",
+	"Xl6IlUW5e0uBphJzDzNVF6oEV3A5T0FjczUSNfblItLkKAoc7eBKfZ+EjidOiXeqMx8vSNQ6WJ8wbP4b",
+	"7OOyZ7SZ5dyiF86FYSRQAYzPJOcx5BoP4SXCcamX+rrEsYIrN0Q3oHNHfsWsxqgQ34JG75AQHXwqtSOM",
+	"caBEWroWVUXJK8RNyw8g+ivlUTsi9j4nb+orQS533UQm1IPVeOfF7C4pD3idpl5jdqOpck5b4SPCGZ68",
+	"uvEP4nSUn01DXpEUH4NTPHbWDvfSdCO1S249TT8tlLRaVVWveJGjG69p/4HfXBSFfaHUJSYkuUfvWqls",
+	"XGk5Dzke+j7B7Uz7SrHcyAXRgDmcLty1w1kCF5jMIHssbqAUP6RlTsD87TAHPaxzvxgurL+uLjPNP2Mw",
+	"q7JVW1Hkz9S/l5PtqGtsjkXlUOF6uIPviJgOe3pZRZ8qYpFDNINEgs3tl2cE3reE2A3+lyTw/rhsBdwO",
+	"5k4uyiFz8VLUohiV9XoAEKRCrn2wAv6vI4lFrqLWLl0Lecb0AZ14q5AD4t1gwxFODpSFOwE1cHqOAH7q",
+	"lA9zl9/SOVBj0Jz/fq9NgHkr4N/vp/IO8xjz7HzdkpZ3GAnJskY4Qj7N/l43yDeUemM51RnSBAvWxBs+",
+	"AWDcPbIDwyQnyWPBQFs/Fqi2I5c76ajmyUvbR2Qmo4fCXDQLK7i7sNE+wkXVaPDJm5yIr7v2r5rbTbg6",
+	"sflQk4xaSR/V+jtoRXbucp7YX6ByNQl7ygBVLyq4go7XqKNl05CoKa4g9DWxMysBarJG9nVkOXfI9C7v",
+	"KU782hejHj557GY1KQ6xbqfYATVJVqlzIxfumJipRwkhuhJlwzv4M8eKHF01IB7lDKoGb4RFeEdOneZn",
+	"N8KrMMBF6J8TZQImfpvGh45mQXnU7WNAB92jGzN26mXeOzpNlxYNLDRbGQ2xjsRbvmFqfi3HFZJDkm+f",
+	"WxP3SSiZIPbrGyhIqvHvHSj9i2fESOEzLxG1S4DSvQqwS0bbvgHJpGqfPaSNDE+VNo9r+MFNTI2E9K/p",
+	"WxiVWyfmu+8so8GY6SV0HH1I6Eint1fP/yknce9BHB0vRyMGfNTvHv1XoG7/7KAGqqlKJnE/Ufbf8CsI",
+	"t5jn4nO2bMJAqK1wReLSd+gzCHZQJVMTkFtRyITonA8J3e4GG6o6RBKmghZ8pekfqSz7V8MrsdoRn3Hg",
+	"h27MbDiSkDe8xmJtAiqCdL94NQ+AeRBKFaZy6xZTx0yG2+EoCdB4kYcCY4pt+SWk20DODo5/FhYZp2mW",
+	"pLnAK7u3nUMs+MWHNFFbXqYvfUpWu+twh5C+HHv/H20IbDpVyDFZV7yAslMmrctnUBiKxGU3sN0fIz3k",
+	"a4EEQquEaHVIqlHeQmV6JOvKBR6NlYDqgJ08I7oVoE6zjIma316dnz3R5ZOWcupdOK7+ZwI0me5Dos8D",
+	"4LsEzb7tB8F/No/02DKmgP9XwXsswDYOLzX5EFjuJN7JwOq01Ut1s9CwMoccTKg1Ped1m7InqFiFLDRw",
+	"4zxunv/kH55tmmQhWYisaG2acZQSVkK2zFLIurGZdwxlS5a7BGGp0p/QOmJCG5MSUJi84tVPV6C1KMc2",
+	"Dk+HWqVJnRGSYOjwfTMqjHinDgcQpn3DUVh2q0ZPm+EF7grhOXdNY7ksuS7T5kJSMV+OZUr5ztzeohSN",
+	"A4dsSjyRZrrJQhLrEpG2A6TaeaPwHe09EUB+QsPPBIPNmw146u8aa5xqx6oR+8wQhn8Lg82W36CNj4KH",
+	"x8JCXH5ssvBRMwq5QimK5LNp6w7zGPE77J+GSoN4RmQVzTpliv3n/ifaSnpG/iyF3XvynY6yH83t/G7d",
+	"wQxIlevW+d8Ry/A81kV+srobhB8jbXyoSqA9SDYRRuxDXb34yC6SG4TP3pAqwaeXXOx6WuTC/J1mYEEa",
+	"A7PHvb9NRUe4Nl6VNHA366saHFLmPknCkZo2p58P99IIeD6oz5317rTRZQbHOaZO5f60CIta1Ytiis+n",
+	"qx5UOgACpF0Yx2O+YD91RPcYE+tppdTYLax1bKnO0cJeh6xddbHv0T+mJhrh6F0ThFoRL6Mj7JRjSqfK",
+	"lHk/xqyrBotMgnGmoWg0qYmv+e5w6cORrPWvv7v4/OGjvz/6/AuGDbAyA5i28kGvdGDrFyhkX+/zYT0B",
+	"B8uz+U0ISUcc4oL9MQRVxU3xZ81xW9OmNR4UTjxGv5y5ADLHMVOy7lZ7ReO0rv1/re3KLfLkO5ZDwR+/",
+	"Z+imka88E+WqjAElt1uJCQVfIDVoI4xFRti1gArbekSbDakHKf/4lUsipWQBQX/sqUDYEZer3ELGHGqJ",
+	"n+GnUOyfwU1deV51HSLCR9fl32lOQ0dCI3nFoBZL1V60FyuWg4giiHQSWesVn6QRT3xkI7N13rI5QvSe",
+	"53nSS4v27+f23YLSNs/pcRMz4kU4lLcgzTH7xHi6kttwkla1/5fhH5n8KyfjGnG5fwSvyL4P9sQcXwz8",
+	"HmLukUmgDXNxZMiDABiJtu3ESSaBYkkydO2sBGRPCAbkvvjxQ2tYPhgWQpCEDgfAS8Nn23YxksGD8ydn",
+	"Ff8hIiVZym9jlNBZ/qGI3MB640WSbJFXmlgLxrElNRQLk3Br8zRGMY+8SgbBzlopy5RE3UgmSNrpcehM",
+	"pYQjpAV9xasPzzW+EdrYC8IHlK/GQ6PSSNkUyQ6V5nbpOV/wSXNX/A+YWr6kwOz/DbhH2XvOD+WN8IPb",
+	"jJQ7vHLu1atojQbJrmlM2mn28Au29AV/ag2FMH3j/nUQTmJgKGi0jtEUmBtzfyTqoXX+ouwdyHgVPHHY",
+	"j4l5K9rsPYTtEf2TmcrIyc1SeY76BmSRwV+WR+1k8bTRJicSXrCgErVIPEri090o7VwEVor0t3okoH5a",
+	"ViCzk4Uf9ezIwhbpsb7eKOPvUp88yfk+pBowBFwClOauubI6VSVyOE2Lrh+4gu9YcOd2GbSSXJhHZtAa",
+	"lpOfujxaB13kjYHhOidLQB3cZoSfdm1T079NrtuDpdGWU7K25WvsYHdKG3eSYjtHldr5AxLGORz5Mfy8",
+	"OYr5ZSyFuEuTPVLmoLcfWBHhoKUyLVqBQcwgwQhDZRn+7muCfVj5JEDgslkMj6qD9S4peBxiMmvtTJ5M",
+	"lZSjmFCJwnfLJI+jSNGi0cLuqB58UEqKv2dzXH0b86X4fDvRPunlCasuQQYfmja7SmOCxPKt4hXd8c5s",
+	"KvFmV9UZ+9oVS/AH5W+fLP8DPvvPx+WDzx7+x/I/H3z+oIDHn3/54AH/8jF/+OVnD+HRf37++AE8XH3x",
+	"5fJR+ejxo+XjR4+/+PzL4rPHD5ePv/jyPz6ZzWcCQXaAhiopT2b/1+KiWqvFxcvnizcIbIsTXgtMSfP+",
+	"PekfVgqXT0gt6CTClotq9iT89H+GE3ZWqG07fPh15uvuzTbW1ubJ+fn19fVZ2uV8TekUFlY1xeY8zDPI",
+	"JXjx8nmMe3C+TbSjrUb+bNaSwgV9e/X16zfs4uXzs5ZgZk9mD84enD3E8VUNktdi9mT2Gf1Ep2dD+35O",
+	"qYrPja9Cct7Gv2Vtoa8oDCA8eDS6hX4aI5n+R7SGm3shIArLiOCVgUEwCF1cxfOSiMvXnp7NZ+7pahw5",
+	"PnrwIOyFlx6TC+ccB8PfHP/I5RzNJmj0AGcha2v5Dhf9s7yU6loyyqvqDlCz3XK9cyvoYCMZnLaJrw0Z",
+	"LrS44hZmv2HvPs7r2td+GUM5VS/snvLQmQgkFg/hMtQU8RVcTA7lw7ozd8T+3jy7g8kyu0ONXiLMISVR",
+	"gCcY2TzOyA7vEBbPCO3IENHzWd1k0Pk1BSuZfTibJ/VMHDSqKiPGBxh92fz/BKNIuv5umj15h3+53Jed",
+	"P85jwkv8bYvEW4S/NPBy5/9vrvkak1L6teNPV4/Ow2vv/J3PTPN+37fzBIlmcsPzd8lfC1EemCK4oB1q",
+	"cv6O/j04oE+Cs5gMe+xwGJKQhWq8RScoY2rDc++jnHRYa6AImHMrthAqERy8MmI3FrpFw/8w+vYJCzm8",
+	"GNdIhJWJEWRCs0JDCdIKXpk5pbVidqPBoB+jYRpc+ZYaBRbQQnlXJQv1nOZWjUWLh06c+leiomr37itF",
+	"KJIy+qxXIl+D5eSv5sBk3sPLRwNfhCW+8St85VolUcKNjsLygDcPutNlHTzCZk9+7aP3p7bAH80fEBs9",
+	"CGMcMclG/2pA71rZJdZa6d+krRvNb3dkZd33gMNZRivQp4celufIg8FYpzqY7moQ0Em7EHF6SDj3UA4f",
+	"RUNGe3EMSePEj094FXQzvWeg+4qXLGSooLkffri5n0vnno/yrJO7CYLHHw4C2nT2o7LsG/wfzv/5h8T+",
+	"c2lBS14FyRGn/+zDTd+SpgF9JVwRJKmSJAN3EXuPZ+g5gZi4W0jiKNdeysAbZuKNva/Z+VLdHNF0cH0e",
+	"6gBmauORa3eP4ND/NDIEibHm/B1h+P3Y7+fe2Dzy0b2Xxz6TOcm16WOo39IlNMt/7AgU7+wNrmn/cNgm",
+	"Gc+nnz9/R/8hQTVZsFMgn9sbeU7ut+fvRDn8PMBT9/e2e9riaqtKCMCp1cqAPfD5/J37N5kIbmrQAo8K",
+	"r9pfXe7oc9PUdbUb/ryTRfbHc6cTN3s/nr/DS/79lDZDvKRtBx87SXoPCH6UANoEB/hubt/s67SfMNjc",
+	"9S01Lf1gb9aMiDDkr/tW9vGm/5A3fWf72Pew+0vd+re6Yqcdn8P36XxWK5efrHvULspyQPROCgZjv1Ll",
+	"bg/GtmZde8e6Fmmthl5IrjMl6/JaucGymMtLG/QU+HCapeK51Q28P+mjBEF4njFVkjsLxeZ6Q3sH1Gz6",
+	"6r7Dsht5ykPiZW/wMGkb0vqRp3zkKX/OS+K1fz+8gW2tNNei2rGf5d2fERdlmc353z36B3kcWj8LVcIa",
+	"5MIzsMVSlTtfO3XWmeAS3Ht/IMicB+NVR/k4wj2DWSwnrezV16Anq89IUTfLShTMGe5JO4NmmcSwFJOw",
+	"d5nffI/hY56p78VKUTUxAZe9Vj7BzfBCSYw5VjHzL00XDx1EYXfsWshSXd8bUyaFafbrk+bDUratM8hK",
+	"6SFYY/ORKmgKdvZM/oLfbu6KHzv1b3+0iSsmOf5fr3/6MUkg4ZSDzoeb0hc40sUF1lpRDCV6axvLXcn7",
+	"p87EVO0oEYor+5RW2z77eA995P2nUCGFqheuzralArpDlpTcBUcpkLq8/V3nT69rmbkIulytCvydcbYW",
+	"6Fk5vKCWO/b82eD16rr1r4Svds+fDW+FDL/vg3gU4x9hL/tEGlzIWtkYR+gW9VHI/Chk3unhOvnwTHm7",
+	"ZjVL39LAfPAemwdDWCdWm+rNkFf+AJQp+qc/9fieZOOHuq2cLsvVxcFEAe0H5y3cR/NHFvGRRdyNRaD5",
+	"aMgX8NR6ppEhuuN0XVMZBmW/LDtRMUHqCM2biuskh8shFfYFjZh/Cv4hXONDK+yyuCrLGOopXIxTZgNP",
+	"q8P7yPI+srx/H5Z3cZjRdAWTO2u9LmG35XXUdZlNY0t1nTjbESwESsYwGutCd/4+v+bCYoCBr7LIVxb0",
+	"sLMFXhGyRQW9X0thuDGwXQ6/6J1uEvDyBu/013PetZB2vhHrHes4cGjLffVW6pFGIe1V+NyGBKQu9sT2",
+	"o3P9r78hyzagr8KN0HqMPzk/pzyIG2Xs+ez9PP1meh9/i+TxLt4jnkzeE10oLdZCYh0e52a5aL3CH509",
+	"mL3//wYAGvAobphRAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -177,239 +177,270 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPctpLgv4Kb3Srb2qEkfyT74qvUnmInedrYsctSsvvW8iUYsmcGTyTAB4DzEZ//",
-	"9ys0ABIkwRmOJNvJbn6yNSSBRqPR6O9+P0lFUQoOXKvJ0/eTkkpagAaJf9E0FRXXCcvMXxmoVLJSM8En",
-	"T/0zorRkfDGZTpj5taR6OZlOOC2gecd8P51I+EfFJGSTp1pWMJ2odAkFNQPrbWnerkfaJAuRuCHO7BDn",
-	"zycfdjygWSZBqT6Ur3i+JYyneZUB0ZJyRVPzSJE100uil0wR9zFhnAgORMyJXrZeJnMGeaaO/SL/UYHc",
-	"Bqt0kw8v6UMDYiJFDn04n4lixjh4qKAGqt4QogXJYI4vLakmZgYDq39RC6KAynRJ5kLuAdUCEcILvCom",
-	"T99OFPAMJO5WCmyF/51LgN8g0VQuQE/eTWOLm2uQiWZFZGnnDvsSVJVrRfBdXOOCrYAT89UxeVkpTWZA",
-	"KCdvvntGHj9+/JVZSEG1hswR2eCqmtnDNdnPJ08nGdXgH/dpjeYLISnPkvr9N989w/kv3ALHvkWVgvhh",
-	"OTNPyPnzoQX4DyMkxLiGBe5Di/rNF5FD0fw8g7mQMHJP7Mt3uinh/J91V1Kq02UpGNeRfSH4lNjHUR4W",
-	"fL6Lh9UAtN4vDaakGfTtafLVu/cPpw9PP/zT27Pkv9yfXzz+MHL5z+px92Ag+mJaSQk83SYLCRRPy5Ly",
-	"Pj7eOHpQS1HlGVnSFW4+LZDVu2+J+dayzhXNK0MnLJXiLF8IRagjowzmtMo18ROTiueGTZnRHLUTpkgp",
-	"xYplkE0N910vWbokKVV2CHyPrFmeGxqsFGRDtBZf3Y7D9CFEiYHrRvjABf1+kdGsaw8mYIPcIElzoSDR",
-	"Ys/15G8cyjMSXijNXaUOu6zI5RIITm4e2MsWcccNTef5lmjc14xQRSjxV9OUsDnZioqscXNydo3fu9UY",
-	"rBXEIA03p3WPmsM7hL4eMiLImwmRA+WIPH/u+ijjc7aoJCiyXoJeujtPgioFV0DE7O+QarPt/37x6kci",
-	"JHkJStEFvKbpNQGeigyyY3I+J1zogDQcLSEOzZdD63BwxS75vythaKJQi5Km1/EbPWcFi6zqJd2woioI",
-	"r4oZSLOl/grRgkjQleRDANkR95BiQTf9SS9lxVPc/2balixnqI2pMqdbRFhBN1+fTh04itA8JyXwjPEF",
-	"0Rs+KMeZufeDl0hR8WyEmKPNngYXqyohZXMGGalH2QGJm2YfPIwfBk8jfAXg+EEGwaln2QMOh02EZszp",
-	"Nk9ISRcQkMwx+ckxN3yqxTXwmtDJbIuPSgkrJipVfzQAI069WwLnQkNSSpizCI1dOHQYBmPfcRy4cDJQ",
-	"KrimjENmmDMCLTRYZjUIUzDhbn2nf4vPqIIvnwzd8c3Tkbs/F91d37njo3YbX0rskYxcneapO7Bxyar1",
-	"/Qj9MJxbsUVif+5tJFtcmttmznK8if5u9s+joVLIBFqI8HeTYgtOdSXh6RU/Mn+RhFxoyjMqM/NLYX96",
-	"WeWaXbCF+Sm3P70QC5ZesMUAMmtYowoXflbYf8x4cXasN1G94oUQ11UZLihtKa6zLTl/PrTJdsxDCfOs",
-	"1nZDxeNy45WRQ7/Qm3ojB4AcxF1JzYvXsJVgoKXpHP/ZzJGe6Fz+Zv4py9x8rct5DLWGjt2VjOYDZ1Y4",
-	"K8ucpdQg8Y17bJ4aJgBWkaDNGyd4oT59H4BYSlGC1MwOSssyyUVK80RpqnGkf5Ywnzyd/NNJY385sZ+r",
-	"k2DyF+arC/zIiKxWDEpoWR4wxmsj+qgdzMIwaHyEbMKyPRSaGLebaEiJGRacw4pyfdyoLC1+UB/gt26m",
-	"Bt9W2rH47qhggwgn9sUZKCsB2xfvKRKgniBaCaIVBdJFLmb1D/fPyrLBID4/K0uLD5QegaFgBhumtHqA",
-	"y6fNSQrnOX9+TL4Px0ZRXPB8ay4HK2qYu2Hubi13i9W2JbeGZsR7iuB2CnlstsajwYj5d0FxqFYsRW6k",
-	"nr20Yl7+q3s3JDPz+6iP/xgkFuJ2mLhQ0XKYszoO/hIoN/c7lNMnHGfuOSZn3W9vRjZmlB0Eo84bLN41",
-	"8eAvTEOh9lJCAFFATW57qJR0O3FCYoLCXp9MflJgKaSkC8YR2qlRnzgp6LXdD4F4N4QAqtaLLC1ZCbI2",
-	"oTqZ06H+uGdn+QNQa2xjvSRqJNWcKY16Nb5MlpCj4Ey5J+iQVG5EGSM2fMciapjXkpaWlt0TK3Yxjvq8",
-	"fcnCesuLd+SdGIU5YPfBRiNUN2bLe1lnFBLkGh0YvslFev1XqpZ3cMJnfqw+7eM0ZAk0A0mWVC0jB6dD",
-	"281oY+jbvIg0S2bBVMfNEvHvO1skjrZnmRnVNFimgz0uzQYwDiDCPhuDim+iCHghFuoOlp+LQ3h3WT6j",
-	"eW6m7vPszipx4FGcLM+JeZlAwdBj4DRn62KwCij5lqZLIxeRlOb5tLGViTLJYQU5EZIwzkFOiV5S3XA/",
-	"HNkrdshIFBhur4EEq3F2NrQxytoYI4EUFK/gwqhzZd7+pr5CFC2gIwaiSCAqNKMEmtb5c786WAFHplwP",
-	"jeDXa0RzVTj4sZnbPcKZubCLsyZQ7f2XNf5qhtkC2rzdCBS8mULIzBrttfmNSZIKaYewIo6b3PwHqGw+",
-	"tsfzfikhcUNIugKpaG5W11nUg5p87+rkfqwzO52kICNmqlf4H5oT89iIcYaSGuphKI2JwJ+cWcnEoMrO",
-	"ZF5Ag7MghbXlkpKm1wdB+ayZPM5eRp28b6352G2hW0S9Q5cblqm72iYcbGiv2ifEGu88O+oJYzuZTjDX",
-	"GARcipJY9tEBwXIKHM0iRGzu/F7/Rmyi3F5sene62MCd7IQZZzSz/0ZsnjvIhNyPeRx71HUmNoTTAhRe",
-	"7zxknGaWxjF5NhPyZuJU54LhpHG3EmpGDaTJaQdJ+GpVJu5sRlw29oXOQE2Ey24pqDt8DGMtLFxo+hGw",
-	"oMyod4GF9kB3jQVRlCyHOyD9ZVSKnVEFjx+Ri7+effHw0S+PvvjSkGQpxULSgsy2GhS57+ySROltDg+i",
-	"6iFKF/HRv3zinXTtcWPjKFHJFApa9oeyzj+r/tvXiHmvj7U2mnHVNYCjOCKYq82inVi/tgHtOcyqxQVo",
-	"bVT911LM75wb9maIQYcvvS6lESxU21HqpKWTzLxyAhst6UmJbwLPbKCFWQdTRgkuZndCVEMbnzWzZMRh",
-	"NIO9h+LQbWqm2YZbJbeyugv7DkgpZPQKLqXQIhV5YuQ8JiIWmtfuDeLe8NtVdn+30JI1VcTMje7bimcD",
-	"hhi94ePvLzv05YY3uNl5g9n1Rlbn5h2zL23kN1pICTLRG06QOlv2obkUBaEkww9R1vgetJW/WAEXmhbl",
-	"q/n8bsy9AgeKGLJYAcrMROwbRvpRkApuoxn32KzcqGPQ00WMd7PpYQAcRi62PEVf4V0c22FzXsE4Bi6o",
-	"LU8D256BMYds0SLL29vwhtBhp7qnIuAYdLzAx+iseA65pt8JedmIr99LUZV3zp67c45dDnWLce6QzHzr",
-	"7eCML/J2BO3CwH4cW+NnWdCz2ohg14DQI0W+YIulDvTF11J8hDsxOksMUHxgrWW5+aZvM/tRZIaZ6Erd",
-	"gSjZDNZwOEO3IV+jM1FpQgkXGeDmVyouZA7EXGKwF8ao6VBuRfsEU2QGhrpSWpnVViXBCKzefdF8mNDU",
-	"ntAEUaMG4k/qwCH7lp3OxvPlEmi2JTMATsTMBXm48BNcJMXwMe3FNCfiRvhFC65SihSUgixxtvi9oPn3",
-	"7NWhd+AJAUeA61mIEmRO5a2BvV7thfMatgkGOypy/4ef1YPPAK8WmuZ7EIvvxNDbtaf1oR43/S6C604e",
-	"kp211FmqNeKtYRA5aBhC4UE4Gdy/LkS9Xbw9WlYgMabmo1K8n+R2BFSD+pHp/bbQVuVACL9T042EZzaM",
-	"Uy68YBUbLKdKJ/vYsnmpZUswKwg4YYwT48ADgtcLqrSNA2M8Q5umvU5wHiuEmSmGAR5UQ8zIP3sNpD92",
-	"au5BripVqyOqKkshNWSxNaBLenCuH2FTzyXmwdi1zqMFqRTsG3kIS8H4DllOA8Y/qK4d0M6l3V8cBhWY",
-	"e34bRWULiAYRuwC58G8F2A3DmAcAYapBtCUcpjqUU8dOTydKi7I03EInFa+/G0LThX37TP/UvNsnLuvk",
-	"sPd2JkChA8W97yBfW8zaAPYlVcTB4WMM0JxjA9b6MJvDmCjGU0h2UT6qeOat8AjsPaRVuZA0gySDnG4j",
-	"0RH2MbGPdw2AO96ou0JDYiOR45veULIP/NwxtMDxVEx4JPiEpOYIGlWgIRD39Z6RM8CxY8zJ0dG9eiic",
-	"K7pFfjxctt3qyIh4G66ENjvu6AFBdhx9DMADeKiHvjkq8OOk0T27U/wNlJugliMOn2QLamgJzfgHLWDA",
-	"FuySvILz0mHvHQ4cZZuDbGwPHxk6sgOG6ddUapayEnWdH2B756pfd4Ko45xkoCnLISPBA6sGluH3xMbQ",
-	"dse8mSo4yvbWB79nfIssx8cptYG/hi3q3K9tckZg6rgLXTYyqrmfKCcIqA/5NiJ4+ApsaKrzrRHU9BK2",
-	"ZA0SiKpmNoSh70/RokzCAaL+mR0zOu9s1De60118gUMFy4sF21mdYDd8lx3FoIUOpwuUQuQjLGQ9ZEQh",
-	"GBU7Qkphdp25/C+fAeQpqQWkY9romq+v/3uqhWZcAfmbqEhKOapclYZaphESBQUUIM0MRgSr53TRmQ2G",
-	"IIcCrCaJT46Ougs/OnJ7zhSZw9onTZoXu+g4OkI7zmuhdOtw3YE91By388j1gY4rc/E5LaTLU/aHfLmR",
-	"x+zk687gtbfLnCmlHOGa5d+aAXRO5mbM2kMaGRfuhuOO8uW044N668Z9v2BFlVN9F14rWNE8ESuQkmWw",
-	"l5O7iZng365o/qr+DBNCITU0mkKSYhrjyLHg0nxjMx/NOIwzc4Bt1sNYgODcfnVhP9qjYjahuqwoIGNU",
-	"Q74lpYQUbMKfkRxVvdRjYlMB0iXlC1QYpKgWLrrXjoMMv1LWNCMr3hsiKlTpDU/QyB27AFyYms/5NOIU",
-	"UKPSdS3kVoFZ03o+l+Y75mYO9qDrMYg6yaaTQY3XIHXVaLwWOe3E1RGXQUveC/DTTDzSlYKoM7JPH1/h",
-	"tpjDZDb345jsm6FjUPYnDkKem4dDUc9G3c63dyD02IGIhFKCwisqNFMp+1TMwyR1Hyq4VRqKviXffvrL",
-	"wPF7M6gvCp4zDkkhOGyjdVkYh5f4MHqc8Joc+BgFlqFvuzpIC/4OWO15xlDjbfGLu909oV2PlfpOyLty",
-	"idoBR4v3IzyQe93tbsqb+klpnkdciy6FtcsA1LQO1mWSUKVEylBmO8/U1EUFW2+ky3dto/91nZhzB2ev",
-	"O27HhxZWR0AbMeQloSTNGVqQBVdaVqm+4hRtVMFSI0FcXhkftlo+86/EzaQRK6Yb6opTDOCrLVfRgI05",
-	"RMw03wF446WqFgtQuqPrzAGuuHuLcVJxpnGuwhyXxJ6XEiRGUh3bNwu6JXNDE1qQ30AKMqt0W/rHDG2l",
-	"WZ47h56Zhoj5Faea5ECVJi8Zv9zgcN7p748sB70W8rrGQvx2XwAHxVQSDzb73j7FxAa3/KVLcsBwd/vY",
-	"B502JSMmZpmtKjH/9/6/PX17lvwXTX47Tb76l5N37598eHDU+/HRh6+//n/tnx5/+PrBv/1zbKc87LH8",
-	"YQf5+XOnGZ8/R/UnCNXvwv7J7P8F40mUyMJojg5tkftYK8MR0IO2cUwv4YrrDTeEtKI5ywxvuQk5dG+Y",
-	"3lm0p6NDNa2N6BjD/FoPVCpuwWVIhMl0WOONpah+fGY8Ux+dki75Hs/LvOJ2K730bRNRfXyZmE/ragy2",
-	"UNtTgqn6S+qDPN2fj774cjJtUuzr55PpxD19F6Fklm1ihRQy2MR0xTBJ4p4iJd0q0HHugbBHQ+lsbEc4",
-	"bAHFDKRasvLTcwql2SzO4XzOlrM5bfg5twH+5vygi3PrPCdi/unh1hIgg1IvYwWcWoIavtXsJkAn7KSU",
-	"YgV8StgxHHdtPpnRF11QXw507gNTpRBjtKH6HFhC81QRYD1cyCjDSox+OukN7vJXd64OuYFjcHXnjEX0",
-	"3vv+20ty4himumdretihgyoMEVXaZY+2ApIMNwtzyq74FX8Oc7Q+CP70imdU05MZVSxVJ5UC+Q3NKU/h",
-	"eCHIU5+Q+pxqesV7ktZgZckga5yU1SxnKbkOFZKGPG21sP4IV1dvab4QV1fverEZffXBTRXlL3aCxAjC",
-	"otKJq3WUSFhTGfN9qbrWDY5si5ntmtUK2aKyBlJfS8mNH+d5tCxVt+ZFf/llmZvlB2SoXEUHs2VEaVHn",
-	"oxkBxeU0m/39UbiLQdK1t6tUChT5taDlW8b1O5JcVaenjzGzrykC8au78g1NbksYbV0ZrMnRNargwq1a",
-	"ibHqSUkXMRfb1dVbDbTE3Ud5uUAbR54T/KyVdegTDHCoZgF1jvfgBlg4Ds6OxsVd2K98Xcv4EvARbmE7",
-	"A/1W+xUUELjxdu0pQkArvUzM2Y6uShkS9ztTl7tbGCHLR2MotkBt1VUGnAFJl5Beu5JtUJR6O2197gN+",
-	"nKDpWQdTtpifzTDEclLooJgBqcqMOlGc8m23ro+yGRU46Bu4hu2laKpRHVLIp11XRg0dVKTUQLo0xBoe",
-	"WzdGd/NdVJlPNHXlWTB505PF05ou/DfDB9mKvHdwiGNE0ap7MoQIKiOIsMQ/gIIbLNSMdyvSjy2P8RS4",
-	"ZitIIGcLNovVIf6Pvj/Mw2qo0pVedFHI9YCKsDkxqvzMXqxOvZeUL8Bcz+ZKFYrmtqxsNGgD9aElUKln",
-	"QPVOOz8PK3J46FClXGPmNVr4pmYJsDH7zTRa7DisjVaBhiL7jotePh6OP7OAQ3ZDePznjaZwPKjrOtRF",
-	"Si76W7nGbq3WutC8kM4QLvu8AKzZKtZmXwwUwpUbtVVtgvulUnQBA7pL6L0bWRCk5fHDQfZJJFEZRMy7",
-	"okZPEoiCbF9OzJqjZxjME3OIUc3sBGT6mayD2PmMsIq4Q9gsRwG2jly1e09ly4tqyyIPgRZnLSB5Iwp6",
-	"MNoYCY/jkip/HLFgrOeyo6Szj1j3ZldtvvMgljCoCltX3vO3YZeD9vR+V6HPl+XztfhCpX9EXT2je2H6",
-	"Qmw7BEfRNIMcFnbh9mVPKE3FqGaDDByv5nPkLUksLDEwUAcCgJsDjOZyRIj1jZDRI8TIOAAbAx9wYPKj",
-	"CM8mXxwCJHcVr6gfG6+I4G+IJ/bZQH0jjIrSXK5swN+Yeg7gSlE0kkUnohqHIYxPiWFzK5obNud08WaQ",
-	"Xok4VCg6BeFc6M2DIUVjh2vKXvkHrckKCTdZTSjNeqDjovYOiGdik9gM5aguMtvMDL1HcxcwXzp2MG0x",
-	"vnuKzMQGw7nwarGx8ntgGYbDgxHYXjZMIb3id0NylgVm17S75dwYFSokGWdorcllSNAbM/WAbDlELveD",
-	"+no3AqBjhmqaVTizxF7zQVs86V/mza02berG+rSw2PEfOkLRXRrAX98+1q6I99em8uFwdTV/oj5JKcC+",
-	"Zek2JRrtx6Utu3hIhcYuObSA2IHV1105MIrWdqxXG68B1mKsxDDfvlOyjzYFOaASnLRE0+Q6FilgdHnA",
-	"e/zCfxYY63D3KN8+CAIIJSyY0tA4jXxc0Ocwx1OsHy3EfHh1upRzs743QtSXv3Wb44etZX7yFWAE/pxJ",
-	"pRP0uEWXYF76TqER6TvzalwCbYco2m4LLItzXJz2GrZJxvIqTq9u3h+em2l/rC8aVc3wFmPcBmjNsDtI",
-	"NHB5x9Q2tn3ngl/YBb+gd7becafBvGomloZc2nP8Qc5Fh4HtYgcRAowRR3/XBlG6g0EGCed97hhIo0FM",
-	"y/Eub0PvMGV+7L1Raj7tfejmtyNF1xKUAYxnCIrFAjJf3sz7w3hQRC4XfBG0sSrLXTXzjoktXYeV53YU",
-	"rXNh+DAUhB+I+wnjGWzi0IdaAULeZNZhwT2cZAHcliuJm4WiqAlD/PGNwFb3iX2h3QSAaBD0ZceZ3UQn",
-	"212qtxM3IAeaOZ1EgV/f7mPZ3xCHuulQ+HSr9OvuI4QDIk0xHXR26ZchGGDAtCxZtuk4nuyog0YwepB1",
-	"eUDaQtbiBtuDgXYQdJTgWrXEXai1M7CfoM57YrQyG3vtAosNfdPUJeBnlUQPRiuyuV+4vtbVRq79h58v",
-	"tJB0Ac4LlViQbjUELucQNARl4RXRzIaTZGw+h9D7om7iOWgB17OxZyNIN0JkcRdNxbj+8kmMjPZQTwPj",
-	"fpTFKSZCC0M++cu+l8vL9IEpqb4Sgq25gasqmq7/A2yTn2leGSWDSdWE5zq3U/vyPWDXV8UPsMWR90a9",
-	"GsD27Apant4A0mDM0l8/UkEF73uq1eMA1cvWFh6wU2fxXbqjrXFdKYaJv7llWl0b2ku5zcFogiQMLGN2",
-	"4yIem2BOD7QR3yXlfZvAsv0ySCDvh1Mx5Xt49q+iuhbFPtq9BJp74sXlTD5MJ7eLBIjdZm7EPbh+XV+g",
-	"UTxjpKn1DLcCew5EOS1LKVY0T1y8xNDlL8XKXf74ug+v+MSaTJyyL789e/Hagf9hOklzoDKpLQGDq8L3",
-	"yj/Mqmwfi91Xia327Qyd1lIUbH5dkTmMsVhjZe+OsanXFaaJnwmOoou5mMcD3vfyPhfqY5e4I+QHyjri",
-	"p/F52oCfdpAPXVGWe2ejh3YgOB0XN661UJQrhAPcOlgoiPlK7pTd9E53/HQ01LWHJ+Fcr7A0ZVzj4K5w",
-	"JbIiF/xD71x6+k7IFvN3mYnR4KGPJ1YZIdvicSBW2zfw7ApTx8QKXr8ufjWn8egoPGpHR1Pya+4eBADi",
-	"7zP3O+oXR0dR72HUjGWYBFqpOC3gQZ1lMbgRn1YB57Aed0GfrYpashTDZFhTqI0C8uheO+ytJXP4zNwv",
-	"GeRgfjoeo6SHm27RHQIz5gRdDGUi1kGmhe0Zqojg3ZhqTII1pIXM3rVksM7Y/hHiVYEOzETlLI2HdvCZ",
-	"MuyV22BK8zLBlwestWbEig3E5vKKBWOZ18bUTO0AGcwRRaaKlm1tcDcT7nhXnP2jAsIyo9XMGUi81zpX",
-	"nVcOcNSeQBq3i7mBrZ+qGf42dpAd/iZvC9plBNnpv3te+5T8QmNdjw6MAA9n7DHuHdHbjj4cNdtstmU7",
-	"BHOcHjOmd7xndM5ZNzBHtBc8U8lcit8g7ghB/1GkEIZ3fDI08/4GPBa512UptVO5aWnfzL5vu8frxkMb",
-	"f2td2C+6brt2k8s0fqoP28ibKL0qXq7ZIXlICQsjDNqpAQOsBY9XEAyLbVB89BHl9jzZKhCtDLP4qQxz",
-	"OU/s+M2pdDD38l9zup7RWI8YowsZmILtbcVJaUH8x34DVF3jwM5Oggju+l1mK8mVIBsfRL8q7Q31Gjvt",
-	"aI2mUWCQokLVZWrDFHIlIsNUfE25baNuvrP8yn2twLrgzVdrIbEOpIqHdGWQsiJqjr26epul/fCdjC2Y",
-	"7RBeKQhaULuBiC02iVTk2njXlTscas7n5HQa9MF3u5GxFVNslgO+8dC+MaMKr8vaHV5/YpYHXC8Vvv5o",
-	"xOvLimcSMr1UFrFKkFr3RCGvDkycgV4DcHKK7z38itzHkEzFVvDAYNEJQZOnD7/CgBr7x2nslnUd3nex",
-	"7Ax5tg/WjtMxxqTaMQyTdKPGo6/nEuA3GL4ddpwm++mYs4Rvugtl/1kqKKcLiOdnFHtgst/ibqI7v4MX",
-	"br0BoLQUW8J0fH7Q1PCngZxvw/4sGCQVRcF04QL3lCgMPTX9pe2kfjhsROb7RXm4/EOMfy19+F/H1vWJ",
-	"1RhaDORsYZTyj+ijDdE6JdQW/8xZE5nuG5aSc19bGBto1X2zLG7MXGbpKEtioPqclJJxjfaPSs+Tvxi1",
-	"WNLUsL/jIXCT2ZdPIo2o2r1a+GGAf3K8S1AgV3HUywGy9zKL+5bc54InheEo2YOmxkJwKgcDdeMhmUNx",
-	"obuHHiv5mlGSQXKrWuRGA059K8LjOwa8JSnW6zmIHg9e2SenzErGyYNWZod+evPCSRmFkLGGAc1xdxKH",
-	"BC0ZrDBjLr5JZsxb7oXMR+3CbaD/vPFPXuQMxDJ/lqOKQODR3JUsb6T4n182lc/RsWozETs2QCEj1k5n",
-	"t/vE0YaHWd26/lsbMIbPBjA3Gm04Sh8rA9H3Nry+/uZzxAt1QbJ73jI4PvyVSKODoxx/dIRAHx1NnRj8",
-	"66P2Y8vej47iBYijJjfza4OF22jE+G1sD78REQOY71pYBxS5+ggRA+TQJWUeGCY4c0NNSbtD3KeXIu4m",
-	"vysebRo/BVdXb/GJxwP+0UXEZ2aWuIFNlsLwYW93yIySTFY/D+LcKflGbMYSTucO8sTzO0DRAEpGmudw",
-	"Jb0OoFF3/d54kYBGzagzyIVRMsOmQKE9/4+DZ7P46Q5sVyzPfm5qu3UuEkl5uoxGCc/Mh79YGb11BVtW",
-	"Ge0zsqScQx4dzuq2v3gdOKKl/12MnadgfOS73Q60drmdxTWAt8H0QPkJDXqZzs0EIVbbZbPqsgz5QmQE",
-	"52maWjTMsd/KOdZCM5LfjMMWlXZxq5gL7goOzVmOYZhxvzG+mUiqBwpoYb9z31/IjIPtx5U1M9jRQRLK",
-	"CryYFS3KHPBkrkDSBX4qOHQ+xxJqOHLQsYKo0jzCN7FghSC6kpyI+TxYBnDNJOTbKSmpUnaQU7Ms2ODc",
-	"k6cPT0+jZi/EzoiVWiz6Zb5qlvLwBF+xT1yTJdsK4CBg98P6oaGoQza2Tziup+Q/KlA6xlPxgc1cRS+p",
-	"ubVtP8m69+kx+R4rHxkibpW6R3OlLyLcLqhZlbmg2RSLG19+e/aC2FntN7aFvO1nuUBrXZv8o+6V8QVG",
-	"fWWngco548fZXcrDrFrppG4/GatNaN5oGmSyTswN2vFC7ByT59aEWjfwt5MQLJEtC8iCbpdWiUfiMP/R",
-	"mqZLtE22JKBhXjm+EatnZ43nJsg+rLsfIcM2cLterLYV65QIvQS5ZgowIx9W0C6HWNcGdbZxXx6xvTxZ",
-	"cW4p5fgAYbTudXQo2j1wVpL1QQVRyDqIP9AyZfsxH9qX9gK/iudidJrcdrz+vrieL7FNXjrnQkq54CzF",
-	"VggxSRpLt41zU47oGhH3L6qJO6GRwxVtrVvnAjssDjbb9YzQIa7v8g+emk211GH/1LBxLdcWoJXjbJBN",
-	"fadr5xBjXIHrZmWIKOSTQkaCmqKJEHUAxYFkhFWZBiyc35lnPzr7NxbFuGYcLV0ObU4/sy6rXDH0THPC",
-	"NFkIUG497Wwe9dZ8c4xVGjPYvDt+IRYsvWALHMOG0Zll25jR/lBnPoLURWyad5+Zd13t/PrnVjiYnfSs",
-	"LN2kw33Qo4Kk3vBBBMfilnwgSYDcevxwtB3ktjP0G+9TQ2iwwqg1KPEe7hFG3Uu7Pcq3Rre0FIVvEJtR",
-	"GS2gy3gEjBeMexdq/IJIo1cCbgye14HvVCqptrrDKJ52CTQfSIDADGXrg7/tUN3OAQYluEY/x/A2Nm3A",
-	"BxhH/UIj8VO+Jf5QGOoOhIlnNK9DpyNNvVGqckJUhslFnTbfMcZhGHfiUyZb6Nqbvld/jt04Dr2JhmoU",
-	"zqpsATqhWRYrbfUNPiX41CeJwQbSqm5CVWcHtmuU96nNTZQKrqpix1z+hVtOF/TNj1BD2Lvf7zBW2plt",
-	"8d9YB6bhnXFB0wdn5foI6eywwvz9LOOY1GtoOlFskYzHBN4pt0dHM/XNCL35/k4p3afr/i6ycTtcLtyj",
-	"GH/71lwcYeHeXny6vVrquroYCy7wuS94VFeEbHMlvMp6fcYw6gE3L7JlHeD9i1HAVzQfyIQPfSX2frX+",
-	"g6F8+HSwfAPVrjyXpmQnCxoseWRjhTvel74LcSg+2IYH353Xwq11J0KHfXc/tDx1NkasYRaDHrqbOdGa",
-	"DT7Ui/bDaqhEgu/Tgc/DfiAuimfqysDDionKR1/5GGivEtpfXQmeVt+PgfVHMws+t9di0Mdy6frX2mU6",
-	"nfyHn60XlgDXcvs78Lj0Nr3bVCYi7VrzVPMKqVsfjmqF2LoVx/SwibVLcbKht5VZ1tKipV77mR5ZPR8j",
-	"DvTw8WE6Oc8OujBjLXcmdpTYsXvBFkuNFfv/CjQD+XpPR4KmCwEesVIo1nQgzc1grgTsEoc7HptsYAiY",
-	"hR0V+mP5INQVpBrbzjbBdRLgkP4KZjLv9PmzM8GwOl3nZLiGBLu6EPR7ze6543uFk4LiX7ZP5/H4mvtn",
-	"dQi1zQBbU9WUa+nkTI/O3JzPIcWqyDsLVf3HEnhQBGnq7TIIyzyoW8XqPCas63241bEBaFcdqZ3wBP11",
-	"bg3OUB77NWzvKdKihmjj0DqJ7yaFgxED1gXma0gPGZJd1BhTNWUgFnxIsCvF3DTHGKz5HJRdu+FcniTN",
-	"xdGUYtsxZbzp+ai5zKcHlX3ElJyhWlb9nsnD+sdzbFGtXIAcrQsPh1o6Oe83zlm7wsVYVqz2nfgSxqD8",
-	"b76GoJ0lZ9eufwBixXqq1lRm/o07KQpl7yYWB3pez8yaBI5+kEOkFQPmQqW5MGJEMpRQ1s6ZqAMO7ykb",
-	"GdoU8EG45iAlZLVLJBcKEi18wscuOHahwoa/3ggJarD9kQVusPT1m6a2N7aBo1jqmrqo13CBREJBDXQy",
-	"qMA9POcuZD+zz30Svm8DttfCVNPr/n60PnWHqR4SQ6qfE3db7k/uv4mxiXEOMvGep245bt6uyIZ1N7Mq",
-	"tRd0eDBqg9zo2jk7WEnUTpP2V9nREYIk+WvYnlglyDfy9TsYAm0lJwt6UHC0s8l3an5TMbgXdwLe560j",
-	"VwqRJwPOjvN+DfEuxV+z9BqwBmAd4j7Qo53cRxt77c1eL7e+ZnZZAofswTEhZ9wmFXnHdru9YGdyfk/v",
-	"mn+Ds2aVLevvjGrHVzyenYEF9+UtuZkfZjcPU2BY3S2nsoPsqVC94UMhN2sszt/u4nk8Vivvu5q7XeQb",
-	"orJQxGSSC+uxeoYHPWY4whIIQa0OdGRS4jxdROUiFst7kzINZqg4psLJECANfEy1gBoKN3gUAdG+6JFT",
-	"aEvfuaJ3Yk4kNE7km1b/67dwj2n03ZnrWdr8bi4ktJqxm69tpc868QXLaOJ/ZkxLKrc3qdHXayHfs54M",
-	"YnlvOFYdidUspInG6uMwz8U6QWaV1H0uYqqteU+1L2PfdK35zpzqGQRxXVQ5QW1LljQjqZAS0vCLeL6n",
-	"haoQEpJcYJhXzAM910buLjDJi5NcLIgoU5GB7RcTp6ChuSrOKYpNEETVRFFgaQezhe03AR2PnNLcqdaP",
-	"lKCotTigd34KNnO9qepkF51YX+ZAxDIoV8XJYci+3Id3R+//OG+esw3SDcjYkZ8TLSuYEvdGt0e2O/hU",
-	"AimYUhaUmpbWLM8xcZxtAs9rHbgQR+2A2HuOYZUrhrE37SICVhouzZ1XV1YIecBFWPaI6KUU1WIZFJiu",
-	"4fQqr6ycQhyO8pOqMDwKM8jMFE9IIZR2mqYdqVlyE3J2PxVcS5HnbaOUFdEXztL+km7O0lS/EOJ6RtPr",
-	"B6jXcqHrlWZTn1/dDQ5sZpKd0mLtCzix7cz3l+q172GonCPa0Qyyw+IObuwegPluPwfdb3M/6y+su642",
-	"M42rMWecUC0KlsbP1B8r2m4wRi7GoqI1y2xvRVtlAl/Dwx5eVnVwBbLIPpqB02hzuDPiGIFzMiO7Mf9F",
-	"Cbw7LpmDYzQDF2WfuTgpKkkHZb0OAAipTX3WlbQNGUNJrOYqYmFLJaCLvAvoyFsFI5FuB5sZ4c6B0nAr",
-	"oHrRjzWA963xYWpry9lIypnY+OcPmuJzNwL+w24qbzGPoRCvi4a0pA3y8oVqBjhCvMT1znioS0x7n42N",
-	"iqqb54684QMAhuOkWjCMipY6FIw5ZTlkSaz34nlto5oGmrZLzeq2RGfKcfKUVr71oRm7kuAKp1gRX7b9",
-	"XyU1pCTq1/uWZJ7BBmxex28ghe1pOA38L5DblocdY4AokxxW0Aofc9VcKhQ12Qr8t6r+mGQAJXojuzay",
-	"WFxUeJd3DCdu7UkQWTMGu1FLikWs3Smyx0wSNepseGKPiRp7lAxEK5ZVtIU/dajI0TYDmqMcQVVPR0i8",
-	"Hjl2mp/sCG/8AGf++5go4zHxbhwfOpgFxVG3iwHtjZOs1NCp5/EwybBUUe1gwdmy2hFrSbzhG6qkaz5s",
-	"kOyTfKNujdwnJniA2G83kKJU4/QdyJzGM+CkcFVPkNo5QGa1AvNJxNq+BE64CFpMrqmqVZWmhqL/wU6M",
-	"LzHutOkbOJWbaMbb7yzBwYjqFFMbVCRkTac3N89/lpO48yAOjhejEQUu/W+H/ctTt1M78AVs5c3NfhrZ",
-	"H5s0ulvMcfEpmVV+oDwXa9szMtRDn4P3g1rq8y4gJ5az+lr2UZtTV96za+pgQbx6QbdESPzHaJ3/qGjO",
-	"5lvkMxZ8/xlRS2pIyDlebUSAiwI1E+8Wr6YeMG9tEX4qu242dsxguK0ZJQDaXOS+uY8gBb2GcBsw2MHy",
-	"z1QbxqmqGVouzJXd2c4+FtzifYmWgmahpo+FIttt1H3pYPP1/25y4cKpfH23Mqep7xDqWhS1+Qx2AfbE",
-	"pZdQ7E6W7PM1TwJ1Z+GGaKXPrs9uYDI9kHXFMhCG2q+0wO51XO11nrnVMkZafjs9NnakmY5ayl3vwtio",
-	"mx7QYZ/GfeCHbSs/Df6jNVyHljEG/N8L3gca1Ybw2p60nwDLrQocEVittXomNomEudoXYGLN1Uadl03t",
-	"Dm9iZTyVQJWNuDl/5RTPpkQp40YRtjGhtU+zHiWDOeMNs2S8rHREj8FKpXwbICw0+iNaB1xoQ1KCESZX",
-	"NH+1AilZNrRx5nTYlo5hiwjv6HDfRkwY9Z3aH4CpRofD/MzGjB6+Zi5w24TKhmsqTXlGZRa+zjhJQZp7",
-	"n6zpVt3co1Q7B/b5lGggzbSrBgTeJSRtC0i+dU7hW/p7agDpHTp+RjhsMC444qyxph0tBvwzfRj+EA6b",
-	"gm6SXCwwi3DgQLjatOjhsyqg4GgGt/LZuHX7eRT7DXZPg2X5HSPSAmcdM8Xuc/8KtxLVyJ840ztPvrVR",
-	"dtM6bdytPZgeqXzRBP9bYumfx1gmriu+EmbjemHTp6p42oNgE2HAP9S2iw/sIoZBuDTu0Ag+vt1ZO9Ii",
-	"lu9rLQMJWgzUjvB+UE0oO01deFbflNYzNVikTF229IGWNmuf9/fSAHi2N7076+1p65AZM84hPeJ250cn",
-	"pSiTdEzMp+3ckTk3gYO0DeMAfQROgIF11+Exqu5l06p71Gpqc2ibvMGmOvu8XWW6S+kfMhMNcPS2C0LM",
-	"kZfZzu1o3cJMntqYMu3mmLXNYDWTIJRISCuJZuI13e5vOzZQMfrir2dfPHz0y6MvviTmBZKxBaim6nin",
-	"bVcTF8h41+7zaSMBe8vT8U3w1Qcs4rz/0SdV1ZvizprltqopKdprWnaIfTlyAUSOY6Rd1I32CsdpQvt/",
-	"X9sVW+Sd71gMBR9/z6TI83jXh1quijhQYrsVuFCMBlKCVExpwwjbHlCmm4hotUTzINb+XdlqMoKn4O3H",
-	"jgqYHgi5ii1kKKAW+RnmdjuvEYFNmTteZT09u9bl9DRroUOhEaNiZkBKUTrRns1JDCLMIJJBZq0zfKJF",
-	"PIiRrZmtjZaNEaKLPI+TXtgweze3bzdz1XFObzYxIl74Q3kD0hzyTwzXLbgJJ2lM+78b/hEpxHBnXKNe",
-	"7sfgFVH94GZN+UeB1k/Kj5AHAjCQbdvKkwwSxYJCxNJ6CdCf4B3IXfHjZeNY3psWgpD4D/aAF6bPNu/V",
-	"mQwOnM9c0fdljZRgKe+GKKG1/H0ZuZ711hdJsEXOaKI1KMuWRF8sDNKt1bM6i3lAK+klO0shNDGaaZ5H",
-	"kqStHQfPVEg4RiWQK5p/eq7xHZNKnyE+IHsznBoVZsqGSLaoVDer0/eCjpo7yIq9u6n5a0zM/g8wexS9",
-	"59xQzgnfu83QuIMd6xf+VrC53mSNY9ogq4dfkplrtlFKSJnqOvfXXjipE0NBsrkLaIWN3pOJum+dPwt9",
-	"CzKe+0gc8mPg3qp99g7C5oh+ZqYycHKjVB6jvh5ZRPAX41Fhc94918UtGzPcrOxLUMDtwLIv/bbDY5dn",
-	"S5uYS6dS0F/n6Nu6hdvIRd2sbWzNotH9Ha6u3urZmFJD8V4M5nOsdXQnTRkOasnwEaocWRy5Mdy8MYr5",
-	"eajura3tOlCbu7MfFcv3Bqy0Kq1/mE4WwEExhbXEf3G9Yz7tXeohsJUX+kfVwnqbcjEWMZG1tiYPpgpq",
-	"qI8on+4+i9S8xqzGtJJMb7FvsDegsV+i9Zi+r2t7uNowtS/N3X1aXEPdu72pBFIpf7t+L2iO95F18XFz",
-	"C4n8mHxrK3y7g/L1vdm/wuO/PMlOHz/819lfTr84TeHJF1+dntKvntCHXz1+CI/+8sWTU3g4//Kr2aPs",
-	"0ZNHsyePnnz5xVfp4ycPZ0++/Opf7xk+ZEC2gPrS/k8n/5mc5QuRnL0+Ty4NsA1OaMl+ALM3qCvPBfa1",
-	"NEhN8SRCQVk+eep/+j/+hB2nomiG979OXH+myVLrUj09OVmv18fhJycLTP1PtKjS5YmfB7sNtuSV1+d1",
-	"jL6Nw8EdbazHuKmOFM7w2ZtvLy7J2evz44ZgJk8np8enxw9da2tOSzZ5OnmMP+HpWeK+n2B9zRPlSuef",
-	"1LlaH6a9Z2VpC+ubR45G3V9LoDkW2DF/FKAlS/0jCTTbuv+rNV0sQB5j9ob9afXoxEsjJ+9d5YQPu56d",
-	"hJEhJ+9bBSayPV/6yId9r5y8961zdw/YapvqYs4MUqMuz+9Bu3JL1vYQqdWBngY3+pQorJtvfiolE+a8",
-	"Ts3lmwHGBWB4m8QC4lpWPLXOYjsFcPzvy7P/RIf5y7P/JF+T06lLOFCo0MSmtxnXNaGdZxbsfpyi+mZ7",
-	"VlczaZzrk6dvY0YmFyxaVrOcpcTKKXhQDRUG56geseGTaFGcqLq/ecP1DSc/Tb569/6Lv3yISZM92bhG",
-	"UlDgo+X1Fb7zKSKtoJuvh1C2cRHoZtx/VCC3zSIKupmEAPc9qJGqZz5ByDeADmMTg6jFf7949SMRkjjt",
-	"+TVNr+vkKJ8N12QAhslw5sshiN3FGgINvCrMHeWyrAq1KNsFgGs0v8NuiQgospNHp6eehzoNJTigJ+7c",
-	"BzN1zFp9QsMwncBQ2U+FVwQ2NNX5llAVxElg1KLvbNpJYRNl0gqk32ka7c/otiSahXBoNn6kQr3QNN8D",
-	"32WnC2QLHS7kpzSX7P709x4yohC8i4kR4dZ6Gvlzd/977G5fKiGlMGeaYVx2c+X466wFpJNF860Hd6DQ",
-	"yDH5m6hQdjRaQaUh1gMfZ7A+ETenq4sUBNI1qUP45Oiou/Cjoybsbw5rZLKU44tddBwdHZudenIgK9tp",
-	"p26VER51dg4ZrrdZL+mmjpqmhAuecFhQzVZAAoXzyenDP+wKz7mNUzfCshXqP0wnX/yBt+ycG8GG5gTf",
-	"tKt5/IddzQXIFUuBXEJRCkkly7fkJ14nAgQt1vvs7yd+zcWae0QYfbUqCiq3ToimNc+peND3Zyf/6VU4",
-	"agRt5KJ0oTAWBkVUK9P6Koh8MXn3wesAI3WPXa+dzLAD5thXIVRYhrUT9Eyok/doWx/8/cQ5SAceWr15",
-	"6DG6QOw7J74048CbtghX/GFLaXqvN2adu4cz7wTjpVSny6o8eY//QTU5WLCt6X+iN/wEQ0ZP3rfw5B73",
-	"8NT+vfk8fGNViAw8cGI+V6jm7Xp88t7+G0wEmxIkM7cV1tF0v9p6xyfYaHrb/3nL0+iP/XW0ar0O/Hzi",
-	"rTQxjbv95vvWn22SU8tKZ2IdzIL+Deuc60NmHlaq+/fJmjJtZChXYpTONcj+xxpofuL6CXV+bUr4955g",
-	"X4Lgx47UVQpbY6it8L6h68tWqqi0tTS+EWjHGOLHm2TGODKpkIk2Vkv7sK9B9Vjn5RJseK53/EZEVC3I",
-	"TAqapVRp84frvNVTnT/cUj3rlv44j7j1EEy0RvSrVRp2c7zX14PjjpFBg30h58/9hE1+2keX23oQfUMz",
-	"4otSJeQlzc2GQ0bOnHbQwsbHlrk+v5D0maWaTyaGfOMPnyIUK/S19EcZr6kTtMgbI3MYJdMwgAXwxLGg",
-	"ZCayretiNpF0rTe2hEeXuZ3Q9o3RtlNSSQs19PAOjJi/b8vlPoPln3bCP+2Ef1qS/rQT/rm7f9oJR9oJ",
-	"/7Si/WlF+x9pRTvEdBYTM535Z1jaxLbqtDWv1fto076iZvHt4mJM1zJZK8sUO2UwfUzIJVaGoeaWgBVI",
-	"mpOUKitduSpGBQZ/YokyyJ5e8aQFiQ2xNBPfb/5rY1uvqtPTx0BOH3S/UZrlecib+9+ivIuPbPrJ1+Rq",
-	"cjXpjSShECvIbK5sWD7dfrV32P9Vj/uq13cBk+Sx9I6vZEZUNZ+zlFmU54IvCF2IJi4b67VygU9AGuBs",
-	"9yrC9NTlsTCXPO2a27ervLcl974EcN5s4d6Igw65xIMNDOEdGGnwL2PCDP5HS+k3LXZ1W0a6c+weV/2T",
-	"q3wKrvLZ+cof3YcbmBb/W4qZT06f/GEXFBqifxSafIc5B7cTx1wd0TTaxOumgpavI+PNfU3cchgHjLdo",
-	"HQH89p25CBTIlb9gm7DWpycnWFhsKZQ+mZjrrx3yGj58V8P83t9OpWQr7BKN1k0h2YJxmicuLjRpQlcf",
-	"HZ9OPvz/AAAA//8ezQsLGSMBAA==",
+	"H4sIAAAAAAAC/+y9e5PbtpIo/lXw025VYq80YztO9sS/Su2d2HnMxk5cnkl298a+50BkS8IZCuABwBkp",
+	"vv7ut7oBkCAJStSMYie7/sseEY9Go9Fo9PPtJFPrUkmQ1kyevJ2UXPM1WND0F88yVUk7Ezn+lYPJtCit",
+	"UHLyJHxjxmohl5PpROCvJberyXQi+RomT+L+04mGf1RCQz55YnUF04nJVrDmOLDdlti6HmkzW6qZH+LM",
+	"DXH+bPJuxwee5xqM6UP5kyy2TMisqHJgVnNpeIafDLsRdsXsShjmOzMhmZLA1ILZVasxWwgocnMSFvmP",
+	"CvQ2WqWffHhJ7xoQZ1oV0IfzqVrPhYQAFdRA1RvCrGI5LKjRiluGMyCsoaFVzADX2YotlN4DqgMihhdk",
+	"tZ48+XViQOagabcyENf034UG+A1mlusl2MmbaWpxCwt6ZsU6sbRzj30NpiqsYdSW1rgU1yAZ9jphLypj",
+	"2RwYl+zVt0/ZZ5999iUuZM2thdwT2eCqmtnjNbnukyeTnFsIn/u0xoul0lzms7r9q2+f0vwXfoFjW3Fj",
+	"IH1YzvALO382tIDQMUFCQlpY0j60qB97JA5F8/McFkrDyD1xjY+6KfH8H3RXMm6zVamEtIl9YfSVuc9J",
+	"HhZ138XDagBa7UvElMZBf30w+/LN24fThw/e/dOvZ7P/7f/8/LN3I5f/tB53DwaSDbNKa5DZdrbUwOm0",
+	"rLjs4+OVpwezUlWRsxW/ps3na2L1vi/Dvo51XvOiQjoRmVZnxVIZxj0Z5bDgVWFZmJhVsgBjaDRP7UwY",
+	"Vmp1LXLIp0xIdrMS2Ypl3LghqB27EUWBNFgZyIdoLb26HYfpXYwShOtW+KAF/XGR0axrDyZgQ9xglhXK",
+	"wMyqPddTuHG4zFl8oTR3lTnssmKXK2A0OX5wly3hTiJNF8WWWdrXnHHDOAtX05SJBduqit3Q5hTiivr7",
+	"1SDW1gyRRpvTukfx8A6hr4eMBPLmShXAJSEvnLs+yuRCLCsNht2swK78nafBlEoaYGr+d8gsbvu/X/z0",
+	"I1OavQBj+BJe8uyKgcxUDvkJO18wqWxEGp6WCIfYc2gdHq7UJf93o5Am1mZZ8uwqfaMXYi0Sq3rBN2Jd",
+	"rZms1nPQuKXhCrGKabCVlkMAuRH3kOKab/qTXupKZrT/zbQtWQ6pTZiy4FtC2Jpvvnow9eAYxouClSBz",
+	"IZfMbuSgHIdz7wdvplUl8xFijsU9jS5WU0ImFgJyVo+yAxI/zT54hDwMnkb4isARcg84Qo4DR8ImQTN4",
+	"uvELK/kSIpI5YT975kZfrboCWRM6m2/pU6nhWqjK1J0GYKSpd0vgUlmYlRoWIkFjFx4dyGBcG8+B114G",
+	"ypS0XEjImZAOaGXBMatBmKIJd793+rf4nBv44vHk3b6vI3d/obq7vnPHR+02NZq5I5m4OvGrP7BpyarV",
+	"f8T7MJ7biOXM/dzbSLG8xNtmIQq6if6O+xfQUBliAi1EhLvJiKXkttLw5LW8j3+xGbuwXOZc5/jL2v30",
+	"oiqsuBBL/KlwPz1XS5FdiOUAMmtYkw8u6rZ2/+B4aXZsN8l3xXOlrqoyXlDWerjOt+z82dAmuzEPJcyz",
+	"+rUbPzwuN+ExcmgPu6k3cgDIQdyVHBtewVYDQsuzBf2zWRA98YX+Df8pywJ723KRQi3Ssb+SSX3g1Qpn",
+	"ZVmIjCMSX/nP+BWZALiHBG9anNKF+uRtBGKpVQnaCjcoL8tZoTJezIzllkb6Zw2LyZPJP502+pdT192c",
+	"RpM/x14X1AlFVicGzXhZHjDGSxR9zA5mgQyaPhGbcGyPhCYh3SYiKQnDNBRwzaU9mUxTZ7I5wL/6mRp8",
+	"O2nH4bvzBBtEOHMN52CcBOwafmJYhHpGaGWEVhJIl4Wa1z98elaWDQbp+1lZOnyQ9AiCBDPYCGPNPVo+",
+	"b05SPM/5sxP2XTw2ieIK1Utz8KIG3g0Lf2v5W6zWLfk1NCN+YhhtJypr3k0TaDDnDfaPQ4OmS4RdZtKg",
+	"0ivBogmMV5Y5OHFBqrT+NlTEYiysza1J29MJ15pvJ16UmJFI0Af0ZwOOVEu+FJIGm6KQLdmaXyGD51LR",
+	"viJFgqmlZ7cCGrRRtHnJxL8OTnqv8T/NsUnRS5BaDOOsEMbSGyx9egx9k2GDW0RpDNhjkCC9dVeqQFF8",
+	"L6Vg4+9925j34e+jOv85+F6M22GOh62Yx5x7eNMv0Yv70w4763Mzr4M8YWfdvrfjZTjKABfDT8fmXzHx",
+	"0C/jGE4EUURNH9nN7al1NKOhxmwFBb3m0rzlqTvWv9+9F8lMCfvQy+YQpK4839k9RuPbj55XtPdlGWSO",
+	"nFt+m4vwIz3emh7HUc+eW7C1zbup9Hfga617bRyFUssh2hxPgTjMR9q7M+3dgiXupbdb0dWIa3DHcmqw",
+	"bzQv3Q3vvzgNiZCkeneNHKxLDbAGaS/FGgoh4QingXYhcQrCFPUh0OC1ca7HlKkiR3pbCG0OOANhCaSt",
+	"CpP0D0WKVsw4YgkzMOtHr5ewVqSZyvCjHxGxekfNw+hLJwFr8zk+SATVrZ8AI1hQAhL80IXh60JlV99z",
+	"szoCnc3DWH1So2nYCngOmq24WSUYU4cemtHGkAQ2JGbA5tFUJ80S6e+jLZJG27PMILq0YU+r8yIYBxDh",
+	"vo1BxddJBDxXS3OE5RfqkHdCWT7lRYFT7z3+NPCow18UDBszWAtrG9OB87FwGnj2Dc9WJEZmvCimjbFQ",
+	"lbMCrqFgSjMhJegp2pptc63QyEGzTezZAN6mFli0Gm9oJCOrrq1RGtia03Nvjfrssmj3qa9ow9fQ0YPR",
+	"81NVZEeKVM3nz8Lq4Bok3Xb10AR+vUay18WDn7Cz+hPNLJVbnLMBB3G7wV99DbWAxtbN41U2UyidO68F",
+	"i78JzTKl3RC1CI+T43+A66azO56flhpmfgjNr0EbXuDqOou6V5PvsU7u73Vmp5MMdMJO9xP9hxcMP6PK",
+	"gBSBNfUIevmryKEud5IfosrNhA3I4q7Y2hmzGVqYD4LyaTN5mr2MOnnfOPu530K/iHqHLjciN8faJhps",
+	"aK/aJ8S0BJOesLuT6URzjUHApSqZYx8dEBynoNEcQtTm6Pf612qT5PZq07vT1QaOshNq4/4zitl/rTbP",
+	"PGRKf3wC1SRGSJwe8BTCHUVDXa08bms1Gle0s7nSt5MfOzeqZI2DHeM4avQomXbf1ti0KmeeGSWcdFyD",
+	"zkCNT/Nusa87fApjLSxcWP47YMFYHgF/Byy0Bzo2FtS6FMUx3oirpNiOLhGfPWIX3599/vDRXx99/gWS",
+	"ZKnVUvM1m28tGPapt0QzY7cF3EseNhKn0qN/8Ti4ZbXHTY1jVKUzWPOyP5Rz93JPQNeMYbs+1tpoplXX",
+	"AI66AgDvcod25jwZEbRnMK+WF2At6tFfarU4OvvvzZCCjhq9LDVKUqbtGufFw9Mcm5zCxmp+WlJLkDnR",
+	"PK1DGG4MrOdHIaqhjc+bWXLmMZrD3kNx6DY102zjrdJbXR1DyQhaK52UOUqtrMpUMUPBVqjEfffSt2C+",
+	"Rdiusvu7g5bdcMNwbtJwVTIfuNbQE2/0he2GvtzIBjc7hSW33sTq/Lxj9qWN/ObZVYKe2Y1kRJ2t23ah",
+	"1ZpxllNHEq6+A+sETrGGC8vX5U+LxXFsqYoGSivKDM7EXAsmJDOQKeniV/ZIAH7UMejpIiY4VtlhADxG",
+	"LrYyI33bsfSFaeFoLSS5qpqtzCJJCWEsIF+CHoGP8ZLQEDrcVJ+YBDiIju+BF3b1dAXZlTk6H44HTyqi",
+	"EpOnmPEn331zyU5X1Po0o+afIPDPaW3fSKu3L7VSiyPsJ71LZukbHjd15XVnzfPc/xHJQ7VnY+MuubWQ",
+	"4kG7QkNwtoLPoQgzFNzYaBrntV7iulnGZW0+t7oyyPmUzLzI70YRhhHqIKfnMY7jws3mXpHtuUfo70SD",
+	"KWlbrMJ7fC1suBq1UpbxQsll85Rwj44ID03UWm/hgHs2cGycV3gt6gSTp9JIEwQUqR6mzB+mudq40Idp",
+	"7S4AklktgF3BFtc9r0RhaX2jdkXJQkiY+YnNSGpwvQK4hq2EsUpvu8ThNsNh0zCrpuw30Io4A30yTk9U",
+	"asi5BSbsISA7j1Z6zh0INvV0D8H3DDpRcBpOA1rwQvwGOXsB+ooUeVlR0WVP3ZpYSbfZoyZE2h16zirb",
+	"Qk60+LUDgGbi/ow7gpvzgssMjOu+B2cjIRy8VGrychuWZg3T+jkOjE5aCO4ByXjyIT6dkA/WjLCKworT",
+	"gAklR5IRdfebEneno/p+CMkqywtzGFNxfRgn/cFYvp28oz1dBdYW6HrajseLrpca4H24H2BIOw79GHnh",
+	"ZTg/3IsInlL4kgtp7A7yP2muXvKefAaF5d8qfdno+b7TqiqPLk505xwrBtUrdESaY9/gnCbksmjHWi8R",
+	"9uQaP8iCntbWFrcGgp4k2ediubKRYv32ItBOGFOz7CMo7NM3Lv6ocnyE2MocQQXVDNa8jJBm4/cQn6vK",
+	"Ms6kyh2HqkxaObVDBHPRjDF3dcwJxQpA6sp4hautSkaxejtkvBnPHNeYEWoGWFUTYuZauelc5Gehgeco",
+	"5YFs5DYfqESL5BRoaFv3V1UmGX4EV6lVBsZAXrOYfaCFdk5otDvwRIATwPUszCi24PrOwF5d74XzCrYz",
+	"kg0N+/SHX8y9DwAvcfk9iKU2KfR2DY99qMdNv4vgupPHZOdMmo5q/SugLMDCEAoPwsng/nUh6u3i3dHi",
+	"rtnfmeLDJHcjoBrU35ne7wptVQ4ke/DqfdQM4YZJLlVQyKQGQ2F2tv9l3JJ4mcEVRJwwxYlp4AHZ+jk3",
+	"zgeLCZmT8dc0kjX1oSmGAR5UX+LIv7iPqbEzJQ1IU5lajWmqslTaQp5aAxkGB+f6ETb1XGoRjV3rSq1i",
+	"lYF9Iw9hKRrfI8t4dQD+wW307sDhEosjT3+857dJVLaAaBCxC5CL0CrCbhzwPgCIMA2iaxfWNuXUUfbT",
+	"SfmonGng2YrPRSFsQnXx8tHLV1GDGjPxb/4k4SzNwSyreSEyhkjQEiweuxws6HW43M8qq348u3ziW05Z",
+	"qcU1uUZoVskrqW7kCaM8Q5ShYQWS6Up6ybd8VDIJ9kbpq6QixlhVlsgG7aySNUKG9v/CtT6zPzdt+6eG",
+	"22aZuQJDLjS+vfsCN45kXA6HFTfMwxFM2GTfcjGb/c1ALjMzQmYw23WkSeeNreKzvZf7VOVS8xxmORQ8",
+	"sc0/u8/Mfd41AJFyo/9XFmYuGD9Nzc0RDe/JHUMrGi9xG/yoGH1hGfIWfOM0lO977xk5Bxo7xXX9Afmk",
+	"HormSm5RGI+WPehTS9f8tUIzXaAHAtlfVWMAHsBDPfTtUUGdZ81DvzvFf4HxE4Q2t5hkC2ZoCc34By1g",
+	"wDju8xxF56Vzb3WuluR9MMif9/CRoSM7YKl/ybUVmSjpEfcDbI/+pu1OkHSdRC7MBVpdow/ufVvG/ZkL",
+	"I++Oebs37ihjZB/8njUysZwQAtAG/gq2pEx46fKTRDqcYzzSE6My4dIOIaAh6wHk7XQqsOGZLbZ4FdoV",
+	"bNkNaGCmmjsn1r6DCbqqxgMkHVZ2zOj985LecTsdBi9oqGh5KV8u99jZDd9l58XTQod/5JRKFSNMhj1k",
+	"JCEY5T3MSoW7LnwKpJAEJ1BSC0jPtIttANdfFTGaaQXsv1RFBiuk7MpCLawpTYIC9qUZhInm9LGgDYag",
+	"oKiGGjv373cXfv++33Nh2AJuQt6w+/f76Lh/nxRUL5WxrcN1BIMiHrfzxPVBmmi8+LxQ2OUp+53+/cij",
+	"lL2dwcOkdKaM8YSLy78zA+iczM2Ytcc0Mi7gwW5Grvyy7SHeWzft+4VYVwW3x3DjgWtezNQ1aC1y2MvJ",
+	"/cRCyW+uefFT3Y1yokGGNJrBLKNMXiPHgkvs45J/4ThCCitCzoWxAMG563XhOu15OzeeoGK9hlxwC8WW",
+	"lRoyyJ0bgjDM1Es9YTQsy1ZcLunBoFW19M6jbhxi+JVxOiddyd4QSaHKbuSMtPepC8AHKnhWT+IUPs36",
+	"qn/3gLnh9XyQt+6FkXvQNYUkvYamk8GnPCL1unnKO+S0c7eNuAxa8l6En2bikb4lhLoFuQd08RVvCx4m",
+	"3NzfxxbRDJ2Csj9xFErYfByKJkQ9QrE9gtDjBmIaSg2GrqhY/2bcV7WI8zSGYJGtsbDumyhc178OHL9X",
+	"g+9FbyBcKwnbZGpiIeEFfUz1dtfkQGcSWIb6dt8gLfg7YLXnGUONd8Wv2+2tzJ5W2ih9jGsucyOlTzE5",
+	"XPkWUxcL5BQ7kq9hPGupAd7rfBiguYvbmJfeEMS8tQKcPWJvZ5kV18Juj4NE3MFdtwzy1lofm6nrgMmu",
+	"EME9VEzIHDYnw6rGP0iIRfdxMO4Z2Htc1ZswgkIiVHfmP1CsCho/VWUryKN48mkrbk9pF3w+Re1fFCjd",
+	"Jie6LbtmcfOt0sfy13QDjsbxCDeHvcj2U972NGJgYN9/wWdU7F7GZlrTnSCEq0zQ++k8N1Mfo+lcHryz",
+	"Sxv9Ta6HI9yD3XE7hvo4WS8ZoqAoGWdZIchMpaSxusrsa8lJXxwtNRFhEhRjw6aRp6FJ2haTMJX4oV5L",
+	"5x1Ua5GTJ3gBCZXpt1C7s5pquQTyq2zl9Qd4LX0rIVklhaW51nh1zdzdVYKmMI8T1xKjZhdIE1Y5r6l5",
+	"ZdsvcUoYaiwaWpzXAE7D1OK15JYVwI1lLwT6suNwwSM5XJ/eVlBjIc07lyDBiCEXw+/cVwoz98uPXcV8",
+	"5xAC2He3apKW/59P/+0JJivns98ezL78l9M3bx+/u3e/9+Ojd1999X/bP3327qt7//bPqZ0KsIt8EPLz",
+	"Z15Ldf6MVBFR4HQX9vdmZMQcuEkii13NO7TFPqXUzZ6A7rUV1XYFryXGEViF7rOCXO1uQw7du6V3Ft3p",
+	"6FBNayM6iumw1gNvojtwGZZgMh3WeCQHc1x8OnEsbmTIBYut2KKSbivDS9ilbQlezmoxrZMDu7ohTxhl",
+	"jl3xEIHm/3z0+ReTaZ21tPk+mU781zcJShb5JpXXN4dNSm8Th6x/YljJtwYGXE0HPH5rB7J42DWgws+s",
+	"RPn+OYWxYp7mcCGDhtf/buS5dOHWeH7Ij2LrrZhq8f7hthogh9KuUvUEWo8matXsJkDHt835DE+ZOIGT",
+	"rv41R92NjxkogC/i0IARbKI+B47QGn/ZGuvxQg7yaO2QZRxs7i//44e6+IFTcHXnHI5w8QwTg1vehXzD",
+	"cVLghFqrzgcXeT0iN4szfLyWr+UzWJAmUMknr2XOLT+dcyMyc1oZ0F87D/aTpWJPQtKlZ9zy17InaQ0W",
+	"OoryRQaXhivYpsjTFa/oj/D69a9oYXn9+k3PAaz/lPdTJfmLm2CGgrCq7Myn3p9puOE6ZYc2dep1Gpl6",
+	"75zVCdmqcsYKPz7z46d53v7st7j8sixw+a3ccNTJudEbq+rsIFHCNdzfH5W/GDS/CTrOyoBhf1vz8lch",
+	"7Rs2e109ePAZ5VlpUt/+zV/5SJPbEo6fR5cW7lQ8FEg7wyT8Jrl8C7yk3Sd5eU36xqJg1K31lgzRzzRU",
+	"s4AoAd3ABjg4Ds5VRYu7cL1CmaX0EugTbWE79+Sd9itKHXrr7dqTfpRXdjXDs51clUESDztTV1/xgQLO",
+	"5QuNqngIfKGaOYRgMyqIAevSbqet7mrREjSjtM5UW8ble6EIMjIWYs2ZMudeFOdy200zb1y4Nw36Cq5g",
+	"e6ma4giH5JVvpzk3QweVKLVs5Ua8Y+bOQBZParoIfYYPshN5j3CIU0SxLzMkIYLrBCJ2J4g8fKE43p1I",
+	"P7U8ITOQVlzDDAqxFPNUWbz/6NumA6xIlb4SkA91qAc0aK4W1oTQMP+812jvYhTYpEpleOGqnCUdqOg9",
+	"tAKu7Ry43Wlzk3HylQAd9mc3eLKctp2iJWGD+y0sac9RDZd7RZFr40MkToadXB3gkN8SntC9eSmcDL51",
+	"PeoSFYDCrVxjt37Wek1wTGeXq/o7JUFcanWD+2IoQtZRqstnHd0vleFLGHi7xJb0kUkvW9Z3GmSfRJKU",
+	"QdB3py1q9CSBJMiu8QzXnDzDgF/wENMzs+P1HWZyzhrefkvOph5h84IE2No93u091y2PBrncBVqatYCW",
+	"jSgYwGhjJD6OK27CccynEZcdJZ39jimOdpWKOY8clqMiZXUhmHAbdjlo793vC8aEKjGhNEz86B9R5sUF",
+	"Ilbp7VCSRNMcCli6hbvGgVCaXPHNBiEcPy0WxFtmKRfhSEEdCQB+DsCXy33GnJ2SjR4hRcYR2OSERAOz",
+	"H1V8NuXyECClz3XPw9h0RUR/w8lgkCoJFjMqXzETA7b/LHAAnxgwLoPRCtsIVTCmDNncNS9A1mH6zSC9",
+	"mgv0oOjUJ/FucPeGHho7zMTuyj9oTdTjVquJpdkAdFrU3gHxXG1mLn1S8i0y38yR3pMBUtgreTBdbZhP",
+	"DOUkQNdKulpcQM4eWIbhCGA0AFB9BVw79RuSsxwwu6bdLeemqNCwT2upsyGXHZnA9049IFsOkcunUWWN",
+	"WwHQUUM1tZO9WmKv+qAtnvQv8+ZWmzZlzELsaer4Dx2h5C4N4K+vH2vXwvi+qXkyXFfBN3o/RUD6mqW7",
+	"FGdxnQkQc1Btli45tIDYgdWXXTkwidZWqw5eI6ylWAkTMmGU7KPNQAH0CJ61RNPZFWzTb3mge/widIuU",
+	"dbR7XG7vRc68GpbCWGiMRsFH70Oo45ssCYOrs6Ve4PpeKWVTuSniZb73FVA0DPk9zMjillwCNvrWkBLp",
+	"W2yalkBbm81c8V+RpzkuTYuRobkoqjS9+nl/eIbT/lhfNKaa0y0mpHOWnLusQakggh1TuziTnQt+7hb8",
+	"nB9tveNOAzbFiTWSS3uOP8m56DCwXewgQYAp4ujv2iBKdzDIKKtFnztG0mjk03Kyy9rQO0x5GHuvx2jI",
+	"rTF087uRkmsJxRNeeo3NmcbYyyKxpFqpQ/FcyMWhtO3sDIn1DQ1HsdKteNr28OieHIz2+bQT18go0Cqw",
+	"vugt3/FeiY7MIWnPai3OsBNEpiF3gSPp4XKxBFPDVShyCPvl1bdMVbasbGcmzZrxktOVoIUaeL+7b+0R",
+	"ecqPIa0VroS0rtCtAZmsY3DZV2oHuG+her4BzJ2SnsV9G4eccavqRpQ4PNZr7eQraiaa1IBOaxreeX7a",
+	"5U36pye0c7n7UbI3oK/bx4fllXZBzY6QmT+MLuMjqZzWwE3lX67xgaiDrbFpMyBIx8Fbh6N9QvOt5GuR",
+	"zVw1XwqjVNXA/rg2zLdhYKxY07Oknr7BIPNoC7nWumf4BJUTFNBdSSsKBpJiJPoDGBcskakCGT8MnG+Q",
+	"+UByhp+l2Di0dHDWjubvooucWv0+BEhvVqKABple6x1CudOA3QKvQjJYLKh2vQzH+0EPf0OeJ3T8TdL7",
+	"pOGvpuHfQQdS08dhdX26F0fCDrFX+ege/rdhWcj9j7vvO+4Qu9Jg8OmUwO4vztwZGri0CHfH7mUYMYVX",
+	"Ty8DxZzwC1sIfQQg3GjjijZNWrvSo/+YRFsYjZazk9E2GEmIKNetXag34VgiSjPyDa+3+D0LJ9SW/ufP",
+	"sSanxjpZKFmifSvqh9L7XFmr1tHktxEt6tXf6qBCmZ4Dvxxjhh0uh/WuYZsnzKiFnVIJFsQZ8vkkYnYJ",
+	"Kk5h2hZXQqoEnq3qTM31eqIrBGT7W0xLwd1sXsm8gONJO4h9P1ZH5Bkl3FwOXV5n9Z1VMxoeOt3tpMXD",
+	"HuGE5cDztGRG0rr/WtOhm33clbvgxg7b1a2v6sPZgrtidBjUsw1TpG3jI85kZITuYOv4p/OIc+2h0Hqb",
+	"PFr30GdUUSwJfaGWS8hDpaTgzCmjelTtBM/4+47yWyfMVcGiIlY76l/5fA4wlM0hslXNKH5r4M3VNHOQ",
+	"N5tAtbtokiVIVwgg7dOglntyRVCLSCB4z468XYkmGU1/2fHEbsLc3S7V20kbUADPvUHNQFjfbp1Sf0M8",
+	"6qZDcfitKpK79T80INGUsCZSivfIYkB7yMtS5JuO1+SuuL+RRoJmsAFTAenF/GB7MNCOpk8SXNP4E8N8",
+	"zL73Djslg+0pmhR9oXwXoY70zTOfotK/jdsh8r1z1RgaR679h18urNJ8Cd6FcuZAutMQtJxD0EAd6rUL",
+	"FwuRi8UCYtdBcxu3txZw3eOWorizEUR2EI8Xe89PA+N+lKUpJkELQw7lCW2Wbxv7QdRXQrQ1t1B2JRNa",
+	"/gDb2S9oMWclF9o0cd7eZ7KtOT5g16/XP8CWRt77VEPA9uwKuU28AqLBlJta/clEIbGfmBhjzjba2sID",
+	"duosvUtH2hpelruJv7ll4hV1lnKXg9F4+CMsY3bjIu1Yj6cH2ojvkvK+TRD5fhkkMlbFUwlyUE9fRXW2",
+	"1n20iyWaAvHSciZ1fMht3dhTt5kfcQ+uX9YXaBLPFCbp3JpbUSkHopyXGHzEi5l39h+6/LW69pc/NQ+x",
+	"Ae/ZDJem7Mtvzp6/9OCjVaQArme1GXtwVdSu/NOsSgO3ao9hxBUO9l46zs0h2vy6uGscIHBDRYI7nhIo",
+	"J3rialhod7wQMLBIR2vv5X0+TsUtcUe8CpR1uErjsEudOxEq/JqLInjKBmgHIqtpcU2M0MFcIR7gzpEu",
+	"UcDS7Kjspne606ejoa49PInm+omKvqVfHNKXhCNW5CNX+NGlp2+VbjF/n+IqGfny+4lVKGQ7PA5o/byf",
+	"cU+YOmFO8Prb8m94Gu/fj4/a/ftT9rfCf4gApN/n/nd6X9y/3wfa3XZpJkEuFpKv4V6dImBwI97vA1zC",
+	"zbgL+ux6XUuWapgMawp1ISwB3TceezdaeHzm/pccCsCfTsY80uNNd+iOgRlzgi6GUlrVEZJrvsFMA4Yp",
+	"2Q0IpmxqSFrE7H11d+dJ3D9CslqT9+3MFCJLxyXIuUH2Kl0kIDZm1HhA0YgjVmIgsFRWIhoLm42pRtgB",
+	"MpojiUyTLIjY4G6u/PGupPhHBUyQKXchQNO91rnqwuOARu0JpGm9mB+Y+kTD30UPssNZMuiCdilBdjqf",
+	"PqsdIsNCay9uLlthPAeEL8cz9hj3jtBjTx+eml0qlhXcytjivEiT6gPv/hoYnfc0HZhjqWbIFkM/l2hY",
+	"mNlCq98g7cVHzo+JjKp+InqOUO+Uar3LUmqP6LCeePZ92z3+bTy08Xd+C4dF02+mnY9i9GWaPtWHbeRt",
+	"Hr0mXQh1OomPZBou95G149oHWAsdryiSkyxwIXSGS3eeXDrRVnqU9KmMWphTN35zKj3M3V3NCn4z59lV",
+	"+i2EMEXb2wrysYqFzmEDTJ0s083OovDjuq1wJQlK0I0NIuXDdqt3jZt29IumecBgx9bTxdXe5IVRiWEq",
+	"ecOlheCD7/iV723A+Y9jrxulqVKKSccj5ZCJdVId+/r1r3nWjz3JxRJncnVEGF9YX2bDD8RcORaiolyY",
+	"suDbOgWsR835At116jMZdiMX18JgFC61eBhqKxq6LmsjZt0FlwfSrgw1fzSi+aqSuYbcroxDrFGsfnuS",
+	"kFdH1c3B3gBI9oDaPfySferrTl7DPcSiF4ImTx5+SdEg7o8HacvqgleF3cWyc+LZwSKapmPnx0BjIJP0",
+	"o6bNowsN8BsM3w47TpPrOuYsUUt/oew/S2su+RLSyQXWe2ByfWk3yRe9gxdXnTYHY7Xa+lKR/fnBcuRP",
+	"A04kyP4cGL4o5dpHnRm1RnoKjDQctjCcK8HreHoNV/hIwZtliF3r6Lre8zOGr9P0wCnE9key0cZonTLu",
+	"yuMUovEP8gzxhJ2H6ltUcrh22XC4wblw6SRL4hZitIAW0pL+o7KL2V/wWax5ZkGniwPjELP5F4/7IH/N",
+	"DXzxuK7iKQ8D/L3jXQN5sSZRrwfIPsgsvi+mcJOztUBWf69JEBidysEo0+S0diiocffQYyVfHGU2SG5V",
+	"i9x4xKnvRHhyx4B3JMV6PQfR48Ere++UWek0efAKd+jnV8+9lLFWOlVSsznuXuLQYLWAa8gHNwnHvONe",
+	"6GLULtwF+g8bvBNEzkgsC2c5+RCILJq7Mr2hFP/Li6Y2IBlWXRqdjg5Q6YS20+vt3nOo3GFat6791kU7",
+	"0bcBzI1GG43Sx8pA6Dj93PT5EP5CXZDcnrcUjg//xjS+wUmOv3+fgEa9o2v6t0ftz46937+frmSVVLnh",
+	"rw0W7vIipr6pPfxaJRRgX6uN48LBocgn9+vv3+AlhR+QCc79UFM2b/GX9y9FHCc5STpUMn0KMDISvwQ8",
+	"0B9dRHxgZkkb2ITYDx/2r9XmmV+d0mmSyevvUZA2Z1+rzVjC6dxBgXjef4hxekMT4Pk9pVtag620hLy5",
+	"rulrrVBzeSZCMWDKY31wmXxCx8D+jNQVEtxq0bm3kr4De51XogODo84BfV1Nq4Z3bFz4A2963xA0me7A",
+	"diWK/JcmS3rnVtNcZqtkvO0cO/7VPRha8oDj2ymsoflTQpEczj20/xoe5AmVwd/V2HnWQo5s28GVX25n",
+	"cQ3gbTADUGFCRK+wBU4QY7WdgLpOcFgsVc5onqYGbcOpTyaJvXoG82p54RIbmpc6lTTZDbuurHeipegF",
+	"n7p3IQr834ARm1rONLcDXv+aMgItmhFdaKbTebjRQTMu1iQlGI6FwelkXgM6K2JXJaHTnZKR08itgIUS",
+	"P1FLSv2oGLIjphaLaBkgrdBQbKes5Ma4QR7gsmBDc0+ePHzwIKmDI+yMWKnDYljmT81SHp5SE/fFs0FX",
+	"4PIgYPfD+q6hqEM2tk84eqsr+crx6hRPpQ8uBxR2JhEip04MZE463BP2HeUQRiJulftAaOrSWO3SFFVZ",
+	"KJ5PqWQXugkxN6vr4y4ZliNRLxH+DvknbT3jS3WEHMkDOWjHj7M7KaYrQUMBe8bydZnK8o8tLkMDJjoO",
+	"QKRUjLFzwp45fa4J2kI3CaPCb3oNOaun8xoFIg78j7UuMsqqljg2zCubisRDlTJe+haBnTVmpCiPz3X4",
+	"SAwb4XaeBsAqmYOeMqqPcyOwCNeKW7iGdmGBAEZdE9sXGmgvL9SxFvLkAMm4Lk1+KNoDcDRu7eGQhKyD",
+	"+EMjilSlMxhPk+48X1CvdGBIp25QxwUhpKkPhePYC2/pyLhUUmRU4DMl1lMS9HE20xG1UNPGTjPxJzRx",
+	"uBL02oj8AYt+/W8GGaFHXN//IPqKm+qow/1pMcCdzHtLsMZzNsinpMESBXjrnJAGfPF5JKKYTyqd8LBK",
+	"RmXU3hwHkhHlNx5Qt36L3370yng8guxKSFK7ebSF2Duyn2FGSKR2yYRlSwXGr6cdWmR+xT4nVO8gh82b",
+	"k+dqKbILsaQxnE8fLts5sPaHOgvurN59FNs+xba+ImT9c8s3zU16VpZ+0hQnMPUO9z5h1cMhBKecqIJX",
+	"S4Tcevx4tB3kttMPne5TJDQsFeoiCPEe7hEGaJ16rmKh0MpRFLVgLjdRCinpiM7nQgZ7bvqCyJJXAm0M",
+	"ndeBfibT3GarFhva5706EI1BMfLZ1TGG6mywj54ss0mYY3gbLzfS1+0cYBx1g0bi53LLwqFA6o6ECYzF",
+	"rP2CSQhqq6ZlXgtROUU6+WBnJ5alGQcy7lmI32yha28sYd2dasweehMNZfufV/kSLGaSTyWJ/pq+Mvoa",
+	"Itawzm1Vl1avQxXb1b4SOQHcRJmSplrvmCs0uON0uTDcGFjPi4QP67P6I+T1DiOloZkH/03VFR/eGe/B",
+	"fXB+q+CunR9W4q6frysl9SJNzzCT8XhM0J1yd3Q0U9+O0Jv+R6X0EDv8hwgN7nC5eI9S/O0brZWOS+D0",
+	"nOXd1VJXqCHHdEXfQ+rgurZCJ18Sd0Tbm9NvXmLLOsCHhknAr3kxkFMuNty4+9UZM4Yyy2WDiRC59Ymu",
+	"LWc7WdBg8mDnuNwxBfXtmUPOys5X+XgmFL/WnQgdNiT+0DIbOoe1hlkMmgtvZ9FrNvhQk973wAu7eopF",
+	"Rwa0PHURa9WksvBlblfU2ZUsMQmiBstFETISCncMXraapDy/euW8nU4d56WZKLUJOHmO7OVTZqpsxbhp",
+	"JX4yU1YCaOYT7CtNzldsDUtOJJbUAEXHrZt5YxtBkIvcuW9xM+yLk8Zn43zjB3vCMhQAq3Ia3syzgi8d",
+	"8GbKcmGupnjTZlegZ06vyqjEbpRV8mRyQFb6yxV0ktB7OHA1U3bDtSRscVGcjDUn+bn2UJjZRWLmMOLK",
+	"6gFHXUsxnR+WTKxVPLkNolPipdncLuyvlbHMoHInuRPmdltRZ8sOD32PotSm/HA9lGM0FLql793y1lfg",
+	"yxGVGq6FqoIHaIjDCJog96vPYd0qnDvA9pLRTR/acjpoFqRzDDd+mX7rfvjFeYIwkFZv/wBW396md6sy",
+	"Jx651CK6p7zmq8cqB3RZLWF4TBHoVL1h/yQMKnInUbRoqVe/uUdWz8a8Anr4eDednOcHycmpmtUTN0rq",
+	"2D3H1F5U8vJ74Dnol3tKejZlPOmIlcqI+hHGChws5GSj4U7GBjwhAYu4JGl/rOAIfw2ZVbrl4KsBDilQ",
+	"ipMFW+/H0p7DWrQ6LsxX9NxVxnM6aeXI/wG2O1fG+5nHo+z5gzkMh4pWntVhHC4KFQWyOmVUJ2/D6Ohx",
+	"yk0qrtuJtlMZ0GSURXxap0KzlPG0Sfwu6lhKKox3uLGhAajgt4Sn4McDZyiXxhVsPzGsRQ3nz6Lxe4HE",
+	"t6m8RRhwlu+QaHPIfuRdYYSpKYOwEMISXHdoqssOFk2L6hbccq5AknhxNLUMdkx5rSzcci7selDdFAoL",
+	"HEoG/9IVR4muy2G1wzP3yvJOuryu3BUr59DO0K08feMrf1Fe/tpkGmqAgQm/hSIcbpZCXMW5ip2BGuu2",
+	"hBZHSUxHzVBFnwJ6Uc8smiCyvm9Tf49dPGZWKBQjZkNBre24rdrp+RPjvNObJGIE1wK0hry2hBbKwMyq",
+	"EHS2C45dqDDkgn8rJJjB+uEOuMHaca+a4nhrkWnFqVYc95738QKZhjVH6HRUwm54zl3Ifuq+h0QgoY7+",
+	"XsVyTa+zvW6eIXxQmB4SY6pfMH9b7k8wchsds5AS9CwYnLv17GQ7KyQVrsmrzF3Q8cGo9fCj83ftYCVJ",
+	"9WzWX2XnjRAl6riC7al7BPmUHfUOxkA7ycmBHlXs6WzyUbXuJgX38ijgfdhclqVSxWzAxnneL8LXpfgr",
+	"gb5iTFVNmI3XZcQtcRL2KZnWaieWm9U2FJ0rS5CQ3zth7Ey6wMbgzxKXAexNLj+xu+bf0Kx55epiel36",
+	"yWuZjhCjipX6jtwsDLObh7mqDnecyg2yeyK7kUOedjdU3RLyGKcnY1/lfQ+TbsLchqgcFCmZ5MIZqp/S",
+	"QU8pjigNS5QviPwXOPMGbmYKlYonuE2qGBwqjal4MgLIghyTsaSGwg+eRIB33tuTltR/Dok31YJpaHxH",
+	"bpuB1Cf1dKzZDL3ouzPXs7T53UJpiGck31SXbbgOvkOGQx5bei6s5np7mzyhbVSltCeDWN7rhVk7YDYL",
+	"aZww+zgsCnUzI2Y1qwvFpp622M60L2Nf0rApMEueb3OI3Dm58YLalq14zjKlNWRxj3TMuYNqrTTMMKt0",
+	"MtvLc7GwKHevKdBUYu5hpspM5eAKLqcpaGiuSqLGPp/VNDmIAkc7uFLfJ6LjkVPinerMxzMStfbWJwyb",
+	"f4l9XPaMJrOcW/TMuTAMBCqA8ZnkPIZc4z68RDgu9VJXlzhUcGVDdAM6deQXzGqMCvEtaPQWCdHBp1I7",
+	"whgHSk1LN6IoKHmF2DT8AGp/pTRqB8Tec/KmvhbkctdOZEI9WIl3Xp3dJeYBF3HqNWZXmirnNBU+ajjD",
+	"k1dX/kEcj/KzqcgrkuJjcIrHztrhXppupGbJjafpp5mSVqui6BQvcnTjNe0v+OYsy+xzpa4wIck9etdK",
+	"ZeuV5tOQ46HrE9zMtKsUy0bOiAbM/nThrh3OErjAaAbZYXE9pfg+LXME5pv9HHS/zv2sv7DuutrMNP2M",
+	"wazKVq1Flj5Tfy4n20HX2BSLSqHC9XAH3xExHfb4sqp9qohF9tEMEgk2tV+eEXjfEmI3+F+SwLvjsgVw",
+	"25s7uij7zMVLUbNsUNbrAECQCrn0wQr4v5YkVnMVtXTpWsgzpgvoyFuFHBDvBhuOcHSgLNwJqJ7Tcw3g",
+	"p075MHX5LZ0DNQbN+e/3mgSYtwL+3W4qbzGPIc/Oi4a0vMNISJY1wBHSafZ3ukFeUuqN+VhnSBMsWCNv",
+	"+AiAYffIFgyjnCQPBQNt/Vig2g5c7qSjmkYvbR+RGY0eCnPRLCzj7sJG+wgXRaXBJ29yIr5u279Kblfh",
+	"6sTmfU0yaiV9VOtvoBXZufNpZH+BwtUk7CgDVDkr4BpaXqOOlk1Foqa4htDX1J1ZDlCSNbKrI0u5Q8Z3",
+	"eUdx4tc+G/TwSWM3qUlxiHU7xfaoSZJKnY2cuWNixh4lhOha5BVv4c8cKnK01YB4lBOo6r0RZuEdOXaa",
+	"n90Ir8IAZ6F/SpQJmHgzjg8dzILSqNvFgPa6R1dm6NTLtHd0nC6tNrDQbHltiHUk3vANU/IbOayQ7JN8",
+	"89wauU9CyQix32wgI6nGv3cg9y+eASOFz7xE1C4BcvcqwC4JbfsKJJOqefaQNjI8VZo8ruEHNzE1EtK/",
+	"pm9hVG6cmO++s4wGY6aT0HHwIaFrOr29ev6DnMSdB3FwvBSNGPBRvzv0X4G6/bODGqiqyJnE/UTZf8Wv",
+	"IdxinotP2bwKA6G2whWJi9+hzyDYQZWMTUBuRSETonM+JHS7G6yv6hBRmApa8JWmf6Sy7B8VL8RiS3zG",
+	"gR+6MbPiSELe8FoXaxNQEKS7xatpAMyDkKswlVu3GDtmNNwWR4mAxos8FBhTbM2vIN4GcnZw/DOzyDhN",
+	"NSfNBV7Zne3sY8EvPqSJWvM8fulTstptizuE9OXY+/9vQmDjqUKOybLgGeStMmltPoPCUE1cdgXr3THS",
+	"fb4WSCC0iohWh6Qa+S1UpgeyrlTg0VAJqBbY0TOiXQHqOMsYqfnt1PnZEV0+ainH3oXD6n9GQJPpPiT6",
+	"3AO+S9Ds274X/CfzSA8tYwz4fxS81wXYhuGlJu8Dy63EOwlYnbZ6rjYzDQuzz8GEWiPwDcCmVrEKmWng",
+	"xnncnP/kH55NmmQhWYisaGya9Sg5LIRsmKWQZWUT7xjKliy3EcJipT+hdcCENiQloDB5zYufrkFrkQ9t",
+	"HJ4OteiWqQmGDt83ocKo79T+AMI0bzgKy27U6HEzvMBdITznrmkslznXedxcSCrmy7FMKd+a21uUauPA",
+	"PpsSj6SZdrKQyLpEpO0AKbbeKHxHe08NID+i4WeEweZyBZ7628Yap9qxasA+04fhT2GwWfMN2vgoeHgo",
+	"LMTlxyYLHzWjkKuMSyefjVt3mMeI32D3NFQaxDMiq2jWMVPsPvc/0VbSM/JnKezOk+90lN1obud36w5m",
+	"QKpcNs7/jlj657HM0pOV7SD8OtLGh6oE2oNoE2HAPtTWiw/sIrlB+OwNsRJ8fMnFtqdFKszfaQZmpDEw",
+	"O9z7m1R0hGvjVUk9d7OuqsEhZeqTJByoaXP6+XAvDYDng/rcWW9PW7vM4DiH1KncnRZhVqpylo3x+XTV",
+	"g3IHQIC0DeNwzBfspo7aPcbU9bRiamwX1jq0VOdgYa991q4y2/XoH1ITDXD0tglCLYiX0RF2yjGlY2XK",
+	"tBtj1laD1UyCcaYhqzSpiW/4dn/pw4Gs9Rffn33+8NFfH33+BcMGLBdLME3lg07pwMYvUMiu3uf9egL2",
+	"lmfTmxCSjtDn2v4YgqrqTfFnzXFb06Q17hVOPES/nLgAEscxUbLuVntF4zSu/X+s7Uot8ug7lkLB779n",
+	"6KaRrjxTy1UJA0pqtyITCr5AStBGGAvSdiygwjYe0WZF6kHKP37tkkgpmUHQH3sqEHbA5Sq1kCGHWuJn",
+	"+CkU+2ewKQvPq25CRPjguvw7zWnoSGgkrxjUYqnSi/ZiwVIQUQSRjiJrveKTNOKRj2zNbJ23bIoQved5",
+	"mvTiov27uX27oLRNc3rcxIR4EQ7lLUhzyD4xnK7kNpykUe3/YfhHIv/K0bhGvdzfg1ck3wc7Yo7Pen4P",
+	"de6RUaD1c3EkyIMAGIi2bcVJRoFiUTJ07awEZE8IBuSu+PGiMSzvDQshSEKHPeDF4bNNuzqSwYPzgbOK",
+	"v6iREi3lzRAltJa/LyI3sN76Iom2yCtNrAXj2JLqi4VRuLV5WkcxD7xKesHOWinLlETdSCJI2ulx6EzF",
+	"hCOkBX3Ni/fPNb4V2tgzwgfkr4ZDo+JI2RjJDpXmduk5n/NRcxf8d5havqTA7P8A3KPkPeeH8kb43m1G",
+	"yh1eOPfqRW2NBsluaEzaafbwCzb3BX9KDZkwXeP+TRBO6sBQ0GgdoylgY/dEou5b5y/K3oGMF8ETh/0Y",
+	"mbdqm72HsDmiH5ipDJzcJJWnqK9HFgn8JXnUVmZPK21SIuEZCypRi8SjJD7djdLORWChSH+rBwLqx2UF",
+	"MluZ+VFPDixsER/rm5Uy/i71yZOc70OsAUPAJUBu7porq1VVIoXTuOj6niv4jgV3bpdBK8qFeWAGrX45",
+	"+bHLo3XQRV4Z6K9ztATUwm1C+GnWNjb92+i6PVgabT4ma1u6xg52p7RxRym2c1Cpnd8hYZzDkR/Dz5ui",
+	"mF+GUoi7NNkDZQ46+4EVEfZaKuOiFRjEDBKMMFSW4a++Jtj7lU8CBC6bRf+oOljvkoLHISax1tbk0VRR",
+	"OYoRlSh8t0TyOIoUzSot7JbqwQelpPhrMsfVd3W+FJ9vp7ZPennCqiuQwYemya5SmSCxfKd4QXe8M5tK",
+	"YFap4oR944ol+IPy1Sfzf4XP/vI4f/DZw3+d/+XB5w8yePz5lw8e8C8f84dffvYQHv3l88cP4OHiiy/n",
+	"j/JHjx/NHz96/MXnX2afPX44f/zFl//6CfIhBNkBGqqkPJn85+ysWKrZ2cvz2SUC2+CElwJT0rx7R/qH",
+	"hcLlE1IzOomw5qKYPAk//a9wwk4ytW6GD79OfN29ycra0jw5Pb25uTmJu5wuKZ3CzKoqW52GeXq5BM9e",
+	"ntdxD863iXa00cifTBpSOKNvr765uGRnL89PGoKZPJk8OHlw8hDHVyVIXorJk8ln9BOdnhXt+ymlKj41",
+	"vgrJaR3/9m7a+1aWrkYJfvI06v9yOfBaf5zWie/wtzVYLbLwlwaeb/3/zQ1fYnI6ipJxP10/Og1S3+lb",
+	"n6Hi3a5vp7EHzuiGp2+jv2Yi3zNFcEXZ1+T0bainvntAnwxjNhr2usN+SEI2muEWLefssQ1Pva8iEk7S",
+	"VP4dWJ+my+msEjleyELlR58yQ2VW8KdSC4U8acqEZDngYomDkDvMlFldycw5GbgpQNJ/X5z9JzlavDj7",
+	"T/YVFp52gSqGHsKp6V2kfn2YznMHdt+/1Xy9Pauz4DROGZMnv6aUkw5xrKzmhciYk8WIGeFJi3hFPWJz",
+	"F5AmeuLuQjIQ1zcb3lYPZl++efv5X96lXiE90bpGUpQYJka9VaFqNyFtzTdfDaFs4yMXcNx/VKC3zSLW",
+	"fDOJAe5b3hPZ8kJg2Y0vhB37tEberv9+8dOPTGnmtS4vUcccgupCFGUTORoHUWLPIYi98BADDRKLev8a",
+	"ovPWZlm288XXaH4znQRAiWU+evAg3BP+ZRsd4VPPx6KZOurQPqHhunmk4O6nUEDVNM8wARI3kX8NebuG",
+	"qtyd0EdVzjpnfIdKvT+j35Jk9MqhWRz6kj4VPd0D32WngnELHd5VrERBYn/ahB4ykhC8SYlK8dYGGvm4",
+	"u/89drcvebFSkbqE/PmbKydcZy0gvbxdbAO4AwlqTth/qYrkY3z5VBZqFqg0sbP6whQmmtPn02owFIWc",
+	"0Zf797sLv3+/cRddwA0xWS6pYRcd9++f4E49PpCV7bRvtLLOjzo7hwzX26wXfFN723MmlZxJWHKLbhfR",
+	"o/rxg4d/2hWeSxffgA8C93B5N518/ifesnNpQUteMGrpVvPZn3Y1F6CvRQbsEtal0lyLYst+lnUAiXvY",
+	"kXzSZ38/yyupbmRABL7Jq/Wa660XonnNcyoZlYnbyX+6jCcStImL8qUhHyoSUZ1MG7JnyuXkzbvwBlhq",
+	"IE5zasUafJGh8G3k82lXs9O52hzQtPeW2dcBzNjGA2+gHa+47qeBIcj0Zk7fkpr53dDvp94DYOCjU2IM",
+	"fSYbn2vTxVC3pcsyl/7Yet29tRtc0+7hsE00nq8JcPqW/kM6i2jBTqt/ajfylHyiT9+KvP+5h6f27033",
+	"uMX1WuUQgFOLhQG75/PpW/dvNBFsStACiZ0Xza8uofepqcqy2PZ/3sos+eOpM1SYnR9P3+KT5N2YNn28",
+	"xG17H1uZkwd+Pg36uZRepd3ybevP9jkwq8rm6iaahayFztTdh6wuMdD6+/SGC4uSpU/YyxcWdL+zBV6c",
+	"+qJ8nV+bOji9L1TcJ/qxI4uWymXsaqsBXvGby1bgta+G/bXKtztuqc1sLiSx7vhqafTV7mP/XZksIkLO",
+	"7sGNIiG4W8XmWvE848biH74UR0+h8O6Oj9ZuIp3zhCWPwCQdTT/3K/K2/aUgaNwxknm0L1jd20/YRHv+",
+	"7tJsD6Kvec5CircZe8EL3HDI2Zl/M7Ww8XtLoh9edPzAst57E86+DofPME75Lluvap3OUBXVmR0jieHT",
+	"GxnAEuTMs6DZXOVbXwp0ovmN3biEOF3mdsrb11PrGykxzdDHI6h2/9j63H1q3I/a04/a04/6tY/a04+7",
+	"+1F7OlJ7+lG3+FG3+D9St3iIQjElZnpd07C0KdCjmTPbe/fxphhMzeLbqfqErWWyVsw21Z0R9oRhmg3t",
+	"Uj5RcUNesIwbJ135nGBrcqWmhH+QP3ktZy1InMMyTvxp81/nzfq6evDgM2AP7nX7GIuBVRFv7vcleZc+",
+	"uWCur9jryetJbyQNa3UNuYs8j4sRuF57h/3/6nF/6lUxoZQTlMgq5AVkplosRCYcygsll4wvVRPlgHyb",
+	"SUVfQCNwrhYcE3bqo8KET0XgdqVTM6EtufclgPNmC/f6YXTIJe2CgYR3oP/Fv4xxvvgfLaXfNnXcXRnp",
+	"zrHfTT9ylQ/AVT44X/mzW7Yj1eJ/SzHz8YPHf9oFxYroH5Vl3+JhuKM45rPyZsmSeLcVtEJWpqDuazzW",
+	"Yw9wukVr3+9f3+BFYEBfhwu2cWh+cnpKafpWytjTybtp/M10Pr6pYQ6xRJNSi2uE5h1pN5UWSyGxTIzz",
+	"/p01TsuPTh5M3v2/AQDnvm6lN1ABAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
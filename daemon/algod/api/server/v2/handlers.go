@@ -66,6 +66,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
@@ -96,6 +97,32 @@ const MaxAssetResults = 1000
 // /v2/accounts/{address}/assets endpoint
 const DefaultAssetResults = uint64(1000)
 
+// MaxAccountResourceResults sets a size limit for the number of resources returned in a single request to the
+// /v2/accounts/{address}/created-assets, /v2/accounts/{address}/created-applications and
+// /v2/accounts/{address}/applications endpoints
+const MaxAccountResourceResults = 1000
+
+// DefaultAccountResourceResults sets a default size limit for the number of resources returned in a single request
+// to the account resource endpoints
+const DefaultAccountResourceResults = uint64(100)
+
+// MaxTransactionActivityResults sets a size limit for the number of transactions returned in a single request to the
+// /v2/accounts/{address}/transactions, /v2/applications/{application-id}/transactions and
+// /v2/assets/{asset-id}/transactions endpoints
+const MaxTransactionActivityResults = 1000
+
+// DefaultTransactionActivityResults sets a default size limit for the number of transactions returned in a single
+// request to the transaction activity endpoints
+const DefaultTransactionActivityResults = uint64(100)
+
+// MaxBoxResults sets a size limit for the number of boxes returned in a single page of the
+// /v2/applications/{application-id}/boxes endpoint
+const MaxBoxResults = 1000
+
+// DefaultBoxResults sets a default size limit for the number of boxes returned in a single page of the
+// /v2/applications/{application-id}/boxes endpoint
+const DefaultBoxResults = uint64(1000)
+
 const (
 	errInvalidLimit      = "limit parameter must be a positive integer"
 	errUnableToParseNext = "unable to parse next token"
@@ -120,17 +147,27 @@ type LedgerForAPI interface {
 	LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error)
 	LookupKv(round basics.Round, key string) ([]byte, error)
 	LookupKeysByPrefix(round basics.Round, keyPrefix string, maxKeyNum uint64) ([]string, error)
+	LookupKvsByPrefix(round basics.Round, keyPrefix string, after string, limit uint64, withValues bool) ([]ledger.KvPair, error)
 	ConsensusParams(r basics.Round) (config.ConsensusParams, error)
 	Latest() basics.Round
 	LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error)
 	LookupAssets(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64) ([]ledgercore.AssetResourceWithIDs, basics.Round, error)
+	LookupCreatedAssets(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64) ([]ledgercore.AssetResourceWithIDs, basics.Round, error)
+	LookupCreatedApplications(addr basics.Address, appIDGT basics.AppIndex, limit uint64) ([]ledgercore.AppResourceWithIDs, basics.Round, error)
+	LookupApplications(addr basics.Address, appIDGT basics.AppIndex, limit uint64) ([]ledgercore.AppResourceWithIDs, basics.Round, error)
 	LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error)
+	ProveAccount(addr basics.Address) (ledger.TrieProof, error)
+	ProveResource(addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) (ledger.TrieProof, error)
+	ProveBox(appIdx basics.AppIndex, name string) (ledger.TrieProof, error)
 	BlockCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error)
 	LatestTotals() (basics.Round, ledgercore.AccountTotals, error)
 	BlockHdr(rnd basics.Round) (blk bookkeeping.BlockHeader, err error)
 	Wait(r basics.Round) chan struct{}
 	WaitWithCancel(r basics.Round) (chan struct{}, func())
 	GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error)
+	GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error)
+	AccountActivity(addr basics.Address, minRound basics.Round, before ledger.TxnLocation, limit uint64) ([]ledger.ActivityTxn, basics.Round, error)
+	CreatableActivity(cidx basics.CreatableIndex, ctype basics.CreatableType, minRound basics.Round, before ledger.TxnLocation, limit uint64) ([]ledger.ActivityTxn, basics.Round, error)
 	EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error)
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 	AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error)
@@ -161,8 +198,13 @@ type NodeInterface interface {
 	SetSyncRound(rnd uint64) error
 	GetSyncRound() uint64
 	UnsetSyncRound()
+	ListSyncCursors() []node.SyncCursor
+	RegisterSyncCursor(name string, rnd uint64) error
+	AdvanceSyncCursor(name string, rnd uint64) error
+	DeleteSyncCursor(name string) error
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	AgreementTimelines() ([]agreement.RoundTimeline, error)
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -422,6 +464,74 @@ func (v2 *Handlers) AppendKeys(ctx echo.Context, participationID string) error {
 	return nil
 }
 
+func convertRoundTimeline(tl agreement.RoundTimeline) model.AgreementRoundTimeline {
+	res := model.AgreementRoundTimeline{
+		Round:         uint64(tl.Round),
+		StartTime:     uint64(tl.Start.UnixNano()),
+		FilterTimeout: uint64(tl.FilterTimeout),
+		Proposals:     make([]model.AgreementProposalArrival, len(tl.Proposals)),
+		Thresholds:    make([]model.AgreementThreshold, len(tl.Thresholds)),
+		Timeouts:      make([]model.AgreementTimeout, len(tl.Timeouts)),
+	}
+	if !tl.End.IsZero() {
+		end := uint64(tl.End.UnixNano())
+		res.EndTime = &end
+	}
+	res.DynamicFilterTimeout = omitEmpty(uint64(tl.DynamicFilterTimeout))
+	for i, p := range tl.Proposals {
+		res.Proposals[i] = model.AgreementProposalArrival{
+			Period:     p.Period,
+			Sender:     p.Sender.String(),
+			BlockHash:  p.BlockHash.String(),
+			Credential: p.Credential.String(),
+			Weight:     p.Weight,
+			Arrival:    uint64(p.Arrival),
+		}
+	}
+	for i, th := range tl.Thresholds {
+		res.Thresholds[i] = model.AgreementThreshold{
+			Period:    th.Period,
+			Step:      th.Step,
+			Type:      th.Type,
+			BlockHash: th.BlockHash.String(),
+			Weight:    omitEmpty(th.Weight),
+			Arrival:   uint64(th.Arrival),
+		}
+	}
+	for i, to := range tl.Timeouts {
+		res.Timeouts[i] = model.AgreementTimeout{
+			Period:   to.Period,
+			Step:     to.Step,
+			Deadline: uint64(to.Deadline),
+			Fast:     to.Fast,
+			Arrival:  uint64(to.Arrival),
+		}
+	}
+	return res
+}
+
+// GetAgreementTimeline returns the agreement timelines of the most recent rounds.
+// (GET /v2/agreement/timeline)
+func (v2 *Handlers) GetAgreementTimeline(ctx echo.Context, params model.GetAgreementTimelineParams) error {
+	timelines, err := v2.Node.AgreementTimelines()
+	if err != nil {
+		return serviceUnavailable(ctx, err, errFailedRetrievingAgreementTimeline, v2.Log)
+	}
+
+	response := model.AgreementTimelineResponse{Rounds: make([]model.AgreementRoundTimeline, 0, len(timelines))}
+	for _, tl := range timelines {
+		if params.Round != nil && uint64(tl.Round) != *params.Round {
+			continue
+		}
+		response.Rounds = append(response.Rounds, convertRoundTimeline(tl))
+	}
+	if params.Round != nil && len(response.Rounds) == 0 {
+		err = errors.New(errAgreementTimelineRoundNotFound)
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // ShutdownNode shuts down the node.
 // (POST /v2/shutdown)
 func (v2 *Handlers) ShutdownNode(ctx echo.Context, params model.ShutdownNodeParams) error {
@@ -442,18 +552,23 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
+	myLedger := v2.Node.LedgerForAPI()
+
 	// should we skip fetching apps and assets?
 	if params.Exclude != nil {
 		switch *params.Exclude {
 		case "all":
-			return v2.basicAccountInformation(ctx, addr, handle, contentType)
+			return v2.basicAccountInformation(ctx, addr, params.Round, handle, contentType)
 		case "none", "":
 		default:
 			return badRequest(ctx, err, errFailedToParseExclude, v2.Log)
 		}
 	}
 
-	myLedger := v2.Node.LedgerForAPI()
+	// the resources of an account are only available at the latest round
+	if params.Round != nil {
+		return badRequest(ctx, errors.New(errRoundRequiresExcludeAll), errRoundRequiresExcludeAll, v2.Log)
+	}
 
 	// count total # of resources, if max limit is set
 	if maxResults := v2.Node.Config().MaxAPIResourcesPerAccount; maxResults != 0 {
@@ -506,12 +621,17 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 	return ctx.JSON(http.StatusOK, response)
 }
 
-// basicAccountInformation handles the case when no resources (assets or apps) are requested.
-func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, handle codec.Handle, contentType string) error {
+// basicAccountInformation handles the case when no resources (assets or apps) are requested,
+// optionally at a past round.
+func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, round *uint64, handle codec.Handle, contentType string) error {
 	myLedger := v2.Node.LedgerForAPI()
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+	rnd, err := stateRound(myLedger, round)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return badRequest(ctx, err, errRoundGreaterThanTheLatest, v2.Log)
+	}
+	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(rnd, addr)
+	if err != nil {
+		return ledgerLookupError(ctx, err, v2.Log)
 	}
 
 	if handle == protocol.CodecHandle {
@@ -527,6 +647,39 @@ func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Addres
 		return internalError(ctx, err, fmt.Sprintf("could not retrieve consensus information for last round (%d)", lastRound), v2.Log)
 	}
 
+	account, err := basicAccountToModel(addr, record, lastRound, &consensus, amountWithoutPendingRewards)
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+	response := model.AccountResponse(account)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// stateRound returns the round a state query is answered at: the requested round, if any, or the latest round.
+func stateRound(myLedger LedgerForAPI, round *uint64) (basics.Round, error) {
+	latest := myLedger.Latest()
+	if round == nil {
+		return latest, nil
+	}
+	if basics.Round(*round) > latest {
+		return 0, fmt.Errorf("round %d is greater than the latest round %d", *round, latest)
+	}
+	return basics.Round(*round), nil
+}
+
+// ledgerLookupError reports a failed state lookup, which is a bad request when the state at the requested
+// round is not available on this node.
+func ledgerLookupError(ctx echo.Context, err error, logger logging.Logger) error {
+	var unavailable ledgercore.ErrHistoricalStateUnavailable
+	var roundOffsetErr *ledger.RoundOffsetError
+	if errors.As(err, &unavailable) || errors.As(err, &roundOffsetErr) {
+		return badRequest(ctx, err, fmt.Sprintf(errHistoricalStateUnavailable, err), logger)
+	}
+	return internalError(ctx, err, errFailedLookingUpLedger, logger)
+}
+
+// basicAccountToModel converts an account, without its assets and apps, to model.Account.
+func basicAccountToModel(addr basics.Address, record ledgercore.AccountData, lastRound basics.Round, consensus *config.ConsensusParams, amountWithoutPendingRewards basics.MicroAlgos) (model.Account, error) {
 	var apiParticipation *model.AccountParticipation
 	if !record.VoteID.IsEmpty() {
 		apiParticipation = &model.AccountParticipation{
@@ -544,7 +697,7 @@ func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Addres
 
 	pendingRewards, overflowed := basics.OSubA(record.MicroAlgos, amountWithoutPendingRewards)
 	if overflowed {
-		return internalError(ctx, errors.New("overflow on pending reward calculation"), errInternalFailure, v2.Log)
+		return model.Account{}, errors.New("overflow on pending reward calculation")
 	}
 
 	account := model.Account{
@@ -571,12 +724,11 @@ func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Addres
 		AppsTotalExtraPages: omitEmpty(uint64(record.TotalExtraAppPages)),
 		TotalBoxes:          omitEmpty(record.TotalBoxes),
 		TotalBoxBytes:       omitEmpty(record.TotalBoxBytes),
-		MinBalance:          record.MinBalance(&consensus).Raw,
+		MinBalance:          record.MinBalance(consensus).Raw,
 		LastProposed:        omitEmpty(uint64(record.LastProposed)),
 		LastHeartbeat:       omitEmpty(uint64(record.LastHeartbeat)),
 	}
-	response := model.AccountResponse(account)
-	return ctx.JSON(http.StatusOK, response)
+	return account, nil
 }
 
 // AccountAssetInformation gets account information about a given asset.
@@ -594,10 +746,13 @@ func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, as
 
 	ledger := v2.Node.LedgerForAPI()
 
-	lastRound := ledger.Latest()
+	lastRound, err := stateRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, errRoundGreaterThanTheLatest, v2.Log)
+	}
 	record, err := ledger.LookupAsset(lastRound, addr, basics.AssetIndex(assetID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return ledgerLookupError(ctx, err, v2.Log)
 	}
 
 	if record.AssetParams == nil && record.AssetHolding == nil {
@@ -647,10 +802,13 @@ func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address stri
 
 	ledger := v2.Node.LedgerForAPI()
 
-	lastRound := ledger.Latest()
+	lastRound, err := stateRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, errRoundGreaterThanTheLatest, v2.Log)
+	}
 	record, err := ledger.LookupApplication(lastRound, addr, basics.AppIndex(applicationID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return ledgerLookupError(ctx, err, v2.Log)
 	}
 
 	if record.AppParams == nil && record.AppLocalState == nil {
@@ -991,10 +1149,19 @@ func (v2 *Handlers) GetSupply(ctx echo.Context) error {
 // GetStatus gets the current node status.
 // (GET /v2/status)
 func (v2 *Handlers) GetStatus(ctx echo.Context) error {
-	stat, err := v2.Node.Status()
+	response, err := v2.nodeStatus()
 	if err != nil {
 		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
 	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// nodeStatus reports the node status as returned by GetStatus.
+func (v2 *Handlers) nodeStatus() (model.NodeStatusResponse, error) {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return model.NodeStatusResponse{}, err
+	}
 
 	response := model.NodeStatusResponse{
 		LastRound:                   uint64(stat.LastRound),
@@ -1015,6 +1182,7 @@ func (v2 *Handlers) GetStatus(ctx echo.Context) error {
 		CatchpointVerifiedKvs:       &stat.CatchpointCatchupVerifiedKVs,
 		CatchpointTotalBlocks:       &stat.CatchpointCatchupTotalBlocks,
 		CatchpointAcquiredBlocks:    &stat.CatchpointCatchupAcquiredBlocks,
+		P2pReachability:             omitEmpty(stat.P2PReachability),
 	}
 
 	// Make sure a vote is happening
@@ -1043,7 +1211,7 @@ func (v2 *Handlers) GetStatus(ctx echo.Context) error {
 		response.UpgradeVoteRounds = &upgradeVoteRounds
 	}
 
-	return ctx.JSON(http.StatusOK, response)
+	return response, nil
 }
 
 // WaitForBlock returns the node status after waiting for the given round.
@@ -1259,6 +1427,307 @@ func (v2 *Handlers) AccountAssetsInformation(ctx echo.Context, address string, p
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// accountResourcePage parses the paging parameters of the account resource endpoints, returning the
+// creatable index to list resources after and the number of resources to return.
+func accountResourcePage(next *string, limit *uint64) (basics.CreatableIndex, uint64, error) {
+	var after uint64
+	if next != nil {
+		var err error
+		after, err = strconv.ParseUint(*next, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%s: %v", errUnableToParseNext, err)
+		}
+	}
+
+	if limit == nil {
+		return basics.CreatableIndex(after), DefaultAccountResourceResults, nil
+	}
+	if *limit == 0 {
+		return 0, 0, errors.New(errInvalidLimit)
+	}
+	if *limit > MaxAccountResourceResults {
+		return 0, 0, fmt.Errorf("limit %d exceeds max resources single batch limit %d", *limit, MaxAccountResourceResults)
+	}
+	return basics.CreatableIndex(after), *limit, nil
+}
+
+// AccountCreatedAssetsInformation gets the assets created by an account.
+// (GET /v2/accounts/{address}/created-assets)
+func (v2 *Handlers) AccountCreatedAssetsInformation(ctx echo.Context, address string, params model.AccountCreatedAssetsInformationParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+	after, limit, err := accountResourcePage(params.Next, params.Limit)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	// We intentionally request one more than the limit to determine if there are more assets.
+	records, lookupRound, err := v2.Node.LedgerForAPI().LookupCreatedAssets(addr, basics.AssetIndex(after), limit+1)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.AccountCreatedAssetsInformationResponse{Round: uint64(lookupRound)}
+	if uint64(len(records)) > limit {
+		records = records[:limit]
+		nextTk := strconv.FormatUint(uint64(records[len(records)-1].AssetID), 10)
+		response.NextToken = &nextTk
+	}
+
+	createdAssets := make([]model.Asset, 0, len(records))
+	for _, record := range records {
+		createdAssets = append(createdAssets, AssetParamsToAsset(addr.String(), record.AssetID, record.AssetParams))
+	}
+	response.CreatedAssets = &createdAssets
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountCreatedApplicationsInformation gets the applications created by an account.
+// (GET /v2/accounts/{address}/created-applications)
+func (v2 *Handlers) AccountCreatedApplicationsInformation(ctx echo.Context, address string, params model.AccountCreatedApplicationsInformationParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+	after, limit, err := accountResourcePage(params.Next, params.Limit)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	// We intentionally request one more than the limit to determine if there are more applications.
+	records, lookupRound, err := v2.Node.LedgerForAPI().LookupCreatedApplications(addr, basics.AppIndex(after), limit+1)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.AccountCreatedApplicationsInformationResponse{Round: uint64(lookupRound)}
+	if uint64(len(records)) > limit {
+		records = records[:limit]
+		nextTk := strconv.FormatUint(uint64(records[len(records)-1].AppID), 10)
+		response.NextToken = &nextTk
+	}
+
+	createdApps := make([]model.Application, 0, len(records))
+	for _, record := range records {
+		createdApps = append(createdApps, AppParamsToApplication(addr.String(), record.AppID, record.AppParams))
+	}
+	response.CreatedApps = &createdApps
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AccountApplicationsInformation gets the local states of the applications an account is opted in to.
+// (GET /v2/accounts/{address}/applications)
+func (v2 *Handlers) AccountApplicationsInformation(ctx echo.Context, address string, params model.AccountApplicationsInformationParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+	after, limit, err := accountResourcePage(params.Next, params.Limit)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	// We intentionally request one more than the limit to determine if there are more applications.
+	records, lookupRound, err := v2.Node.LedgerForAPI().LookupApplications(addr, basics.AppIndex(after), limit+1)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.AccountApplicationsInformationResponse{Round: uint64(lookupRound)}
+	if uint64(len(records)) > limit {
+		records = records[:limit]
+		nextTk := strconv.FormatUint(uint64(records[len(records)-1].AppID), 10)
+		response.NextToken = &nextTk
+	}
+
+	appsLocalState := make([]model.ApplicationLocalState, 0, len(records))
+	for _, record := range records {
+		if record.AppLocalState == nil {
+			v2.Log.Warnf("AccountApplicationsInformation: app %d has no local state - should not be possible", record.AppID)
+			continue
+		}
+		appsLocalState = append(appsLocalState, AppLocalState(*record.AppLocalState, record.AppID))
+	}
+	response.AppsLocalState = &appsLocalState
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetAccountProof returns a proof of an account, or of one of its resources, against the catchpoint merkle trie.
+// (GET /v2/accounts/{address}/proof)
+func (v2 *Handlers) GetAccountProof(ctx echo.Context, address string, params model.GetAccountProofParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	ledgerForAPI := v2.Node.LedgerForAPI()
+	var tp ledger.TrieProof
+	switch {
+	case params.AssetId != nil && params.ApplicationId != nil:
+		return badRequest(ctx, errors.New(errAssetAndApplicationID), errAssetAndApplicationID, v2.Log)
+	case params.AssetId != nil:
+		tp, err = ledgerForAPI.ProveResource(addr, basics.CreatableIndex(*params.AssetId), basics.AssetCreatable)
+	case params.ApplicationId != nil:
+		tp, err = ledgerForAPI.ProveResource(addr, basics.CreatableIndex(*params.ApplicationId), basics.AppCreatable)
+	default:
+		tp, err = ledgerForAPI.ProveAccount(addr)
+	}
+	return v2.ledgerEntryProof(ctx, tp, err)
+}
+
+// ledgerEntryProof writes the response of the ledger entry proof endpoints.
+func (v2 *Handlers) ledgerEntryProof(ctx echo.Context, tp ledger.TrieProof, err error) error {
+	switch {
+	case errors.Is(err, ledger.ErrTrieProofsUnavailable):
+		return notFound(ctx, err, errTrieProofsUnavailable, v2.Log)
+	case errors.Is(err, ledger.ErrTrieEntryNotFound):
+		return notFound(ctx, err, errLedgerEntryNotFound, v2.Log)
+	case err != nil:
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	response := model.LedgerEntryProofResponse{
+		Round:                      uint64(tp.Round),
+		Root:                       tp.Root[:],
+		Entry:                      tp.Entry,
+		Proof:                      tp.Proof,
+		Catchpoint:                 tp.Label,
+		BlockHash:                  tp.Catchpoint.BlockHash[:],
+		Totals:                     protocol.Encode(&tp.Catchpoint.Totals),
+		StateProofVerificationHash: tp.Catchpoint.StateProofVerificationHash[:],
+		OnlineAccountsHash:         tp.Catchpoint.OnlineAccountsHash[:],
+		OnlineRoundParamsHash:      tp.Catchpoint.OnlineRoundParamsHash[:],
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetAccountTransactions lists the transactions that touched an account.
+// (GET /v2/accounts/{address}/transactions)
+func (v2 *Handlers) GetAccountTransactions(ctx echo.Context, address string, params model.GetAccountTransactionsParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+	return v2.transactionActivity(ctx, params.Limit, params.Next, params.MinRound, params.MaxRound, (*string)(params.Format),
+		func(minRound basics.Round, before ledger.TxnLocation, limit uint64) ([]ledger.ActivityTxn, basics.Round, error) {
+			return v2.Node.LedgerForAPI().AccountActivity(addr, minRound, before, limit)
+		})
+}
+
+// GetApplicationTransactions lists the transactions that touched an application.
+// (GET /v2/applications/{application-id}/transactions)
+func (v2 *Handlers) GetApplicationTransactions(ctx echo.Context, applicationID uint64, params model.GetApplicationTransactionsParams) error {
+	return v2.transactionActivity(ctx, params.Limit, params.Next, params.MinRound, params.MaxRound, (*string)(params.Format),
+		func(minRound basics.Round, before ledger.TxnLocation, limit uint64) ([]ledger.ActivityTxn, basics.Round, error) {
+			return v2.Node.LedgerForAPI().CreatableActivity(basics.CreatableIndex(applicationID), basics.AppCreatable, minRound, before, limit)
+		})
+}
+
+// GetAssetTransactions lists the transactions that touched an asset.
+// (GET /v2/assets/{asset-id}/transactions)
+func (v2 *Handlers) GetAssetTransactions(ctx echo.Context, assetID uint64, params model.GetAssetTransactionsParams) error {
+	return v2.transactionActivity(ctx, params.Limit, params.Next, params.MinRound, params.MaxRound, (*string)(params.Format),
+		func(minRound basics.Round, before ledger.TxnLocation, limit uint64) ([]ledger.ActivityTxn, basics.Round, error) {
+			return v2.Node.LedgerForAPI().CreatableActivity(basics.CreatableIndex(assetID), basics.AssetCreatable, minRound, before, limit)
+		})
+}
+
+// transactionActivity pages through the transaction activity index with lookup, newest first. The next token is
+// the location of the last transaction returned.
+func (v2 *Handlers) transactionActivity(ctx echo.Context, limit *uint64, next *string, minRound *uint64, maxRound *uint64, format *string,
+	lookup func(minRound basics.Round, before ledger.TxnLocation, limit uint64) ([]ledger.ActivityTxn, basics.Round, error)) error {
+	cfg := v2.Node.Config()
+	if !cfg.Archival || !cfg.EnableTransactionActivityIndex {
+		return notFound(ctx, errors.New(errTransactionActivityIndexDisabled), errTransactionActivityIndexDisabled, v2.Log)
+	}
+
+	handle, contentType, err := getCodecHandle(format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	n := DefaultTransactionActivityResults
+	if limit != nil {
+		if *limit == 0 {
+			return badRequest(ctx, errors.New(errInvalidLimit), errInvalidLimit, v2.Log)
+		}
+		if *limit > MaxTransactionActivityResults {
+			limitErrMsg := fmt.Sprintf("limit %d exceeds max transactions single batch limit %d", *limit, MaxTransactionActivityResults)
+			return badRequest(ctx, errors.New(limitErrMsg), limitErrMsg, v2.Log)
+		}
+		n = *limit
+	}
+
+	before := ledger.TxnLocation{Round: basics.Round(math.MaxUint64)}
+	if maxRound != nil && *maxRound < math.MaxUint64 {
+		before = ledger.TxnLocation{Round: basics.Round(*maxRound + 1)}
+	}
+	if next != nil {
+		loc, err0 := parseActivityToken(*next)
+		if err0 != nil {
+			return badRequest(ctx, err0, fmt.Sprintf("%s: %v", errUnableToParseNext, err0), v2.Log)
+		}
+		if loc.Less(before) {
+			before = loc
+		}
+	}
+	var first basics.Round
+	if minRound != nil {
+		first = basics.Round(*minRound)
+	}
+
+	// We intentionally request one more than the limit to determine if there are more transactions.
+	txns, latest, err := lookup(first, before, n+1)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := PreEncodedTransactionActivityResponse{CurrentRound: uint64(latest)}
+	if uint64(len(txns)) > n {
+		txns = txns[:n]
+		last := txns[len(txns)-1]
+		nextTk := fmt.Sprintf("%d-%d", last.Round, last.Offset)
+		response.NextToken = &nextTk
+	}
+
+	myLedger := v2.Node.LedgerForAPI()
+	response.Transactions = make([]PreEncodedTxInfo, len(txns))
+	for i, txn := range txns {
+		response.Transactions[i] = confirmedTxnInfo(node.TxnWithStatus{
+			Txn:            txn.Txn.SignedTxn,
+			ConfirmedRound: txn.Round,
+			ApplyData:      txn.Txn.ApplyData,
+		}, myLedger)
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// parseActivityToken parses the round-offset next token of the transaction activity endpoints.
+func parseActivityToken(token string) (ledger.TxnLocation, error) {
+	rnd, offset, ok := strings.Cut(token, "-")
+	if !ok {
+		return ledger.TxnLocation{}, fmt.Errorf("malformed token %q", token)
+	}
+	r, err := strconv.ParseUint(rnd, 10, 64)
+	if err != nil {
+		return ledger.TxnLocation{}, err
+	}
+	o, err := strconv.ParseUint(offset, 10, 64)
+	if err != nil {
+		return ledger.TxnLocation{}, err
+	}
+	return ledger.TxnLocation{Round: basics.Round(r), Offset: o}, nil
+}
+
 // PreEncodedSimulateTxnResult mirrors model.SimulateTransactionResult
 type PreEncodedSimulateTxnResult struct {
 	Txn                      PreEncodedTxInfo                        `codec:"txn-result"`
@@ -1325,26 +1794,9 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 	if err != nil {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
-	requestData := requestBuffer.Bytes()
-
-	var simulateRequest PreEncodedSimulateRequest
-	err = decode(protocol.CodecHandle, requestData, &simulateRequest)
+	simulateRequest, err := decodeSimulateRequest(requestBuffer.Bytes(), proto.MaxTxGroupSize)
 	if err != nil {
-		err = decode(protocol.JSONStrictHandle, requestData, &simulateRequest)
-		if err != nil {
-			return badRequest(ctx, err, err.Error(), v2.Log)
-		}
-	}
-
-	for _, txgroup := range simulateRequest.TxnGroups {
-		if len(txgroup.Txns) == 0 {
-			err = errors.New("empty txgroup")
-			return badRequest(ctx, err, err.Error(), v2.Log)
-		}
-		if len(txgroup.Txns) > proto.MaxTxGroupSize {
-			err = fmt.Errorf("transaction group size %d exceeds protocol max %d", len(txgroup.Txns), proto.MaxTxGroupSize)
-			return badRequest(ctx, err, err.Error(), v2.Log)
-		}
+		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
 	// Simulate transaction
@@ -1373,6 +1825,28 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 	return ctx.Blob(http.StatusOK, contentType, responseData)
 }
 
+// decodeSimulateRequest decodes and checks a msgpack or JSON encoded simulation request.
+func decodeSimulateRequest(requestData []byte, maxTxGroupSize int) (PreEncodedSimulateRequest, error) {
+	var simulateRequest PreEncodedSimulateRequest
+	err := decode(protocol.CodecHandle, requestData, &simulateRequest)
+	if err != nil {
+		err = decode(protocol.JSONStrictHandle, requestData, &simulateRequest)
+		if err != nil {
+			return PreEncodedSimulateRequest{}, err
+		}
+	}
+
+	for _, txgroup := range simulateRequest.TxnGroups {
+		if len(txgroup.Txns) == 0 {
+			return PreEncodedSimulateRequest{}, errors.New("empty txgroup")
+		}
+		if len(txgroup.Txns) > maxTxGroupSize {
+			return PreEncodedSimulateRequest{}, fmt.Errorf("transaction group size %d exceeds protocol max %d", len(txgroup.Txns), maxTxGroupSize)
+		}
+	}
+	return simulateRequest, nil
+}
+
 // TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
 // (POST /v2/teal/dryrun)
 func (v2 *Handlers) TealDryrun(ctx echo.Context) error {
@@ -1473,6 +1947,48 @@ func (v2 *Handlers) GetSyncRound(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, model.GetSyncRoundResponse{Round: rnd})
 }
 
+// ListSyncCursors lists the named sync cursors.
+// (GET /v2/ledger/sync/cursors)
+func (v2 *Handlers) ListSyncCursors(ctx echo.Context) error {
+	cursors := v2.Node.ListSyncCursors()
+	response := model.SyncCursorsResponse{Cursors: make([]model.SyncCursor, len(cursors))}
+	for i, cursor := range cursors {
+		response.Cursors[i] = model.SyncCursor{Name: cursor.Name, Round: uint64(cursor.Round)}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// RegisterSyncCursor registers a named sync cursor.
+// (POST /v2/ledger/sync/cursors/{name}/{round})
+func (v2 *Handlers) RegisterSyncCursor(ctx echo.Context, name string, round uint64) error {
+	return v2.syncCursorResult(ctx, v2.Node.RegisterSyncCursor(name, round))
+}
+
+// AdvanceSyncCursor moves a named sync cursor forward.
+// (PUT /v2/ledger/sync/cursors/{name}/{round})
+func (v2 *Handlers) AdvanceSyncCursor(ctx echo.Context, name string, round uint64) error {
+	return v2.syncCursorResult(ctx, v2.Node.AdvanceSyncCursor(name, round))
+}
+
+// DeleteSyncCursor removes a named sync cursor.
+// (DELETE /v2/ledger/sync/cursors/{name})
+func (v2 *Handlers) DeleteSyncCursor(ctx echo.Context, name string) error {
+	return v2.syncCursorResult(ctx, v2.Node.DeleteSyncCursor(name))
+}
+
+func (v2 *Handlers) syncCursorResult(ctx echo.Context, err error) error {
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusOK)
+	case node.ErrSyncCursorNotFound:
+		return notFound(ctx, err, fmt.Sprintf(errFailedUpdatingSyncCursor, err), v2.Log)
+	case node.ErrSyncCursorExists, node.ErrSyncCursorInvalidName, node.ErrSyncCursorBackwards, node.ErrSyncCursorsUnsupported, catchup.ErrSyncRoundInvalid:
+		return badRequest(ctx, err, fmt.Sprintf(errFailedUpdatingSyncCursor, err), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedUpdatingSyncCursor, err), v2.Log)
+	}
+}
+
 // GetLedgerStateDelta returns the deltas for a given round.
 // This should be a representation of the ledgercore.StateDelta object.
 // (GET /v2/deltas/{round})
@@ -1543,6 +2059,13 @@ type PreEncodedTxInfo struct {
 	Inners             *[]PreEncodedTxInfo        `codec:"inner-txns,omitempty"`
 }
 
+// PreEncodedTransactionActivityResponse mirrors model.TransactionActivityResponse
+type PreEncodedTransactionActivityResponse struct {
+	CurrentRound uint64             `codec:"current-round"`
+	NextToken    *string            `codec:"next-token,omitempty"`
+	Transactions []PreEncodedTxInfo `codec:"transactions"`
+}
+
 // PendingTransactionInformation returns a transaction with the specified txID
 // from the transaction pool. If not found looks for the transaction in the
 // last proto.MaxTxnLife rounds
@@ -1578,19 +2101,7 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 	}
 
 	if txn.ConfirmedRound != 0 {
-		r := uint64(txn.ConfirmedRound)
-		response.ConfirmedRound = &r
-
-		response.ClosingAmount = &txn.ApplyData.ClosingAmount.Raw
-		response.AssetClosingAmount = &txn.ApplyData.AssetClosingAmount
-		response.SenderRewards = &txn.ApplyData.SenderRewards.Raw
-		response.ReceiverRewards = &txn.ApplyData.ReceiverRewards.Raw
-		response.CloseRewards = &txn.ApplyData.CloseRewards.Raw
-		response.AssetIndex = computeAssetIndexFromTxn(txn, v2.Node.LedgerForAPI())
-		response.ApplicationIndex = computeAppIndexFromTxn(txn, v2.Node.LedgerForAPI())
-		response.LocalStateDelta, response.GlobalStateDelta = convertToDeltas(txn)
-		response.Logs = convertLogs(txn)
-		response.Inners = convertInners(&txn)
+		response = confirmedTxnInfo(txn, v2.Node.LedgerForAPI())
 	}
 
 	handle, contentType, err := getCodecHandle((*string)(params.Format))
@@ -1741,31 +2252,61 @@ func (v2 *Handlers) GetPendingTransactions(ctx echo.Context, params model.GetPen
 
 // GetApplicationByID returns application information by app idx.
 // (GET /v2/applications/{application-id})
-func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) error {
+func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64, params model.GetApplicationByIDParams) error {
 	appIdx := basics.AppIndex(applicationID)
-	ledger := v2.Node.LedgerForAPI()
-	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
+	var creator basics.Address
+	var appParams *basics.AppParams
+	var err error
+	if params.Round == nil {
+		creator, appParams, err = v2.lookupAppParams(appIdx)
+	} else {
+		var rnd basics.Round
+		rnd, err = stateRound(v2.Node.LedgerForAPI(), params.Round)
+		if err != nil {
+			return badRequest(ctx, err, errRoundGreaterThanTheLatest, v2.Log)
+		}
+		creator, appParams, err = v2.lookupAppParamsForRound(appIdx, rnd)
+	}
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return ledgerLookupError(ctx, err, v2.Log)
 	}
-	if !ok {
+	if appParams == nil {
 		return notFound(ctx, errors.New(errAppDoesNotExist), errAppDoesNotExist, v2.Log)
 	}
+	app := AppParamsToApplication(creator.String(), appIdx, appParams)
+	response := model.ApplicationResponse(app)
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	lastRound := ledger.Latest()
+// lookupAppParams returns the creator and latest parameters of an application,
+// or nil parameters if it doesn't exist.
+func (v2 *Handlers) lookupAppParams(appIdx basics.AppIndex) (basics.Address, *basics.AppParams, error) {
+	ledger := v2.Node.LedgerForAPI()
+	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
+	if err != nil || !ok {
+		return basics.Address{}, nil, err
+	}
 
-	record, err := ledger.LookupApplication(lastRound, creator, basics.AppIndex(applicationID))
+	record, err := ledger.LookupApplication(ledger.Latest(), creator, appIdx)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return basics.Address{}, nil, err
 	}
+	return creator, record.AppParams, nil
+}
 
-	if record.AppParams == nil {
-		return notFound(ctx, errors.New(errAppDoesNotExist), errAppDoesNotExist, v2.Log)
+// lookupAppParamsForRound is like lookupAppParams, but for the given round.
+func (v2 *Handlers) lookupAppParamsForRound(appIdx basics.AppIndex, rnd basics.Round) (basics.Address, *basics.AppParams, error) {
+	ledger := v2.Node.LedgerForAPI()
+	creator, ok, err := ledger.GetCreatorForRound(rnd, basics.CreatableIndex(appIdx), basics.AppCreatable)
+	if err != nil || !ok {
+		return basics.Address{}, nil, err
 	}
-	appParams := *record.AppParams
-	app := AppParamsToApplication(creator.String(), appIdx, &appParams)
-	response := model.ApplicationResponse(app)
-	return ctx.JSON(http.StatusOK, response)
+
+	record, err := ledger.LookupApplication(rnd, creator, appIdx)
+	if err != nil {
+		return basics.Address{}, nil, err
+	}
+	return creator, record.AppParams, nil
 }
 
 func applicationBoxesMaxKeys(requestedMax uint64, algodMax uint64) uint64 {
@@ -1791,6 +2332,10 @@ func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID uint64,
 	lastRound := ledger.Latest()
 	keyPrefix := apps.MakeBoxKey(uint64(appIdx), "")
 
+	if params.Prefix != nil || params.Next != nil || params.Limit != nil || params.Values != nil {
+		return v2.applicationBoxesPage(ctx, appIdx, params)
+	}
+
 	requestedMax, algodMax := nilToZero(params.Max), v2.Node.Config().MaxAPIBoxPerApplication
 	max := applicationBoxesMaxKeys(requestedMax, algodMax)
 
@@ -1823,7 +2368,77 @@ func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID uint64,
 			Name: []byte(boxKey[prefixLen:]),
 		}
 	}
-	response := model.BoxesResponse{Boxes: responseBoxes}
+	response := model.BoxesResponse{Round: uint64(lastRound), Boxes: responseBoxes}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// applicationBoxesPage returns a page of the boxes of an application, in the order of their names.
+func (v2 *Handlers) applicationBoxesPage(ctx echo.Context, appIdx basics.AppIndex, params model.GetApplicationBoxesParams) error {
+	algodMax := v2.Node.Config().MaxAPIBoxPerApplication
+	limit := DefaultBoxResults
+	if algodMax != 0 && limit > algodMax {
+		limit = algodMax
+	}
+	if params.Limit != nil {
+		if *params.Limit == 0 {
+			return badRequest(ctx, errors.New(errInvalidLimit), errInvalidLimit, v2.Log)
+		}
+		if algodMax != 0 && *params.Limit > algodMax {
+			limitErrMsg := fmt.Sprintf("limit %d exceeds max-api-box-per-application %d", *params.Limit, algodMax)
+			return badRequest(ctx, errors.New(limitErrMsg), limitErrMsg, v2.Log)
+		}
+		if *params.Limit > MaxBoxResults {
+			limitErrMsg := fmt.Sprintf("limit %d exceeds max boxes single page limit %d", *params.Limit, MaxBoxResults)
+			return badRequest(ctx, errors.New(limitErrMsg), limitErrMsg, v2.Log)
+		}
+		limit = *params.Limit
+	}
+
+	var namePrefix []byte
+	if params.Prefix != nil {
+		prefixBytes, err := apps.NewAppCallBytes(*params.Prefix)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		namePrefix, err = prefixBytes.Raw()
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+
+	appPrefix := apps.MakeBoxKey(uint64(appIdx), "")
+	after := ""
+	if params.Next != nil {
+		name, err := base64.StdEncoding.DecodeString(*params.Next)
+		if err != nil {
+			return badRequest(ctx, err, fmt.Sprintf("%s: %v", errUnableToParseNext, err), v2.Log)
+		}
+		after = appPrefix + string(name)
+	}
+	withValues := params.Values != nil && *params.Values
+
+	myLedger := v2.Node.LedgerForAPI()
+	lastRound := myLedger.Latest()
+	// We intentionally request one more than the limit to determine if there are more boxes.
+	kvs, err := myLedger.LookupKvsByPrefix(lastRound, appPrefix+string(namePrefix), after, limit+1, withValues)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := model.BoxesResponse{Round: uint64(lastRound)}
+	if uint64(len(kvs)) > limit {
+		kvs = kvs[:limit]
+		nextTk := base64.StdEncoding.EncodeToString([]byte(kvs[len(kvs)-1].Key[len(appPrefix):]))
+		response.NextToken = &nextTk
+	}
+	response.Boxes = make([]model.BoxDescriptor, len(kvs))
+	for i, kv := range kvs {
+		response.Boxes[i].Name = []byte(kv.Key[len(appPrefix):])
+		if withValues {
+			value := kv.Value
+			response.Boxes[i].Value = &value
+		}
+	}
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -1832,7 +2447,10 @@ func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID uint64,
 func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint64, params model.GetApplicationBoxByNameParams) error {
 	appIdx := basics.AppIndex(applicationID)
 	ledger := v2.Node.LedgerForAPI()
-	lastRound := ledger.Latest()
+	lastRound, err := stateRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, errRoundGreaterThanTheLatest, v2.Log)
+	}
 
 	encodedBoxName := params.Name
 	boxNameBytes, err := apps.NewAppCallBytes(encodedBoxName)
@@ -1846,7 +2464,7 @@ func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint
 
 	value, err := ledger.LookupKv(lastRound, apps.MakeBoxKey(uint64(appIdx), string(boxName)))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return ledgerLookupError(ctx, err, v2.Log)
 	}
 	if value == nil {
 		return notFound(ctx, errors.New(errBoxDoesNotExist), errBoxDoesNotExist, v2.Log)
@@ -1860,32 +2478,52 @@ func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetApplicationBoxProof returns a proof of a box value against the catchpoint merkle trie.
+// (GET /v2/applications/{application-id}/box/proof)
+func (v2 *Handlers) GetApplicationBoxProof(ctx echo.Context, applicationID uint64, params model.GetApplicationBoxProofParams) error {
+	boxNameBytes, err := apps.NewAppCallBytes(params.Name)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	boxName, err := boxNameBytes.Raw()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	tp, err := v2.Node.LedgerForAPI().ProveBox(basics.AppIndex(applicationID), string(boxName))
+	return v2.ledgerEntryProof(ctx, tp, err)
+}
+
 // GetAssetByID returns application information by app idx.
 // (GET /v2/assets/{asset-id})
 func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
 	assetIdx := basics.AssetIndex(assetID)
-	ledger := v2.Node.LedgerForAPI()
-	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
+	creator, assetParams, err := v2.lookupAssetParams(assetIdx)
 	if err != nil {
 		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 	}
-	if !ok {
+	if assetParams == nil {
 		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
 	}
+	asset := AssetParamsToAsset(creator.String(), assetIdx, assetParams)
+	response := model.AssetResponse(asset)
+	return ctx.JSON(http.StatusOK, response)
+}
 
-	lastRound := ledger.Latest()
-	record, err := ledger.LookupAsset(lastRound, creator, basics.AssetIndex(assetID))
-	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+// lookupAssetParams returns the creator and latest parameters of an asset, or
+// nil parameters if it doesn't exist.
+func (v2 *Handlers) lookupAssetParams(assetIdx basics.AssetIndex) (basics.Address, *basics.AssetParams, error) {
+	ledger := v2.Node.LedgerForAPI()
+	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
+	if err != nil || !ok {
+		return basics.Address{}, nil, err
 	}
 
-	if record.AssetParams == nil {
-		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
+	record, err := ledger.LookupAsset(ledger.Latest(), creator, assetIdx)
+	if err != nil {
+		return basics.Address{}, nil, err
 	}
-	assetParams := *record.AssetParams
-	asset := AssetParamsToAsset(creator.String(), assetIdx, &assetParams)
-	response := model.AssetResponse(asset)
-	return ctx.JSON(http.StatusOK, response)
+	return creator, record.AssetParams, nil
 }
 
 // GetPendingTransactionsByAddress takes an Algorand address and returns its associated list of unconfirmed transactions currently in the transaction pool.
@@ -196,6 +196,36 @@ func TestPendingTransactionResponseStruct(t *testing.T) {
 	generatedResponseGraph.AssertEquals(t, customResponseGraph)
 }
 
+// TestTransactionActivityResponseStruct ensures that the hand-written PreEncodedTransactionActivityResponse has
+// the same encoding structure as the generated model.TransactionActivityResponse
+func TestTransactionActivityResponseStruct(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	generatedResponseType := reflect.TypeOf(model.TransactionActivityResponse{})
+	generatedResponseGraph := makeTagGraph(generatedResponseType, make(map[reflect.Type]*tagNode))
+
+	customResponseType := reflect.TypeOf(PreEncodedTransactionActivityResponse{})
+	customResponseGraph := makeTagGraph(customResponseType, make(map[reflect.Type]*tagNode))
+
+	expectedGeneratedTxnGraph := map[string]*tagNode{
+		"<map-key>": {children: make(map[string]*tagNode)},
+		"<value>":   {children: make(map[string]*tagNode)},
+	}
+	preEncodedTxPath := func(graph *tagNode) *tagNode {
+		// Resolve the field model.TransactionActivityResponse{}.Transactions[0].Txn
+		return graph.children["transactions"].children["<value>"].children["txn"]
+	}
+	if assert.Equal(t, expectedGeneratedTxnGraph, preEncodedTxPath(generatedResponseGraph).children) {
+		// The generated response type uses map[string]interface{} to represent a transaction, while
+		// the custom response type uses transactions.SignedTxn. Let's copy that into the generated
+		// type.
+		preEncodedTxPath(generatedResponseGraph).children = preEncodedTxPath(customResponseGraph).children
+	}
+
+	generatedResponseGraph.AssertEquals(t, customResponseGraph)
+}
+
 // TestSimulateResponseStruct ensures that the hand-written PreEncodedSimulateResponse has the same
 // encoding structure as the generated model.SimulateResponse
 func TestSimulateResponseStruct(t *testing.T) {
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/algodv1"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func setupConnectTest(t *testing.T) (v2.Handlers, *echo.Echo, string, func()) {
+	handler, _, _, rootkeys, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	e := echo.New()
+	v2.RegisterConnectHandlers(e, &handler)
+	return handler, e, rootkeys[0].Address().String(), releasefunc
+}
+
+func callConnect(e *echo.Echo, method string, contentType string, body []byte, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, v2.ConnectServicePath+method, bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, contentType)
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func callConnectUnary(t *testing.T, e *echo.Echo, method string, req protobuf.Message, resp protobuf.Message) *httptest.ResponseRecorder {
+	body, err := protobuf.Marshal(req)
+	require.NoError(t, err)
+	rec := callConnect(e, method, "application/proto", body)
+	if rec.Code == http.StatusOK {
+		require.Equal(t, "application/proto", rec.Header().Get(echo.HeaderContentType))
+		require.NoError(t, protobuf.Unmarshal(rec.Body.Bytes(), resp))
+	}
+	return rec
+}
+
+func requireConnectError(t *testing.T, rec *httptest.ResponseRecorder, status int, code string) {
+	t.Helper()
+	require.Equal(t, status, rec.Code)
+	var connectErr struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &connectErr))
+	require.Equal(t, code, connectErr.Code)
+	require.NotEmpty(t, connectErr.Message)
+}
+
+func TestConnectUnary(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, e, address, releasefunc := setupConnectTest(t)
+	defer releasefunc()
+
+	var status algodv1.GetStatusResponse
+	rec := callConnectUnary(t, e, "GetStatus", &algodv1.GetStatusRequest{}, &status)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, uint64(cannedStatusReportGolden.LastRound), status.LastRound)
+	require.Equal(t, string(cannedStatusReportGolden.LastVersion), status.LastVersion)
+	require.Equal(t, cannedStatusReportGolden.Catchpoint, status.Catchpoint)
+
+	// The account matches the one of the REST API, which uses the same ledger lookups
+	var account algodv1.GetAccountResponse
+	rec = callConnectUnary(t, e, "GetAccount", &algodv1.GetAccountRequest{Address: address}, &account)
+	require.Equal(t, http.StatusOK, rec.Code)
+	restRec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), restRec)
+	exclude := model.AccountInformationParamsExcludeAll
+	require.NoError(t, handler.AccountInformation(c, address, model.AccountInformationParams{Exclude: &exclude}))
+	var restAccount model.AccountResponse
+	require.NoError(t, json.Unmarshal(restRec.Body.Bytes(), &restAccount))
+	require.Equal(t, address, account.Account.Address)
+	require.Equal(t, restAccount.Amount, account.Account.Amount)
+	require.Equal(t, restAccount.MinBalance, account.Account.MinBalance)
+	require.Equal(t, restAccount.Status, account.Account.Status)
+
+	rec = callConnectUnary(t, e, "GetAccount", &algodv1.GetAccountRequest{Address: "not an address"}, &account)
+	requireConnectError(t, rec, http.StatusBadRequest, "invalid_argument")
+
+	var block algodv1.GetBlockResponse
+	rec = callConnectUnary(t, e, "GetBlock", &algodv1.GetBlockRequest{Round: 0}, &block)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotEmpty(t, block.Block)
+	rec = callConnectUnary(t, e, "GetBlock", &algodv1.GetBlockRequest{Round: 1}, &block)
+	requireConnectError(t, rec, http.StatusNotFound, "not_found")
+
+	var asset algodv1.GetAssetResponse
+	rec = callConnectUnary(t, e, "GetAsset", &algodv1.GetAssetRequest{AssetId: 1000}, &asset)
+	requireConnectError(t, rec, http.StatusNotFound, "not_found")
+
+	var submit algodv1.SubmitTransactionsResponse
+	rec = callConnectUnary(t, e, "SubmitTransactions", &algodv1.SubmitTransactionsRequest{}, &submit)
+	requireConnectError(t, rec, http.StatusBadRequest, "invalid_argument")
+
+	// JSON encoded messages are accepted too
+	body, err := protojson.Marshal(&algodv1.GetAccountRequest{Address: address})
+	require.NoError(t, err)
+	rec = callConnect(e, "GetAccount", "application/json", body)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, protojson.Unmarshal(rec.Body.Bytes(), &account))
+	require.Equal(t, restAccount.Amount, account.Account.Amount)
+
+	rec = callConnect(e, "GetStatus", "text/plain", nil)
+	require.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+}
+
+func TestConnectStreamBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// The ledger holds rounds 0 and 1
+	handler, _, _, _, releasefunc := addBlockHelper(t)
+	defer releasefunc()
+	e := echo.New()
+	v2.RegisterConnectHandlers(e, &handler)
+
+	streamBlocks := func(fromRound uint64) []*algodv1.GetBlockResponse {
+		reqData, err := protobuf.Marshal(&algodv1.StreamBlocksRequest{FromRound: fromRound})
+		require.NoError(t, err)
+		envelope := binary.BigEndian.AppendUint32([]byte{0}, uint32(len(reqData)))
+		envelope = append(envelope, reqData...)
+
+		// The stream ends when no more blocks come before the timeout
+		rec := callConnect(e, "StreamBlocks", "application/connect+proto", envelope, "Connect-Timeout-Ms", "100")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/connect+proto", rec.Header().Get(echo.HeaderContentType))
+
+		var blocks []*algodv1.GetBlockResponse
+		stream := rec.Body.Bytes()
+		for {
+			require.GreaterOrEqual(t, len(stream), 5)
+			flags := stream[0]
+			size := binary.BigEndian.Uint32(stream[1:5])
+			data := stream[5 : 5+size]
+			stream = stream[5+size:]
+			if flags == 0 {
+				var block algodv1.GetBlockResponse
+				require.NoError(t, protobuf.Unmarshal(data, &block))
+				blocks = append(blocks, &block)
+				continue
+			}
+
+			require.Equal(t, byte(2), flags)
+			var end struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(data, &end))
+			require.Equal(t, "deadline_exceeded", end.Error.Code)
+			require.Empty(t, stream)
+			return blocks
+		}
+	}
+
+	blocks := streamBlocks(0)
+	require.Empty(t, blocks)
+
+	blocks = streamBlocks(1)
+	require.Len(t, blocks, 1)
+	require.Equal(t, uint64(1), blocks[0].Round)
+	require.NotEmpty(t, blocks[0].Block)
+}
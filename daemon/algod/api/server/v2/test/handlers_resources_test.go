@@ -37,6 +37,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -54,6 +55,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/logging"
@@ -105,6 +107,10 @@ func (l *mockLedger) LookupKeysByPrefix(round basics.Round, keyPrefix string, ma
 	panic("not implemented")
 }
 
+func (l *mockLedger) LookupKvsByPrefix(round basics.Round, keyPrefix string, after string, limit uint64, withValues bool) ([]ledger.KvPair, error) {
+	panic("not implemented")
+}
+
 func (l *mockLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, error) {
 	return config.Consensus[protocol.ConsensusFuture], nil
 }
@@ -151,6 +157,51 @@ func (l *mockLedger) LookupAssets(addr basics.Address, assetIDGT basics.AssetInd
 	return res, basics.Round(0), nil
 }
 
+func (l *mockLedger) LookupCreatedAssets(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64) ([]ledgercore.AssetResourceWithIDs, basics.Round, error) {
+	var res []ledgercore.AssetResourceWithIDs
+	for aidx, ap := range l.accounts[addr].AssetParams {
+		if aidx > assetIDGT {
+			ap := ap
+			res = append(res, ledgercore.AssetResourceWithIDs{AssetID: aidx, Creator: addr, AssetResource: ledgercore.AssetResource{AssetParams: &ap}})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].AssetID < res[j].AssetID })
+	if uint64(len(res)) > limit {
+		res = res[:limit]
+	}
+	return res, l.latest, nil
+}
+
+func (l *mockLedger) LookupCreatedApplications(addr basics.Address, appIDGT basics.AppIndex, limit uint64) ([]ledgercore.AppResourceWithIDs, basics.Round, error) {
+	var res []ledgercore.AppResourceWithIDs
+	for aidx, ap := range l.accounts[addr].AppParams {
+		if aidx > appIDGT {
+			ap := ap
+			res = append(res, ledgercore.AppResourceWithIDs{AppID: aidx, Creator: addr, AppResource: ledgercore.AppResource{AppParams: &ap}})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].AppID < res[j].AppID })
+	if uint64(len(res)) > limit {
+		res = res[:limit]
+	}
+	return res, l.latest, nil
+}
+
+func (l *mockLedger) LookupApplications(addr basics.Address, appIDGT basics.AppIndex, limit uint64) ([]ledgercore.AppResourceWithIDs, basics.Round, error) {
+	var res []ledgercore.AppResourceWithIDs
+	for aidx, ls := range l.accounts[addr].AppLocalStates {
+		if aidx > appIDGT {
+			ls := ls
+			res = append(res, ledgercore.AppResourceWithIDs{AppID: aidx, AppResource: ledgercore.AppResource{AppLocalState: &ls}})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].AppID < res[j].AppID })
+	if uint64(len(res)) > limit {
+		res = res[:limit]
+	}
+	return res, l.latest, nil
+}
+
 func (l *mockLedger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ar ledgercore.AppResource, err error) {
 	ad, ok := l.accounts[addr]
 	if !ok {
@@ -186,6 +237,24 @@ func (l *mockLedger) WaitWithCancel(r basics.Round) (chan struct{}, func()) {
 func (l *mockLedger) GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (c basics.Address, ok bool, err error) {
 	panic("not implemented")
 }
+func (l *mockLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (c basics.Address, ok bool, err error) {
+	panic("not implemented")
+}
+func (l *mockLedger) AccountActivity(addr basics.Address, minRound basics.Round, before ledger.TxnLocation, limit uint64) ([]ledger.ActivityTxn, basics.Round, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) CreatableActivity(cidx basics.CreatableIndex, ctype basics.CreatableType, minRound basics.Round, before ledger.TxnLocation, limit uint64) ([]ledger.ActivityTxn, basics.Round, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) ProveAccount(addr basics.Address) (ledger.TrieProof, error) {
+	return ledger.TrieProof{}, ledger.ErrTrieProofsUnavailable
+}
+func (l *mockLedger) ProveResource(addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) (ledger.TrieProof, error) {
+	return ledger.TrieProof{}, ledger.ErrTrieProofsUnavailable
+}
+func (l *mockLedger) ProveBox(appIdx basics.AppIndex, name string) (ledger.TrieProof, error) {
+	return ledger.TrieProof{}, ledger.ErrTrieProofsUnavailable
+}
 func (l *mockLedger) EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error) {
 	panic("not implemented")
 }
@@ -556,6 +625,124 @@ func TestAccountAssetsInformation(t *testing.T) {
 
 }
 
+// TestAccountResourcesInformation tests the paginated created assets, created applications and
+// application local state endpoints
+func TestAccountResourcesInformation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// 250 resources of each kind, the kind of a resource being its ID modulo 4
+	total := 250
+	handlers, addr, acctData := setupTestForLargeResources(t, total*4, 50, randomAccountWithResources)
+
+	type page struct {
+		ids       []uint64
+		nextToken *string
+		round     uint64
+	}
+	lookups := map[string]func(next *string, limit *uint64) (int, page){
+		"created-assets": func(next *string, limit *uint64) (int, page) {
+			ctx, rec := newReq(t)
+			err := handlers.AccountCreatedAssetsInformation(ctx, addr.String(), model.AccountCreatedAssetsInformationParams{Next: next, Limit: limit})
+			require.NoError(t, err)
+			var ret model.AccountCreatedAssetsInformationResponse
+			p := page{}
+			if rec.Code == 200 {
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ret))
+				p = page{nextToken: ret.NextToken, round: ret.Round}
+				for _, asset := range *ret.CreatedAssets {
+					require.Equal(t, addr.String(), asset.Params.Creator)
+					require.Equal(t, acctData.AssetParams[basics.AssetIndex(asset.Index)].Total, asset.Params.Total)
+					p.ids = append(p.ids, asset.Index)
+				}
+			}
+			return rec.Code, p
+		},
+		"created-applications": func(next *string, limit *uint64) (int, page) {
+			ctx, rec := newReq(t)
+			err := handlers.AccountCreatedApplicationsInformation(ctx, addr.String(), model.AccountCreatedApplicationsInformationParams{Next: next, Limit: limit})
+			require.NoError(t, err)
+			var ret model.AccountCreatedApplicationsInformationResponse
+			p := page{}
+			if rec.Code == 200 {
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ret))
+				p = page{nextToken: ret.NextToken, round: ret.Round}
+				for _, app := range *ret.CreatedApps {
+					require.Equal(t, addr.String(), app.Params.Creator)
+					require.EqualValues(t, acctData.AppParams[basics.AppIndex(app.Id)].ApprovalProgram, app.Params.ApprovalProgram)
+					p.ids = append(p.ids, app.Id)
+				}
+			}
+			return rec.Code, p
+		},
+		"applications": func(next *string, limit *uint64) (int, page) {
+			ctx, rec := newReq(t)
+			err := handlers.AccountApplicationsInformation(ctx, addr.String(), model.AccountApplicationsInformationParams{Next: next, Limit: limit})
+			require.NoError(t, err)
+			var ret model.AccountApplicationsInformationResponse
+			p := page{}
+			if rec.Code == 200 {
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ret))
+				p = page{nextToken: ret.NextToken, round: ret.Round}
+				for _, ls := range *ret.AppsLocalState {
+					require.Equal(t, acctData.AppLocalStates[basics.AppIndex(ls.Id)].Schema.NumUint, ls.Schema.NumUint)
+					p.ids = append(p.ids, ls.Id)
+				}
+			}
+			return rec.Code, p
+		},
+	}
+	offsets := map[string]uint64{"created-assets": 1, "applications": 2, "created-applications": 3}
+
+	for name, lookup := range lookups {
+		t.Run(name, func(t *testing.T) {
+			// default limit
+			code, p := lookup(nil, nil)
+			require.Equal(t, 200, code)
+			require.Len(t, p.ids, int(v2.DefaultAccountResourceResults))
+			require.NotNil(t, p.nextToken)
+			require.Equal(t, uint64(10), p.round)
+
+			// walk all the pages, with the IDs strictly increasing across them
+			limit := uint64(60)
+			var all []uint64
+			var next *string
+			for {
+				code, p = lookup(next, &limit)
+				require.Equal(t, 200, code)
+				all = append(all, p.ids...)
+				if p.nextToken == nil {
+					break
+				}
+				require.Len(t, p.ids, int(limit))
+				require.Equal(t, strconv.FormatUint(p.ids[len(p.ids)-1], 10), *p.nextToken)
+				next = p.nextToken
+			}
+			require.Len(t, all, total)
+			for i, id := range all {
+				require.Equal(t, uint64(i*4)+offsets[name], id)
+			}
+
+			// invalid paging parameters
+			zero := uint64(0)
+			code, _ = lookup(nil, &zero)
+			require.Equal(t, 400, code)
+			tooMany := uint64(v2.MaxAccountResourceResults + 1)
+			code, _ = lookup(nil, &tooMany)
+			require.Equal(t, 400, code)
+			bad := "abc"
+			code, _ = lookup(&bad, nil)
+			require.Equal(t, 400, code)
+		})
+	}
+
+	// malformed address
+	ctx, rec := newReq(t)
+	err := handlers.AccountCreatedAssetsInformation(ctx, "", model.AccountCreatedAssetsInformationParams{})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	require.Equal(t, "{\"message\":\"failed to parse the address\"}\n", rec.Body.String())
+}
+
 func TestAccountInformationResourceLimits(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -578,3 +765,36 @@ func TestAccountInformationResourceLimits(t *testing.T) {
 		})
 	}
 }
+
+func TestLedgerEntryProofs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	handlers, addr, _ := setupTestForLargeResources(t, 4, 50, randomAccountWithResources)
+	id := uint64(1)
+
+	ctx, rec := newReq(t)
+	err := handlers.GetAccountProof(ctx, addr.String(), model.GetAccountProofParams{AssetId: &id, ApplicationId: &id})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	ctx, rec = newReq(t)
+	err = handlers.GetAccountProof(ctx, "invalid", model.GetAccountProofParams{})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	// the mock ledger does not maintain the catchpoint trie
+	ctx, rec = newReq(t)
+	err = handlers.GetAccountProof(ctx, addr.String(), model.GetAccountProofParams{AssetId: &id})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	ctx, rec = newReq(t)
+	err = handlers.GetApplicationBoxProof(ctx, id, model.GetApplicationBoxProofParams{Name: "str:box"})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	ctx, rec = newReq(t)
+	err = handlers.GetApplicationBoxProof(ctx, id, model.GetApplicationBoxProofParams{Name: "bad:box"})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
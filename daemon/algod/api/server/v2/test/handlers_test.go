@@ -155,6 +155,176 @@ func TestAccountInformation(t *testing.T) {
 	accountInformationTest(t, "bad account", 400)
 }
 
+func TestStateQueriesAtRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	latest := uint64(handler.Node.LedgerForAPI().Latest())
+	all := model.AccountInformationParamsExcludeAll
+	err := handler.AccountInformation(c, poolAddr.String(), model.AccountInformationParams{Round: &latest, Exclude: &all})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response model.AccountResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, poolAddrResponseGolden.Amount, response.Amount)
+	require.Equal(t, latest, response.Round)
+	require.Nil(t, response.Assets)
+
+	// assets and applications are not served at a round
+	none := model.AccountInformationParamsExcludeNone
+	for _, exclude := range []*model.AccountInformationParamsExclude{nil, &none} {
+		c, rec = newReq(t)
+		err = handler.AccountInformation(c, poolAddr.String(), model.AccountInformationParams{Round: &latest, Exclude: exclude})
+		require.NoError(t, err)
+		require.Equal(t, 400, rec.Code)
+		require.Contains(t, rec.Body.String(), "round requires exclude=all")
+	}
+
+	future := latest + 10
+	c, rec = newReq(t)
+	err = handler.AccountInformation(c, poolAddr.String(), model.AccountInformationParams{Round: &future, Exclude: &all})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.AccountApplicationInformation(c, poolAddr.String(), 1, model.AccountApplicationInformationParams{Round: &future})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetApplicationByID(c, 1, model.GetApplicationByIDParams{Round: &latest})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetApplicationBoxByName(c, 1, model.GetApplicationBoxByNameParams{Name: "str:foo", Round: &future})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
+func TestTransactionActivity(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	newHandler := func(enabled bool) v2.Handlers {
+		cfg := config.GetDefaultLocal()
+		cfg.Archival = true
+		cfg.EnableTransactionActivityIndex = enabled
+		return v2.Handlers{
+			Node:     makeMockNodeWithConfig(mockLedger, t.Name(), nil, cannedStatusReportGolden, false, cfg),
+			Log:      logging.Base(),
+			Shutdown: make(chan struct{}),
+		}
+	}
+
+	handler := newHandler(false)
+	c, rec := newReq(t)
+	err := handler.GetAccountTransactions(c, poolAddr.String(), model.GetAccountTransactionsParams{})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	handler = newHandler(true)
+	c, rec = newReq(t)
+	err = handler.GetAccountTransactions(c, "bad account", model.GetAccountTransactionsParams{})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	zero, tooMany := uint64(0), uint64(v2.MaxTransactionActivityResults+1)
+	c, rec = newReq(t)
+	err = handler.GetApplicationTransactions(c, 1, model.GetApplicationTransactionsParams{Limit: &zero})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetAssetTransactions(c, 1, model.GetAssetTransactionsParams{Limit: &tooMany})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	for _, next := range []string{"", "12", "12-x", "-3"} {
+		c, rec = newReq(t)
+		err = handler.GetAccountTransactions(c, poolAddr.String(), model.GetAccountTransactionsParams{Next: &next})
+		require.NoError(t, err)
+		require.Equal(t, 400, rec.Code, next)
+	}
+
+	format := model.GetAssetTransactionsParamsFormat("bad format")
+	c, rec = newReq(t)
+	err = handler.GetAssetTransactions(c, 1, model.GetAssetTransactionsParams{Format: &format})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
+func TestGetApplicationBoxesPage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAPIBoxPerApplication = 10
+	handler := v2.Handlers{
+		Node:     makeMockNodeWithConfig(mockLedger, t.Name(), nil, cannedStatusReportGolden, false, cfg),
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	values := true
+	c, rec := newReq(t)
+	err := handler.GetApplicationBoxes(c, 1, model.GetApplicationBoxesParams{Values: &values})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response model.BoxesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Empty(t, response.Boxes)
+	require.Nil(t, response.NextToken)
+	require.Equal(t, uint64(mockLedger.Latest()), response.Round)
+
+	zero, tooMany := uint64(0), uint64(11)
+	for _, limit := range []*uint64{&zero, &tooMany} {
+		c, rec = newReq(t)
+		err = handler.GetApplicationBoxes(c, 1, model.GetApplicationBoxesParams{Limit: limit})
+		require.NoError(t, err)
+		require.Equal(t, 400, rec.Code)
+	}
+
+	// without a node limit, pages are still bounded and the limit cannot wrap
+	// around when asking for one more box
+	cfg.MaxAPIBoxPerApplication = 0
+	unlimited := v2.Handlers{
+		Node:     makeMockNodeWithConfig(mockLedger, t.Name(), nil, cannedStatusReportGolden, false, cfg),
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	maxPage, overMax, maxUint := uint64(v2.MaxBoxResults), uint64(v2.MaxBoxResults+1), uint64(math.MaxUint64)
+	c, rec = newReq(t)
+	err = unlimited.GetApplicationBoxes(c, 1, model.GetApplicationBoxesParams{Limit: &maxPage})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	for _, limit := range []*uint64{&overMax, &maxUint} {
+		c, rec = newReq(t)
+		err = unlimited.GetApplicationBoxes(c, 1, model.GetApplicationBoxesParams{Limit: limit})
+		require.NoError(t, err)
+		require.Equal(t, 400, rec.Code)
+		require.Contains(t, rec.Body.String(), "exceeds max boxes single page limit")
+	}
+
+	badPrefix, badNext := "foo:bar", "not base64!"
+	c, rec = newReq(t)
+	err = handler.GetApplicationBoxes(c, 1, model.GetApplicationBoxesParams{Prefix: &badPrefix})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetApplicationBoxes(c, 1, model.GetApplicationBoxesParams{Next: &badNext})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
 func getBlockTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
 	defer releasefunc()
@@ -298,6 +468,62 @@ func TestSyncRound(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, mockNode)
 }
 
+func TestSyncCursors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	// ListSyncCursors 200
+	c, rec := newReq(t)
+	mockCall := mockNode.On("ListSyncCursors").Return([]node.SyncCursor{{Name: "exporter", Round: 10}, {Name: "indexer", Round: 20}})
+	require.NoError(t, handler.ListSyncCursors(c))
+	require.Equal(t, 200, rec.Code)
+	var response model.SyncCursorsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, []model.SyncCursor{{Name: "exporter", Round: 10}, {Name: "indexer", Round: 20}}, response.Cursors)
+	mockCall.Unset()
+
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{nil, 200},
+		{node.ErrSyncCursorExists, 400},
+		{node.ErrSyncCursorInvalidName, 400},
+		{node.ErrSyncCursorBackwards, 400},
+		{catchup.ErrSyncRoundInvalid, 400},
+		{node.ErrSyncCursorNotFound, 404},
+		{fmt.Errorf("unknown error"), 500},
+	}
+	for _, test := range tests {
+		c, rec = newReq(t)
+		mockCall = mockNode.On("RegisterSyncCursor", "indexer", uint64(20)).Return(test.err)
+		require.NoError(t, handler.RegisterSyncCursor(c, "indexer", 20))
+		require.Equal(t, test.code, rec.Code)
+		mockCall.Unset()
+
+		c, rec = newReq(t)
+		mockCall = mockNode.On("AdvanceSyncCursor", "indexer", uint64(30)).Return(test.err)
+		require.NoError(t, handler.AdvanceSyncCursor(c, "indexer", 30))
+		require.Equal(t, test.code, rec.Code)
+		mockCall.Unset()
+
+		c, rec = newReq(t)
+		mockCall = mockNode.On("DeleteSyncCursor", "indexer").Return(test.err)
+		require.NoError(t, handler.DeleteSyncCursor(c, "indexer"))
+		require.Equal(t, test.code, rec.Code)
+		mockCall.Unset()
+	}
+}
+
 func addBlockHelper(t *testing.T) (v2.Handlers, echo.Context, *httptest.ResponseRecorder, transactions.SignedTxn, func()) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
 
@@ -2268,6 +2494,69 @@ func TestTimestampOffsetInDevMode(t *testing.T) {
 	require.Equal(t, "{\"message\":\"failed to set timestamp offset on the node: block timestamp offset cannot be larger than max int64 value\"}\n", rec.Body.String())
 }
 
+func TestGetAgreementTimeline(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	start := time.Unix(1700000000, 0)
+	sender := basics.Address{1}
+	handler.Node.(*mockNode).timelines = []agreement.RoundTimeline{
+		{Round: 9, Start: start, End: start.Add(3 * time.Second), FilterTimeout: 4 * time.Second},
+		{
+			Round:                10,
+			Start:                start.Add(3 * time.Second),
+			FilterTimeout:        3 * time.Second,
+			DynamicFilterTimeout: 2 * time.Second,
+			Proposals:            []agreement.ProposalArrival{{Sender: sender, Weight: 1, Arrival: 100 * time.Millisecond}},
+			Thresholds:           []agreement.ThresholdReached{{Step: 1, Type: "soft", Weight: 2000, Arrival: time.Second}},
+			Timeouts:             []agreement.TimeoutFired{{Step: 1, Deadline: 3 * time.Second, Arrival: 3 * time.Second}},
+		},
+	}
+
+	err := handler.GetAgreementTimeline(c, model.GetAgreementTimelineParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response model.AgreementTimelineResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Len(t, response.Rounds, 2)
+	require.Equal(t, uint64(9), response.Rounds[0].Round)
+	require.NotNil(t, response.Rounds[0].EndTime)
+	require.Nil(t, response.Rounds[0].DynamicFilterTimeout)
+	require.Empty(t, response.Rounds[0].Proposals)
+
+	round := uint64(10)
+	c, rec = newReq(t)
+	err = handler.GetAgreementTimeline(c, model.GetAgreementTimelineParams{Round: &round})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = model.AgreementTimelineResponse{}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Len(t, response.Rounds, 1)
+	tl := response.Rounds[0]
+	require.Equal(t, round, tl.Round)
+	require.Equal(t, uint64(start.Add(3*time.Second).UnixNano()), tl.StartTime)
+	require.Nil(t, tl.EndTime)
+	require.Equal(t, uint64(3*time.Second), tl.FilterTimeout)
+	require.Equal(t, uint64(2*time.Second), *tl.DynamicFilterTimeout)
+	require.Len(t, tl.Proposals, 1)
+	require.Equal(t, sender.String(), tl.Proposals[0].Sender)
+	require.Equal(t, uint64(100*time.Millisecond), tl.Proposals[0].Arrival)
+	require.Len(t, tl.Thresholds, 1)
+	require.Equal(t, "soft", tl.Thresholds[0].Type)
+	require.Equal(t, uint64(2000), *tl.Thresholds[0].Weight)
+	require.Len(t, tl.Timeouts, 1)
+	require.Equal(t, uint64(3*time.Second), tl.Timeouts[0].Deadline)
+
+	round = 11
+	c, rec = newReq(t)
+	err = handler.GetAgreementTimeline(c, model.GetAgreementTimelineParams{Round: &round})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
 func TestDeltasForTxnGroup(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -2410,7 +2699,7 @@ func TestRouterRequestBody(t *testing.T) {
 	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
 	dummyShutdownChan := make(chan struct{})
 	l, err := net.Listen("tcp", ":0") // create listener so requests are buffered
-	e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, "", "", l, 1000)
+	e := server.NewRouter(logging.TestingLog(t), mockNode, dummyShutdownChan, "", "", nil, l, 1000)
 	go e.Start(":0")
 	defer e.Close()
 
@@ -40,6 +40,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
@@ -113,6 +114,7 @@ type mockNode struct {
 	devmode         bool
 	timestampOffset *int64
 	PartKeyBinary   []byte
+	timelines       []agreement.RoundTimeline
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -145,6 +147,26 @@ func (m *mockNode) GetSyncRound() uint64 {
 	return uint64(args.Int(0))
 }
 
+func (m *mockNode) ListSyncCursors() []node.SyncCursor {
+	args := m.Called()
+	return args.Get(0).([]node.SyncCursor)
+}
+
+func (m *mockNode) RegisterSyncCursor(name string, rnd uint64) error {
+	args := m.Called(name, rnd)
+	return args.Error(0)
+}
+
+func (m *mockNode) AdvanceSyncCursor(name string, rnd uint64) error {
+	args := m.Called(name, rnd)
+	return args.Error(0)
+}
+
+func (m *mockNode) DeleteSyncCursor(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
 func (m *mockNode) AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error {
 	m.id = id
 	m.keys = keys
@@ -176,6 +198,10 @@ func (m *mockNode) GenesisID() string {
 	return m.genesisID
 }
 
+func (m *mockNode) HealthChecks() node.HealthReport {
+	return node.HealthReport{Status: node.HealthPass, Round: m.status.LastRound}
+}
+
 func (m *mockNode) GenesisHash() crypto.Digest {
 	return m.ledger.(*data.Ledger).GenesisHash()
 }
@@ -237,6 +263,10 @@ func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	return m.timestampOffset, nil
 }
 
+func (m *mockNode) AgreementTimelines() ([]agreement.RoundTimeline, error) {
+	return m.timelines, m.err
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
@@ -370,6 +370,29 @@ func convertLogs(txn node.TxnWithStatus) *[][]byte {
 	return logItems
 }
 
+// confirmedTxnInfo converts a confirmed transaction to PreEncodedTxInfo for the REST API
+func confirmedTxnInfo(txn node.TxnWithStatus, l LedgerForAPI) PreEncodedTxInfo {
+	response := PreEncodedTxInfo{
+		Txn:       txn.Txn,
+		PoolError: txn.PoolError,
+	}
+
+	r := uint64(txn.ConfirmedRound)
+	response.ConfirmedRound = &r
+
+	response.ClosingAmount = &txn.ApplyData.ClosingAmount.Raw
+	response.AssetClosingAmount = &txn.ApplyData.AssetClosingAmount
+	response.SenderRewards = &txn.ApplyData.SenderRewards.Raw
+	response.ReceiverRewards = &txn.ApplyData.ReceiverRewards.Raw
+	response.CloseRewards = &txn.ApplyData.CloseRewards.Raw
+	response.AssetIndex = computeAssetIndexFromTxn(txn, l)
+	response.ApplicationIndex = computeAppIndexFromTxn(txn, l)
+	response.LocalStateDelta, response.GlobalStateDelta = convertToDeltas(txn)
+	response.Logs = convertLogs(txn)
+	response.Inners = convertInners(&txn)
+	return response
+}
+
 func convertInners(txn *node.TxnWithStatus) *[]PreEncodedTxInfo {
 	inner := make([]PreEncodedTxInfo, len(txn.ApplyData.EvalDelta.InnerTxns))
 	for i := range txn.ApplyData.EvalDelta.InnerTxns {
@@ -72,3 +72,30 @@ type BuildVersion struct {
 	// Branch-derived release channel the build is based on
 	Channel string `json:"channel"`
 }
+
+// HealthChecks contains the results of the node's health checks.
+// swagger:model HealthChecks
+type HealthChecks struct {
+	// required: true
+	// The most severe status of the checks: pass, warn or fail
+	Status string `json:"status"`
+	// required: true
+	// The latest round of the node's ledger
+	Round uint64 `json:"round"`
+	// required: true
+	Checks []HealthCheck `json:"checks"`
+}
+
+// HealthCheck contains the result of one of the node's health checks.
+type HealthCheck struct {
+	// required: true
+	// The name of the check: catchup, network-lag, peers, disk, tracker-commit or participation
+	Name string `json:"name"`
+	// required: true
+	// The status of the check: pass, warn or fail
+	Status string `json:"status"`
+	// Why the check did not pass
+	Message string `json:"message,omitempty"`
+	// The values the check was evaluated on
+	Details map[string]uint64 `json:"details,omitempty"`
+}
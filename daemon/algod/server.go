@@ -54,6 +54,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	apiServer "github.com/algorand/go-algorand/daemon/algod/api/server"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/logging"
@@ -397,8 +398,10 @@ func (s *Server) Start() {
 		MaxHeaderBytes: maxHeaderBytes,
 	}
 
+	scopedTokens := middlewares.MakeScopedTokens(filepath.Join(s.RootPath, tokens.AlgodScopedTokensFilename), s.log)
+
 	e := apiServer.NewRouter(
-		s.log, s.node, s.stopping, apiToken, adminAPIToken, listener,
+		s.log, s.node, s.stopping, apiToken, adminAPIToken, scopedTokens, listener,
 		cfg.RestConnectionsSoftLimit)
 
 	// Set up files for our PID and our listening address
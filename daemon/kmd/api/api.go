@@ -87,6 +87,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-algorand/daemon/kmd/api/v1"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
@@ -153,7 +154,7 @@ func SwaggerHandler(w http.ResponseWriter, r *http.Request) {
 
 // Handler returns the root mux router for the kmd API. It sets up handlers on
 // subrouters specific to each API version.
-func Handler(sm *session.Manager, log logging.Logger, allowedOrigins []string, apiToken string, pnaHeader bool, reqCB func()) *mux.Router {
+func Handler(sm *session.Manager, auditLog *audit.Log, log logging.Logger, allowedOrigins []string, apiToken string, pnaHeader bool, reqCB func()) *mux.Router {
 	rootRouter := mux.NewRouter()
 
 	// Send the appropriate CORS headers
@@ -173,7 +174,7 @@ func Handler(sm *session.Manager, log logging.Logger, allowedOrigins []string, a
 
 	// Handle API V1 routes at /v1/<...>
 	v1Router := rootRouter.PathPrefix(fmt.Sprintf("/%s", apiV1Tag)).Subrouter()
-	v1.RegisterHandlers(v1Router, sm, log, apiToken, reqCB)
+	v1.RegisterHandlers(v1Router, sm, auditLog, log, apiToken, reqCB)
 
 	return rootRouter
 }
@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// audit records an operation on the wallet in the audit log, along with the
+// error it failed with, if any. The result of an operation must not be
+// returned to the client unless it was recorded.
+func (ctx reqContext) audit(r *http.Request, w wallet.Wallet, entry audit.Entry, opErr error) error {
+	if ctx.auditLog == nil {
+		return nil
+	}
+
+	if w != nil {
+		metadata, err := w.Metadata()
+		if err == nil {
+			entry.WalletID = string(metadata.ID)
+		}
+	}
+	entry.Origin = r.RemoteAddr
+	entry.UserAgent = r.UserAgent()
+	if opErr != nil {
+		entry.Error = opErr.Error()
+	}
+
+	_, err := ctx.auditLog.Append(entry)
+	if err != nil {
+		return fmt.Errorf("could not write audit log: %w", err)
+	}
+	return nil
+}
+
+// addressAuditEntry describes an operation on a key or multisig address. The
+// address is left out if it is unknown, e.g. because an import failed.
+func addressAuditEntry(op string, addr crypto.Digest) audit.Entry {
+	entry := audit.Entry{Operation: op}
+	if !addr.IsZero() {
+		entry.Address = basics.Address(addr).String()
+	}
+	return entry
+}
+
+// txAuditEntry describes signing a transaction with the passed key, or with
+// the key of the sender if pk is empty
+func txAuditEntry(op string, tx transactions.Transaction, pk crypto.PublicKey) audit.Entry {
+	entry := addressAuditEntry(op, crypto.Digest(pk))
+	entry.TxID = tx.ID().String()
+	entry.Sender = tx.Sender.String()
+	entry.TxType = string(tx.Type)
+	switch tx.Type {
+	case protocol.PaymentTx:
+		entry.Amount = tx.Amount.Raw
+		entry.Receiver = tx.Receiver.String()
+	case protocol.AssetTransferTx:
+		entry.Amount = tx.AssetAmount
+		entry.AssetID = uint64(tx.XferAsset)
+		entry.Receiver = tx.AssetReceiver.String()
+	}
+	return entry
+}
+
+// programAuditEntry describes signing a program
+func programAuditEntry(op string, program []byte, addr crypto.Digest) audit.Entry {
+	entry := addressAuditEntry(op, addr)
+	entry.ProgramHash = crypto.HashObj(logic.Program(program)).String()
+	return entry
+}
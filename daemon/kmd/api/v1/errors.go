@@ -42,3 +42,4 @@ var errCouldNotDecode = fmt.Errorf("could not decode request body")
 var errCouldNotDecodeAddress = fmt.Errorf("could not decode address")
 var errCouldNotDecodeTx = fmt.Errorf("could not decode transaction")
 var errInvalidAPIToken = fmt.Errorf("invalid API token")
+var errNoAuditLog = fmt.Errorf("audit log not available")
@@ -33,11 +33,14 @@
 package v1
 
 import (
+	"math"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
@@ -51,7 +54,8 @@ import (
 // reqContext is passed to each of the handlers below via wrapCtx, allowing
 // handlers to interact with kmd's session store
 type reqContext struct {
-	sm *session.Manager
+	sm       *session.Manager
+	auditLog *audit.Log
 }
 
 // errorResponse sets the specified status code (should != 200), and fills in the
@@ -100,6 +104,57 @@ func apiWalletFromMetadata(metadata wallet.Metadata) kmdapi.APIV1Wallet {
 	}
 }
 
+// policyFromAPI decodes the policy of a scoped wallet handle
+func policyFromAPI(p *kmdapi.APIV1WalletHandlePolicy) (policy session.Policy, err error) {
+	for _, addr := range p.Addresses {
+		a, err := basics.UnmarshalChecksumAddress(addr)
+		if err != nil {
+			return session.Policy{}, errCouldNotDecodeAddress
+		}
+		policy.Keys = append(policy.Keys, crypto.Digest(a))
+	}
+	for _, addr := range p.Receivers {
+		a, err := basics.UnmarshalChecksumAddress(addr)
+		if err != nil {
+			return session.Policy{}, errCouldNotDecodeAddress
+		}
+		policy.Receivers = append(policy.Receivers, a)
+	}
+	for _, appID := range p.AppIDs {
+		policy.AppIDs = append(policy.AppIDs, basics.AppIndex(appID))
+	}
+	policy.TxTypes = p.TxTypes
+	policy.MaxAmount = p.MaxAmount
+	policy.MaxAmountPerPeriod = p.MaxAmountPerPeriod
+	policy.Period = time.Duration(p.PeriodSeconds) * time.Second
+	policy.RequireApproval = p.RequireApproval
+	return policy, nil
+}
+
+// apiWalletHandlePolicy returns the policy of a wallet obtained from a scoped
+// handle, or nil if the handle is unscoped
+func apiWalletHandlePolicy(wallet wallet.Wallet) *kmdapi.APIV1WalletHandlePolicy {
+	policy, ok := session.PolicyOf(wallet)
+	if !ok {
+		return nil
+	}
+	p := &kmdapi.APIV1WalletHandlePolicy{
+		Addresses:          encodeAddresses(policy.Keys),
+		TxTypes:            policy.TxTypes,
+		MaxAmount:          policy.MaxAmount,
+		MaxAmountPerPeriod: policy.MaxAmountPerPeriod,
+		PeriodSeconds:      uint64(policy.Period / time.Second),
+		RequireApproval:    policy.RequireApproval,
+	}
+	for _, addr := range policy.Receivers {
+		p.Receivers = append(p.Receivers, addr.String())
+	}
+	for _, appID := range policy.AppIDs {
+		p.AppIDs = append(p.AppIDs, uint64(appID))
+	}
+	return p
+}
+
 // getWalletsHandler handles `GET /v1/wallets`
 func getWalletsHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation GET /v1/wallets ListWallets
@@ -227,6 +282,8 @@ func postWalletInitHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	//      These tokens expire periodically and must be renewed. You can `POST` the token to `/v1/wallet/info`
 	//      to see how much time remains until expiration, and renew it with `/v1/wallet/renew`. When you're done,
 	//      you can invalidate the token with `/v1/wallet/release`.
+	//      If a policy is passed, the token is scoped: it may only sign what the policy allows,
+	//      without the wallet password, and may not export, import or delete keys.
 	//    Produces:
 	//    - application/json
 	//    Parameters:
@@ -256,7 +313,23 @@ func postWalletInitHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	}
 
 	// Attempt to auth
-	handleToken, err := ctx.sm.InitWalletHandle(wallet, []byte(req.WalletPassword))
+	var handleToken []byte
+	if req.Policy != nil {
+		var policy session.Policy
+		policy, err = policyFromAPI(req.Policy)
+		if err != nil {
+			errorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		err = policy.Validate()
+		if err != nil {
+			errorResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		handleToken, err = ctx.sm.InitScopedWalletHandle(wallet, []byte(req.WalletPassword), policy)
+	} else {
+		handleToken, err = ctx.sm.InitWalletHandle(wallet, []byte(req.WalletPassword))
+	}
 	if err != nil {
 		errorResponse(w, http.StatusUnauthorized, err)
 		return
@@ -319,6 +392,7 @@ func postWalletInfoHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 		WalletHandle: kmdapi.APIV1WalletHandle{
 			Wallet:         apiWalletFromMetadata(metadata),
 			ExpiresSeconds: expiresSeconds,
+			Policy:         apiWalletHandlePolicy(wallet),
 		},
 	}
 
@@ -366,6 +440,10 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 
 	// Export the master derivation key
 	mdk, err := wallet.ExportMasterDerivationKey([]byte(req.WalletPassword))
+	if auditErr := ctx.audit(r, wallet, audit.Entry{Operation: audit.OpExportMasterKey}, err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -467,6 +545,7 @@ func postWalletRenewHandler(ctx reqContext, w http.ResponseWriter, r *http.Reque
 		WalletHandle: kmdapi.APIV1WalletHandle{
 			Wallet:         apiWalletFromMetadata(metadata),
 			ExpiresSeconds: expiresSeconds,
+			Policy:         apiWalletHandlePolicy(wallet),
 		},
 	}
 
@@ -545,6 +624,120 @@ func postWalletRenameHandler(ctx reqContext, w http.ResponseWriter, r *http.Requ
 	successResponse(w, resp)
 }
 
+// postWalletBackupHandler handles `POST /v1/wallet/backup`
+func postWalletBackupHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/backup BackupWallet
+	//---
+	//    Summary: Back up a wallet
+	//    Description: >
+	//      Export the keys, multisig preimages and metadata of the wallet as a single portable file,
+	//      encrypted with the backup password. Unlike the master derivation key, the backup also
+	//      covers imported keys. The file can be restored on any kmd with `/v1/wallet/restore`.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Backup Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/BackupWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/BackupWalletResponse"
+	var req kmdapi.APIV1POSTWalletBackupRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Back up the wallet
+	backup, err := driver.BackupWallet(wallet, []byte(req.WalletPassword), []byte(req.BackupPassword))
+	if auditErr := ctx.audit(r, wallet, audit.Entry{Operation: audit.OpBackupWallet}, err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletBackupResponse{
+		Backup: backup,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletRestoreHandler handles `POST /v1/wallet/restore`
+func postWalletRestoreHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/restore RestoreWallet
+	//---
+	//    Summary: Restore a wallet from a backup
+	//    Description: >
+	//      Create a wallet from a file produced by `/v1/wallet/backup`. The restored wallet keeps the ID
+	//      of the backed up wallet and is protected by the given wallet password. It keeps its original
+	//      name unless a new one is given.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Restore Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RestoreWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RestoreWalletResponse"
+	var req kmdapi.APIV1POSTWalletRestoreRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Restore the wallet
+	wallet, err := driver.RestoreWallet(req.Backup, []byte(req.BackupPassword), []byte(req.WalletName), []byte(req.WalletPassword))
+	if auditErr := ctx.audit(r, wallet, audit.Entry{Operation: audit.OpRestoreWallet}, err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch metadata about the wallet we just restored
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletRestoreResponse{
+		Wallet: apiWalletFromMetadata(metadata),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postKeyImportHandler handles `POST /v1/key/import`
 func postKeyImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/key/import ImportKey
@@ -584,6 +777,10 @@ func postKeyImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request
 
 	// Import the key
 	addr, err := wallet.ImportKey(req.PrivateKey)
+	if auditErr := ctx.audit(r, wallet, addressAuditEntry(audit.OpImportKey, addr), err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -641,6 +838,10 @@ func postKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request
 
 	// Export the key
 	secretKey, err := wallet.ExportKey(crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	if auditErr := ctx.audit(r, wallet, addressAuditEntry(audit.OpExportKey, crypto.Digest(reqAddr)), err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -750,6 +951,10 @@ func deleteKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 
 	// Delete the key
 	err = wallet.DeleteKey(crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	if auditErr := ctx.audit(r, wallet, addressAuditEntry(audit.OpDeleteKey, crypto.Digest(reqAddr)), err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -860,6 +1065,10 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 
 	// Sign the transaction
 	stx, err := wallet.SignTransaction(tx, req.PublicKey, []byte(req.WalletPassword))
+	if auditErr := ctx.audit(r, wallet, txAuditEntry(audit.OpSignTransaction, tx, req.PublicKey), err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -918,6 +1127,10 @@ func postProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Reque
 	}
 
 	stx, err := wallet.SignProgram(req.Program, crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	if auditErr := ctx.audit(r, wallet, programAuditEntry(audit.OpSignProgram, req.Program, crypto.Digest(reqAddr)), err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -1020,6 +1233,10 @@ func postMultisigImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Re
 
 	// Import the key
 	addr, err := wallet.ImportMultisigAddr(req.Version, req.Threshold, req.PKs)
+	if auditErr := ctx.audit(r, wallet, addressAuditEntry(audit.OpImportMultisig, addr), err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -1144,6 +1361,10 @@ func postMultisigTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r
 
 	// Sign the transaction
 	msig, err := wallet.MultisigSignTransaction(tx, req.PublicKey, req.PartialMsig, []byte(req.WalletPassword), req.AuthAddr)
+	if auditErr := ctx.audit(r, wallet, txAuditEntry(audit.OpMultisigSignTransaction, tx, req.PublicKey), err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -1203,6 +1424,10 @@ func postMultisigProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *ht
 
 	// Sign the transaction
 	msig, err := wallet.MultisigSignProgram(req.Program, crypto.Digest(reqAddr), req.PublicKey, req.PartialMsig, []byte(req.WalletPassword))
+	if auditErr := ctx.audit(r, wallet, programAuditEntry(audit.OpMultisigSignProgram, req.Program, crypto.Digest(reqAddr)), err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -1261,6 +1486,10 @@ func deleteMultisigHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 
 	// Delete the key
 	err = wallet.DeleteMultisigAddr(crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	if auditErr := ctx.audit(r, wallet, addressAuditEntry(audit.OpDeleteMultisig, crypto.Digest(reqAddr)), err); auditErr != nil {
+		errorResponse(w, http.StatusInternalServerError, auditErr)
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -1273,6 +1502,186 @@ func deleteMultisigHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	successResponse(w, resp)
 }
 
+// postApprovalListHandler handles `POST /v1/approval/list`
+func postApprovalListHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/approval/list ListApprovals
+	//---
+	//    Summary: List pending approvals
+	//    Description: >
+	//      Lists the out-of-policy requests of scoped wallet handles that are awaiting approval.
+	//      The passed wallet handle token must be an unscoped token for the same wallet.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: List Approvals Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ListApprovalsRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ListApprovalsResponse"
+	var req kmdapi.APIV1POSTApprovalListRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// List the approvals
+	approvals, err := ctx.sm.ListApprovals([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTApprovalListResponse{
+		Approvals: make([]kmdapi.APIV1Approval, 0, len(approvals)),
+	}
+	now := time.Now()
+	for _, approval := range approvals {
+		resp.Approvals = append(resp.Approvals, kmdapi.APIV1Approval{
+			ID:             approval.ID,
+			Description:    approval.Description,
+			Reason:         approval.Reason,
+			ExpiresSeconds: int64(approval.Expires.Sub(now).Seconds()),
+		})
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postApprovalHandler handles `POST /v1/approval`
+func postApprovalHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/approval Approve
+	//---
+	//    Summary: Approve a pending request
+	//    Description: >
+	//      Approves an out-of-policy request of a scoped wallet handle. The scoped handle may then
+	//      repeat the request once. The passed wallet handle token must be an unscoped token for the
+	//      same wallet.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Approve Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ApproveRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ApproveResponse"
+	var req kmdapi.APIV1POSTApprovalRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Approve the request
+	err = ctx.sm.Approve([]byte(req.WalletHandleToken), []byte(req.WalletPassword), req.ApprovalID)
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTApprovalResponse{}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postAuditListHandler handles `POST /v1/audit/list`
+func postAuditListHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/audit/list ListAudit
+	//---
+	//    Summary: Query the audit log
+	//    Description: >
+	//      Returns the entries of the audit log of signing, export, import and delete operations
+	//      matching the passed filters, oldest first. Each entry is hash-chained to the one before it;
+	//      if the log has been tampered with, `chain_error` describes where.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: List Audit Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ListAuditRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ListAuditResponse"
+	var req kmdapi.APIV1POSTAuditListRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	if ctx.auditLog == nil {
+		errorResponse(w, http.StatusNotFound, errNoAuditLog)
+		return
+	}
+
+	// Query the audit log
+	entries, err := ctx.auditLog.Query(audit.Filter{
+		WalletID:  req.WalletID,
+		Address:   req.Address,
+		Operation: req.Operation,
+		AfterSeq:  req.AfterSeq,
+		Limit:     int(min(req.Limit, math.MaxInt32)),
+	})
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTAuditListResponse{
+		Entries: make([]kmdapi.APIV1AuditEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, kmdapi.APIV1AuditEntry{
+			Seq:         e.Seq,
+			Time:        e.Time.Unix(),
+			Operation:   e.Operation,
+			WalletID:    e.WalletID,
+			Origin:      e.Origin,
+			UserAgent:   e.UserAgent,
+			Address:     e.Address,
+			TxID:        e.TxID,
+			Sender:      e.Sender,
+			TxType:      e.TxType,
+			Amount:      e.Amount,
+			AssetID:     e.AssetID,
+			Receiver:    e.Receiver,
+			ProgramHash: e.ProgramHash,
+			Error:       e.Error,
+			PrevHash:    e.PrevHash,
+			Hash:        e.Hash,
+		})
+	}
+	err = ctx.auditLog.Verify()
+	if err != nil {
+		resp.ChainError = err.Error()
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // wrapCtx is used to pass common context to each request without using any
 // global variables.
 func wrapCtx(ctx reqContext, handler func(reqContext, http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
@@ -1296,7 +1705,7 @@ func reqCallbackMiddleware(reqCB func()) func(http.Handler) http.Handler {
 }
 
 // RegisterHandlers sets up the API handlers on the passed router
-func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logger, apiToken string, reqCB func()) {
+func RegisterHandlers(router *mux.Router, sm *session.Manager, auditLog *audit.Log, log logging.Logger, apiToken string, reqCB func()) {
 	// All /v1 requests require a valid auth token
 	router.Use(authMiddleware(log, apiToken))
 
@@ -1305,7 +1714,8 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 
 	// ctx holds the global context passed to each of the handlers
 	ctx := reqContext{
-		sm: sm,
+		sm:       sm,
+		auditLog: auditLog,
 	}
 
 	router.HandleFunc("/wallets", wrapCtx(ctx, getWalletsHandler)).Methods("GET")
@@ -1315,6 +1725,8 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/wallet/renew", wrapCtx(ctx, postWalletRenewHandler)).Methods("POST")
 	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
 	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
+	router.HandleFunc("/wallet/backup", wrapCtx(ctx, postWalletBackupHandler)).Methods("POST")
+	router.HandleFunc("/wallet/restore", wrapCtx(ctx, postWalletRestoreHandler)).Methods("POST")
 	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
 
 	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
@@ -1332,4 +1744,9 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 
 	router.HandleFunc("/transaction/sign", wrapCtx(ctx, postTransactionSignHandler)).Methods("POST")
 	router.HandleFunc("/program/sign", wrapCtx(ctx, postProgramSignHandler)).Methods("POST")
+
+	router.HandleFunc("/approval/list", wrapCtx(ctx, postApprovalListHandler)).Methods("POST")
+	router.HandleFunc("/approval", wrapCtx(ctx, postApprovalHandler)).Methods("POST")
+
+	router.HandleFunc("/audit/list", wrapCtx(ctx, postAuditListHandler)).Methods("POST")
 }
@@ -0,0 +1,317 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package audit implements kmd's append-only, hash-chained log of operations
+// on wallet keys.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// Filename is the name of the audit log in the kmd data dir
+const Filename = "kmd_audit.log"
+
+// Operations recorded in the audit log
+const (
+	OpSignTransaction         = "sign_transaction"
+	OpSignProgram             = "sign_program"
+	OpMultisigSignTransaction = "multisig_sign_transaction"
+	OpMultisigSignProgram     = "multisig_sign_program"
+	OpExportKey               = "export_key"
+	OpExportMasterKey         = "export_master_key"
+	OpBackupWallet            = "backup_wallet"
+	OpImportKey               = "import_key"
+	OpImportMultisig          = "import_multisig"
+	OpRestoreWallet           = "restore_wallet"
+	OpDeleteKey               = "delete_key"
+	OpDeleteMultisig          = "delete_multisig"
+)
+
+// Entry is a record of one operation. Each entry commits to the previous one
+// through PrevHash, so that entries can't be changed, removed or reordered
+// without breaking the chain.
+type Entry struct {
+	Seq       uint64    `json:"seq"`
+	Time      time.Time `json:"time"`
+	Operation string    `json:"op"`
+	WalletID  string    `json:"wallet_id"`
+	// Origin is the remote address of the client, and UserAgent what it
+	// claims to be
+	Origin    string `json:"origin,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+
+	// Address is the key or multisig address operated on
+	Address string `json:"address,omitempty"`
+	TxID    string `json:"txid,omitempty"`
+	Sender  string `json:"sender,omitempty"`
+	TxType  string `json:"tx_type,omitempty"`
+	// Amount is in microalgos for payments and in base units for asset
+	// transfers
+	Amount      uint64 `json:"amount,omitempty"`
+	AssetID     uint64 `json:"asset_id,omitempty"`
+	Receiver    string `json:"receiver,omitempty"`
+	ProgramHash string `json:"program_hash,omitempty"`
+
+	// Error is set if the operation failed
+	Error string `json:"error,omitempty"`
+
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// hash computes the hash of the entry, which covers every field but Hash
+func (e Entry) hash() (string, error) {
+	e.Hash = ""
+	enc, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	return crypto.Hash(enc).String(), nil
+}
+
+// Filter selects audit log entries. Zero fields match every entry.
+type Filter struct {
+	WalletID string
+	// Address matches the key operated on or the sender of the transaction
+	Address   string
+	Operation string
+	AfterSeq  uint64
+	Limit     int
+}
+
+func (f Filter) match(e Entry) bool {
+	return e.Seq > f.AfterSeq &&
+		(f.WalletID == "" || e.WalletID == f.WalletID) &&
+		(f.Address == "" || e.Address == f.Address || e.Sender == f.Address) &&
+		(f.Operation == "" || e.Operation == f.Operation)
+}
+
+// Log is an audit log backed by a file of JSON entries, one per line
+type Log struct {
+	mu       deadlock.Mutex
+	path     string
+	f        *os.File
+	seq      uint64
+	lastHash string
+}
+
+// Open opens the audit log at path, creating it if it does not exist.
+// Appended entries continue the chain of the last intact entry.
+func Open(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	l := &Log{path: path, f: f, lastHash: crypto.Digest{}.String()}
+
+	// Find the tail of the chain
+	var good int64
+	err = scanEntries(f, func(e Entry, end int64) error {
+		l.seq = e.Seq
+		l.lastHash = e.Hash
+		good = end
+		return nil
+	}, func(end int64) {
+		good = end
+	})
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	// Drop a partial entry left behind by a crash, so that the next entry
+	// starts on its own line
+	err = f.Truncate(good)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	_, err = f.Seek(good, io.SeekStart)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// scanEntries reads the entries of the log, calling fn with each intact
+// entry and skip with each corrupted line, along with the offset of the end
+// of the line. A final line missing its newline is a torn write and is
+// reported to neither.
+func scanEntries(r io.ReaderAt, fn func(e Entry, end int64) error, skip func(end int64)) error {
+	br := bufio.NewReader(io.NewSectionReader(r, 0, 1<<62))
+	var offset int64
+	for {
+		line, err := br.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		offset += int64(len(line))
+
+		var e Entry
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.DisallowUnknownFields()
+		if dec.Decode(&e) != nil || e.Hash == "" {
+			if skip != nil {
+				skip(offset)
+			}
+			continue
+		}
+		err = fn(e, offset)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Append adds an entry to the log, filling in its sequence number, time and
+// hashes. The entry is synced to disk before Append returns.
+func (l *Log) Append(e Entry) (Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		return Entry{}, os.ErrClosed
+	}
+
+	e.Seq = l.seq + 1
+	e.Time = time.Now().UTC()
+	e.PrevHash = l.lastHash
+	hash, err := e.hash()
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Hash = hash
+
+	enc, err := json.Marshal(e)
+	if err != nil {
+		return Entry{}, err
+	}
+	_, err = l.f.Write(append(enc, '\n'))
+	if err != nil {
+		return Entry{}, err
+	}
+	err = l.f.Sync()
+	if err != nil {
+		return Entry{}, err
+	}
+
+	l.seq = e.Seq
+	l.lastHash = e.Hash
+	return e, nil
+}
+
+// Query returns the entries matching the filter, in order
+func (l *Log) Query(filter Filter) (entries []Entry, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		return nil, os.ErrClosed
+	}
+
+	errLimit := fmt.Errorf("limit reached")
+	err = scanEntries(l.f, func(e Entry, _ int64) error {
+		if !filter.match(e) {
+			return nil
+		}
+		entries = append(entries, e)
+		if filter.Limit > 0 && len(entries) >= filter.Limit {
+			return errLimit
+		}
+		return nil
+	}, nil)
+	if err == errLimit {
+		err = nil
+	}
+	return
+}
+
+// Verify checks the whole chain, returning an error describing the first
+// entry that was tampered with, if any
+func (l *Log) Verify() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		return os.ErrClosed
+	}
+
+	var seq uint64
+	prevHash := crypto.Digest{}.String()
+	var line, corrupted int
+	err := scanEntries(l.f, func(e Entry, _ int64) error {
+		line++
+		if e.Seq != seq+1 {
+			return fmt.Errorf("audit log line %d: expected entry %d, found %d", line, seq+1, e.Seq)
+		}
+		if e.PrevHash != prevHash {
+			return fmt.Errorf("audit log entry %d does not follow entry %d", e.Seq, seq)
+		}
+		hash, err := e.hash()
+		if err != nil {
+			return err
+		}
+		if hash != e.Hash {
+			return fmt.Errorf("audit log entry %d was modified", e.Seq)
+		}
+		seq = e.Seq
+		prevHash = e.Hash
+		return nil
+	}, func(int64) {
+		line++
+		if corrupted == 0 {
+			corrupted = line
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if corrupted != 0 {
+		return fmt.Errorf("audit log line %d is corrupted", corrupted)
+	}
+	if seq != l.seq || prevHash != l.lastHash {
+		return fmt.Errorf("audit log entries after %d are missing or corrupted", seq)
+	}
+	return nil
+}
+
+// Close closes the log
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Close()
+	l.f = nil
+	return err
+}
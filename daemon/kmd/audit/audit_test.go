@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func openTestLog(t *testing.T) (*Log, string) {
+	path := filepath.Join(t.TempDir(), Filename)
+	l, err := Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	return l, path
+}
+
+func TestAuditLogAppendQuery(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l, path := openTestLog(t)
+	_, err := l.Append(Entry{Operation: OpSignTransaction, WalletID: "w1", Sender: "A", TxID: "T1", Amount: 5})
+	require.NoError(t, err)
+	_, err = l.Append(Entry{Operation: OpExportKey, WalletID: "w2", Address: "B"})
+	require.NoError(t, err)
+	_, err = l.Append(Entry{Operation: OpSignTransaction, WalletID: "w2", Sender: "B", TxID: "T2", Error: "wrong password"})
+	require.NoError(t, err)
+	require.NoError(t, l.Verify())
+
+	entries, err := l.Query(Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for i, e := range entries {
+		require.Equal(t, uint64(i+1), e.Seq)
+		if i > 0 {
+			require.Equal(t, entries[i-1].Hash, e.PrevHash)
+		}
+	}
+
+	entries, err = l.Query(Filter{WalletID: "w2"})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	entries, err = l.Query(Filter{Address: "B", Operation: OpSignTransaction})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "T2", entries[0].TxID)
+	entries, err = l.Query(Filter{AfterSeq: 1, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(2), entries[0].Seq)
+
+	// Reopening continues the chain
+	require.NoError(t, l.Close())
+	l, err = Open(path)
+	require.NoError(t, err)
+	defer l.Close()
+	e, err := l.Append(Entry{Operation: OpDeleteKey, WalletID: "w1", Address: "A"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), e.Seq)
+	require.NoError(t, l.Verify())
+}
+
+func TestAuditLogTamper(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, tamper := range []func(lines []string) []string{
+		// Modify an entry
+		func(lines []string) []string {
+			lines[1] = strings.Replace(lines[1], `"amount":2`, `"amount":20`, 1)
+			return lines
+		},
+		// Remove an entry
+		func(lines []string) []string {
+			return append(lines[:1], lines[2:]...)
+		},
+		// Reorder entries
+		func(lines []string) []string {
+			lines[0], lines[1] = lines[1], lines[0]
+			return lines
+		},
+		// Corrupt the last entry
+		func(lines []string) []string {
+			lines[2] = "garbage"
+			return lines
+		},
+	} {
+		l, path := openTestLog(t)
+		for i := uint64(1); i <= 3; i++ {
+			_, err := l.Append(Entry{Operation: OpSignTransaction, Amount: i})
+			require.NoError(t, err)
+		}
+		require.NoError(t, l.Close())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		lines = tamper(lines)
+		require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600))
+
+		l, err = Open(path)
+		require.NoError(t, err)
+		_, err = l.Append(Entry{Operation: OpSignTransaction, Amount: 4})
+		require.NoError(t, err)
+		require.Error(t, l.Verify())
+		l.Close()
+	}
+}
+
+func TestAuditLogTornWrite(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l, path := openTestLog(t)
+	_, err := l.Append(Entry{Operation: OpSignTransaction})
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"seq":2,"op":"sign_tr`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	l, err = Open(path)
+	require.NoError(t, err)
+	defer l.Close()
+	e, err := l.Append(Entry{Operation: OpSignTransaction})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), e.Seq)
+	require.NoError(t, l.Verify())
+}
@@ -115,6 +115,12 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTWalletInfoRequest:
 		reqPath = "v1/wallet/info"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletBackupRequest:
+		reqPath = "v1/wallet/backup"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletRestoreRequest:
+		reqPath = "v1/wallet/restore"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTMasterKeyExportRequest:
 		reqPath = "v1/master-key/export"
 		reqMethod = "POST"
@@ -157,6 +163,15 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1DELETEMultisigRequest:
 		reqPath = "v1/multisig"
 		reqMethod = "DELETE"
+	case kmdapi.APIV1POSTApprovalListRequest:
+		reqPath = "v1/approval/list"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTApprovalRequest:
+		reqPath = "v1/approval"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTAuditListRequest:
+		reqPath = "v1/audit/list"
+		reqMethod = "POST"
 	}
 	return
 }
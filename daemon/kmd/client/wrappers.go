@@ -85,6 +85,18 @@ func (kcl KMDClient) InitWallet(walletID []byte, walletPassword []byte) (resp km
 	return
 }
 
+// InitScopedWallet wraps kmdapi.APIV1POSTWalletInitRequest with a policy,
+// returning a scoped wallet handle
+func (kcl KMDClient) InitScopedWallet(walletID []byte, walletPassword []byte, policy kmdapi.APIV1WalletHandlePolicy) (resp kmdapi.APIV1POSTWalletInitResponse, err error) {
+	req := kmdapi.APIV1POSTWalletInitRequest{
+		WalletID:       string(walletID),
+		WalletPassword: string(walletPassword),
+		Policy:         &policy,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // ReleaseWalletHandle wraps kmdapi.APIV1POSTWalletReleaseRequest
 func (kcl KMDClient) ReleaseWalletHandle(walletHandle []byte) (resp kmdapi.APIV1POSTWalletReleaseResponse, err error) {
 	req := kmdapi.APIV1POSTWalletReleaseRequest{
@@ -213,6 +225,62 @@ func (kcl KMDClient) ExportMasterDerivationKey(walletHandle []byte, walletPasswo
 	return
 }
 
+// BackupWallet wraps kmdapi.APIV1POSTWalletBackupRequest
+func (kcl KMDClient) BackupWallet(walletHandle []byte, walletPassword []byte, backupPassword []byte) (resp kmdapi.APIV1POSTWalletBackupResponse, err error) {
+	req := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		BackupPassword:    string(backupPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RestoreWallet wraps kmdapi.APIV1POSTWalletRestoreRequest
+func (kcl KMDClient) RestoreWallet(backup []byte, backupPassword []byte, walletName []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletRestoreResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRestoreRequest{
+		Backup:         backup,
+		BackupPassword: string(backupPassword),
+		WalletName:     string(walletName),
+		WalletPassword: string(walletPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ListApprovals wraps kmdapi.APIV1POSTApprovalListRequest
+func (kcl KMDClient) ListApprovals(walletHandle []byte) (resp kmdapi.APIV1POSTApprovalListResponse, err error) {
+	req := kmdapi.APIV1POSTApprovalListRequest{
+		WalletHandleToken: string(walletHandle),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// Approve wraps kmdapi.APIV1POSTApprovalRequest
+func (kcl KMDClient) Approve(walletHandle []byte, walletPassword []byte, approvalID string) (resp kmdapi.APIV1POSTApprovalResponse, err error) {
+	req := kmdapi.APIV1POSTApprovalRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		ApprovalID:        approvalID,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// ListAudit wraps kmdapi.APIV1POSTAuditListRequest
+func (kcl KMDClient) ListAudit(walletID []byte, address string, op string, afterSeq uint64, limit uint64) (resp kmdapi.APIV1POSTAuditListResponse, err error) {
+	req := kmdapi.APIV1POSTAuditListRequest{
+		WalletID:  string(walletID),
+		Address:   address,
+		Operation: op,
+		AfterSeq:  afterSeq,
+		Limit:     limit,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignTransaction wraps kmdapi.APIV1POSTTransactionSignRequest
 func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKey, tx transactions.Transaction) (resp kmdapi.APIV1POSTTransactionSignResponse, err error) {
 	txBytes := protocol.Encode(&tx)
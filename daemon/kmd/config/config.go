@@ -63,6 +63,7 @@ type KMDConfig struct {
 type DriverConfig struct {
 	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
 	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+	PKCS11WalletDriverConfig PKCS11WalletDriverConfig `json:"pkcs11"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -77,6 +78,21 @@ type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
 }
 
+// PKCS11WalletDriverConfig is configuration specific to the PKCS11WalletDriver
+type PKCS11WalletDriverConfig struct {
+	// ModulePath is the PKCS#11 library to load, e.g. libsofthsm2.so. The
+	// driver is disabled when it is empty.
+	ModulePath string              `json:"module_path"`
+	Tokens     []PKCS11TokenConfig `json:"tokens"`
+}
+
+// PKCS11TokenConfig describes a token exposed as a wallet by the PKCS11WalletDriver
+type PKCS11TokenConfig struct {
+	TokenLabel string `json:"token_label"`
+	// WalletName defaults to the token label
+	WalletName string `json:"wallet_name"`
+}
+
 // ScryptParams stores the parameters used for key derivation. This allows
 // upgrading security parameters over time
 type ScryptParams struct {
@@ -112,6 +128,21 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+
+	pkcs11Cfg := k.DriverConfig.PKCS11WalletDriverConfig
+	if pkcs11Cfg.ModulePath != "" {
+		if !filepath.IsAbs(pkcs11Cfg.ModulePath) {
+			return ErrPKCS11ModuleNotAbsolute
+		}
+		if len(pkcs11Cfg.Tokens) == 0 {
+			return ErrPKCS11NoTokens
+		}
+		for _, token := range pkcs11Cfg.Tokens {
+			if token.TokenLabel == "" {
+				return ErrPKCS11NoTokenLabel
+			}
+		}
+	}
 	return nil
 }
 
@@ -38,3 +38,12 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrPKCS11ModuleNotAbsolute is returned when the passed PKCS#11 module path is relative
+var ErrPKCS11ModuleNotAbsolute = fmt.Errorf("pkcs11 module path must be absolute path")
+
+// ErrPKCS11NoTokens is returned when a PKCS#11 module is configured without any tokens
+var ErrPKCS11NoTokens = fmt.Errorf("pkcs11 module configured without any tokens")
+
+// ErrPKCS11NoTokenLabel is returned when a PKCS#11 token is configured without a label
+var ErrPKCS11NoTokenLabel = fmt.Errorf("pkcs11 token must have a token_label")
@@ -66,6 +66,51 @@ type APIV1Wallet struct {
 // APIV1WalletHandle includes the wallet the handle corresponds to
 // and the number of number of seconds to expiration
 type APIV1WalletHandle struct {
-	Wallet         APIV1Wallet `json:"wallet"`
-	ExpiresSeconds int64       `json:"expires_seconds"`
+	Wallet         APIV1Wallet              `json:"wallet"`
+	ExpiresSeconds int64                    `json:"expires_seconds"`
+	Policy         *APIV1WalletHandlePolicy `json:"policy,omitempty"`
+}
+
+// APIV1WalletHandlePolicy restricts what a scoped wallet handle may sign.
+// Empty fields leave the corresponding dimension unrestricted.
+type APIV1WalletHandlePolicy struct {
+	Addresses          []string          `json:"addresses,omitempty"`
+	TxTypes            []protocol.TxType `json:"tx_types,omitempty"`
+	MaxAmount          uint64            `json:"max_amount,omitempty"`
+	MaxAmountPerPeriod uint64            `json:"max_amount_per_period,omitempty"`
+	PeriodSeconds      uint64            `json:"period_seconds,omitempty"`
+	Receivers          []string          `json:"receivers,omitempty"`
+	AppIDs             []uint64          `json:"app_ids,omitempty"`
+	RequireApproval    bool              `json:"require_approval,omitempty"`
+}
+
+// APIV1Approval is an out-of-policy request of a scoped wallet handle
+// awaiting approval
+type APIV1Approval struct {
+	ID             string `json:"id"`
+	Description    string `json:"description"`
+	Reason         string `json:"reason"`
+	ExpiresSeconds int64  `json:"expires_seconds"`
+}
+
+// APIV1AuditEntry is a record in kmd's audit log of an operation on wallet
+// keys. Hash commits to all other fields and to the previous entry.
+type APIV1AuditEntry struct {
+	Seq         uint64 `json:"seq"`
+	Time        int64  `json:"time"`
+	Operation   string `json:"op"`
+	WalletID    string `json:"wallet_id"`
+	Origin      string `json:"origin,omitempty"`
+	UserAgent   string `json:"user_agent,omitempty"`
+	Address     string `json:"address,omitempty"`
+	TxID        string `json:"txid,omitempty"`
+	Sender      string `json:"sender,omitempty"`
+	TxType      string `json:"tx_type,omitempty"`
+	Amount      uint64 `json:"amount,omitempty"`
+	AssetID     uint64 `json:"asset_id,omitempty"`
+	Receiver    string `json:"receiver,omitempty"`
+	ProgramHash string `json:"program_hash,omitempty"`
+	Error       string `json:"error,omitempty"`
+	PrevHash    string `json:"prev_hash"`
+	Hash        string `json:"hash"`
 }
@@ -75,6 +75,9 @@ type APIV1POSTWalletInitRequest struct {
 
 	WalletID       string `json:"wallet_id"`
 	WalletPassword string `json:"wallet_password"`
+	// Policy, if set, makes a scoped handle that may only sign what the
+	// policy allows, without the wallet password
+	Policy *APIV1WalletHandlePolicy `json:"policy,omitempty"`
 }
 
 // APIV1POSTWalletReleaseRequest is the request for `POST /v1/wallet/release`
@@ -106,6 +109,49 @@ type APIV1POSTWalletRenameRequest struct {
 	NewWalletName  string `json:"wallet_name"`
 }
 
+// APIV1POSTWalletBackupRequest is the request for `POST /v1/wallet/backup`
+//
+// swagger:model BackupWalletRequest
+type APIV1POSTWalletBackupRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	BackupPassword    string `json:"backup_password"`
+}
+
+// APIV1POSTWalletRestoreRequest is the request for `POST /v1/wallet/restore`
+//
+// swagger:model RestoreWalletRequest
+type APIV1POSTWalletRestoreRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Backup         []byte `json:"backup"`
+	BackupPassword string `json:"backup_password"`
+	WalletName     string `json:"wallet_name"`
+	WalletPassword string `json:"wallet_password"`
+}
+
+// APIV1POSTApprovalListRequest is the request for `POST /v1/approval/list`
+//
+// swagger:model ListApprovalsRequest
+type APIV1POSTApprovalListRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+}
+
+// APIV1POSTApprovalRequest is the request for `POST /v1/approval`
+//
+// swagger:model ApproveRequest
+type APIV1POSTApprovalRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	ApprovalID        string `json:"approval_id"`
+}
+
 // APIV1POSTWalletInfoRequest is the request for `POST /v1/wallet/info`
 //
 // swagger:model WalletInfoRequest
@@ -278,3 +324,16 @@ type APIV1POSTMultisigProgramSignRequest struct {
 	PartialMsig    crypto.MultisigSig `json:"partial_multisig"`
 	WalletPassword string             `json:"wallet_password"`
 }
+
+// APIV1POSTAuditListRequest is the request for `POST /v1/audit/list`
+//
+// swagger:model ListAuditRequest
+type APIV1POSTAuditListRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletID  string `json:"wallet_id"`
+	Address   string `json:"address"`
+	Operation string `json:"op"`
+	AfterSeq  uint64 `json:"after_seq"`
+	Limit     uint64 `json:"limit"`
+}
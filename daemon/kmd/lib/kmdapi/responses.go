@@ -161,6 +161,61 @@ type renameWalletResponse struct {
 	Body *APIV1POSTWalletRenameResponse
 }
 
+// APIV1POSTWalletBackupResponse is the response to `POST /v1/wallet/backup`
+// friendly:BackupWalletResponse
+type APIV1POSTWalletBackupResponse struct {
+	APIV1ResponseEnvelope
+	Backup []byte `json:"backup"`
+}
+
+// Response to `POST /v1/wallet/backup`
+// swagger:response BackupWalletResponse
+type backupWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletBackupResponse
+}
+
+// APIV1POSTWalletRestoreResponse is the response to `POST /v1/wallet/restore`
+// friendly:RestoreWalletResponse
+type APIV1POSTWalletRestoreResponse struct {
+	APIV1ResponseEnvelope
+	Wallet APIV1Wallet `json:"wallet"`
+}
+
+// Response to `POST /v1/wallet/restore`
+// swagger:response RestoreWalletResponse
+type restoreWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletRestoreResponse
+}
+
+// APIV1POSTApprovalListResponse is the response to `POST /v1/approval/list`
+// friendly:ListApprovalsResponse
+type APIV1POSTApprovalListResponse struct {
+	APIV1ResponseEnvelope
+	Approvals []APIV1Approval `json:"approvals"`
+}
+
+// Response to `POST /v1/approval/list`
+// swagger:response ListApprovalsResponse
+type listApprovalsResponse struct {
+	//	in:body
+	Body *APIV1POSTApprovalListResponse
+}
+
+// APIV1POSTApprovalResponse is the response to `POST /v1/approval`
+// friendly:ApproveResponse
+type APIV1POSTApprovalResponse struct {
+	APIV1ResponseEnvelope
+}
+
+// Response to `POST /v1/approval`
+// swagger:response ApproveResponse
+type approveResponse struct {
+	//	in:body
+	Body *APIV1POSTApprovalResponse
+}
+
 // APIV1POSTWalletInfoResponse is the response to `POST /v1/wallet/info`
 // friendly:WalletInfoResponse
 type APIV1POSTWalletInfoResponse struct {
@@ -378,3 +433,19 @@ type signProgramMultisigResponse struct {
 	//	in:body
 	Body *APIV1POSTMultisigProgramSignResponse
 }
+
+// APIV1POSTAuditListResponse is the response to `POST /v1/audit/list`
+// friendly:ListAuditResponse
+type APIV1POSTAuditListResponse struct {
+	APIV1ResponseEnvelope
+	Entries []APIV1AuditEntry `json:"entries"`
+	// ChainError describes where the audit log was tampered with, if it was
+	ChainError string `json:"chain_error,omitempty"`
+}
+
+// Response to `POST /v1/audit/list`
+// swagger:response ListAuditResponse
+type listAuditResponse struct {
+	//	in:body
+	Body *APIV1POSTAuditListResponse
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/gofrs/flock"
 
 	"github.com/algorand/go-algorand/daemon/kmd/api"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/util/tokens"
@@ -85,6 +86,7 @@ type WalletServer struct {
 	fileLock     *flock.Flock
 	sockPath     string
 	tmpSocketDir string
+	auditLog     *audit.Log
 
 	// This mutex protects shutdown, which lets us know if we died unexpectedly
 	// or as a result of being killed
@@ -214,10 +216,18 @@ func (ws *WalletServer) Start(kill chan os.Signal) (died chan error, sock string
 		return
 	}
 
+	// Open the audit log, which only one instance may write to
+	ws.auditLog, err = audit.Open(filepath.Join(ws.DataDir, audit.Filename))
+	if err != nil {
+		ws.releaseFileLock()
+		return
+	}
+
 	// Start the server
 	died, sock, err = ws.start(kill)
 	if err != nil {
 		// Release our file lock if we failed to start
+		ws.auditLog.Close()
 		ws.releaseFileLock()
 	}
 	return
@@ -228,7 +238,7 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 	// Initialize HTTP server
 	watchdogCB := ws.makeWatchdogCallback(kill)
 	srv := http.Server{
-		Handler: api.Handler(ws.SessionManager, ws.Log, ws.AllowedOrigins, ws.APIToken, ws.AllowHeaderPNA, watchdogCB),
+		Handler: api.Handler(ws.SessionManager, ws.auditLog, ws.Log, ws.AllowedOrigins, ws.APIToken, ws.AllowHeaderPNA, watchdogCB),
 	}
 
 	// Read the kill channel and shut down the server gracefully
@@ -300,6 +310,9 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 		// Clean up the session manager gracefully
 		ws.SessionManager.Kill()
 
+		// Close the audit log
+		ws.auditLog.Close()
+
 		// Release our file lock
 		ws.releaseFileLock()
 
@@ -137,6 +137,12 @@ func (sm *Manager) deleteExpiredHandles() {
 			delete(sm.walletHandles, handleID)
 		}
 	}
+	for id, approval := range sm.approvals {
+		_, ok := sm.walletHandles[approval.handleID]
+		if !ok || checkHandleNotExpired(approval.Expires) != nil {
+			delete(sm.approvals, id)
+		}
+	}
 }
 
 // InitWalletHandle attempts to init the wallet using the passed password,
@@ -154,6 +160,48 @@ func (sm *Manager) InitWalletHandle(w wallet.Wallet, pw []byte) ([]byte, error)
 		return nil, err
 	}
 
+	return sm.insertHandle(handleID, handleSecret, w), nil
+}
+
+// InitScopedWalletHandle is like InitWalletHandle, but the handle may only
+// sign what the policy allows. The holder of the handle does not need the
+// wallet password to sign.
+func (sm *Manager) InitScopedWalletHandle(w wallet.Wallet, pw []byte, policy Policy) ([]byte, error) {
+	err := policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// Attempt to initialize the wallet with the password
+	err = w.Init(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := w.Metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate wallet handle credentials
+	handleID, handleSecret, err := generateHandleIDAndSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	scoped := &scopedWallet{
+		Wallet:   w,
+		sm:       sm,
+		handleID: string(handleID),
+		walletID: string(metadata.ID),
+		pw:       pw,
+		policy:   policy,
+	}
+	return sm.insertHandle(handleID, handleSecret, scoped), nil
+}
+
+// insertHandle adds a handle for the wallet and returns its token
+func (sm *Manager) insertHandle(handleID []byte, handleSecret []byte, w wallet.Wallet) []byte {
 	// Build the walletHandle
 	handle := walletHandle{
 		secret:  handleSecret,
@@ -165,9 +213,7 @@ func (sm *Manager) InitWalletHandle(w wallet.Wallet, pw []byte) ([]byte, error)
 	sm.mux.Lock()
 	defer sm.mux.Unlock()
 	sm.walletHandles[string(handleID)] = handle
-	handleToken := []byte(fmt.Sprintf("%s%s%s", handleID, wHandleTokenSplitChar, handleSecret))
-
-	return handleToken, nil
+	return []byte(fmt.Sprintf("%s%s%s", handleID, wHandleTokenSplitChar, handleSecret))
 }
 
 // getHandleFromTokenLocked is a helper that looks up the wallet handle from
@@ -207,8 +253,13 @@ func (sm *Manager) ReleaseWalletHandle(walletHandleToken []byte) error {
 		return err
 	}
 
-	// Delete the handle
+	// Delete the handle along with its pending approvals
 	delete(sm.walletHandles, string(handleID))
+	for id, approval := range sm.approvals {
+		if approval.handleID == string(handleID) {
+			delete(sm.approvals, id)
+		}
+	}
 	return nil
 }
 
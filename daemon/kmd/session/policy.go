@@ -0,0 +1,486 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Policy restricts what a scoped wallet handle may sign. Empty fields leave
+// the corresponding dimension unrestricted.
+type Policy struct {
+	// Keys are the addresses of the keys the handle may sign with
+	Keys []crypto.Digest
+	// TxTypes are the transaction types the handle may sign
+	TxTypes []protocol.TxType
+	// MaxAmount caps the microalgos, amount plus fee, spent by a single
+	// transaction. While amounts are limited, closing out accounts and
+	// transferring assets are out of policy, since they can't be counted
+	// against the limits.
+	MaxAmount uint64
+	// MaxAmountPerPeriod caps the microalgos spent over any window of Period
+	MaxAmountPerPeriod uint64
+	Period             time.Duration
+	// Receivers are the addresses payments and asset transfers may go to
+	Receivers []basics.Address
+	// AppIDs are the applications the handle may call
+	AppIDs []basics.AppIndex
+	// RequireApproval queues out-of-policy requests for approval by an
+	// unscoped handle of the same wallet, instead of rejecting them
+	RequireApproval bool
+}
+
+// Validate checks that the policy is consistent
+func (p Policy) Validate() error {
+	if p.MaxAmountPerPeriod != 0 && p.Period <= 0 {
+		return fmt.Errorf("a period is required to limit the amount per period")
+	}
+	return nil
+}
+
+// PolicyViolationError is returned when a scoped handle attempts an operation
+// outside of its policy
+type PolicyViolationError struct {
+	Reason string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("request violates wallet handle policy: %s", e.Reason)
+}
+
+// ApprovalRequiredError is returned when an out-of-policy request has been
+// queued for approval. Once approved, the same request will succeed.
+type ApprovalRequiredError struct {
+	ApprovalID string
+	Reason     string
+}
+
+func (e *ApprovalRequiredError) Error() string {
+	return fmt.Sprintf("request violates wallet handle policy (%s), approval %s required", e.Reason, e.ApprovalID)
+}
+
+var errScopedNotAllowed = &PolicyViolationError{Reason: "operation not allowed on scoped wallet handles"}
+
+// Approval is a request from a scoped handle awaiting approval
+type Approval struct {
+	ID          string
+	Description string
+	Reason      string
+	Expires     time.Time
+}
+
+type pendingApproval struct {
+	Approval
+	handleID string
+	walletID string
+	digest   crypto.Digest
+	approved bool
+}
+
+type spend struct {
+	at     time.Time
+	amount uint64
+}
+
+// scopedWallet enforces the policy of a scoped handle on top of the wallet.
+// The handle holds the wallet password so that its holder can sign without
+// knowing it.
+type scopedWallet struct {
+	wallet.Wallet
+
+	sm       *Manager
+	handleID string
+	walletID string
+	pw       []byte
+	policy   Policy
+
+	// mu serializes signing so that the spending history can't be raced
+	mu    deadlock.Mutex
+	spent []spend
+}
+
+// PolicyOf returns the policy of a wallet obtained from a scoped handle
+func PolicyOf(w wallet.Wallet) (Policy, bool) {
+	sw, ok := w.(*scopedWallet)
+	if !ok {
+		return Policy{}, false
+	}
+	return sw.policy, true
+}
+
+// checkTransaction returns the microalgos spent by tx, and why it violates the
+// policy, if it does
+func (sw *scopedWallet) checkTransaction(tx transactions.Transaction, signer crypto.Digest) (amount uint64, reason string) {
+	p := sw.policy
+	amount = tx.Fee.Raw
+	if tx.Type == protocol.PaymentTx {
+		amount, _ = basics.OAdd(amount, tx.Amount.Raw)
+	}
+
+	if len(p.Keys) > 0 && !slices.Contains(p.Keys, signer) {
+		return amount, fmt.Sprintf("key %s not allowed", basics.Address(signer))
+	}
+	if len(p.TxTypes) > 0 && !slices.Contains(p.TxTypes, tx.Type) {
+		return amount, fmt.Sprintf("transaction type %s not allowed", tx.Type)
+	}
+	if !tx.RekeyTo.IsZero() {
+		return amount, "rekeying not allowed"
+	}
+	if p.MaxAmount != 0 && amount > p.MaxAmount {
+		return amount, fmt.Sprintf("amount %d exceeds the limit of %d", amount, p.MaxAmount)
+	}
+
+	if len(p.Receivers) > 0 {
+		var receivers []basics.Address
+		switch tx.Type {
+		case protocol.PaymentTx:
+			receivers = append(receivers, tx.Receiver, tx.CloseRemainderTo)
+		case protocol.AssetTransferTx:
+			receivers = append(receivers, tx.AssetReceiver, tx.AssetCloseTo)
+		}
+		for _, receiver := range receivers {
+			if !receiver.IsZero() && !slices.Contains(p.Receivers, receiver) {
+				return amount, fmt.Sprintf("receiver %s not allowed", receiver)
+			}
+		}
+	}
+	// Closing out an account spends its whole balance, and asset amounts
+	// aren't microalgos
+	if p.MaxAmount != 0 || p.MaxAmountPerPeriod != 0 {
+		switch {
+		case tx.Type == protocol.PaymentTx && !tx.CloseRemainderTo.IsZero():
+			return amount, "closing the account exceeds the amount limits"
+		case tx.Type == protocol.AssetTransferTx && !tx.AssetCloseTo.IsZero():
+			return amount, "closing out the asset exceeds the amount limits"
+		case tx.Type == protocol.AssetTransferTx && tx.AssetAmount != 0:
+			return amount, "asset transfers exceed the amount limits"
+		}
+	}
+
+	if len(p.AppIDs) > 0 && tx.Type == protocol.ApplicationCallTx && !slices.Contains(p.AppIDs, tx.ApplicationID) {
+		return amount, fmt.Sprintf("application %d not allowed", tx.ApplicationID)
+	}
+	return amount, ""
+}
+
+// checkPeriodLocked returns why spending amount now would exceed the period
+// limit, if it would
+func (sw *scopedWallet) checkPeriodLocked(now time.Time, amount uint64) string {
+	p := sw.policy
+	if p.MaxAmountPerPeriod == 0 {
+		return ""
+	}
+
+	// Forget spending that fell out of the window
+	cutoff := now.Add(-p.Period)
+	sw.spent = slices.DeleteFunc(sw.spent, func(s spend) bool { return !s.at.After(cutoff) })
+
+	total := amount
+	for _, s := range sw.spent {
+		total, _ = basics.OAdd(total, s.amount)
+	}
+	if total > p.MaxAmountPerPeriod {
+		return fmt.Sprintf("spending %d would exceed the limit of %d per %s", amount, p.MaxAmountPerPeriod, p.Period)
+	}
+	return ""
+}
+
+// authorizeLocked decides whether a request may go ahead. Out-of-policy
+// requests go ahead only if they were approved.
+func (sw *scopedWallet) authorizeLocked(digest crypto.Digest, description string, amount uint64, reason string) error {
+	if reason == "" {
+		reason = sw.checkPeriodLocked(time.Now(), amount)
+	}
+	if reason == "" {
+		return nil
+	}
+	if !sw.policy.RequireApproval {
+		return &PolicyViolationError{Reason: reason}
+	}
+	if sw.sm.consumeApproval(sw.handleID, digest) {
+		return nil
+	}
+	id, err := sw.sm.requestApproval(sw.handleID, sw.walletID, digest, description, reason)
+	if err != nil {
+		return err
+	}
+	return &ApprovalRequiredError{ApprovalID: id, Reason: reason}
+}
+
+func (sw *scopedWallet) recordSpendLocked(amount uint64) {
+	if sw.policy.MaxAmountPerPeriod != 0 {
+		sw.spent = append(sw.spent, spend{at: time.Now(), amount: amount})
+	}
+}
+
+// requestDigest identifies a signing request for approvals
+func requestDigest(msg crypto.Hashable, signer crypto.Digest) crypto.Digest {
+	h := crypto.HashObj(msg)
+	return crypto.Hash(append(h[:], signer[:]...))
+}
+
+func (sw *scopedWallet) signTransactionLocked(tx transactions.Transaction, signer crypto.Digest, sign func() error) error {
+	amount, reason := sw.checkTransaction(tx, signer)
+	description := fmt.Sprintf("sign %s transaction %s with %s", tx.Type, tx.ID(), basics.Address(signer))
+	err := sw.authorizeLocked(requestDigest(tx, signer), description, amount, reason)
+	if err != nil {
+		return err
+	}
+	err = sign()
+	if err != nil {
+		return err
+	}
+	sw.recordSpendLocked(amount)
+	return nil
+}
+
+// signProgramLocked authorizes signing a program. Delegated logic signatures
+// can spend without bound, so they always need approval.
+func (sw *scopedWallet) signProgramLocked(program []byte, signer crypto.Digest, sign func() error) error {
+	progb := logic.Program(program)
+	description := fmt.Sprintf("sign program %s with %s", crypto.HashObj(&progb), basics.Address(signer))
+	err := sw.authorizeLocked(requestDigest(&progb, signer), description, 0, "program signing not allowed")
+	if err != nil {
+		return err
+	}
+	return sign()
+}
+
+// SignTransaction signs the transaction if the policy allows it
+func (sw *scopedWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) (stx []byte, err error) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	signer := crypto.Digest(pk)
+	if (pk == crypto.PublicKey{}) {
+		signer = crypto.Digest(tx.Src())
+	}
+	err = sw.signTransactionLocked(tx, signer, func() (err error) {
+		stx, err = sw.Wallet.SignTransaction(tx, pk, sw.pw)
+		return
+	})
+	return
+}
+
+// MultisigSignTransaction adds a signature to the multisig transaction if
+// the policy allows it
+func (sw *scopedWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (sig crypto.MultisigSig, err error) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	err = sw.signTransactionLocked(tx, crypto.Digest(pk), func() (err error) {
+		sig, err = sw.Wallet.MultisigSignTransaction(tx, pk, partial, sw.pw, signer)
+		return
+	})
+	return
+}
+
+// SignProgram signs the program once approved
+func (sw *scopedWallet) SignProgram(program []byte, src crypto.Digest, pw []byte) (sig []byte, err error) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	err = sw.signProgramLocked(program, src, func() (err error) {
+		sig, err = sw.Wallet.SignProgram(program, src, sw.pw)
+		return
+	})
+	return
+}
+
+// MultisigSignProgram adds a signature to the multisig program once approved
+func (sw *scopedWallet) MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (sig crypto.MultisigSig, err error) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	err = sw.signProgramLocked(program, crypto.Digest(pk), func() (err error) {
+		sig, err = sw.Wallet.MultisigSignProgram(program, src, pk, partial, sw.pw)
+		return
+	})
+	return
+}
+
+// The operations below are not available to scoped handles, since they would
+// let the holder take the keys or change the wallet
+
+// ExportMasterDerivationKey implements the Wallet interface
+func (sw *scopedWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errScopedNotAllowed
+}
+
+// ImportKey implements the Wallet interface
+func (sw *scopedWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errScopedNotAllowed
+}
+
+// ExportKey implements the Wallet interface
+func (sw *scopedWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errScopedNotAllowed
+}
+
+// GenerateKey implements the Wallet interface
+func (sw *scopedWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	return crypto.Digest{}, errScopedNotAllowed
+}
+
+// DeleteKey implements the Wallet interface
+func (sw *scopedWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return errScopedNotAllowed
+}
+
+// ImportMultisigAddr implements the Wallet interface
+func (sw *scopedWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errScopedNotAllowed
+}
+
+// DeleteMultisigAddr implements the Wallet interface
+func (sw *scopedWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	return errScopedNotAllowed
+}
+
+// requestApproval queues a request from a scoped handle for approval,
+// returning the ID of the approval. Repeated requests share an approval
+// until it expires.
+func (sm *Manager) requestApproval(handleID string, walletID string, digest crypto.Digest, description string, reason string) (string, error) {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	for id, approval := range sm.approvals {
+		if checkHandleNotExpired(approval.Expires) != nil {
+			delete(sm.approvals, id)
+			continue
+		}
+		if approval.handleID == handleID && approval.digest == digest {
+			return id, nil
+		}
+	}
+
+	id, _, err := generateHandleIDAndSecret()
+	if err != nil {
+		return "", err
+	}
+	sm.approvals[string(id)] = &pendingApproval{
+		Approval: Approval{
+			ID:          string(id),
+			Description: description,
+			Reason:      reason,
+			Expires:     time.Now().Add(sm.sessionLifetime),
+		},
+		handleID: handleID,
+		walletID: walletID,
+		digest:   digest,
+	}
+	return string(id), nil
+}
+
+// consumeApproval reports whether the request was approved, using up the
+// approval if it was
+func (sm *Manager) consumeApproval(handleID string, digest crypto.Digest) bool {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	for id, approval := range sm.approvals {
+		if checkHandleNotExpired(approval.Expires) != nil {
+			delete(sm.approvals, id)
+			continue
+		}
+		if approval.handleID == handleID && approval.digest == digest && approval.approved {
+			delete(sm.approvals, id)
+			return true
+		}
+	}
+	return false
+}
+
+// authApprover checks that the token is an unscoped handle, which may
+// approve requests from scoped handles of the same wallet
+func (sm *Manager) authApprover(walletHandleToken []byte) (wallet.Wallet, string, error) {
+	w, _, err := sm.AuthWithWalletHandleToken(walletHandleToken)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, scoped := w.(*scopedWallet); scoped {
+		return nil, "", errScopedNotAllowed
+	}
+	metadata, err := w.Metadata()
+	if err != nil {
+		return nil, "", err
+	}
+	return w, string(metadata.ID), nil
+}
+
+// ListApprovals lists the pending approvals of scoped handles of the wallet
+// of the passed unscoped handle
+func (sm *Manager) ListApprovals(walletHandleToken []byte) ([]Approval, error) {
+	_, walletID, err := sm.authApprover(walletHandleToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	var approvals []Approval
+	for id, approval := range sm.approvals {
+		if checkHandleNotExpired(approval.Expires) != nil {
+			delete(sm.approvals, id)
+			continue
+		}
+		if approval.walletID == walletID && !approval.approved {
+			approvals = append(approvals, approval.Approval)
+		}
+	}
+	slices.SortFunc(approvals, func(a, b Approval) int { return a.Expires.Compare(b.Expires) })
+	return approvals, nil
+}
+
+// Approve approves a pending request of a scoped handle. The approver must
+// hold an unscoped handle for the same wallet and know its password.
+func (sm *Manager) Approve(walletHandleToken []byte, pw []byte, approvalID string) error {
+	w, walletID, err := sm.authApprover(walletHandleToken)
+	if err != nil {
+		return err
+	}
+	err = w.CheckPassword(pw)
+	if err != nil {
+		return err
+	}
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	approval, ok := sm.approvals[approvalID]
+	if ok && checkHandleNotExpired(approval.Expires) != nil {
+		delete(sm.approvals, approvalID)
+		ok = false
+	}
+	if !ok || approval.walletID != walletID {
+		return fmt.Errorf("approval does not exist")
+	}
+	approval.approved = true
+	return nil
+}
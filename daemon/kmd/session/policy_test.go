@@ -0,0 +1,323 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testWallet signs anything with a correct password and counts signatures
+type testWallet struct {
+	wallet.Wallet
+	pw     string
+	signed int
+}
+
+func (w *testWallet) Init(pw []byte) error {
+	return w.CheckPassword(pw)
+}
+
+func (w *testWallet) CheckPassword(pw []byte) error {
+	if string(pw) != w.pw {
+		return errors.New("wrong password")
+	}
+	return nil
+}
+
+func (w *testWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{ID: []byte("test-wallet")}, nil
+}
+
+func (w *testWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	err := w.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+	w.signed++
+	return protocol.Encode(&tx), nil
+}
+
+func (w *testWallet) SignProgram(program []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	err := w.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+	w.signed++
+	return program, nil
+}
+
+func makeTestManager(t *testing.T) *Manager {
+	sm := MakeManager(config.KMDConfig{SessionLifetimeSecs: 60})
+	t.Cleanup(sm.Kill)
+	return sm
+}
+
+func testPayment(sender, receiver basics.Address, amount uint64) transactions.Transaction {
+	return transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender: sender,
+			Fee:    basics.MicroAlgos{Raw: 1000},
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: receiver,
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+}
+
+func TestScopedWalletHandlePolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sm := makeTestManager(t)
+	w := &testWallet{pw: "hunter2"}
+
+	sender := basics.Address{1}
+	receiver := basics.Address{2}
+	policy := Policy{
+		Keys:      []crypto.Digest{crypto.Digest(sender)},
+		TxTypes:   []protocol.TxType{protocol.PaymentTx},
+		MaxAmount: 101000,
+		Receivers: []basics.Address{receiver},
+	}
+
+	_, err := sm.InitScopedWalletHandle(w, []byte("wrong"), policy)
+	require.Error(t, err)
+
+	token, err := sm.InitScopedWalletHandle(w, []byte("hunter2"), policy)
+	require.NoError(t, err)
+	sw, _, err := sm.AuthWithWalletHandleToken(token)
+	require.NoError(t, err)
+	got, scoped := PolicyOf(sw)
+	require.True(t, scoped)
+	require.Equal(t, policy, got)
+
+	// Signing within the policy needs no password
+	_, err = sw.SignTransaction(testPayment(sender, receiver, 100000), crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, w.signed)
+
+	var violation *PolicyViolationError
+	rekey := testPayment(sender, receiver, 1)
+	rekey.RekeyTo = basics.Address{3}
+	closeOut := testPayment(sender, receiver, 1)
+	closeOut.CloseRemainderTo = receiver
+	keyreg := testPayment(sender, receiver, 0)
+	keyreg.Type = protocol.KeyRegistrationTx
+	for _, tx := range []transactions.Transaction{
+		testPayment(sender, receiver, 100001),
+		testPayment(sender, basics.Address{3}, 1),
+		testPayment(basics.Address{3}, receiver, 1),
+		rekey,
+		closeOut,
+		keyreg,
+	} {
+		_, err = sw.SignTransaction(tx, crypto.PublicKey{}, []byte("hunter2"))
+		require.ErrorAs(t, err, &violation)
+	}
+	_, err = sw.SignProgram([]byte{1}, crypto.Digest(sender), nil)
+	require.ErrorAs(t, err, &violation)
+	require.Equal(t, 1, w.signed)
+
+	// Scoped handles can't take the keys
+	_, err = sw.ExportKey(crypto.Digest(sender), []byte("hunter2"))
+	require.ErrorAs(t, err, &violation)
+	_, err = sw.ExportMasterDerivationKey([]byte("hunter2"))
+	require.ErrorAs(t, err, &violation)
+
+	// Nor list or grant approvals
+	_, err = sm.ListApprovals(token)
+	require.ErrorAs(t, err, &violation)
+
+	// Unscoped handles are unaffected
+	token, err = sm.InitWalletHandle(w, []byte("hunter2"))
+	require.NoError(t, err)
+	uw, _, err := sm.AuthWithWalletHandleToken(token)
+	require.NoError(t, err)
+	_, scoped = PolicyOf(uw)
+	require.False(t, scoped)
+	_, err = uw.SignTransaction(testPayment(sender, basics.Address{3}, 1000000), crypto.PublicKey{}, []byte("hunter2"))
+	require.NoError(t, err)
+}
+
+func TestScopedWalletHandleAssetTransfer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sm := makeTestManager(t)
+	w := &testWallet{pw: "hunter2"}
+
+	sender := basics.Address{1}
+	receiver := basics.Address{2}
+	axfer := func(amount uint64, closeTo basics.Address) transactions.Transaction {
+		return transactions.Transaction{
+			Type:   protocol.AssetTransferTx,
+			Header: transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: 1000}},
+			AssetTransferTxnFields: transactions.AssetTransferTxnFields{
+				XferAsset:     1,
+				AssetAmount:   amount,
+				AssetReceiver: receiver,
+				AssetCloseTo:  closeTo,
+			},
+		}
+	}
+
+	for _, policy := range []Policy{
+		{MaxAmount: 100000},
+		{MaxAmountPerPeriod: 100000, Period: time.Hour},
+	} {
+		token, err := sm.InitScopedWalletHandle(w, []byte("hunter2"), policy)
+		require.NoError(t, err)
+		sw, _, err := sm.AuthWithWalletHandleToken(token)
+		require.NoError(t, err)
+
+		// Opting in moves no asset
+		_, err = sw.SignTransaction(axfer(0, basics.Address{}), crypto.PublicKey{}, nil)
+		require.NoError(t, err)
+
+		var violation *PolicyViolationError
+		_, err = sw.SignTransaction(axfer(1, basics.Address{}), crypto.PublicKey{}, nil)
+		require.ErrorAs(t, err, &violation)
+		_, err = sw.SignTransaction(axfer(0, receiver), crypto.PublicKey{}, nil)
+		require.ErrorAs(t, err, &violation)
+	}
+	require.Equal(t, 2, w.signed)
+
+	// Without amount limits, assets may move
+	token, err := sm.InitScopedWalletHandle(w, []byte("hunter2"), Policy{Receivers: []basics.Address{receiver}})
+	require.NoError(t, err)
+	sw, _, err := sm.AuthWithWalletHandleToken(token)
+	require.NoError(t, err)
+	_, err = sw.SignTransaction(axfer(1000, receiver), crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, w.signed)
+}
+
+func TestScopedWalletHandlePeriodLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sm := makeTestManager(t)
+	w := &testWallet{pw: "hunter2"}
+
+	_, err := sm.InitScopedWalletHandle(w, []byte("hunter2"), Policy{MaxAmountPerPeriod: 10000})
+	require.Error(t, err)
+
+	token, err := sm.InitScopedWalletHandle(w, []byte("hunter2"), Policy{MaxAmountPerPeriod: 10000, Period: time.Hour})
+	require.NoError(t, err)
+	sw, _, err := sm.AuthWithWalletHandleToken(token)
+	require.NoError(t, err)
+
+	sender := basics.Address{1}
+	for i := 0; i < 3; i++ {
+		_, err = sw.SignTransaction(testPayment(sender, basics.Address{2}, 2000), crypto.PublicKey{}, nil)
+		require.NoError(t, err)
+	}
+	var violation *PolicyViolationError
+	_, err = sw.SignTransaction(testPayment(sender, basics.Address{2}, 2000), crypto.PublicKey{}, nil)
+	require.ErrorAs(t, err, &violation)
+	_, err = sw.SignTransaction(testPayment(sender, basics.Address{2}, 0), crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+
+	// Spending falls out of the window over time
+	scoped := sw.(*scopedWallet)
+	for i := range scoped.spent {
+		scoped.spent[i].at = scoped.spent[i].at.Add(-time.Hour)
+	}
+	_, err = sw.SignTransaction(testPayment(sender, basics.Address{2}, 2000), crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+}
+
+func TestScopedWalletHandleApproval(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sm := makeTestManager(t)
+	w := &testWallet{pw: "hunter2"}
+
+	token, err := sm.InitScopedWalletHandle(w, []byte("hunter2"), Policy{MaxAmount: 10000, RequireApproval: true})
+	require.NoError(t, err)
+	sw, _, err := sm.AuthWithWalletHandleToken(token)
+	require.NoError(t, err)
+	approverToken, err := sm.InitWalletHandle(w, []byte("hunter2"))
+	require.NoError(t, err)
+
+	tx := testPayment(basics.Address{1}, basics.Address{2}, 1000000)
+	var required *ApprovalRequiredError
+	_, err = sw.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.ErrorAs(t, err, &required)
+	id := required.ApprovalID
+
+	// Repeating the request doesn't queue another approval
+	_, err = sw.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.ErrorAs(t, err, &required)
+	require.Equal(t, id, required.ApprovalID)
+	require.Zero(t, w.signed)
+
+	approvals, err := sm.ListApprovals(approverToken)
+	require.NoError(t, err)
+	require.Len(t, approvals, 1)
+	require.Equal(t, id, approvals[0].ID)
+	require.Contains(t, approvals[0].Description, tx.ID().String())
+
+	require.Error(t, sm.Approve(approverToken, []byte("wrong"), id))
+	require.Error(t, sm.Approve(approverToken, []byte("hunter2"), "nonexistent"))
+	require.NoError(t, sm.Approve(approverToken, []byte("hunter2"), id))
+	approvals, err = sm.ListApprovals(approverToken)
+	require.NoError(t, err)
+	require.Empty(t, approvals)
+
+	// An approval is good for one request
+	_, err = sw.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, w.signed)
+	_, err = sw.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.ErrorAs(t, err, &required)
+	require.NotEqual(t, id, required.ApprovalID)
+
+	// Consumed approvals are gone, and expired ones are dropped as soon as
+	// approvals are looked at
+	require.Len(t, sm.approvals, 1)
+	sm.approvals[required.ApprovalID].Expires = time.Now().Add(-time.Second)
+	require.Error(t, sm.Approve(approverToken, []byte("hunter2"), required.ApprovalID))
+	require.Empty(t, sm.approvals)
+
+	_, err = sw.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.ErrorAs(t, err, &required)
+	sm.approvals[required.ApprovalID].Expires = time.Now().Add(-time.Second)
+	approvals, err = sm.ListApprovals(approverToken)
+	require.NoError(t, err)
+	require.Empty(t, approvals)
+	require.Empty(t, sm.approvals)
+
+	// Releasing the scoped handle drops its approvals
+	_, err = sw.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.ErrorAs(t, err, &required)
+	require.NoError(t, sm.ReleaseWalletHandle(token))
+	approvals, err = sm.ListApprovals(approverToken)
+	require.NoError(t, err)
+	require.Empty(t, approvals)
+}
@@ -53,6 +53,7 @@ type walletHandle struct {
 type Manager struct {
 	Initialized     bool
 	walletHandles   map[string]walletHandle
+	approvals       map[string]*pendingApproval
 	sessionLifetime time.Duration
 	Kill            context.CancelFunc
 	ctx             context.Context
@@ -66,6 +67,7 @@ func MakeManager(cfg config.KMDConfig) *Manager {
 	sm := &Manager{
 		Initialized:     true,
 		walletHandles:   make(map[string]walletHandle),
+		approvals:       make(map[string]*pendingApproval),
 		sessionLifetime: time.Duration(cfg.SessionLifetimeSecs * uint64(time.Second)),
 		Kill:            cancel,
 		ctx:             ctx,
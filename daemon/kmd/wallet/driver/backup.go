@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+)
+
+const (
+	walletBackupMagic         = "algorand-kmd-wallet-backup"
+	walletBackupFormatVersion = 1
+)
+
+// walletBackupFile is the portable file produced by BackupWallet. The backup
+// itself is encrypted with the backup password in the same format as the
+// blobs stored in sqlite wallets, so it carries its own scrypt parameters.
+type walletBackupFile struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Magic   string `codec:"magic"`
+	Version uint32 `codec:"version"`
+	Blob    []byte `codec:"blob"`
+}
+
+// walletBackup is the plaintext contents of a wallet backup
+type walletBackup struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	DriverName          string                     `codec:"driver"`
+	ID                  []byte                     `codec:"id"`
+	Name                []byte                     `codec:"name"`
+	MasterDerivationKey crypto.MasterDerivationKey `codec:"mdk"`
+	MaxKeyIdx           uint64                     `codec:"max_key_idx"`
+	Keys                []walletBackupKey          `codec:"keys"`
+	Multisigs           []walletBackupMultisig     `codec:"msigs"`
+}
+
+// walletBackupKey is a key in a wallet backup. KeyIdx is the derivation index
+// of generated keys, and zero for imported keys.
+type walletBackupKey struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	SK     crypto.PrivateKey `codec:"sk"`
+	KeyIdx uint64            `codec:"idx"`
+}
+
+// walletBackupMultisig is a multisig preimage in a wallet backup
+type walletBackupMultisig struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version   uint8              `codec:"v"`
+	Threshold uint8              `codec:"t"`
+	PKs       []crypto.PublicKey `codec:"pks"`
+}
+
+// backupWallet is implemented by wallets whose contents can be exported
+type backupWallet interface {
+	backup(pw []byte) (walletBackup, config.ScryptParams, error)
+}
+
+// restoreDriver is implemented by drivers that can recreate a wallet from a
+// backup
+type restoreDriver interface {
+	restoreWallet(backup walletBackup, name []byte, pw []byte) error
+}
+
+// BackupWallet exports the keys, multisig preimages and metadata of the
+// wallet to a single file encrypted with backupPw
+func BackupWallet(w wallet.Wallet, pw []byte, backupPw []byte) ([]byte, error) {
+	if len(backupPw) == 0 {
+		return nil, errBackupNoPassword
+	}
+	bw, ok := w.(backupWallet)
+	if !ok {
+		return nil, errBackupDriver
+	}
+
+	backup, scryptParams, err := bw.backup(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	blob, err := encryptBlobWithPasswordBlankOK(msgpackEncode(backup), PTWalletBackup, backupPw, &scryptParams)
+	if err != nil {
+		return nil, err
+	}
+	return msgpackEncode(walletBackupFile{
+		Magic:   walletBackupMagic,
+		Version: walletBackupFormatVersion,
+		Blob:    blob,
+	}), nil
+}
+
+// RestoreWallet recreates a wallet from a file produced by BackupWallet,
+// keeping its ID and protecting it with pw. The wallet keeps its original
+// name unless name is set.
+func RestoreWallet(backupFile []byte, backupPw []byte, name []byte, pw []byte) (wallet.Wallet, error) {
+	var file walletBackupFile
+	err := msgpackDecode(backupFile, &file)
+	if err != nil || file.Magic != walletBackupMagic || file.Version != walletBackupFormatVersion {
+		return nil, errBackupFormat
+	}
+
+	plaintext, err := decryptBlobWithPassword(file.Blob, PTWalletBackup, backupPw)
+	if err != nil {
+		return nil, err
+	}
+	var backup walletBackup
+	err = msgpackDecode(plaintext, &backup)
+	if err != nil {
+		return nil, errBackupFormat
+	}
+
+	d, err := FetchWalletDriver(backup.DriverName)
+	if err != nil {
+		return nil, err
+	}
+	rd, ok := d.(restoreDriver)
+	if !ok {
+		return nil, errBackupDriver
+	}
+
+	if len(name) == 0 {
+		name = backup.Name
+	}
+	err = rd.restoreWallet(backup, name, pw)
+	if err != nil {
+		return nil, err
+	}
+	return d.FetchWallet(backup.ID)
+}
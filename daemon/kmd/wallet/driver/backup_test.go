@@ -0,0 +1,132 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func testSQLiteWalletDriver(t *testing.T) *SQLiteWalletDriver {
+	var cfg config.KMDConfig
+	cfg.DataDir = t.TempDir()
+	cfg.DriverConfig.SQLiteWalletDriverConfig.UnsafeScrypt = true
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}
+
+	var swd SQLiteWalletDriver
+	require.NoError(t, swd.InitWithConfig(cfg, logging.TestingLog(t)))
+	return &swd
+}
+
+func TestWalletBackupRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Restores go through the registered driver
+	dst := testSQLiteWalletDriver(t)
+	prevDriver := walletDrivers[sqliteWalletDriverName]
+	walletDrivers[sqliteWalletDriverName] = dst
+	t.Cleanup(func() { walletDrivers[sqliteWalletDriverName] = prevDriver })
+
+	src := testSQLiteWalletDriver(t)
+	pw := []byte("hunter2")
+	require.NoError(t, src.CreateWallet([]byte("default"), []byte("walletid"), pw, crypto.MasterDerivationKey{}))
+	w, err := src.FetchWallet([]byte("walletid"))
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+
+	_, err = w.GenerateKey(false)
+	require.NoError(t, err)
+	_, err = w.GenerateKey(false)
+	require.NoError(t, err)
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	_, err = w.ImportKey(crypto.PrivateKey(secrets.SK))
+	require.NoError(t, err)
+	keys, err := w.ListKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+
+	pks := []crypto.PublicKey{crypto.PublicKey(keys[0]), crypto.PublicKey(keys[1])}
+	msigAddr, err := w.ImportMultisigAddr(1, 2, pks)
+	require.NoError(t, err)
+
+	_, err = BackupWallet(w, pw, nil)
+	require.Equal(t, errBackupNoPassword, err)
+	_, err = BackupWallet(w, []byte("wrong"), []byte("backup"))
+	require.Equal(t, errDecrypt, err)
+	backup, err := BackupWallet(w, pw, []byte("backup"))
+	require.NoError(t, err)
+
+	_, err = RestoreWallet([]byte("garbage"), []byte("backup"), nil, pw)
+	require.Equal(t, errBackupFormat, err)
+	_, err = RestoreWallet(backup, []byte("wrong"), nil, pw)
+	require.Equal(t, errDecrypt, err)
+
+	// Restoring next to the original wallet is refused
+	walletDrivers[sqliteWalletDriverName] = src
+	_, err = RestoreWallet(backup, []byte("backup"), []byte("copy"), pw)
+	require.Equal(t, errSameID, err)
+	walletDrivers[sqliteWalletDriverName] = dst
+
+	newPW := []byte("correct horse")
+	restored, err := RestoreWallet(backup, []byte("backup"), nil, newPW)
+	require.NoError(t, err)
+	md, err := restored.Metadata()
+	require.NoError(t, err)
+	require.Equal(t, "default", string(md.Name))
+	require.Equal(t, "walletid", string(md.ID))
+
+	require.Equal(t, errDecrypt, restored.Init(pw))
+	require.NoError(t, restored.Init(newPW))
+
+	restoredKeys, err := restored.ListKeys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, keys, restoredKeys)
+	for _, addr := range keys {
+		sk, err := w.ExportKey(addr, pw)
+		require.NoError(t, err)
+		restoredSK, err := restored.ExportKey(addr, newPW)
+		require.NoError(t, err)
+		require.Equal(t, sk, restoredSK)
+	}
+
+	mdk, err := w.ExportMasterDerivationKey(pw)
+	require.NoError(t, err)
+	restoredMDK, err := restored.ExportMasterDerivationKey(newPW)
+	require.NoError(t, err)
+	require.Equal(t, mdk, restoredMDK)
+
+	version, threshold, restoredPKs, err := restored.LookupMultisigPreimage(msigAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), version)
+	require.Equal(t, uint8(2), threshold)
+	require.Equal(t, pks, restoredPKs)
+
+	// The derivation index carries over, so both wallets generate the same next key
+	next, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	restoredNext, err := restored.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, next, restoredNext)
+}
@@ -0,0 +1,620 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	pkcs11WalletDriverName    = "pkcs11"
+	pkcs11WalletDriverVersion = 1
+
+	// pkcs11KeyLabelPrefix prefixes the address in the label of key objects
+	// created by kmd
+	pkcs11KeyLabelPrefix = "algorand:"
+
+	// pkcs11MsigApplication is the CKA_APPLICATION of the data objects
+	// holding multisig preimages
+	pkcs11MsigApplication = "algorand-kmd-multisig"
+)
+
+var pkcs11WalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+// pkcs11Module is a loaded PKCS#11 library.
+type pkcs11Module interface {
+	// OpenToken opens a read-write session on the token with the given label.
+	OpenToken(label string) (pkcs11Token, error)
+	Close()
+}
+
+// pkcs11KeyHandle is the handle of a private key object in a token session.
+type pkcs11KeyHandle uint64
+
+// pkcs11Token is a session on a PKCS#11 token holding Ed25519 keys. Sessions
+// are not safe for concurrent use.
+type pkcs11Token interface {
+	// Login authenticates the session as the token's user.
+	Login(pin []byte) error
+
+	// PublicKeys lists the Ed25519 public keys stored on the token.
+	PublicKeys() ([]crypto.PublicKey, error)
+	// SigningKeys finds the private key of every Ed25519 key pair on the
+	// token in one pass. The session must be logged in.
+	SigningKeys() (map[crypto.PublicKey]pkcs11KeyHandle, error)
+	// GenerateKeyPair generates a non-extractable key pair inside the token.
+	GenerateKeyPair() (crypto.PublicKey, error)
+	// ImportKeyPair stores an existing key pair in the token, marking the
+	// private key as sensitive and non-extractable.
+	ImportKeyPair(seed crypto.Seed, pk crypto.PublicKey) error
+	DestroyKeyPair(pk crypto.PublicKey) error
+	Sign(key pkcs11KeyHandle, msg []byte) (crypto.Signature, error)
+
+	// Data returns the value of every data object of the application, keyed by label.
+	Data(application string) (map[string][]byte, error)
+	PutData(application string, label string, value []byte) error
+	DestroyData(application string, label string) error
+
+	Close()
+}
+
+// openPKCS11Module loads the PKCS#11 library at path
+var openPKCS11Module = loadPKCS11Module
+
+// PKCS11WalletDriver exposes the tokens of a PKCS#11 module, such as a
+// hardware security module, as wallets. Keys are generated and imported
+// inside the token and never leave it.
+type PKCS11WalletDriver struct {
+	mu      deadlock.Mutex
+	module  pkcs11Module
+	wallets map[string]*PKCS11Wallet
+	log     logging.Logger
+}
+
+// PKCS11Wallet is a wallet backed by a single PKCS#11 token. The lock
+// serializes access to the token session.
+type PKCS11Wallet struct {
+	mu    deadlock.Mutex
+	id    string
+	name  string
+	token pkcs11Token
+
+	loggedIn           bool
+	walletPasswordSalt [saltLen]byte
+	walletPasswordHash crypto.Digest
+
+	// signingKeys caches the private key handles of the token keys so that
+	// signing does not search the token. It is loaded at login and is nil
+	// whenever it has to be reloaded.
+	signingKeys map[crypto.PublicKey]pkcs11KeyHandle
+}
+
+type pkcs11MsigPreimage struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version   uint8              `codec:"v"`
+	Threshold uint8              `codec:"t"`
+	PKs       []crypto.PublicKey `codec:"pks"`
+}
+
+// InitWithConfig loads the configured PKCS#11 module and opens a session on
+// each of the configured tokens.
+func (hwd *PKCS11WalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	hwd.mu.Lock()
+	defer hwd.mu.Unlock()
+
+	hwd.log = log
+	hwd.wallets = make(map[string]*PKCS11Wallet)
+
+	pkcs11Cfg := cfg.DriverConfig.PKCS11WalletDriverConfig
+	if pkcs11Cfg.ModulePath == "" {
+		return nil
+	}
+
+	module, err := openPKCS11Module(pkcs11Cfg.ModulePath)
+	if err != nil {
+		return fmt.Errorf("could not load pkcs11 module %s: %w", pkcs11Cfg.ModulePath, err)
+	}
+	hwd.module = module
+
+	for _, tokenCfg := range pkcs11Cfg.Tokens {
+		token, err := module.OpenToken(tokenCfg.TokenLabel)
+		if err != nil {
+			return fmt.Errorf("could not open pkcs11 token %s: %w", tokenCfg.TokenLabel, err)
+		}
+		name := tokenCfg.WalletName
+		if name == "" {
+			name = tokenCfg.TokenLabel
+		}
+		id := pathToID(pkcs11Cfg.ModulePath + "/" + tokenCfg.TokenLabel)
+		if _, ok := hwd.wallets[id]; ok {
+			token.Close()
+			return errSameID
+		}
+		hwd.wallets[id] = &PKCS11Wallet{id: id, name: name, token: token}
+	}
+	return nil
+}
+
+// ListWalletMetadatas returns the wallets of all configured tokens
+func (hwd *PKCS11WalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	hwd.mu.Lock()
+	defer hwd.mu.Unlock()
+
+	for _, w := range hwd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		metadatas = append(metadatas, md)
+	}
+
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+	return metadatas, nil
+}
+
+// CreateWallet implements the Driver interface. Tokens are initialized with
+// the tools of the HSM vendor and then listed in the kmd configuration.
+func (hwd *PKCS11WalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	return errNotSupported
+}
+
+// RenameWallet implements the Driver interface.
+func (hwd *PKCS11WalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID
+func (hwd *PKCS11WalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	hwd.mu.Lock()
+	defer hwd.mu.Unlock()
+
+	w, ok := hwd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+	return w, nil
+}
+
+// Init logs into the token with the wallet password as the user PIN
+func (hw *PKCS11Wallet) Init(pw []byte) error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	return hw.loginLocked(pw)
+}
+
+// CheckPassword checks the password against the PIN the token was logged
+// into with, logging in if that has not happened yet
+func (hw *PKCS11Wallet) CheckPassword(pw []byte) error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	return hw.loginLocked(pw)
+}
+
+func (hw *PKCS11Wallet) loginLocked(pw []byte) error {
+	if hw.loggedIn {
+		pwhash := fastHashWithSalt(pw, hw.walletPasswordSalt[:])
+		if subtle.ConstantTimeCompare(pwhash[:], hw.walletPasswordHash[:]) == 1 {
+			return nil
+		}
+		return errDecrypt
+	}
+
+	err := hw.token.Login(pw)
+	if err != nil {
+		return err
+	}
+	err = fillRandomBytes(hw.walletPasswordSalt[:])
+	if err != nil {
+		return err
+	}
+	hw.walletPasswordHash = fastHashWithSalt(pw, hw.walletPasswordSalt[:])
+	hw.loggedIn = true
+	return hw.loadSigningKeysLocked()
+}
+
+func (hw *PKCS11Wallet) loadSigningKeysLocked() error {
+	keys, err := hw.token.SigningKeys()
+	if err != nil {
+		hw.signingKeys = nil
+		return err
+	}
+	hw.signingKeys = keys
+	return nil
+}
+
+// ExportMasterDerivationKey implements the Wallet interface. Token keys are
+// not derived from a master key.
+func (hw *PKCS11Wallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// Metadata implements the Wallet interface.
+func (hw *PKCS11Wallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(hw.id),
+		Name:                  []byte(hw.name),
+		DriverName:            pkcs11WalletDriverName,
+		DriverVersion:         pkcs11WalletDriverVersion,
+		SupportedTransactions: pkcs11WalletSupportedTxs,
+	}, nil
+}
+
+// ListKeys lists the addresses of the keys stored on the token
+func (hw *PKCS11Wallet) ListKeys() ([]crypto.Digest, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	// Private keys are only visible once logged in, so refresh the signing
+	// keys while listing if possible
+	if hw.loggedIn {
+		err := hw.loadSigningKeysLocked()
+		if err != nil {
+			return nil, err
+		}
+		addrs := make([]crypto.Digest, 0, len(hw.signingKeys))
+		for pk := range hw.signingKeys {
+			addrs = append(addrs, publicKeyToAddress(pk))
+		}
+		return addrs, nil
+	}
+
+	pks, err := hw.token.PublicKeys()
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]crypto.Digest, len(pks))
+	for i, pk := range pks {
+		addrs[i] = publicKeyToAddress(pk)
+	}
+	return addrs, nil
+}
+
+func (hw *PKCS11Wallet) hasKeyLocked(pk crypto.PublicKey) (bool, error) {
+	pks, err := hw.token.PublicKeys()
+	if err != nil {
+		return false, err
+	}
+	for _, candidate := range pks {
+		if candidate == pk {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ImportKey stores the key in the token as a non-extractable key
+func (hw *PKCS11Wallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	if err != nil {
+		return crypto.Digest{}, errSKToPK
+	}
+	seed, err := crypto.SecretKeyToSeed(sk)
+	if err != nil {
+		return crypto.Digest{}, errSKToSeed
+	}
+	pk := crypto.PublicKey(secrets.SignatureVerifier)
+
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	exists, err := hw.hasKeyLocked(pk)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	if exists {
+		return crypto.Digest{}, errKeyExists
+	}
+
+	hw.signingKeys = nil
+	err = hw.token.ImportKeyPair(seed, pk)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	return publicKeyToAddress(pk), nil
+}
+
+// ExportKey implements the Wallet interface. Keys never leave the token.
+func (hw *PKCS11Wallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey generates a key inside the token
+func (hw *PKCS11Wallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	if displayMnemonic {
+		return crypto.Digest{}, errNotSupported
+	}
+
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	hw.signingKeys = nil
+	pk, err := hw.token.GenerateKeyPair()
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	return publicKeyToAddress(pk), nil
+}
+
+// DeleteKey destroys the key pair in the token
+func (hw *PKCS11Wallet) DeleteKey(addr crypto.Digest, pw []byte) error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.loginLocked(pw)
+	if err != nil {
+		return err
+	}
+	hw.signingKeys = nil
+	return hw.token.DestroyKeyPair(crypto.PublicKey(addr))
+}
+
+func pkcs11KeyLabel(pk crypto.PublicKey) string {
+	return pkcs11KeyLabelPrefix + basics.Address(pk).String()
+}
+
+// ImportMultisigAddr stores the multisig preimage as a data object in the token
+func (hw *PKCS11Wallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	addr, err := crypto.MultisigAddrGen(version, threshold, pks)
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	preimage := pkcs11MsigPreimage{Version: version, Threshold: threshold, PKs: pks}
+	err = hw.token.PutData(pkcs11MsigApplication, basics.Address(addr).String(), msgpackEncode(preimage))
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	return addr, nil
+}
+
+// LookupMultisigPreimage returns the preimage of a multisig address stored in the token
+func (hw *PKCS11Wallet) LookupMultisigPreimage(addr crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+	return hw.lookupMultisigPreimageLocked(addr)
+}
+
+func (hw *PKCS11Wallet) lookupMultisigPreimageLocked(addr crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	data, err := hw.token.Data(pkcs11MsigApplication)
+	if err != nil {
+		return
+	}
+	blob, ok := data[basics.Address(addr).String()]
+	if !ok {
+		err = errMsigDataNotFound
+		return
+	}
+
+	var preimage pkcs11MsigPreimage
+	err = msgpackDecode(blob, &preimage)
+	if err != nil {
+		return
+	}
+
+	// Sanity check: make sure the preimage is correct
+	addr2, err := crypto.MultisigAddrGen(preimage.Version, preimage.Threshold, preimage.PKs)
+	if err != nil || addr2 != addr {
+		err = errTampering
+		return
+	}
+	return preimage.Version, preimage.Threshold, preimage.PKs, nil
+}
+
+// ListMultisigAddrs lists the multisig addresses whose preimages are stored in the token
+func (hw *PKCS11Wallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	data, err := hw.token.Data(pkcs11MsigApplication)
+	if err != nil {
+		return nil, err
+	}
+	for label := range data {
+		addr, err := basics.UnmarshalChecksumAddress(label)
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, crypto.Digest(addr))
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	return addrs, nil
+}
+
+// DeleteMultisigAddr removes the multisig preimage from the token
+func (hw *PKCS11Wallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.loginLocked(pw)
+	if err != nil {
+		return err
+	}
+	return hw.token.DestroyData(pkcs11MsigApplication, basics.Address(addr).String())
+}
+
+// signLocked signs msg with the token key pk. The token is only searched when
+// pk is missing from the cached signing keys, since it may have been added to
+// the token since they were loaded.
+func (hw *PKCS11Wallet) signLocked(pk crypto.PublicKey, msg crypto.Hashable) (crypto.Signature, error) {
+	key, ok := hw.signingKeys[pk]
+	if !ok {
+		err := hw.loadSigningKeysLocked()
+		if err != nil {
+			return crypto.Signature{}, err
+		}
+		key, ok = hw.signingKeys[pk]
+		if !ok {
+			return crypto.Signature{}, errKeyNotFound
+		}
+	}
+
+	sig, err := hw.token.Sign(key, crypto.HashRep(msg))
+	if err != nil {
+		// The handle may be stale, e.g. if the key was destroyed outside kmd
+		hw.signingKeys = nil
+	}
+	return sig, err
+}
+
+// SignTransaction signs the transaction with the token key pk, or with the
+// key of the sender if pk is zero
+func (hw *PKCS11Wallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.loginLocked(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Src())
+	}
+	sig, err := hw.signLocked(pk, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{Txn: tx, Sig: sig}
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram signs the program with the token key of src
+func (hw *PKCS11Wallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.loginLocked(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	progb := logic.Program(data)
+	sig, err := hw.signLocked(crypto.PublicKey(src), &progb)
+	if err != nil {
+		return nil, err
+	}
+	return sig[:], nil
+}
+
+// multisigSignLocked starts a multisig signature of msg from the multisig
+// address addr, or adds the signature of pk to partial
+func (hw *PKCS11Wallet) multisigSignLocked(msg crypto.Hashable, addr crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, signer crypto.Digest) (crypto.MultisigSig, error) {
+	var version, threshold uint8
+	var pks []crypto.PublicKey
+	var err error
+
+	fresh := partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0
+	if fresh {
+		// We weren't given a partial multisig, so look up the preimage in the token
+		version, threshold, pks, err = hw.lookupMultisigPreimageLocked(addr)
+		if err != nil {
+			return crypto.MultisigSig{}, err
+		}
+	} else {
+		// Check that the preimage matches either the sender or the signer
+		msigAddr, err := crypto.MultisigAddrGenWithSubsigs(partial.Version, partial.Threshold, partial.Subsigs)
+		if err != nil {
+			return crypto.MultisigSig{}, err
+		}
+		if msigAddr != addr && msigAddr != signer {
+			return crypto.MultisigSig{}, errMsigWrongAddr
+		}
+		version, threshold, pks = partial.Preimage()
+	}
+
+	// Check that the key is one of the ones in the preimage
+	idx := -1
+	for i := range pks {
+		if pks[i] == pk {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return crypto.MultisigSig{}, errMsigWrongKey
+	}
+
+	sig, err := hw.signLocked(pk, msg)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+
+	msig := crypto.MultisigSig{Version: version, Threshold: threshold, Subsigs: make([]crypto.MultisigSubsig, len(pks))}
+	for i := range pks {
+		msig.Subsigs[i].Key = pks[i]
+	}
+	msig.Subsigs[idx].Sig = sig
+	if fresh {
+		return msig, nil
+	}
+	return crypto.MultisigMerge(partial, msig)
+}
+
+// MultisigSignTransaction starts a multisig signature or adds a signature to a
+// partially signed multisig transaction signature of the passed transaction
+// using the token key pk
+func (hw *PKCS11Wallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.loginLocked(pw)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	return hw.multisigSignLocked(tx, crypto.Digest(tx.Src()), pk, partial, signer)
+}
+
+// MultisigSignProgram starts a multisig signature or adds a signature to a
+// partially signed multisig program signature using the token key pk
+func (hw *PKCS11Wallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	hw.mu.Lock()
+	defer hw.mu.Unlock()
+
+	err := hw.loginLocked(pw)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	progb := logic.Program(data)
+	return hw.multisigSignLocked(&progb, src, pk, partial, src)
+}
@@ -0,0 +1,726 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !windows
+
+package driver
+
+/*
+#cgo linux LDFLAGS: -ldl
+#include <dlfcn.h>
+#include <stdlib.h>
+#include <string.h>
+
+// The declarations below mirror the parts of the PKCS#11 (Cryptoki) headers
+// used by the driver, so that no vendor headers are needed at build time.
+
+typedef unsigned long ck_ulong;
+typedef unsigned char ck_byte;
+
+typedef struct { ck_byte major; ck_byte minor; } ck_version;
+typedef struct { ck_ulong type; void *value; ck_ulong value_len; } ck_attribute;
+typedef struct { ck_ulong mechanism; void *parameter; ck_ulong parameter_len; } ck_mechanism;
+typedef struct {
+	void *create_mutex;
+	void *destroy_mutex;
+	void *lock_mutex;
+	void *unlock_mutex;
+	ck_ulong flags;
+	void *reserved;
+} ck_c_initialize_args;
+
+// ck_function_list mirrors CK_FUNCTION_LIST: a version followed by the
+// Cryptoki entry points, in the order fixed by the standard.
+typedef struct { ck_version version; void *fn[68]; } ck_function_list;
+
+enum {
+	fnInitialize = 0,
+	fnFinalize = 1,
+	fnGetSlotList = 4,
+	fnGetTokenInfo = 6,
+	fnOpenSession = 12,
+	fnCloseSession = 13,
+	fnLogin = 18,
+	fnCreateObject = 20,
+	fnDestroyObject = 22,
+	fnGetAttributeValue = 24,
+	fnSetAttributeValue = 25,
+	fnFindObjectsInit = 26,
+	fnFindObjects = 27,
+	fnFindObjectsFinal = 28,
+	fnSignInit = 42,
+	fnSign = 43,
+	fnGenerateKeyPair = 59,
+};
+
+#define CKF_RW_SESSION 0x2UL
+#define CKF_SERIAL_SESSION 0x4UL
+#define CKF_OS_LOCKING_OK 0x2UL
+#define CKU_USER 1UL
+#define CKM_EC_EDWARDS_KEY_PAIR_GEN 0x1055UL
+#define CKM_EDDSA 0x1057UL
+
+typedef ck_ulong (*get_function_list_fn)(ck_function_list **);
+
+static ck_ulong p11_load(const char *path, void **handle, ck_function_list **fl) {
+	*handle = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+	if (*handle == NULL) {
+		return (ck_ulong)-1;
+	}
+	get_function_list_fn get = (get_function_list_fn)dlsym(*handle, "C_GetFunctionList");
+	if (get == NULL) {
+		dlclose(*handle);
+		*handle = NULL;
+		return (ck_ulong)-2;
+	}
+	ck_ulong rv = get(fl);
+	if (rv != 0) {
+		dlclose(*handle);
+		*handle = NULL;
+	}
+	return rv;
+}
+
+static ck_ulong p11_initialize(ck_function_list *fl) {
+	ck_c_initialize_args args;
+	memset(&args, 0, sizeof(args));
+	args.flags = CKF_OS_LOCKING_OK;
+	return ((ck_ulong (*)(void *))fl->fn[fnInitialize])(&args);
+}
+
+static void p11_unload(void *handle, ck_function_list *fl) {
+	((ck_ulong (*)(void *))fl->fn[fnFinalize])(NULL);
+	dlclose(handle);
+}
+
+static ck_ulong p11_get_slot_list(ck_function_list *fl, ck_ulong *slots, ck_ulong *count) {
+	return ((ck_ulong (*)(ck_byte, ck_ulong *, ck_ulong *))fl->fn[fnGetSlotList])(1, slots, count);
+}
+
+static ck_ulong p11_get_token_label(ck_function_list *fl, ck_ulong slot, char *label) {
+	// CK_TOKEN_INFO starts with the 32 byte label; the buffer is larger than the structure.
+	ck_ulong info[128];
+	ck_ulong rv = ((ck_ulong (*)(ck_ulong, void *))fl->fn[fnGetTokenInfo])(slot, info);
+	if (rv == 0) {
+		memcpy(label, info, 32);
+	}
+	return rv;
+}
+
+static ck_ulong p11_open_session(ck_function_list *fl, ck_ulong slot, ck_ulong *session) {
+	return ((ck_ulong (*)(ck_ulong, ck_ulong, void *, void *, ck_ulong *))fl->fn[fnOpenSession])(slot, CKF_SERIAL_SESSION | CKF_RW_SESSION, NULL, NULL, session);
+}
+
+static ck_ulong p11_close_session(ck_function_list *fl, ck_ulong session) {
+	return ((ck_ulong (*)(ck_ulong))fl->fn[fnCloseSession])(session);
+}
+
+static ck_ulong p11_login(ck_function_list *fl, ck_ulong session, ck_byte *pin, ck_ulong pin_len) {
+	return ((ck_ulong (*)(ck_ulong, ck_ulong, ck_byte *, ck_ulong))fl->fn[fnLogin])(session, CKU_USER, pin, pin_len);
+}
+
+static ck_ulong p11_create_object(ck_function_list *fl, ck_ulong session, ck_attribute *tmpl, ck_ulong n, ck_ulong *obj) {
+	return ((ck_ulong (*)(ck_ulong, ck_attribute *, ck_ulong, ck_ulong *))fl->fn[fnCreateObject])(session, tmpl, n, obj);
+}
+
+static ck_ulong p11_destroy_object(ck_function_list *fl, ck_ulong session, ck_ulong obj) {
+	return ((ck_ulong (*)(ck_ulong, ck_ulong))fl->fn[fnDestroyObject])(session, obj);
+}
+
+static ck_ulong p11_get_attribute_value(ck_function_list *fl, ck_ulong session, ck_ulong obj, ck_attribute *tmpl, ck_ulong n) {
+	return ((ck_ulong (*)(ck_ulong, ck_ulong, ck_attribute *, ck_ulong))fl->fn[fnGetAttributeValue])(session, obj, tmpl, n);
+}
+
+static ck_ulong p11_set_attribute_value(ck_function_list *fl, ck_ulong session, ck_ulong obj, ck_attribute *tmpl, ck_ulong n) {
+	return ((ck_ulong (*)(ck_ulong, ck_ulong, ck_attribute *, ck_ulong))fl->fn[fnSetAttributeValue])(session, obj, tmpl, n);
+}
+
+static ck_ulong p11_find_objects(ck_function_list *fl, ck_ulong session, ck_attribute *tmpl, ck_ulong n, ck_ulong *objs, ck_ulong max, ck_ulong *count) {
+	ck_ulong rv = ((ck_ulong (*)(ck_ulong, ck_attribute *, ck_ulong))fl->fn[fnFindObjectsInit])(session, tmpl, n);
+	if (rv != 0) {
+		return rv;
+	}
+	rv = ((ck_ulong (*)(ck_ulong, ck_ulong *, ck_ulong, ck_ulong *))fl->fn[fnFindObjects])(session, objs, max, count);
+	ck_ulong rv2 = ((ck_ulong (*)(ck_ulong))fl->fn[fnFindObjectsFinal])(session);
+	return rv != 0 ? rv : rv2;
+}
+
+static ck_ulong p11_sign(ck_function_list *fl, ck_ulong session, ck_ulong key, ck_byte *data, ck_ulong data_len, ck_byte *sig, ck_ulong *sig_len) {
+	ck_mechanism mech = { CKM_EDDSA, NULL, 0 };
+	ck_ulong rv = ((ck_ulong (*)(ck_ulong, ck_mechanism *, ck_ulong))fl->fn[fnSignInit])(session, &mech, key);
+	if (rv != 0) {
+		return rv;
+	}
+	return ((ck_ulong (*)(ck_ulong, ck_byte *, ck_ulong, ck_byte *, ck_ulong *))fl->fn[fnSign])(session, data, data_len, sig, sig_len);
+}
+
+static ck_ulong p11_generate_key_pair(ck_function_list *fl, ck_ulong session, ck_attribute *pub, ck_ulong npub, ck_attribute *priv, ck_ulong npriv, ck_ulong *pubkey, ck_ulong *privkey) {
+	ck_mechanism mech = { CKM_EC_EDWARDS_KEY_PAIR_GEN, NULL, 0 };
+	return ((ck_ulong (*)(ck_ulong, ck_mechanism *, ck_attribute *, ck_ulong, ck_attribute *, ck_ulong, ck_ulong *, ck_ulong *))fl->fn[fnGenerateKeyPair])(session, &mech, pub, npub, priv, npriv, pubkey, privkey);
+}
+*/
+import "C"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+const (
+	ckrOK                         = 0x0
+	ckrPinIncorrect               = 0xA0
+	ckrUserAlreadyLoggedIn        = 0x100
+	ckrCryptokiAlreadyInitialized = 0x191
+
+	ckoData       = 0x0
+	ckoPublicKey  = 0x2
+	ckoPrivateKey = 0x3
+
+	ckaClass       = 0x0
+	ckaToken       = 0x1
+	ckaPrivate     = 0x2
+	ckaLabel       = 0x3
+	ckaApplication = 0x10
+	ckaValue       = 0x11
+	ckaKeyType     = 0x100
+	ckaID          = 0x102
+	ckaSensitive   = 0x103
+	ckaSign        = 0x108
+	ckaVerify      = 0x10A
+	ckaExtractable = 0x162
+	ckaECParams    = 0x180
+	ckaECPoint     = 0x181
+
+	ckkECEdwards = 0x40
+
+	// pkcs11FindBatch is the initial number of handles fetched by a search
+	pkcs11FindBatch = 64
+)
+
+// pkcs11Ed25519Params is the DER encoding of the id-Ed25519 object identifier (RFC 8410)
+var pkcs11Ed25519Params = []byte{0x06, 0x03, 0x2b, 0x65, 0x70}
+
+var pkcs11True = []byte{1}
+var pkcs11False = []byte{0}
+
+// pkcs11Error is a Cryptoki return value other than CKR_OK
+type pkcs11Error C.ck_ulong
+
+func (e pkcs11Error) Error() string {
+	return fmt.Sprintf("pkcs11 error 0x%x", uint64(e))
+}
+
+func pkcs11Check(rv C.ck_ulong) error {
+	if rv == ckrOK {
+		return nil
+	}
+	return pkcs11Error(rv)
+}
+
+func pkcs11Ulong(v uint64) []byte {
+	b := make([]byte, unsafe.Sizeof(C.ck_ulong(0)))
+	if len(b) == 4 {
+		binary.NativeEndian.PutUint32(b, uint32(v))
+	} else {
+		binary.NativeEndian.PutUint64(b, v)
+	}
+	return b
+}
+
+type pkcs11Attribute struct {
+	typ   uint64
+	value []byte
+}
+
+// pkcs11Template is an attribute template allocated in C memory
+type pkcs11Template struct {
+	attrs *C.ck_attribute
+	n     C.ck_ulong
+}
+
+func makePKCS11Template(attrs ...pkcs11Attribute) pkcs11Template {
+	if len(attrs) == 0 {
+		return pkcs11Template{}
+	}
+	t := pkcs11Template{
+		attrs: (*C.ck_attribute)(C.calloc(C.size_t(len(attrs)), C.size_t(unsafe.Sizeof(C.ck_attribute{})))),
+		n:     C.ck_ulong(len(attrs)),
+	}
+	cattrs := unsafe.Slice(t.attrs, len(attrs))
+	for i := range attrs {
+		cattrs[i]._type = C.ck_ulong(attrs[i].typ)
+		if len(attrs[i].value) > 0 {
+			cattrs[i].value = C.CBytes(attrs[i].value)
+			cattrs[i].value_len = C.ck_ulong(len(attrs[i].value))
+		}
+	}
+	return t
+}
+
+func (t pkcs11Template) free() {
+	if t.attrs == nil {
+		return
+	}
+	for _, attr := range unsafe.Slice(t.attrs, int(t.n)) {
+		C.free(attr.value)
+	}
+	C.free(unsafe.Pointer(t.attrs))
+}
+
+type cgoPKCS11Module struct {
+	handle unsafe.Pointer
+	fl     *C.ck_function_list
+}
+
+type cgoPKCS11Token struct {
+	fl      *C.ck_function_list
+	session C.ck_ulong
+}
+
+func loadPKCS11Module(path string) (pkcs11Module, error) {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+
+	var m cgoPKCS11Module
+	rv := C.p11_load(cpath, &m.handle, &m.fl)
+	if m.handle == nil {
+		switch rv {
+		case ^C.ck_ulong(0):
+			return nil, fmt.Errorf("%s", C.GoString(C.dlerror()))
+		case ^C.ck_ulong(1):
+			return nil, fmt.Errorf("%s does not export C_GetFunctionList", path)
+		}
+		return nil, pkcs11Check(rv)
+	}
+
+	rv = C.p11_initialize(m.fl)
+	if rv != ckrOK && rv != ckrCryptokiAlreadyInitialized {
+		C.dlclose(m.handle)
+		return nil, pkcs11Check(rv)
+	}
+	return &m, nil
+}
+
+func (m *cgoPKCS11Module) Close() {
+	C.p11_unload(m.handle, m.fl)
+}
+
+// OpenToken finds the slot holding the token labeled label and opens a session on it
+func (m *cgoPKCS11Module) OpenToken(label string) (pkcs11Token, error) {
+	var count C.ck_ulong
+	err := pkcs11Check(C.p11_get_slot_list(m.fl, nil, &count))
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("no tokens present")
+	}
+	slots := make([]C.ck_ulong, count)
+	err = pkcs11Check(C.p11_get_slot_list(m.fl, &slots[0], &count))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slot := range slots[:count] {
+		var raw [32]C.char
+		err = pkcs11Check(C.p11_get_token_label(m.fl, slot, &raw[0]))
+		if err != nil {
+			continue
+		}
+		// Token labels are padded with blanks
+		tokenLabel := string(bytes.TrimRight(C.GoBytes(unsafe.Pointer(&raw[0]), 32), " \x00"))
+		if tokenLabel != label {
+			continue
+		}
+
+		var session C.ck_ulong
+		err = pkcs11Check(C.p11_open_session(m.fl, slot, &session))
+		if err != nil {
+			return nil, err
+		}
+		return &cgoPKCS11Token{fl: m.fl, session: session}, nil
+	}
+	return nil, fmt.Errorf("no token labeled %s", label)
+}
+
+func (t *cgoPKCS11Token) Close() {
+	C.p11_close_session(t.fl, t.session)
+}
+
+func (t *cgoPKCS11Token) Login(pin []byte) error {
+	cpin := C.CBytes(pin)
+	defer C.free(cpin)
+	rv := C.p11_login(t.fl, t.session, (*C.ck_byte)(cpin), C.ck_ulong(len(pin)))
+	switch rv {
+	case ckrOK, ckrUserAlreadyLoggedIn:
+		return nil
+	case ckrPinIncorrect:
+		return errDecrypt
+	}
+	return pkcs11Check(rv)
+}
+
+func (t *cgoPKCS11Token) find(attrs ...pkcs11Attribute) ([]C.ck_ulong, error) {
+	tmpl := makePKCS11Template(attrs...)
+	defer tmpl.free()
+
+	// Each search runs to completion in a single C_FindObjects call; the
+	// buffer grows until it holds every match.
+	for max := pkcs11FindBatch; ; max *= 2 {
+		var count C.ck_ulong
+		objs := make([]C.ck_ulong, max)
+		err := pkcs11Check(C.p11_find_objects(t.fl, t.session, tmpl.attrs, tmpl.n, &objs[0], C.ck_ulong(max), &count))
+		if err != nil {
+			return nil, err
+		}
+		if int(count) < max {
+			return objs[:count], nil
+		}
+	}
+}
+
+func (t *cgoPKCS11Token) attribute(obj C.ck_ulong, typ uint64) ([]byte, error) {
+	tmpl := makePKCS11Template(pkcs11Attribute{typ: typ})
+	defer tmpl.free()
+
+	err := pkcs11Check(C.p11_get_attribute_value(t.fl, t.session, obj, tmpl.attrs, tmpl.n))
+	if err != nil {
+		return nil, err
+	}
+	size := tmpl.attrs.value_len
+	if size == ^C.ck_ulong(0) {
+		return nil, fmt.Errorf("attribute 0x%x unavailable", typ)
+	}
+	if size == 0 {
+		return nil, nil
+	}
+	tmpl.attrs.value = C.calloc(1, C.size_t(size))
+	err = pkcs11Check(C.p11_get_attribute_value(t.fl, t.session, obj, tmpl.attrs, tmpl.n))
+	if err != nil {
+		return nil, err
+	}
+	return C.GoBytes(tmpl.attrs.value, C.int(tmpl.attrs.value_len)), nil
+}
+
+func (t *cgoPKCS11Token) setAttributes(obj C.ck_ulong, attrs ...pkcs11Attribute) error {
+	tmpl := makePKCS11Template(attrs...)
+	defer tmpl.free()
+	return pkcs11Check(C.p11_set_attribute_value(t.fl, t.session, obj, tmpl.attrs, tmpl.n))
+}
+
+func (t *cgoPKCS11Token) create(attrs ...pkcs11Attribute) (C.ck_ulong, error) {
+	tmpl := makePKCS11Template(attrs...)
+	defer tmpl.free()
+	var obj C.ck_ulong
+	err := pkcs11Check(C.p11_create_object(t.fl, t.session, tmpl.attrs, tmpl.n, &obj))
+	return obj, err
+}
+
+func (t *cgoPKCS11Token) destroy(obj C.ck_ulong) error {
+	return pkcs11Check(C.p11_destroy_object(t.fl, t.session, obj))
+}
+
+// pkcs11DecodePoint extracts an Ed25519 public key from CKA_EC_POINT, which
+// holds either a DER octet string or the raw key
+func pkcs11DecodePoint(point []byte) (pk crypto.PublicKey, ok bool) {
+	if len(point) == len(pk)+2 && point[0] == 0x04 && int(point[1]) == len(pk) {
+		point = point[2:]
+	}
+	if len(point) != len(pk) {
+		return pk, false
+	}
+	copy(pk[:], point)
+	return pk, true
+}
+
+func pkcs11EncodePoint(pk crypto.PublicKey) []byte {
+	return append([]byte{0x04, byte(len(pk))}, pk[:]...)
+}
+
+func (t *cgoPKCS11Token) publicKeyObjects() (map[crypto.PublicKey]C.ck_ulong, error) {
+	objs, err := t.find(
+		pkcs11Attribute{ckaClass, pkcs11Ulong(ckoPublicKey)},
+		pkcs11Attribute{ckaKeyType, pkcs11Ulong(ckkECEdwards)},
+	)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[crypto.PublicKey]C.ck_ulong, len(objs))
+	for _, obj := range objs {
+		point, err := t.attribute(obj, ckaECPoint)
+		if err != nil {
+			return nil, err
+		}
+		if pk, ok := pkcs11DecodePoint(point); ok {
+			out[pk] = obj
+		}
+	}
+	return out, nil
+}
+
+// keyPair finds the objects of the key pair of pk. The private key is the one
+// sharing the CKA_ID of the public key.
+func (t *cgoPKCS11Token) keyPair(pk crypto.PublicKey) (pub C.ck_ulong, priv C.ck_ulong, err error) {
+	pubs, err := t.publicKeyObjects()
+	if err != nil {
+		return
+	}
+	pub, ok := pubs[pk]
+	if !ok {
+		err = errKeyNotFound
+		return
+	}
+	id, err := t.attribute(pub, ckaID)
+	if err != nil {
+		return
+	}
+	privs, err := t.find(
+		pkcs11Attribute{ckaClass, pkcs11Ulong(ckoPrivateKey)},
+		pkcs11Attribute{ckaID, id},
+	)
+	if err != nil {
+		return
+	}
+	if len(privs) != 1 {
+		err = errKeyNotFound
+		return
+	}
+	return pub, privs[0], nil
+}
+
+func (t *cgoPKCS11Token) PublicKeys() ([]crypto.PublicKey, error) {
+	pubs, err := t.publicKeyObjects()
+	if err != nil {
+		return nil, err
+	}
+	pks := make([]crypto.PublicKey, 0, len(pubs))
+	for pk := range pubs {
+		pks = append(pks, pk)
+	}
+	return pks, nil
+}
+
+// SigningKeys pairs each public key with the private key sharing its CKA_ID
+func (t *cgoPKCS11Token) SigningKeys() (map[crypto.PublicKey]pkcs11KeyHandle, error) {
+	pubs, err := t.publicKeyObjects()
+	if err != nil {
+		return nil, err
+	}
+	privs, err := t.find(
+		pkcs11Attribute{ckaClass, pkcs11Ulong(ckoPrivateKey)},
+		pkcs11Attribute{ckaKeyType, pkcs11Ulong(ckkECEdwards)},
+	)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]C.ck_ulong, len(privs))
+	for _, priv := range privs {
+		id, err := t.attribute(priv, ckaID)
+		if err != nil {
+			return nil, err
+		}
+		byID[string(id)] = priv
+	}
+
+	out := make(map[crypto.PublicKey]pkcs11KeyHandle, len(pubs))
+	for pk, pub := range pubs {
+		id, err := t.attribute(pub, ckaID)
+		if err != nil {
+			return nil, err
+		}
+		if priv, ok := byID[string(id)]; ok {
+			out[pk] = pkcs11KeyHandle(priv)
+		}
+	}
+	return out, nil
+}
+
+func (t *cgoPKCS11Token) GenerateKeyPair() (crypto.PublicKey, error) {
+	pubTmpl := makePKCS11Template(
+		pkcs11Attribute{ckaToken, pkcs11True},
+		pkcs11Attribute{ckaPrivate, pkcs11False},
+		pkcs11Attribute{ckaVerify, pkcs11True},
+		pkcs11Attribute{ckaECParams, pkcs11Ed25519Params},
+	)
+	defer pubTmpl.free()
+	privTmpl := makePKCS11Template(
+		pkcs11Attribute{ckaToken, pkcs11True},
+		pkcs11Attribute{ckaPrivate, pkcs11True},
+		pkcs11Attribute{ckaSensitive, pkcs11True},
+		pkcs11Attribute{ckaExtractable, pkcs11False},
+		pkcs11Attribute{ckaSign, pkcs11True},
+	)
+	defer privTmpl.free()
+
+	var pub, priv C.ck_ulong
+	err := pkcs11Check(C.p11_generate_key_pair(t.fl, t.session, pubTmpl.attrs, pubTmpl.n, privTmpl.attrs, privTmpl.n, &pub, &priv))
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+
+	point, err := t.attribute(pub, ckaECPoint)
+	if err == nil {
+		pk, ok := pkcs11DecodePoint(point)
+		if !ok {
+			err = fmt.Errorf("token returned a malformed public key")
+		} else {
+			// Tie the pair together by the public key so it can be found again
+			label := pkcs11Attribute{ckaLabel, []byte(pkcs11KeyLabel(pk))}
+			id := pkcs11Attribute{ckaID, pk[:]}
+			err = t.setAttributes(pub, label, id)
+			if err == nil {
+				err = t.setAttributes(priv, label, id)
+			}
+			if err == nil {
+				return pk, nil
+			}
+		}
+	}
+	t.destroy(pub)
+	t.destroy(priv)
+	return crypto.PublicKey{}, err
+}
+
+func (t *cgoPKCS11Token) ImportKeyPair(seed crypto.Seed, pk crypto.PublicKey) error {
+	label := []byte(pkcs11KeyLabel(pk))
+	priv, err := t.create(
+		pkcs11Attribute{ckaClass, pkcs11Ulong(ckoPrivateKey)},
+		pkcs11Attribute{ckaKeyType, pkcs11Ulong(ckkECEdwards)},
+		pkcs11Attribute{ckaToken, pkcs11True},
+		pkcs11Attribute{ckaPrivate, pkcs11True},
+		pkcs11Attribute{ckaSensitive, pkcs11True},
+		pkcs11Attribute{ckaExtractable, pkcs11False},
+		pkcs11Attribute{ckaSign, pkcs11True},
+		pkcs11Attribute{ckaLabel, label},
+		pkcs11Attribute{ckaID, pk[:]},
+		pkcs11Attribute{ckaECParams, pkcs11Ed25519Params},
+		pkcs11Attribute{ckaValue, seed[:]},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = t.create(
+		pkcs11Attribute{ckaClass, pkcs11Ulong(ckoPublicKey)},
+		pkcs11Attribute{ckaKeyType, pkcs11Ulong(ckkECEdwards)},
+		pkcs11Attribute{ckaToken, pkcs11True},
+		pkcs11Attribute{ckaPrivate, pkcs11False},
+		pkcs11Attribute{ckaVerify, pkcs11True},
+		pkcs11Attribute{ckaLabel, label},
+		pkcs11Attribute{ckaID, pk[:]},
+		pkcs11Attribute{ckaECParams, pkcs11Ed25519Params},
+		pkcs11Attribute{ckaECPoint, pkcs11EncodePoint(pk)},
+	)
+	if err != nil {
+		t.destroy(priv)
+	}
+	return err
+}
+
+func (t *cgoPKCS11Token) DestroyKeyPair(pk crypto.PublicKey) error {
+	pub, priv, err := t.keyPair(pk)
+	if err != nil {
+		return err
+	}
+	err = t.destroy(priv)
+	if err != nil {
+		return err
+	}
+	return t.destroy(pub)
+}
+
+func (t *cgoPKCS11Token) Sign(key pkcs11KeyHandle, msg []byte) (sig crypto.Signature, err error) {
+	cmsg := C.CBytes(msg)
+	defer C.free(cmsg)
+	csig := (*C.ck_byte)(C.calloc(1, C.size_t(len(sig))))
+	defer C.free(unsafe.Pointer(csig))
+	sigLen := C.ck_ulong(len(sig))
+
+	err = pkcs11Check(C.p11_sign(t.fl, t.session, C.ck_ulong(key), (*C.ck_byte)(cmsg), C.ck_ulong(len(msg)), csig, &sigLen))
+	if err != nil {
+		return
+	}
+	if int(sigLen) != len(sig) {
+		err = fmt.Errorf("token returned a %d byte signature", sigLen)
+		return
+	}
+	copy(sig[:], C.GoBytes(unsafe.Pointer(csig), C.int(sigLen)))
+	return
+}
+
+func (t *cgoPKCS11Token) dataObjects(application string, label string) ([]C.ck_ulong, error) {
+	attrs := []pkcs11Attribute{
+		{ckaClass, pkcs11Ulong(ckoData)},
+		{ckaApplication, []byte(application)},
+	}
+	if label != "" {
+		attrs = append(attrs, pkcs11Attribute{ckaLabel, []byte(label)})
+	}
+	return t.find(attrs...)
+}
+
+func (t *cgoPKCS11Token) Data(application string) (map[string][]byte, error) {
+	objs, err := t.dataObjects(application, "")
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string][]byte, len(objs))
+	for _, obj := range objs {
+		label, err := t.attribute(obj, ckaLabel)
+		if err != nil {
+			return nil, err
+		}
+		value, err := t.attribute(obj, ckaValue)
+		if err != nil {
+			return nil, err
+		}
+		out[string(label)] = value
+	}
+	return out, nil
+}
+
+func (t *cgoPKCS11Token) PutData(application string, label string, value []byte) error {
+	err := t.DestroyData(application, label)
+	if err != nil {
+		return err
+	}
+	_, err = t.create(
+		pkcs11Attribute{ckaClass, pkcs11Ulong(ckoData)},
+		pkcs11Attribute{ckaToken, pkcs11True},
+		pkcs11Attribute{ckaPrivate, pkcs11True},
+		pkcs11Attribute{ckaApplication, []byte(application)},
+		pkcs11Attribute{ckaLabel, []byte(label)},
+		pkcs11Attribute{ckaValue, value},
+	)
+	return err
+}
+
+func (t *cgoPKCS11Token) DestroyData(application string, label string) error {
+	objs, err := t.dataObjects(application, label)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		err = t.destroy(obj)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
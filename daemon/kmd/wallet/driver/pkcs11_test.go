@@ -0,0 +1,354 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// fakePKCS11Token is an in-memory token
+type fakePKCS11Token struct {
+	pin  string
+	keys map[crypto.PublicKey]*crypto.SignatureSecrets
+	data map[string]map[string][]byte
+
+	// handles of the private keys, and the number of key searches
+	handles    map[pkcs11KeyHandle]crypto.PublicKey
+	nextHandle pkcs11KeyHandle
+	searches   int
+}
+
+func (t *fakePKCS11Token) storeKey(secrets *crypto.SignatureSecrets) {
+	t.keys[secrets.SignatureVerifier] = secrets
+	t.nextHandle++
+	t.handles[t.nextHandle] = secrets.SignatureVerifier
+}
+
+func (t *fakePKCS11Token) Login(pin []byte) error {
+	if string(pin) != t.pin {
+		return errDecrypt
+	}
+	return nil
+}
+
+func (t *fakePKCS11Token) PublicKeys() (pks []crypto.PublicKey, err error) {
+	t.searches++
+	for pk := range t.keys {
+		pks = append(pks, pk)
+	}
+	return
+}
+
+func (t *fakePKCS11Token) SigningKeys() (map[crypto.PublicKey]pkcs11KeyHandle, error) {
+	t.searches++
+	keys := make(map[crypto.PublicKey]pkcs11KeyHandle, len(t.handles))
+	for handle, pk := range t.handles {
+		keys[pk] = handle
+	}
+	return keys, nil
+}
+
+func (t *fakePKCS11Token) GenerateKeyPair() (crypto.PublicKey, error) {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	t.storeKey(secrets)
+	return secrets.SignatureVerifier, nil
+}
+
+func (t *fakePKCS11Token) ImportKeyPair(seed crypto.Seed, pk crypto.PublicKey) error {
+	t.storeKey(crypto.GenerateSignatureSecrets(seed))
+	return nil
+}
+
+func (t *fakePKCS11Token) DestroyKeyPair(pk crypto.PublicKey) error {
+	if _, ok := t.keys[pk]; !ok {
+		return errKeyNotFound
+	}
+	delete(t.keys, pk)
+	for handle, candidate := range t.handles {
+		if candidate == pk {
+			delete(t.handles, handle)
+		}
+	}
+	return nil
+}
+
+func (t *fakePKCS11Token) Sign(key pkcs11KeyHandle, msg []byte) (crypto.Signature, error) {
+	pk, ok := t.handles[key]
+	if !ok {
+		return crypto.Signature{}, errKeyNotFound
+	}
+	return t.keys[pk].SignBytes(msg), nil
+}
+
+func (t *fakePKCS11Token) Data(application string) (map[string][]byte, error) {
+	return t.data[application], nil
+}
+
+func (t *fakePKCS11Token) PutData(application string, label string, value []byte) error {
+	if t.data[application] == nil {
+		t.data[application] = make(map[string][]byte)
+	}
+	t.data[application][label] = value
+	return nil
+}
+
+func (t *fakePKCS11Token) DestroyData(application string, label string) error {
+	delete(t.data[application], label)
+	return nil
+}
+
+func (t *fakePKCS11Token) Close() {}
+
+type fakePKCS11Module map[string]*fakePKCS11Token
+
+func (m fakePKCS11Module) OpenToken(label string) (pkcs11Token, error) {
+	token, ok := m[label]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+	return token, nil
+}
+
+func (m fakePKCS11Module) Close() {}
+
+func pkcs11TestConfig(modulePath string, labels ...string) config.KMDConfig {
+	var cfg config.KMDConfig
+	cfg.DriverConfig.PKCS11WalletDriverConfig.ModulePath = modulePath
+	for _, label := range labels {
+		cfg.DriverConfig.PKCS11WalletDriverConfig.Tokens = append(cfg.DriverConfig.PKCS11WalletDriverConfig.Tokens, config.PKCS11TokenConfig{TokenLabel: label})
+	}
+	return cfg
+}
+
+func fakePKCS11Wallet(t *testing.T) *PKCS11Wallet {
+	module := fakePKCS11Module{"kmd": &fakePKCS11Token{
+		pin:     "1234",
+		keys:    make(map[crypto.PublicKey]*crypto.SignatureSecrets),
+		data:    make(map[string]map[string][]byte),
+		handles: make(map[pkcs11KeyHandle]crypto.PublicKey),
+	}}
+	prevOpen := openPKCS11Module
+	openPKCS11Module = func(path string) (pkcs11Module, error) { return module, nil }
+	t.Cleanup(func() { openPKCS11Module = prevOpen })
+
+	var hwd PKCS11WalletDriver
+	cfg := pkcs11TestConfig("/usr/lib/fake-pkcs11.so", "kmd")
+	require.NoError(t, cfg.Validate())
+	require.NoError(t, hwd.InitWithConfig(cfg, logging.TestingLog(t)))
+
+	mds, err := hwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, mds, 1)
+	require.Equal(t, "kmd", string(mds[0].Name))
+	require.Equal(t, pkcs11WalletDriverName, mds[0].DriverName)
+
+	w, err := hwd.FetchWallet(mds[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, errNotSupported, hwd.CreateWallet([]byte("new"), []byte("id"), nil, crypto.MasterDerivationKey{}))
+	return w.(*PKCS11Wallet)
+}
+
+func TestPKCS11WalletConfigValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.NoError(t, pkcs11TestConfig("").Validate())
+	require.Equal(t, config.ErrPKCS11ModuleNotAbsolute, pkcs11TestConfig("libsofthsm2.so", "kmd").Validate())
+	require.Equal(t, config.ErrPKCS11NoTokens, pkcs11TestConfig("/usr/lib/libsofthsm2.so").Validate())
+	require.Equal(t, config.ErrPKCS11NoTokenLabel, pkcs11TestConfig("/usr/lib/libsofthsm2.so", "").Validate())
+}
+
+func TestPKCS11WalletPassword(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	w := fakePKCS11Wallet(t)
+	require.Equal(t, errDecrypt, w.Init([]byte("wrong")))
+	require.NoError(t, w.Init([]byte("1234")))
+	require.NoError(t, w.CheckPassword([]byte("1234")))
+	require.Equal(t, errDecrypt, w.CheckPassword([]byte("wrong")))
+
+	_, err := w.ExportMasterDerivationKey([]byte("1234"))
+	require.Equal(t, errNotSupported, err)
+}
+
+func testPKCS11WalletKeys(t *testing.T, w *PKCS11Wallet, pw []byte) {
+	generated, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	_, err = w.GenerateKey(true)
+	require.Equal(t, errNotSupported, err)
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	sk := crypto.PrivateKey(secrets.SK)
+	imported, err := w.ImportKey(sk)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Digest(secrets.SignatureVerifier), imported)
+	_, err = w.ImportKey(sk)
+	require.Equal(t, errKeyExists, err)
+
+	keys, err := w.ListKeys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []crypto.Digest{generated, imported}, keys)
+
+	_, err = w.ExportKey(imported, pw)
+	require.Equal(t, errNotSupported, err)
+
+	// Sign with both keys and verify outside the token
+	for _, addr := range keys {
+		tx := transactions.Transaction{
+			Type:             protocol.PaymentTx,
+			Header:           transactions.Header{Sender: basics.Address(addr), Fee: basics.MicroAlgos{Raw: 1000}},
+			PaymentTxnFields: transactions.PaymentTxnFields{Amount: basics.MicroAlgos{Raw: 1}},
+		}
+		_, err = w.SignTransaction(tx, crypto.PublicKey{}, []byte("wrong"))
+		require.Equal(t, errDecrypt, err)
+		stxnBytes, err := w.SignTransaction(tx, crypto.PublicKey{}, pw)
+		require.NoError(t, err)
+		var stxn transactions.SignedTxn
+		require.NoError(t, protocol.Decode(stxnBytes, &stxn))
+		require.True(t, crypto.SignatureVerifier(addr).Verify(tx, stxn.Sig))
+		require.True(t, stxn.AuthAddr.IsZero())
+	}
+
+	// Multisig
+	msigAddr, err := w.ImportMultisigAddr(1, 2, []crypto.PublicKey{crypto.PublicKey(generated), crypto.PublicKey(imported)})
+	require.NoError(t, err)
+	msigs, err := w.ListMultisigAddrs()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{msigAddr}, msigs)
+
+	tx := transactions.Transaction{Type: protocol.PaymentTx, Header: transactions.Header{Sender: basics.Address(msigAddr)}}
+	msig, err := w.MultisigSignTransaction(tx, crypto.PublicKey(generated), crypto.MultisigSig{}, pw, crypto.Digest{})
+	require.NoError(t, err)
+	msig, err = w.MultisigSignTransaction(tx, crypto.PublicKey(imported), msig, pw, crypto.Digest{})
+	require.NoError(t, err)
+	require.NoError(t, crypto.MultisigVerify(tx, msigAddr, msig))
+
+	require.NoError(t, w.DeleteMultisigAddr(msigAddr, pw))
+	_, _, _, err = w.LookupMultisigPreimage(msigAddr)
+	require.Equal(t, errMsigDataNotFound, err)
+
+	require.NoError(t, w.DeleteKey(generated, pw))
+	require.NoError(t, w.DeleteKey(imported, pw))
+	keys, err = w.ListKeys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
+func TestPKCS11WalletKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	w := fakePKCS11Wallet(t)
+	require.NoError(t, w.Init([]byte("1234")))
+	testPKCS11WalletKeys(t, w, []byte("1234"))
+}
+
+func TestPKCS11WalletSigningKeyCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	w := fakePKCS11Wallet(t)
+	token := w.token.(*fakePKCS11Token)
+	pw := []byte("1234")
+	require.NoError(t, w.Init(pw))
+
+	addr, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	tx := transactions.Transaction{Type: protocol.PaymentTx, Header: transactions.Header{Sender: basics.Address(addr)}}
+
+	// Only the first signature after the key was added searches the token
+	searches := token.searches
+	for i := 0; i < 10; i++ {
+		_, err = w.SignTransaction(tx, crypto.PublicKey{}, pw)
+		require.NoError(t, err)
+	}
+	require.Equal(t, searches+1, token.searches)
+
+	// Listing the keys refreshes the cache
+	_, err = w.ListKeys()
+	require.NoError(t, err)
+	searches = token.searches
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, pw)
+	require.NoError(t, err)
+	require.Equal(t, searches, token.searches)
+
+	// Keys destroyed through the wallet are dropped from the cache
+	require.NoError(t, w.DeleteKey(addr, pw))
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, pw)
+	require.Equal(t, errKeyNotFound, err)
+
+	// Keys destroyed outside kmd leave a stale handle that is dropped on failure
+	addr, err = w.GenerateKey(false)
+	require.NoError(t, err)
+	tx.Sender = basics.Address(addr)
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, pw)
+	require.NoError(t, err)
+	require.NoError(t, token.DestroyKeyPair(crypto.PublicKey(addr)))
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, pw)
+	require.Equal(t, errKeyNotFound, err)
+	require.Nil(t, w.signingKeys)
+}
+
+// TestPKCS11WalletSoftHSM runs against a SoftHSM token, e.g.
+//
+//	softhsm2-util --init-token --free --label kmd --pin 1234 --so-pin 1234
+//	KMD_PKCS11_TEST_MODULE=/usr/lib/softhsm/libsofthsm2.so KMD_PKCS11_TEST_TOKEN=kmd KMD_PKCS11_TEST_PIN=1234 go test -run SoftHSM
+func TestPKCS11WalletSoftHSM(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	modulePath := os.Getenv("KMD_PKCS11_TEST_MODULE")
+	if modulePath == "" {
+		t.Skip("KMD_PKCS11_TEST_MODULE is not set")
+	}
+	modulePath, err := filepath.Abs(modulePath)
+	require.NoError(t, err)
+	label := os.Getenv("KMD_PKCS11_TEST_TOKEN")
+	pin := []byte(os.Getenv("KMD_PKCS11_TEST_PIN"))
+
+	var hwd PKCS11WalletDriver
+	require.NoError(t, hwd.InitWithConfig(pkcs11TestConfig(modulePath, label), logging.TestingLog(t)))
+	defer hwd.module.Close()
+
+	mds, err := hwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, mds, 1)
+	w, err := hwd.FetchWallet(mds[0].ID)
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pin))
+
+	// The test deletes every key it lists, so refuse to run on a token in use
+	hw := w.(*PKCS11Wallet)
+	keys, err := hw.ListKeys()
+	require.NoError(t, err)
+	if len(keys) > 0 {
+		t.Skip("token is not empty")
+	}
+	testPKCS11WalletKeys(t, hw, pin)
+}
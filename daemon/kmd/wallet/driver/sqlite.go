@@ -35,6 +35,7 @@ package driver
 import (
 	"bytes"
 	"crypto/subtle"
+	"database/sql"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -602,6 +603,31 @@ func (swd *SQLiteWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte
 	return nil
 }
 
+// restoreWallet creates a wallet from a backup, re-encrypting its keys under
+// a fresh master encryption key protected by pw
+func (swd *SQLiteWalletDriver) restoreWallet(backup walletBackup, name []byte, pw []byte) error {
+	err := swd.CreateWallet(name, backup.ID, pw, backup.MasterDerivationKey)
+	if err != nil {
+		return err
+	}
+
+	w, err := swd.FetchWallet(backup.ID)
+	if err != nil {
+		return err
+	}
+	sw, ok := w.(*SQLiteWallet)
+	if !ok {
+		return errSQLiteWrongType
+	}
+
+	err = sw.restoreContents(backup, pw)
+	if err != nil {
+		// Don't leave a partially restored wallet behind
+		os.Remove(sw.dbPath)
+	}
+	return err
+}
+
 // Metadata builds a wallet.Metadata from our metadata table
 func (sw *SQLiteWallet) Metadata() (meta wallet.Metadata, err error) {
 	// Connect to the database
@@ -1124,6 +1150,161 @@ func (sw *SQLiteWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
 	return
 }
 
+// restoreContents inserts the keys and multisig preimages of a backup into
+// the freshly created wallet
+func (sw *SQLiteWallet) restoreContents(backup walletBackup, pw []byte) error {
+	err := sw.Init(pw)
+	if err != nil {
+		return err
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer tx.Rollback()
+
+	for _, key := range backup.Keys {
+		pk, err := crypto.SecretKeyToPublicKey(key.SK)
+		if err != nil {
+			return errSKToPK
+		}
+		skEncrypted, err := encryptBlobWithKey(msgpackEncode(key.SK), PTSecretKey, sw.masterEncryptionKey)
+		if err != nil {
+			return err
+		}
+
+		// Imported keys have no derivation index
+		var keyIdx sql.NullInt64
+		if key.KeyIdx != 0 {
+			keyIdx = sql.NullInt64{Int64: int64(key.KeyIdx), Valid: true}
+		}
+		addr := publicKeyToAddress(pk)
+		_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", addr[:], skEncrypted, keyIdx)
+		err = checkDBError(err)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, msig := range backup.Multisigs {
+		addr, err := crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO msig_addrs (address, version, threshold, pks) VALUES (?, ?, ?, ?)", addr[:], msig.Version, msig.Threshold, msgpackEncode(msig.PKs))
+		err = checkDBError(err)
+		if err != nil {
+			return err
+		}
+	}
+
+	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(backup.MaxKeyIdx), PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("UPDATE metadata SET max_key_idx_encrypted = ?", encryptedIdxBlob)
+	if err != nil {
+		return errDatabase
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// backup decrypts the whole contents of the wallet so that they can be
+// written to a wallet backup
+func (sw *SQLiteWallet) backup(pw []byte) (backup walletBackup, scryptParams config.ScryptParams, err error) {
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	metadata, err := sw.Metadata()
+	if err != nil {
+		return
+	}
+	backup.DriverName = metadata.DriverName
+	backup.ID = metadata.ID
+	backup.Name = metadata.Name
+	copy(backup.MasterDerivationKey[:], sw.masterDerivationKey)
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var encryptedHighestIndexBlob []byte
+	err = db.Get(&encryptedHighestIndexBlob, "SELECT max_key_idx_encrypted FROM metadata LIMIT 1")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	highestIndexBlob, err := decryptBlobWithPassword(encryptedHighestIndexBlob, PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+	err = msgpackDecode(highestIndexBlob, &backup.MaxKeyIdx)
+	if err != nil {
+		return
+	}
+
+	var keyRows []struct {
+		Address []byte        `db:"address"`
+		KeyIdx  sql.NullInt64 `db:"key_idx"`
+	}
+	err = db.Select(&keyRows, "SELECT address, key_idx FROM keys")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	for _, row := range keyRows {
+		var addr crypto.Digest
+		copy(addr[:], row.Address)
+
+		// fetchSecretKey checks that the key matches its address
+		var sk crypto.PrivateKey
+		sk, err = sw.fetchSecretKey(addr)
+		if err != nil {
+			return
+		}
+		key := walletBackupKey{SK: sk}
+		if row.KeyIdx.Valid {
+			key.KeyIdx = uint64(row.KeyIdx.Int64)
+		}
+		backup.Keys = append(backup.Keys, key)
+	}
+
+	msigAddrs, err := sw.ListMultisigAddrs()
+	if err != nil {
+		return
+	}
+	for _, addr := range msigAddrs {
+		msig := walletBackupMultisig{}
+		msig.Version, msig.Threshold, msig.PKs, err = sw.LookupMultisigPreimage(addr)
+		if err != nil {
+			return
+		}
+		backup.Multisigs = append(backup.Multisigs, msig)
+	}
+
+	return backup, sw.cfg.ScryptParams, nil
+}
+
 // SignTransaction signs the passed transaction with the private key whose public key is provided, or
 // if the provided public key is zero, inferring the required private key from the transaction itself
 func (sw *SQLiteWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) (stx []byte, err error) {
@@ -67,6 +67,8 @@ var (
 	PTMasterDerivationKey plaintextType = "master_derivation_key"
 	// PTMaxKeyIdx is the plaintext type for the maximum key index
 	PTMaxKeyIdx plaintextType = "max_key_idx"
+	// PTWalletBackup is the plaintext type for a full wallet backup
+	PTWalletBackup plaintextType = "wallet_backup"
 )
 
 // typedPlaintext prevents us from confusing differently typed data encrypted
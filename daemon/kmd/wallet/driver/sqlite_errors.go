@@ -61,3 +61,6 @@ var errNameTooLong = fmt.Errorf("wallet name too long, must be <= %d bytes", sql
 var errIDTooLong = fmt.Errorf("wallet id too long, must be <= %d bytes", sqliteMaxWalletIDLen)
 var errMsigWrongAddr = fmt.Errorf("given multisig preimage hashes to neither Sender nor AuthAddr")
 var errMsigWrongKey = fmt.Errorf("given key is not a possible signer for this multisig")
+var errBackupNoPassword = fmt.Errorf("wallet backups must be protected by a password")
+var errBackupFormat = fmt.Errorf("not a kmd wallet backup, or backup format not supported")
+var errBackupDriver = fmt.Errorf("wallet driver does not support backups")
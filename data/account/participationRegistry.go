@@ -109,6 +109,10 @@ type (
 	// one specific round. In Addition, it also returns the participation metadata
 	ParticipationRecordForRound struct {
 		ParticipationRecord
+
+		// Signer is set when the secrets are held by a ParticipationSigner
+		// rather than by the node.
+		Signer ParticipationSigner
 	}
 
 	// StateProofSecretsForRound contains participant's state proof secrets that corresponds to
@@ -118,6 +122,10 @@ type (
 		ParticipationRecord
 
 		StateProofSecrets *merklesignature.Signer
+
+		// Signer is set when the secrets are held by a ParticipationSigner
+		// rather than by the node.
+		Signer ParticipationSigner
 	}
 
 	// SortUint64 implements sorting by uint64 keys for
@@ -127,7 +135,7 @@ type (
 
 // IsZero returns true if the object contains zero values.
 func (r ParticipationRecordForRound) IsZero() bool {
-	return r.ParticipationRecord.IsZero()
+	return r.ParticipationRecord.IsZero() && r.Signer == nil
 }
 
 // VotingSigner returns the voting secrets associated with this Participation account,
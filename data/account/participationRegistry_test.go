@@ -313,7 +313,7 @@ func TestParticipation_DeleteExpired(t *testing.T) {
 		proto := config.Consensus[protocol.ConsensusCurrentVersion]
 		for _, p := range getAll {
 			// like in loadRoundParticipationKeys
-			prfr := ParticipationRecordForRound{p}
+			prfr := ParticipationRecordForRound{ParticipationRecord: p}
 			voting := prfr.VotingSigner()
 
 			// count remaining batches (with keyDilution = 1)
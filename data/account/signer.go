@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrNoSecrets is returned when a participation record holds neither local
+// secrets nor a ParticipationSigner able to produce the requested signature.
+var ErrNoSecrets = errors.New("participation record has no secrets for signing")
+
+// SigningMessage is a message in its pre-hashed form, as handed to a
+// ParticipationSigner. It allows signers running out of process to receive
+// the exact bytes which would be signed locally.
+//
+//msgp:ignore SigningMessage
+type SigningMessage struct {
+	HashID protocol.HashID
+	Data   []byte
+}
+
+// MakeSigningMessage captures the representation of a Hashable object.
+func MakeSigningMessage(obj crypto.Hashable) SigningMessage {
+	hashID, data := obj.ToBeHashed()
+	return SigningMessage{HashID: hashID, Data: data}
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (m SigningMessage) ToBeHashed() (protocol.HashID, []byte) {
+	return m.HashID, m.Data
+}
+
+// ParticipationSigner produces signatures with participation keys whose secrets
+// are not held by the node itself.
+//
+// Implementations are expected to refuse producing two different votes for the
+// same round, period and step with the same participation key.
+type ParticipationSigner interface {
+	// SignOneTime signs the vote msg using the one-time signature key for
+	// otsID. proto is the consensus version of the round being voted on,
+	// which sets the key dilution of keys registered without one.
+	SignOneTime(id ParticipationID, otsID crypto.OneTimeSignatureIdentifier, proto protocol.ConsensusVersion, msg SigningMessage) (crypto.OneTimeSignature, error)
+
+	// SignHeartbeat signs the seed msg of a heartbeat transaction whose last
+	// valid round is lastValid, using the one-time signature key for otsID.
+	SignHeartbeat(id ParticipationID, otsID crypto.OneTimeSignatureIdentifier, lastValid basics.Round, msg SigningMessage) (crypto.OneTimeSignature, error)
+
+	// ProveVRF computes a VRF proof of msg using the selection key.
+	ProveVRF(id ParticipationID, msg SigningMessage) (crypto.VrfProof, error)
+
+	// SignStateProof signs a state proof message using the state proof key
+	// for the given round.
+	SignStateProof(id ParticipationID, round basics.Round, msg []byte) (merklesignature.Signature, error)
+}
+
+// RemoteSigner is a ParticipationSigner which also advertises the keys it holds.
+type RemoteSigner interface {
+	ParticipationSigner
+
+	// Keys returns the participation keys held by the signer. The returned
+	// records only contain the public parts of the keys.
+	Keys() []ParticipationRecord
+}
+
+// SignOneTime signs the vote msg for a round of consensus version proto with
+// the voting key of the participation record, delegating to the record's
+// Signer if it has one.
+func (r *ParticipationRecordForRound) SignOneTime(id crypto.OneTimeSignatureIdentifier, proto protocol.ConsensusVersion, msg crypto.Hashable) (crypto.OneTimeSignature, error) {
+	if r.Signer != nil {
+		return r.Signer.SignOneTime(r.ParticipationID, id, proto, MakeSigningMessage(msg))
+	}
+	if r.Voting == nil {
+		return crypto.OneTimeSignature{}, ErrNoSecrets
+	}
+	return r.Voting.Sign(id, msg), nil
+}
+
+// SignHeartbeat signs the seed of a heartbeat transaction valid until
+// lastValid with the voting key of the participation record, delegating to
+// the record's Signer if it has one.
+func (r *ParticipationRecordForRound) SignHeartbeat(id crypto.OneTimeSignatureIdentifier, lastValid basics.Round, seed crypto.Hashable) (crypto.OneTimeSignature, error) {
+	if r.Signer != nil {
+		return r.Signer.SignHeartbeat(r.ParticipationID, id, lastValid, MakeSigningMessage(seed))
+	}
+	if r.Voting == nil {
+		return crypto.OneTimeSignature{}, ErrNoSecrets
+	}
+	return r.Voting.Sign(id, seed), nil
+}
+
+// ProveVRF computes a VRF proof of msg with the selection key of the participation
+// record, delegating to the record's Signer if it has one.
+func (r *ParticipationRecordForRound) ProveVRF(msg crypto.Hashable) (crypto.VrfProof, error) {
+	if r.Signer != nil {
+		return r.Signer.ProveVRF(r.ParticipationID, MakeSigningMessage(msg))
+	}
+	if r.VRF == nil {
+		return crypto.VrfProof{}, ErrNoSecrets
+	}
+	proof, ok := r.VRF.SK.Prove(msg)
+	if !ok {
+		return crypto.VrfProof{}, fmt.Errorf("could not compute VRF proof for participation %s", r.ParticipationID)
+	}
+	return proof, nil
+}
+
+// SignBytes signs a state proof message for the given round, delegating to the
+// record's Signer if it has one.
+func (r *StateProofSecretsForRound) SignBytes(round basics.Round, msg []byte) (merklesignature.Signature, error) {
+	if r.Signer != nil {
+		return r.Signer.SignStateProof(r.ParticipationID, round, msg)
+	}
+	if r.StateProofSecrets == nil {
+		return merklesignature.Signature{}, ErrNoSecrets
+	}
+	return r.StateProofSecrets.SignBytes(msg)
+}
@@ -59,6 +59,10 @@ type AccountManager struct {
 
 	registry account.ParticipationRegistry
 	log      logging.Logger
+
+	// remote holds participation keys whose secrets live outside of the node.
+	// syncronized by mu
+	remote account.RemoteSigner
 }
 
 // DeleteStateProofKey deletes keys related to a ParticipationID. The function removes
@@ -78,6 +82,26 @@ func MakeAccountManager(log logging.Logger, registry account.ParticipationRegist
 	return manager
 }
 
+// SetRemoteSigner installs a signer holding participation keys outside of the node.
+// Keys held by the signer are returned alongside the registry keys, with their
+// Signer field set.
+func (manager *AccountManager) SetRemoteSigner(signer account.RemoteSigner) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	manager.remote = signer
+}
+
+func (manager *AccountManager) remoteKeys() (account.RemoteSigner, []account.ParticipationRecord) {
+	manager.mu.Lock()
+	remote := manager.remote
+	manager.mu.Unlock()
+
+	if remote == nil {
+		return nil, nil
+	}
+	return remote, remote.Keys()
+}
+
 // Keys returns a list of Participation accounts, and their keys/secrets for requested round.
 func (manager *AccountManager) Keys(rnd basics.Round) (out []account.ParticipationRecordForRound) {
 	for _, part := range manager.registry.GetAll() {
@@ -90,6 +114,13 @@ func (manager *AccountManager) Keys(rnd basics.Round) (out []account.Participati
 			out = append(out, partRndSecrets)
 		}
 	}
+
+	remote, keys := manager.remoteKeys()
+	for _, part := range keys {
+		if part.OverlapsInterval(rnd, rnd) {
+			out = append(out, account.ParticipationRecordForRound{ParticipationRecord: part, Signer: remote})
+		}
+	}
 	return out
 }
 
@@ -105,12 +136,26 @@ func (manager *AccountManager) StateProofKeys(rnd basics.Round) (out []account.S
 			out = append(out, partRndSecrets)
 		}
 	}
+
+	remote, keys := manager.remoteKeys()
+	for _, part := range keys {
+		if part.StateProof != nil && part.OverlapsInterval(rnd, rnd) {
+			out = append(out, account.StateProofSecretsForRound{ParticipationRecord: part, Signer: remote})
+		}
+	}
 	return out
 }
 
 // HasLiveKeys returns true if we have any Participation
 // keys valid for the specified round range (inclusive)
 func (manager *AccountManager) HasLiveKeys(from, to basics.Round) bool {
+	_, keys := manager.remoteKeys()
+	for _, part := range keys {
+		if part.OverlapsInterval(from, to) {
+			return true
+		}
+	}
+
 	manager.mu.Lock()
 	defer manager.mu.Unlock()
 
@@ -162,7 +162,11 @@ func (s *Service) loop() {
 			if suppress[pr.Account] > latest {
 				continue
 			}
-			stxn := s.prepareHeartbeat(pr, lastHdr)
+			stxn, err := s.prepareHeartbeat(pr, lastHdr)
+			if err != nil {
+				s.log.Errorf("could not prepare heartbeat for %v: %v", pr.Account, err)
+				continue
+			}
 			s.log.Infof("sending heartbeat %v for %v\n", stxn.Txn.HeartbeatTxnFields, pr.Account)
 			err = s.bcast.BroadcastInternalSignedTxGroup([]transactions.SignedTxn{stxn})
 			if err != nil {
@@ -188,7 +192,7 @@ var acceptingSender = basics.Address(logic.HashProgram(acceptingByteCode))
 // grace period than to try a single time with a longer lifetime.
 const hbLifetime = 10
 
-func (s *Service) prepareHeartbeat(pr account.ParticipationRecordForRound, latest bookkeeping.BlockHeader) transactions.SignedTxn {
+func (s *Service) prepareHeartbeat(pr account.ParticipationRecordForRound, latest bookkeeping.BlockHeader) (transactions.SignedTxn, error) {
 	var stxn transactions.SignedTxn
 	stxn.Lsig = transactions.LogicSig{Logic: acceptingByteCode}
 	stxn.Txn.Type = protocol.HeartbeatTx
@@ -200,13 +204,17 @@ func (s *Service) prepareHeartbeat(pr account.ParticipationRecordForRound, lates
 	}
 
 	id := basics.OneTimeIDForRound(stxn.Txn.LastValid, pr.KeyDilution)
+	sig, err := pr.SignHeartbeat(id, stxn.Txn.LastValid, latest.Seed)
+	if err != nil {
+		return transactions.SignedTxn{}, err
+	}
 	stxn.Txn.HeartbeatTxnFields = &transactions.HeartbeatTxnFields{
 		HbAddress:     pr.Account,
-		HbProof:       pr.Voting.Sign(id, latest.Seed).ToHeartbeatProof(),
+		HbProof:       sig.ToHeartbeatProof(),
 		HbSeed:        latest.Seed,
 		HbVoteID:      pr.Voting.OneTimeSignatureVerifier,
 		HbKeyDilution: pr.KeyDilution,
 	}
 
-	return stxn
+	return stxn, nil
 }
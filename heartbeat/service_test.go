@@ -34,6 +34,8 @@ package heartbeat
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -46,8 +48,10 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/partsigner"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
 	"github.com/algorand/go-deadlock"
 	"github.com/stretchr/testify/require"
 )
@@ -314,3 +318,56 @@ func TestHeartbeatOnlyWhenChallenged(t *testing.T) {
 
 	s.Stop()
 }
+
+func TestHeartbeatRemoteSigner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	a := require.New(t)
+	log := logging.TestingLog(t)
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	addr := basics.Address(crypto.GenerateSignatureSecrets(seed).SignatureVerifier)
+	store, err := db.MakeAccessor(t.Name()+"_part", false, true)
+	a.NoError(err)
+	defer store.Close()
+	part, err := account.FillDBWithParticipationKeys(store, addr, 0, 1000, 10)
+	a.NoError(err)
+
+	signer, err := partsigner.MakeSoftSigner("", log)
+	a.NoError(err)
+	defer signer.Close()
+	signer.AddParticipation(part)
+
+	// unix socket paths are limited in length, so avoid t.TempDir()
+	dir, err := os.MkdirTemp("", "heartbeat")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "signer.sock")
+	server := partsigner.MakeServer(signer, log)
+	a.NoError(server.Start(socket))
+	defer server.Stop()
+
+	client := partsigner.MakeClient(socket, log)
+	keys := client.Keys()
+	a.Len(keys, 1)
+	pr := account.ParticipationRecordForRound{ParticipationRecord: keys[0], Signer: client}
+
+	ledger := newMockedLedger(t)
+	s := NewService(&mockedAcctManager{}, &ledger, &txnSink{t: t}, log)
+	latest := bookkeeping.BlockHeader{Round: 100, Seed: committee.Seed{0xc8}}
+	stxn, err := s.prepareHeartbeat(pr, latest)
+	a.NoError(err)
+
+	hb := stxn.Txn.HeartbeatTxnFields
+	a.Equal(addr, hb.HbAddress)
+	a.Equal(part.Voting.OneTimeSignatureVerifier, hb.HbVoteID)
+	id := basics.OneTimeIDForRound(stxn.Txn.LastValid, hb.HbKeyDilution)
+	a.True(hb.HbVoteID.Verify(id, hb.HbSeed, hb.HbProof.ToOneTimeSignature()))
+
+	// a different seed for the same last valid round is refused
+	latest.Seed = committee.Seed{0xc9}
+	_, err = s.prepareHeartbeat(pr, latest)
+	a.ErrorIs(err, partsigner.ErrDoubleSign)
+}
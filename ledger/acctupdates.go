@@ -361,6 +361,75 @@ func (au *accountUpdates) LookupAssetResources(addr basics.Address, assetIDGT ba
 	return au.lookupAssetResources(addr, assetIDGT, limit)
 }
 
+// LookupCreatedAssetResources returns, in asset ID order, up to limit assets created by addr with an ID above assetIDGT,
+// along with the round the data tier was at when they were read.
+func (au *accountUpdates) LookupCreatedAssetResources(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64) ([]ledgercore.AssetResourceWithIDs, basics.Round, error) {
+	persistedResources, dbRound, err := au.lookupOrderedResources(addr, basics.AssetCreatable, basics.CreatableIndex(assetIDGT), true, limit)
+	if err != nil {
+		return nil, basics.Round(0), err
+	}
+	data := make([]ledgercore.AssetResourceWithIDs, 0, len(persistedResources))
+	for _, pd := range persistedResources {
+		ap := pd.Data.GetAssetParams()
+		arwi := ledgercore.AssetResourceWithIDs{
+			AssetID: basics.AssetIndex(pd.Aidx),
+			Creator: addr,
+
+			AssetResource: ledgercore.AssetResource{
+				AssetParams: &ap,
+			},
+		}
+		if pd.Data.IsHolding() {
+			ah := pd.Data.GetAssetHolding()
+			arwi.AssetHolding = &ah
+		}
+		data = append(data, arwi)
+	}
+	return data, dbRound, nil
+}
+
+// LookupAppResources returns, in application ID order, up to limit applications with an ID above appIDGT that
+// addr created, if created is set, or else is opted in to, along with the round the data tier was at when they were read.
+func (au *accountUpdates) LookupAppResources(addr basics.Address, appIDGT basics.AppIndex, created bool, limit uint64) ([]ledgercore.AppResourceWithIDs, basics.Round, error) {
+	persistedResources, dbRound, err := au.lookupOrderedResources(addr, basics.AppCreatable, basics.CreatableIndex(appIDGT), created, limit)
+	if err != nil {
+		return nil, basics.Round(0), err
+	}
+	data := make([]ledgercore.AppResourceWithIDs, 0, len(persistedResources))
+	for _, pd := range persistedResources {
+		arwi := ledgercore.AppResourceWithIDs{AppID: basics.AppIndex(pd.Aidx)}
+		if pd.Data.IsOwning() {
+			ap := pd.Data.GetAppParams()
+			arwi.AppParams = &ap
+			arwi.Creator = addr
+		}
+		if pd.Data.IsHolding() {
+			als := pd.Data.GetAppLocalState()
+			arwi.AppLocalState = &als
+		}
+		data = append(data, arwi)
+	}
+	return data, dbRound, nil
+}
+
+// lookupOrderedResources reads the ordered resources of addr from the data tier, along with the round
+// the data tier was at, within a single snapshot.
+func (au *accountUpdates) lookupOrderedResources(addr basics.Address, ctype basics.CreatableType, minIdx basics.CreatableIndex, created bool, limit uint64) (data []trackerdb.PersistedResourcesData, dbRound basics.Round, err error) {
+	err = au.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		ar, err0 := tx.MakeAccountsReader()
+		if err0 != nil {
+			return err0
+		}
+		dbRound, err0 = ar.AccountsRound()
+		if err0 != nil {
+			return err0
+		}
+		data, err0 = ar.LookupOrderedResources(addr, ctype, minIdx, created, limit)
+		return err0
+	})
+	return data, dbRound, err
+}
+
 func (au *accountUpdates) LookupKv(rnd basics.Round, key string) ([]byte, error) {
 	return au.lookupKv(rnd, key, true /* take lock */)
 }
@@ -601,6 +670,139 @@ func (au *accountUpdates) lookupKeysByPrefix(round basics.Round, keyPrefix strin
 	}
 }
 
+// KvPair is a key of the kvstore with its value.
+//
+//msgp:ignore KvPair
+type KvPair struct {
+	Key   string
+	Value []byte
+}
+
+// LookupKvsByPrefix returns, in key order, up to limit kvstore entries at round whose keys start with keyPrefix and
+// sort strictly after the key after. If limit is 0, all of them are returned, and if withValues is false, only the
+// keys are returned.
+func (au *accountUpdates) LookupKvsByPrefix(round basics.Round, keyPrefix string, after string, limit uint64, withValues bool) ([]KvPair, error) {
+	au.accountsMu.RLock()
+	needUnlock := true
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+
+	for {
+		currentDBRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		offset, err := au.roundOffset(round)
+		if err != nil {
+			return nil, err
+		}
+
+		// the latest modification of each matching key in the deltas up to round, nil when it was deleted
+		mods := make(map[string][]byte)
+		for offset > 0 {
+			offset--
+			for key, mv := range au.deltas[offset].KvMods {
+				if !strings.HasPrefix(key, keyPrefix) || key <= after {
+					continue
+				}
+				if _, ok := mods[key]; !ok {
+					mods[key] = mv.Data
+				}
+			}
+		}
+		au.accountsMu.RUnlock()
+		needUnlock = false
+
+		var results []KvPair
+		var dbRound basics.Round
+		err = au.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+			ar, err0 := tx.MakeAccountsReader()
+			if err0 != nil {
+				return err0
+			}
+			dbRound, err0 = ar.AccountsRound()
+			if err0 != nil {
+				return err0
+			}
+			var start []byte
+			if after != "" {
+				start = []byte(after)
+			}
+			iter, err0 := tx.MakeKVsPrefixIter(ctx, []byte(keyPrefix), start)
+			if err0 != nil {
+				return err0
+			}
+			defer iter.Close()
+			results, err0 = mergeKvs(iter, mods, limit, withValues)
+			return err0
+		})
+		if err != nil {
+			return nil, err
+		}
+		if dbRound == currentDBRound {
+			return results, nil
+		}
+		if dbRound < currentDBRound {
+			au.log.Errorf("accountUpdates.LookupKvsByPrefix: database round %d is behind in-memory round %d", dbRound, currentDBRound)
+			return nil, &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDBRound}
+		}
+		// the DB advanced while the deltas were read, wait for the in-memory state to catch up and start over
+		au.accountsMu.RLock()
+		needUnlock = true
+		for currentDBRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
+// mergeKvs merges the kvstore entries read by iter with the in-memory modifications in mods, which take precedence,
+// returning up to limit entries in key order.
+func mergeKvs(iter trackerdb.KVsIter, mods map[string][]byte, limit uint64, withValues bool) ([]KvPair, error) {
+	memKeys := make([]string, 0, len(mods))
+	for key, value := range mods {
+		if value != nil {
+			memKeys = append(memKeys, key)
+		}
+	}
+	sort.Strings(memKeys)
+
+	results := []KvPair{}
+	full := func() bool {
+		return limit != 0 && uint64(len(results)) >= limit
+	}
+	add := func(key string, value []byte) {
+		if !withValues {
+			value = nil
+		}
+		results = append(results, KvPair{Key: key, Value: value})
+	}
+
+	for !full() && iter.Next() {
+		k, v, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		key := string(k)
+		if _, ok := mods[key]; ok {
+			continue
+		}
+		for len(memKeys) > 0 && memKeys[0] < key && !full() {
+			add(memKeys[0], mods[memKeys[0]])
+			memKeys = memKeys[1:]
+		}
+		if full() {
+			break
+		}
+		add(key, v)
+	}
+	for len(memKeys) > 0 && !full() {
+		add(memKeys[0], mods[memKeys[0]])
+		memKeys = memKeys[1:]
+	}
+	return results, nil
+}
+
 // LookupWithoutRewards returns the account data for a given address at a given round.
 func (au *accountUpdates) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (data ledgercore.AccountData, validThrough basics.Round, err error) {
 	data, validThrough, _, _, err = au.lookupWithoutRewards(rnd, addr, true /* take lock*/)
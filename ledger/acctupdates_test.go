@@ -1070,6 +1070,96 @@ func TestBoxNamesByAppIDs(t *testing.T) {
 	}
 }
 
+func TestLookupKvsByPrefix(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	accts := make(map[basics.Address]basics.AccountData)
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	ml := makeMockLedgerForTracker(t, true, 1, protocol.ConsensusCurrentVersion,
+		[]map[basics.Address]basics.AccountData{accts},
+	)
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	au, _ := newAcctUpdates(t, ml, conf)
+
+	knownCreatables := make(map[basics.CreatableIndex]bool)
+	opts := auNewBlockOpts{ledgercore.AccountDeltas{}, protocol.ConsensusCurrentVersion, protoParams, knownCreatables}
+
+	const appID = 1000
+	prefix := apps.MakeBoxKey(appID, "")
+
+	// one box per round, in reverse order, so that the older boxes are flushed to the DB and the newer ones stay
+	// in memory
+	var currentRound basics.Round
+	for i := 10; i >= 1; i-- {
+		currentRound++
+		auNewBlock(t, currentRound, au, accts, opts, map[string]ledgercore.KvValueDelta{
+			apps.MakeBoxKey(appID, fmt.Sprintf("box%02d", i)): {Data: []byte("v1")},
+		})
+		auCommitSync(t, currentRound, au, ml)
+	}
+	currentRound++
+	auNewBlock(t, currentRound, au, accts, opts, map[string]ledgercore.KvValueDelta{
+		apps.MakeBoxKey(appID, "box03"):   {},
+		apps.MakeBoxKey(appID, "box07"):   {Data: []byte("v2")},
+		apps.MakeBoxKey(appID, "b"):       {Data: []byte("v1")},
+		apps.MakeBoxKey(appID+1, "box01"): {Data: []byte("v1")},
+	})
+	auCommitSync(t, currentRound, au, ml)
+	require.Greater(t, au.cachedDBRound, basics.Round(0))
+	require.Less(t, au.cachedDBRound, basics.Round(8))
+
+	names := func(kvs []KvPair) (names []string) {
+		for _, kv := range kvs {
+			require.True(t, strings.HasPrefix(kv.Key, prefix))
+			names = append(names, kv.Key[len(prefix):])
+		}
+		return names
+	}
+
+	all, err := au.LookupKvsByPrefix(currentRound, prefix, "", 0, true)
+	require.NoError(t, err)
+	expected := []string{"b", "box01", "box02", "box04", "box05", "box06", "box07", "box08", "box09", "box10"}
+	require.Equal(t, expected, names(all))
+	for _, kv := range all {
+		if kv.Key == apps.MakeBoxKey(appID, "box07") {
+			require.Equal(t, []byte("v2"), kv.Value)
+		} else {
+			require.Equal(t, []byte("v1"), kv.Value)
+		}
+	}
+
+	// paging through the boxes gives the same results
+	var paged []string
+	after := ""
+	for {
+		page, err := au.LookupKvsByPrefix(currentRound, prefix, after, 3, false)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page), 3)
+		for _, kv := range page {
+			require.Nil(t, kv.Value)
+		}
+		paged = append(paged, names(page)...)
+		if len(page) < 3 {
+			break
+		}
+		after = page[len(page)-1].Key
+	}
+	require.Equal(t, expected, paged)
+
+	// narrower prefix
+	boxes, err := au.LookupKvsByPrefix(currentRound, apps.MakeBoxKey(appID, "box0"), "", 0, false)
+	require.NoError(t, err)
+	require.Equal(t, expected[1:9], names(boxes))
+
+	// an earlier round does not see the last changes
+	boxes, err = au.LookupKvsByPrefix(currentRound-1, prefix, apps.MakeBoxKey(appID, "box02"), 2, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"box03", "box04"}, names(boxes))
+}
+
 func TestKVCache(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
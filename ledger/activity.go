@@ -0,0 +1,458 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// activity index key kinds
+const (
+	activityKindAccount byte = 'a'
+	activityKindApp     byte = 'p'
+	activityKindAsset   byte = 's'
+)
+
+// activityBackfillBatch is the number of rounds indexed per database transaction when the activity
+// index catches up with the blocks added while it was disabled.
+const activityBackfillBatch = 1000
+
+var errActivityIndexDisabled = errors.New("the transaction activity index is not enabled")
+
+var activitySchema = []string{
+	`CREATE TABLE IF NOT EXISTS activitymeta (
+		name TEXT PRIMARY KEY,
+		value INTEGER)`,
+	// intra is the offset of the transaction in the payset of round rnd
+	`CREATE TABLE IF NOT EXISTS activity (
+		key BLOB NOT NULL,
+		rnd INTEGER NOT NULL,
+		intra INTEGER NOT NULL,
+		PRIMARY KEY (key, rnd, intra)) WITHOUT ROWID`,
+}
+
+// TxnLocation locates a transaction by the round it was confirmed in and its offset in the payset of the block.
+type TxnLocation struct {
+	Round  basics.Round
+	Offset uint64
+}
+
+// Less reports whether the transaction at loc was confirmed before the one at other.
+func (loc TxnLocation) Less(other TxnLocation) bool {
+	return loc.Round < other.Round || (loc.Round == other.Round && loc.Offset < other.Offset)
+}
+
+// ActivityTxn is a transaction listed by the transaction activity index.
+type ActivityTxn struct {
+	TxnLocation
+	Txn transactions.SignedTxnWithAD
+}
+
+// activityRecord notes that the transaction at offset intra of a round touched key.
+type activityRecord struct {
+	key   string
+	intra uint64
+}
+
+// activityTracker maintains the transaction activity index of an archival ledger: for every round added to the
+// ledger, it records which accounts, applications and assets each transaction of the block touched, including
+// through its inner transactions. The index only stores the location of the transactions, which are read back
+// from the block database on lookups.
+//
+// The rounds committed to the tracker database are kept in the activity database, and the later ones in memory.
+type activityTracker struct {
+	enabled bool
+	dbPath  string
+	dbMem   bool
+
+	log logging.Logger
+
+	dbs db.Pair
+
+	mu deadlock.RWMutex
+
+	// start is the round the index was started at, the index holds the transactions of the later rounds
+	start basics.Round
+
+	// dbRound is the round of the tracker database, deltas[0] holds the records of round dbRound+1
+	dbRound basics.Round
+
+	// deltas holds the records of the rounds that were not committed yet
+	deltas [][]activityRecord
+}
+
+func (at *activityTracker) initialize(cfg config.Local, dirs DirsAndPrefix, dbMem bool) {
+	at.enabled = cfg.Archival && cfg.EnableTransactionActivityIndex
+	at.dbPath = filepath.Join(dirs.ResolvedGenesisDirs.BlockGenesisDir, dirs.DBFilePrefix) + ".activity.sqlite"
+	at.dbMem = dbMem
+}
+
+func (at *activityTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) (err error) {
+	at.log = l.trackerLog()
+	at.dbs, err = db.OpenPair(at.dbPath, at.dbMem)
+	if err != nil {
+		return fmt.Errorf("unable to open activity database %s: %w", at.dbPath, err)
+	}
+	at.dbs.Rdb.SetLogger(at.log)
+	at.dbs.Wdb.SetLogger(at.log)
+
+	var start, round basics.Round
+	var found bool
+	err = at.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range activitySchema {
+			_, err0 := tx.ExecContext(ctx, stmt)
+			if err0 != nil {
+				return err0
+			}
+		}
+		var err0 error
+		start, found, err0 = activityMeta(tx, "start")
+		if err0 != nil || !found {
+			return err0
+		}
+		round, _, err0 = activityMeta(tx, "round")
+		return err0
+	})
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case found && start <= dbRound && round >= dbRound:
+		// drop the rounds of a commit that the tracker database did not complete, if any
+		err = at.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			_, err0 := tx.ExecContext(ctx, "DELETE FROM activity WHERE rnd > ?", dbRound)
+			if err0 != nil {
+				return err0
+			}
+			return setActivityMeta(tx, "round", dbRound)
+		})
+	case found && start <= round && round < dbRound && at.blocksAvailable(l, round+1):
+		// the index was disabled for a while, index the blocks added since
+		at.log.Infof("activityTracker.loadFromDisk indexing rounds %d-%d", round+1, dbRound)
+		err = at.backfill(l, round, dbRound)
+	default:
+		// the blocks the index is missing are not available, e.g. after a catchpoint catchup or when the index is
+		// enabled for the first time: start over from the current tracker database round.
+		if found {
+			at.log.Infof("activityTracker.loadFromDisk resetting the activity index of rounds %d-%d to start at round %d", start, round, dbRound)
+		}
+		start = dbRound
+		err = at.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			_, err0 := tx.ExecContext(ctx, "DELETE FROM activity")
+			if err0 != nil {
+				return err0
+			}
+			err0 = setActivityMeta(tx, "start", start)
+			if err0 != nil {
+				return err0
+			}
+			return setActivityMeta(tx, "round", dbRound)
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	at.mu.Lock()
+	defer at.mu.Unlock()
+	at.start = start
+	at.dbRound = dbRound
+	at.deltas = nil
+	return nil
+}
+
+func (at *activityTracker) blocksAvailable(l ledgerForTracker, rnd basics.Round) bool {
+	_, err := l.Block(rnd)
+	return err == nil
+}
+
+// backfill indexes the blocks of rounds from+1 to to.
+func (at *activityTracker) backfill(l ledgerForTracker, from, to basics.Round) error {
+	for from < to {
+		last := min(from+activityBackfillBatch, to)
+		err := at.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			for rnd := from + 1; rnd <= last; rnd++ {
+				blk, err0 := l.Block(rnd)
+				if err0 != nil {
+					return err0
+				}
+				records, err0 := activityRecords(blk)
+				if err0 != nil {
+					return err0
+				}
+				err0 = writeActivity(ctx, tx, rnd, records)
+				if err0 != nil {
+					return err0
+				}
+			}
+			return setActivityMeta(tx, "round", last)
+		})
+		if err != nil {
+			return err
+		}
+		from = last
+	}
+	return nil
+}
+
+func activityMeta(tx *sql.Tx, name string) (rnd basics.Round, found bool, err error) {
+	err = tx.QueryRow("SELECT value FROM activitymeta WHERE name = ?", name).Scan(&rnd)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	return rnd, err == nil, err
+}
+
+func setActivityMeta(tx *sql.Tx, name string, rnd basics.Round) error {
+	_, err := tx.Exec("INSERT OR REPLACE INTO activitymeta (name, value) VALUES (?, ?)", name, rnd)
+	return err
+}
+
+func writeActivity(ctx context.Context, tx *sql.Tx, rnd basics.Round, records []activityRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	stmt, err := tx.PrepareContext(ctx, "INSERT OR IGNORE INTO activity (key, rnd, intra) VALUES (?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, rec := range records {
+		_, err = stmt.ExecContext(ctx, []byte(rec.key), rnd, rec.intra)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (at *activityTracker) close() {
+	at.dbs.Close()
+	at.dbs = db.Pair{}
+}
+
+func (at *activityTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	records, err := activityRecords(blk)
+	if err != nil {
+		// the block was just validated, so its transactions can be decoded
+		at.log.Errorf("activityTracker.newBlock: unable to index round %d: %v", blk.Round(), err)
+	}
+
+	at.mu.Lock()
+	defer at.mu.Unlock()
+	at.deltas = append(at.deltas, records)
+}
+
+func (at *activityTracker) committedUpTo(committedRound basics.Round) (retRound, lookback basics.Round) {
+	return committedRound, basics.Round(0)
+}
+
+func (at *activityTracker) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (at *activityTracker) prepareCommit(dcc *deferredCommitContext) error {
+	at.mu.RLock()
+	defer at.mu.RUnlock()
+	if at.dbRound != dcc.oldBase {
+		return fmt.Errorf("activityTracker.prepareCommit: the activity index round %d does not match the commit base round %d", at.dbRound, dcc.oldBase)
+	}
+	if dcc.offset > uint64(len(at.deltas)) {
+		return fmt.Errorf("activityTracker.prepareCommit: commit offset %d exceeds the %d rounds held in memory", dcc.offset, len(at.deltas))
+	}
+	dcc.activityDeltas = at.deltas[:dcc.offset]
+	return nil
+}
+
+func (at *activityTracker) commitRound(ctx context.Context, tx trackerdb.TransactionScope, dcc *deferredCommitContext) error {
+	return at.dbs.Wdb.AtomicContext(ctx, func(ctx context.Context, atx *sql.Tx) error {
+		for i, records := range dcc.activityDeltas {
+			err := writeActivity(ctx, atx, dcc.oldBase+basics.Round(i)+1, records)
+			if err != nil {
+				return err
+			}
+		}
+		return setActivityMeta(atx, "round", dcc.newBase())
+	})
+}
+
+func (at *activityTracker) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+	at.mu.Lock()
+	defer at.mu.Unlock()
+	at.deltas = at.deltas[dcc.offset:]
+	at.dbRound = dcc.newBase()
+}
+
+// lookup returns the locations of up to limit transactions that touched key, newest first, starting with the
+// transactions confirmed before the one at before and down to round minRound. It also returns the latest round
+// covered by the index.
+func (at *activityTracker) lookup(key []byte, minRound basics.Round, before TxnLocation, limit uint64) (locs []TxnLocation, latest basics.Round, err error) {
+	skey := string(key)
+	at.mu.RLock()
+	dbRound := at.dbRound
+	latest = dbRound + basics.Round(len(at.deltas))
+	for i := len(at.deltas) - 1; i >= 0 && uint64(len(locs)) < limit; i-- {
+		rnd := dbRound + basics.Round(i) + 1
+		if rnd < minRound {
+			break
+		}
+		records := at.deltas[i]
+		for j := len(records) - 1; j >= 0 && uint64(len(locs)) < limit; j-- {
+			loc := TxnLocation{Round: rnd, Offset: records[j].intra}
+			if records[j].key == skey && loc.Less(before) {
+				locs = append(locs, loc)
+			}
+		}
+	}
+	at.mu.RUnlock()
+
+	// the rounds held in memory may have been committed meanwhile, only look up the ones that were not listed yet
+	if dbLimit := (TxnLocation{Round: dbRound + 1}); dbLimit.Less(before) {
+		before = dbLimit
+	}
+	if uint64(len(locs)) >= limit || !(TxnLocation{Round: minRound}).Less(before) {
+		return locs, latest, nil
+	}
+
+	err = at.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		rows, err0 := tx.QueryContext(ctx, "SELECT rnd, intra FROM activity WHERE key = ? AND rnd >= ? AND (rnd < ? OR (rnd = ? AND intra < ?)) ORDER BY rnd DESC, intra DESC LIMIT ?",
+			key, minRound, before.Round, before.Round, before.Offset, limit-uint64(len(locs)))
+		if err0 != nil {
+			return err0
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var loc TxnLocation
+			err0 = rows.Scan(&loc.Round, &loc.Offset)
+			if err0 != nil {
+				return err0
+			}
+			locs = append(locs, loc)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return locs, latest, nil
+}
+
+// activityRecords lists the accounts, applications and assets touched by each transaction of blk.
+func activityRecords(blk bookkeeping.Block) ([]activityRecord, error) {
+	var records []activityRecord
+	keys := make(map[string]struct{})
+	for i, stib := range blk.Payset {
+		stxn, ad, err := blk.DecodeSignedTxn(stib)
+		if err != nil {
+			return records, err
+		}
+		clear(keys)
+		activityKeys(&transactions.SignedTxnWithAD{SignedTxn: stxn, ApplyData: ad}, keys)
+		for key := range keys {
+			records = append(records, activityRecord{key: key, intra: uint64(i)})
+		}
+	}
+	return records, nil
+}
+
+// activityKeys adds the index keys of the accounts, application and asset touched by txn and its inner
+// transactions to keys.
+func activityKeys(txn *transactions.SignedTxnWithAD, keys map[string]struct{}) {
+	addAccount := func(addr basics.Address) {
+		if !addr.IsZero() {
+			keys[string(activityAccountKey(addr))] = struct{}{}
+		}
+	}
+	addCreatable := func(cidx basics.CreatableIndex, ctype basics.CreatableType) {
+		if cidx != 0 {
+			keys[string(activityCreatableKey(cidx, ctype))] = struct{}{}
+		}
+	}
+
+	tx := &txn.Txn
+	addAccount(tx.Sender)
+	addAccount(tx.RekeyTo)
+	switch tx.Type {
+	case protocol.PaymentTx:
+		addAccount(tx.Receiver)
+		addAccount(tx.CloseRemainderTo)
+	case protocol.AssetConfigTx:
+		aidx := tx.ConfigAsset
+		if aidx == 0 {
+			aidx = txn.ApplyData.ConfigAsset
+		}
+		addCreatable(basics.CreatableIndex(aidx), basics.AssetCreatable)
+	case protocol.AssetTransferTx:
+		addAccount(tx.AssetSender)
+		addAccount(tx.AssetReceiver)
+		addAccount(tx.AssetCloseTo)
+		addCreatable(basics.CreatableIndex(tx.XferAsset), basics.AssetCreatable)
+	case protocol.AssetFreezeTx:
+		addAccount(tx.FreezeAccount)
+		addCreatable(basics.CreatableIndex(tx.FreezeAsset), basics.AssetCreatable)
+	case protocol.ApplicationCallTx:
+		appIdx := tx.ApplicationID
+		if appIdx == 0 {
+			appIdx = txn.ApplyData.ApplicationID
+		}
+		addCreatable(basics.CreatableIndex(appIdx), basics.AppCreatable)
+		for _, addr := range tx.Accounts {
+			addAccount(addr)
+		}
+		for _, addr := range txn.ApplyData.EvalDelta.SharedAccts {
+			addAccount(addr)
+		}
+	case protocol.HeartbeatTx:
+		if tx.HeartbeatTxnFields != nil {
+			addAccount(tx.HbAddress)
+		}
+	}
+
+	for i := range txn.ApplyData.EvalDelta.InnerTxns {
+		activityKeys(&txn.ApplyData.EvalDelta.InnerTxns[i], keys)
+	}
+}
+
+func activityAccountKey(addr basics.Address) []byte {
+	return append([]byte{activityKindAccount}, addr[:]...)
+}
+
+func activityCreatableKey(cidx basics.CreatableIndex, ctype basics.CreatableType) []byte {
+	kind := activityKindAsset
+	if ctype == basics.AppCreatable {
+		kind = activityKindApp
+	}
+	return binary.BigEndian.AppendUint64([]byte{kind}, uint64(cidx))
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/txntest"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// activityAppSource pays its first foreign account with an inner transaction
+const activityAppSource = `#pragma version 8
+txn ApplicationID
+bz end
+itxn_begin
+int pay
+itxn_field TypeEnum
+txn Accounts 1
+itxn_field Receiver
+int 1000
+itxn_field Amount
+itxn_submit
+end:
+int 1
+`
+
+func TestTransactionActivityIndex(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.EnableTransactionActivityIndex = true
+	cfg.MaxAcctLookback = 2
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+	require.True(t, l.activity.enabled)
+
+	eval := nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{
+		Type:            protocol.ApplicationCallTx,
+		Sender:          addrs[0],
+		ApprovalProgram: activityAppSource,
+	})
+	vb := endBlock(t, l, eval)
+	appID := vb.Block().Payset[0].ApplyData.ApplicationID
+	appCalls := []TxnLocation{{Round: vb.Block().Round(), Offset: 0}}
+
+	eval = nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[0],
+		Receiver: appID.Address(),
+		Amount:   1_000_000,
+	})
+	endBlock(t, l, eval)
+
+	// addrs[2] is only touched by the inner payments of the app
+	var payments, innerPayments []TxnLocation
+	for i := 1; i <= 12; i++ {
+		eval = nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   addrs[0],
+			Receiver: addrs[1],
+			Amount:   uint64(1000 * i),
+		})
+		payments = append(payments, TxnLocation{Round: eval.Round(), Offset: 0})
+		if i%3 != 0 {
+			txn(t, l, eval, &txntest.Txn{
+				Type:          protocol.ApplicationCallTx,
+				Sender:        addrs[1],
+				ApplicationID: appID,
+				Accounts:      []basics.Address{addrs[2]},
+			})
+			appCalls = append(appCalls, TxnLocation{Round: eval.Round(), Offset: 1})
+			innerPayments = append(innerPayments, TxnLocation{Round: eval.Round(), Offset: 1})
+		}
+		endBlock(t, l, eval)
+		if i == 6 {
+			// commit the first rounds to the activity database and keep the later ones in memory
+			l.WaitForCommit(l.Latest())
+			triggerTrackerFlush(t, l)
+		}
+	}
+	l.WaitForCommit(l.Latest())
+	require.Greater(t, l.activity.dbRound, appCalls[0].Round)
+	require.NotEmpty(t, l.activity.deltas)
+
+	newestFirst := func(locs []TxnLocation) []TxnLocation {
+		reversed := make([]TxnLocation, len(locs))
+		for i, loc := range locs {
+			reversed[len(locs)-1-i] = loc
+		}
+		return reversed
+	}
+	everything := TxnLocation{Round: math.MaxUint64}
+	locations := func(txns []ActivityTxn) []TxnLocation {
+		locs := make([]TxnLocation, len(txns))
+		for i, txn := range txns {
+			locs[i] = txn.TxnLocation
+		}
+		return locs
+	}
+
+	txns, latest, err := l.AccountActivity(addrs[2], 0, everything, 100)
+	require.NoError(t, err)
+	require.Equal(t, l.Latest(), latest)
+	require.Equal(t, newestFirst(innerPayments), locations(txns))
+	for _, txn := range txns {
+		require.Equal(t, appID, txn.Txn.Txn.ApplicationID)
+		require.Len(t, txn.Txn.ApplyData.EvalDelta.InnerTxns, 1)
+	}
+
+	txns, _, err = l.CreatableActivity(basics.CreatableIndex(appID), basics.AppCreatable, 0, everything, 100)
+	require.NoError(t, err)
+	require.Equal(t, newestFirst(appCalls), locations(txns))
+
+	// page through the payments of addrs[0] across the rounds in memory and in the database
+	txns, _, err = l.AccountActivity(addrs[0], payments[0].Round, everything, 100)
+	require.NoError(t, err)
+	require.Equal(t, newestFirst(payments), locations(txns))
+	var paged []TxnLocation
+	before := everything
+	for {
+		txns, _, err = l.AccountActivity(addrs[0], payments[0].Round, before, 5)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(txns), 5)
+		if len(txns) == 0 {
+			break
+		}
+		paged = append(paged, locations(txns)...)
+		before = txns[len(txns)-1].TxnLocation
+	}
+	require.Equal(t, newestFirst(payments), paged)
+
+	txns, _, err = l.CreatableActivity(basics.CreatableIndex(appID), basics.AssetCreatable, 0, everything, 100)
+	require.NoError(t, err)
+	require.Empty(t, txns)
+
+	// an in-memory activity database does not survive a reload, so the index starts over
+	dbRound := l.accts.cachedDBRound
+	require.NoError(t, l.reloadLedger())
+	txns, _, err = l.AccountActivity(addrs[2], 0, everything, 100)
+	require.NoError(t, err)
+	for _, txn := range txns {
+		require.Greater(t, txn.Round, dbRound)
+	}
+
+	// the blocks are still there to index the earlier rounds
+	require.NoError(t, l.activity.backfill(l, 0, dbRound))
+	txns, _, err = l.AccountActivity(addrs[2], 0, everything, 100)
+	require.NoError(t, err)
+	require.Equal(t, newestFirst(innerPayments), locations(txns))
+}
+
+func TestTransactionActivityIndexDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.EnableTransactionActivityIndex = true
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg, simpleLedgerNotArchival())
+	defer l.Close()
+
+	_, _, err := l.AccountActivity(addrs[0], 0, TxnLocation{Round: math.MaxUint64}, 10)
+	require.ErrorIs(t, err, errActivityIndexDisabled)
+}
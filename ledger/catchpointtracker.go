@@ -59,6 +59,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/trieproof"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/protocol"
@@ -178,6 +179,9 @@ type catchpointTracker struct {
 	// cachedDBRound is always exactly tracker DB round (and therefore, accountsRound()),
 	// cached to use in lookup functions
 	cachedDBRound basics.Round
+
+	// trieSnapshot keeps the trie of the last catchpoint, to prove ledger entries against
+	trieSnapshot trieSnapshot
 }
 
 // initialize initializes the catchpointTracker structure
@@ -406,13 +410,30 @@ func (ct *catchpointTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Rou
 	close(ct.catchpointDataSlowWriting)
 	ct.catchpointsMu.Unlock()
 
+	var trieRebuilt bool
 	err = ct.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		ar, err0 := tx.MakeAccountsReader()
+		if err0 != nil {
+			return err0
+		}
+		hashRound, err0 := ar.AccountsHashRound(ctx)
+		if err0 != nil {
+			return err0
+		}
+		trieRebuilt = hashRound != dbRound
 		return ct.initializeHashes(ctx, tx, dbRound)
 	})
 	if err != nil {
 		return err
 	}
 
+	if ct.trieSnapshotEnabled() {
+		err = ct.trieSnapshot.loadFromDisk(ct.log, dbRound, trieRebuilt)
+		if err != nil {
+			return err
+		}
+	}
+
 	ct.lastCatchpointLabel, err = ct.catchpointStore.ReadCatchpointStateString(
 		context.Background(), trackerdb.CatchpointStateLastCatchpoint)
 	if err != nil {
@@ -589,12 +610,22 @@ func (ct *catchpointTracker) commitRound(ctx context.Context, tx trackerdb.Trans
 		return err
 	}
 
+	var recorder *trieSnapshotRecorder
 	if ct.catchpointEnabled() {
 		var mc trackerdb.MerkleCommitter
 		mc, err = tx.MakeMerkleCommitter(false)
 		if err != nil {
 			return
 		}
+		if ct.trieSnapshotEnabled() && !dcc.catchpointFirstStage {
+			recorder, err = ct.trieSnapshot.makeRecorder(ctx, mc)
+			if err != nil {
+				return err
+			}
+			if recorder != nil {
+				mc = recorder
+			}
+		}
 
 		var trie *merkletrie.Trie
 		ct.catchpointsMu.Lock()
@@ -622,6 +653,25 @@ func (ct *catchpointTracker) commitRound(ctx context.Context, tx trackerdb.Trans
 		return err
 	}
 
+	// the trie snapshot is committed ahead of the tracker database, see trieSnapshot.
+	if recorder != nil {
+		recorder.recordEntries(dcc.compactAccountDeltas, dcc.compactResourcesDeltas, dcc.compactKvDeltas)
+		err = recorder.flush(ctx, dcc.newBase())
+		if err != nil {
+			return err
+		}
+	} else if ct.trieSnapshotEnabled() && dcc.catchpointFirstStage {
+		var root crypto.Digest
+		root, err = ct.balancesTrie.RootHash()
+		if err != nil {
+			return err
+		}
+		err = ct.trieSnapshot.reset(ctx, dcc.newBase(), root)
+		if err != nil {
+			return err
+		}
+	}
+
 	if dcc.updateStats {
 		now := time.Duration(time.Now().UnixNano())
 		dcc.stats.MerkleTrieUpdateDuration = now - dcc.stats.MerkleTrieUpdateDuration
@@ -835,6 +885,19 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 	ct.lastCatchpointLabel = label
 	ct.catchpointsMu.Unlock()
 
+	if ct.trieSnapshotEnabled() {
+		snapshotErr := ct.trieSnapshot.setCatchpoint(ctx, accountsRound, dataInfo.TrieBalancesHash, label, trieproof.Catchpoint{
+			BlockHash:                  blockHash,
+			Totals:                     dataInfo.Totals,
+			StateProofVerificationHash: dataInfo.StateProofVerificationHash,
+			OnlineAccountsHash:         dataInfo.OnlineAccountsHash,
+			OnlineRoundParamsHash:      dataInfo.OnlineRoundParamsHash,
+		})
+		if snapshotErr != nil {
+			ct.log.Warnf("unable to record catchpoint %s of the merkle trie snapshot: %v", label, snapshotErr)
+		}
+	}
+
 	if !ct.enableGeneratingCatchpointFiles {
 		return nil
 	}
@@ -1070,6 +1133,9 @@ func (ct *catchpointTracker) cancelWrite(dcc *deferredCommitContext) {
 // be called even if loadFromDisk() is not called or does
 // not succeed.
 func (ct *catchpointTracker) close() {
+	if ct.trieSnapshotEnabled() {
+		ct.trieSnapshot.close()
+	}
 }
 
 // accountsUpdateBalances applies the given compactAccountDeltas to the merkle trie
@@ -1524,6 +1590,11 @@ func (ct *catchpointTracker) catchpointEnabled() bool {
 	return ct.catchpointInterval != 0
 }
 
+// trieSnapshotEnabled returns true if the trie of the last catchpoint is kept to prove ledger entries against.
+func (ct *catchpointTracker) trieSnapshotEnabled() bool {
+	return ct.trieSnapshot.enabled && ct.catchpointEnabled()
+}
+
 // initializeHashes initializes account/resource/kv hashes.
 // as part of the initialization, it tests if a hash table matches to account base and updates the former.
 func (ct *catchpointTracker) initializeHashes(ctx context.Context, tx trackerdb.TransactionScope, rnd basics.Round) error {
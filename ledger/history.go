@@ -0,0 +1,550 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// history index key kinds
+const (
+	historyKindAccount       byte = 'a'
+	historyKindAppParams     byte = 'p'
+	historyKindAppLocalState byte = 'l'
+	historyKindAssetParams   byte = 's'
+	historyKindAssetHolding  byte = 'h'
+	historyKindKv            byte = 'k'
+	historyKindCreator       byte = 'c'
+)
+
+var historySchema = []string{
+	`CREATE TABLE IF NOT EXISTS historymeta (
+		name TEXT PRIMARY KEY,
+		value INTEGER)`,
+	// value is the state of key after round rnd, or NULL if it was deleted in that round
+	`CREATE TABLE IF NOT EXISTS history (
+		key BLOB NOT NULL,
+		rnd INTEGER NOT NULL,
+		value BLOB,
+		PRIMARY KEY (key, rnd)) WITHOUT ROWID`,
+}
+
+// historyKey identifies a single piece of state tracked by the history index.
+type historyKey struct {
+	kind  byte
+	addr  basics.Address
+	cidx  basics.CreatableIndex
+	ctype basics.CreatableType
+	kv    string
+}
+
+// historyRecord is the new value of a key in some round, with a nil value
+// when the key was deleted.
+type historyRecord struct {
+	key   historyKey
+	value []byte
+}
+
+// historyTracker maintains the historical state index of an archival ledger.
+// For every round committed to the tracker database, it records the new value
+// of each account, application and asset resource, box and creator that
+// changed in that round. The first time a key changes, the value it had when
+// the index was started is recorded as well, so that lookups can be answered
+// for any round since then.
+//
+// The index is kept in its own database and is committed ahead of the tracker
+// database: the tracker has to be registered before accountUpdates so that
+// commitRound still observes the old values in the tracker database.
+type historyTracker struct {
+	enabled bool
+	dbPath  string
+	dbMem   bool
+
+	log logging.Logger
+
+	dbs db.Pair
+
+	// accountsq reads the current state from the tracker database
+	accountsq trackerdb.AccountsReader
+
+	mu deadlock.RWMutex
+
+	// start is the earliest round the index can answer lookups for
+	start basics.Round
+
+	// dbRound is the round of the tracker database, deltas[0] holds the changes of round dbRound+1
+	dbRound basics.Round
+
+	// deltas holds the changes of the rounds that were not committed yet
+	deltas [][]historyRecord
+}
+
+func (ht *historyTracker) initialize(cfg config.Local, dirs DirsAndPrefix, dbMem bool) {
+	ht.enabled = cfg.Archival && cfg.EnableHistoricalStateIndex
+	ht.dbPath = filepath.Join(dirs.ResolvedGenesisDirs.BlockGenesisDir, dirs.DBFilePrefix) + ".history.sqlite"
+	ht.dbMem = dbMem
+}
+
+func (ht *historyTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) (err error) {
+	ht.log = l.trackerLog()
+	ht.dbs, err = db.OpenPair(ht.dbPath, ht.dbMem)
+	if err != nil {
+		return fmt.Errorf("unable to open history database %s: %w", ht.dbPath, err)
+	}
+	ht.dbs.Rdb.SetLogger(ht.log)
+	ht.dbs.Wdb.SetLogger(ht.log)
+
+	ht.accountsq, err = l.trackerDB().MakeAccountsOptimizedReader()
+	if err != nil {
+		return err
+	}
+
+	var start basics.Round
+	err = ht.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range historySchema {
+			_, err0 := tx.ExecContext(ctx, stmt)
+			if err0 != nil {
+				return err0
+			}
+		}
+
+		var round basics.Round
+		var found bool
+		var err0 error
+		start, found, err0 = historyMeta(tx, "start")
+		if err0 != nil {
+			return err0
+		}
+		if found {
+			round, _, err0 = historyMeta(tx, "round")
+			if err0 != nil {
+				return err0
+			}
+		}
+
+		// the index can only follow the tracker database if it covers every round up to it.
+		// Otherwise, e.g. after a catchpoint catchup or when the index is enabled for the
+		// first time, start over from the current tracker database round.
+		if found && start <= dbRound && round >= dbRound {
+			// drop the rounds of a commit that the tracker database did not complete, if any.
+			// Values recorded at the start round for keys first changed in those rounds remain
+			// valid, since the tracker database still holds them.
+			if round == dbRound {
+				return nil
+			}
+			_, err0 = tx.ExecContext(ctx, "DELETE FROM history WHERE rnd > ?", dbRound)
+			if err0 != nil {
+				return err0
+			}
+			return setHistoryMeta(tx, "round", dbRound)
+		}
+		if found {
+			ht.log.Infof("historyTracker.loadFromDisk resetting the history index of rounds %d-%d to start at round %d", start, round, dbRound)
+		}
+		_, err0 = tx.ExecContext(ctx, "DELETE FROM history")
+		if err0 != nil {
+			return err0
+		}
+		start = dbRound
+		err0 = setHistoryMeta(tx, "start", start)
+		if err0 != nil {
+			return err0
+		}
+		return setHistoryMeta(tx, "round", dbRound)
+	})
+	if err != nil {
+		return err
+	}
+
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	ht.start = start
+	ht.dbRound = dbRound
+	ht.deltas = nil
+	return nil
+}
+
+func historyMeta(tx *sql.Tx, name string) (rnd basics.Round, found bool, err error) {
+	err = tx.QueryRow("SELECT value FROM historymeta WHERE name = ?", name).Scan(&rnd)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	return rnd, err == nil, err
+}
+
+func setHistoryMeta(tx *sql.Tx, name string, rnd basics.Round) error {
+	_, err := tx.Exec("INSERT OR REPLACE INTO historymeta (name, value) VALUES (?, ?)", name, rnd)
+	return err
+}
+
+func (ht *historyTracker) close() {
+	if ht.accountsq != nil {
+		ht.accountsq.Close()
+		ht.accountsq = nil
+	}
+	ht.dbs.Close()
+	ht.dbs = db.Pair{}
+}
+
+func (ht *historyTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	records := make([]historyRecord, 0, len(delta.Accts.Accts)+len(delta.Accts.AppResources)+len(delta.Accts.AssetResources)+len(delta.KvMods)+len(delta.Creatables))
+	for _, br := range delta.Accts.Accts {
+		rec := historyRecord{key: historyKey{kind: historyKindAccount, addr: br.Addr}}
+		if !br.AccountData.IsZero() {
+			rec.value = encodeHistoryAccount(&br.AccountData)
+		}
+		records = append(records, rec)
+	}
+	for _, rec := range delta.Accts.AppResources {
+		cidx := basics.CreatableIndex(rec.Aidx)
+		if rec.Params.Params != nil || rec.Params.Deleted {
+			r := historyRecord{key: historyKey{kind: historyKindAppParams, addr: rec.Addr, cidx: cidx}}
+			if rec.Params.Params != nil {
+				r.value = protocol.Encode(rec.Params.Params)
+			}
+			records = append(records, r)
+		}
+		if rec.State.LocalState != nil || rec.State.Deleted {
+			r := historyRecord{key: historyKey{kind: historyKindAppLocalState, addr: rec.Addr, cidx: cidx}}
+			if rec.State.LocalState != nil {
+				r.value = protocol.Encode(rec.State.LocalState)
+			}
+			records = append(records, r)
+		}
+	}
+	for _, rec := range delta.Accts.AssetResources {
+		cidx := basics.CreatableIndex(rec.Aidx)
+		if rec.Params.Params != nil || rec.Params.Deleted {
+			r := historyRecord{key: historyKey{kind: historyKindAssetParams, addr: rec.Addr, cidx: cidx}}
+			if rec.Params.Params != nil {
+				r.value = protocol.Encode(rec.Params.Params)
+			}
+			records = append(records, r)
+		}
+		if rec.Holding.Holding != nil || rec.Holding.Deleted {
+			r := historyRecord{key: historyKey{kind: historyKindAssetHolding, addr: rec.Addr, cidx: cidx}}
+			if rec.Holding.Holding != nil {
+				r.value = protocol.Encode(rec.Holding.Holding)
+			}
+			records = append(records, r)
+		}
+	}
+	for key, kv := range delta.KvMods {
+		records = append(records, historyRecord{key: historyKey{kind: historyKindKv, kv: key}, value: kv.Data})
+	}
+	for cidx, mc := range delta.Creatables {
+		r := historyRecord{key: historyKey{kind: historyKindCreator, cidx: cidx, ctype: mc.Ctype}}
+		if mc.Created {
+			r.value = append([]byte{}, mc.Creator[:]...)
+		}
+		records = append(records, r)
+	}
+
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	ht.deltas = append(ht.deltas, records)
+}
+
+func (ht *historyTracker) committedUpTo(committedRound basics.Round) (retRound, lookback basics.Round) {
+	return committedRound, basics.Round(0)
+}
+
+func (ht *historyTracker) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (ht *historyTracker) prepareCommit(dcc *deferredCommitContext) error {
+	ht.mu.RLock()
+	defer ht.mu.RUnlock()
+	if ht.dbRound != dcc.oldBase {
+		return fmt.Errorf("historyTracker.prepareCommit: the history index round %d does not match the commit base round %d", ht.dbRound, dcc.oldBase)
+	}
+	if dcc.offset > uint64(len(ht.deltas)) {
+		return fmt.Errorf("historyTracker.prepareCommit: commit offset %d exceeds the %d rounds held in memory", dcc.offset, len(ht.deltas))
+	}
+	dcc.historyDeltas = ht.deltas[:dcc.offset]
+	return nil
+}
+
+// commitRound writes the changes of the committed rounds to the history database. It runs within the tracker
+// database transaction, before the accounts are updated, so that the values keys had before their first
+// recorded change can be read from it.
+func (ht *historyTracker) commitRound(ctx context.Context, tx trackerdb.TransactionScope, dcc *deferredCommitContext) error {
+	ar, err := tx.MakeAccountsOptimizedReader()
+	if err != nil {
+		return err
+	}
+	defer ar.Close()
+
+	ht.mu.RLock()
+	start := ht.start
+	ht.mu.RUnlock()
+
+	return ht.dbs.Wdb.AtomicContext(ctx, func(ctx context.Context, htx *sql.Tx) error {
+		existsStmt, err := htx.PrepareContext(ctx, "SELECT 1 FROM history WHERE key = ? LIMIT 1")
+		if err != nil {
+			return err
+		}
+		defer existsStmt.Close()
+		insertStmt, err := htx.PrepareContext(ctx, "INSERT OR REPLACE INTO history (key, rnd, value) VALUES (?, ?, ?)")
+		if err != nil {
+			return err
+		}
+		defer insertStmt.Close()
+
+		for i, records := range dcc.historyDeltas {
+			rnd := dcc.oldBase + basics.Round(i) + 1
+			for _, rec := range records {
+				key := rec.key.bytes()
+				var exists int
+				err = existsStmt.QueryRowContext(ctx, key).Scan(&exists)
+				if errors.Is(err, sql.ErrNoRows) {
+					// first change since the index was started: keys that never changed since are read from
+					// the tracker database, so record the value the key had until now.
+					var baseline []byte
+					baseline, err = rec.key.persistedValue(ar)
+					if err != nil {
+						return err
+					}
+					_, err = insertStmt.ExecContext(ctx, key, start, baseline)
+				}
+				if err != nil {
+					return err
+				}
+				_, err = insertStmt.ExecContext(ctx, key, rnd, rec.value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return setHistoryMeta(htx, "round", dcc.newBase())
+	})
+}
+
+func (ht *historyTracker) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	ht.deltas = ht.deltas[dcc.offset:]
+	ht.dbRound = dcc.newBase()
+}
+
+// lookup returns the value key had at round rnd, or nil if it did not exist.
+func (ht *historyTracker) lookup(rnd basics.Round, key historyKey) ([]byte, error) {
+	ht.mu.RLock()
+	start, dbRound := ht.start, ht.dbRound
+	ht.mu.RUnlock()
+	if rnd < start || rnd > dbRound {
+		return nil, ledgercore.ErrHistoricalStateUnavailable{Round: rnd, First: start}
+	}
+
+	// The current value has to be read before the index: the index is committed ahead of the tracker
+	// database, so a key without any record in the index has not changed since the index was started
+	// up to at least the round the current value was read at.
+	current, err := key.persistedValue(ht.accountsq)
+	if err != nil {
+		return nil, err
+	}
+
+	var found, present bool
+	var value []byte
+	err = ht.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		err0 := tx.QueryRowContext(ctx, "SELECT value IS NOT NULL, value FROM history WHERE key = ? AND rnd <= ? ORDER BY rnd DESC LIMIT 1", key.bytes(), rnd).Scan(&present, &value)
+		if errors.Is(err0, sql.ErrNoRows) {
+			found = false
+			return nil
+		}
+		found = err0 == nil
+		return err0
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return current, nil
+	}
+	if !present {
+		return nil, nil
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	return value, nil
+}
+
+// lookupAccount returns the account data of addr at round rnd, without rewards applied.
+func (ht *historyTracker) lookupAccount(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, error) {
+	value, err := ht.lookup(rnd, historyKey{kind: historyKindAccount, addr: addr})
+	if err != nil || value == nil {
+		return ledgercore.AccountData{}, err
+	}
+	var ba trackerdb.BaseAccountData
+	err = protocol.Decode(value, &ba)
+	if err != nil {
+		return ledgercore.AccountData{}, err
+	}
+	return ba.GetLedgerCoreAccountData(), nil
+}
+
+// lookupResource returns the application or asset resource of addr at round rnd.
+func (ht *historyTracker) lookupResource(rnd basics.Round, addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) (res ledgercore.AccountResource, err error) {
+	paramsKind, holdingKind := historyKindAssetParams, historyKindAssetHolding
+	if ctype == basics.AppCreatable {
+		paramsKind, holdingKind = historyKindAppParams, historyKindAppLocalState
+	}
+	params, err := ht.lookup(rnd, historyKey{kind: paramsKind, addr: addr, cidx: cidx})
+	if err != nil {
+		return ledgercore.AccountResource{}, err
+	}
+	holding, err := ht.lookup(rnd, historyKey{kind: holdingKind, addr: addr, cidx: cidx})
+	if err != nil {
+		return ledgercore.AccountResource{}, err
+	}
+
+	switch ctype {
+	case basics.AppCreatable:
+		if params != nil {
+			res.AppParams = new(basics.AppParams)
+			err = protocol.Decode(params, res.AppParams)
+			if err != nil {
+				return ledgercore.AccountResource{}, err
+			}
+		}
+		if holding != nil {
+			res.AppLocalState = new(basics.AppLocalState)
+			err = protocol.Decode(holding, res.AppLocalState)
+		}
+	default:
+		if params != nil {
+			res.AssetParams = new(basics.AssetParams)
+			err = protocol.Decode(params, res.AssetParams)
+			if err != nil {
+				return ledgercore.AccountResource{}, err
+			}
+		}
+		if holding != nil {
+			res.AssetHolding = new(basics.AssetHolding)
+			err = protocol.Decode(holding, res.AssetHolding)
+		}
+	}
+	if err != nil {
+		return ledgercore.AccountResource{}, err
+	}
+	return res, nil
+}
+
+// lookupKv returns the value of the kv store key at round rnd, or nil if it did not exist.
+func (ht *historyTracker) lookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return ht.lookup(rnd, historyKey{kind: historyKindKv, kv: key})
+}
+
+// lookupCreator returns the creator of a creatable at round rnd.
+func (ht *historyTracker) lookupCreator(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
+	value, err := ht.lookup(rnd, historyKey{kind: historyKindCreator, cidx: cidx, ctype: ctype})
+	if err != nil || value == nil {
+		return basics.Address{}, false, err
+	}
+	copy(creator[:], value)
+	return creator, true, nil
+}
+
+// bytes returns the key the history of k is stored under.
+func (k historyKey) bytes() []byte {
+	switch k.kind {
+	case historyKindAccount:
+		return append([]byte{k.kind}, k.addr[:]...)
+	case historyKindKv:
+		return append([]byte{k.kind}, k.kv...)
+	case historyKindCreator:
+		return binary.BigEndian.AppendUint64([]byte{k.kind, byte(k.ctype)}, uint64(k.cidx))
+	default:
+		return binary.BigEndian.AppendUint64(append([]byte{k.kind}, k.addr[:]...), uint64(k.cidx))
+	}
+}
+
+// persistedValue reads the value of k from the tracker database, encoded the way the history index stores it.
+func (k historyKey) persistedValue(ar trackerdb.AccountsReader) ([]byte, error) {
+	switch k.kind {
+	case historyKindAccount:
+		pad, err := ar.LookupAccount(k.addr)
+		if err != nil || pad.AccountData.IsEmpty() {
+			return nil, err
+		}
+		ad := pad.AccountData.GetLedgerCoreAccountData()
+		return encodeHistoryAccount(&ad), nil
+	case historyKindAppParams, historyKindAppLocalState:
+		prd, err := ar.LookupResources(k.addr, k.cidx, basics.AppCreatable)
+		if err != nil {
+			return nil, err
+		}
+		res := prd.AccountResource()
+		if k.kind == historyKindAppParams && res.AppParams != nil {
+			return protocol.Encode(res.AppParams), nil
+		}
+		if k.kind == historyKindAppLocalState && res.AppLocalState != nil {
+			return protocol.Encode(res.AppLocalState), nil
+		}
+		return nil, nil
+	case historyKindAssetParams, historyKindAssetHolding:
+		prd, err := ar.LookupResources(k.addr, k.cidx, basics.AssetCreatable)
+		if err != nil {
+			return nil, err
+		}
+		res := prd.AccountResource()
+		if k.kind == historyKindAssetParams && res.AssetParams != nil {
+			return protocol.Encode(res.AssetParams), nil
+		}
+		if k.kind == historyKindAssetHolding && res.AssetHolding != nil {
+			return protocol.Encode(res.AssetHolding), nil
+		}
+		return nil, nil
+	case historyKindKv:
+		pv, err := ar.LookupKeyValue(k.kv)
+		if err != nil {
+			return nil, err
+		}
+		return pv.Value, nil
+	case historyKindCreator:
+		creator, ok, _, err := ar.LookupCreator(k.cidx, k.ctype)
+		if err != nil || !ok {
+			return nil, err
+		}
+		return append([]byte{}, creator[:]...), nil
+	}
+	return nil, fmt.Errorf("unknown history key kind %c", k.kind)
+}
+
+func encodeHistoryAccount(ad *ledgercore.AccountData) []byte {
+	var ba trackerdb.BaseAccountData
+	ba.SetCoreAccountData(ad)
+	return protocol.Encode(&ba)
+}
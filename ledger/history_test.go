@@ -0,0 +1,258 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const historyAppSource = `#pragma version 8
+txn ApplicationID
+bz end
+byte "n"
+byte "n"
+app_global_get
+int 1
++
+app_global_put
+byte "b"
+int 8
+box_create
+pop
+byte "b"
+int 0
+byte "n"
+app_global_get
+itob
+box_replace
+end:
+int 1
+`
+
+type historyState struct {
+	sender   ledgercore.AccountData
+	receiver ledgercore.AccountData
+	app      ledgercore.AppResource
+	creator  basics.Address
+	box      []byte
+}
+
+func TestHistoricalStateLookups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.EnableHistoricalStateIndex = true
+	cfg.MaxAcctLookback = 2
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+	require.True(t, l.history.enabled)
+
+	eval := nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            addrs[0],
+		ApprovalProgram:   historyAppSource,
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+	})
+	vb := endBlock(t, l, eval)
+	appID := vb.Block().Payset[0].ApplyData.ApplicationID
+	boxKey := apps.MakeBoxKey(uint64(appID), "b")
+
+	eval = nextBlock(t, l)
+	txn(t, l, eval, &txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[0],
+		Receiver: appID.Address(),
+		Amount:   1_000_000,
+	})
+	endBlock(t, l, eval)
+
+	states := make(map[basics.Round]historyState)
+	record := func() {
+		rnd := l.Latest()
+		var st historyState
+		var err error
+		st.sender, _, _, err = l.LookupAccount(rnd, addrs[0])
+		require.NoError(t, err)
+		st.receiver, _, _, err = l.LookupAccount(rnd, addrs[1])
+		require.NoError(t, err)
+		st.app, err = l.LookupApplication(rnd, addrs[0], appID)
+		require.NoError(t, err)
+		var ok bool
+		st.creator, ok, err = l.GetCreatorForRound(rnd, basics.CreatableIndex(appID), basics.AppCreatable)
+		require.NoError(t, err)
+		require.True(t, ok)
+		st.box, err = l.LookupKv(rnd, boxKey)
+		require.NoError(t, err)
+		states[rnd] = st
+	}
+	record()
+
+	for i := 1; i <= 10; i++ {
+		eval = nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   addrs[0],
+			Receiver: addrs[1],
+			Amount:   uint64(1000 * i),
+		})
+		if i%3 != 0 {
+			txn(t, l, eval, &txntest.Txn{
+				Type:          protocol.ApplicationCallTx,
+				Sender:        addrs[0],
+				ApplicationID: appID,
+				Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("b")}},
+			})
+		}
+		endBlock(t, l, eval)
+		record()
+	}
+
+	l.WaitForCommit(l.Latest())
+	triggerTrackerFlush(t, l)
+	require.Greater(t, l.accts.cachedDBRound, basics.Round(3))
+
+	for rnd, st := range states {
+		sender, validThrough, _, err := l.LookupAccount(rnd, addrs[0])
+		require.NoError(t, err)
+		require.Equal(t, rnd, validThrough)
+		require.Equal(t, st.sender, sender, "round %d", rnd)
+		receiver, _, _, err := l.LookupAccount(rnd, addrs[1])
+		require.NoError(t, err)
+		require.Equal(t, st.receiver, receiver, "round %d", rnd)
+
+		app, err := l.LookupApplication(rnd, addrs[0], appID)
+		require.NoError(t, err)
+		require.Equal(t, st.app, app, "round %d", rnd)
+		creator, ok, err := l.GetCreatorForRound(rnd, basics.CreatableIndex(appID), basics.AppCreatable)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, st.creator, creator)
+		box, err := l.LookupKv(rnd, boxKey)
+		require.NoError(t, err)
+		require.Equal(t, st.box, box, "round %d", rnd)
+	}
+
+	// the app did not exist before it was created
+	app, err := l.LookupApplication(0, addrs[0], appID)
+	require.NoError(t, err)
+	require.Nil(t, app.AppParams)
+	_, ok, err := l.GetCreatorForRound(0, basics.CreatableIndex(appID), basics.AppCreatable)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// an in-memory history database does not survive a reload, so the index starts over
+	dbRound := l.accts.cachedDBRound
+	require.NoError(t, l.reloadLedger())
+	_, _, _, err = l.LookupAccount(1, addrs[0])
+	require.Equal(t, ledgercore.ErrHistoricalStateUnavailable{Round: 1, First: dbRound}, err)
+}
+
+func TestHistoricalStateIndexAheadOfTrackerDB(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.EnableHistoricalStateIndex = true
+	cfg.MaxAcctLookback = 2
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg, simpleLedgerOnDisk())
+	defer l.Close()
+	t.Cleanup(func() {
+		files, _ := filepath.Glob(t.Name() + ".*.sqlite*")
+		for _, f := range files {
+			os.Remove(f)
+		}
+	})
+
+	idle := make(map[basics.Round]ledgercore.AccountData)
+	pay := func() {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{
+			Type:     protocol.PaymentTx,
+			Sender:   addrs[0],
+			Receiver: addrs[1],
+			Amount:   1000,
+		})
+		endBlock(t, l, eval)
+		data, _, _, err := l.LookupAccount(l.Latest(), addrs[2])
+		require.NoError(t, err)
+		idle[l.Latest()] = data
+	}
+	for i := 0; i < 5; i++ {
+		pay()
+	}
+	l.WaitForCommit(l.Latest())
+	triggerTrackerFlush(t, l)
+	dbRound := l.accts.cachedDBRound
+
+	// a commit that reached the history database but not the tracker database
+	idleKey := historyKey{kind: historyKindAccount, addr: addrs[2]}
+	err := l.history.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err0 := tx.ExecContext(ctx, "INSERT INTO history (key, rnd, value) VALUES (?, ?, NULL)", idleKey.bytes(), dbRound+1)
+		if err0 != nil {
+			return err0
+		}
+		return setHistoryMeta(tx, "round", dbRound+1)
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, l.reloadLedger())
+	var round basics.Round
+	var ahead int
+	err = l.history.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+		round, _, err0 = historyMeta(tx, "round")
+		if err0 != nil {
+			return err0
+		}
+		return tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM history WHERE key = ? AND rnd > ?", idleKey.bytes(), dbRound).Scan(&ahead)
+	})
+	require.NoError(t, err)
+	require.Zero(t, ahead)
+	require.LessOrEqual(t, round, l.accts.cachedDBRound)
+
+	// the index keeps following the tracker database
+	for i := 0; i < 5; i++ {
+		pay()
+	}
+	l.WaitForCommit(l.Latest())
+	triggerTrackerFlush(t, l)
+	require.Greater(t, l.accts.cachedDBRound, dbRound+1)
+	for rnd, want := range idle {
+		data, _, _, err := l.LookupAccount(rnd, addrs[2])
+		require.NoError(t, err)
+		require.Equal(t, want, data, "round %d", rnd)
+	}
+}
@@ -35,6 +35,7 @@ package ledger
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"time"
@@ -105,6 +106,8 @@ type Ledger struct {
 	notifier       blockNotifier
 	metrics        metricsTracker
 	spVerification spVerificationTracker
+	history        historyTracker
+	activity       activityTracker
 
 	trackers  trackerRegistry
 	trackerMu deadlock.RWMutex
@@ -183,6 +186,9 @@ func OpenLedger[T string | DirsAndPrefix](
 		err = fmt.Errorf("OpenLedger.openLedgerDB %v", err)
 		return nil, err
 	}
+	l.history.initialize(cfg, dirs, dbMem)
+	l.activity.initialize(cfg, dirs, dbMem)
+	l.catchpoint.trieSnapshot.initialize(cfg, dirs, dbMem)
 
 	l.setSynchronousMode(context.Background(), l.synchronousMode)
 
@@ -262,6 +268,14 @@ func (l *Ledger) reloadLedger() error {
 		&l.metrics,        // provides metrics reporting support
 		&l.spVerification, // provides state proof verification support
 	}
+	if l.history.enabled {
+		// the history index reads the values changed keys had before a commit, so it has to
+		// commit before the accounts are updated.
+		trackers = append([]ledgerTracker{&l.history}, trackers...)
+	}
+	if l.activity.enabled {
+		trackers = append(trackers, &l.activity)
+	}
 
 	l.accts.initialize(l.cfg)
 	l.acctsOnline.initialize(l.cfg)
@@ -523,7 +537,11 @@ func (l *Ledger) GetLastCatchpointLabel() string {
 func (l *Ledger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
-	return l.accts.GetCreatorForRound(rnd, cidx, ctype)
+	creator, ok, err = l.accts.GetCreatorForRound(rnd, cidx, ctype)
+	if l.lookupHistory(err) {
+		return l.history.lookupCreator(rnd, cidx, ctype)
+	}
+	return creator, ok, err
 }
 
 // GetCreator is like GetCreatorForRound, but for the latest round and race-free
@@ -589,6 +607,9 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	defer l.trackerMu.RUnlock()
 
 	data, rnd, rewardsVersion, rewardsLevel, err := l.accts.lookupWithoutRewards(round, addr, true /* take lock */)
+	if l.lookupHistory(err) {
+		data, rnd, rewardsVersion, rewardsLevel, err = l.lookupHistoricalAccount(round, addr)
+	}
 	if err != nil {
 		return ledgercore.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
 	}
@@ -599,6 +620,27 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	return data, rnd, withoutRewards, nil
 }
 
+// lookupHistory reports whether a lookup that failed with err, because the requested round is older
+// than the rounds held by the accounts tracker, can be answered from the historical state index.
+func (l *Ledger) lookupHistory(err error) bool {
+	var roundOffsetErr *RoundOffsetError
+	return l.history.enabled && errors.As(err, &roundOffsetErr)
+}
+
+// lookupHistoricalAccount looks up the account state at a round covered by the historical state index,
+// along with the rewards parameters of that round.
+func (l *Ledger) lookupHistoricalAccount(round basics.Round, addr basics.Address) (data ledgercore.AccountData, rnd basics.Round, rewardsVersion protocol.ConsensusVersion, rewardsLevel uint64, err error) {
+	data, err = l.history.lookupAccount(round, addr)
+	if err != nil {
+		return ledgercore.AccountData{}, 0, "", 0, err
+	}
+	hdr, err := l.BlockHdr(round)
+	if err != nil {
+		return ledgercore.AccountData{}, 0, "", 0, err
+	}
+	return data, round, hdr.CurrentProtocol, hdr.RewardsLevel, nil
+}
+
 // LookupApplication loads an application resource that matches the request parameters from the ledger.
 func (l *Ledger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
 	r, err := l.lookupResource(rnd, addr, basics.CreatableIndex(aidx), basics.AppCreatable)
@@ -617,6 +659,21 @@ func (l *Ledger) LookupAssets(addr basics.Address, assetIDGT basics.AssetIndex,
 	return resources, lookupRound, err
 }
 
+// LookupCreatedAssets loads, in asset ID order, the assets created by addr with an ID above assetIDGT.
+func (l *Ledger) LookupCreatedAssets(addr basics.Address, assetIDGT basics.AssetIndex, limit uint64) ([]ledgercore.AssetResourceWithIDs, basics.Round, error) {
+	return l.accts.LookupCreatedAssetResources(addr, assetIDGT, limit)
+}
+
+// LookupCreatedApplications loads, in application ID order, the applications created by addr with an ID above appIDGT.
+func (l *Ledger) LookupCreatedApplications(addr basics.Address, appIDGT basics.AppIndex, limit uint64) ([]ledgercore.AppResourceWithIDs, basics.Round, error) {
+	return l.accts.LookupAppResources(addr, appIDGT, true, limit)
+}
+
+// LookupApplications loads, in application ID order, the applications addr is opted in to with an ID above appIDGT.
+func (l *Ledger) LookupApplications(addr basics.Address, appIDGT basics.AppIndex, limit uint64) ([]ledgercore.AppResourceWithIDs, basics.Round, error) {
+	return l.accts.LookupAppResources(addr, appIDGT, false, limit)
+}
+
 // lookupResource loads a resource that matches the request parameters from the accounts update
 func (l *Ledger) lookupResource(rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.AccountResource, error) {
 	l.trackerMu.RLock()
@@ -624,6 +681,9 @@ func (l *Ledger) lookupResource(rnd basics.Round, addr basics.Address, aidx basi
 
 	// Intentionally apply (pending) rewards up to rnd.
 	res, _, err := l.accts.LookupResource(rnd, addr, aidx, ctype)
+	if l.lookupHistory(err) {
+		res, err = l.history.lookupResource(rnd, addr, aidx, ctype)
+	}
 	if err != nil {
 		return ledgercore.AccountResource{}, err
 	}
@@ -636,7 +696,11 @@ func (l *Ledger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
 
-	return l.accts.LookupKv(rnd, key)
+	value, err := l.accts.LookupKv(rnd, key)
+	if l.lookupHistory(err) {
+		return l.history.lookupKv(rnd, key)
+	}
+	return value, err
 }
 
 // LookupKeysByPrefix searches keys with specific prefix, up to `maxKeyNum`
@@ -648,6 +712,60 @@ func (l *Ledger) LookupKeysByPrefix(round basics.Round, keyPrefix string, maxKey
 	return l.accts.LookupKeysByPrefix(round, keyPrefix, maxKeyNum)
 }
 
+// LookupKvsByPrefix returns, in key order, up to limit kvstore entries whose keys start with keyPrefix and sort
+// strictly after the key after, with their values if withValues is set. If limit is 0, all of them are returned.
+func (l *Ledger) LookupKvsByPrefix(round basics.Round, keyPrefix string, after string, limit uint64, withValues bool) ([]KvPair, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	return l.accts.LookupKvsByPrefix(round, keyPrefix, after, limit, withValues)
+}
+
+// AccountActivity returns up to limit transactions that touched addr, directly or through their inner transactions,
+// newest first, starting with the transactions confirmed before the one at before and down to round minRound.
+// It also returns the latest round covered by the transaction activity index.
+func (l *Ledger) AccountActivity(addr basics.Address, minRound basics.Round, before TxnLocation, limit uint64) ([]ActivityTxn, basics.Round, error) {
+	return l.activityTxns(activityAccountKey(addr), minRound, before, limit)
+}
+
+// CreatableActivity is like AccountActivity, for the transactions that touched an application or asset.
+func (l *Ledger) CreatableActivity(cidx basics.CreatableIndex, ctype basics.CreatableType, minRound basics.Round, before TxnLocation, limit uint64) ([]ActivityTxn, basics.Round, error) {
+	return l.activityTxns(activityCreatableKey(cidx, ctype), minRound, before, limit)
+}
+
+func (l *Ledger) activityTxns(key []byte, minRound basics.Round, before TxnLocation, limit uint64) ([]ActivityTxn, basics.Round, error) {
+	if !l.activity.enabled {
+		return nil, 0, errActivityIndexDisabled
+	}
+
+	l.trackerMu.RLock()
+	locs, latest, err := l.activity.lookup(key, minRound, before, limit)
+	l.trackerMu.RUnlock()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	txns := make([]ActivityTxn, 0, len(locs))
+	var blk bookkeeping.Block
+	for _, loc := range locs {
+		if blk.Round() != loc.Round {
+			blk, err = l.Block(loc.Round)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		if loc.Offset >= uint64(len(blk.Payset)) {
+			return nil, 0, fmt.Errorf("activity index references transaction %d of round %d which only has %d", loc.Offset, loc.Round, len(blk.Payset))
+		}
+		stxn, ad, err := blk.DecodeSignedTxn(blk.Payset[loc.Offset])
+		if err != nil {
+			return nil, 0, err
+		}
+		txns = append(txns, ActivityTxn{TxnLocation: loc, Txn: transactions.SignedTxnWithAD{SignedTxn: stxn, ApplyData: ad}})
+	}
+	return txns, latest, nil
+}
+
 // LookupAgreement returns account data used by agreement.
 func (l *Ledger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
 	l.trackerMu.RLock()
@@ -760,6 +878,17 @@ func (l *Ledger) Latest() basics.Round {
 	return l.blockQ.latest()
 }
 
+// EarliestBlock returns the lowest round number the ledger holds a block for.
+// Non-archival ledgers forget old blocks as new ones are committed.
+func (l *Ledger) EarliestBlock() (earliest basics.Round, err error) {
+	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var err0 error
+		earliest, err0 = blockdb.BlockEarliest(tx)
+		return err0
+	})
+	return
+}
+
 // LatestCommitted returns the last block round number written to
 // persistent storage.  This block, and all previous blocks, are
 // guaranteed to be available after a crash. In addition, it returns
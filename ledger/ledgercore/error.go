@@ -110,6 +110,21 @@ func (err ErrNoEntry) Error() string {
 	return fmt.Sprintf("ledger does not have entry %d (latest %d, committed %d)", err.Round, err.Latest, err.Committed)
 }
 
+// ErrHistoricalStateUnavailable is returned when state is requested for a round that is older than the
+// recent rounds held by the ledger and is not covered by the historical state index.
+type ErrHistoricalStateUnavailable struct {
+	Round basics.Round
+	First basics.Round // First is the earliest round covered by the historical state index, if any
+}
+
+// Error satisfies builtin interface `error`
+func (err ErrHistoricalStateUnavailable) Error() string {
+	if err.First == 0 {
+		return fmt.Sprintf("historical state for round %d is not available: the historical state index is not enabled", err.Round)
+	}
+	return fmt.Sprintf("historical state for round %d is not available: the historical state index starts at round %d", err.Round, err.First)
+}
+
 // ErrNonSequentialBlockEval provides feedback when the evaluator cannot be created for
 // stale/future rounds.
 type ErrNonSequentialBlockEval struct {
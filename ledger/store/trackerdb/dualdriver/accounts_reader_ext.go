@@ -266,6 +266,39 @@ func (ar *accountsReaderExt) LookupResourceDataByAddrID(accRef trackerdb.Account
 	return dataP, nil
 }
 
+// LookupOrderedResources implements trackerdb.AccountsReaderExt
+func (ar *accountsReaderExt) LookupOrderedResources(addr basics.Address, ctype basics.CreatableType, minIdx basics.CreatableIndex, created bool, limit uint64) (data []trackerdb.PersistedResourcesData, err error) {
+	dataP, errP := ar.primary.LookupOrderedResources(addr, ctype, minIdx, created, limit)
+	dataS, errS := ar.secondary.LookupOrderedResources(addr, ctype, minIdx, created, limit)
+	// coalesce errors
+	err = coalesceErrors(errP, errS)
+	if err != nil {
+		return
+	}
+	// coalesce refs
+	if len(dataP) != len(dataS) {
+		err = ErrInconsistentResult
+		return
+	}
+	var ref trackerdb.AccountRef
+	for i := range dataP {
+		ref, err = coalesceAccountRefs(dataP[i].AcctRef, dataS[i].AcctRef)
+		if err != nil {
+			return nil, err
+		}
+		// update ref in results
+		dataP[i].AcctRef = ref
+		dataS[i].AcctRef = ref
+	}
+	// check results match
+	if !cmp.Equal(dataP, dataS, allowAllUnexported) {
+		err = ErrInconsistentResult
+		return
+	}
+	// return primary results
+	return dataP, nil
+}
+
 // OnlineAccountsAll implements trackerdb.AccountsReaderExt
 func (ar *accountsReaderExt) OnlineAccountsAll(maxAccounts uint64) (accounts []trackerdb.PersistedOnlineAccountData, err error) {
 	accountsP, errP := ar.primary.OnlineAccountsAll(maxAccounts)
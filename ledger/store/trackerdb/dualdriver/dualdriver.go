@@ -281,6 +281,12 @@ func (*reader) MakeCatchpointReader() (trackerdb.CatchpointReader, error) {
 	return nil, nil
 }
 
+// MakeMerkleReader implements trackerdb.Reader
+func (r *reader) MakeMerkleReader() (trackerdb.MerkleCommitter, error) {
+	// only the primary maintains the catchpoint merkle trie
+	return r.primary.MakeMerkleReader()
+}
+
 // MakeEncodedAccountsBatchIter implements trackerdb.Reader
 func (*reader) MakeEncodedAccountsBatchIter() trackerdb.EncodedAccountsBatchIter {
 	// TODO: catchpoint
@@ -293,6 +299,12 @@ func (*reader) MakeKVsIter(ctx context.Context) (trackerdb.KVsIter, error) {
 	return nil, nil
 }
 
+// MakeKVsPrefixIter implements trackerdb.Reader
+func (r *reader) MakeKVsPrefixIter(ctx context.Context, prefix, after []byte) (trackerdb.KVsIter, error) {
+	// only the primary is iterated, the results are not compared
+	return r.primary.MakeKVsPrefixIter(ctx, prefix, after)
+}
+
 // MakeOnlineAccountsIter implements trackerdb.Reader
 func (*reader) MakeOnlineAccountsIter(context.Context, bool) (trackerdb.TableIterator[*encoded.OnlineAccountRecordV6], error) {
 	// TODO: catchpoint
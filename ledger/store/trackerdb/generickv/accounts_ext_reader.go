@@ -126,6 +126,43 @@ func (r *accountsReader) LookupResourceDataByAddrID(accRef trackerdb.AccountRef,
 	return value, nil
 }
 
+func (r *accountsReader) LookupOrderedResources(addr basics.Address, ctype basics.CreatableType, minIdx basics.CreatableIndex, created bool, limit uint64) (data []trackerdb.PersistedResourcesData, err error) {
+	// resource keys end with the big endian index, so they are iterated in index order
+	low := resourceKey(addr, minIdx)
+	_, high := resourceAddrOnlyRangePrefix(addr)
+
+	iter := r.kvr.NewIter(low[:], high[:], false)
+	defer iter.Close()
+
+	var value []byte
+	for iter.Next() {
+		pitem := trackerdb.PersistedResourcesData{AcctRef: accountRef{addr}}
+		pitem.Aidx = extractResourceAidx(iter.Key())
+		if pitem.Aidx <= minIdx {
+			continue
+		}
+		value, err = iter.Value()
+		if err != nil {
+			return
+		}
+		err = protocol.Decode(value, &pitem.Data)
+		if err != nil {
+			return
+		}
+		if ctype == basics.AssetCreatable && !pitem.Data.IsAsset() || ctype == basics.AppCreatable && !pitem.Data.IsApp() {
+			continue
+		}
+		if created && !pitem.Data.IsOwning() || !created && !pitem.Data.IsHolding() {
+			continue
+		}
+		data = append(data, pitem)
+		if limit > 0 && uint64(len(data)) == limit {
+			break
+		}
+	}
+	return
+}
+
 func (r *accountsReader) TotalResources(ctx context.Context) (total uint64, err error) {
 	// TODO: catchpoint
 	return
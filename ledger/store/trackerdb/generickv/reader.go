@@ -33,6 +33,7 @@
 package generickv
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/algorand/go-algorand/config"
@@ -82,6 +83,12 @@ func (r *reader) MakeCatchpointReader() (trackerdb.CatchpointReader, error) {
 	panic("unimplemented")
 }
 
+// MakeMerkleReader implements trackerdb.Reader
+func (r *reader) MakeMerkleReader() (trackerdb.MerkleCommitter, error) {
+	// TODO: catchpoint
+	panic("unimplemented")
+}
+
 // MakeEncodedAccountsBatchIter implements trackerdb.Reader
 func (r *reader) MakeEncodedAccountsBatchIter() trackerdb.EncodedAccountsBatchIter {
 	// TODO: catchpoint
@@ -94,6 +101,30 @@ func (r *reader) MakeKVsIter(ctx context.Context) (trackerdb.KVsIter, error) {
 	panic("unimplemented")
 }
 
+// MakeKVsPrefixIter implements trackerdb.Reader
+func (r *reader) MakeKVsPrefixIter(ctx context.Context, prefix, after []byte) (trackerdb.KVsIter, error) {
+	low, high := keyPrefixIntervalPreprocessing(appKvKey(string(prefix)))
+	if after != nil && bytes.Compare(after, prefix) >= 0 {
+		// the smallest key sorting strictly after `after`
+		low = append(appKvKey(string(after)), 0)
+	}
+	// the iterator enforces low itself, so that keys equal to the bound
+	// given to NewIter are neither required nor relied upon
+	return &kvsIter{iter: r.NewIter(keyBelow(low), high, false), low: low}, nil
+}
+
+// keyBelow returns a key sorting before low, such that few keys sort between
+// the two.
+func keyBelow(low []byte) []byte {
+	last := len(low) - 1
+	if low[last] == 0 {
+		return low[:last]
+	}
+	below := make([]byte, last, len(low)+1)
+	copy(below, low)
+	return append(below, low[last]-1, 0xff)
+}
+
 // MakeOnlineAccountsIter implements trackerdb.Reader
 func (r *reader) MakeOnlineAccountsIter(context.Context, bool) (trackerdb.TableIterator[*encoded.OnlineAccountRecordV6], error) {
 	// TODO: catchpoint
@@ -105,3 +136,27 @@ func (r *reader) MakeOnlineRoundParamsIter(context.Context, bool) (trackerdb.Tab
 	// TODO: catchpoint
 	panic("unimplemented")
 }
+
+type kvsIter struct {
+	iter KvIter
+	low  []byte
+}
+
+func (i *kvsIter) Next() bool {
+	for i.iter.Next() {
+		if bytes.Compare(i.iter.Key(), i.low) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *kvsIter) KeyValue() (k []byte, v []byte, err error) {
+	k = i.iter.Key()[prefixLength+separatorLength:]
+	v, err = i.iter.Value()
+	return k, v, err
+}
+
+func (i *kvsIter) Close() {
+	i.iter.Close()
+}
@@ -139,6 +139,9 @@ type AccountsReaderExt interface {
 	LookupAccountAddressFromAddressID(ctx context.Context, ref AccountRef) (address basics.Address, err error)
 	LookupAccountRowID(basics.Address) (ref AccountRef, err error)
 	LookupResourceDataByAddrID(accountRef AccountRef, aidx basics.CreatableIndex) (data []byte, err error)
+	// LookupOrderedResources returns, in index order, up to limit resources of type ctype with an index above minIdx
+	// that the account created, if created is set, or else holds. A limit of 0 returns all of them.
+	LookupOrderedResources(addr basics.Address, ctype basics.CreatableType, minIdx basics.CreatableIndex, created bool, limit uint64) (data []PersistedResourcesData, err error)
 	TotalResources(ctx context.Context) (total uint64, err error)
 	TotalAccounts(ctx context.Context) (total uint64, err error)
 	TotalKVs(ctx context.Context) (total uint64, err error)
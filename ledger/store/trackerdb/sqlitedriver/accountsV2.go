@@ -274,6 +274,39 @@ ORDER BY normalizedonlinebalance DESC, address DESC LIMIT ? OFFSET ?`, rnd, n, o
 	return res, rows.Err()
 }
 
+// LookupOrderedResources returns, in index order, up to limit resources of type ctype with an index above minIdx
+// that the account created, if created is set, or else holds.
+func (r *accountsV2Reader) LookupOrderedResources(addr basics.Address, ctype basics.CreatableType, minIdx basics.CreatableIndex, created bool, limit uint64) ([]trackerdb.PersistedResourcesData, error) {
+	rows, err := r.q.Query("SELECT r.addrid, r.aidx, r.data FROM accountbase ab JOIN resources r ON r.addrid = ab.addrid WHERE ab.address = ? AND r.ctype = ? AND r.aidx > ? ORDER BY r.aidx ASC", addr[:], ctype, minIdx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []trackerdb.PersistedResourcesData
+	for rows.Next() {
+		var addrid, aidx int64
+		var buf []byte
+		err = rows.Scan(&addrid, &aidx, &buf)
+		if err != nil {
+			return nil, err
+		}
+		data := trackerdb.PersistedResourcesData{AcctRef: sqlRowRef{addrid}, Aidx: basics.CreatableIndex(aidx)}
+		err = protocol.Decode(buf, &data.Data)
+		if err != nil {
+			return nil, err
+		}
+		if created && !data.Data.IsOwning() || !created && !data.Data.IsHolding() {
+			continue
+		}
+		result = append(result, data)
+		if limit > 0 && uint64(len(result)) == limit {
+			break
+		}
+	}
+	return result, rows.Err()
+}
+
 // OnlineAccountsAll returns all online accounts
 func (r *accountsV2Reader) OnlineAccountsAll(maxAccounts uint64) ([]trackerdb.PersistedOnlineAccountData, error) {
 	rows, err := r.q.Query("SELECT rowid, address, updround, data FROM onlineaccounts ORDER BY address, updround ASC")
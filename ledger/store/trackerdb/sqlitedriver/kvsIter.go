@@ -33,6 +33,7 @@
 package sqlitedriver
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
@@ -63,6 +64,31 @@ func MakeKVsIter(ctx context.Context, q db.Queryable) (*kvsIter, error) {
 	}, nil
 }
 
+// MakeKVsPrefixIter creates a KV iterator over the keys starting with prefix and
+// sorting after the given key, in key order.
+func MakeKVsPrefixIter(ctx context.Context, q db.Queryable, prefix, after []byte) (*kvsIter, error) {
+	start, end := keyPrefixIntervalPreprocessing(prefix)
+	query := "SELECT key, value FROM kvstore WHERE key >= ?"
+	if after != nil && bytes.Compare(after, start) >= 0 {
+		query = "SELECT key, value FROM kvstore WHERE key > ?"
+		start = after
+	}
+	args := []interface{}{start}
+	if end != nil {
+		query += " AND key < ?"
+		args = append(args, end)
+	}
+	rows, err := q.QueryContext(ctx, query+" ORDER BY key", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvsIter{
+		q:    q,
+		rows: rows,
+	}, nil
+}
+
 func (iter *kvsIter) Next() bool {
 	return iter.rows.Next()
 }
@@ -34,6 +34,7 @@ package sqlitedriver
 
 import (
 	"database/sql"
+	"errors"
 
 	"github.com/algorand/go-algorand/util/db"
 )
@@ -91,3 +92,35 @@ func (mc *merkleCommitter) LoadPage(page uint64) (content []byte, err error) {
 	}
 	return content, nil
 }
+
+//msgp:ignore merkleReader
+type merkleReader struct {
+	selectStmt *sql.Stmt
+}
+
+// MakeMerkleReader creates a read-only MerkleCommitter object, allowing loading merkletrie pages from a sqlite database
+// within a read transaction.
+func MakeMerkleReader(q db.Queryable) (mr *merkleReader, err error) {
+	mr = &merkleReader{}
+	mr.selectStmt, err = q.Prepare("SELECT data FROM accounthashes WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	return mr, nil
+}
+
+// StorePage is the merkletrie.Committer interface implementation, and fails as the reader does not store pages.
+func (mr *merkleReader) StorePage(page uint64, content []byte) error {
+	return errors.New("merkle reader cannot store pages")
+}
+
+// LoadPage is the merkletrie.Committer interface implementation, load a single page from a sqlite database table.
+func (mr *merkleReader) LoadPage(page uint64) (content []byte, err error) {
+	err = mr.selectStmt.QueryRow(page).Scan(&content)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
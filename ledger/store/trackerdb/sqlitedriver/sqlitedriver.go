@@ -217,6 +217,11 @@ func (r *sqlReader) MakeCatchpointReader() (trackerdb.CatchpointReader, error) {
 	return makeCatchpointReader(r.q), nil
 }
 
+// MakeMerkleReader implements trackerdb.Reader
+func (r *sqlReader) MakeMerkleReader() (trackerdb.MerkleCommitter, error) {
+	return MakeMerkleReader(r.q)
+}
+
 // MakeEncodedAccountsBatchIter implements trackerdb.Reader
 func (r *sqlReader) MakeEncodedAccountsBatchIter() trackerdb.EncodedAccountsBatchIter {
 	return MakeEncodedAccountsBatchIter(r.q)
@@ -227,6 +232,11 @@ func (r *sqlReader) MakeKVsIter(ctx context.Context) (trackerdb.KVsIter, error)
 	return MakeKVsIter(ctx, r.q)
 }
 
+// MakeKVsPrefixIter implements trackerdb.Reader
+func (r *sqlReader) MakeKVsPrefixIter(ctx context.Context, prefix, after []byte) (trackerdb.KVsIter, error) {
+	return MakeKVsPrefixIter(ctx, r.q, prefix, after)
+}
+
 // MakeOnlineAccountsIter implements trackerdb.Reader
 func (r *sqlReader) MakeOnlineAccountsIter(ctx context.Context, useStaging bool) (trackerdb.TableIterator[*encoded.OnlineAccountRecordV6], error) {
 	return MakeOnlineAccountsIter(ctx, r.q, useStaging)
@@ -80,8 +80,14 @@ type Reader interface {
 	MakeCatchpointPendingHashesIterator(hashCount int) CatchpointPendingHashesIter
 	// Note: Catchpoint tracker needs this on the reader handle in sqlite to not get locked by write txns
 	MakeCatchpointReader() (CatchpointReader, error)
+	// MakeMerkleReader loads the catchpoint merkle trie pages, as of the same state as the other readers.
+	// The returned MerkleCommitter is read-only.
+	MakeMerkleReader() (MerkleCommitter, error)
 	MakeEncodedAccountsBatchIter() EncodedAccountsBatchIter
 	MakeKVsIter(ctx context.Context) (KVsIter, error)
+	// MakeKVsPrefixIter iterates, in key order, over the kvstore entries whose keys start with prefix
+	// and sort strictly after the given key. A nil after starts from the first key with the prefix.
+	MakeKVsPrefixIter(ctx context.Context, prefix, after []byte) (KVsIter, error)
 	MakeOnlineAccountsIter(ctx context.Context, useStaging bool) (TableIterator[*encoded.OnlineAccountRecordV6], error)
 	MakeOnlineRoundParamsIter(ctx context.Context, useStaging bool) (TableIterator[*encoded.OnlineRoundParamsRecordV6], error)
 }
@@ -52,6 +52,7 @@ func init() {
 	registerTest("online_accounts-round_params-update", CustomTestOnlineAccountParams)
 	registerTest("accounts-lookup_by_rowid", CustomTestAccountLookupByRowID)
 	registerTest("resources-lookup_by_rowid", CustomTestResourceLookupByRowID)
+	registerTest("resources-lookup_ordered", CustomTestResourcesLookupOrdered)
 }
 
 func CustomTestRoundUpdate(t *customT) {
@@ -327,3 +328,87 @@ func CustomTestResourceLookupByRowID(t *customT) {
 	require.Error(t, err)
 	require.Equal(t, err, trackerdb.ErrNotFound)
 }
+
+func CustomTestResourcesLookupOrdered(t *customT) {
+	aow, err := t.db.MakeAccountsOptimizedWriter(true, true, false, false)
+	require.NoError(t, err)
+
+	ar, err := t.db.MakeAccountsReader()
+	require.NoError(t, err)
+
+	addrA := RandomAddress()
+	accDataA := trackerdb.BaseAccountData{RewardsBase: 1000}
+	refAccA, err := aow.InsertAccount(addrA, accDataA.NormalizedOnlineBalance(t.proto), accDataA)
+	require.NoError(t, err)
+
+	// an other account, with resources that should never be returned
+	addrB := RandomAddress()
+	refAccB, err := aow.InsertAccount(addrB, accDataA.NormalizedOnlineBalance(t.proto), accDataA)
+	require.NoError(t, err)
+
+	// created assets 1, 4 (also held), held assets 2, 4, 7
+	// created apps 3 (also opted in), 6, opted in apps 3, 5
+	insert := func(ref trackerdb.AccountRef, aidx basics.CreatableIndex, rd trackerdb.ResourcesData) {
+		_, err := aow.InsertResource(ref, aidx, rd)
+		require.NoError(t, err)
+	}
+	var rd trackerdb.ResourcesData
+	rd = trackerdb.MakeResourcesData(0)
+	rd.SetAssetParams(basics.AssetParams{Total: 1}, false)
+	insert(refAccA, 1, rd)
+	rd = trackerdb.ResourcesData{}
+	rd.SetAssetHolding(basics.AssetHolding{Amount: 2})
+	insert(refAccA, 2, rd)
+	rd = trackerdb.ResourcesData{}
+	rd.SetAppParams(basics.AppParams{ApprovalProgram: []byte{1}}, true)
+	rd.SetAppLocalState(basics.AppLocalState{})
+	insert(refAccA, 3, rd)
+	rd = trackerdb.ResourcesData{}
+	rd.SetAssetHolding(basics.AssetHolding{Amount: 4})
+	rd.SetAssetParams(basics.AssetParams{Total: 4}, true)
+	insert(refAccA, 4, rd)
+	rd = trackerdb.ResourcesData{}
+	rd.SetAppLocalState(basics.AppLocalState{})
+	insert(refAccA, 5, rd)
+	rd = trackerdb.MakeResourcesData(0)
+	rd.SetAppParams(basics.AppParams{ApprovalProgram: []byte{6}}, false)
+	insert(refAccA, 6, rd)
+	rd = trackerdb.ResourcesData{}
+	rd.SetAssetHolding(basics.AssetHolding{})
+	insert(refAccA, 7, rd)
+	rd = trackerdb.ResourcesData{}
+	rd.SetAssetHolding(basics.AssetHolding{Amount: 8})
+	insert(refAccB, 8, rd)
+
+	lookup := func(ctype basics.CreatableType, minIdx basics.CreatableIndex, created bool, limit uint64) (idxs []basics.CreatableIndex) {
+		data, err := ar.LookupOrderedResources(addrA, ctype, minIdx, created, limit)
+		require.NoError(t, err)
+		for _, prd := range data {
+			idxs = append(idxs, prd.Aidx)
+		}
+		return idxs
+	}
+
+	require.Equal(t, []basics.CreatableIndex{1, 4}, lookup(basics.AssetCreatable, 0, true, 0))
+	require.Equal(t, []basics.CreatableIndex{2, 4, 7}, lookup(basics.AssetCreatable, 0, false, 0))
+	require.Equal(t, []basics.CreatableIndex{3, 6}, lookup(basics.AppCreatable, 0, true, 0))
+	require.Equal(t, []basics.CreatableIndex{3, 5}, lookup(basics.AppCreatable, 0, false, 0))
+
+	// paging
+	require.Equal(t, []basics.CreatableIndex{2, 4}, lookup(basics.AssetCreatable, 0, false, 2))
+	require.Equal(t, []basics.CreatableIndex{7}, lookup(basics.AssetCreatable, 4, false, 2))
+	require.Empty(t, lookup(basics.AssetCreatable, 7, false, 2))
+	require.Equal(t, []basics.CreatableIndex{6}, lookup(basics.AppCreatable, 3, true, 0))
+
+	// the resource data is returned
+	data, err := ar.LookupOrderedResources(addrA, basics.AssetCreatable, 3, true, 1)
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	require.Equal(t, uint64(4), data[0].Data.GetAssetParams().Total)
+	require.Equal(t, uint64(4), data[0].Data.GetAssetHolding().Amount)
+
+	// unknown account
+	data, err = ar.LookupOrderedResources(RandomAddress(), basics.AssetCreatable, 0, false, 0)
+	require.NoError(t, err)
+	require.Empty(t, data)
+}
@@ -33,6 +33,8 @@
 package testsuite
 
 import (
+	"context"
+
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger/store/trackerdb"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
@@ -48,6 +50,7 @@ func init() {
 	//       it is only supported by the sqlite implementation and is enabled there (see sqlitedb_test.go)
 	// registerTest("resources-query-all-limited", CustomTestResourcesQueryAllLimited)
 	registerTest("kv-crud", CustomTestAppKVCrud)
+	registerTest("kv-prefix-iter", CustomTestAppKVPrefixIter)
 	registerTest("creatables-crud", CustomTestCreatablesCrud)
 }
 
@@ -517,6 +520,44 @@ func CustomTestAppKVCrud(t *customT) {
 	require.Equal(t, expectedRound, pv1.Round) // db round (this is present even if record does not exist)
 }
 
+func CustomTestAppKVPrefixIter(t *customT) {
+	aow, err := t.db.MakeAccountsOptimizedWriter(false, false, true, false)
+	require.NoError(t, err)
+
+	keys := []string{"bx:a", "bx:a\x00", "bx:ab", "bx:b", "bx:b\xff", "bx:\xff", "by:a", "bw:z"}
+	for _, key := range keys {
+		err = aow.UpsertKvPair(key, []byte("v-"+key))
+		require.NoError(t, err)
+	}
+
+	iterate := func(prefix string, after []byte) (found []string) {
+		iter, err := t.db.MakeKVsPrefixIter(context.Background(), []byte(prefix), after)
+		require.NoError(t, err)
+		defer iter.Close()
+		for iter.Next() {
+			k, v, err := iter.KeyValue()
+			require.NoError(t, err)
+			require.Equal(t, "v-"+string(k), string(v))
+			found = append(found, string(k))
+		}
+		return found
+	}
+
+	// keys are returned in byte order
+	require.Equal(t, []string{"bx:a", "bx:a\x00", "bx:ab", "bx:b", "bx:b\xff", "bx:\xff"}, iterate("bx:", nil))
+	require.Equal(t, []string{"bx:b", "bx:b\xff"}, iterate("bx:b", nil))
+
+	// iteration starts strictly after the given key
+	require.Equal(t, []string{"bx:ab", "bx:b", "bx:b\xff", "bx:\xff"}, iterate("bx:", []byte("bx:a\x00")))
+	require.Equal(t, []string{"bx:b\xff", "bx:\xff"}, iterate("bx:", []byte("bx:b")))
+	require.Empty(t, iterate("bx:", []byte("bx:\xff")))
+	// a key sorting before the prefix does not widen the range
+	require.Equal(t, []string{"bx:b", "bx:b\xff"}, iterate("bx:b", []byte("bw:z")))
+
+	// no matches
+	require.Empty(t, iterate("bz:", nil))
+}
+
 func CustomTestCreatablesCrud(t *customT) {
 	aow, err := t.db.MakeAccountsOptimizedWriter(true, true, false, true)
 	require.NoError(t, err)
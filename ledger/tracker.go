@@ -314,6 +314,12 @@ type deferredCommitContext struct {
 	stats       telemetryspec.AccountsUpdateMetrics
 	updateStats bool
 
+	// historyDeltas holds the changes of the committed rounds recorded by the history index
+	historyDeltas [][]historyRecord
+
+	// activityDeltas holds the records of the committed rounds for the transaction activity index
+	activityDeltas [][]activityRecord
+
 	spVerification struct {
 		// state proof verification deletion information
 		lastDeleteIndex           int
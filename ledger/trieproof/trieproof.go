@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package trieproof builds and verifies the keys and Merkle inclusion proofs of ledger entries in the catchpoint
+// merkle trie. A proof only shows that an entry is in the trie with a given root hash, so the root has to be checked
+// with VerifyCatchpoint against a catchpoint label obtained from a trusted source before the entry can be trusted.
+package trieproof
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/avm-abi/apps"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrCatchpointMismatch is returned when a catchpoint label does not commit to a trie root.
+var ErrCatchpointMismatch = errors.New("catchpoint label does not match the trie root")
+
+// Catchpoint holds the parts of a catchpoint label, other than its round and the balances trie root, that the label
+// hash is computed from.
+type Catchpoint struct {
+	// BlockHash is the hash of the block of the catchpoint round.
+	BlockHash crypto.Digest
+	// Totals are the account totals as of the accounts round of the catchpoint.
+	Totals ledgercore.AccountTotals
+	// StateProofVerificationHash is the hash of the state proof verification data, zero for catchpoints that
+	// predate it.
+	StateProofVerificationHash crypto.Digest
+	// OnlineAccountsHash and OnlineRoundParamsHash are the hashes of the online accounts and round params
+	// history, zero for catchpoints that predate them.
+	OnlineAccountsHash    crypto.Digest
+	OnlineRoundParamsHash crypto.Digest
+}
+
+// VerifyCatchpoint checks that the catchpoint label commits to the balances trie root, given the other parts of the
+// label. Proofs against the root can be trusted as much as the label is.
+func VerifyCatchpoint(label string, root crypto.Digest, cp Catchpoint) error {
+	round, _, err := ledgercore.ParseCatchpointLabel(label)
+	if err != nil {
+		return err
+	}
+	// the label version depends on the consensus protocol of the catchpoint round, so try each of them: the
+	// label hash can only be reproduced from the parts it was computed from.
+	makers := []ledgercore.CatchpointLabelMaker{
+		ledgercore.MakeCatchpointLabelMakerCurrent(round, &cp.BlockHash, &root, cp.Totals, &cp.StateProofVerificationHash, &cp.OnlineAccountsHash, &cp.OnlineRoundParamsHash),
+		ledgercore.MakeCatchpointLabelMakerV7(round, &cp.BlockHash, &root, cp.Totals, &cp.StateProofVerificationHash),
+		ledgercore.MakeCatchpointLabelMakerV6(round, &cp.BlockHash, &root, cp.Totals),
+	}
+	for _, maker := range makers {
+		if ledgercore.MakeLabel(maker) == label {
+			return nil
+		}
+	}
+	return ErrCatchpointMismatch
+}
+
+// AccountKey returns the trie key of an account, given the msgpack encoding of its trackerdb.BaseAccountData.
+func AccountKey(addr basics.Address, entry []byte) ([]byte, trackerdb.BaseAccountData, error) {
+	var data trackerdb.BaseAccountData
+	err := protocol.Decode(entry, &data)
+	if err != nil {
+		return nil, trackerdb.BaseAccountData{}, err
+	}
+	return trackerdb.AccountHashBuilderV6(addr, &data, entry), data, nil
+}
+
+// ResourceKey returns the trie key of an asset or application resource of an account, given the msgpack encoding
+// of its trackerdb.ResourcesData.
+func ResourceKey(addr basics.Address, cidx basics.CreatableIndex, entry []byte) ([]byte, trackerdb.ResourcesData, error) {
+	var data trackerdb.ResourcesData
+	err := protocol.Decode(entry, &data)
+	if err != nil {
+		return nil, trackerdb.ResourcesData{}, err
+	}
+	key, err := trackerdb.ResourcesHashBuilderV6(&data, addr, cidx, data.UpdateRound, entry)
+	if err != nil {
+		return nil, trackerdb.ResourcesData{}, err
+	}
+	return key, data, nil
+}
+
+// BoxKey returns the trie key of an application box, given its value.
+func BoxKey(appIdx basics.AppIndex, name string, value []byte) []byte {
+	return trackerdb.KvHashBuilderV6(apps.MakeBoxKey(uint64(appIdx), name), value)
+}
+
+// VerifyAccount checks that proof proves the account entry against the trie root, and returns the account data.
+func VerifyAccount(root crypto.Digest, addr basics.Address, entry []byte, proof []byte) (ledgercore.AccountData, error) {
+	key, data, err := AccountKey(addr, entry)
+	if err != nil {
+		return ledgercore.AccountData{}, fmt.Errorf("unable to decode account entry: %w", err)
+	}
+	err = verify(root, key, proof)
+	if err != nil {
+		return ledgercore.AccountData{}, err
+	}
+	return data.GetLedgerCoreAccountData(), nil
+}
+
+// VerifyResource checks that proof proves the resource entry against the trie root, and returns the asset or
+// application params and holding of the account.
+func VerifyResource(root crypto.Digest, addr basics.Address, cidx basics.CreatableIndex, entry []byte, proof []byte) (ledgercore.AccountResource, error) {
+	key, data, err := ResourceKey(addr, cidx, entry)
+	if err != nil {
+		return ledgercore.AccountResource{}, fmt.Errorf("unable to decode resource entry: %w", err)
+	}
+	err = verify(root, key, proof)
+	if err != nil {
+		return ledgercore.AccountResource{}, err
+	}
+	prd := trackerdb.PersistedResourcesData{Aidx: cidx, Data: data}
+	return prd.AccountResource(), nil
+}
+
+// VerifyBox checks that proof proves the box value against the trie root.
+func VerifyBox(root crypto.Digest, appIdx basics.AppIndex, name string, value []byte, proof []byte) error {
+	return verify(root, BoxKey(appIdx, name, value), proof)
+}
+
+func verify(root crypto.Digest, key []byte, proof []byte) error {
+	p, err := merkletrie.DeserializeProof(proof)
+	if err != nil {
+		return err
+	}
+	return merkletrie.VerifyProof(root, key, p)
+}
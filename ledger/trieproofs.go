@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/avm-abi/apps"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/trieproof"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrTrieProofsUnavailable is returned when proving a ledger entry while the trie of the last catchpoint is not kept,
+// or no catchpoint was made of it yet.
+var ErrTrieProofsUnavailable = errors.New("catchpoint merkle trie is not available")
+
+// ErrTrieEntryNotFound is returned when proving a ledger entry that does not exist.
+var ErrTrieEntryNotFound = errors.New("ledger entry not found")
+
+// TrieProof is a Merkle inclusion proof of a ledger entry in the catchpoint merkle trie of the last catchpoint. It
+// can be verified with the trieproof package, checking the root against a trusted catchpoint label first.
+//
+//msgp:ignore TrieProof
+type TrieProof struct {
+	// Round is the accounts round of the catchpoint, that the entry is proven at.
+	Round basics.Round
+	// Root is the root hash of the trie at Round, the balances root of the catchpoint.
+	Root crypto.Digest
+	// Entry is the msgpack encoded account or resource data, or the box value, that the trie key is built from.
+	Entry []byte
+	// Proof is the serialized merkletrie.Proof of the trie key.
+	Proof []byte
+	// Label is the label of the catchpoint.
+	Label string
+	// Catchpoint holds the other parts of the catchpoint label, to check that it commits to Root.
+	Catchpoint trieproof.Catchpoint
+}
+
+// ProveAccount returns the proof of the base data of an account, excluding its assets and applications.
+func (l *Ledger) ProveAccount(addr basics.Address) (TrieProof, error) {
+	lookup := func(ar trackerdb.AccountsReader) ([]byte, error) {
+		pad, err := ar.LookupAccount(addr)
+		if err != nil || pad.Ref == nil {
+			return nil, err
+		}
+		return protocol.Encode(&pad.AccountData), nil
+	}
+	return l.catchpoint.proveTrieEntry(trieSnapshotAccountKey(addr), lookup, func(entry []byte) ([]byte, error) {
+		key, _, err := trieproof.AccountKey(addr, entry)
+		return key, err
+	})
+}
+
+// ProveResource returns the proof of the params and holding of an asset or application by an account.
+func (l *Ledger) ProveResource(addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) (TrieProof, error) {
+	lookup := func(ar trackerdb.AccountsReader) ([]byte, error) {
+		prd, err := ar.LookupResources(addr, cidx, ctype)
+		if err != nil || prd.AcctRef == nil {
+			return nil, err
+		}
+		return protocol.Encode(&prd.Data), nil
+	}
+	return l.catchpoint.proveTrieEntry(trieSnapshotResourceKey(addr, cidx), lookup, func(entry []byte) ([]byte, error) {
+		key, data, err := trieproof.ResourceKey(addr, cidx, entry)
+		if err != nil {
+			return nil, err
+		}
+		if (ctype == basics.AssetCreatable && !data.IsAsset()) || (ctype == basics.AppCreatable && !data.IsApp()) {
+			return nil, ErrTrieEntryNotFound
+		}
+		return key, nil
+	})
+}
+
+// ProveBox returns the proof of the value of an application box.
+func (l *Ledger) ProveBox(appIdx basics.AppIndex, name string) (TrieProof, error) {
+	key := apps.MakeBoxKey(uint64(appIdx), name)
+	lookup := func(ar trackerdb.AccountsReader) ([]byte, error) {
+		pv, err := ar.LookupKeyValue(key)
+		return pv.Value, err
+	}
+	return l.catchpoint.proveTrieEntry(trieSnapshotKvKey(key), lookup, func(entry []byte) ([]byte, error) {
+		return trieproof.BoxKey(appIdx, name, entry), nil
+	})
+}
+
+// proveTrieEntry proves a ledger entry against the trie of the last catchpoint. The entry is the original value
+// recorded in the trie snapshot under snapshotKey if it changed since the accounts round of the catchpoint, or the
+// one read by lookup, which returns nil if it does not exist, otherwise. trieKey returns the trie key of the entry.
+func (ct *catchpointTracker) proveTrieEntry(snapshotKey []byte, lookup func(ar trackerdb.AccountsReader) ([]byte, error), trieKey func(entry []byte) ([]byte, error)) (tp TrieProof, err error) {
+	if !ct.trieSnapshotEnabled() {
+		return TrieProof{}, ErrTrieProofsUnavailable
+	}
+	err = ct.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		arExt, err0 := tx.MakeAccountsReader()
+		if err0 != nil {
+			return err0
+		}
+		dbRound, err0 := arExt.AccountsRound()
+		if err0 != nil {
+			return err0
+		}
+		hashRound, err0 := arExt.AccountsHashRound(ctx)
+		if err0 != nil {
+			return err0
+		}
+		if hashRound != dbRound {
+			// the trie is being rebuilt
+			return ErrTrieProofsUnavailable
+		}
+
+		// the trie snapshot is read after the tracker database: being committed ahead of it, it holds the
+		// original values of everything that changed in the tracker database up to dbRound.
+		return ct.trieSnapshot.read(ctx, func(stx *sql.Tx, meta trieSnapshotMeta) error {
+			if meta.round > dbRound {
+				// the snapshot was started by a commit the tracker database did not complete yet
+				return ErrTrieProofsUnavailable
+			}
+
+			entry, changed, err1 := lookupTrieSnapshotEntry(ctx, stx, snapshotKey)
+			if err1 != nil {
+				return err1
+			}
+			if !changed {
+				ar, err1 := tx.MakeAccountsOptimizedReader()
+				if err1 != nil {
+					return err1
+				}
+				defer ar.Close()
+				entry, err1 = lookup(ar)
+				if err1 != nil {
+					return err1
+				}
+			}
+			if entry == nil {
+				return ErrTrieEntryNotFound
+			}
+			key, err1 := trieKey(entry)
+			if err1 != nil {
+				return err1
+			}
+
+			mr, err1 := tx.MakeMerkleReader()
+			if err1 != nil {
+				return err1
+			}
+			sr, err1 := makeTrieSnapshotReader(ctx, stx, mr)
+			if err1 != nil {
+				return err1
+			}
+			defer sr.close()
+			trie, err1 := merkletrie.MakeTrie(sr, trackerdb.TrieMemoryConfig)
+			if err1 != nil {
+				return err1
+			}
+			root, err1 := trie.RootHash()
+			if err1 != nil {
+				return err1
+			}
+			if root != meta.root {
+				return fmt.Errorf("catchpoint merkle trie snapshot of round %d has root %v rather than %v", meta.round, root, meta.root)
+			}
+			proof, found, err1 := trie.Prove(key)
+			if err1 != nil {
+				return err1
+			}
+			if !found {
+				return fmt.Errorf("ledger entry at round %d is missing from the catchpoint merkle trie", meta.round)
+			}
+			tp = TrieProof{
+				Round:      meta.round,
+				Root:       root,
+				Entry:      entry,
+				Proof:      proof.Serialize(),
+				Label:      meta.label,
+				Catchpoint: meta.catchpoint,
+			}
+			return nil
+		})
+	})
+	return tp, err
+}
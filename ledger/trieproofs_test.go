@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/ledger/trieproof"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTrieProofs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// make catchpoints quickly
+	testProtocolVersion := protocol.ConsensusVersion("test-protocol-TestTrieProofs")
+	protoParams := config.Consensus[protocol.ConsensusFuture]
+	protoParams.CatchpointLookback = 16
+	config.Consensus[testProtocolVersion] = protoParams
+	defer delete(config.Consensus, testProtocolVersion)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointInterval = 64
+	cfg.CatchpointTracking = 1 // track catchpoints without storing catchpoint files
+	cfg.EnableLedgerEntryProofs = true
+	dl := NewDoubleLedger(t, genBalances, testProtocolVersion, cfg)
+	defer dl.Close()
+	l := dl.validator
+
+	boxApp := dl.fundedApp(addrs[1], 1_000_000, boxAppSource)
+	makeBox := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[2],
+		ApplicationID: boxApp,
+		Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("xxx")}},
+	}
+	dl.txn(makeBox.Args("create", "xxx"))
+	dl.txn(makeBox.Args("set", "xxx", "before"))
+
+	_, err := l.ProveAccount(addrs[3])
+	require.ErrorIs(t, err, ErrTrieProofsUnavailable)
+
+	pay := txntest.Txn{
+		Type:     "pay",
+		Sender:   addrs[0],
+		Receiver: addrs[3],
+		Amount:   100000,
+	}
+	// the balance of addrs[3] as of every round
+	balances := make(map[basics.Round]basics.MicroAlgos)
+	for i := 0; l.GetLastCatchpointLabel() == ""; i++ {
+		require.Less(t, i, 200, "no catchpoint was made")
+		dl.fullBlock(pay.Noted(strconv.Itoa(i)))
+		ad, _, err := l.LookupWithoutRewards(l.Latest(), addrs[3])
+		require.NoError(t, err)
+		balances[l.Latest()] = ad.MicroAlgos
+		l.WaitForCommit(l.Latest())
+		triggerTrackerFlush(t, l)
+	}
+	label := l.GetLastCatchpointLabel()
+
+	// account
+	tp, err := l.ProveAccount(addrs[3])
+	require.NoError(t, err)
+	require.Equal(t, label, tp.Label)
+	require.Less(t, tp.Round, l.trackers.getDbRound())
+	require.NoError(t, trieproof.VerifyCatchpoint(label, tp.Root, tp.Catchpoint))
+	ad, err := trieproof.VerifyAccount(tp.Root, addrs[3], tp.Entry, tp.Proof)
+	require.NoError(t, err)
+	require.Equal(t, balances[tp.Round], ad.MicroAlgos)
+	require.NotEqual(t, balances[l.trackers.getDbRound()], ad.MicroAlgos)
+	_, err = trieproof.VerifyAccount(tp.Root, addrs[4], tp.Entry, tp.Proof)
+	require.ErrorIs(t, err, merkletrie.ErrProofVerificationFailure)
+
+	// the root is only trusted through a catchpoint label
+	forged := tp.Catchpoint
+	forged.Totals.Online.Money.Raw++
+	require.ErrorIs(t, trieproof.VerifyCatchpoint(label, tp.Root, forged), trieproof.ErrCatchpointMismatch)
+	require.ErrorIs(t, trieproof.VerifyCatchpoint(label, crypto.Hash([]byte("forged")), tp.Catchpoint), trieproof.ErrCatchpointMismatch)
+
+	// entries that change after the catchpoint are still proven as of its accounts round
+	newAcct := ledgertesting.RandomAddress()
+	dl.txn(makeBox.Args("set", "xxx", "after!"))
+	payNew := pay
+	payNew.Receiver = newAcct
+	dl.fullBlock(&payNew)
+	for i := 0; i < 10; i++ {
+		dl.fullBlock(pay.Noted("after" + strconv.Itoa(i)))
+	}
+	l.WaitForCommit(l.Latest())
+	triggerTrackerFlush(t, l)
+	require.Equal(t, label, l.GetLastCatchpointLabel())
+
+	tp2, err := l.ProveAccount(addrs[3])
+	require.NoError(t, err)
+	require.Equal(t, tp, tp2)
+
+	_, err = l.ProveAccount(newAcct)
+	require.ErrorIs(t, err, ErrTrieEntryNotFound)
+
+	// resource
+	tp, err = l.ProveResource(addrs[1], basics.CreatableIndex(boxApp), basics.AppCreatable)
+	require.NoError(t, err)
+	ar, err := trieproof.VerifyResource(tp.Root, addrs[1], basics.CreatableIndex(boxApp), tp.Entry, tp.Proof)
+	require.NoError(t, err)
+	require.NotNil(t, ar.AppParams)
+	require.Nil(t, ar.AppLocalState)
+	expectedApp, err := l.LookupApplication(l.Latest(), addrs[1], boxApp)
+	require.NoError(t, err)
+	require.Equal(t, expectedApp.AppParams.ApprovalProgram, ar.AppParams.ApprovalProgram)
+
+	// box
+	tp, err = l.ProveBox(boxApp, "xxx")
+	require.NoError(t, err)
+	require.Equal(t, "before", string(tp.Entry[:6]))
+	require.NoError(t, trieproof.VerifyBox(tp.Root, boxApp, "xxx", tp.Entry, tp.Proof))
+	require.ErrorIs(t, trieproof.VerifyBox(tp.Root, boxApp, "xxx", []byte("forged"), tp.Proof), merkletrie.ErrProofVerificationFailure)
+	require.ErrorIs(t, trieproof.VerifyBox(tp.Root, boxApp, "yyy", tp.Entry, tp.Proof), merkletrie.ErrProofVerificationFailure)
+
+	// missing entries
+	_, err = l.ProveAccount(ledgertesting.RandomAddress())
+	require.ErrorIs(t, err, ErrTrieEntryNotFound)
+	_, err = l.ProveResource(addrs[2], basics.CreatableIndex(boxApp), basics.AppCreatable)
+	require.ErrorIs(t, err, ErrTrieEntryNotFound)
+	_, err = l.ProveBox(boxApp, "yyy")
+	require.ErrorIs(t, err, ErrTrieEntryNotFound)
+}
+
+func TestTrieProofsUnavailable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	for _, tracking := range []int64{-1, 1} {
+		cfg := config.GetDefaultLocal()
+		cfg.CatchpointInterval = 100
+		cfg.CatchpointTracking = tracking
+		// proofs are only served when the trie of the last catchpoint is kept, and catchpoints are tracked
+		cfg.EnableLedgerEntryProofs = tracking < 0
+		l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusFuture, cfg)
+		_, err := l.ProveAccount(addrs[0])
+		require.ErrorIs(t, err, ErrTrieProofsUnavailable)
+		l.Close()
+	}
+}
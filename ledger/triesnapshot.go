@@ -0,0 +1,374 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/trieproof"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var trieSnapshotSchema = []string{
+	// a single row describing the snapshot, if there is one
+	`CREATE TABLE IF NOT EXISTS triesnapshot (
+		id INTEGER PRIMARY KEY,
+		rnd INTEGER NOT NULL,
+		root BLOB NOT NULL,
+		synced INTEGER NOT NULL,
+		label TEXT,
+		catchpoint BLOB)`,
+	// data is the content of a trie page at the snapshot round, or NULL if the page did not exist
+	`CREATE TABLE IF NOT EXISTS triesnapshotpages (
+		id INTEGER PRIMARY KEY,
+		data BLOB)`,
+	// value is the trie entry at the snapshot round, or NULL if it did not exist
+	`CREATE TABLE IF NOT EXISTS triesnapshotentries (
+		key BLOB PRIMARY KEY,
+		value BLOB) WITHOUT ROWID`,
+}
+
+// trieSnapshot keeps the catchpoint merkle trie, and the accounts, resources and boxes it is built from, as of the
+// accounts round of the last catchpoint, so that they can be proven against the balances root its label commits to.
+// Rather than a copy of the trie, it holds the original content of the trie pages and entries that changed since
+// that round, which the catchpointTracker records as it commits rounds. Everything else is read from the tracker
+// database.
+//
+// The snapshot is kept in its own database, committed ahead of the tracker database like the history index: an
+// original value recorded by a commit the tracker database did not complete is still the current value there.
+type trieSnapshot struct {
+	enabled bool
+	dbPath  string
+	dbMem   bool
+
+	log logging.Logger
+
+	dbs db.Pair
+}
+
+// trieSnapshotMeta describes a trie snapshot.
+type trieSnapshotMeta struct {
+	// round is the accounts round of the snapshot
+	round basics.Round
+	// root is the root hash of the trie at round
+	root crypto.Digest
+	// synced is the last tracker database round whose changes were recorded
+	synced basics.Round
+	// label is the label of the catchpoint made of the snapshot, empty until it is made
+	label      string
+	catchpoint trieproof.Catchpoint
+}
+
+// trieSnapshotEntry is the original value of a trie entry.
+type trieSnapshotEntry struct {
+	key     []byte
+	value   []byte
+	present bool
+}
+
+func (ts *trieSnapshot) initialize(cfg config.Local, dirs DirsAndPrefix, dbMem bool) {
+	ts.enabled = cfg.EnableLedgerEntryProofs
+	ts.dbPath = filepath.Join(dirs.ResolvedGenesisDirs.TrackerGenesisDir, dirs.DBFilePrefix) + ".trie.sqlite"
+	ts.dbMem = dbMem
+}
+
+// loadFromDisk opens the snapshot database, and drops the snapshot unless the original values of everything the
+// tracker database committed since the snapshot round were recorded.
+func (ts *trieSnapshot) loadFromDisk(log logging.Logger, dbRound basics.Round, trieRebuilt bool) (err error) {
+	ts.log = log
+	ts.dbs, err = db.OpenPair(ts.dbPath, ts.dbMem)
+	if err != nil {
+		return fmt.Errorf("unable to open trie snapshot database %s: %w", ts.dbPath, err)
+	}
+	ts.dbs.Rdb.SetLogger(ts.log)
+	ts.dbs.Wdb.SetLogger(ts.log)
+
+	return ts.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range trieSnapshotSchema {
+			_, err0 := tx.ExecContext(ctx, stmt)
+			if err0 != nil {
+				return err0
+			}
+		}
+
+		meta, found, err0 := readTrieSnapshotMeta(ctx, tx)
+		if err0 != nil {
+			return err0
+		}
+		// e.g. after a catchpoint catchup, or when the trie was rebuilt, the snapshot is dropped until the
+		// next catchpoint.
+		if !found || (!trieRebuilt && meta.round <= dbRound && dbRound <= meta.synced) {
+			return nil
+		}
+		ts.log.Infof("trieSnapshot.loadFromDisk dropping the catchpoint merkle trie snapshot of round %d synced to round %d at round %d", meta.round, meta.synced, dbRound)
+		return clearTrieSnapshot(ctx, tx)
+	})
+}
+
+func (ts *trieSnapshot) close() {
+	ts.dbs.Close()
+}
+
+func readTrieSnapshotMeta(ctx context.Context, tx *sql.Tx) (meta trieSnapshotMeta, found bool, err error) {
+	var root []byte
+	var label sql.NullString
+	var catchpoint []byte
+	err = tx.QueryRowContext(ctx, "SELECT rnd, root, synced, label, catchpoint FROM triesnapshot WHERE id = 0").Scan(&meta.round, &root, &meta.synced, &label, &catchpoint)
+	if errors.Is(err, sql.ErrNoRows) {
+		return trieSnapshotMeta{}, false, nil
+	}
+	if err != nil {
+		return trieSnapshotMeta{}, false, err
+	}
+	copy(meta.root[:], root)
+	meta.label = label.String
+	if catchpoint != nil {
+		err = protocol.DecodeReflect(catchpoint, &meta.catchpoint)
+		if err != nil {
+			return trieSnapshotMeta{}, false, err
+		}
+	}
+	return meta, true, nil
+}
+
+func clearTrieSnapshot(ctx context.Context, tx *sql.Tx) error {
+	for _, table := range []string{"triesnapshot", "triesnapshotpages", "triesnapshotentries"} {
+		_, err := tx.ExecContext(ctx, "DELETE FROM "+table)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reset starts a new snapshot at round, whose changes were just committed to the trie.
+func (ts *trieSnapshot) reset(ctx context.Context, round basics.Round, root crypto.Digest) error {
+	return ts.dbs.Wdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		err := clearTrieSnapshot(ctx, tx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "INSERT INTO triesnapshot (id, rnd, root, synced) VALUES (0, ?, ?, ?)", round, root[:], round)
+		return err
+	})
+}
+
+// setCatchpoint records the catchpoint made of the snapshot, if the snapshot is of its accounts round.
+func (ts *trieSnapshot) setCatchpoint(ctx context.Context, accountsRound basics.Round, root crypto.Digest, label string, cp trieproof.Catchpoint) error {
+	return ts.dbs.Wdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "UPDATE triesnapshot SET label = ?, catchpoint = ? WHERE id = 0 AND rnd = ? AND root = ?",
+			label, protocol.EncodeReflect(&cp), accountsRound, root[:])
+		return err
+	})
+}
+
+// makeRecorder returns a committer recording the original content of the pages mc stores, or nil if there is no
+// snapshot to record them for.
+func (ts *trieSnapshot) makeRecorder(ctx context.Context, mc trackerdb.MerkleCommitter) (*trieSnapshotRecorder, error) {
+	var found bool
+	err := ts.dbs.Rdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) (err error) {
+		_, found, err = readTrieSnapshotMeta(ctx, tx)
+		return err
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+	return &trieSnapshotRecorder{MerkleCommitter: mc, ts: ts, checked: make(map[uint64]bool)}, nil
+}
+
+// read runs fn within a read transaction of the snapshot database, if there is a snapshot a catchpoint was made of.
+func (ts *trieSnapshot) read(ctx context.Context, fn func(tx *sql.Tx, meta trieSnapshotMeta) error) error {
+	return ts.dbs.Rdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		meta, found, err := readTrieSnapshotMeta(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if !found || meta.label == "" {
+			return ErrTrieProofsUnavailable
+		}
+		return fn(tx, meta)
+	})
+}
+
+// lookupTrieSnapshotEntry returns the original value of a trie entry that changed since the snapshot round, and
+// whether it changed. The value is nil if the entry did not exist.
+func lookupTrieSnapshotEntry(ctx context.Context, tx *sql.Tx, key []byte) (value []byte, changed bool, err error) {
+	var absent bool
+	err = tx.QueryRowContext(ctx, "SELECT value IS NULL, value FROM triesnapshotentries WHERE key = ?", key).Scan(&absent, &value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if absent {
+		return nil, true, nil
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	return value, true, nil
+}
+
+func trieSnapshotAccountKey(addr basics.Address) []byte {
+	return append([]byte{'a'}, addr[:]...)
+}
+
+func trieSnapshotResourceKey(addr basics.Address, cidx basics.CreatableIndex) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{'r'}, addr[:]...), uint64(cidx))
+}
+
+func trieSnapshotKvKey(key string) []byte {
+	return append([]byte{'k'}, key...)
+}
+
+// trieSnapshotRecorder is the MerkleCommitter of a commit, recording the original content of the pages that change
+// for the first time since the snapshot round, along with the original value of the entries that change.
+type trieSnapshotRecorder struct {
+	trackerdb.MerkleCommitter
+
+	ts *trieSnapshot
+
+	// checked holds the pages already stored by the commit
+	checked map[uint64]bool
+	pages   map[uint64][]byte
+	entries []trieSnapshotEntry
+}
+
+// StorePage records the content page has in the tracker database, unless it was recorded since the snapshot round,
+// before storing it.
+func (r *trieSnapshotRecorder) StorePage(page uint64, content []byte) error {
+	if !r.checked[page] {
+		r.checked[page] = true
+		var exists int
+		err := r.ts.dbs.Rdb.Handle.QueryRow("SELECT 1 FROM triesnapshotpages WHERE id = ?", page).Scan(&exists)
+		if errors.Is(err, sql.ErrNoRows) {
+			var original []byte
+			original, err = r.MerkleCommitter.LoadPage(page)
+			if err != nil {
+				return err
+			}
+			if r.pages == nil {
+				r.pages = make(map[uint64][]byte)
+			}
+			r.pages[page] = original
+		} else if err != nil {
+			return err
+		}
+	}
+	return r.MerkleCommitter.StorePage(page, content)
+}
+
+// recordEntries records the values the entries changed by the commit had in the tracker database.
+func (r *trieSnapshotRecorder) recordEntries(accountsDeltas compactAccountDeltas, resourcesDeltas compactResourcesDeltas, kvDeltas map[string]modifiedKvValue) {
+	for i := 0; i < accountsDeltas.len(); i++ {
+		delta := accountsDeltas.getByIdx(i)
+		entry := trieSnapshotEntry{key: trieSnapshotAccountKey(delta.address)}
+		if !delta.oldAcct.AccountData.IsEmpty() {
+			entry.value, entry.present = protocol.Encode(&delta.oldAcct.AccountData), true
+		}
+		r.entries = append(r.entries, entry)
+	}
+	for i := 0; i < resourcesDeltas.len(); i++ {
+		resDelta := resourcesDeltas.getByIdx(i)
+		entry := trieSnapshotEntry{key: trieSnapshotResourceKey(resDelta.address, resDelta.oldResource.Aidx)}
+		if !resDelta.oldResource.Data.IsEmpty() {
+			entry.value, entry.present = protocol.Encode(&resDelta.oldResource.Data), true
+		}
+		r.entries = append(r.entries, entry)
+	}
+	for key, mv := range kvDeltas {
+		r.entries = append(r.entries, trieSnapshotEntry{key: trieSnapshotKvKey(key), value: mv.oldData, present: mv.oldData != nil})
+	}
+}
+
+// flush writes what the commit recorded to the snapshot database, keeping the values recorded by earlier commits.
+func (r *trieSnapshotRecorder) flush(ctx context.Context, synced basics.Round) error {
+	return r.ts.dbs.Wdb.AtomicContext(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		pageStmt, err := tx.PrepareContext(ctx, "INSERT OR IGNORE INTO triesnapshotpages (id, data) VALUES (?, ?)")
+		if err != nil {
+			return err
+		}
+		defer pageStmt.Close()
+		for page, original := range r.pages {
+			_, err = pageStmt.ExecContext(ctx, page, original)
+			if err != nil {
+				return err
+			}
+		}
+
+		entryStmt, err := tx.PrepareContext(ctx, "INSERT OR IGNORE INTO triesnapshotentries (key, value) VALUES (?, ?)")
+		if err != nil {
+			return err
+		}
+		defer entryStmt.Close()
+		for _, entry := range r.entries {
+			var value interface{}
+			if entry.present {
+				// a zero-length blob rather than NULL for empty values
+				value = append([]byte{}, entry.value...)
+			}
+			_, err = entryStmt.ExecContext(ctx, entry.key, value)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE triesnapshot SET synced = ? WHERE id = 0", synced)
+		return err
+	})
+}
+
+// trieSnapshotReader is a read-only MerkleCommitter loading the trie pages at the snapshot round: the original
+// content recorded for the pages that changed since, and the content in the tracker database otherwise.
+type trieSnapshotReader struct {
+	trackerdb.MerkleCommitter
+
+	selectStmt *sql.Stmt
+}
+
+func makeTrieSnapshotReader(ctx context.Context, tx *sql.Tx, mr trackerdb.MerkleCommitter) (*trieSnapshotReader, error) {
+	stmt, err := tx.PrepareContext(ctx, "SELECT data FROM triesnapshotpages WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	return &trieSnapshotReader{MerkleCommitter: mr, selectStmt: stmt}, nil
+}
+
+// LoadPage is the merkletrie.Committer interface implementation.
+func (r *trieSnapshotReader) LoadPage(page uint64) (content []byte, err error) {
+	err = r.selectStmt.QueryRow(page).Scan(&content)
+	if errors.Is(err, sql.ErrNoRows) {
+		return r.MerkleCommitter.LoadPage(page)
+	}
+	return content, err
+}
+
+func (r *trieSnapshotReader) close() {
+	r.selectStmt.Close()
+}
@@ -689,6 +689,33 @@ func (c *Client) AccountAssetsInformation(account string, next *string, limit *u
 	return
 }
 
+// AccountCreatedAssetsInformation returns the assets created by an account.
+func (c *Client) AccountCreatedAssetsInformation(account string, next *string, limit *uint64) (resp model.AccountCreatedAssetsInformationResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountCreatedAssetsInformation(account, next, limit)
+	}
+	return
+}
+
+// AccountCreatedApplicationsInformation returns the applications created by an account.
+func (c *Client) AccountCreatedApplicationsInformation(account string, next *string, limit *uint64) (resp model.AccountCreatedApplicationsInformationResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountCreatedApplicationsInformation(account, next, limit)
+	}
+	return
+}
+
+// AccountApplicationsInformation returns the local states of the applications an account is opted in to.
+func (c *Client) AccountApplicationsInformation(account string, next *string, limit *uint64) (resp model.AccountApplicationsInformationResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountApplicationsInformation(account, next, limit)
+	}
+	return
+}
+
 // AccountApplicationInformation gets account information about a given app.
 func (c *Client) AccountApplicationInformation(accountAddress string, applicationID uint64) (resp model.AccountApplicationResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -798,6 +825,16 @@ func (c *Client) ApplicationBoxes(appID uint64, maxBoxNum uint64) (resp model.Bo
 	return
 }
 
+// ApplicationBoxesPage takes an app's index and returns a page of the boxes under it whose names start with prefix,
+// in the order of their names, optionally with their values. See RestClient.ApplicationBoxesPage.
+func (c *Client) ApplicationBoxesPage(appID uint64, prefix string, next string, limit uint64, values bool) (resp model.BoxesResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.ApplicationBoxesPage(appID, prefix, next, limit, values)
+	}
+	return
+}
+
 // GetApplicationBoxByName takes an app's index and box name and returns its value.
 // The box name should be of the form `encoding:value`. See apps.AppCallBytes for more information.
 func (c *Client) GetApplicationBoxByName(index uint64, name string) (resp model.BoxResponse, err error) {
@@ -808,6 +845,25 @@ func (c *Client) GetApplicationBoxByName(index uint64, name string) (resp model.
 	return
 }
 
+// GetApplicationBoxProof takes an app's index and box name and returns a proof of its value.
+// The box name should be of the form `encoding:value`. See apps.AppCallBytes for more information.
+func (c *Client) GetApplicationBoxProof(index uint64, name string) (resp model.LedgerEntryProofResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.GetApplicationBoxProof(index, name)
+	}
+	return
+}
+
+// AccountProof returns a proof of an account, or of one of its asset or application resources.
+func (c *Client) AccountProof(account string, assetID, applicationID *uint64) (resp model.LedgerEntryProofResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.AccountProof(account, assetID, applicationID)
+	}
+	return
+}
+
 // PendingTransactionInformation returns information about a recently issued
 // transaction based on its txid.
 func (c *Client) PendingTransactionInformation(txid string) (resp model.PendingTransactionResponse, err error) {
@@ -874,6 +930,15 @@ func (c *Client) HealthCheck() error {
 	return algod.HealthCheck()
 }
 
+// HealthChecks returns the result of each of the node's health checks
+func (c *Client) HealthChecks() (resp model.HealthChecksResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.HealthChecks()
+	}
+	return
+}
+
 // WaitForRound takes a round, waits up to one minute, for it to appear and
 // returns the node status. This function blocks and fails if the block does not
 // appear in one minute.
@@ -1080,6 +1145,15 @@ func (c *Client) GetParticipationKeys() (resp model.ParticipationKeysResponse, e
 	return
 }
 
+// GetAgreementTimeline gets the agreement timelines of the most recent rounds, or of a single round if round is not nil.
+func (c *Client) GetAgreementTimeline(round *uint64) (resp model.AgreementTimelineResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetAgreementTimeline(round)
+	}
+	return
+}
+
 // GetParticipationKeyByID looks up a specific participation key by its participationID.
 func (c *Client) GetParticipationKeyByID(id string) (resp model.ParticipationKeyResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -1346,6 +1420,42 @@ func (c *Client) GetSyncRound() (rep model.GetSyncRoundResponse, err error) {
 	return
 }
 
+// ListSyncCursors lists the named sync cursors on a node w/ EnableFollowMode
+func (c *Client) ListSyncCursors() (rep model.SyncCursorsResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.ListSyncCursors()
+	}
+	return
+}
+
+// RegisterSyncCursor registers a named sync cursor on a node w/ EnableFollowMode
+func (c *Client) RegisterSyncCursor(name string, round uint64) (err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.RegisterSyncCursor(name, round)
+	}
+	return
+}
+
+// AdvanceSyncCursor moves a named sync cursor forward on a node w/ EnableFollowMode
+func (c *Client) AdvanceSyncCursor(name string, round uint64) (err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.AdvanceSyncCursor(name, round)
+	}
+	return
+}
+
+// DeleteSyncCursor removes a named sync cursor on a node w/ EnableFollowMode
+func (c *Client) DeleteSyncCursor(name string) (err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.DeleteSyncCursor(name)
+	}
+	return
+}
+
 // GetLedgerStateDelta gets the LedgerStateDelta on a node w/ EnableFollowMode
 func (c *Client) GetLedgerStateDelta(round uint64) (rep ledgercore.StateDelta, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -236,3 +236,54 @@ func (c *Client) ExportMasterDerivationKey(wh []byte, pw []byte) (mdk crypto.Mas
 	// Return the mdk from the response
 	return resp.MasterDerivationKey, nil
 }
+
+// BackupWallet returns an encrypted backup of the given wallet, protected by backupPw
+func (c *Client) BackupWallet(wh []byte, pw []byte, backupPw []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Back up the wallet
+	resp, err := kmd.BackupWallet(wh, pw, backupPw)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Backup, nil
+}
+
+// RestoreWallet creates a wallet from a backup made by BackupWallet, returning
+// its ID. The wallet keeps its original name if name is empty.
+func (c *Client) RestoreWallet(backup []byte, backupPw []byte, name []byte, pw []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore the wallet
+	resp, err := kmd.RestoreWallet(backup, backupPw, name, pw)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Wallet.ID), nil
+}
+
+// ListAudit returns the entries of the kmd audit log matching the passed
+// filters, and a description of where the log was tampered with, if it was.
+// Empty filters match every entry.
+func (c *Client) ListAudit(walletID []byte, address string, op string, afterSeq uint64, limit uint64) (entries []kmdapi.APIV1AuditEntry, chainErr string, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	// Query the audit log
+	resp, err := kmd.ListAudit(walletID, address, op, afterSeq, limit)
+	if err != nil {
+		return
+	}
+
+	return resp.Entries, resp.ChainError, nil
+}
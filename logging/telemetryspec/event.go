@@ -135,6 +135,21 @@ type PartKeyRegisteredEventDetails struct {
 	LastValid  uint64
 }
 
+// ParticipationAlertEvent event
+const ParticipationAlertEvent Event = "ParticipationAlert"
+
+// ParticipationAlertClearedEvent event
+const ParticipationAlertClearedEvent Event = "ParticipationAlertCleared"
+
+// ParticipationAlertEventDetails contains details for the ParticipationAlertEvent and ParticipationAlertClearedEvent
+type ParticipationAlertEventDetails struct {
+	Address         string
+	Alert           string
+	Round           uint64
+	ParticipationID string
+	Message         string
+}
+
 // BlockProposedEvent event
 const BlockProposedEvent Event = "BlockProposed"
 
@@ -0,0 +1,188 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// bandwidthPriority is the outgoing bandwidth priority class of a message tag.
+// Lower values have higher priority.
+type bandwidthPriority int
+
+const (
+	// bandwidthPriorityAgreement messages, as well as any message not classified otherwise, are never throttled
+	// but still consume the outgoing budget.
+	bandwidthPriorityAgreement bandwidthPriority = iota
+	// bandwidthPriorityProposal messages are throttled once the outgoing budget is exhausted.
+	bandwidthPriorityProposal
+	// bandwidthPriorityTxn messages are throttled once the outgoing budget falls to the transaction reserve.
+	bandwidthPriorityTxn
+)
+
+// tagBandwidthPriority returns the bandwidth priority class of the given tag
+func tagBandwidthPriority(tag protocol.Tag) bandwidthPriority {
+	switch tag {
+	case protocol.ProposalPayloadTag:
+		return bandwidthPriorityProposal
+	case protocol.TxnTag:
+		return bandwidthPriorityTxn
+	default:
+		return bandwidthPriorityAgreement
+	}
+}
+
+// tokenBucket is a byte budget refilled at a constant rate up to its burst size.
+// The balance may go negative when a message larger than the remaining budget is allowed through.
+type tokenBucket struct {
+	rate   float64 // bytes per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func makeTokenBucket(rate uint64, now time.Time) *tokenBucket {
+	// allow one second worth of traffic to accumulate
+	return &tokenBucket{rate: float64(rate), burst: float64(rate), tokens: float64(rate), last: now}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+}
+
+// bandwidthShaper enforces the outgoing bandwidth budget shared by all the peers of a network.
+// A global bucket limits the overall outgoing traffic, and optional per-tag buckets limit proposals and transactions.
+// A nil *bandwidthShaper allows everything.
+type bandwidthShaper struct {
+	mu deadlock.Mutex
+
+	// global is nil when there is no global cap
+	global *tokenBucket
+	// txnReserve is the global budget level below which transaction messages are throttled
+	txnReserve float64
+	// tags holds the per-tag buckets for the capped tags
+	tags map[protocol.Tag]*tokenBucket
+
+	// clock is replaceable for testing
+	clock func() time.Time
+}
+
+// makeBandwidthShaper creates a bandwidthShaper from the config, or returns nil if no outgoing bandwidth cap is set
+func makeBandwidthShaper(cfg config.Local) *bandwidthShaper {
+	if cfg.OutgoingBandwidthLimit == 0 && cfg.OutgoingProposalBandwidthLimit == 0 && cfg.OutgoingTxnBandwidthLimit == 0 {
+		return nil
+	}
+	s := &bandwidthShaper{
+		tags:  make(map[protocol.Tag]*tokenBucket),
+		clock: time.Now,
+	}
+	now := s.clock()
+	if cfg.OutgoingBandwidthLimit > 0 {
+		s.global = makeTokenBucket(cfg.OutgoingBandwidthLimit, now)
+		reservePercent := cfg.OutgoingTxnBandwidthReservePercent
+		if reservePercent > 100 {
+			reservePercent = 100
+		}
+		s.txnReserve = s.global.burst * float64(reservePercent) / 100
+	}
+	if cfg.OutgoingProposalBandwidthLimit > 0 {
+		s.tags[protocol.ProposalPayloadTag] = makeTokenBucket(cfg.OutgoingProposalBandwidthLimit, now)
+	}
+	if cfg.OutgoingTxnBandwidthLimit > 0 {
+		s.tags[protocol.TxnTag] = makeTokenBucket(cfg.OutgoingTxnBandwidthLimit, now)
+	}
+	return s
+}
+
+// allow reports whether a message of the given tag and size may be sent now, and charges the budget if so.
+// Throttled messages are counted in the outgoing throttled metrics and should be dropped by the caller.
+func (s *bandwidthShaper) allow(tag protocol.Tag, size int) bool {
+	if s == nil {
+		return true
+	}
+	if !s.take(tag, float64(size)) {
+		networkOutgoingThrottledBytesTotal.AddUint64(uint64(size), nil)
+		networkOutgoingThrottledBytesByTag.Add(string(tag), uint64(size))
+		networkOutgoingThrottledMessagesByTag.Add(string(tag), 1)
+		return false
+	}
+	return true
+}
+
+func (s *bandwidthShaper) take(tag protocol.Tag, size float64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock()
+	if s.global != nil {
+		s.global.refill(now)
+	}
+	tb := s.tags[tag]
+	if tb != nil {
+		tb.refill(now)
+	}
+
+	switch tagBandwidthPriority(tag) {
+	case bandwidthPriorityProposal:
+		if s.global != nil && s.global.tokens <= 0 {
+			return false
+		}
+	case bandwidthPriorityTxn:
+		if s.global != nil && s.global.tokens <= s.txnReserve {
+			return false
+		}
+	}
+	// a message is let through as long as there is some budget left, so that messages larger
+	// than the burst size can still be sent; the debt is paid back before the next one.
+	if tb != nil && tb.tokens <= 0 {
+		return false
+	}
+
+	if s.global != nil {
+		s.global.tokens -= size
+	}
+	if tb != nil {
+		tb.tokens -= size
+	}
+	return true
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestBandwidthShaper(t *testing.T, cfg config.Local) (*bandwidthShaper, *time.Time) {
+	s := makeBandwidthShaper(cfg)
+	require.NotNil(t, s)
+	now := time.Now()
+	s.clock = func() time.Time { return now }
+	for _, b := range s.tags {
+		b.last = now
+	}
+	if s.global != nil {
+		s.global.last = now
+	}
+	return s, &now
+}
+
+func TestBandwidthShaperDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	s := makeBandwidthShaper(cfg)
+	require.Nil(t, s)
+	require.True(t, s.allow(protocol.TxnTag, 1<<20))
+}
+
+func TestBandwidthShaperPriorities(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.OutgoingBandwidthLimit = 1000
+	cfg.OutgoingTxnBandwidthReservePercent = 50
+	s, now := makeTestBandwidthShaper(t, cfg)
+
+	// transactions may only use the budget above the reserve
+	require.True(t, s.allow(protocol.TxnTag, 400))
+	require.True(t, s.allow(protocol.TxnTag, 400))
+	require.False(t, s.allow(protocol.TxnTag, 100))
+
+	// proposals may use the rest of the budget
+	require.True(t, s.allow(protocol.ProposalPayloadTag, 150))
+	require.True(t, s.allow(protocol.ProposalPayloadTag, 100))
+	require.False(t, s.allow(protocol.ProposalPayloadTag, 100))
+
+	// votes always go through, and their debt throttles everything else
+	require.True(t, s.allow(protocol.AgreementVoteTag, 500))
+	require.True(t, s.allow(protocol.VoteBundleTag, 500))
+	require.True(t, s.allow(protocol.TopicMsgRespTag, 500))
+
+	*now = now.Add(time.Second)
+	require.False(t, s.allow(protocol.ProposalPayloadTag, 1))
+	*now = now.Add(time.Second)
+	require.True(t, s.allow(protocol.ProposalPayloadTag, 1))
+	require.False(t, s.allow(protocol.TxnTag, 1))
+
+	// the bucket never accumulates more than a second worth of budget
+	*now = now.Add(time.Hour)
+	require.True(t, s.allow(protocol.TxnTag, 500))
+	require.False(t, s.allow(protocol.TxnTag, 1))
+}
+
+func TestBandwidthShaperTagCaps(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.OutgoingTxnBandwidthLimit = 100
+	s, now := makeTestBandwidthShaper(t, cfg)
+	require.Nil(t, s.global)
+
+	// a message larger than the budget is let through when there is budget left, and paid back afterwards
+	require.True(t, s.allow(protocol.TxnTag, 250))
+	require.False(t, s.allow(protocol.TxnTag, 1))
+	*now = now.Add(time.Second)
+	require.False(t, s.allow(protocol.TxnTag, 1))
+	*now = now.Add(time.Second)
+	require.True(t, s.allow(protocol.TxnTag, 1))
+
+	// other tags are not capped
+	for i := 0; i < 10; i++ {
+		require.True(t, s.allow(protocol.ProposalPayloadTag, 1000))
+	}
+}
+
+func TestBandwidthShaperWsPeerDrop(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.OutgoingTxnBandwidthLimit = 10
+	s, _ := makeTestBandwidthShaper(t, cfg)
+
+	conn := &mockSendConn{}
+	wp := &wsPeer{conn: conn, bwShaper: s, sendMessageTag: defaultSendMessageTags}
+	txMsg := sendMessage{data: append([]byte(protocol.TxnTag), make([]byte, 20)...), enqueued: time.Now(), peerEnqueued: time.Now()}
+	require.Equal(t, disconnectReasonNone, wp.writeLoopSendMsg(txMsg))
+	require.Equal(t, disconnectReasonNone, wp.writeLoopSendMsg(txMsg))
+	require.Equal(t, 1, conn.sent)
+
+	voteMsg := sendMessage{data: append([]byte(protocol.AgreementVoteTag), make([]byte, 20)...), enqueued: time.Now(), peerEnqueued: time.Now()}
+	require.Equal(t, disconnectReasonNone, wp.writeLoopSendMsg(voteMsg))
+	require.Equal(t, 2, conn.sent)
+}
+
+type mockSendConn struct {
+	nopConn
+	sent int
+}
+
+func (c *mockSendConn) WriteMessage(int, []byte) error {
+	c.sent++
+	return nil
+}
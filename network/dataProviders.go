@@ -0,0 +1,220 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/network/p2p"
+)
+
+// DataProviderInfo is an optional NodeInfo extension describing the blocks and catchpoints the node serves.
+// When implemented, the P2P network advertises them through the DHT so that catching up nodes can find this node.
+type DataProviderInfo interface {
+	ProvidedData() p2p.ProvidedData
+}
+
+// DataProviderFinder is implemented by networks able to locate the peers advertising specific blocks or catchpoints.
+// The returned peers are ordered by preference and may be empty when no provider is known.
+type DataProviderFinder interface {
+	BlockProviders(round basics.Round) []Peer
+	CatchpointProviders(label string) []Peer
+	// BlockProviderFailed reports that a peer returned by BlockProviders(round) could not serve the block,
+	// so that it is not returned again for that round until the providers are looked up anew.
+	BlockProviderFailed(round basics.Round, peer Peer)
+	// CatchpointProviderFailed reports that a peer returned by CatchpointProviders(label) could not serve the catchpoint.
+	CatchpointProviderFailed(label string, peer Peer)
+}
+
+const (
+	// dataProvidersToFind is the maximum number of providers looked up for a single block range or catchpoint
+	dataProvidersToFind = 5
+	// dataProvidersCacheDuration is how long a provider lookup result is reused, including empty results
+	dataProvidersCacheDuration = time.Minute
+)
+
+type dataProvidersCacheEntry struct {
+	peers   []Peer
+	expires time.Time
+}
+
+// dataProvidersCache caches DHT provider lookups so that fetching consecutive rounds does not query the DHT every time
+type dataProvidersCache struct {
+	mu      deadlock.Mutex
+	entries map[string]dataProvidersCacheEntry
+
+	// lookups runs a single lookup per key at a time, without holding mu, so that concurrent fetches
+	// of the same data share a DHT query while fetches of other data are not held up by it
+	lookups singleflight.Group
+}
+
+// get returns the cached peers for key, or calls lookup and caches its result.
+// The returned slice must not be modified.
+func (c *dataProvidersCache) get(key string, lookup func() []Peer) []Peer {
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && time.Now().Before(e.expires) {
+		c.mu.Unlock()
+		return e.peers
+	}
+	c.mu.Unlock()
+
+	peers, _, _ := c.lookups.Do(key, func() (interface{}, error) {
+		peers := lookup()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		now := time.Now()
+		if c.entries == nil {
+			c.entries = make(map[string]dataProvidersCacheEntry)
+		}
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.entries[key] = dataProvidersCacheEntry{peers: peers, expires: now.Add(dataProvidersCacheDuration)}
+		return peers, nil
+	})
+	return peers.([]Peer)
+}
+
+// evict removes a peer that failed to serve the data of key from the cached peers for key
+func (c *dataProvidersCache) evict(key string, peer Peer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	// the cached slice may be in use by callers of get, so it is copied rather than modified
+	peers := make([]Peer, 0, len(e.peers))
+	for _, p := range e.peers {
+		if p != peer {
+			peers = append(peers, p)
+		}
+	}
+	e.peers = peers
+	c.entries[key] = e
+}
+
+func blockProvidersKey(round basics.Round) string {
+	return fmt.Sprintf("b%d", uint64(round)/p2p.BlockProviderBucketSize)
+}
+
+func catchpointProvidersKey(label string) string {
+	return "c" + label
+}
+
+// BlockProviders returns the peers advertising the blocks range containing round through the DHT,
+// followed by the archival nodes found in the DHT.
+func (n *P2PNetwork) BlockProviders(round basics.Round) []Peer {
+	if n.capabilitiesDiscovery == nil {
+		return nil
+	}
+	return n.dataProviders.get(blockProvidersKey(round), func() []Peer {
+		infos, err := n.capabilitiesDiscovery.PeersForBlock(uint64(round), dataProvidersToFind)
+		if err != nil {
+			n.log.Debugf("Error getting block providers for round %d from capabilities discovery: %v", round, err)
+		}
+		archival, err := n.capabilitiesDiscovery.PeersForCapability(p2p.Archival, dataProvidersToFind)
+		if err != nil {
+			n.log.Debugf("Error getting archival nodes from capabilities discovery: %v", err)
+		}
+		return n.addrInfosToPeers(append(infos, archival...))
+	})
+}
+
+// CatchpointProviders returns the peers advertising the given catchpoint label through the DHT.
+func (n *P2PNetwork) CatchpointProviders(label string) []Peer {
+	if n.capabilitiesDiscovery == nil {
+		return nil
+	}
+	return n.dataProviders.get(catchpointProvidersKey(label), func() []Peer {
+		infos, err := n.capabilitiesDiscovery.PeersForCatchpoint(label, dataProvidersToFind)
+		if err != nil {
+			n.log.Debugf("Error getting providers for catchpoint %s from capabilities discovery: %v", label, err)
+		}
+		return n.addrInfosToPeers(infos)
+	})
+}
+
+// BlockProviderFailed implements DataProviderFinder
+func (n *P2PNetwork) BlockProviderFailed(round basics.Round, peer Peer) {
+	n.dataProviders.evict(blockProvidersKey(round), peer)
+}
+
+// CatchpointProviderFailed implements DataProviderFinder
+func (n *P2PNetwork) CatchpointProviderFailed(label string, peer Peer) {
+	n.dataProviders.evict(catchpointProvidersKey(label), peer)
+}
+
+// addrInfosToPeers converts DHT lookup results into HTTP capable peers, skipping duplicates
+func (n *P2PNetwork) addrInfosToPeers(infos []peer.AddrInfo) []Peer {
+	var peers []Peer
+	seen := make(map[peer.ID]struct{}, len(infos))
+	for i := range infos {
+		if _, ok := seen[infos[i].ID]; ok {
+			continue
+		}
+		seen[infos[i].ID] = struct{}{}
+		if peerCore, ok := addrInfoToWsPeerCore(n, &infos[i]); ok {
+			peers = append(peers, &peerCore)
+		}
+	}
+	return peers
+}
+
+// BlockProviders implements DataProviderFinder
+func (n *HybridP2PNetwork) BlockProviders(round basics.Round) []Peer {
+	return n.p2pNetwork.BlockProviders(round)
+}
+
+// CatchpointProviders implements DataProviderFinder
+func (n *HybridP2PNetwork) CatchpointProviders(label string) []Peer {
+	return n.p2pNetwork.CatchpointProviders(label)
+}
+
+// BlockProviderFailed implements DataProviderFinder
+func (n *HybridP2PNetwork) BlockProviderFailed(round basics.Round, peer Peer) {
+	n.p2pNetwork.BlockProviderFailed(round, peer)
+}
+
+// CatchpointProviderFailed implements DataProviderFinder
+func (n *HybridP2PNetwork) CatchpointProviderFailed(label string, peer Peer) {
+	n.p2pNetwork.CatchpointProviderFailed(label, peer)
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDataProvidersCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var c dataProvidersCache
+	p1, p2 := &wsPeerCore{rootURL: "p1"}, &wsPeerCore{rootURL: "p2"}
+
+	// concurrent fetches of the same data share a lookup, which does not hold up other lookups
+	release := make(chan struct{})
+	var lookups atomic.Int32
+	slowLookup := func() []Peer {
+		lookups.Add(1)
+		<-release
+		return []Peer{p1, p2}
+	}
+	var wg sync.WaitGroup
+	results := make([][]Peer, 4)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = c.get("b1", slowLookup)
+		}(i)
+	}
+	require.Eventually(t, func() bool { return lookups.Load() == 1 }, time.Second, time.Millisecond)
+	require.Equal(t, []Peer{p2}, c.get("b2", func() []Peer { return []Peer{p2} }))
+	close(release)
+	wg.Wait()
+	require.EqualValues(t, 1, lookups.Load())
+	for _, peers := range results {
+		require.Equal(t, []Peer{p1, p2}, peers)
+	}
+
+	// cached results are reused
+	require.Equal(t, []Peer{p1, p2}, c.get("b1", func() []Peer {
+		require.Fail(t, "unexpected lookup")
+		return nil
+	}))
+
+	// failed providers are evicted for their key only, without changing the results handed out already
+	held := c.get("b1", nil)
+	c.evict("b1", p1)
+	require.Equal(t, []Peer{p2}, c.get("b1", nil))
+	require.Equal(t, []Peer{p1, p2}, held)
+	c.evict("b2", p1)
+	require.Equal(t, []Peer{p2}, c.get("b2", nil))
+	c.evict("b3", p1)
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network/addr"
+	"github.com/algorand/go-algorand/network/p2p"
 	"github.com/algorand/go-algorand/protocol"
 )
 
@@ -63,20 +64,34 @@ func NewHybridP2PNetwork(log logging.Logger, cfg config.Local, datadir string, p
 	p2pcfg := cfg
 	p2pcfg.NetAddress = cfg.P2PHybridNetAddress
 	p2pcfg.IncomingConnectionsLimit = cfg.P2PHybridIncomingConnectionsLimit
+	// both networks share the same allowlist in private network mode
+	var allowlist *p2p.PeerAllowlist
+	if cfg.PeerAllowlistFile != "" {
+		var err error
+		allowlist, err = p2p.LoadPeerAllowlist(cfg.PeerAllowlistFile)
+		if err != nil {
+			return nil, err
+		}
+	}
 	identityTracker := NewIdentityTracker()
-	p2pnet, err := NewP2PNetwork(log, p2pcfg, datadir, phonebookAddresses, genesisID, networkID, nodeInfo, &identityOpts{tracker: identityTracker})
+	p2pnet, err := NewP2PNetwork(log, p2pcfg, datadir, phonebookAddresses, genesisID, networkID, nodeInfo, &identityOpts{tracker: identityTracker, allowlist: allowlist})
 	if err != nil {
 		return nil, err
 	}
 
 	identOpts := identityOpts{
-		tracker: identityTracker,
-		scheme:  NewIdentityChallengeScheme(NetIdentityDedupNames(cfg.PublicAddress, p2pnet.PeerID().String()), NetIdentitySigner(p2pnet.PeerIDSigner())),
+		tracker:   identityTracker,
+		scheme:    NewIdentityChallengeScheme(NetIdentityDedupNames(cfg.PublicAddress, p2pnet.PeerID().String()), NetIdentitySigner(p2pnet.PeerIDSigner())),
+		allowlist: allowlist,
 	}
 	wsnet, err := NewWebsocketNetwork(log, cfg, phonebookAddresses, genesisID, networkID, nodeInfo, &identOpts)
 	if err != nil {
 		return nil, err
 	}
+	// both networks share the same uplink, so they share the same outgoing bandwidth budget
+	bwShaper := makeBandwidthShaper(cfg)
+	p2pnet.bwShaper = bwShaper
+	wsnet.bwShaper = bwShaper
 	return &HybridP2PNetwork{
 		p2pNetwork: p2pnet,
 		wsNetwork:  wsnet,
@@ -93,6 +108,11 @@ func (n *HybridP2PNetwork) Address() (string, bool) {
 	return n.wsNetwork.Address()
 }
 
+// P2PReachability implements ReachabilityReporter
+func (n *HybridP2PNetwork) P2PReachability() string {
+	return n.p2pNetwork.P2PReachability()
+}
+
 type hybridNetworkError struct{ p2pErr, wsErr error }
 
 func (e *hybridNetworkError) Error() string {
@@ -59,6 +59,9 @@ func init() {
 	networkP2PReceivedBytesByTag = metrics.NewTagCounterFiltered("algod_network_p2p_received_bytes_{TAG}", "Number of bytes that were received from the network for {TAG} messages", tagStringList, "UNK")
 	networkP2PMessageReceivedByTag = metrics.NewTagCounterFiltered("algod_network_p2p_message_received_{TAG}", "Number of complete messages that were received from the network for {TAG} messages", tagStringList, "UNK")
 	networkP2PMessageSentByTag = metrics.NewTagCounterFiltered("algod_network_p2p_message_sent_{TAG}", "Number of complete messages that were sent to the network for {TAG} messages", tagStringList, "UNK")
+
+	networkOutgoingThrottledBytesByTag = metrics.NewTagCounterFiltered("algod_network_outgoing_throttled_bytes_{TAG}", "Number of outgoing bytes dropped by the bandwidth shaper for {TAG} messages", tagStringList, "UNK")
+	networkOutgoingThrottledMessagesByTag = metrics.NewTagCounterFiltered("algod_network_outgoing_throttled_messages_{TAG}", "Number of outgoing messages dropped by the bandwidth shaper for {TAG} messages", tagStringList, "UNK")
 }
 
 var networkSentBytesTotal = metrics.MakeCounter(metrics.NetworkSentBytesTotal)
@@ -79,6 +82,10 @@ var networkP2PMessageSentTotal = metrics.MakeCounter(metrics.NetworkP2PMessageSe
 var networkMessageSentByTag *metrics.TagCounter
 var networkP2PMessageSentByTag *metrics.TagCounter
 
+var networkOutgoingThrottledBytesTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_outgoing_throttled_bytes_total", Description: "Total number of outgoing bytes dropped by the bandwidth shaper"})
+var networkOutgoingThrottledBytesByTag *metrics.TagCounter
+var networkOutgoingThrottledMessagesByTag *metrics.TagCounter
+
 var networkHandleMicrosByTag *metrics.TagCounter
 var networkHandleCountByTag *metrics.TagCounter
 
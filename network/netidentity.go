@@ -38,6 +38,7 @@ import (
 	"net/http"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/network/p2p"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-deadlock"
 )
@@ -120,6 +121,8 @@ type identityChallengeSigner interface {
 type identityOpts struct {
 	scheme  identityChallengeScheme
 	tracker identityTracker
+	// allowlist is set when running in private network mode, see peerAllowlist.go
+	allowlist *p2p.PeerAllowlist
 }
 
 type identityChallengeLegacySigner struct {
@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	algocrypto "github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-deadlock"
+
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// PeerAllowlist is a set of peer identities permitted to connect to a node running in private network mode.
+// Identities are libp2p peer IDs derived from ed25519 keys, so the same list applies to P2P peers
+// and to websocket peers authenticated with identity challenges signed by their P2P private key.
+// The list is loaded from a file with one peer ID per line; empty lines and lines starting with '#' are ignored.
+type PeerAllowlist struct {
+	path string
+
+	mu      deadlock.RWMutex
+	ids     map[peer.ID]struct{}
+	modTime time.Time
+	size    int64
+}
+
+// LoadPeerAllowlist reads a peer allowlist file
+func LoadPeerAllowlist(path string) (*PeerAllowlist, error) {
+	a := &PeerAllowlist{path: path}
+	if _, err := a.Reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Reload re-reads the allowlist file if it was modified since the last load.
+// It returns true if the list was reloaded. On error the previously loaded list is kept.
+func (a *PeerAllowlist) Reload() (bool, error) {
+	fi, err := os.Stat(a.path)
+	if err != nil {
+		return false, fmt.Errorf("unable to stat peer allowlist %s: %w", a.path, err)
+	}
+	a.mu.RLock()
+	unchanged := a.ids != nil && fi.ModTime().Equal(a.modTime) && fi.Size() == a.size
+	a.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(a.path)
+	if err != nil {
+		return false, fmt.Errorf("unable to read peer allowlist %s: %w", a.path, err)
+	}
+	ids, err := parsePeerAllowlist(data)
+	if err != nil {
+		return false, fmt.Errorf("invalid peer allowlist %s: %w", a.path, err)
+	}
+
+	a.mu.Lock()
+	a.ids = ids
+	a.modTime = fi.ModTime()
+	a.size = fi.Size()
+	a.mu.Unlock()
+	return true, nil
+}
+
+func parsePeerAllowlist(data []byte) (map[peer.ID]struct{}, error) {
+	ids := make(map[peer.ID]struct{})
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, err := peer.Decode(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		ids[id] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Len returns the number of allowed peer identities
+func (a *PeerAllowlist) Len() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return len(a.ids)
+}
+
+// Allowed returns true if the peer ID is in the allowlist
+func (a *PeerAllowlist) Allowed(id peer.ID) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	_, ok := a.ids[id]
+	return ok
+}
+
+// AllowedPublicKey returns true if the peer ID derived from the ed25519 public key is in the allowlist
+func (a *PeerAllowlist) AllowedPublicKey(pk algocrypto.PublicKey) bool {
+	if pk == (algocrypto.PublicKey{}) {
+		return false
+	}
+	pubKey, err := crypto.UnmarshalEd25519PublicKey(pk[:])
+	if err != nil {
+		return false
+	}
+	id, err := peer.IDFromPublicKey(pubKey)
+	if err != nil {
+		return false
+	}
+	return a.Allowed(id)
+}
+
+// allowlistGater implements connmgr.ConnectionGater by refusing connections to and from peers not in the allowlist
+type allowlistGater struct {
+	allowlist *PeerAllowlist
+}
+
+var _ connmgr.ConnectionGater = allowlistGater{}
+
+// InterceptPeerDial implements connmgr.ConnectionGater
+func (g allowlistGater) InterceptPeerDial(p peer.ID) bool {
+	return g.allowlist.Allowed(p)
+}
+
+// InterceptAddrDial implements connmgr.ConnectionGater
+func (g allowlistGater) InterceptAddrDial(p peer.ID, _ multiaddr.Multiaddr) bool {
+	return g.allowlist.Allowed(p)
+}
+
+// InterceptAccept implements connmgr.ConnectionGater.
+// The remote peer ID is not known until the security handshake completes, so the check is done in InterceptSecured.
+func (g allowlistGater) InterceptAccept(network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured implements connmgr.ConnectionGater
+func (g allowlistGater) InterceptSecured(_ network.Direction, p peer.ID, _ network.ConnMultiaddrs) bool {
+	return g.allowlist.Allowed(p)
+}
+
+// InterceptUpgraded implements connmgr.ConnectionGater
+func (g allowlistGater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	algocrypto "github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestPeerID(t *testing.T) (peer.ID, *PeerIDChallengeSigner) {
+	privKey, err := generatePrivKey()
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(privKey)
+	require.NoError(t, err)
+	return id, MakePeerIDChallengeSigner(privKey)
+}
+
+func TestPeerAllowlistLoadReload(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	idA, signerA := makeTestPeerID(t)
+	idB, signerB := makeTestPeerID(t)
+
+	path := filepath.Join(t.TempDir(), "allowlist")
+	err := os.WriteFile(path, []byte("# consortium members\n\n"+idA.String()+"\n"), 0600)
+	require.NoError(t, err)
+
+	allowlist, err := LoadPeerAllowlist(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, allowlist.Len())
+	require.True(t, allowlist.Allowed(idA))
+	require.False(t, allowlist.Allowed(idB))
+	require.True(t, allowlist.AllowedPublicKey(signerA.PublicKey()))
+	require.False(t, allowlist.AllowedPublicKey(signerB.PublicKey()))
+	require.False(t, allowlist.AllowedPublicKey(algocrypto.PublicKey{}))
+
+	// unchanged file is not reloaded
+	changed, err := allowlist.Reload()
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	err = os.WriteFile(path, []byte(idB.String()+"\n"), 0600)
+	require.NoError(t, err)
+	// make sure the modification time differs on filesystems with coarse timestamps
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, future, future))
+	changed, err = allowlist.Reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.False(t, allowlist.Allowed(idA))
+	require.True(t, allowlist.Allowed(idB))
+
+	// invalid content keeps the previous list
+	err = os.WriteFile(path, []byte("not-a-peer-id\n"), 0600)
+	require.NoError(t, err)
+	future = future.Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, future, future))
+	_, err = allowlist.Reload()
+	require.ErrorContains(t, err, "line 1")
+	require.True(t, allowlist.Allowed(idB))
+
+	_, err = LoadPeerAllowlist(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestPeerAllowlistGater(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	idA, _ := makeTestPeerID(t)
+	idB, _ := makeTestPeerID(t)
+	path := filepath.Join(t.TempDir(), "allowlist")
+	err := os.WriteFile(path, []byte(idA.String()), 0600)
+	require.NoError(t, err)
+	allowlist, err := LoadPeerAllowlist(path)
+	require.NoError(t, err)
+
+	gater := allowlistGater{allowlist: allowlist}
+	require.True(t, gater.InterceptPeerDial(idA))
+	require.False(t, gater.InterceptPeerDial(idB))
+	require.True(t, gater.InterceptSecured(network.DirInbound, idA, nil))
+	require.False(t, gater.InterceptSecured(network.DirInbound, idB, nil))
+	require.False(t, gater.InterceptAddrDial(idB, nil))
+}
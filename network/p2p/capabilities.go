@@ -104,12 +104,17 @@ func (c *CapabilitiesDiscovery) addPeer(p peer.AddrInfo) (bool, error) { //nolin
 // Since CapabilitiesDiscovery uses a backoffcache, it will attempt to hit cache, then disk, then network
 // in order to fetch n peers which are advertising the required capability.
 func (c *CapabilitiesDiscovery) PeersForCapability(capability Capability, n int) ([]peer.AddrInfo, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	return c.peersForNamespace(string(capability), n, operationTimeout)
+}
+
+// peersForNamespace returns up to n peers advertising the given namespace, waiting at most timeout for them
+func (c *CapabilitiesDiscovery) peersForNamespace(ns string, n int, timeout time.Duration) ([]peer.AddrInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 	var peers []peer.AddrInfo
 	// +1 because it can include self but we exclude self from the returned list
 	// that might confuse the caller (and tests assertions)
-	peersChan, err := c.findPeers(ctx, string(capability), discovery.Limit(n+1))
+	peersChan, err := c.findPeers(ctx, ns, discovery.Limit(n+1))
 	if err != nil {
 		return nil, err
 	}
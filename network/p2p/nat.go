@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/host/autorelay"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/network/p2p/peerstore"
+	"github.com/algorand/go-algorand/network/phonebook"
+)
+
+// natTraversalOptions returns libp2p options enabling circuit relay v2 service,
+// AutoNAT service, auto relay and hole punching according to the configuration.
+// AutoNAT client is always enabled by libp2p so the reachability is known regardless of these settings.
+// The options must be applied after libp2p.NoListenAddrs.
+func natTraversalOptions(cfg config.Local, pstore *peerstore.PeerStore) []libp2p.Option {
+	var opts []libp2p.Option
+	if cfg.P2PEnableCircuitRelayService {
+		opts = append(opts, libp2p.EnableRelayService(), libp2p.EnableNATService())
+	}
+	if cfg.P2PEnableNATTraversal {
+		// the relay transport is disabled by libp2p.NoListenAddrs so re-enable it for dialing and listening through relays
+		opts = append(opts,
+			libp2p.EnableRelay(),
+			libp2p.EnableAutoRelayWithPeerSource(relayPeerSource(pstore)),
+			libp2p.EnableHolePunching(),
+		)
+	}
+	return opts
+}
+
+// relayPeerSource returns an autorelay.PeerSource offering relays from the phonebook as circuit relay candidates
+func relayPeerSource(pstore *peerstore.PeerStore) autorelay.PeerSource {
+	return func(ctx context.Context, num int) <-chan peer.AddrInfo {
+		addrInfos := pstore.GetAddresses(num, phonebook.PhoneBookEntryRelayRole)
+		out := make(chan peer.AddrInfo, len(addrInfos))
+		for _, info := range addrInfos {
+			out <- *info
+		}
+		close(out)
+		return out
+	}
+}
+
+// reachabilityString converts libp2p reachability status into a string reported by the REST API
+func reachabilityString(r network.Reachability) string {
+	switch r {
+	case network.ReachabilityPublic:
+		return "public"
+	case network.ReachabilityPrivate:
+		return "private"
+	default:
+		return "unknown"
+	}
+}
+
+// trackReachability subscribes to AutoNAT reachability changes and stores the latest status in the service
+func (s *serviceImpl) trackReachability(h host.Host) error {
+	sub, err := h.EventBus().Subscribe(new(event.EvtLocalReachabilityChanged))
+	if err != nil {
+		return err
+	}
+	s.reachabilitySub = sub
+	go func() {
+		for e := range sub.Out() {
+			evt := e.(event.EvtLocalReachabilityChanged)
+			prev := network.Reachability(s.reachability.Swap(int32(evt.Reachability)))
+			if prev != evt.Reachability {
+				s.log.Infof("p2p reachability changed to %s", reachabilityString(evt.Reachability))
+			}
+		}
+	}()
+	return nil
+}
+
+// Reachability returns the node reachability as determined by AutoNAT: "public", "private" or "unknown"
+func (s *serviceImpl) Reachability() string {
+	return reachabilityString(network.Reachability(s.reachability.Load()))
+}
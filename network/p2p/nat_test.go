@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/host/eventbus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/p2p/peerstore"
+	"github.com/algorand/go-algorand/network/phonebook"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestNATTraversalOptions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	pstore, err := peerstore.NewPeerStore(nil, "test")
+	require.NoError(t, err)
+
+	cfg := config.GetDefaultLocal()
+	require.Empty(t, natTraversalOptions(cfg, pstore))
+
+	cfg.P2PEnableCircuitRelayService = true
+	require.Len(t, natTraversalOptions(cfg, pstore), 2)
+
+	cfg.P2PEnableCircuitRelayService = false
+	cfg.P2PEnableNATTraversal = true
+	require.Len(t, natTraversalOptions(cfg, pstore), 3)
+
+	// the options must be accepted by libp2p
+	cfg.P2PEnableCircuitRelayService = true
+	opts := append([]libp2p.Option{libp2p.NoListenAddrs}, natTraversalOptions(cfg, pstore)...)
+	h, err := libp2p.New(opts...)
+	require.NoError(t, err)
+	h.Close()
+}
+
+func TestNATRelayPeerSource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	pstore, err := peerstore.NewPeerStore(nil, "test")
+	require.NoError(t, err)
+
+	var relays []*peer.AddrInfo
+	for _, addr := range []string{
+		"/ip4/1.2.3.4/tcp/4160/p2p/QmcgpsyWgH8Y8ajJz1Cu72KnS5uo2Aa2LpzU7kinSupNKC",
+		"/ip4/5.6.7.8/tcp/4160/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N",
+	} {
+		info, err := peer.AddrInfoFromString(addr)
+		require.NoError(t, err)
+		relays = append(relays, info)
+	}
+	pstore.AddPersistentPeers(relays, "test", phonebook.PhoneBookEntryRelayRole)
+
+	source := relayPeerSource(pstore)
+	var got []peer.ID
+	for info := range source(context.Background(), 10) {
+		got = append(got, info.ID)
+	}
+	require.ElementsMatch(t, []peer.ID{relays[0].ID, relays[1].ID}, got)
+
+	got = got[:0]
+	for info := range source(context.Background(), 1) {
+		got = append(got, info.ID)
+	}
+	require.Len(t, got, 1)
+}
+
+func TestNATReachabilityTracking(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	h, err := libp2p.New(libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	defer h.Close()
+
+	s := &serviceImpl{log: logging.TestingLog(t), host: h}
+	require.NoError(t, s.trackReachability(h))
+	defer s.reachabilitySub.Close()
+	require.Equal(t, "unknown", s.Reachability())
+
+	emitter, err := h.EventBus().Emitter(new(event.EvtLocalReachabilityChanged), eventbus.Stateful)
+	require.NoError(t, err)
+	defer emitter.Close()
+
+	require.NoError(t, emitter.Emit(event.EvtLocalReachabilityChanged{Reachability: network.ReachabilityPrivate}))
+	require.Eventually(t, func() bool { return s.Reachability() == "private" }, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, emitter.Emit(event.EvtLocalReachabilityChanged{Reachability: network.ReachabilityPublic}))
+	require.Eventually(t, func() bool { return s.Reachability() == "public" }, 5*time.Second, 10*time.Millisecond)
+}
@@ -40,6 +40,7 @@ import (
 	"net/http"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/algorand/go-algorand/config"
@@ -53,6 +54,7 @@ import (
 	"github.com/libp2p/go-libp2p"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/event"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -87,6 +89,9 @@ type Service interface {
 	Subscribe(topic string, val pubsub.ValidatorEx) (SubNextCancellable, error)
 	Publish(ctx context.Context, topic string, data []byte) error
 
+	// Reachability returns the node reachability as determined by AutoNAT: "public", "private" or "unknown"
+	Reachability() string
+
 	// GetHTTPClient returns a rate-limiting libp2p-streaming http client that can be used to make requests to the given peer
 	GetHTTPClient(addrInfo *peer.AddrInfo, connTimeStore limitcaller.ConnectionTimeStore, queueingTimeout time.Duration) (*http.Client, error)
 }
@@ -103,6 +108,9 @@ type serviceImpl struct {
 
 	topics   map[string]*pubsub.Topic
 	topicsMu deadlock.RWMutex
+
+	reachability    atomic.Int32
+	reachabilitySub event.Subscription
 }
 
 // AlgorandWsProtocol defines a libp2p protocol name for algorand's websockets messages
@@ -116,7 +124,8 @@ const dialTimeout = 30 * time.Second
 
 // MakeHost creates a libp2p host but does not start listening.
 // Use host.Network().Listen() on the returned address to start listening.
-func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.Host, string, error) {
+// If allowlist is not nil, connections to and from peers not in the allowlist are refused.
+func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore, allowlist *PeerAllowlist) (host.Host, string, error) {
 	// load stored peer ID, or make ephemeral peer ID
 	privKey, err := GetPrivKey(cfg, datadir)
 	if err != nil {
@@ -164,7 +173,7 @@ func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.
 		return nil, "", err
 	}
 
-	host, err := libp2p.New(
+	opts := []libp2p.Option{
 		libp2p.Identity(privKey),
 		libp2p.UserAgent(ua),
 		libp2p.Transport(tcp.NewTCPTransport),
@@ -175,7 +184,13 @@ func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.
 		enableMetrics,
 		libp2p.ResourceManager(rm),
 		libp2p.AddrsFactory(addrFactory),
-	)
+	}
+	if allowlist != nil {
+		opts = append(opts, libp2p.ConnectionGater(allowlistGater{allowlist: allowlist}))
+	}
+	opts = append(opts, natTraversalOptions(cfg, pstore)...)
+
+	host, err := libp2p.New(opts...)
 	return host, listenAddr, err
 }
 
@@ -213,7 +228,7 @@ func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, h ho
 	if err != nil {
 		return nil, err
 	}
-	return &serviceImpl{
+	s := &serviceImpl{
 		log:        log,
 		listenAddr: listenAddr,
 		host:       h,
@@ -222,7 +237,11 @@ func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, h ho
 		pubsubCtx:  ctx,
 		privKey:    h.Peerstore().PrivKey(h.ID()),
 		topics:     make(map[string]*pubsub.Topic),
-	}, nil
+	}
+	if err = s.trackReachability(h); err != nil {
+		return nil, err
+	}
+	return s, nil
 }
 
 // Start starts the P2P service
@@ -243,6 +262,9 @@ func (s *serviceImpl) Start() error {
 
 // Close shuts down the P2P service
 func (s *serviceImpl) Close() error {
+	if s.reachabilitySub != nil {
+		s.reachabilitySub.Close()
+	}
 	return s.host.Close()
 }
 
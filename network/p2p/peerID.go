@@ -170,6 +170,11 @@ type PeerIDChallengeSigner struct {
 	key crypto.PrivKey
 }
 
+// MakePeerIDChallengeSigner returns a PeerIDChallengeSigner for the given private key
+func MakePeerIDChallengeSigner(key crypto.PrivKey) *PeerIDChallengeSigner {
+	return &PeerIDChallengeSigner{key: key}
+}
+
 // Sign implements the identityChallengeSigner interface.
 func (p *PeerIDChallengeSigner) Sign(message algocrypto.Hashable) algocrypto.Signature {
 	return p.SignBytes(algocrypto.HashRep(message))
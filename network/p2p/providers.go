@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// BlockProviderBucketSize is the number of rounds covered by a single block range advertisement.
+const BlockProviderBucketSize = 1000
+
+// providerLookupTimeout bounds the DHT lookups for block and catchpoint providers, which happen on the catchup path.
+const providerLookupTimeout = time.Second * 2
+
+// providerAdvertisementInterval is how often the provided data is checked for new blocks or catchpoints to advertise.
+const providerAdvertisementInterval = time.Minute
+
+// ProvidedData describes the blocks and catchpoints a node serves to its peers.
+type ProvidedData struct {
+	// HasBlocks is set when the node serves the blocks from FirstRound to LastRound, inclusive.
+	HasBlocks  bool
+	FirstRound uint64
+	LastRound  uint64
+	// Catchpoints lists the labels of the catchpoints the node serves.
+	Catchpoints []string
+}
+
+// blocksNamespace returns the DHT namespace advertised by the nodes holding the blocks of the given bucket
+func blocksNamespace(bucket uint64) string {
+	return fmt.Sprintf("blocks/%d", bucket)
+}
+
+// catchpointNamespace returns the DHT namespace advertised by the nodes serving the given catchpoint
+func catchpointNamespace(label string) string {
+	return "catchpoint/" + label
+}
+
+// namespaces returns the DHT namespaces to advertise for the provided data.
+// A bucket is advertised when its first round is held, so that the bucket containing the latest round is advertised
+// as well: rounds beyond the latest one are not available from any node yet.
+func (d ProvidedData) namespaces() []string {
+	var nss []string
+	if d.HasBlocks && d.LastRound >= d.FirstRound {
+		firstBucket := (d.FirstRound + BlockProviderBucketSize - 1) / BlockProviderBucketSize
+		for bucket := firstBucket; bucket <= d.LastRound/BlockProviderBucketSize; bucket++ {
+			nss = append(nss, blocksNamespace(bucket))
+		}
+	}
+	for _, label := range d.Catchpoints {
+		nss = append(nss, catchpointNamespace(label))
+	}
+	return nss
+}
+
+// PeersForBlock returns up to n peers advertising the blocks range containing the given round.
+func (c *CapabilitiesDiscovery) PeersForBlock(round uint64, n int) ([]peer.AddrInfo, error) {
+	return c.peersForNamespace(blocksNamespace(round/BlockProviderBucketSize), n, providerLookupTimeout)
+}
+
+// PeersForCatchpoint returns up to n peers advertising the given catchpoint label.
+func (c *CapabilitiesDiscovery) PeersForCatchpoint(label string, n int) ([]peer.AddrInfo, error) {
+	return c.peersForNamespace(catchpointNamespace(label), n, providerLookupTimeout)
+}
+
+// AdvertiseProvidedData periodically advertises on the DHT the block ranges and catchpoints reported by source.
+// Each namespace is advertised as soon as it appears, and then re-advertised once its advertisement expires.
+// Namespaces that are no longer reported are not withdrawn, and expire from the DHT on their own.
+func (c *CapabilitiesDiscovery) AdvertiseProvidedData(source func() ProvidedData) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		// next advertisement time of the currently provided namespaces
+		advertised := make(map[string]time.Time)
+		ticker := time.NewTicker(providerAdvertisementInterval)
+		defer ticker.Stop()
+		for {
+			now := time.Now()
+			current := make(map[string]time.Time)
+			for _, ns := range source().namespaces() {
+				if next, ok := advertised[ns]; ok && now.Before(next) {
+					current[ns] = next
+					continue
+				}
+				ttl, err := c.advertise(c.dht.Context(), ns)
+				if err != nil {
+					// leave it out to retry on the next tick
+					c.log.Debugf("failed to advertise %s: %v", ns, err)
+					continue
+				}
+				if ttl > maxAdvertisementInterval {
+					ttl = maxAdvertisementInterval
+				}
+				current[ns] = now.Add(ttl)
+			}
+			advertised = current
+
+			select {
+			case <-c.dht.Context().Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
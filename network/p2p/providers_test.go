@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestProvidedDataNamespaces(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Empty(t, ProvidedData{}.namespaces())
+	require.Empty(t, ProvidedData{FirstRound: 1, LastRound: 5000}.namespaces())
+
+	// the bucket containing the first round is only partially held
+	data := ProvidedData{HasBlocks: true, FirstRound: 1500, LastRound: 3200, Catchpoints: []string{"3000#ABC"}}
+	require.Equal(t, []string{"blocks/2", "blocks/3", "catchpoint/3000#ABC"}, data.namespaces())
+
+	data = ProvidedData{HasBlocks: true, FirstRound: 2000, LastRound: 2000}
+	require.Equal(t, []string{"blocks/2"}, data.namespaces())
+
+	data = ProvidedData{HasBlocks: true, FirstRound: 2001, LastRound: 2999}
+	require.Empty(t, data.namespaces())
+}
+
+func TestCapabilities_ProvidedData(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	capsDisc := setupCapDiscovery(t, 3, 3)
+	provider, other, seeker := capsDisc[0], capsDisc[1], capsDisc[2]
+	for _, disc := range capsDisc {
+		waitForRouting(t, disc)
+	}
+
+	provider.AdvertiseProvidedData(func() ProvidedData {
+		return ProvidedData{HasBlocks: true, FirstRound: 1000, LastRound: 2500, Catchpoints: []string{"2000#ABC"}}
+	})
+	other.AdvertiseProvidedData(func() ProvidedData {
+		return ProvidedData{HasBlocks: true, FirstRound: 2000, LastRound: 2500}
+	})
+
+	require.Eventually(t, func() bool {
+		peers, err := seeker.PeersForBlock(1500, 5)
+		return err == nil && len(peers) == 1 && peers[0].ID == provider.Host().ID()
+	}, time.Minute, time.Second)
+	require.Eventually(t, func() bool {
+		peers, err := seeker.PeersForBlock(2400, 5)
+		return err == nil && len(peers) == 2
+	}, time.Minute, time.Second)
+	require.Eventually(t, func() bool {
+		peers, err := seeker.PeersForCatchpoint("2000#ABC", 5)
+		return err == nil && len(peers) == 1 && peers[0].ID == provider.Host().ID()
+	}, time.Minute, time.Second)
+
+	peers, err := seeker.PeersForBlock(5000, 5)
+	require.NoError(t, err)
+	require.Empty(t, peers)
+	peers, err = seeker.PeersForCatchpoint("3000#ABC", 5)
+	require.NoError(t, err)
+	require.Empty(t, peers)
+
+	for _, disc := range capsDisc {
+		require.NoError(t, disc.Close())
+	}
+}
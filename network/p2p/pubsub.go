@@ -37,6 +37,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/util/metrics"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/libp2p/go-libp2p/core/host"
@@ -74,34 +75,19 @@ const TXTopicName = "algotx01"
 
 const incomingThreads = 20 // matches to number wsNetwork workers
 
+// peerScoreInspectPeriod is how often gossipsub peer scores are exported as metrics
+const peerScoreInspectPeriod = 10 * time.Second
+
+var networkP2PGossipSubScoredPeers = metrics.MakeGauge(metrics.NetworkP2PGossipSubScoredPeers)
+var networkP2PGossipSubPeersBelowThreshold = metrics.MakeGauge(metrics.NetworkP2PGossipSubPeersBelowThreshold)
+var networkP2PGossipSubTopicMeshPeers = metrics.MakeGauge(metrics.NetworkP2PGossipSubTopicMeshPeers)
+var networkP2PGossipSubTopicInvalidDeliveries = metrics.MakeGauge(metrics.NetworkP2PGossipSubTopicInvalidDeliveries)
+
 func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTracer pubsub.RawTracer) (*pubsub.PubSub, error) {
 	//defaultParams := pubsub.DefaultGossipSubParams()
 
 	options := []pubsub.Option{
-		pubsub.WithPeerScore(&pubsub.PeerScoreParams{
-			DecayInterval: pubsub.DefaultDecayInterval,
-			DecayToZero:   pubsub.DefaultDecayToZero,
-
-			AppSpecificScore: func(p peer.ID) float64 { return 1000 },
-
-			Topics: map[string]*pubsub.TopicScoreParams{
-				TXTopicName: {
-					TopicWeight: 0.1,
-
-					TimeInMeshWeight:  0.0002778, // ~1/3600
-					TimeInMeshQuantum: time.Second,
-					TimeInMeshCap:     1,
-
-					FirstMessageDeliveriesWeight: 0.5, // max value is 50
-					FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(10 * time.Minute),
-					FirstMessageDeliveriesCap:    100, // 100 messages in 10 minutes
-
-					// invalid messages decay after 1 hour
-					InvalidMessageDeliveriesWeight: -1000,
-					InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
-				},
-			},
-		},
+		pubsub.WithPeerScore(makePeerScoreParams(cfg),
 			&pubsub.PeerScoreThresholds{
 				GossipThreshold:             gossipScoreThreshold,
 				PublishThreshold:            publishScoreThreshold,
@@ -110,6 +96,7 @@ func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTr
 				OpportunisticGraftThreshold: opportunisticGraftScoreThreshold,
 			},
 		),
+		pubsub.WithPeerScoreInspect(pubsub.ExtendedPeerScoreInspectFn(exportPeerScoreMetrics), peerScoreInspectPeriod),
 		// pubsub.WithPeerGater(&pubsub.PeerGaterParams{}),
 		pubsub.WithSubscriptionFilter(pubsub.WrapLimitSubscriptionFilter(pubsub.NewAllowlistSubscriptionFilter(TXTopicName), 100)),
 		// pubsub.WithEventTracer(jsonTracer),
@@ -126,6 +113,121 @@ func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTr
 	return pubsub.NewGossipSub(ctx, host, options...)
 }
 
+// makePeerScoreParams returns gossipsub peer score parameters for all topics this node participates in
+func makePeerScoreParams(cfg config.Local) *pubsub.PeerScoreParams {
+	ipColocationThreshold := cfg.P2PGossipScoreIPColocationFactorThreshold
+	if ipColocationThreshold < 1 {
+		ipColocationThreshold = 1
+	}
+	return &pubsub.PeerScoreParams{
+		DecayInterval: pubsub.DefaultDecayInterval,
+		DecayToZero:   pubsub.DefaultDecayToZero,
+
+		AppSpecificScore: func(p peer.ID) float64 { return 1000 },
+
+		IPColocationFactorWeight:    float64(cfg.P2PGossipScoreIPColocationFactorWeight),
+		IPColocationFactorThreshold: ipColocationThreshold,
+
+		Topics: map[string]*pubsub.TopicScoreParams{
+			TXTopicName: makeTopicScoreParams(cfg, TXTopicName),
+		},
+	}
+}
+
+// makeTopicScoreParams returns gossipsub peer score parameters for a single topic.
+// Invalid message deliveries are counted when the topic validator returns pubsub.ValidationReject,
+// so the penalty directly reflects the outcome of our own message verification.
+func makeTopicScoreParams(cfg config.Local, topicName string) *pubsub.TopicScoreParams {
+	invalidDecay := time.Duration(cfg.P2PGossipScoreInvalidMessageDecaySec) * time.Second
+	if invalidDecay <= pubsub.DefaultDecayInterval {
+		invalidDecay = time.Hour
+	}
+
+	var params *pubsub.TopicScoreParams
+	switch topicName {
+	case TXTopicName:
+		params = &pubsub.TopicScoreParams{
+			TopicWeight: 0.1,
+
+			TimeInMeshWeight:  0.0002778, // ~1/3600
+			TimeInMeshQuantum: time.Second,
+			TimeInMeshCap:     1,
+
+			FirstMessageDeliveriesWeight: 0.5, // max value is 50
+			FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(10 * time.Minute),
+			FirstMessageDeliveriesCap:    100, // 100 messages in 10 minutes
+		}
+	default:
+		params = &pubsub.TopicScoreParams{
+			TopicWeight:       0.1,
+			TimeInMeshQuantum: time.Second,
+		}
+	}
+
+	// invalid messages decay after P2PGossipScoreInvalidMessageDecaySec (1 hour by default)
+	params.InvalidMessageDeliveriesWeight = float64(cfg.P2PGossipScoreInvalidMessageWeight)
+	params.InvalidMessageDeliveriesDecay = pubsub.ScoreParameterDecay(invalidDecay)
+
+	if cfg.P2PGossipScoreMeshDeliveriesThreshold > 0 && cfg.P2PGossipScoreMeshDeliveriesWeight != 0 {
+		activation := time.Duration(cfg.P2PGossipScoreMeshDeliveriesWindowSec) * time.Second
+		if activation < time.Second {
+			activation = time.Second
+		}
+		threshold := float64(cfg.P2PGossipScoreMeshDeliveriesThreshold)
+		// mesh deliveries counter decays over the activation window so that the threshold
+		// is an expectation of the delivery rate rather than a one-off total
+		decay := pubsub.ScoreParameterDecay(activation)
+		if activation <= pubsub.DefaultDecayInterval {
+			decay = pubsub.ScoreParameterDecay(2 * pubsub.DefaultDecayInterval)
+		}
+		params.MeshMessageDeliveriesWeight = float64(cfg.P2PGossipScoreMeshDeliveriesWeight)
+		params.MeshMessageDeliveriesDecay = decay
+		params.MeshMessageDeliveriesThreshold = threshold
+		params.MeshMessageDeliveriesCap = 4 * threshold
+		params.MeshMessageDeliveriesActivation = activation
+		params.MeshMessageDeliveriesWindow = 10 * time.Millisecond
+
+		// keep penalizing peers pruned with a delivery deficit
+		params.MeshFailurePenaltyWeight = float64(cfg.P2PGossipScoreMeshDeliveriesWeight)
+		params.MeshFailurePenaltyDecay = decay
+	}
+	return params
+}
+
+// exportPeerScoreMetrics is a gossipsub peer score inspector that publishes score statistics as metrics
+func exportPeerScoreMetrics(scores map[peer.ID]*pubsub.PeerScoreSnapshot) {
+	var belowGossip, belowPublish, belowGraylist uint64
+	meshPeers := make(map[string]uint64)
+	invalidDeliveries := make(map[string]float64)
+	for _, snapshot := range scores {
+		if snapshot.Score < gossipScoreThreshold {
+			belowGossip++
+		}
+		if snapshot.Score < publishScoreThreshold {
+			belowPublish++
+		}
+		if snapshot.Score < graylistScoreThreshold {
+			belowGraylist++
+		}
+		for topic, ts := range snapshot.Topics {
+			if ts.TimeInMesh > 0 {
+				meshPeers[topic]++
+			}
+			invalidDeliveries[topic] += ts.InvalidMessageDeliveries
+		}
+	}
+
+	networkP2PGossipSubScoredPeers.Set(uint64(len(scores)))
+	networkP2PGossipSubPeersBelowThreshold.SetLabels(belowGossip, map[string]string{"threshold": "gossip"})
+	networkP2PGossipSubPeersBelowThreshold.SetLabels(belowPublish, map[string]string{"threshold": "publish"})
+	networkP2PGossipSubPeersBelowThreshold.SetLabels(belowGraylist, map[string]string{"threshold": "graylist"})
+	// report known topics even if there are no peers so that gauges drop back to zero
+	for _, topic := range []string{TXTopicName} {
+		networkP2PGossipSubTopicMeshPeers.SetLabels(meshPeers[topic], map[string]string{"topic": topic})
+		networkP2PGossipSubTopicInvalidDeliveries.SetLabels(uint64(invalidDeliveries[topic]), map[string]string{"topic": topic})
+	}
+}
+
 func txMsgID(m *pubsub_pb.Message) string {
 	h := blake2b.Sum256(m.Data)
 	return string(h[:])
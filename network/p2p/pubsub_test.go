@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPubSubTopicScoreParams(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	params := makeTopicScoreParams(cfg, TXTopicName)
+	require.Equal(t, float64(cfg.P2PGossipScoreInvalidMessageWeight), params.InvalidMessageDeliveriesWeight)
+	require.Equal(t, pubsub.ScoreParameterDecay(time.Hour), params.InvalidMessageDeliveriesDecay)
+	// mesh delivery expectations are disabled by default
+	require.Zero(t, params.MeshMessageDeliveriesWeight)
+	require.Zero(t, params.MeshFailurePenaltyWeight)
+
+	cfg.P2PGossipScoreMeshDeliveriesThreshold = 5
+	cfg.P2PGossipScoreMeshDeliveriesWeight = -2
+	cfg.P2PGossipScoreMeshDeliveriesWindowSec = 30
+	cfg.P2PGossipScoreInvalidMessageDecaySec = 600
+	params = makeTopicScoreParams(cfg, TXTopicName)
+	require.Equal(t, pubsub.ScoreParameterDecay(10*time.Minute), params.InvalidMessageDeliveriesDecay)
+	require.Equal(t, float64(-2), params.MeshMessageDeliveriesWeight)
+	require.Equal(t, float64(5), params.MeshMessageDeliveriesThreshold)
+	require.Equal(t, float64(20), params.MeshMessageDeliveriesCap)
+	require.Equal(t, 30*time.Second, params.MeshMessageDeliveriesActivation)
+	require.Equal(t, float64(-2), params.MeshFailurePenaltyWeight)
+
+	cfg.P2PGossipScoreIPColocationFactorWeight = -5
+	cfg.P2PGossipScoreIPColocationFactorThreshold = 0
+	scoreParams := makePeerScoreParams(cfg)
+	require.Equal(t, float64(-5), scoreParams.IPColocationFactorWeight)
+	require.Equal(t, 1, scoreParams.IPColocationFactorThreshold)
+	require.Contains(t, scoreParams.Topics, TXTopicName)
+}
+
+func TestPubSubMakeScoreConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h, err := libp2p.New(libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	defer h.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.P2PGossipScoreMeshDeliveriesThreshold = 5
+	cfg.P2PGossipScoreIPColocationFactorWeight = -1
+	_, err = makePubSub(ctx, cfg, h, nil)
+	require.NoError(t, err)
+
+	// positive penalty weights are rejected by gossipsub parameter validation
+	cfg = config.GetDefaultLocal()
+	cfg.P2PGossipScoreInvalidMessageWeight = 10
+	_, err = makePubSub(ctx, cfg, h, nil)
+	require.Error(t, err)
+}
+
+func TestPubSubExportPeerScoreMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	scores := map[peer.ID]*pubsub.PeerScoreSnapshot{
+		peer.ID("good"): {
+			Score:  10,
+			Topics: map[string]*pubsub.TopicScoreSnapshot{TXTopicName: {TimeInMesh: time.Minute}},
+		},
+		peer.ID("bad"): {
+			Score:  2 * publishScoreThreshold,
+			Topics: map[string]*pubsub.TopicScoreSnapshot{TXTopicName: {InvalidMessageDeliveries: 3}},
+		},
+		peer.ID("awful"): {
+			Score: 2 * graylistScoreThreshold,
+		},
+	}
+	exportPeerScoreMetrics(scores)
+
+	require.Equal(t, uint64(2), networkP2PGossipSubPeersBelowThreshold.GetUint64ValueForLabels(map[string]string{"threshold": "gossip"}))
+	require.Equal(t, uint64(2), networkP2PGossipSubPeersBelowThreshold.GetUint64ValueForLabels(map[string]string{"threshold": "publish"}))
+	require.Equal(t, uint64(1), networkP2PGossipSubPeersBelowThreshold.GetUint64ValueForLabels(map[string]string{"threshold": "graylist"}))
+	require.Equal(t, uint64(1), networkP2PGossipSubTopicMeshPeers.GetUint64ValueForLabels(map[string]string{"topic": TXTopicName}))
+	require.Equal(t, uint64(3), networkP2PGossipSubTopicInvalidDeliveries.GetUint64ValueForLabels(map[string]string{"topic": TXTopicName}))
+}
@@ -92,6 +92,8 @@ type P2PNetwork struct {
 	wantTXGossip  atomic.Bool
 
 	capabilitiesDiscovery *p2p.CapabilitiesDiscovery
+	// dataProviders caches the block and catchpoint providers found through capabilitiesDiscovery
+	dataProviders dataProvidersCache
 
 	bootstrapperStart func()
 	bootstrapperStop  func()
@@ -100,6 +102,12 @@ type P2PNetwork struct {
 	httpServer        *p2p.HTTPServer
 
 	identityTracker identityTracker
+
+	// peerAllowlist restricts peering to allowlisted peer IDs in private network mode
+	peerAllowlist *p2p.PeerAllowlist
+
+	// bwShaper limits the outgoing bandwidth per message tag; nil when outgoing bandwidth is not capped
+	bwShaper *bandwidthShaper
 }
 
 type bootstrapper struct {
@@ -242,6 +250,7 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 		nodeInfo:      node,
 		pstore:        pstore,
 		relayMessages: relayMessages,
+		bwShaper:      makeBandwidthShaper(cfg),
 		peerStater: peerConnectionStater{
 			log:                           log,
 			peerConnectionsUpdateInterval: time.Duration(cfg.PeerConnectionsUpdateInterval) * time.Second,
@@ -266,6 +275,13 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 
 	if identityOpts != nil {
 		net.identityTracker = identityOpts.tracker
+		net.peerAllowlist = identityOpts.allowlist
+	}
+	if net.peerAllowlist == nil && cfg.PeerAllowlistFile != "" {
+		net.peerAllowlist, err = p2p.LoadPeerAllowlist(cfg.PeerAllowlistFile)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if net.identityTracker == nil {
 		net.identityTracker = noopIdentityTracker{}
@@ -276,7 +292,7 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 		return nil, err
 	}
 
-	h, la, err := p2p.MakeHost(cfg, datadir, pstore)
+	h, la, err := p2p.MakeHost(cfg, datadir, pstore, net.peerAllowlist)
 	if err != nil {
 		return nil, err
 	}
@@ -334,6 +350,16 @@ func (n *P2PNetwork) PeerID() p2p.PeerID {
 	return p2p.PeerID(n.service.ID())
 }
 
+// ReachabilityReporter is implemented by networks able to report the node reachability from the public internet
+type ReachabilityReporter interface {
+	P2PReachability() string
+}
+
+// P2PReachability returns the node reachability as determined by AutoNAT: "public", "private" or "unknown"
+func (n *P2PNetwork) P2PReachability() string {
+	return n.service.Reachability()
+}
+
 // PeerIDSigner returns an identityChallengeSigner that uses the libp2p peer ID's private key.
 func (n *P2PNetwork) PeerIDSigner() identityChallengeSigner {
 	return n.service.IDSigner()
@@ -378,6 +404,17 @@ func (n *P2PNetwork) Start() error {
 
 	if n.capabilitiesDiscovery != nil {
 		n.capabilitiesDiscovery.AdvertiseCapabilities(n.nodeInfo.Capabilities()...)
+		if info, ok := n.nodeInfo.(DataProviderInfo); ok {
+			n.capabilitiesDiscovery.AdvertiseProvidedData(info.ProvidedData)
+		}
+	}
+
+	if n.peerAllowlist != nil {
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			peerAllowlistThread(n.ctx, n.log, n.peerAllowlist, peerAllowlistReloadInterval(n.config), n.enforcePeerAllowlist)
+		}()
 	}
 
 	return nil
@@ -545,6 +582,12 @@ func (n *P2PNetwork) Address() (string, bool) {
 func (n *P2PNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
 	// For tags using pubsub topics, publish to GossipSub
 	if topic, ok := n.topicTags[tag]; ok {
+		// gossipsub sends the message to every topic peer in our mesh, so charge the shaper accordingly.
+		// Like messages dropped by the websocket peers write loops, a dropped message is counted in the
+		// outgoing throttled metrics and is not an error.
+		if n.bwShaper != nil && !n.bwShaper.allow(tag, len(data)*len(n.service.ListPeersForTopic(topic))) {
+			return nil
+		}
 		return n.service.Publish(ctx, topic, data)
 	}
 	// Otherwise broadcast over websocket protocol stream
@@ -809,6 +852,7 @@ func (n *P2PNetwork) wsStreamHandler(ctx context.Context, p2pPeer peer.ID, strea
 		outgoing:   !incoming,
 		identity:   netIdentPeerID,
 		peerType:   peerTypeP2P,
+		bwShaper:   n.bwShaper,
 	}
 	protos, err := n.pstore.GetProtocols(p2pPeer)
 	if err != nil {
@@ -1016,6 +1060,11 @@ func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *
 	case Disconnect:
 		return pubsub.ValidationReject
 	case Accept:
+		// gossipsub forwards accepted messages to the topic peers in our mesh but the sender, so charge the
+		// shaper accordingly. The message was handled already, ignoring it only stops it from being forwarded.
+		if n.bwShaper != nil && !n.bwShaper.allow(protocol.TxnTag, len(msg.Data)*max(len(n.service.ListPeersForTopic(p2p.TXTopicName))-1, 0)) {
+			return pubsub.ValidationIgnore
+		}
 		msg.ValidatorData = outmsg
 		return pubsub.ValidationAccept
 	default:
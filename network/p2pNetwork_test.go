@@ -385,6 +385,10 @@ func (s *mockService) Publish(ctx context.Context, topic string, data []byte) er
 	return nil
 }
 
+func (s *mockService) Reachability() string {
+	return "unknown"
+}
+
 func (s *mockService) GetHTTPClient(addrInfo *peer.AddrInfo, connTimeStore limitcaller.ConnectionTimeStore, queueingTimeout time.Duration) (*http.Client, error) {
 	return nil, nil
 }
@@ -1421,3 +1425,48 @@ func TestP2PTxTopicValidator_NoWsPeer(t *testing.T) {
 	res := net.txTopicValidator(ctx, peerID, &msg)
 	require.Equal(t, pubsub.ValidationAccept, res)
 }
+
+type topicPeersService struct {
+	*mockService
+	topicPeers []peer.ID
+}
+
+func (s *topicPeersService) ListPeersForTopic(topic string) []peer.ID {
+	return s.topicPeers
+}
+
+// TestP2PTxTopicValidatorBandwidthShaper checks that transactions gossipsub would forward are charged to the
+// outgoing bandwidth budget, and are handled but not forwarded once it is exhausted
+func TestP2PTxTopicValidatorBandwidthShaper(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.DNSBootstrapID = ""
+	cfg.OutgoingTxnBandwidthLimit = 100
+	net, err := NewP2PNetwork(logging.TestingLog(t), cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	now := time.Now()
+	net.bwShaper.clock = func() time.Time { return now }
+	net.bwShaper.tags[protocol.TxnTag].last = now
+	// the sender and two more topic peers
+	net.service = &topicPeersService{mockService: makeMockService("self", nil), topicPeers: []peer.ID{"sender", "p1", "p2"}}
+
+	handled := 0
+	net.handler.RegisterValidatorHandlers([]TaggedMessageValidatorHandler{
+		{Tag: protocol.TxnTag, MessageHandler: ValidateHandleFunc(func(IncomingMessage) OutgoingMessage {
+			handled++
+			return OutgoingMessage{Action: Accept}
+		})},
+	})
+
+	msg := func() *pubsub.Message {
+		return &pubsub.Message{Message: &pb.Message{Data: make([]byte, 60)}, ReceivedFrom: "sender"}
+	}
+	ctx := context.Background()
+	require.Equal(t, pubsub.ValidationAccept, net.txTopicValidator(ctx, "sender", msg()))
+	require.Equal(t, pubsub.ValidationIgnore, net.txTopicValidator(ctx, "sender", msg()))
+	require.Equal(t, 2, handled)
+
+	now = now.Add(2 * time.Second)
+	require.Equal(t, pubsub.ValidationAccept, net.txTopicValidator(ctx, "sender", msg()))
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/p2p"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// peerAllowlist.go implements the private network mode, where a node only peers with an allowlisted set of identities.
+// Identities are libp2p peer IDs. P2P connections are gated by libp2p itself, while websocket peers must complete the
+// identity challenge exchange (see netidentity.go) with a key listed in the allowlist:
+// - incoming websocket connections without a valid identity challenge for an allowed key are refused before upgrading
+// - outgoing websocket connections are closed if the responder does not prove an allowed identity
+// - incoming websocket peers that do not complete identity verification in time are disconnected
+// The allowlist file is periodically checked for modifications and peers no longer allowed are disconnected.
+
+// allowlistVerificationTimeout is how long an incoming websocket peer has to complete identity verification
+const allowlistVerificationTimeout = 30 * time.Second
+
+const defaultPeerAllowlistReloadInterval = 10 * time.Second
+
+var errAllowlistNoIdentity = errors.New("private network allowlist mode requires a persistent node identity")
+
+var networkPeersNotAllowedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peers_not_allowed_total", Description: "Number of peers refused or disconnected because they are not in the peer allowlist"})
+
+// MakeAllowlistIdentityOpts returns identity options for a websocket network running in private network allowlist mode,
+// or nil if PeerAllowlistFile is not set. The node identity is derived from its P2P private key so that a single peer ID
+// identifies the node on both websocket and P2P networks.
+func MakeAllowlistIdentityOpts(cfg config.Local, datadir string) (*identityOpts, error) {
+	if cfg.PeerAllowlistFile == "" {
+		return nil, nil
+	}
+	allowlist, err := p2p.LoadPeerAllowlist(cfg.PeerAllowlistFile)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := p2p.GetPrivKey(cfg, datadir)
+	if err != nil {
+		return nil, err
+	}
+	peerID, err := p2p.PeerIDFromPublicKey(privKey.GetPublic())
+	if err != nil {
+		return nil, err
+	}
+	return &identityOpts{
+		tracker:   NewIdentityTracker(),
+		scheme:    NewIdentityChallengeScheme(NetIdentityDedupNames(cfg.PublicAddress, peerID.String()), NetIdentitySigner(p2p.MakePeerIDChallengeSigner(privKey))),
+		allowlist: allowlist,
+	}, nil
+}
+
+// peerAllowlistReloadInterval returns the configured allowlist reload interval
+func peerAllowlistReloadInterval(cfg config.Local) time.Duration {
+	if cfg.PeerAllowlistReloadIntervalSec <= 0 {
+		return defaultPeerAllowlistReloadInterval
+	}
+	return time.Duration(cfg.PeerAllowlistReloadIntervalSec) * time.Second
+}
+
+// peerAllowlistThread periodically reloads the allowlist if it changed and calls enforce to drop peers that are not allowed
+func peerAllowlistThread(ctx context.Context, log logging.Logger, allowlist *p2p.PeerAllowlist, interval time.Duration, enforce func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := allowlist.Reload()
+			if err != nil {
+				log.Warnf("failed to reload peer allowlist, keeping the previous one: %v", err)
+			} else if changed {
+				log.Infof("peer allowlist reloaded with %d entries", allowlist.Len())
+			}
+			enforce()
+		}
+	}
+}
+
+// enforcePeerAllowlist disconnects websocket peers that are not in the allowlist
+// or that did not complete identity verification in time
+func (wn *WebsocketNetwork) enforcePeerAllowlist() {
+	peers, _ := wn.peerSnapshot(nil)
+	now := time.Now()
+	for _, peer := range peers {
+		allowed := wn.peerAllowlist.AllowedPublicKey(peer.identity)
+		if allowed && peer.identityVerified.Load() == 0 && now.Sub(peer.createTime) < allowlistVerificationTimeout {
+			// still waiting for the identity verification message
+			continue
+		}
+		if !allowed || peer.identityVerified.Load() == 0 {
+			networkPeersNotAllowedTotal.Inc(nil)
+			wn.log.With("remote", peer.OriginAddress()).Info("disconnecting peer not in the peer allowlist")
+			wn.disconnect(peer, disconnectNotAllowed)
+		}
+	}
+}
+
+// enforcePeerAllowlist closes P2P connections to peers that are not in the allowlist
+func (n *P2PNetwork) enforcePeerAllowlist() {
+	for _, conn := range n.service.Conns() {
+		peerID := conn.RemotePeer()
+		if n.peerAllowlist.Allowed(peerID) {
+			continue
+		}
+		networkPeersNotAllowedTotal.Inc(nil)
+		n.log.Infof("disconnecting peer %s not in the peer allowlist", peerID)
+		if err := n.service.ClosePeer(peerID); err != nil {
+			n.log.Warnf("failed to disconnect peer %s: %v", peerID, err)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/p2p"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// option to set identity options including the peer allowlist
+type testWebsocketIdentityOption struct{ opts *identityOpts }
+
+func (o testWebsocketIdentityOption) applyOpt(wn *WebsocketNetwork) {
+	wn.identityScheme = o.opts.scheme
+	wn.identityTracker = o.opts.tracker
+	wn.peerAllowlist = o.opts.allowlist
+}
+
+// makeTestAllowlistPeer creates a persistent p2p identity in a new data directory and returns the directory and peer ID
+func makeTestAllowlistPeer(t *testing.T) (string, peer.ID) {
+	dir := t.TempDir()
+	cfg := config.GetDefaultLocal()
+	cfg.P2PPersistPeerID = true
+	privKey, err := p2p.GetPrivKey(cfg, dir)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(privKey)
+	require.NoError(t, err)
+	return dir, id
+}
+
+func writeTestAllowlist(t *testing.T, path string, modTime time.Time, ids ...peer.ID) {
+	var content string
+	for _, id := range ids {
+		content += id.String() + "\n"
+	}
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func getTestFreeAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestPeerAllowlistWebsocket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dirA, idA := makeTestAllowlistPeer(t)
+	dirB, idB := makeTestAllowlistPeer(t)
+	dirC, idC := makeTestAllowlistPeer(t)
+
+	now := time.Now()
+	allowlistA := filepath.Join(t.TempDir(), "allowlist")
+	writeTestAllowlist(t, allowlistA, now, idB)
+	allowlistBC := filepath.Join(t.TempDir(), "allowlist")
+	writeTestAllowlist(t, allowlistBC, now, idA)
+
+	cfgA := defaultConfig
+	cfgA.NetAddress = getTestFreeAddress(t)
+	cfgA.PublicAddress = cfgA.NetAddress
+	cfgA.PeerAllowlistFile = allowlistA
+	cfgA.P2PPersistPeerID = true
+	optsA, err := MakeAllowlistIdentityOpts(cfgA, dirA)
+	require.NoError(t, err)
+	netA := makeTestWebsocketNodeWithConfig(t, cfgA, testWebsocketLogNameOption{"netA"}, testWebsocketIdentityOption{optsA})
+	netA.Start()
+	defer netStop(t, netA, "A")
+
+	cfgBC := defaultConfig
+	cfgBC.NetAddress = ""
+	cfgBC.PeerAllowlistFile = allowlistBC
+	cfgBC.P2PPersistPeerID = true
+	optsB, err := MakeAllowlistIdentityOpts(cfgBC, dirB)
+	require.NoError(t, err)
+	netB := makeTestWebsocketNodeWithConfig(t, cfgBC, testWebsocketLogNameOption{"netB"}, testWebsocketIdentityOption{optsB})
+	netB.Start()
+	defer netStop(t, netB, "B")
+	optsC, err := MakeAllowlistIdentityOpts(cfgBC, dirC)
+	require.NoError(t, err)
+	netC := makeTestWebsocketNodeWithConfig(t, cfgBC, testWebsocketLogNameOption{"netC"}, testWebsocketIdentityOption{optsC})
+	netC.Start()
+	defer netStop(t, netC, "C")
+
+	addrA := cfgA.NetAddress
+	gossipA, err := netB.addrToGossipAddr("http://" + addrA)
+	require.NoError(t, err)
+
+	// B is allowed by A
+	if _, ok := netB.tryConnectReserveAddr(addrA); ok {
+		netB.wg.Add(1)
+		netB.tryConnect(addrA, gossipA)
+	}
+	require.Eventually(t, func() bool {
+		return len(netB.GetPeers(PeersConnectedOut)) == 1 && len(netA.GetPeers(PeersConnectedIn)) == 1
+	}, 2*time.Second, 50*time.Millisecond)
+	// A keeps B once its identity is verified
+	require.Eventually(t, func() bool {
+		peers := netA.GetPeers(PeersConnectedIn)
+		return len(peers) == 1 && peers[0].(*wsPeer).identityVerified.Load() == 1
+	}, 2*time.Second, 50*time.Millisecond)
+	netA.enforcePeerAllowlist()
+	require.Len(t, netA.GetPeers(PeersConnectedIn), 1)
+
+	// C is not allowed by A
+	if _, ok := netC.tryConnectReserveAddr(addrA); ok {
+		netC.wg.Add(1)
+		netC.tryConnect(addrA, gossipA)
+	}
+	require.Empty(t, netC.GetPeers(PeersConnectedOut))
+	require.Len(t, netA.GetPeers(PeersConnectedIn), 1)
+
+	// hot-reload the allowlist to replace B with C
+	writeTestAllowlist(t, allowlistA, now.Add(time.Minute), idC)
+	changed, err := netA.peerAllowlist.Reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+	netA.enforcePeerAllowlist()
+	require.Empty(t, netA.GetPeers(PeersConnectedIn))
+
+	if _, ok := netC.tryConnectReserveAddr(addrA); ok {
+		netC.wg.Add(1)
+		netC.tryConnect(addrA, gossipA)
+	}
+	require.Eventually(t, func() bool {
+		return len(netC.GetPeers(PeersConnectedOut)) == 1
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func TestPeerAllowlistWebsocketRequiresIdentity(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := defaultConfig
+	cfg.PeerAllowlistFile = filepath.Join(t.TempDir(), "allowlist")
+	_, err := NewWebsocketNetwork(logging.TestingLog(t), cfg, nil, genesisID, config.Devtestnet, nil, nil)
+	require.ErrorIs(t, err, errAllowlistNoIdentity)
+
+	// a missing allowlist file is an error
+	_, err = MakeAllowlistIdentityOpts(cfg, t.TempDir())
+	require.Error(t, err)
+
+	opts, err := MakeAllowlistIdentityOpts(config.GetDefaultLocal(), t.TempDir())
+	require.NoError(t, err)
+	require.Nil(t, opts)
+}
+
+func TestPeerAllowlistP2P(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dirA, _ := makeTestAllowlistPeer(t)
+	dirB, idB := makeTestAllowlistPeer(t)
+	_, idOther := makeTestAllowlistPeer(t)
+
+	now := time.Now()
+	allowlistPath := filepath.Join(t.TempDir(), "allowlist")
+	writeTestAllowlist(t, allowlistPath, now, idOther)
+
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	cfg.DNSBootstrapID = "" // disable DNS lookups since the test uses phonebook addresses
+	cfg.NetAddress = "127.0.0.1:0"
+	cfg.P2PPersistPeerID = true
+	cfgA := cfg
+	cfgA.PeerAllowlistFile = allowlistPath
+	netA, err := NewP2PNetwork(log, cfgA, dirA, nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, netA.peerAllowlist)
+	err = netA.Start()
+	require.NoError(t, err)
+	defer netA.Stop()
+
+	peerInfoA := netA.service.AddrInfo()
+	addrsA, err := peer.AddrInfoToP2pAddrs(&peerInfoA)
+	require.NoError(t, err)
+	require.NotZero(t, addrsA[0])
+
+	netB, err := NewP2PNetwork(log, cfg, dirB, []string{addrsA[0].String()}, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, idB, netB.service.ID())
+	err = netB.Start()
+	require.NoError(t, err)
+	defer netB.Stop()
+
+	// B is refused by A
+	netB.service.DialPeersUntilTargetCount(1)
+	require.Empty(t, netA.service.Conns())
+
+	writeTestAllowlist(t, allowlistPath, now.Add(time.Minute), idOther, idB)
+	changed, err := netA.peerAllowlist.Reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	netB.service.DialPeersUntilTargetCount(1)
+	require.Eventually(t, func() bool {
+		return len(netA.service.Conns()) == 1
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// removing B from the allowlist disconnects it
+	writeTestAllowlist(t, allowlistPath, now.Add(2*time.Minute), idOther)
+	_, err = netA.peerAllowlist.Reload()
+	require.NoError(t, err)
+	netA.enforcePeerAllowlist()
+	require.Eventually(t, func() bool {
+		return len(netA.service.Conns()) == 0
+	}, 2*time.Second, 50*time.Millisecond)
+}
@@ -221,6 +221,12 @@ type WebsocketNetwork struct {
 	identityScheme  identityChallengeScheme
 	identityTracker identityTracker
 
+	// peerAllowlist restricts peering to allowlisted identities in private network mode
+	peerAllowlist *p2p.PeerAllowlist
+
+	// bwShaper limits the outgoing bandwidth per message tag; nil when outgoing bandwidth is not capped
+	bwShaper *bandwidthShaper
+
 	// outgoingMessagesBufferSize is the size used for outgoing messages.
 	outgoingMessagesBufferSize int
 
@@ -751,6 +757,14 @@ func (wn *WebsocketNetwork) Start() error {
 
 	go wn.postMessagesOfInterestThread()
 
+	if wn.peerAllowlist != nil {
+		wn.wg.Add(1)
+		go func() {
+			defer wn.wg.Done()
+			peerAllowlistThread(wn.ctx, wn.log, wn.peerAllowlist, peerAllowlistReloadInterval(wn.config), wn.enforcePeerAllowlist)
+		}()
+	}
+
 	wn.log.Infof("serving genesisID=%s on %#v with RandomID=%s", wn.GenesisID, wn.PublicAddress(), wn.RandomID)
 
 	return nil
@@ -1060,6 +1074,13 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 			return
 		}
 	}
+	if wn.peerAllowlist != nil && !wn.peerAllowlist.AllowedPublicKey(peerID) {
+		networkPeersNotAllowedTotal.Inc(nil)
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "not allowed"})
+		wn.log.With("remote", trackedRequest.remoteAddress()).With("local", localAddr).Infof("peer (%s) is not in the peer allowlist, abandoning peering", trackedRequest.remoteAddr)
+		response.WriteHeader(http.StatusForbidden)
+		return
+	}
 
 	conn, err := wn.upgrader.Upgrade(response, request, responseHeader)
 	if err != nil {
@@ -1087,6 +1108,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		identityChallenge: peerIDChallenge,
 		identityVerified:  atomic.Uint32{},
 		features:          decodePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)),
+		bwShaper:          wn.bwShaper,
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
@@ -2143,6 +2165,12 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 			return
 		}
 	}
+	if wn.peerAllowlist != nil && (len(idVerificationMessage) == 0 || !wn.peerAllowlist.AllowedPublicKey(peerID)) {
+		networkPeersNotAllowedTotal.Inc(nil)
+		wn.log.With("remote", netAddr).With("local", localAddr).Info("peer is not in the peer allowlist, abandoning peering")
+		closeEarly("Peer not allowed")
+		return
+	}
 
 	throttledConnection := false
 	if wn.throttledOutgoingConnections.Add(int32(-1)) >= 0 {
@@ -2163,6 +2191,7 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		version:                     matchingVersion,
 		identity:                    peerID,
 		features:                    decodePeerFeatures(matchingVersion, response.Header.Get(PeerFeaturesHeader)),
+		bwShaper:                    wn.bwShaper,
 	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
 
@@ -2262,6 +2291,7 @@ func NewWebsocketNetwork(log logging.Logger, config config.Local, phonebookAddre
 		NetworkID:         networkID,
 		nodeInfo:          nodeInfo,
 		resolveSRVRecords: tools_network.ReadFromSRV,
+		bwShaper:          makeBandwidthShaper(config),
 		peerStater: peerConnectionStater{
 			log:                           log,
 			peerConnectionsUpdateInterval: time.Duration(config.PeerConnectionsUpdateInterval) * time.Second,
@@ -2273,10 +2303,14 @@ func NewWebsocketNetwork(log logging.Logger, config config.Local, phonebookAddre
 	if identityOpts != nil {
 		wn.identityScheme = identityOpts.scheme
 		wn.identityTracker = identityOpts.tracker
+		wn.peerAllowlist = identityOpts.allowlist
 	}
 	if wn.identityTracker == nil {
 		wn.identityTracker = NewIdentityTracker()
 	}
+	if config.PeerAllowlistFile != "" && (wn.peerAllowlist == nil || wn.identityScheme == nil) {
+		return nil, errAllowlistNoIdentity
+	}
 
 	wn.setup()
 	return wn, nil
@@ -167,6 +167,7 @@ const disconnectStaleWrite disconnectReason = "DisconnectStaleWrite"
 const disconnectDuplicateConnection disconnectReason = "DuplicateConnection"
 const disconnectBadIdentityData disconnectReason = "BadIdentityData"
 const disconnectUnexpectedTopicResp disconnectReason = "UnexpectedTopicResp"
+const disconnectNotAllowed disconnectReason = "NotAllowed"
 
 // Response is the structure holding the response from the server
 type Response struct {
@@ -304,6 +305,9 @@ type wsPeer struct {
 	// peerType defines the peer's underlying connection type
 	// used for separate p2p vs ws metrics
 	peerType peerType
+
+	// bwShaper is the network's outgoing bandwidth shaper; nil when outgoing bandwidth is not capped
+	bwShaper *bandwidthShaper
 }
 
 // HTTPPeer is what the opaque Peer might be.
@@ -829,6 +833,10 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		// the peer isn't interested in this message.
 		return disconnectReasonNone
 	}
+	if !wp.bwShaper.allow(tag, len(msg.data)) {
+		// over the outgoing bandwidth budget; drop the message but keep the connection.
+		return disconnectReasonNone
+	}
 
 	// check if this message was waiting in the queue for too long. If this is the case, return "true" to indicate that we want to close the connection.
 	now := time.Now()
@@ -36,6 +36,7 @@ package node
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -86,6 +87,14 @@ type AlgorandFollowerNode struct {
 	lastRoundTimestamp    time.Time
 	hasSyncedSinceStartup bool
 
+	// syncMu protects the sync round and the named sync cursors, which together decide how far the
+	// catchup service is allowed to sync.
+	syncMu          deadlock.Mutex
+	syncRound       uint64
+	syncRoundSet    bool
+	syncCursors     map[string]basics.Round
+	syncCursorsPath string
+
 	cryptoPool                        execpool.ExecutionPool
 	lowPriorityCryptoVerificationPool execpool.BacklogPool
 	catchupBlockAuth                  blockAuthenticatorImpl
@@ -110,7 +119,12 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 	node.config = cfg
 
 	// tie network, block fetcher, and agreement services together
-	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, nil, nil)
+	identityOpts, err := network.MakeAllowlistIdentityOpts(node.config, rootDir)
+	if err != nil {
+		log.Errorf("could not load peer allowlist: %v", err)
+		return nil, err
+	}
+	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, nil, identityOpts)
 	if err != nil {
 		log.Errorf("could not create websocket node: %v", err)
 		return nil, err
@@ -148,12 +162,29 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
 	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, make(chan catchup.PendingUnmatchedCertificate), node.lowPriorityCryptoVerificationPool)
 
-	// Initialize sync round to the latest db round + 1 so that nothing falls out of the cache on Start
-	err = node.SetSyncRound(uint64(node.Ledger().LatestTrackerCommitted() + 1))
+	node.syncCursorsPath = filepath.Join(node.genesisDirs.RootGenesisDir, config.SyncCursorsFilename)
+	node.syncCursors, err = loadSyncCursors(node.syncCursorsPath)
 	if err != nil {
-		log.Errorf("unable to set sync round to Ledger.DBRound %v", err)
+		log.Errorf("Cannot load sync cursors: %v", err)
 		return nil, err
 	}
+	if len(node.syncCursors) > 0 {
+		// The registered cursors hold the ledger back to the deltas their consumers still need
+		node.syncMu.Lock()
+		err = node.applySyncRound()
+		node.syncMu.Unlock()
+		if err != nil {
+			log.Errorf("unable to apply the sync cursors %v", err)
+			return nil, err
+		}
+	} else {
+		// Initialize sync round to the latest db round + 1 so that nothing falls out of the cache on Start
+		err = node.SetSyncRound(uint64(node.Ledger().LatestTrackerCommitted() + 1))
+		if err != nil {
+			log.Errorf("unable to set sync round to Ledger.DBRound %v", err)
+			return nil, err
+		}
+	}
 
 	catchpointCatchupState, err := node.ledger.GetCatchpointCatchupState(context.Background())
 	if err != nil {
@@ -295,6 +326,9 @@ func (node *AlgorandFollowerNode) Status() (StatusReport, error) {
 
 	s.LastRoundTimestamp = lastRoundTimestamp
 	s.HasSyncedSinceStartup = hasSyncedSinceStartup
+	if rr, ok := node.net.(network.ReachabilityReporter); ok {
+		s.P2PReachability = rr.P2PReachability()
+	}
 
 	return s, err
 }
@@ -461,22 +495,132 @@ func (node *AlgorandFollowerNode) SetCatchpointCatchupMode(catchpointCatchupMode
 	return
 }
 
-// SetSyncRound sets the minimum sync round on the catchup service
-func (node *AlgorandFollowerNode) SetSyncRound(rnd uint64) error {
+// applySyncRound sets the catchup service to stop syncing where the deltas of the lowest of the sync round
+// and the sync cursors would fall out of the ledger cache. node.syncMu must be held.
+func (node *AlgorandFollowerNode) applySyncRound() error {
+	rnd, set := node.syncRound, node.syncRoundSet
+	for _, cursor := range node.syncCursors {
+		if !set || uint64(cursor) < rnd {
+			rnd, set = uint64(cursor), true
+		}
+	}
+	if !set {
+		node.catchupService.UnsetDisableSyncRound()
+		return nil
+	}
 	// Calculate the first round for which we want to disable catchup from the network.
-	// This is based on the size of the cache used in the ledger.
+	// This is based on the size of the cache used in the ledger. Cursors left behind the
+	// ledger, e.g. across a restart or a fast catchup, hold it at its latest round.
 	disableSyncRound := rnd + node.Config().MaxAcctLookback
+	if latest := uint64(node.ledger.LastRound()); disableSyncRound < latest {
+		disableSyncRound = latest
+	}
 	return node.catchupService.SetDisableSyncRound(disableSyncRound)
 }
 
+// checkSyncRound returns catchup.ErrSyncRoundInvalid if the deltas of rnd already fell out of the ledger cache.
+func (node *AlgorandFollowerNode) checkSyncRound(rnd uint64) error {
+	if basics.Round(rnd+node.Config().MaxAcctLookback) < node.ledger.LastRound() {
+		return catchup.ErrSyncRoundInvalid
+	}
+	return nil
+}
+
+// SetSyncRound sets the minimum sync round on the catchup service. Registered sync cursors
+// behind rnd keep holding the catchup service back.
+func (node *AlgorandFollowerNode) SetSyncRound(rnd uint64) error {
+	node.syncMu.Lock()
+	defer node.syncMu.Unlock()
+	if err := node.checkSyncRound(rnd); err != nil {
+		return err
+	}
+	node.syncRound, node.syncRoundSet = rnd, true
+	return node.applySyncRound()
+}
+
 // GetSyncRound retrieves the sync round, removes cache offset used during SetSyncRound
 func (node *AlgorandFollowerNode) GetSyncRound() uint64 {
 	return basics.SubSaturate(node.catchupService.GetDisableSyncRound(), node.Config().MaxAcctLookback)
 }
 
-// UnsetSyncRound removes the sync round constraint on the catchup service
+// UnsetSyncRound removes the sync round constraint on the catchup service, leaving only
+// the constraint of the registered sync cursors, if any.
 func (node *AlgorandFollowerNode) UnsetSyncRound() {
-	node.catchupService.UnsetDisableSyncRound()
+	node.syncMu.Lock()
+	defer node.syncMu.Unlock()
+	node.syncRound, node.syncRoundSet = 0, false
+	if err := node.applySyncRound(); err != nil {
+		node.log.Warnf("unable to apply the sync cursors: %v", err)
+	}
+}
+
+// ListSyncCursors returns the registered sync cursors, ordered by name.
+func (node *AlgorandFollowerNode) ListSyncCursors() []SyncCursor {
+	node.syncMu.Lock()
+	defer node.syncMu.Unlock()
+	return sortedSyncCursors(node.syncCursors)
+}
+
+// RegisterSyncCursor registers a new named sync cursor at rnd, the first round whose deltas
+// its consumer needs. The node retains the deltas back to the lowest registered cursor.
+func (node *AlgorandFollowerNode) RegisterSyncCursor(name string, rnd uint64) error {
+	if !syncCursorNameRegexp.MatchString(name) {
+		return ErrSyncCursorInvalidName
+	}
+	node.syncMu.Lock()
+	defer node.syncMu.Unlock()
+	if _, ok := node.syncCursors[name]; ok {
+		return ErrSyncCursorExists
+	}
+	if err := node.checkSyncRound(rnd); err != nil {
+		return err
+	}
+	return node.updateSyncCursor(name, basics.Round(rnd), true)
+}
+
+// AdvanceSyncCursor moves the named sync cursor forward to rnd, releasing the deltas of
+// the earlier rounds unless another cursor still needs them.
+func (node *AlgorandFollowerNode) AdvanceSyncCursor(name string, rnd uint64) error {
+	node.syncMu.Lock()
+	defer node.syncMu.Unlock()
+	cur, ok := node.syncCursors[name]
+	if !ok {
+		return ErrSyncCursorNotFound
+	}
+	if basics.Round(rnd) < cur {
+		return ErrSyncCursorBackwards
+	}
+	return node.updateSyncCursor(name, basics.Round(rnd), true)
+}
+
+// DeleteSyncCursor removes the named sync cursor.
+func (node *AlgorandFollowerNode) DeleteSyncCursor(name string) error {
+	node.syncMu.Lock()
+	defer node.syncMu.Unlock()
+	if _, ok := node.syncCursors[name]; !ok {
+		return ErrSyncCursorNotFound
+	}
+	return node.updateSyncCursor(name, 0, false)
+}
+
+// updateSyncCursor sets or removes a sync cursor, saves the cursors and applies them to the
+// catchup service. The change is rolled back if it cannot be saved. node.syncMu must be held.
+func (node *AlgorandFollowerNode) updateSyncCursor(name string, rnd basics.Round, set bool) error {
+	prev, existed := node.syncCursors[name]
+	if set {
+		node.syncCursors[name] = rnd
+	} else {
+		delete(node.syncCursors, name)
+	}
+	if err := saveSyncCursors(node.syncCursorsPath, node.syncCursors); err != nil {
+		if existed {
+			node.syncCursors[name] = prev
+		} else {
+			delete(node.syncCursors, name)
+		}
+		return err
+	}
+	return node.applySyncRound()
 }
 
 // SetBlockTimeStampOffset sets a timestamp offset in the block header.
@@ -485,6 +629,11 @@ func (node *AlgorandFollowerNode) SetBlockTimeStampOffset(offset int64) error {
 	return fmt.Errorf("cannot set block timestamp offset in follower mode")
 }
 
+// AgreementTimelines returns an error in follower mode
+func (node *AlgorandFollowerNode) AgreementTimelines() ([]agreement.RoundTimeline, error) {
+	return nil, fmt.Errorf("agreement timelines are not available in follower mode")
+}
+
 // GetBlockTimeStampOffset gets a timestamp offset.
 // This is only available in dev mode.
 func (node *AlgorandFollowerNode) GetBlockTimeStampOffset() (*int64, error) {
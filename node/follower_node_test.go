@@ -121,6 +121,51 @@ func TestSyncRound(t *testing.T) {
 	require.Equal(t, uint64(0), node.GetSyncRound())
 }
 
+func TestSyncCursors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+	genesis := followNodeDefaultGenesis()
+	root := t.TempDir()
+	node, err := MakeFollower(logging.Base(), root, cfg, []string{}, genesis)
+	require.NoError(t, err)
+	require.Empty(t, node.ListSyncCursors())
+
+	require.ErrorIs(t, node.RegisterSyncCursor("", 1), ErrSyncCursorInvalidName)
+	require.ErrorIs(t, node.RegisterSyncCursor("with space", 1), ErrSyncCursorInvalidName)
+	require.NoError(t, node.RegisterSyncCursor("indexer", 20))
+	require.NoError(t, node.RegisterSyncCursor("exporter", 10))
+	require.ErrorIs(t, node.RegisterSyncCursor("indexer", 30), ErrSyncCursorExists)
+
+	// the node is held back by the sync round, then by the lowest cursor once it is unset
+	require.NoError(t, node.SetSyncRound(5))
+	require.Equal(t, uint64(5), node.GetSyncRound())
+	node.UnsetSyncRound()
+	require.Equal(t, uint64(10), node.GetSyncRound())
+	require.Equal(t, []SyncCursor{{Name: "exporter", Round: 10}, {Name: "indexer", Round: 20}}, node.ListSyncCursors())
+
+	require.NoError(t, node.AdvanceSyncCursor("exporter", 25))
+	require.Equal(t, uint64(20), node.GetSyncRound())
+	require.ErrorIs(t, node.AdvanceSyncCursor("exporter", 24), ErrSyncCursorBackwards)
+	require.ErrorIs(t, node.AdvanceSyncCursor("cache", 24), ErrSyncCursorNotFound)
+	require.NoError(t, node.DeleteSyncCursor("indexer"))
+	require.ErrorIs(t, node.DeleteSyncCursor("indexer"), ErrSyncCursorNotFound)
+	require.Equal(t, uint64(25), node.GetSyncRound())
+	node.ledger.Close()
+
+	// the cursors survive a restart and keep holding the node back
+	node, err = MakeFollower(logging.Base(), root, cfg, []string{}, genesis)
+	require.NoError(t, err)
+	defer node.ledger.Close()
+	require.Equal(t, []SyncCursor{{Name: "exporter", Round: 25}}, node.ListSyncCursors())
+	require.Equal(t, uint64(25), node.GetSyncRound())
+
+	require.NoError(t, node.DeleteSyncCursor("exporter"))
+	require.Equal(t, uint64(0), node.GetSyncRound())
+}
+
 func TestErrors(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
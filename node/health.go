@@ -0,0 +1,298 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/partmonitor"
+	"github.com/algorand/go-algorand/util"
+)
+
+// HealthStatus is the outcome of a health check.
+type HealthStatus string
+
+const (
+	// HealthPass means the check found nothing wrong.
+	HealthPass HealthStatus = "pass"
+	// HealthWarn means the node works but is degraded.
+	HealthWarn HealthStatus = "warn"
+	// HealthFail means the node should not be relied upon.
+	HealthFail HealthStatus = "fail"
+)
+
+// severity orders statuses from pass to fail.
+func (s HealthStatus) severity() int {
+	switch s {
+	case HealthFail:
+		return 2
+	case HealthWarn:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// worse returns the more severe of two statuses.
+func (s HealthStatus) worse(other HealthStatus) HealthStatus {
+	if other.severity() > s.severity() {
+		return other
+	}
+	return s
+}
+
+// Names of the health checks.
+const (
+	HealthCheckCatchup       = "catchup"
+	HealthCheckNetworkLag    = "network-lag"
+	HealthCheckPeers         = "peers"
+	HealthCheckDisk          = "disk"
+	HealthCheckTrackerCommit = "tracker-commit"
+	HealthCheckParticipation = "participation"
+)
+
+// HealthCheck is the result of one of the node's health checks.
+type HealthCheck struct {
+	Name    string
+	Status  HealthStatus
+	Message string
+	// Details holds the values the check was evaluated on, such as round
+	// numbers, peer counts or free megabytes.
+	Details map[string]uint64
+}
+
+// HealthReport is the result of all the node's health checks.
+type HealthReport struct {
+	// Status is the most severe status of Checks.
+	Status HealthStatus
+	// Round is the latest round of the node's ledger.
+	Round  basics.Round
+	Checks []HealthCheck
+}
+
+// makeHealthReport summarizes checks into a HealthReport.
+func makeHealthReport(round basics.Round, checks ...HealthCheck) HealthReport {
+	report := HealthReport{Status: HealthPass, Round: round, Checks: checks}
+	for _, c := range checks {
+		report.Status = report.Status.worse(c.Status)
+	}
+	return report
+}
+
+// thresholdStatus returns the status of value against the warn and fail
+// thresholds it must not exceed. A zero threshold is disabled.
+func thresholdStatus(value, warn, fail uint64) HealthStatus {
+	switch {
+	case fail > 0 && value > fail:
+		return HealthFail
+	case warn > 0 && value > warn:
+		return HealthWarn
+	default:
+		return HealthPass
+	}
+}
+
+// catchupHealth checks that the node is neither stuck nor catching up.
+func catchupHealth(status StatusReport) HealthCheck {
+	check := HealthCheck{
+		Name:   HealthCheckCatchup,
+		Status: HealthPass,
+		Details: map[string]uint64{
+			"last-round":      uint64(status.LastRound),
+			"catchup-seconds": uint64(status.CatchupTime.Seconds()),
+		},
+	}
+	switch {
+	case status.StoppedAtUnsupportedRound:
+		check.Status = HealthFail
+		check.Message = fmt.Sprintf("stopped at round %d, the next protocol version is not supported", status.LastRound)
+	case status.Catchpoint != "":
+		check.Status = HealthFail
+		check.Message = fmt.Sprintf("fast catchup to catchpoint %s in progress", status.Catchpoint)
+		check.Details["catchpoint-acquired-blocks"] = status.CatchpointCatchupAcquiredBlocks
+		check.Details["catchpoint-total-blocks"] = status.CatchpointCatchupTotalBlocks
+		check.Details["catchpoint-processed-accounts"] = status.CatchpointCatchupProcessedAccounts
+		check.Details["catchpoint-total-accounts"] = status.CatchpointCatchupTotalAccounts
+	case status.CatchupTime > 0:
+		check.Status = HealthWarn
+		check.Message = fmt.Sprintf("catching up for %s", status.CatchupTime.Round(time.Second))
+	}
+	return check
+}
+
+// networkLagHealth checks how many rounds the node trails the latest round
+// its peers reported, or, when none did, how long it has been since the
+// last round was added.
+func networkLagHealth(status StatusReport, networkLatest basics.Round, cfg config.Local) HealthCheck {
+	lag := networkLatest.SubSaturate(status.LastRound)
+	check := HealthCheck{
+		Name:   HealthCheckNetworkLag,
+		Status: thresholdStatus(uint64(lag), cfg.HealthNetworkLagWarnRounds, cfg.HealthNetworkLagFailRounds),
+		Details: map[string]uint64{
+			"last-round":    uint64(status.LastRound),
+			"network-round": uint64(networkLatest),
+			"lag-rounds":    uint64(lag),
+		},
+	}
+	if check.Status != HealthPass {
+		check.Message = fmt.Sprintf("%d rounds behind the network", lag)
+		return check
+	}
+	sinceLastRound := status.TimeSinceLastRound()
+	if sinceLastRound >= agreement.DefaultDeadlineTimeout() {
+		check.Status = HealthWarn
+		check.Message = fmt.Sprintf("no new round for %s", sinceLastRound.Round(time.Second))
+	}
+	return check
+}
+
+// peersHealth checks the number of peers the node is connected to.
+func peersHealth(outgoing, incoming int, cfg config.Local) HealthCheck {
+	check := HealthCheck{
+		Name:   HealthCheckPeers,
+		Status: HealthPass,
+		Details: map[string]uint64{
+			"outgoing": uint64(outgoing),
+			"incoming": uint64(incoming),
+		},
+	}
+	switch {
+	case outgoing+incoming == 0:
+		check.Status = HealthFail
+		check.Message = "not connected to any peer"
+	case uint64(outgoing) < cfg.HealthMinOutgoingPeers:
+		check.Status = HealthWarn
+		check.Message = fmt.Sprintf("%d outgoing peers, expected at least %d", outgoing, cfg.HealthMinOutgoingPeers)
+	}
+	return check
+}
+
+// diskHealth checks the free space of the hot and cold data directories,
+// as reported by freeSpace.
+func diskHealth(dirs config.ResolvedGenesisDirs, freeSpace func(string) (uint64, error), cfg config.Local) HealthCheck {
+	check := HealthCheck{Name: HealthCheckDisk, Status: HealthPass, Details: map[string]uint64{}}
+	for _, d := range []struct{ label, path string }{{"hot", dirs.HotGenesisDir}, {"cold", dirs.ColdGenesisDir}} {
+		free, err := freeSpace(d.path)
+		if err != nil {
+			if check.Status == HealthPass {
+				check.Status = HealthWarn
+				check.Message = fmt.Sprintf("unable to read the free space of the %s data directory: %v", d.label, err)
+			}
+			continue
+		}
+		freeMB := free >> 20
+		check.Details[d.label+"-free-mb"] = freeMB
+		status := HealthPass
+		switch {
+		case cfg.HealthDiskFreeFailMB > 0 && freeMB < cfg.HealthDiskFreeFailMB:
+			status = HealthFail
+		case cfg.HealthDiskFreeWarnMB > 0 && freeMB < cfg.HealthDiskFreeWarnMB:
+			status = HealthWarn
+		}
+		if status.severity() > check.Status.severity() {
+			check.Status = status
+			check.Message = fmt.Sprintf("%d MB free in the %s data directory", freeMB, d.label)
+		}
+	}
+	return check
+}
+
+// trackerCommitHealth checks how many rounds the ledger trackers hold in
+// memory without having committed them to the tracker database.
+func trackerCommitHealth(latest, committed basics.Round, behind bool, cfg config.Local) HealthCheck {
+	lag := latest.SubSaturate(committed)
+	check := HealthCheck{
+		Name:   HealthCheckTrackerCommit,
+		Status: thresholdStatus(uint64(lag), cfg.HealthTrackerCommitLagWarnRounds, cfg.HealthTrackerCommitLagFailRounds),
+		Details: map[string]uint64{
+			"latest-round":    uint64(latest),
+			"committed-round": uint64(committed),
+			"lag-rounds":      uint64(lag),
+		},
+	}
+	if behind {
+		check.Status = check.Status.worse(HealthWarn)
+	}
+	if check.Status != HealthPass {
+		check.Message = fmt.Sprintf("%d rounds not committed to the tracker database", lag)
+	}
+	return check
+}
+
+// participationHealth checks the alerts raised by the participation monitor
+// about the accounts the node holds participation keys for. Expired keys and
+// suspended accounts fail the check; other alerts warn.
+func participationHealth(monitorEnabled bool, alerts []partmonitor.Alert) HealthCheck {
+	check := HealthCheck{Name: HealthCheckParticipation, Status: HealthPass, Details: map[string]uint64{}}
+	if !monitorEnabled {
+		check.Message = "participation monitor disabled"
+		return check
+	}
+	for _, a := range alerts {
+		check.Details[string(a.Type)]++
+		status := HealthWarn
+		if a.Type == partmonitor.KeyExpired || a.Type == partmonitor.AccountSuspended {
+			status = HealthFail
+		}
+		if status.severity() > check.Status.severity() {
+			check.Status = status
+			check.Message = fmt.Sprintf("%s: %s", a.Account, a.Message)
+		}
+	}
+	return check
+}
+
+// HealthChecks evaluates the health of the node against the thresholds of its configuration.
+func (node *AlgorandFullNode) HealthChecks() HealthReport {
+	status, err := node.Status()
+	if err != nil {
+		node.log.Warnf("HealthChecks: unable to get the node status: %v", err)
+	}
+	return makeHealthReport(status.LastRound,
+		catchupHealth(status),
+		networkLagHealth(status, node.catchupService.NetworkLatestRound(), node.config),
+		peersHealth(len(node.net.GetPeers(network.PeersConnectedOut)), len(node.net.GetPeers(network.PeersConnectedIn)), node.config),
+		diskHealth(node.genesisDirs, util.FreeDiskSpace, node.config),
+		trackerCommitHealth(node.ledger.Latest(), node.ledger.LatestTrackerCommitted(), node.ledger.IsBehindCommittingDeltas(), node.config),
+		participationHealth(node.config.EnableParticipationMonitor, node.participationMonitor.Alerts()),
+	)
+}
+
+// HealthChecks evaluates the health of the node against the thresholds of its configuration.
+// A follower does not participate, and does not count the rounds it is held back by its sync round as lag.
+func (node *AlgorandFollowerNode) HealthChecks() HealthReport {
+	status, err := node.Status()
+	if err != nil {
+		node.log.Warnf("HealthChecks: unable to get the node status: %v", err)
+	}
+	networkLatest := node.catchupService.NetworkLatestRound()
+	if syncRound := basics.Round(node.catchupService.GetDisableSyncRound()); syncRound != 0 && networkLatest >= syncRound {
+		networkLatest = syncRound - 1
+	}
+	return makeHealthReport(status.LastRound,
+		catchupHealth(status),
+		networkLagHealth(status, networkLatest, node.config),
+		peersHealth(len(node.net.GetPeers(network.PeersConnectedOut)), len(node.net.GetPeers(network.PeersConnectedIn)), node.config),
+		diskHealth(node.genesisDirs, util.FreeDiskSpace, node.config),
+		trackerCommitHealth(node.ledger.Latest(), node.ledger.LatestTrackerCommitted(), node.ledger.IsBehindCommittingDeltas(), node.config),
+	)
+}
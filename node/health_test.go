@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/partmonitor"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestHealthReportStatus(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, HealthPass, makeHealthReport(1).Status)
+	report := makeHealthReport(5,
+		HealthCheck{Name: "a", Status: HealthWarn},
+		HealthCheck{Name: "b", Status: HealthPass},
+	)
+	require.Equal(t, HealthWarn, report.Status)
+	require.EqualValues(t, 5, report.Round)
+	report = makeHealthReport(5,
+		HealthCheck{Name: "a", Status: HealthFail},
+		HealthCheck{Name: "b", Status: HealthWarn},
+	)
+	require.Equal(t, HealthFail, report.Status)
+}
+
+func TestCatchupHealth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, HealthPass, catchupHealth(StatusReport{LastRound: 10}).Status)
+	require.Equal(t, HealthWarn, catchupHealth(StatusReport{LastRound: 10, CatchupTime: time.Minute}).Status)
+
+	check := catchupHealth(StatusReport{Catchpoint: "1000#ABC", CatchpointCatchupAcquiredBlocks: 3, CatchpointCatchupTotalBlocks: 5})
+	require.Equal(t, HealthFail, check.Status)
+	require.Contains(t, check.Message, "1000#ABC")
+	require.EqualValues(t, 3, check.Details["catchpoint-acquired-blocks"])
+
+	require.Equal(t, HealthFail, catchupHealth(StatusReport{StoppedAtUnsupportedRound: true}).Status)
+}
+
+func TestNetworkLagHealth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.HealthNetworkLagWarnRounds = 2
+	cfg.HealthNetworkLagFailRounds = 10
+	status := StatusReport{LastRound: 100, LastRoundTimestamp: time.Now()}
+
+	for _, tc := range []struct {
+		network uint64
+		want    HealthStatus
+	}{
+		{0, HealthPass},
+		{99, HealthPass},
+		{102, HealthPass},
+		{103, HealthWarn},
+		{110, HealthWarn},
+		{111, HealthFail},
+	} {
+		check := networkLagHealth(status, basics.Round(tc.network), cfg)
+		require.Equal(t, tc.want, check.Status, "network round %d", tc.network)
+	}
+
+	cfg.HealthNetworkLagFailRounds = 0
+	require.Equal(t, HealthWarn, networkLagHealth(status, 1000, cfg).Status)
+
+	// no round for longer than the agreement deadline
+	status.LastRoundTimestamp = time.Now().Add(-time.Minute)
+	check := networkLagHealth(status, 0, cfg)
+	require.Equal(t, HealthWarn, check.Status)
+	require.Contains(t, check.Message, "no new round")
+}
+
+func TestPeersHealth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.HealthMinOutgoingPeers = 2
+
+	require.Equal(t, HealthFail, peersHealth(0, 0, cfg).Status)
+	require.Equal(t, HealthWarn, peersHealth(1, 10, cfg).Status)
+	check := peersHealth(4, 10, cfg)
+	require.Equal(t, HealthPass, check.Status)
+	require.EqualValues(t, 4, check.Details["outgoing"])
+	require.EqualValues(t, 10, check.Details["incoming"])
+}
+
+func TestDiskHealth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.HealthDiskFreeWarnMB = 100
+	cfg.HealthDiskFreeFailMB = 10
+	dirs := config.ResolvedGenesisDirs{HotGenesisDir: "/hot", ColdGenesisDir: "/cold"}
+	freeMB := map[string]uint64{}
+	freeSpace := func(path string) (uint64, error) {
+		if mb, ok := freeMB[path]; ok {
+			return mb << 20, nil
+		}
+		return 0, errors.New("no such directory")
+	}
+
+	freeMB["/hot"], freeMB["/cold"] = 1000, 1000
+	check := diskHealth(dirs, freeSpace, cfg)
+	require.Equal(t, HealthPass, check.Status)
+	require.EqualValues(t, 1000, check.Details["hot-free-mb"])
+
+	freeMB["/cold"] = 50
+	check = diskHealth(dirs, freeSpace, cfg)
+	require.Equal(t, HealthWarn, check.Status)
+	require.Contains(t, check.Message, "cold")
+
+	freeMB["/hot"] = 5
+	check = diskHealth(dirs, freeSpace, cfg)
+	require.Equal(t, HealthFail, check.Status)
+	require.Contains(t, check.Message, "hot")
+
+	delete(freeMB, "/hot")
+	delete(freeMB, "/cold")
+	require.Equal(t, HealthWarn, diskHealth(dirs, freeSpace, cfg).Status)
+}
+
+func TestTrackerCommitHealth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.HealthTrackerCommitLagWarnRounds = 256
+	cfg.HealthTrackerCommitLagFailRounds = 1024
+
+	require.Equal(t, HealthPass, trackerCommitHealth(1000, 990, false, cfg).Status)
+	require.Equal(t, HealthWarn, trackerCommitHealth(1000, 990, true, cfg).Status)
+	require.Equal(t, HealthWarn, trackerCommitHealth(1000, 500, false, cfg).Status)
+	check := trackerCommitHealth(3000, 1000, true, cfg)
+	require.Equal(t, HealthFail, check.Status)
+	require.EqualValues(t, 2000, check.Details["lag-rounds"])
+}
+
+func TestParticipationHealth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	check := participationHealth(false, nil)
+	require.Equal(t, HealthPass, check.Status)
+	require.Contains(t, check.Message, "disabled")
+
+	require.Equal(t, HealthPass, participationHealth(true, nil).Status)
+
+	alerts := []partmonitor.Alert{{Type: partmonitor.KeyExpiring, Message: "expiring"}}
+	check = participationHealth(true, alerts)
+	require.Equal(t, HealthWarn, check.Status)
+	require.Contains(t, check.Message, "expiring")
+
+	alerts = append(alerts, partmonitor.Alert{Type: partmonitor.KeyExpired, Message: "expired"}, partmonitor.Alert{Type: partmonitor.NoRecentVotes, Message: "idle"})
+	check = participationHealth(true, alerts)
+	require.Equal(t, HealthFail, check.Status)
+	require.Contains(t, check.Message, "expired")
+	require.EqualValues(t, 1, check.Details[string(partmonitor.KeyExpired)])
+	require.EqualValues(t, 1, check.Details[string(partmonitor.KeyExpiring)])
+}
@@ -99,6 +99,10 @@ func (node *AlgorandFullNode) MakePrioResponse(challenge string) []byte {
 	// it's unlikely to be deleted from underneath of us.
 	voteRound := latest + 2
 	for _, part := range node.accountManager.Keys(voteRound) {
+		if part.Signer != nil {
+			// remote signers only sign consensus messages.
+			continue
+		}
 		parent := part.Account
 		data, err := node.ledger.LookupAgreement(latest, parent)
 		if err != nil {
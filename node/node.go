@@ -67,6 +67,9 @@ import (
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/network/p2p"
+	"github.com/algorand/go-algorand/partmonitor"
+	"github.com/algorand/go-algorand/partrotation"
+	"github.com/algorand/go-algorand/partsigner"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/stateproof"
@@ -114,6 +117,7 @@ type StatusReport struct {
 	UpgradeDelay                       uint64
 	NextProtocolVoteBefore             basics.Round
 	NextProtocolApprovals              uint64
+	P2PReachability                    string // the node reachability as determined by AutoNAT, or empty when not running a p2p network.
 }
 
 // TimeSinceLastRound returns the time since the last block was approved (locally), or 0 if no blocks seen
@@ -174,6 +178,11 @@ type AlgorandFullNode struct {
 	partHandles      []db.Accessor
 
 	heartbeatService *heartbeat.Service
+
+	participationMonitor *partmonitor.Service
+
+	// participationRotation is nil unless ParticipationKeyRotationFile is set
+	participationRotation *partrotation.Service
 }
 
 // TxnWithStatus represents information about a single transaction,
@@ -231,8 +240,13 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 			return nil, err
 		}
 	} else {
+		identityOpts, err0 := network.MakeAllowlistIdentityOpts(node.config, rootDir)
+		if err0 != nil {
+			log.Errorf("could not load peer allowlist: %v", err0)
+			return nil, err0
+		}
 		var wsNode *network.WebsocketNetwork
-		wsNode, err = network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node, nil)
+		wsNode, err = network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node, identityOpts)
 		if err != nil {
 			log.Errorf("could not create websocket node: %v", err)
 			return nil, err
@@ -268,6 +282,14 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		return nil, err
 	}
 
+	if cfg.ParticipationSignerSocket != "" {
+		signerSocket := cfg.ParticipationSignerSocket
+		if !filepath.IsAbs(signerSocket) {
+			signerSocket = filepath.Join(rootDir, signerSocket)
+		}
+		node.accountManager.SetRemoteSigner(partsigner.MakeClient(signerSocket, node.log))
+	}
+
 	node.oldKeyDeletionNotify = make(chan struct{}, 1)
 
 	node.transactionPool = pools.MakeTransactionPool(node.ledger.Ledger, cfg, node.log, node)
@@ -359,6 +381,25 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 	node.heartbeatService = heartbeat.NewService(node.accountManager, node.ledger, node, node.log)
 
+	node.participationMonitor = partmonitor.NewService(cfg, node.accountManager.Registry(), node.ledger, node.log)
+
+	if cfg.ParticipationKeyRotationFile != "" {
+		rotationFile := cfg.ParticipationKeyRotationFile
+		if !filepath.IsAbs(rotationFile) {
+			rotationFile = filepath.Join(rootDir, rotationFile)
+		}
+		rotationCfg, err := partrotation.LoadConfig(rotationFile)
+		if err != nil {
+			log.Errorf("unable to load participation key rotation configuration: %v", err)
+			return nil, err
+		}
+		node.participationRotation, err = partrotation.NewService(rotationCfg, node.accountManager.Registry(), node.ledger, node, node.log)
+		if err != nil {
+			log.Errorf("unable to create participation key rotation service: %v", err)
+			return nil, err
+		}
+	}
+
 	return node, err
 }
 
@@ -402,6 +443,10 @@ func (node *AlgorandFullNode) Start() error {
 		node.txHandler.Start()
 		node.stateProofWorker.Start()
 		node.heartbeatService.Start()
+		node.participationMonitor.Start()
+		if node.participationRotation != nil {
+			node.participationRotation.Start()
+		}
 		err := startNetwork()
 		if err != nil {
 			return err
@@ -427,6 +472,30 @@ func (node *AlgorandFullNode) Capabilities() []p2p.Capability {
 	return caps
 }
 
+// ProvidedData implements network.DataProviderInfo, describing the blocks and catchpoints this node serves.
+// Archival nodes only advertise the Archival capability since they hold every block.
+func (node *AlgorandFullNode) ProvidedData() (data p2p.ProvidedData) {
+	if !node.config.IsGossipServer() {
+		return
+	}
+	if node.config.EnableBlockService && !node.config.Archival {
+		earliest, err := node.ledger.EarliestBlock()
+		if err != nil {
+			node.log.Warnf("ProvidedData: unable to get the earliest block: %v", err)
+		} else {
+			data.HasBlocks = true
+			data.FirstRound = uint64(earliest)
+			data.LastRound = uint64(node.ledger.Latest())
+		}
+	}
+	if node.config.EnableLedgerService && node.config.StoresCatchpoints() {
+		if label := node.ledger.GetLastCatchpointLabel(); label != "" {
+			data.Catchpoints = append(data.Catchpoints, label)
+		}
+	}
+	return
+}
+
 // startMonitoringRoutines starts the internal monitoring routines used by the node.
 func (node *AlgorandFullNode) startMonitoringRoutines() {
 	node.monitoringRoutinesWaitGroup.Add(2)
@@ -481,6 +550,10 @@ func (node *AlgorandFullNode) Stop() {
 	if node.catchpointCatchupService != nil {
 		node.catchpointCatchupService.Stop()
 	} else {
+		if node.participationRotation != nil {
+			node.participationRotation.Stop()
+		}
+		node.participationMonitor.Stop()
 		node.heartbeatService.Stop()
 		node.stateProofWorker.Stop()
 		node.txHandler.Stop()
@@ -755,6 +828,9 @@ func (node *AlgorandFullNode) Status() (StatusReport, error) {
 
 	s.LastRoundTimestamp = lastRoundTimestamp
 	s.HasSyncedSinceStartup = hasSyncedSinceStartup
+	if rr, ok := node.net.(network.ReachabilityReporter); ok {
+		s.P2PReachability = rr.P2PReachability()
+	}
 
 	return s, err
 }
@@ -1243,6 +1319,10 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 			}()
 			node.net.ClearHandlers()
 			node.net.ClearValidatorHandlers()
+			if node.participationRotation != nil {
+				node.participationRotation.Stop()
+			}
+			node.participationMonitor.Stop()
 			node.heartbeatService.Stop()
 			node.stateProofWorker.Stop()
 			node.txHandler.Stop()
@@ -1273,6 +1353,10 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 		node.txHandler.Start()
 		node.stateProofWorker.Start()
 		node.heartbeatService.Start()
+		node.participationMonitor.Start()
+		if node.participationRotation != nil {
+			node.participationRotation.Start()
+		}
 
 		// Set up a context we can use to cancel goroutines on Stop()
 		node.ctx, node.cancelCtx = context.WithCancel(context.Background())
@@ -1408,6 +1492,11 @@ func (node *AlgorandFullNode) VotingKeys(votingRound, keysRound basics.Round) []
 		participations = append(participations, p)
 		matchingAccountsKeys[p.Account] = true
 
+		if p.Signer != nil {
+			// keys held by a remote signer are not tracked by the registry.
+			continue
+		}
+
 		// Make sure the key is registered.
 		err := node.accountManager.Registry().Register(p.ParticipationID, votingRound)
 		if err != nil {
@@ -1475,6 +1564,26 @@ func (node *AlgorandFullNode) GetSyncRound() uint64 {
 func (node *AlgorandFullNode) UnsetSyncRound() {
 }
 
+// ListSyncCursors returns no cursors in the base node implementation
+func (node *AlgorandFullNode) ListSyncCursors() []SyncCursor {
+	return nil
+}
+
+// RegisterSyncCursor errors in the base node implementation
+func (node *AlgorandFullNode) RegisterSyncCursor(_ string, _ uint64) error {
+	return ErrSyncCursorsUnsupported
+}
+
+// AdvanceSyncCursor errors in the base node implementation
+func (node *AlgorandFullNode) AdvanceSyncCursor(_ string, _ uint64) error {
+	return ErrSyncCursorsUnsupported
+}
+
+// DeleteSyncCursor errors in the base node implementation
+func (node *AlgorandFullNode) DeleteSyncCursor(_ string) error {
+	return ErrSyncCursorsUnsupported
+}
+
 // SetBlockTimeStampOffset sets a timestamp offset in the block header.
 // This is only available in dev mode.
 func (node *AlgorandFullNode) SetBlockTimeStampOffset(offset int64) error {
@@ -1485,6 +1594,15 @@ func (node *AlgorandFullNode) SetBlockTimeStampOffset(offset int64) error {
 	return fmt.Errorf("cannot set block timestamp offset when not in dev mode")
 }
 
+// AgreementTimelines returns the agreement timelines of the most recent rounds, oldest first.
+// The number of retained rounds is set by AgreementTimelineRounds.
+func (node *AlgorandFullNode) AgreementTimelines() ([]agreement.RoundTimeline, error) {
+	if node.agreementService == nil {
+		return nil, fmt.Errorf("agreement service is not running")
+	}
+	return node.agreementService.RoundTimelines(), nil
+}
+
 // GetBlockTimeStampOffset gets a timestamp offset.
 // This is only available in dev mode.
 func (node *AlgorandFullNode) GetBlockTimeStampOffset() (*int64, error) {
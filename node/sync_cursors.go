@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+// ErrSyncCursorNotFound is returned when the named sync cursor is not registered.
+var ErrSyncCursorNotFound = errors.New("sync cursor not found")
+
+// ErrSyncCursorExists is returned when registering a sync cursor under a name already in use.
+var ErrSyncCursorExists = errors.New("sync cursor already exists")
+
+// ErrSyncCursorInvalidName is returned when a sync cursor name is empty, too long or has unsupported characters.
+var ErrSyncCursorInvalidName = errors.New("sync cursor names must be 1 to 64 letters, digits, '.', '_' or '-'")
+
+// ErrSyncCursorBackwards is returned when advancing a sync cursor to an earlier round.
+var ErrSyncCursorBackwards = errors.New("sync cursor cannot be moved to an earlier round")
+
+// ErrSyncCursorsUnsupported is returned by nodes not running in follower mode.
+var ErrSyncCursorsUnsupported = errors.New("sync cursors are only supported in follower mode")
+
+var syncCursorNameRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// SyncCursor is a named retention cursor of a follower node. Each consumer of the ledger state deltas
+// keeps its own cursor at the first round it has not consumed yet, and the node does not sync past the
+// rounds whose deltas the lowest cursor still needs.
+type SyncCursor struct {
+	Name  string       `json:"name"`
+	Round basics.Round `json:"round"`
+}
+
+// syncCursorsFile is the content of the config.SyncCursorsFilename file.
+type syncCursorsFile struct {
+	Cursors map[string]basics.Round `json:"cursors"`
+}
+
+// loadSyncCursors reads the sync cursors saved in filename. A missing file holds no cursors.
+func loadSyncCursors(filename string) (map[string]basics.Round, error) {
+	var f syncCursorsFile
+	err := codecs.LoadObjectFromFile(filename, &f)
+	if errors.Is(err, os.ErrNotExist) {
+		return make(map[string]basics.Round), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to load sync cursors from %s: %w", filename, err)
+	}
+	if f.Cursors == nil {
+		f.Cursors = make(map[string]basics.Round)
+	}
+	for name := range f.Cursors {
+		if !syncCursorNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf("unable to load sync cursors from %s: %q: %w", filename, name, ErrSyncCursorInvalidName)
+		}
+	}
+	return f.Cursors, nil
+}
+
+// saveSyncCursors replaces the content of filename with the given sync cursors.
+func saveSyncCursors(filename string, cursors map[string]basics.Round) error {
+	tmp := filename + ".tmp"
+	err := codecs.SaveObjectToFile(tmp, syncCursorsFile{Cursors: cursors}, true)
+	if err != nil {
+		return fmt.Errorf("unable to save sync cursors to %s: %w", tmp, err)
+	}
+	return os.Rename(tmp, filename)
+}
+
+// sortedSyncCursors returns the cursors ordered by name.
+func sortedSyncCursors(cursors map[string]basics.Round) []SyncCursor {
+	out := make([]SyncCursor, 0, len(cursors))
+	for name, rnd := range cursors {
+		out = append(out, SyncCursor{Name: name, Round: rnd})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
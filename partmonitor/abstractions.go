@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partmonitor
+
+import (
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// ledger represents the aspects of the "real" Ledger that the participation
+// monitor needs to interact with
+type ledger interface {
+	// LastRound tells the round is ready for checking
+	LastRound() basics.Round
+
+	// WaitMem allows the Service to wait for the results of a round to be available
+	WaitMem(r basics.Round) chan struct{}
+
+	// LookupAccount allows the Service to observe the on-chain status of accounts
+	LookupAccount(round basics.Round, addr basics.Address) (data ledgercore.AccountData, validThrough basics.Round, withoutRewards basics.MicroAlgos, err error)
+}
+
+// registry captures the aspects of the ParticipationRegistry that are used by
+// this package: the installed keys and their recorded usage.
+type registry interface {
+	GetAll() []account.ParticipationRecord
+}
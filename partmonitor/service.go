@@ -0,0 +1,360 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partmonitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// AlertType identifies a participation health problem.
+type AlertType string
+
+const (
+	// KeyExpiring is raised when the registered participation key of an online
+	// account expires within ParticipationKeyExpiryWarningRounds.
+	KeyExpiring AlertType = "key-expiring"
+
+	// KeyExpired is raised when the registered participation key of an online
+	// account has expired, so the account no longer votes.
+	KeyExpired AlertType = "key-expired"
+
+	// NoRecentVotes is raised when an online account whose key is installed
+	// has not voted in ParticipationVoteInactivityRounds.
+	NoRecentVotes AlertType = "no-recent-votes"
+
+	// AccountOffline is raised when an account has a participation key valid
+	// for the current round but is offline on-chain.
+	AccountOffline AlertType = "account-offline"
+
+	// AccountSuspended is raised when an account with a participation key was
+	// suspended for failing to propose or heartbeat.
+	AccountSuspended AlertType = "account-suspended"
+)
+
+var alertTypes = []AlertType{KeyExpiring, KeyExpired, NoRecentVotes, AccountOffline, AccountSuspended}
+
+// Alert describes a participation health problem of an account.
+type Alert struct {
+	Type    AlertType
+	Account basics.Address
+	// Round is the round at which the problem was detected.
+	Round basics.Round
+	// ParticipationID identifies the key concerned, if any.
+	ParticipationID account.ParticipationID
+	Message         string
+}
+
+var keyRoundsRemainingGauge = metrics.MakeGauge(metrics.ParticipationKeyRoundsRemaining)
+var roundsSinceVoteGauge = metrics.MakeGauge(metrics.ParticipationRoundsSinceVote)
+var accountOnlineGauge = metrics.MakeGauge(metrics.ParticipationAccountOnline)
+var alertsGauge = metrics.MakeGauge(metrics.ParticipationAlerts)
+
+// Service watches the accounts the node holds participation keys for, and
+// warns operators before (or as soon as) they stop participating.
+type Service struct {
+	enabled         bool
+	expiryWarning   basics.Round
+	inactivityLimit basics.Round
+
+	registry registry
+	ledger   ledger
+	webhook  *webhook
+
+	// alerts currently raised, by account
+	alerts map[basics.Address]map[AlertType]Alert
+	mu     sync.Mutex
+
+	// infrastructure
+	ctx      context.Context
+	shutdown context.CancelFunc
+	wg       sync.WaitGroup
+	log      logging.Logger
+}
+
+// NewService creates a participation monitor for the keys in registry.
+func NewService(cfg config.Local, registry registry, ledger ledger, log logging.Logger) *Service {
+	s := &Service{
+		enabled:         cfg.EnableParticipationMonitor,
+		expiryWarning:   basics.Round(cfg.ParticipationKeyExpiryWarningRounds),
+		inactivityLimit: basics.Round(cfg.ParticipationVoteInactivityRounds),
+		registry:        registry,
+		ledger:          ledger,
+		alerts:          make(map[basics.Address]map[AlertType]Alert),
+		log:             log.With("Context", "partmonitor"),
+	}
+	if cfg.ParticipationAlertWebhookURL != "" {
+		s.webhook = makeWebhook(cfg.ParticipationAlertWebhookURL, s.log)
+	}
+	return s
+}
+
+// Start starts the goroutines for the Service, if it is enabled.
+func (s *Service) Start() {
+	if !s.enabled {
+		return
+	}
+	s.ctx, s.shutdown = context.WithCancel(context.Background())
+	if s.webhook != nil {
+		s.wg.Add(1)
+		go s.webhook.run(s.ctx, &s.wg)
+	}
+	s.wg.Add(1)
+	s.log.Info("starting participation monitor")
+	go s.loop()
+}
+
+// Stop any goroutines associated with this worker.
+func (s *Service) Stop() {
+	if !s.enabled {
+		return
+	}
+	s.log.Debug("participation monitor is stopping")
+	defer s.log.Debug("participation monitor has stopped")
+	s.shutdown()
+	s.wg.Wait()
+}
+
+// Alerts returns the alerts currently raised, ordered by account and type.
+func (s *Service) Alerts() []Alert {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []Alert
+	for _, alerts := range s.alerts {
+		for _, a := range alerts {
+			out = append(out, a)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Account != out[j].Account {
+			return out[i].Account.String() < out[j].Account.String()
+		}
+		return out[i].Type < out[j].Type
+	})
+	return out
+}
+
+func (s *Service) loop() {
+	defer s.wg.Done()
+	latest := s.ledger.LastRound()
+	s.evaluate(latest)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-s.ledger.WaitMem(latest + 1):
+		}
+		latest = s.ledger.LastRound()
+		s.evaluate(latest)
+	}
+}
+
+// evaluate checks the health of every account with installed participation
+// keys as of round latest, and raises or clears alerts accordingly.
+func (s *Service) evaluate(latest basics.Round) {
+	keys := make(map[basics.Address][]account.ParticipationRecord)
+	for _, record := range s.registry.GetAll() {
+		keys[record.Account] = append(keys[record.Account], record)
+	}
+
+	current := make(map[basics.Address]map[AlertType]Alert, len(keys))
+	for addr, records := range keys {
+		acct, _, _, err := s.ledger.LookupAccount(latest, addr)
+		if err != nil {
+			s.log.Warnf("participation monitor could not look up %v at round %d: %v", addr, latest, err)
+			// keep whatever was raised until the account can be looked up again
+			s.mu.Lock()
+			current[addr] = s.alerts[addr]
+			s.mu.Unlock()
+			continue
+		}
+		current[addr] = s.check(addr, records, acct, latest)
+	}
+
+	s.mu.Lock()
+	previous := s.alerts
+	for addr, alerts := range current {
+		for t, a := range alerts {
+			// alerts which are still raised keep the round they were raised at
+			if prev, ok := previous[addr][t]; ok {
+				a.Round = prev.Round
+				alerts[t] = a
+			}
+		}
+	}
+	s.alerts = current
+	s.mu.Unlock()
+
+	for addr, alerts := range current {
+		for t, a := range alerts {
+			if _, ok := previous[addr][t]; !ok {
+				s.raise(a)
+			}
+		}
+		for _, t := range alertTypes {
+			_, raised := alerts[t]
+			alertsGauge.SetLabels(boolToUint64(raised), map[string]string{"account": addr.String(), "alert": string(t)})
+		}
+	}
+	for addr, alerts := range previous {
+		for t, a := range alerts {
+			if _, ok := current[addr][t]; !ok {
+				s.clear(a, latest)
+			}
+		}
+		// accounts without keys anymore are no longer monitored
+		if _, ok := current[addr]; !ok {
+			deleteAccountMetrics(addr)
+		}
+	}
+}
+
+// deleteAccountMetrics stops reporting the metrics of an account.
+func deleteAccountMetrics(addr basics.Address) {
+	labels := map[string]string{"account": addr.String()}
+	accountOnlineGauge.DeleteLabels(labels)
+	keyRoundsRemainingGauge.DeleteLabels(labels)
+	roundsSinceVoteGauge.DeleteLabels(labels)
+	for _, t := range alertTypes {
+		alertsGauge.DeleteLabels(map[string]string{"account": addr.String(), "alert": string(t)})
+	}
+}
+
+// check returns the alerts for a single account, given its installed keys and
+// its on-chain state as of round latest.
+func (s *Service) check(addr basics.Address, records []account.ParticipationRecord, acct ledgercore.AccountData, latest basics.Round) map[AlertType]Alert {
+	alerts := make(map[AlertType]Alert)
+	labels := map[string]string{"account": addr.String()}
+	alert := func(t AlertType, id account.ParticipationID, format string, args ...interface{}) {
+		alerts[t] = Alert{Type: t, Account: addr, Round: latest, ParticipationID: id, Message: fmt.Sprintf(format, args...)}
+	}
+
+	// the installed key matching the on-chain registration, if any
+	var registered *account.ParticipationRecord
+	var liveKey *account.ParticipationRecord
+	var newest basics.Round
+	for i := range records {
+		r := &records[i]
+		if acct.VoteID == r.Voting.OneTimeSignatureVerifier && !acct.VoteID.IsEmpty() {
+			registered = r
+		}
+		if r.OverlapsInterval(latest, latest) {
+			liveKey = r
+		}
+		newest = max(newest, r.LastValid)
+	}
+
+	online := acct.Status == basics.Online
+	accountOnlineGauge.SetLabels(boolToUint64(online), labels)
+
+	switch {
+	case online && acct.VoteLastValid < latest:
+		id := account.ParticipationID{}
+		if registered != nil {
+			id = registered.ParticipationID
+		}
+		alert(KeyExpired, id, "participation key of %v expired at round %d", addr, acct.VoteLastValid)
+		keyRoundsRemainingGauge.SetLabels(0, labels)
+	case online:
+		remaining := acct.VoteLastValid - latest
+		keyRoundsRemainingGauge.SetLabels(uint64(remaining), labels)
+		if remaining <= s.expiryWarning {
+			id := account.ParticipationID{}
+			if registered != nil {
+				id = registered.ParticipationID
+			}
+			if newest > acct.VoteLastValid {
+				alert(KeyExpiring, id, "participation key of %v expires in %d rounds, at round %d; a newer key is installed but not registered", addr, remaining, acct.VoteLastValid)
+			} else {
+				alert(KeyExpiring, id, "participation key of %v expires in %d rounds, at round %d", addr, remaining, acct.VoteLastValid)
+			}
+		}
+	case acct.Suspended():
+		id := account.ParticipationID{}
+		if registered != nil {
+			id = registered.ParticipationID
+		}
+		alert(AccountSuspended, id, "%v was suspended; it must send a keyreg transaction to participate again", addr)
+	case liveKey != nil:
+		alert(AccountOffline, liveKey.ParticipationID, "%v has a participation key valid for round %d but is offline", addr, latest)
+	}
+
+	if online && registered != nil && acct.VoteLastValid >= latest {
+		// voting starts once the registration takes effect, which is at
+		// least VoteFirstValid
+		since := max(registered.LastVote, registered.EffectiveFirst, acct.VoteFirstValid)
+		if since <= latest {
+			roundsSinceVoteGauge.SetLabels(uint64(latest-since), labels)
+		}
+		if s.inactivityLimit != 0 && since+s.inactivityLimit < latest {
+			if registered.LastVote == 0 {
+				alert(NoRecentVotes, registered.ParticipationID, "%v has not voted since its key took effect at round %d", addr, since)
+			} else {
+				alert(NoRecentVotes, registered.ParticipationID, "%v has not voted since round %d", addr, registered.LastVote)
+			}
+		}
+	}
+	return alerts
+}
+
+func (s *Service) raise(a Alert) {
+	s.log.Warnf("participation alert %s: %s", a.Type, a.Message)
+	s.log.EventWithDetails(telemetryspec.Accounts, telemetryspec.ParticipationAlertEvent, eventDetails(a))
+	if s.webhook != nil {
+		s.webhook.notify(makeWebhookPayload("raised", a))
+	}
+}
+
+func (s *Service) clear(a Alert, latest basics.Round) {
+	s.log.Infof("participation alert %s for %v cleared at round %d", a.Type, a.Account, latest)
+	a.Round = latest
+	s.log.EventWithDetails(telemetryspec.Accounts, telemetryspec.ParticipationAlertClearedEvent, eventDetails(a))
+	if s.webhook != nil {
+		s.webhook.notify(makeWebhookPayload("cleared", a))
+	}
+}
+
+func eventDetails(a Alert) telemetryspec.ParticipationAlertEventDetails {
+	d := telemetryspec.ParticipationAlertEventDetails{
+		Address: a.Account.String(),
+		Alert:   string(a.Type),
+		Round:   uint64(a.Round),
+		Message: a.Message,
+	}
+	if !a.ParticipationID.IsZero() {
+		d.ParticipationID = a.ParticipationID.String()
+	}
+	return d
+}
+
+func boolToUint64(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,322 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partmonitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-deadlock"
+)
+
+type mockedLedger struct {
+	mu       deadlock.Mutex
+	round    basics.Round
+	accounts map[basics.Address]ledgercore.AccountData
+	waiters  map[basics.Round]chan struct{}
+}
+
+func (l *mockedLedger) LastRound() basics.Round {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.round
+}
+
+func (l *mockedLedger) WaitMem(r basics.Round) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.waiters == nil {
+		l.waiters = make(map[basics.Round]chan struct{})
+	}
+	ch, ok := l.waiters[r]
+	if !ok {
+		ch = make(chan struct{})
+		l.waiters[r] = ch
+		if r <= l.round {
+			close(ch)
+		}
+	}
+	return ch
+}
+
+func (l *mockedLedger) LookupAccount(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.accounts[addr], l.round, basics.MicroAlgos{}, nil
+}
+
+func (l *mockedLedger) set(addr basics.Address, data ledgercore.AccountData) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.accounts[addr] = data
+}
+
+func (l *mockedLedger) advance(r basics.Round) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.round = r
+	for wr, ch := range l.waiters {
+		if wr <= r {
+			close(ch)
+			delete(l.waiters, wr)
+		}
+	}
+}
+
+type mockedRegistry struct {
+	mu      deadlock.Mutex
+	records []account.ParticipationRecord
+}
+
+func (r *mockedRegistry) GetAll() []account.ParticipationRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]account.ParticipationRecord(nil), r.records...)
+}
+
+func makeRecord(addr basics.Address, first, last basics.Round) account.ParticipationRecord {
+	var id account.ParticipationID
+	crypto.RandBytes(id[:])
+	secrets := crypto.GenerateOneTimeSignatureSecrets(0, 1)
+	return account.ParticipationRecord{
+		ParticipationID: id,
+		Account:         addr,
+		FirstValid:      first,
+		LastValid:       last,
+		Voting:          secrets,
+	}
+}
+
+func onlineData(record account.ParticipationRecord) ledgercore.AccountData {
+	var data ledgercore.AccountData
+	data.Status = basics.Online
+	data.VoteID = record.Voting.OneTimeSignatureVerifier
+	data.VoteFirstValid = record.FirstValid
+	data.VoteLastValid = record.LastValid
+	return data
+}
+
+func testAddress() basics.Address {
+	var addr basics.Address
+	crypto.RandBytes(addr[:])
+	return addr
+}
+
+func makeTestService(t *testing.T, l *mockedLedger, r *mockedRegistry, webhookURL string) *Service {
+	cfg := config.GetDefaultLocal()
+	cfg.EnableParticipationMonitor = true
+	cfg.ParticipationKeyExpiryWarningRounds = 100
+	cfg.ParticipationVoteInactivityRounds = 50
+	cfg.ParticipationAlertWebhookURL = webhookURL
+	log := logging.TestingLog(t)
+	return NewService(cfg, r, l, log)
+}
+
+func alertTypesOf(alerts []Alert) []AlertType {
+	var out []AlertType
+	for _, a := range alerts {
+		out = append(out, a.Type)
+	}
+	return out
+}
+
+func TestKeyExpiry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := testAddress()
+	record := makeRecord(addr, 0, 1000)
+	record.EffectiveFirst = 1
+	l := &mockedLedger{accounts: map[basics.Address]ledgercore.AccountData{addr: onlineData(record)}}
+	r := &mockedRegistry{records: []account.ParticipationRecord{record}}
+	s := makeTestService(t, l, r, "")
+	s.inactivityLimit = 0
+
+	s.evaluate(800)
+	require.Empty(t, s.Alerts())
+	require.EqualValues(t, 200, keyRoundsRemainingGauge.GetUint64ValueForLabels(map[string]string{"account": addr.String()}))
+
+	s.evaluate(920)
+	alerts := s.Alerts()
+	require.Equal(t, []AlertType{KeyExpiring}, alertTypesOf(alerts))
+	require.Equal(t, addr, alerts[0].Account)
+	require.Equal(t, record.ParticipationID, alerts[0].ParticipationID)
+	require.EqualValues(t, 920, alerts[0].Round)
+	require.EqualValues(t, 1, alertsGauge.GetUint64ValueForLabels(map[string]string{"account": addr.String(), "alert": string(KeyExpiring)}))
+
+	// alerts keep the round at which they were raised
+	s.evaluate(930)
+	require.EqualValues(t, 920, s.Alerts()[0].Round)
+
+	s.evaluate(1001)
+	require.Equal(t, []AlertType{KeyExpired}, alertTypesOf(s.Alerts()))
+	require.EqualValues(t, 0, alertsGauge.GetUint64ValueForLabels(map[string]string{"account": addr.String(), "alert": string(KeyExpiring)}))
+
+	// registering a new key clears the alerts
+	renewed := makeRecord(addr, 900, 5000)
+	renewed.EffectiveFirst = 1001
+	r.records = append(r.records, renewed)
+	l.set(addr, onlineData(renewed))
+	s.evaluate(1002)
+	require.Empty(t, s.Alerts())
+}
+
+func TestNoRecentVotes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := testAddress()
+	record := makeRecord(addr, 0, 10000)
+	record.EffectiveFirst = 100
+	l := &mockedLedger{accounts: map[basics.Address]ledgercore.AccountData{addr: onlineData(record)}}
+	r := &mockedRegistry{records: []account.ParticipationRecord{record}}
+	s := makeTestService(t, l, r, "")
+
+	// the key only took effect at round 100
+	s.evaluate(140)
+	require.Empty(t, s.Alerts())
+
+	s.evaluate(151)
+	require.Equal(t, []AlertType{NoRecentVotes}, alertTypesOf(s.Alerts()))
+
+	r.records[0].LastVote = 150
+	s.evaluate(160)
+	require.Empty(t, s.Alerts())
+	require.EqualValues(t, 10, roundsSinceVoteGauge.GetUint64ValueForLabels(map[string]string{"account": addr.String()}))
+}
+
+func TestOfflineAndSuspended(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := testAddress()
+	record := makeRecord(addr, 0, 10000)
+	l := &mockedLedger{accounts: map[basics.Address]ledgercore.AccountData{}}
+	r := &mockedRegistry{records: []account.ParticipationRecord{record}}
+	s := makeTestService(t, l, r, "")
+
+	// never registered
+	s.evaluate(10)
+	require.Equal(t, []AlertType{AccountOffline}, alertTypesOf(s.Alerts()))
+	require.EqualValues(t, 0, accountOnlineGauge.GetUint64ValueForLabels(map[string]string{"account": addr.String()}))
+
+	data := onlineData(record)
+	data.Suspend()
+	l.set(addr, data)
+	s.evaluate(11)
+	require.Equal(t, []AlertType{AccountSuspended}, alertTypesOf(s.Alerts()))
+
+	// keys which are not valid yet do not make an offline account unhealthy
+	r.records[0] = makeRecord(addr, 100, 10000)
+	l.set(addr, ledgercore.AccountData{})
+	s.evaluate(12)
+	require.Empty(t, s.Alerts())
+}
+
+func TestRemovedKeyMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := testAddress()
+	record := makeRecord(addr, 0, 1000)
+	record.EffectiveFirst = 100
+	l := &mockedLedger{accounts: map[basics.Address]ledgercore.AccountData{addr: onlineData(record)}}
+	r := &mockedRegistry{records: []account.ParticipationRecord{record}}
+	s := makeTestService(t, l, r, "")
+
+	reported := func() string {
+		var buf strings.Builder
+		for _, g := range []interface{ WriteMetric(*strings.Builder, string) }{accountOnlineGauge, keyRoundsRemainingGauge, roundsSinceVoteGauge, alertsGauge} {
+			g.WriteMetric(&buf, "")
+		}
+		return buf.String()
+	}
+
+	s.evaluate(920)
+	require.Equal(t, []AlertType{KeyExpiring, NoRecentVotes}, alertTypesOf(s.Alerts()))
+	require.Contains(t, reported(), addr.String())
+
+	// once the key is deleted, the account's metrics are no longer reported
+	r.mu.Lock()
+	r.records = nil
+	r.mu.Unlock()
+	s.evaluate(921)
+	require.Empty(t, s.Alerts())
+	require.NotContains(t, reported(), addr.String())
+}
+
+func TestWebhook(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	received := make(chan webhookPayload, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p webhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&p); err == nil {
+			received <- p
+		}
+	}))
+	defer server.Close()
+
+	addr := testAddress()
+	record := makeRecord(addr, 0, 1000)
+	l := &mockedLedger{round: 950, accounts: map[basics.Address]ledgercore.AccountData{addr: onlineData(record)}}
+	r := &mockedRegistry{records: []account.ParticipationRecord{record}}
+	s := makeTestService(t, l, r, server.URL)
+	s.inactivityLimit = 0
+	s.Start()
+	defer s.Stop()
+
+	select {
+	case p := <-received:
+		require.Equal(t, "raised", p.Event)
+		require.Equal(t, string(KeyExpiring), p.Alert)
+		require.Equal(t, addr.String(), p.Address)
+		require.EqualValues(t, 950, p.Round)
+		require.Equal(t, record.ParticipationID.String(), p.ParticipationID)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "webhook was not called")
+	}
+
+	renewed := makeRecord(addr, 900, 5000)
+	r.mu.Lock()
+	r.records = append(r.records, renewed)
+	r.mu.Unlock()
+	l.set(addr, onlineData(renewed))
+	l.advance(951)
+	select {
+	case p := <-received:
+		require.Equal(t, "cleared", p.Event)
+		require.Equal(t, string(KeyExpiring), p.Alert)
+		require.EqualValues(t, 951, p.Round)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "webhook was not called")
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partmonitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/logging"
+)
+
+// webhookTimeout bounds each webhook call.
+const webhookTimeout = 10 * time.Second
+
+// webhookQueueSize is the number of notifications which may wait for delivery
+// before new ones are dropped.
+const webhookQueueSize = 64
+
+// webhookPayload is the JSON body POSTed to the webhook.
+type webhookPayload struct {
+	// Event is "raised" or "cleared".
+	Event           string `json:"event"`
+	Alert           string `json:"alert"`
+	Address         string `json:"address"`
+	Round           uint64 `json:"round"`
+	ParticipationID string `json:"participation-id,omitempty"`
+	Message         string `json:"message"`
+}
+
+func makeWebhookPayload(event string, a Alert) webhookPayload {
+	p := webhookPayload{
+		Event:   event,
+		Alert:   string(a.Type),
+		Address: a.Account.String(),
+		Round:   uint64(a.Round),
+		Message: a.Message,
+	}
+	if !a.ParticipationID.IsZero() {
+		p.ParticipationID = a.ParticipationID.String()
+	}
+	return p
+}
+
+// webhook delivers alert notifications to an operator-provided URL, without
+// blocking the participation monitor.
+type webhook struct {
+	url    string
+	client *http.Client
+	queue  chan webhookPayload
+	log    logging.Logger
+}
+
+func makeWebhook(url string, log logging.Logger) *webhook {
+	return &webhook{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		queue:  make(chan webhookPayload, webhookQueueSize),
+		log:    log,
+	}
+}
+
+// notify queues a notification, dropping it if the queue is full.
+func (w *webhook) notify(p webhookPayload) {
+	select {
+	case w.queue <- p:
+	default:
+		w.log.Warnf("participation alert webhook queue is full, dropping %s notification for %s", p.Event, p.Alert)
+	}
+}
+
+func (w *webhook) run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case p := <-w.queue:
+			if err := w.post(ctx, p); err != nil {
+				w.log.Warnf("participation alert webhook: %v", err)
+			}
+		}
+	}
+}
+
+func (w *webhook) post(ctx context.Context, p webhookPayload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with %s", w.url, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partrotation
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// auditAction names what the rotation service did, or would have done in
+// dry-run mode.
+type auditAction string
+
+const (
+	auditGenerate auditAction = "generate"
+	auditInstall  auditAction = "install"
+	auditRegister auditAction = "register"
+	auditFailure  auditAction = "failure"
+)
+
+// auditEntry is a line of the audit log.
+type auditEntry struct {
+	Time            time.Time   `json:"time"`
+	Address         string      `json:"address"`
+	Action          auditAction `json:"action"`
+	DryRun          bool        `json:"dry-run,omitempty"`
+	Round           uint64      `json:"round"`
+	ParticipationID string      `json:"participation-id,omitempty"`
+	FirstValid      uint64      `json:"first-valid,omitempty"`
+	LastValid       uint64      `json:"last-valid,omitempty"`
+	TxID            string      `json:"txid,omitempty"`
+	Signer          string      `json:"signer,omitempty"`
+	Error           string      `json:"error,omitempty"`
+}
+
+// auditLog appends JSON entries to a file, one per line. The file is opened
+// for every entry, so it may be rotated externally.
+type auditLog struct {
+	mu   deadlock.Mutex
+	path string
+}
+
+func (a *auditLog) append(e auditEntry) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(line, '\n'))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partrotation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// DefaultValidityRounds is the validity of the keys generated when the
+// configuration does not set one.
+const DefaultValidityRounds = 3_000_000
+
+// DefaultRenewBeforeRounds is how many rounds before the registered key of an
+// account expires its next key is generated and registered, when the
+// configuration does not set it.
+const DefaultRenewBeforeRounds = 200_000
+
+// DefaultAuditLog is the name of the audit log, relative to the directory of
+// the configuration file, when the configuration does not set one.
+const DefaultAuditLog = "partkeyrotation.audit.log"
+
+// Config is the content of the file named by ParticipationKeyRotationFile.
+//
+// Relative paths are resolved against the directory holding the file.
+type Config struct {
+	// DryRun makes the service log and audit the actions it would take
+	// without generating keys or submitting transactions.
+	DryRun bool `json:"dry-run"`
+
+	// ValidityRounds is the number of rounds the generated keys are valid for.
+	ValidityRounds uint64 `json:"validity-rounds"`
+
+	// RenewBeforeRounds is how many rounds before the registered key of an
+	// account expires its next key is generated and registered.
+	RenewBeforeRounds uint64 `json:"renew-before-rounds"`
+
+	// KeyDilution of the generated keys. Zero picks a dilution suited to
+	// ValidityRounds.
+	KeyDilution uint64 `json:"key-dilution"`
+
+	// Fee of the keyreg transactions, in microAlgos. It is raised to the
+	// minimum fee if lower.
+	Fee uint64 `json:"fee"`
+
+	// AuditLog is the file every action of the service is appended to.
+	AuditLog string `json:"audit-log"`
+
+	// KMDDataDir is the data directory of the kmd instance holding the
+	// spending keys of accounts which name a KMDWallet.
+	KMDDataDir string `json:"kmd-data-dir"`
+
+	Accounts []AccountConfig `json:"accounts"`
+}
+
+// AccountConfig names an account whose participation keys are rotated, and
+// where the key authorizing its keyreg transactions is found. Exactly one of
+// KMDWallet and MnemonicFile must be set.
+type AccountConfig struct {
+	Address string `json:"address"`
+
+	// KMDWallet is the name of the kmd wallet holding the key, unlocked with
+	// the password stored in KMDWalletPasswordFile.
+	KMDWallet             string `json:"kmd-wallet"`
+	KMDWalletPasswordFile string `json:"kmd-wallet-password-file"`
+
+	// MnemonicFile holds the 25-word mnemonic of the key.
+	MnemonicFile string `json:"mnemonic-file"`
+}
+
+// LoadConfig reads a rotation configuration file and applies the defaults.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	resolve := func(p string) string {
+		if p == "" || filepath.IsAbs(p) {
+			return p
+		}
+		return filepath.Join(dir, p)
+	}
+
+	if cfg.ValidityRounds == 0 {
+		cfg.ValidityRounds = DefaultValidityRounds
+	}
+	if cfg.RenewBeforeRounds == 0 {
+		cfg.RenewBeforeRounds = DefaultRenewBeforeRounds
+	}
+	if cfg.RenewBeforeRounds >= cfg.ValidityRounds {
+		return cfg, fmt.Errorf("%s: renew-before-rounds (%d) must be less than validity-rounds (%d)", path, cfg.RenewBeforeRounds, cfg.ValidityRounds)
+	}
+	if cfg.AuditLog == "" {
+		cfg.AuditLog = DefaultAuditLog
+	}
+	cfg.AuditLog = resolve(cfg.AuditLog)
+	cfg.KMDDataDir = resolve(cfg.KMDDataDir)
+
+	if len(cfg.Accounts) == 0 {
+		return cfg, fmt.Errorf("%s: no accounts configured", path)
+	}
+	seen := make(map[string]bool)
+	for i := range cfg.Accounts {
+		acct := &cfg.Accounts[i]
+		if _, err := basics.UnmarshalChecksumAddress(acct.Address); err != nil {
+			return cfg, fmt.Errorf("%s: invalid address %q: %w", path, acct.Address, err)
+		}
+		if seen[acct.Address] {
+			return cfg, fmt.Errorf("%s: account %s is listed more than once", path, acct.Address)
+		}
+		seen[acct.Address] = true
+
+		switch {
+		case acct.KMDWallet != "" && acct.MnemonicFile != "":
+			return cfg, fmt.Errorf("%s: account %s names both a kmd wallet and a mnemonic file", path, acct.Address)
+		case acct.KMDWallet != "":
+			if cfg.KMDDataDir == "" {
+				return cfg, fmt.Errorf("%s: account %s uses kmd but kmd-data-dir is not set", path, acct.Address)
+			}
+		case acct.MnemonicFile == "":
+			return cfg, fmt.Errorf("%s: account %s names neither a kmd wallet nor a mnemonic file", path, acct.Address)
+		}
+		acct.KMDWalletPasswordFile = resolve(acct.KMDWalletPasswordFile)
+		acct.MnemonicFile = resolve(acct.MnemonicFile)
+	}
+	return cfg, nil
+}
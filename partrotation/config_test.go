@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partrotation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{1}.String()
+	other := basics.Address{2}.String()
+	dir := t.TempDir()
+	write := func(content string) string {
+		path := filepath.Join(dir, "rotation.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	cfg, err := LoadConfig(write(`{
+		"kmd-data-dir": "kmd",
+		"accounts": [
+			{"address": "` + addr + `", "mnemonic-file": "keys/a.mnemonic"},
+			{"address": "` + other + `", "kmd-wallet": "w", "kmd-wallet-password-file": "/etc/pw"}
+		]
+	}`))
+	require.NoError(t, err)
+	require.False(t, cfg.DryRun)
+	require.EqualValues(t, DefaultValidityRounds, cfg.ValidityRounds)
+	require.EqualValues(t, DefaultRenewBeforeRounds, cfg.RenewBeforeRounds)
+	require.Equal(t, filepath.Join(dir, DefaultAuditLog), cfg.AuditLog)
+	require.Equal(t, filepath.Join(dir, "kmd"), cfg.KMDDataDir)
+	require.Equal(t, filepath.Join(dir, "keys/a.mnemonic"), cfg.Accounts[0].MnemonicFile)
+	require.Equal(t, "/etc/pw", cfg.Accounts[1].KMDWalletPasswordFile)
+
+	for _, bad := range []string{
+		`{"accounts": []}`,
+		`{"accounts": [{"address": "nope", "mnemonic-file": "a"}]}`,
+		`{"accounts": [{"address": "` + addr + `"}]}`,
+		`{"accounts": [{"address": "` + addr + `", "kmd-wallet": "w"}]}`,
+		`{"kmd-data-dir": "kmd", "accounts": [{"address": "` + addr + `", "kmd-wallet": "w", "mnemonic-file": "a"}]}`,
+		`{"accounts": [{"address": "` + addr + `", "mnemonic-file": "a"}, {"address": "` + addr + `", "mnemonic-file": "b"}]}`,
+		`{"validity-rounds": 100, "renew-before-rounds": 100, "accounts": [{"address": "` + addr + `", "mnemonic-file": "a"}]}`,
+	} {
+		_, err := LoadConfig(write(bad))
+		require.Error(t, err, bad)
+	}
+}
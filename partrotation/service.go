@@ -0,0 +1,340 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package partrotation renews the participation keys of configured accounts
+// before they expire: it generates the next key, installs it, and registers
+// it on-chain with a keyreg transaction signed by a key held in kmd or in a
+// local mnemonic file.
+package partrotation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/libgoal/participation"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// keyregLifetime is the validity of the keyreg transactions, in rounds. A
+// transaction which does not make it into a block is sent again once it
+// expires.
+const keyregLifetime = 100
+
+// retryRounds is how long the service waits before generating a key again
+// after a failure.
+const retryRounds = 100
+
+// rotatingAccount is an account whose keys the service rotates.
+type rotatingAccount struct {
+	address basics.Address
+	signer  signer
+
+	// generating is set while the next key of the account is generated
+	generating bool
+	// retryAfter delays key generation after a failure
+	retryAfter basics.Round
+	// pendingUntil is the last round of the keyreg transaction in flight
+	pendingUntil basics.Round
+	// reported is the expiring registration last reported in dry-run mode
+	reported basics.Round
+}
+
+// Service rotates the participation keys of the configured accounts.
+type Service struct {
+	cfg      Config
+	accounts []*rotatingAccount
+
+	ledger   ledger
+	registry registry
+	node     node
+	audit    *auditLog
+
+	// generate creates and installs a participation key, returning its ID
+	generate func(addr basics.Address, first, last basics.Round, dilution uint64) (account.ParticipationID, error)
+
+	// synchronizes the rotatingAccount fields between the loop and the
+	// key generation goroutines
+	mu sync.Mutex
+
+	// infrastructure
+	ctx      context.Context
+	shutdown context.CancelFunc
+	wg       sync.WaitGroup
+	log      logging.Logger
+}
+
+// NewService creates a rotation service for the accounts listed in cfg.
+func NewService(cfg Config, registry registry, ledger ledger, node node, log logging.Logger) (*Service, error) {
+	s := &Service{
+		cfg:      cfg,
+		ledger:   ledger,
+		registry: registry,
+		node:     node,
+		audit:    &auditLog{path: cfg.AuditLog},
+		log:      log.With("Context", "partrotation"),
+	}
+	s.generate = s.generateKey
+	for _, acct := range cfg.Accounts {
+		addr, err := basics.UnmarshalChecksumAddress(acct.Address)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := makeSigner(cfg, acct)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", acct.Address, err)
+		}
+		s.accounts = append(s.accounts, &rotatingAccount{address: addr, signer: sig})
+	}
+	return s, nil
+}
+
+// Start starts the goroutines for the Service.
+func (s *Service) Start() {
+	s.ctx, s.shutdown = context.WithCancel(context.Background())
+	s.wg.Add(1)
+	if s.cfg.DryRun {
+		s.log.Infof("starting participation key rotation service for %d accounts in dry-run mode", len(s.accounts))
+	} else {
+		s.log.Infof("starting participation key rotation service for %d accounts", len(s.accounts))
+	}
+	go s.loop()
+}
+
+// Stop any goroutines associated with this worker. Key generation cannot be
+// interrupted, so Stop waits for any key being generated to be installed.
+func (s *Service) Stop() {
+	s.log.Debug("participation key rotation service is stopping")
+	defer s.log.Debug("participation key rotation service has stopped")
+	s.shutdown()
+	s.wg.Wait()
+}
+
+func (s *Service) loop() {
+	defer s.wg.Done()
+	latest := s.ledger.LastRound()
+	for {
+		s.evaluate(latest)
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-s.ledger.WaitMem(latest + 1):
+		}
+		latest = s.ledger.LastRound()
+	}
+}
+
+// evaluate checks the registration of every configured account as of round
+// latest, and takes the next step of the rotation of those expiring soon.
+func (s *Service) evaluate(latest basics.Round) {
+	hdr, err := s.ledger.BlockHdr(latest)
+	if err != nil {
+		s.log.Errorf("participation key rotation could not fetch block header for round %d: %v", latest, err)
+		return
+	}
+	proto, ok := config.Consensus[hdr.CurrentProtocol]
+	if !ok {
+		s.log.Errorf("participation key rotation: unknown protocol %v at round %d", hdr.CurrentProtocol, latest)
+		return
+	}
+
+	keys := make(map[basics.Address][]account.ParticipationRecord)
+	for _, record := range s.registry.GetAll() {
+		keys[record.Account] = append(keys[record.Account], record)
+	}
+
+	for _, a := range s.accounts {
+		data, _, _, err := s.ledger.LookupAccount(latest, a.address)
+		if err != nil {
+			s.log.Warnf("participation key rotation could not look up %v at round %d: %v", a.address, latest, err)
+			continue
+		}
+		s.rotate(a, data, keys[a.address], hdr, proto)
+	}
+}
+
+// rotate takes the next step of the rotation of a single account: generating
+// its next key if none is installed, or registering it.
+func (s *Service) rotate(a *rotatingAccount, data ledgercore.AccountData, records []account.ParticipationRecord, hdr bookkeeping.BlockHeader, proto config.ConsensusParams) {
+	latest := hdr.Round
+	// only online accounts are kept online; going offline is the operator's
+	// call, and suspended accounts are handled by the heartbeat service
+	if data.Status != basics.Online {
+		return
+	}
+	if data.VoteLastValid > latest+basics.Round(s.cfg.RenewBeforeRounds) {
+		return
+	}
+
+	// the installed key which extends the registration furthest, if any
+	var next *account.ParticipationRecord
+	for i := range records {
+		r := &records[i]
+		if r.LastValid <= data.VoteLastValid || r.FirstValid > latest+1 || r.Voting.OneTimeSignatureVerifier == data.VoteID {
+			continue
+		}
+		if next == nil || r.LastValid > next.LastValid {
+			next = r
+		}
+	}
+
+	if next == nil {
+		s.startKeyGeneration(a, data, latest)
+		return
+	}
+
+	s.mu.Lock()
+	pending := latest <= a.pendingUntil
+	if !pending {
+		a.pendingUntil = latest + keyregLifetime
+	}
+	s.mu.Unlock()
+	if pending {
+		return
+	}
+	s.register(a, data, next, hdr, proto)
+}
+
+func (s *Service) startKeyGeneration(a *rotatingAccount, data ledgercore.AccountData, latest basics.Round) {
+	first := latest
+	last := latest + basics.Round(s.cfg.ValidityRounds)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if a.generating || latest < a.retryAfter {
+		return
+	}
+
+	if s.cfg.DryRun {
+		if a.reported != data.VoteLastValid {
+			a.reported = data.VoteLastValid
+			s.log.Infof("dry run: would generate participation key for %v, valid from %d to %d, replacing the one expiring at %d", a.address, first, last, data.VoteLastValid)
+			s.record(auditEntry{Address: a.address.String(), Action: auditGenerate, DryRun: true, Round: uint64(latest), FirstValid: uint64(first), LastValid: uint64(last)})
+		}
+		return
+	}
+
+	a.generating = true
+	s.log.Infof("generating participation key for %v, valid from %d to %d, replacing the one expiring at %d", a.address, first, last, data.VoteLastValid)
+	s.record(auditEntry{Address: a.address.String(), Action: auditGenerate, Round: uint64(latest), FirstValid: uint64(first), LastValid: uint64(last)})
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		id, err := s.generate(a.address, first, last, s.cfg.KeyDilution)
+
+		s.mu.Lock()
+		a.generating = false
+		if err != nil {
+			a.retryAfter = latest + retryRounds
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			s.log.Errorf("failed to generate participation key for %v: %v", a.address, err)
+			s.record(auditEntry{Address: a.address.String(), Action: auditFailure, Round: uint64(latest), FirstValid: uint64(first), LastValid: uint64(last), Error: err.Error()})
+			return
+		}
+		s.log.Infof("installed participation key %s for %v", id, a.address)
+		s.record(auditEntry{Address: a.address.String(), Action: auditInstall, Round: uint64(latest), ParticipationID: id.String(), FirstValid: uint64(first), LastValid: uint64(last)})
+	}()
+}
+
+// register signs and submits the keyreg transaction registering next.
+func (s *Service) register(a *rotatingAccount, data ledgercore.AccountData, next *account.ParticipationRecord, hdr bookkeeping.BlockHeader, proto config.ConsensusParams) {
+	latest := hdr.Round
+	txn := transactions.Transaction{
+		Type: protocol.KeyRegistrationTx,
+		Header: transactions.Header{
+			Sender:      a.address,
+			Fee:         basics.MicroAlgos{Raw: max(s.cfg.Fee, proto.MinTxnFee)},
+			FirstValid:  latest,
+			LastValid:   latest + keyregLifetime,
+			GenesisID:   s.node.GenesisID(),
+			GenesisHash: s.node.GenesisHash(),
+		},
+		KeyregTxnFields: transactions.KeyregTxnFields{
+			VotePK:          next.Voting.OneTimeSignatureVerifier,
+			SelectionPK:     next.VRF.PK,
+			VoteFirst:       next.FirstValid,
+			VoteLast:        next.LastValid,
+			VoteKeyDilution: next.KeyDilution,
+		},
+	}
+	if proto.EnableStateProofKeyregCheck && next.StateProof != nil {
+		txn.KeyregTxnFields.StateProofPK = next.StateProof.Commitment
+	}
+
+	entry := auditEntry{
+		Address:         a.address.String(),
+		Action:          auditRegister,
+		DryRun:          s.cfg.DryRun,
+		Round:           uint64(latest),
+		ParticipationID: next.ParticipationID.String(),
+		FirstValid:      uint64(next.FirstValid),
+		LastValid:       uint64(next.LastValid),
+		TxID:            txn.ID().String(),
+		Signer:          a.signer.String(),
+	}
+
+	stxn, err := a.signer.Sign(txn, data.AuthAddr)
+	if err == nil && !s.cfg.DryRun {
+		err = s.node.BroadcastSignedTxGroup([]transactions.SignedTxn{stxn})
+	}
+	if err != nil {
+		s.log.Errorf("failed to register participation key %s for %v: %v", next.ParticipationID, a.address, err)
+		entry.Action = auditFailure
+		entry.Error = err.Error()
+		s.record(entry)
+		return
+	}
+
+	if s.cfg.DryRun {
+		s.log.Infof("dry run: would register participation key %s for %v with transaction %s", next.ParticipationID, a.address, entry.TxID)
+	} else {
+		s.log.Infof("registering participation key %s for %v with transaction %s", next.ParticipationID, a.address, entry.TxID)
+	}
+	s.record(entry)
+}
+
+// record appends an entry to the audit log, reporting failures to do so.
+func (s *Service) record(e auditEntry) {
+	if err := s.audit.append(e); err != nil {
+		s.log.Errorf("failed to write participation key rotation audit log %s: %v", s.audit.path, err)
+	}
+}
+
+// generateKey generates a participation key and installs it into the node.
+func (s *Service) generateKey(addr basics.Address, first, last basics.Round, dilution uint64) (id account.ParticipationID, err error) {
+	install := func(keyPath string) error {
+		partKeyBinary, err := os.ReadFile(keyPath)
+		if err != nil {
+			return err
+		}
+		id, err = s.node.InstallParticipationKey(partKeyBinary)
+		return err
+	}
+	_, _, err = participation.GenParticipationKeysTo(addr.String(), uint64(first), uint64(last), dilution, "", install)
+	return
+}
@@ -0,0 +1,323 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partrotation
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-deadlock"
+)
+
+type mockedLedger struct {
+	mu       deadlock.Mutex
+	round    basics.Round
+	accounts map[basics.Address]ledgercore.AccountData
+}
+
+func (l *mockedLedger) LastRound() basics.Round {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.round
+}
+
+func (l *mockedLedger) WaitMem(r basics.Round) chan struct{} {
+	return make(chan struct{})
+}
+
+func (l *mockedLedger) BlockHdr(r basics.Round) (bookkeeping.BlockHeader, error) {
+	return bookkeeping.BlockHeader{
+		Round:        r,
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusCurrentVersion},
+	}, nil
+}
+
+func (l *mockedLedger) LookupAccount(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.accounts[addr], l.round, basics.MicroAlgos{}, nil
+}
+
+type mockedNode struct {
+	mu        deadlock.Mutex
+	records   []account.ParticipationRecord
+	broadcast []transactions.SignedTxn
+}
+
+func (n *mockedNode) GetAll() []account.ParticipationRecord {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]account.ParticipationRecord(nil), n.records...)
+}
+
+func (n *mockedNode) InstallParticipationKey([]byte) (account.ParticipationID, error) {
+	panic("keys are installed by the test generator")
+}
+
+func (n *mockedNode) BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.broadcast = append(n.broadcast, txgroup...)
+	return nil
+}
+
+func (n *mockedNode) GenesisID() string { return "test-v1" }
+
+func (n *mockedNode) GenesisHash() crypto.Digest { return crypto.Digest{1} }
+
+// install generates a participation key without storing its secrets on disk.
+func (n *mockedNode) install(addr basics.Address, first, last basics.Round, dilution uint64) (account.ParticipationID, error) {
+	var id account.ParticipationID
+	crypto.RandBytes(id[:])
+	var seed [32]byte
+	crypto.RandBytes(seed[:])
+	vrfPK, vrfSK := crypto.VrfKeygenFromSeed(seed)
+	record := account.ParticipationRecord{
+		ParticipationID: id,
+		Account:         addr,
+		FirstValid:      first,
+		LastValid:       last,
+		KeyDilution:     max(dilution, 100),
+		VRF:             &crypto.VRFSecrets{PK: vrfPK, SK: vrfSK},
+		Voting:          crypto.GenerateOneTimeSignatureSecrets(0, 1),
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.records = append(n.records, record)
+	return id, nil
+}
+
+type testAccount struct {
+	addr    basics.Address
+	secrets *crypto.SignatureSecrets
+	file    string
+}
+
+func makeTestAccount(t *testing.T, dir string) testAccount {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	mnemonic, err := passphrase.KeyToMnemonic(seed[:])
+	require.NoError(t, err)
+	addr := basics.Address(secrets.SignatureVerifier)
+	file := filepath.Join(dir, addr.String()+".mnemonic")
+	require.NoError(t, os.WriteFile(file, []byte(mnemonic+"\n"), 0600))
+	return testAccount{addr: addr, secrets: secrets, file: file}
+}
+
+func onlineUntil(last basics.Round) ledgercore.AccountData {
+	var data ledgercore.AccountData
+	data.Status = basics.Online
+	crypto.RandBytes(data.VoteID[:])
+	data.VoteLastValid = last
+	return data
+}
+
+func readAudit(t *testing.T, path string) []auditEntry {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []auditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e auditEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		entries = append(entries, e)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func makeTestService(t *testing.T, l *mockedLedger, n *mockedNode, dryRun bool, accts ...testAccount) *Service {
+	dir := t.TempDir()
+	cfg := Config{
+		DryRun:            dryRun,
+		ValidityRounds:    10000,
+		RenewBeforeRounds: 1000,
+		AuditLog:          filepath.Join(dir, DefaultAuditLog),
+	}
+	for _, a := range accts {
+		cfg.Accounts = append(cfg.Accounts, AccountConfig{Address: a.addr.String(), MnemonicFile: a.file})
+	}
+	s, err := NewService(cfg, n, l, n, logging.TestingLog(t))
+	require.NoError(t, err)
+	s.generate = n.install
+	s.ctx, s.shutdown = context.WithCancel(context.Background())
+	return s
+}
+
+// waitGenerated waits for the key generation goroutines to finish.
+func waitGenerated(t *testing.T, s *Service) {
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "key generation did not finish")
+	}
+}
+
+func TestRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	acct := makeTestAccount(t, t.TempDir())
+	l := &mockedLedger{accounts: map[basics.Address]ledgercore.AccountData{acct.addr: onlineUntil(5000)}}
+	n := &mockedNode{}
+	s := makeTestService(t, l, n, false, acct)
+
+	// not expiring yet
+	s.evaluate(3000)
+	waitGenerated(t, s)
+	require.Empty(t, n.GetAll())
+
+	s.evaluate(4000)
+	waitGenerated(t, s)
+	records := n.GetAll()
+	require.Len(t, records, 1)
+	require.EqualValues(t, 4000, records[0].FirstValid)
+	require.EqualValues(t, 14000, records[0].LastValid)
+
+	// the next round registers the new key
+	s.evaluate(4001)
+	require.Len(t, n.broadcast, 1)
+	stxn := n.broadcast[0]
+	require.Equal(t, protocol.KeyRegistrationTx, stxn.Txn.Type)
+	require.Equal(t, acct.addr, stxn.Txn.Sender)
+	require.Equal(t, records[0].Voting.OneTimeSignatureVerifier, stxn.Txn.VotePK)
+	require.Equal(t, records[0].VRF.PK, stxn.Txn.SelectionPK)
+	require.EqualValues(t, 4000, stxn.Txn.VoteFirst)
+	require.EqualValues(t, 14000, stxn.Txn.VoteLast)
+	require.Equal(t, "test-v1", stxn.Txn.GenesisID)
+	require.True(t, stxn.Txn.Fee.Raw > 0)
+	require.True(t, acct.secrets.SignatureVerifier.Verify(stxn.Txn, stxn.Sig))
+	require.True(t, stxn.AuthAddr.IsZero())
+
+	// the transaction is not sent again while it may still be committed
+	s.evaluate(4050)
+	require.Len(t, n.broadcast, 1)
+	s.evaluate(4001 + keyregLifetime + 1)
+	require.Len(t, n.broadcast, 2)
+
+	// once registered, nothing happens
+	registered := onlineUntil(14000)
+	registered.VoteID = records[0].Voting.OneTimeSignatureVerifier
+	l.accounts[acct.addr] = registered
+	s.evaluate(4200)
+	waitGenerated(t, s)
+	require.Len(t, n.broadcast, 2)
+	require.Len(t, n.GetAll(), 1)
+
+	entries := readAudit(t, s.audit.path)
+	require.Len(t, entries, 4)
+	require.Equal(t, auditGenerate, entries[0].Action)
+	require.Equal(t, auditInstall, entries[1].Action)
+	require.Equal(t, records[0].ParticipationID.String(), entries[1].ParticipationID)
+	require.Equal(t, auditRegister, entries[2].Action)
+	require.Equal(t, stxn.ID().String(), entries[2].TxID)
+	require.Equal(t, auditRegister, entries[3].Action)
+	for _, e := range entries {
+		require.Equal(t, acct.addr.String(), e.Address)
+		require.False(t, e.DryRun)
+	}
+}
+
+func TestRotationDryRun(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	acct := makeTestAccount(t, t.TempDir())
+	offline := makeTestAccount(t, t.TempDir())
+	l := &mockedLedger{accounts: map[basics.Address]ledgercore.AccountData{acct.addr: onlineUntil(5000)}}
+	n := &mockedNode{}
+	s := makeTestService(t, l, n, true, acct, offline)
+
+	s.evaluate(4500)
+	s.evaluate(4501)
+	waitGenerated(t, s)
+	require.Empty(t, n.GetAll())
+
+	// a key installed by the operator would be registered
+	_, err := n.install(acct.addr, 4000, 20000, 0)
+	require.NoError(t, err)
+	s.evaluate(4502)
+	require.Empty(t, n.broadcast)
+
+	entries := readAudit(t, s.audit.path)
+	require.Len(t, entries, 2)
+	require.Equal(t, auditGenerate, entries[0].Action)
+	require.Equal(t, auditRegister, entries[1].Action)
+	require.EqualValues(t, 20000, entries[1].LastValid)
+	for _, e := range entries {
+		require.True(t, e.DryRun)
+		require.Equal(t, acct.addr.String(), e.Address)
+	}
+}
+
+func TestRotationRekeyed(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	acct := makeTestAccount(t, dir)
+	auth := makeTestAccount(t, dir)
+
+	data := onlineUntil(5000)
+	data.AuthAddr = auth.addr
+	l := &mockedLedger{accounts: map[basics.Address]ledgercore.AccountData{acct.addr: data}}
+	n := &mockedNode{}
+
+	// the account's own key is no longer authorized
+	s := makeTestService(t, l, n, false, acct)
+	_, err := n.install(acct.addr, 4000, 20000, 0)
+	require.NoError(t, err)
+	s.evaluate(4500)
+	require.Empty(t, n.broadcast)
+	entries := readAudit(t, s.audit.path)
+	require.Len(t, entries, 1)
+	require.Equal(t, auditFailure, entries[0].Action)
+
+	s = makeTestService(t, l, n, false, testAccount{addr: acct.addr, file: auth.file})
+	s.evaluate(4500)
+	require.Len(t, n.broadcast, 1)
+	require.Equal(t, auth.addr, n.broadcast[0].AuthAddr)
+	require.True(t, auth.secrets.SignatureVerifier.Verify(n.broadcast[0].Txn, n.broadcast[0].Sig))
+}
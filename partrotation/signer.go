@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partrotation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/daemon/kmd/client"
+	"github.com/algorand/go-algorand/daemon/kmd/server"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+var errSignerMismatch = errors.New("the signing key does not match the account or its authorized address")
+
+// signer signs the keyreg transactions of an account, with the key of the
+// account or of the address it is rekeyed to.
+type signer interface {
+	Sign(txn transactions.Transaction, authAddr basics.Address) (transactions.SignedTxn, error)
+	String() string
+}
+
+func makeSigner(cfg Config, acct AccountConfig) (signer, error) {
+	if acct.KMDWallet != "" {
+		return &kmdSigner{dataDir: cfg.KMDDataDir, wallet: acct.KMDWallet, passwordFile: acct.KMDWalletPasswordFile}, nil
+	}
+	return makeMnemonicSigner(acct.MnemonicFile)
+}
+
+// authorizer returns the address whose key must sign the transactions of an
+// account rekeyed to authAddr, or of the sender if it was not rekeyed.
+func authorizer(sender, authAddr basics.Address) basics.Address {
+	if authAddr.IsZero() {
+		return sender
+	}
+	return authAddr
+}
+
+// mnemonicSigner signs with a key read from a mnemonic file.
+type mnemonicSigner struct {
+	path    string
+	secrets *crypto.SignatureSecrets
+}
+
+func makeMnemonicSigner(path string) (*mnemonicSigner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := passphrase.MnemonicToKey(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic in %s: %w", path, err)
+	}
+	var seed crypto.Seed
+	copy(seed[:], key)
+	return &mnemonicSigner{path: path, secrets: crypto.GenerateSignatureSecrets(seed)}, nil
+}
+
+func (s *mnemonicSigner) Sign(txn transactions.Transaction, authAddr basics.Address) (transactions.SignedTxn, error) {
+	if basics.Address(s.secrets.SignatureVerifier) != authorizer(txn.Sender, authAddr) {
+		return transactions.SignedTxn{}, errSignerMismatch
+	}
+	return txn.Sign(s.secrets), nil
+}
+
+func (s *mnemonicSigner) String() string {
+	return "mnemonic file " + s.path
+}
+
+// kmdSigner signs with a key held in a kmd wallet. The wallet is unlocked
+// for every signature, since rotations are rare and kmd sessions expire.
+type kmdSigner struct {
+	dataDir      string
+	wallet       string
+	passwordFile string
+}
+
+func (s *kmdSigner) client() (client.KMDClient, error) {
+	apiToken, err := tokens.GetAndValidateAPIToken(s.dataDir, tokens.KmdTokenFilename)
+	if err != nil {
+		return client.KMDClient{}, err
+	}
+	address, err := util.GetFirstLineFromFile(filepath.Join(s.dataDir, server.NetFilename))
+	if err != nil {
+		return client.KMDClient{}, fmt.Errorf("kmd does not seem to be running: %w", err)
+	}
+	return client.MakeKMDClient(address, apiToken)
+}
+
+func (s *kmdSigner) Sign(txn transactions.Transaction, authAddr basics.Address) (stxn transactions.SignedTxn, err error) {
+	var password []byte
+	if s.passwordFile != "" {
+		password, err = os.ReadFile(s.passwordFile)
+		if err != nil {
+			return
+		}
+		password = []byte(strings.TrimRight(string(password), "\r\n"))
+	}
+
+	kmd, err := s.client()
+	if err != nil {
+		return
+	}
+	wallets, err := kmd.ListWallets()
+	if err != nil {
+		return
+	}
+	walletID := ""
+	for _, w := range wallets.Wallets {
+		if w.Name == s.wallet {
+			walletID = w.ID
+			break
+		}
+	}
+	if walletID == "" {
+		return stxn, fmt.Errorf("kmd has no wallet named %s", s.wallet)
+	}
+
+	handle, err := kmd.InitWallet([]byte(walletID), password)
+	if err != nil {
+		return
+	}
+	defer kmd.ReleaseWalletHandle([]byte(handle.WalletHandleToken))
+
+	pk := crypto.PublicKey(authorizer(txn.Sender, authAddr))
+	resp, err := kmd.SignTransaction([]byte(handle.WalletHandleToken), password, pk, txn)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(resp.SignedTransaction, &stxn)
+	return
+}
+
+func (s *kmdSigner) String() string {
+	return "kmd wallet " + s.wallet
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partsigner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	// keysRefreshInterval is how long the client caches the list of keys held by the signer.
+	keysRefreshInterval = 10 * time.Second
+
+	// requestTimeout bounds every request made to the signer.
+	requestTimeout = 5 * time.Second
+)
+
+// Client implements account.RemoteSigner by talking to a signer listening on a
+// Unix domain socket.
+type Client struct {
+	http *http.Client
+	log  logging.Logger
+
+	mu        deadlock.Mutex
+	keys      []account.ParticipationRecord
+	refreshed time.Time
+}
+
+// MakeClient creates a Client for the signer listening on socketPath.
+func MakeClient(socketPath string, log logging.Logger) *Client {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socketPath)
+		},
+	}
+	return &Client{
+		http: &http.Client{Transport: transport, Timeout: requestTimeout},
+		log:  log,
+	}
+}
+
+func (c *Client) do(method, path string, req interface{}, resp interface{}) error {
+	var body io.Reader
+	if req != nil {
+		body = bytes.NewReader(protocol.EncodeReflect(req))
+	}
+	httpReq, err := http.NewRequest(method, "http://partsigner"+path, body)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/msgpack")
+
+	httpResp, err := c.http.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(httpResp.Body, maxRequestSize))
+	if err != nil {
+		return err
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		var errResp errorResponse
+		if protocol.DecodeReflect(data, &errResp) != nil {
+			errResp.Error = httpResp.Status
+		}
+		switch httpResp.StatusCode {
+		case http.StatusConflict:
+			return fmt.Errorf("%w: %s", ErrDoubleSign, errResp.Error)
+		case http.StatusNotFound:
+			return fmt.Errorf("%w: %s", account.ErrParticipationIDNotFound, errResp.Error)
+		default:
+			return fmt.Errorf("signer returned %s: %s", httpResp.Status, errResp.Error)
+		}
+	}
+	return protocol.DecodeReflect(data, resp)
+}
+
+// Keys implements account.RemoteSigner. The list is cached for
+// keysRefreshInterval; if the signer cannot be reached the previous list is
+// returned.
+func (c *Client) Keys() []account.ParticipationRecord {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Since(c.refreshed) < keysRefreshInterval {
+		return c.keys
+	}
+	c.refreshed = time.Now()
+
+	var resp keysResponse
+	err := c.do(http.MethodGet, keysPath, nil, &resp)
+	if err != nil {
+		c.log.Warnf("partsigner: could not fetch keys from signer: %v", err)
+		return c.keys
+	}
+	keys := make([]account.ParticipationRecord, len(resp.Keys))
+	for i, info := range resp.Keys {
+		keys[i] = info.record()
+	}
+	c.keys = keys
+	return c.keys
+}
+
+// SignOneTime implements account.ParticipationSigner.
+func (c *Client) SignOneTime(id account.ParticipationID, otsID crypto.OneTimeSignatureIdentifier, proto protocol.ConsensusVersion, msg account.SigningMessage) (crypto.OneTimeSignature, error) {
+	req := signOneTimeRequest{
+		ID:     crypto.Digest(id),
+		Batch:  otsID.Batch,
+		Offset: otsID.Offset,
+		Proto:  proto,
+		HashID: msg.HashID,
+		Data:   msg.Data,
+	}
+	var resp signOneTimeResponse
+	err := c.do(http.MethodPost, signOneTimePath, req, &resp)
+	return resp.Sig, err
+}
+
+// SignHeartbeat implements account.ParticipationSigner.
+func (c *Client) SignHeartbeat(id account.ParticipationID, otsID crypto.OneTimeSignatureIdentifier, lastValid basics.Round, msg account.SigningMessage) (crypto.OneTimeSignature, error) {
+	req := signHeartbeatRequest{
+		ID:        crypto.Digest(id),
+		Batch:     otsID.Batch,
+		Offset:    otsID.Offset,
+		LastValid: lastValid,
+		HashID:    msg.HashID,
+		Data:      msg.Data,
+	}
+	var resp signOneTimeResponse
+	err := c.do(http.MethodPost, signHeartbeatPath, req, &resp)
+	return resp.Sig, err
+}
+
+// ProveVRF implements account.ParticipationSigner.
+func (c *Client) ProveVRF(id account.ParticipationID, msg account.SigningMessage) (crypto.VrfProof, error) {
+	req := proveVRFRequest{ID: crypto.Digest(id), HashID: msg.HashID, Data: msg.Data}
+	var resp proveVRFResponse
+	err := c.do(http.MethodPost, proveVRFPath, req, &resp)
+	return resp.Proof, err
+}
+
+// SignStateProof implements account.ParticipationSigner.
+func (c *Client) SignStateProof(id account.ParticipationID, round basics.Round, msg []byte) (merklesignature.Signature, error) {
+	req := signStateProofRequest{ID: crypto.Digest(id), Round: round, Msg: msg}
+	var resp signStateProofResponse
+	err := c.do(http.MethodPost, signStateProofPath, req, &resp)
+	return resp.Sig, err
+}
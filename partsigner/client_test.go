@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partsigner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestClientServer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+	part := makeTestParticipation(t)
+	signer, err := MakeSoftSigner("", log)
+	require.NoError(t, err)
+	defer signer.Close()
+	id := signer.AddParticipation(part)
+
+	// unix socket paths are limited in length, so avoid t.TempDir()
+	dir, err := os.MkdirTemp("", "partsigner")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "signer.sock")
+
+	server := MakeServer(signer, log)
+	require.NoError(t, server.Start(socket))
+	defer server.Stop()
+
+	info, err := os.Stat(socket)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	client := MakeClient(socket, log)
+	var _ account.RemoteSigner = client
+
+	keys := client.Keys()
+	require.Len(t, keys, 1)
+	require.Equal(t, id, keys[0].ParticipationID)
+	require.Equal(t, part.Parent, keys[0].Account)
+	require.Equal(t, part.Voting.OneTimeSignatureVerifier, keys[0].Voting.OneTimeSignatureVerifier)
+	require.Equal(t, part.VRF.PK, keys[0].VRF.PK)
+	require.Equal(t, *part.StateProofVerifier(), *keys[0].StateProof)
+
+	otsID := basics.OneTimeIDForRound(20, part.KeyDilution)
+	vote := makeTestVote(part, 20, 0, 1, crypto.Hash([]byte("a")))
+	sig, err := client.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, vote)
+	require.NoError(t, err)
+	require.True(t, keys[0].Voting.OneTimeSignatureVerifier.Verify(otsID, vote, sig))
+
+	_, err = client.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, makeTestVote(part, 20, 0, 1, crypto.Hash([]byte("b"))))
+	require.ErrorIs(t, err, ErrDoubleSign)
+
+	hbSeed := committee.Seed{1}
+	hbID := basics.OneTimeIDForRound(30, part.KeyDilution)
+	hbSig, err := client.SignHeartbeat(id, hbID, 30, account.MakeSigningMessage(hbSeed))
+	require.NoError(t, err)
+	require.True(t, keys[0].Voting.OneTimeSignatureVerifier.Verify(hbID, hbSeed, hbSig))
+
+	_, err = client.SignHeartbeat(id, hbID, 30, account.MakeSigningMessage(committee.Seed{2}))
+	require.ErrorIs(t, err, ErrDoubleSign)
+
+	seed := account.SigningMessage{HashID: protocol.Seed, Data: []byte("seed")}
+	proof, err := client.ProveVRF(id, seed)
+	require.NoError(t, err)
+	ok, _ := keys[0].VRF.PK.Verify(proof, seed)
+	require.True(t, ok)
+
+	msg := crypto.Hash([]byte("state proof"))
+	spSig, err := client.SignStateProof(id, 256, msg[:])
+	require.NoError(t, err)
+	require.NoError(t, keys[0].StateProof.VerifyBytes(256, msg[:], &spSig))
+
+	_, err = client.ProveVRF(account.ParticipationID{1}, seed)
+	require.ErrorIs(t, err, account.ErrParticipationIDNotFound)
+
+	// keys remain available from the cache once the signer goes away
+	server.Stop()
+	require.Len(t, client.Keys(), 1)
+	_, err = client.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, vote)
+	require.Error(t, err)
+}
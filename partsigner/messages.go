@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partsigner
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The signing protocol is msgpack over HTTP, served on a Unix domain socket
+// which only the node's user can access.
+const (
+	keysPath           = "/v1/keys"
+	signOneTimePath    = "/v1/sign/onetime"
+	signHeartbeatPath  = "/v1/sign/heartbeat"
+	proveVRFPath       = "/v1/prove/vrf"
+	signStateProofPath = "/v1/sign/stateproof"
+)
+
+// keyInfo is the public description of a participation key held by the signer.
+type keyInfo struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	ID          crypto.Digest                   `codec:"id"`
+	Account     basics.Address                  `codec:"addr"`
+	FirstValid  basics.Round                    `codec:"fv"`
+	LastValid   basics.Round                    `codec:"lv"`
+	KeyDilution uint64                          `codec:"kd"`
+	VoteID      crypto.OneTimeSignatureVerifier `codec:"vote"`
+	SelectionID crypto.VRFVerifier              `codec:"sel"`
+	StateProof  *merklesignature.Verifier       `codec:"sprf"`
+}
+
+type keysResponse struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Keys []keyInfo `codec:"keys"`
+}
+
+type signOneTimeRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	ID     crypto.Digest             `codec:"id"`
+	Batch  uint64                    `codec:"batch"`
+	Offset uint64                    `codec:"off"`
+	Proto  protocol.ConsensusVersion `codec:"proto"`
+	HashID protocol.HashID           `codec:"hid"`
+	Data   []byte                    `codec:"data"`
+}
+
+type signHeartbeatRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	ID        crypto.Digest   `codec:"id"`
+	Batch     uint64          `codec:"batch"`
+	Offset    uint64          `codec:"off"`
+	LastValid basics.Round    `codec:"lv"`
+	HashID    protocol.HashID `codec:"hid"`
+	Data      []byte          `codec:"data"`
+}
+
+type signOneTimeResponse struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Sig crypto.OneTimeSignature `codec:"sig"`
+}
+
+type proveVRFRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	ID     crypto.Digest   `codec:"id"`
+	HashID protocol.HashID `codec:"hid"`
+	Data   []byte          `codec:"data"`
+}
+
+type proveVRFResponse struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Proof crypto.VrfProof `codec:"proof"`
+}
+
+type signStateProofRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	ID    crypto.Digest `codec:"id"`
+	Round basics.Round  `codec:"rnd"`
+	Msg   []byte        `codec:"msg"`
+}
+
+type signStateProofResponse struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Sig merklesignature.Signature `codec:"sig"`
+}
+
+type errorResponse struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Error string `codec:"err"`
+}
+
+func makeKeyInfo(record account.ParticipationRecord) keyInfo {
+	info := keyInfo{
+		ID:          crypto.Digest(record.ParticipationID),
+		Account:     record.Account,
+		FirstValid:  record.FirstValid,
+		LastValid:   record.LastValid,
+		KeyDilution: record.KeyDilution,
+		StateProof:  record.StateProof,
+	}
+	if record.Voting != nil {
+		info.VoteID = record.Voting.OneTimeSignatureVerifier
+	}
+	if record.VRF != nil {
+		info.SelectionID = record.VRF.PK
+	}
+	return info
+}
+
+func (info keyInfo) record() account.ParticipationRecord {
+	record := account.ParticipationRecord{
+		ParticipationID: account.ParticipationID(info.ID),
+		Account:         info.Account,
+		FirstValid:      info.FirstValid,
+		LastValid:       info.LastValid,
+		KeyDilution:     info.KeyDilution,
+		StateProof:      info.StateProof,
+		VRF:             &crypto.VRFSecrets{PK: info.SelectionID},
+		Voting:          &crypto.OneTimeSignatureSecrets{},
+	}
+	record.Voting.OneTimeSignatureVerifier = info.VoteID
+	return record
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partsigner
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// DefaultRetainRounds is the number of rounds of vote history kept by the
+// protection database for every participation key.
+const DefaultRetainRounds = 1000
+
+// ErrDoubleSign is returned when signing would produce a second, different
+// message for a slot which has already been signed.
+var ErrDoubleSign = errors.New("refusing to sign conflicting message")
+
+// ErrBelowWatermark is returned when a signing request targets a round whose
+// history has already been pruned from the protection database.
+var ErrBelowWatermark = errors.New("refusing to sign message for pruned round")
+
+var protectionSchema = []string{
+	`CREATE TABLE IF NOT EXISTS votes (
+		id BLOB NOT NULL,
+		round INTEGER NOT NULL,
+		period INTEGER NOT NULL,
+		step INTEGER NOT NULL,
+		digest BLOB NOT NULL,
+		PRIMARY KEY (id, round, period, step))`,
+	`CREATE TABLE IF NOT EXISTS stateproofs (
+		id BLOB NOT NULL,
+		round INTEGER NOT NULL,
+		digest BLOB NOT NULL,
+		PRIMARY KEY (id, round))`,
+	`CREATE TABLE IF NOT EXISTS heartbeats (
+		id BLOB NOT NULL,
+		round INTEGER NOT NULL,
+		digest BLOB NOT NULL,
+		PRIMARY KEY (id, round))`,
+	`CREATE TABLE IF NOT EXISTS watermarks (
+		id BLOB PRIMARY KEY,
+		round INTEGER NOT NULL)`,
+}
+
+// protection records every vote, state proof and heartbeat message signed by a
+// participation key, so that a restarted or misbehaving node cannot get the
+// signer to equivocate.
+type protection struct {
+	db     db.Accessor
+	retain basics.Round
+}
+
+// makeProtection opens (or creates) the protection database at filename. An
+// empty filename keeps the history in memory only.
+func makeProtection(filename string, retain basics.Round) (*protection, error) {
+	var accessor db.Accessor
+	var err error
+	if filename == "" {
+		accessor, err = db.MakeAccessor(fmt.Sprintf("partsigner-protection-%x", crypto.RandUint64()), false, true)
+	} else {
+		accessor, err = db.MakeAccessor(filename, false, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = accessor.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range protectionSchema {
+			if _, err := tx.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		accessor.Close()
+		return nil, fmt.Errorf("could not initialize protection database: %w", err)
+	}
+	return &protection{db: accessor, retain: retain}, nil
+}
+
+func (p *protection) close() {
+	p.db.Close()
+}
+
+func watermark(tx *sql.Tx, id account.ParticipationID) (basics.Round, error) {
+	var rnd basics.Round
+	err := tx.QueryRow("SELECT round FROM watermarks WHERE id=?", id[:]).Scan(&rnd)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return rnd, err
+}
+
+// checkVote records a vote for (round, period, step) with the given digest,
+// failing if a different vote has been recorded for the same slot.
+func (p *protection) checkVote(id account.ParticipationID, rnd basics.Round, per uint64, step uint64, digest crypto.Digest) error {
+	return p.db.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		low, err := watermark(tx, id)
+		if err != nil {
+			return err
+		}
+		if rnd < low {
+			return fmt.Errorf("%w: vote for round %d is below %d", ErrBelowWatermark, rnd, low)
+		}
+
+		var existing []byte
+		err = tx.QueryRow("SELECT digest FROM votes WHERE id=? AND round=? AND period=? AND step=?", id[:], rnd, per, step).Scan(&existing)
+		switch err {
+		case nil:
+			if crypto.Digest(existing) != digest {
+				return fmt.Errorf("%w: vote for round %d period %d step %d", ErrDoubleSign, rnd, per, step)
+			}
+			return nil
+		case sql.ErrNoRows:
+		default:
+			return err
+		}
+
+		_, err = tx.Exec("INSERT INTO votes (id, round, period, step, digest) VALUES (?, ?, ?, ?, ?)", id[:], rnd, per, step, digest[:])
+		if err != nil {
+			return err
+		}
+
+		if rnd <= p.retain || rnd-p.retain <= low {
+			return nil
+		}
+		low = rnd - p.retain
+		if _, err = tx.Exec("DELETE FROM votes WHERE id=? AND round<?", id[:], low); err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT OR REPLACE INTO watermarks (id, round) VALUES (?, ?)", id[:], low)
+		return err
+	})
+}
+
+// checkStateProof records a state proof signature for round with the given
+// digest, failing if a different message has been signed for the same round.
+func (p *protection) checkStateProof(id account.ParticipationID, rnd basics.Round, digest crypto.Digest) error {
+	return p.checkRound("stateproofs", "state proof", id, rnd, digest)
+}
+
+// checkHeartbeat records a heartbeat signature for the last valid round rnd
+// with the given digest, failing if a different seed has been signed for the
+// same round.
+func (p *protection) checkHeartbeat(id account.ParticipationID, rnd basics.Round, digest crypto.Digest) error {
+	return p.checkRound("heartbeats", "heartbeat", id, rnd, digest)
+}
+
+// checkRound records a message signed for rnd in table, which holds at most
+// one message per participation key and round.
+func (p *protection) checkRound(table string, what string, id account.ParticipationID, rnd basics.Round, digest crypto.Digest) error {
+	return p.db.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var existing []byte
+		err := tx.QueryRow("SELECT digest FROM "+table+" WHERE id=? AND round=?", id[:], rnd).Scan(&existing)
+		switch err {
+		case nil:
+			if crypto.Digest(existing) != digest {
+				return fmt.Errorf("%w: %s for round %d", ErrDoubleSign, what, rnd)
+			}
+			return nil
+		case sql.ErrNoRows:
+		default:
+			return err
+		}
+
+		_, err = tx.Exec("INSERT INTO "+table+" (id, round, digest) VALUES (?, ?, ?)", id[:], rnd, digest[:])
+		return err
+	})
+}
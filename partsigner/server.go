@@ -0,0 +1,202 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partsigner
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// maxRequestSize bounds the size of a signing request body.
+const maxRequestSize = 1 << 20
+
+// Server exposes a signer on a Unix domain socket.
+type Server struct {
+	signer   account.RemoteSigner
+	log      logging.Logger
+	listener net.Listener
+	srv      *http.Server
+	done     chan struct{}
+}
+
+// MakeServer creates a Server for signer.
+func MakeServer(signer account.RemoteSigner, log logging.Logger) *Server {
+	s := &Server{signer: signer, log: log}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(keysPath, s.handleKeys)
+	mux.HandleFunc(signOneTimePath, s.handleSignOneTime)
+	mux.HandleFunc(signHeartbeatPath, s.handleSignHeartbeat)
+	mux.HandleFunc(proveVRFPath, s.handleProveVRF)
+	mux.HandleFunc(signStateProofPath, s.handleSignStateProof)
+	s.srv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return s
+}
+
+// Start listens on the Unix domain socket at socketPath, replacing any stale
+// socket file, and serves requests until Stop is called.
+func (s *Server) Start(socketPath string) error {
+	err := os.Remove(socketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not remove stale socket %s: %w", socketPath, err)
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(socketPath, 0600)
+	if err != nil {
+		listener.Close()
+		return err
+	}
+
+	s.listener = listener
+	s.done = make(chan struct{})
+	go func() {
+		defer close(s.done)
+		err := s.srv.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			s.log.Errorf("partsigner: server stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Stop closes the socket and waits for the server to exit.
+func (s *Server) Stop() {
+	if s.listener == nil {
+		return
+	}
+	s.srv.Close()
+	<-s.done
+}
+
+func (s *Server) respond(w http.ResponseWriter, obj interface{}) {
+	w.Header().Set("Content-Type", "application/msgpack")
+	w.Write(protocol.EncodeReflect(obj))
+}
+
+func (s *Server) fail(w http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	switch {
+	case errors.Is(err, ErrDoubleSign), errors.Is(err, ErrBelowWatermark):
+		status = http.StatusConflict
+	case errors.Is(err, account.ErrParticipationIDNotFound):
+		status = http.StatusNotFound
+	}
+	w.Header().Set("Content-Type", "application/msgpack")
+	w.WriteHeader(status)
+	w.Write(protocol.EncodeReflect(errorResponse{Error: err.Error()}))
+}
+
+func (s *Server) decode(w http.ResponseWriter, r *http.Request, obj interface{}) bool {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err == nil {
+		err = protocol.DecodeReflect(body, obj)
+	}
+	if err != nil {
+		s.fail(w, fmt.Errorf("could not decode request: %w", err))
+		return false
+	}
+	return true
+}
+
+func (s *Server) handleKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var resp keysResponse
+	for _, record := range s.signer.Keys() {
+		resp.Keys = append(resp.Keys, makeKeyInfo(record))
+	}
+	s.respond(w, resp)
+}
+
+func (s *Server) handleSignOneTime(w http.ResponseWriter, r *http.Request) {
+	var req signOneTimeRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+	otsID := crypto.OneTimeSignatureIdentifier{Batch: req.Batch, Offset: req.Offset}
+	msg := account.SigningMessage{HashID: req.HashID, Data: req.Data}
+	sig, err := s.signer.SignOneTime(account.ParticipationID(req.ID), otsID, req.Proto, msg)
+	if err != nil {
+		s.fail(w, err)
+		return
+	}
+	s.respond(w, signOneTimeResponse{Sig: sig})
+}
+
+func (s *Server) handleSignHeartbeat(w http.ResponseWriter, r *http.Request) {
+	var req signHeartbeatRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+	otsID := crypto.OneTimeSignatureIdentifier{Batch: req.Batch, Offset: req.Offset}
+	msg := account.SigningMessage{HashID: req.HashID, Data: req.Data}
+	sig, err := s.signer.SignHeartbeat(account.ParticipationID(req.ID), otsID, req.LastValid, msg)
+	if err != nil {
+		s.fail(w, err)
+		return
+	}
+	s.respond(w, signOneTimeResponse{Sig: sig})
+}
+
+func (s *Server) handleProveVRF(w http.ResponseWriter, r *http.Request) {
+	var req proveVRFRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+	msg := account.SigningMessage{HashID: req.HashID, Data: req.Data}
+	proof, err := s.signer.ProveVRF(account.ParticipationID(req.ID), msg)
+	if err != nil {
+		s.fail(w, err)
+		return
+	}
+	s.respond(w, proveVRFResponse{Proof: proof})
+}
+
+func (s *Server) handleSignStateProof(w http.ResponseWriter, r *http.Request) {
+	var req signStateProofRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+	sig, err := s.signer.SignStateProof(account.ParticipationID(req.ID), req.Round, req.Msg)
+	if err != nil {
+		s.fail(w, err)
+		return
+	}
+	s.respond(w, signStateProofResponse{Sig: sig})
+}
@@ -0,0 +1,325 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package partsigner lets a node delegate signing with participation keys to
+// a separate process. The signer keeps a record of every vote, state proof and
+// heartbeat it has signed and refuses to sign a conflicting message for the
+// same slot.
+package partsigner
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// voteFields mirrors the encoding of an agreement vote. The signer decodes
+// votes to find the slot they are cast for.
+type voteFields struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Sender   basics.Address `codec:"snd"`
+	Round    basics.Round   `codec:"rnd"`
+	Period   uint64         `codec:"per"`
+	Step     uint64         `codec:"step"`
+	Proposal proposalFields `codec:"prop"`
+}
+
+type proposalFields struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	OriginalPeriod   uint64         `codec:"oper"`
+	OriginalProposer basics.Address `codec:"oprop"`
+	BlockDigest      crypto.Digest  `codec:"dig"`
+	EncodingDigest   crypto.Digest  `codec:"encdig"`
+}
+
+// SoftSigner is a ParticipationSigner which holds participation secrets in
+// memory. It enforces the same double-sign protection as an external signer
+// and serves as the reference implementation of the signing protocol.
+type SoftSigner struct {
+	mu    deadlock.RWMutex
+	parts map[account.ParticipationID]account.PersistedParticipation
+
+	// deleteMu serializes the deletion of used one-time keys, and protects
+	// deletedBefore, the round before which keys have been deleted.
+	deleteMu      deadlock.Mutex
+	deletedBefore map[account.ParticipationID]basics.Round
+
+	protection *protection
+	log        logging.Logger
+}
+
+// MakeSoftSigner creates a SoftSigner recording its signing history in the
+// protection database at protectionFile. An empty protectionFile keeps the
+// history in memory, which is only suitable for tests.
+func MakeSoftSigner(protectionFile string, log logging.Logger) (*SoftSigner, error) {
+	p, err := makeProtection(protectionFile, DefaultRetainRounds)
+	if err != nil {
+		return nil, err
+	}
+	return &SoftSigner{
+		parts:         make(map[account.ParticipationID]account.PersistedParticipation),
+		deletedBefore: make(map[account.ParticipationID]basics.Round),
+		protection:    p,
+		log:           log,
+	}, nil
+}
+
+// Close releases the protection database.
+func (s *SoftSigner) Close() {
+	s.protection.close()
+}
+
+// AddParticipation makes the signer hold the secrets of part. One-time keys
+// are deleted once they are no longer needed, and if part has a Store the
+// deletion is persisted to it. The caller remains responsible for closing
+// the Store.
+func (s *SoftSigner) AddParticipation(part account.PersistedParticipation) account.ParticipationID {
+	id := part.ID()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.parts[id] = part
+	return id
+}
+
+// RemoveParticipation drops the secrets of the participation key id.
+func (s *SoftSigner) RemoveParticipation(id account.ParticipationID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.parts, id)
+}
+
+// Keys implements account.RemoteSigner.
+func (s *SoftSigner) Keys() []account.ParticipationRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := make([]account.ParticipationRecord, 0, len(s.parts))
+	for id, part := range s.parts {
+		out = append(out, publicRecord(id, part.Participation))
+	}
+	return out
+}
+
+// publicRecord returns a ParticipationRecord describing part without any of
+// its secrets.
+func publicRecord(id account.ParticipationID, part account.Participation) account.ParticipationRecord {
+	record := account.ParticipationRecord{
+		ParticipationID: id,
+		Account:         part.Parent,
+		FirstValid:      part.FirstValid,
+		LastValid:       part.LastValid,
+		KeyDilution:     part.KeyDilution,
+	}
+	if part.StateProofSecrets != nil {
+		record.StateProof = part.StateProofVerifier()
+	}
+	if part.VRF != nil {
+		record.VRF = &crypto.VRFSecrets{PK: part.VRF.PK}
+	}
+	if part.Voting != nil {
+		record.Voting = &crypto.OneTimeSignatureSecrets{}
+		record.Voting.OneTimeSignatureVerifier = part.Voting.OneTimeSignatureVerifier
+	}
+	return record
+}
+
+func (s *SoftSigner) get(id account.ParticipationID) (account.PersistedParticipation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	part, ok := s.parts[id]
+	if !ok {
+		return account.PersistedParticipation{}, fmt.Errorf("%w: %s", account.ErrParticipationIDNotFound, id)
+	}
+	return part, nil
+}
+
+// SignOneTime implements account.ParticipationSigner. Only votes may be
+// signed, with the one-time key of their round, and only after they are
+// checked against the protection database. Once a vote is signed, the
+// one-time keys of earlier rounds are deleted.
+func (s *SoftSigner) SignOneTime(id account.ParticipationID, otsID crypto.OneTimeSignatureIdentifier, proto protocol.ConsensusVersion, msg account.SigningMessage) (crypto.OneTimeSignature, error) {
+	part, err := s.get(id)
+	if err != nil {
+		return crypto.OneTimeSignature{}, err
+	}
+	if part.Voting == nil {
+		return crypto.OneTimeSignature{}, account.ErrNoSecrets
+	}
+	if msg.HashID != protocol.Vote {
+		return crypto.OneTimeSignature{}, fmt.Errorf("refusing to sign message of type %s", msg.HashID)
+	}
+	params, ok := config.Consensus[proto]
+	if !ok {
+		return crypto.OneTimeSignature{}, fmt.Errorf("unknown consensus version %s", proto)
+	}
+
+	var v voteFields
+	err = protocol.DecodeReflect(msg.Data, &v)
+	if err != nil {
+		return crypto.OneTimeSignature{}, fmt.Errorf("could not decode vote: %w", err)
+	}
+	if v.Sender != part.Parent {
+		return crypto.OneTimeSignature{}, fmt.Errorf("vote sender %v does not match participation account %v", v.Sender, part.Parent)
+	}
+	if v.Round < part.FirstValid || v.Round > part.LastValid {
+		return crypto.OneTimeSignature{}, fmt.Errorf("vote round %d outside of key validity [%d, %d]", v.Round, part.FirstValid, part.LastValid)
+	}
+	dilution := keyDilution(part.Participation, params)
+	if expected := basics.OneTimeIDForRound(v.Round, dilution); otsID != expected {
+		return crypto.OneTimeSignature{}, fmt.Errorf("one-time key batch %d offset %d does not match vote round %d (batch %d offset %d)", otsID.Batch, otsID.Offset, v.Round, expected.Batch, expected.Offset)
+	}
+	err = s.protection.checkVote(id, v.Round, v.Period, v.Step, crypto.HashObj(msg))
+	if err != nil {
+		s.log.Warnf("partsigner: not signing vote for %v: %v", part.Parent, err)
+		return crypto.OneTimeSignature{}, err
+	}
+
+	sig := part.Voting.Sign(otsID, msg)
+	if (sig == crypto.OneTimeSignature{}) {
+		return crypto.OneTimeSignature{}, fmt.Errorf("no one-time key for batch %d offset %d", otsID.Batch, otsID.Offset)
+	}
+	s.deleteOldKeys(id, part, v.Round, params)
+	return sig, nil
+}
+
+// SignHeartbeat implements account.ParticipationSigner. Only seeds may be
+// signed, with the one-time key of the heartbeat's last valid round, and
+// only after they are checked against the protection database.
+func (s *SoftSigner) SignHeartbeat(id account.ParticipationID, otsID crypto.OneTimeSignatureIdentifier, lastValid basics.Round, msg account.SigningMessage) (crypto.OneTimeSignature, error) {
+	part, err := s.get(id)
+	if err != nil {
+		return crypto.OneTimeSignature{}, err
+	}
+	if part.Voting == nil {
+		return crypto.OneTimeSignature{}, account.ErrNoSecrets
+	}
+	if msg.HashID != protocol.Seed {
+		return crypto.OneTimeSignature{}, fmt.Errorf("refusing to sign message of type %s", msg.HashID)
+	}
+
+	var seed committee.Seed
+	if len(msg.Data) != len(seed) {
+		return crypto.OneTimeSignature{}, fmt.Errorf("could not decode seed of length %d", len(msg.Data))
+	}
+	copy(seed[:], msg.Data)
+	if lastValid < part.FirstValid || lastValid > part.LastValid {
+		return crypto.OneTimeSignature{}, fmt.Errorf("heartbeat round %d outside of key validity [%d, %d]", lastValid, part.FirstValid, part.LastValid)
+	}
+	// heartbeats carry the registered key dilution, so keys without one
+	// cannot send them
+	if part.KeyDilution == 0 {
+		return crypto.OneTimeSignature{}, fmt.Errorf("participation %s has no key dilution", id)
+	}
+	if expected := basics.OneTimeIDForRound(lastValid, part.KeyDilution); otsID != expected {
+		return crypto.OneTimeSignature{}, fmt.Errorf("one-time key batch %d offset %d does not match heartbeat round %d (batch %d offset %d)", otsID.Batch, otsID.Offset, lastValid, expected.Batch, expected.Offset)
+	}
+	err = s.protection.checkHeartbeat(id, lastValid, crypto.HashObj(seed))
+	if err != nil {
+		s.log.Warnf("partsigner: not signing heartbeat for %v: %v", part.Parent, err)
+		return crypto.OneTimeSignature{}, err
+	}
+
+	sig := part.Voting.Sign(otsID, seed)
+	if (sig == crypto.OneTimeSignature{}) {
+		return crypto.OneTimeSignature{}, fmt.Errorf("no one-time key for batch %d offset %d", otsID.Batch, otsID.Offset)
+	}
+	return sig, nil
+}
+
+// keyDilution returns the key dilution of part's voting key in rounds of
+// consensus parameters proto.
+func keyDilution(part account.Participation, proto config.ConsensusParams) uint64 {
+	signer := crypto.OneTimeSigner{OneTimeSignatureSecrets: part.Voting, OptionalKeyDilution: part.KeyDilution}
+	return signer.KeyDilution(proto.DefaultKeyDilution)
+}
+
+// deleteOldKeys deletes the one-time keys of part for rounds before rnd, as
+// the node does for the keys it holds itself, so that keys which have been
+// used cannot later be stolen to sign conflicting votes.
+func (s *SoftSigner) deleteOldKeys(id account.ParticipationID, part account.PersistedParticipation, rnd basics.Round, proto config.ConsensusParams) {
+	s.deleteMu.Lock()
+	defer s.deleteMu.Unlock()
+	if rnd <= s.deletedBefore[id] {
+		return
+	}
+	s.deletedBefore[id] = rnd
+
+	if part.Store.Handle == nil {
+		dilution := keyDilution(part.Participation, proto)
+		part.Voting.DeleteBeforeFineGrained(basics.OneTimeIDForRound(rnd, dilution), dilution)
+		return
+	}
+	err := <-part.DeleteOldKeys(rnd, proto)
+	if err != nil {
+		s.log.Warnf("partsigner: could not delete old keys of %v before round %d: %v", part.Parent, rnd, err)
+	}
+}
+
+// ProveVRF implements account.ParticipationSigner. Only selectors and seeds
+// may be proven.
+func (s *SoftSigner) ProveVRF(id account.ParticipationID, msg account.SigningMessage) (crypto.VrfProof, error) {
+	part, err := s.get(id)
+	if err != nil {
+		return crypto.VrfProof{}, err
+	}
+	if part.VRF == nil {
+		return crypto.VrfProof{}, account.ErrNoSecrets
+	}
+
+	switch msg.HashID {
+	case protocol.AgreementSelector, protocol.Seed:
+	default:
+		return crypto.VrfProof{}, fmt.Errorf("refusing to prove message of type %s", msg.HashID)
+	}
+
+	proof, ok := part.VRF.SK.Prove(msg)
+	if !ok {
+		return crypto.VrfProof{}, fmt.Errorf("could not compute VRF proof for participation %s", id)
+	}
+	return proof, nil
+}
+
+// SignStateProof implements account.ParticipationSigner.
+func (s *SoftSigner) SignStateProof(id account.ParticipationID, round basics.Round, msg []byte) (merklesignature.Signature, error) {
+	part, err := s.get(id)
+	if err != nil {
+		return merklesignature.Signature{}, err
+	}
+	if part.StateProofSecrets == nil {
+		return merklesignature.Signature{}, account.ErrNoSecrets
+	}
+	if round < part.FirstValid || round > part.LastValid {
+		return merklesignature.Signature{}, fmt.Errorf("state proof round %d outside of key validity [%d, %d]", round, part.FirstValid, part.LastValid)
+	}
+
+	err = s.protection.checkStateProof(id, round, crypto.Hash(msg))
+	if err != nil {
+		s.log.Warnf("partsigner: not signing state proof for %v: %v", part.Parent, err)
+		return merklesignature.Signature{}, err
+	}
+	return part.StateProofSecrets.GetSigner(uint64(round)).SignBytes(msg)
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package partsigner
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func makeTestParticipation(t *testing.T) account.PersistedParticipation {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	addr := basics.Address(crypto.GenerateSignatureSecrets(seed).SignatureVerifier)
+
+	store, err := db.MakeAccessor(t.Name()+"_part", false, true)
+	require.NoError(t, err)
+	t.Cleanup(store.Close)
+
+	part, err := account.FillDBWithParticipationKeys(store, addr, 0, 1000, 10)
+	require.NoError(t, err)
+	return part
+}
+
+func makeTestVote(part account.PersistedParticipation, rnd basics.Round, per uint64, step uint64, dig crypto.Digest) account.SigningMessage {
+	v := voteFields{Sender: part.Parent, Round: rnd, Period: per, Step: step}
+	v.Proposal.BlockDigest = dig
+	return account.SigningMessage{HashID: protocol.Vote, Data: protocol.EncodeReflect(&v)}
+}
+
+func TestSoftSignerDoubleSign(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	part := makeTestParticipation(t)
+	signer, err := MakeSoftSigner("", logging.TestingLog(t))
+	require.NoError(t, err)
+	defer signer.Close()
+	id := signer.AddParticipation(part)
+
+	otsID := basics.OneTimeIDForRound(10, part.KeyDilution)
+	vote := makeTestVote(part, 10, 0, 1, crypto.Hash([]byte("a")))
+	sig, err := signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, vote)
+	require.NoError(t, err)
+	require.True(t, part.Voting.OneTimeSignatureVerifier.Verify(otsID, vote, sig))
+
+	// identical vote may be signed again
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, vote)
+	require.NoError(t, err)
+
+	// a conflicting vote for the same slot is refused
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, makeTestVote(part, 10, 0, 1, crypto.Hash([]byte("b"))))
+	require.ErrorIs(t, err, ErrDoubleSign)
+
+	// other slots are independent
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, makeTestVote(part, 10, 0, 2, crypto.Hash([]byte("b"))))
+	require.NoError(t, err)
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, makeTestVote(part, 10, 1, 1, crypto.Hash([]byte("b"))))
+	require.NoError(t, err)
+
+	// votes from another account are refused
+	other := makeTestVote(part, 10, 0, 3, crypto.Digest{})
+	var v voteFields
+	require.NoError(t, protocol.DecodeReflect(other.Data, &v))
+	v.Sender = basics.Address{1}
+	other.Data = protocol.EncodeReflect(&v)
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, other)
+	require.ErrorContains(t, err, "does not match")
+
+	// a one-time key other than the one of the vote's round is refused
+	_, err = signer.SignOneTime(id, basics.OneTimeIDForRound(11, part.KeyDilution), protocol.ConsensusCurrentVersion, makeTestVote(part, 10, 0, 4, crypto.Digest{}))
+	require.ErrorContains(t, err, "does not match vote round")
+
+	// arbitrary messages, including seeds, are refused
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, account.SigningMessage{HashID: protocol.Transaction, Data: []byte("x")})
+	require.Error(t, err)
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, account.SigningMessage{HashID: protocol.Seed, Data: []byte("seed")})
+	require.Error(t, err)
+	_, err = signer.ProveVRF(id, account.SigningMessage{HashID: protocol.Transaction, Data: []byte("x")})
+	require.Error(t, err)
+
+	_, err = signer.SignOneTime(account.ParticipationID{1}, otsID, protocol.ConsensusCurrentVersion, vote)
+	require.ErrorIs(t, err, account.ErrParticipationIDNotFound)
+}
+
+func TestSoftSignerHeartbeat(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	part := makeTestParticipation(t)
+	signer, err := MakeSoftSigner("", logging.TestingLog(t))
+	require.NoError(t, err)
+	defer signer.Close()
+	id := signer.AddParticipation(part)
+
+	seed := committee.Seed{1}
+	otsID := basics.OneTimeIDForRound(110, part.KeyDilution)
+	sig, err := signer.SignHeartbeat(id, otsID, 110, account.MakeSigningMessage(seed))
+	require.NoError(t, err)
+	require.True(t, part.Voting.OneTimeSignatureVerifier.Verify(otsID, seed, sig))
+
+	// the same seed may be signed again, but not a different one
+	_, err = signer.SignHeartbeat(id, otsID, 110, account.MakeSigningMessage(seed))
+	require.NoError(t, err)
+	_, err = signer.SignHeartbeat(id, otsID, 110, account.MakeSigningMessage(committee.Seed{2}))
+	require.ErrorIs(t, err, ErrDoubleSign)
+
+	// a one-time key other than the one of the last valid round is refused
+	_, err = signer.SignHeartbeat(id, basics.OneTimeIDForRound(111, part.KeyDilution), 110, account.MakeSigningMessage(seed))
+	require.ErrorContains(t, err, "does not match heartbeat round")
+
+	// only seeds may be signed
+	_, err = signer.SignHeartbeat(id, otsID, 110, makeTestVote(part, 110, 0, 1, crypto.Digest{}))
+	require.ErrorContains(t, err, "refusing to sign")
+	_, err = signer.SignHeartbeat(id, otsID, 110, account.SigningMessage{HashID: protocol.Seed, Data: []byte("seed")})
+	require.ErrorContains(t, err, "could not decode seed")
+
+	_, err = signer.SignHeartbeat(id, basics.OneTimeIDForRound(2000, part.KeyDilution), 2000, account.MakeSigningMessage(seed))
+	require.ErrorContains(t, err, "outside of key validity")
+}
+
+func TestSoftSignerKeyDilution(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// a key registered without a key dilution uses the default of the
+	// consensus version of the round voted on
+	const testVersion = protocol.ConsensusVersion("test-partsigner-dilution")
+	params := config.Consensus[protocol.ConsensusCurrentVersion]
+	params.DefaultKeyDilution = 10
+	config.Consensus[testVersion] = params
+	defer delete(config.Consensus, testVersion)
+
+	part := makeTestParticipation(t)
+	part.KeyDilution = 0
+	signer, err := MakeSoftSigner("", logging.TestingLog(t))
+	require.NoError(t, err)
+	defer signer.Close()
+	id := signer.AddParticipation(account.PersistedParticipation{Participation: part.Participation})
+
+	otsID := basics.OneTimeIDForRound(25, 10)
+	vote := makeTestVote(part, 25, 0, 1, crypto.Digest{})
+	sig, err := signer.SignOneTime(id, otsID, testVersion, vote)
+	require.NoError(t, err)
+	require.True(t, part.Voting.OneTimeSignatureVerifier.Verify(otsID, vote, sig))
+
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, vote)
+	require.ErrorContains(t, err, "does not match vote round")
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusVersion("unknown"), vote)
+	require.ErrorContains(t, err, "unknown consensus version")
+}
+
+func TestSoftSignerDeletesOldKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	part := makeTestParticipation(t)
+	signer, err := MakeSoftSigner("", logging.TestingLog(t))
+	require.NoError(t, err)
+	defer signer.Close()
+	id := signer.AddParticipation(part)
+
+	_, err = signer.SignOneTime(id, basics.OneTimeIDForRound(30, part.KeyDilution), protocol.ConsensusCurrentVersion, makeTestVote(part, 30, 0, 1, crypto.Digest{}))
+	require.NoError(t, err)
+
+	// keys of earlier rounds are gone, while the round voted on remains usable
+	_, err = signer.SignOneTime(id, basics.OneTimeIDForRound(20, part.KeyDilution), protocol.ConsensusCurrentVersion, makeTestVote(part, 20, 0, 1, crypto.Digest{}))
+	require.ErrorContains(t, err, "no one-time key")
+	_, err = signer.SignOneTime(id, basics.OneTimeIDForRound(30, part.KeyDilution), protocol.ConsensusCurrentVersion, makeTestVote(part, 30, 0, 2, crypto.Digest{}))
+	require.NoError(t, err)
+
+	// and the deletion is persisted to the key file
+	restored, err := account.RestoreParticipation(part.Store)
+	require.NoError(t, err)
+	old := basics.OneTimeIDForRound(20, part.KeyDilution)
+	require.Equal(t, crypto.OneTimeSignature{}, restored.Voting.Sign(old, committee.Seed{}))
+	require.NotEqual(t, crypto.OneTimeSignature{}, restored.Voting.Sign(basics.OneTimeIDForRound(30, part.KeyDilution), committee.Seed{}))
+}
+
+func TestSoftSignerStateProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	part := makeTestParticipation(t)
+	signer, err := MakeSoftSigner("", logging.TestingLog(t))
+	require.NoError(t, err)
+	defer signer.Close()
+	id := signer.AddParticipation(part)
+
+	keys := signer.Keys()
+	require.Len(t, keys, 1)
+	require.Equal(t, id, keys[0].ParticipationID)
+	require.NotNil(t, keys[0].StateProof)
+	require.Equal(t, part.VRF.PK, keys[0].VRF.PK)
+	require.Equal(t, crypto.VrfPrivkey{}, keys[0].VRF.SK)
+
+	msg := crypto.Hash([]byte("state proof"))
+	sig, err := signer.SignStateProof(id, 512, msg[:])
+	require.NoError(t, err)
+	require.NoError(t, keys[0].StateProof.VerifyBytes(512, msg[:], &sig))
+
+	_, err = signer.SignStateProof(id, 512, msg[:])
+	require.NoError(t, err)
+
+	other := crypto.Hash([]byte("other state proof"))
+	_, err = signer.SignStateProof(id, 512, other[:])
+	require.ErrorIs(t, err, ErrDoubleSign)
+
+	_, err = signer.SignStateProof(id, 2048, msg[:])
+	require.Error(t, err)
+}
+
+func TestSoftSignerProtectionPersists(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	part := makeTestParticipation(t)
+	filename := filepath.Join(t.TempDir(), "protection.sqlite")
+	otsID := basics.OneTimeIDForRound(10, part.KeyDilution)
+
+	signer, err := MakeSoftSigner(filename, logging.TestingLog(t))
+	require.NoError(t, err)
+	id := signer.AddParticipation(part)
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, makeTestVote(part, 10, 0, 1, crypto.Hash([]byte("a"))))
+	require.NoError(t, err)
+	signer.Close()
+
+	signer, err = MakeSoftSigner(filename, logging.TestingLog(t))
+	require.NoError(t, err)
+	defer signer.Close()
+	signer.AddParticipation(part)
+	_, err = signer.SignOneTime(id, otsID, protocol.ConsensusCurrentVersion, makeTestVote(part, 10, 0, 1, crypto.Hash([]byte("b"))))
+	require.ErrorIs(t, err, ErrDoubleSign)
+}
+
+func TestProtectionWatermark(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	p, err := makeProtection("", 10)
+	require.NoError(t, err)
+	defer p.close()
+
+	id := account.ParticipationID{1}
+	require.NoError(t, p.checkVote(id, 5, 0, 1, crypto.Digest{1}))
+	require.NoError(t, p.checkVote(id, 100, 0, 1, crypto.Digest{1}))
+
+	// history below round 90 has been pruned, so signing there is refused
+	require.ErrorIs(t, p.checkVote(id, 5, 0, 1, crypto.Digest{1}), ErrBelowWatermark)
+	require.ErrorIs(t, p.checkVote(id, 89, 0, 1, crypto.Digest{2}), ErrBelowWatermark)
+	require.NoError(t, p.checkVote(id, 90, 0, 1, crypto.Digest{2}))
+
+	// other participation keys are unaffected
+	require.NoError(t, p.checkVote(account.ParticipationID{2}, 5, 0, 1, crypto.Digest{1}))
+}
@@ -594,6 +594,10 @@ func (spw *Worker) deleteStaleSigs(retainRound basics.Round) {
 func (spw *Worker) deleteStaleKeys(retainRound basics.Round) {
 	keys := spw.accts.StateProofKeys(retainRound)
 	for _, key := range keys {
+		if key.Signer != nil {
+			// keys held by a remote signer are not stored in the registry.
+			continue
+		}
 		firstRoundAtKeyLifeTime, err := key.StateProofSecrets.FirstRoundInKeyLifetime()
 		if err != nil {
 			spw.log.Errorf("deleteStaleKeys: could not calculate keylifetime for account %v on round %d:  %v", key.ParticipationID, firstRoundAtKeyLifeTime, err)
@@ -169,7 +169,7 @@ func (spw *Worker) signStateProofMessage(message *stateproofmsg.Message, round b
 			continue
 		}
 
-		if key.StateProofSecrets == nil {
+		if key.StateProofSecrets == nil && key.Signer == nil {
 			spw.log.Warnf("spw.signStateProofMessage(%d): empty state proof secrets for round", round)
 			continue
 		}
@@ -185,7 +185,7 @@ func (spw *Worker) signStateProofMessage(message *stateproofmsg.Message, round b
 			continue
 		}
 
-		sig, err := key.StateProofSecrets.SignBytes(hashedStateproofMessage[:])
+		sig, err := key.SignBytes(round, hashedStateproofMessage[:])
 		if err != nil {
 			spw.log.Warnf("spw.signStateProofMessage(%d): StateProofSecrets.Sign: %v", round, err)
 			continue
@@ -743,3 +743,90 @@ func TestMasterKeyGeneratePastImportedKeys(t *testing.T) {
 	// Address should be equal to addrs[2]
 	a.Equal(addr1, addrs[2])
 }
+
+func TestAuditLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate a key outside of kmd and import it
+	seed := crypto.Seed{}
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	addr := basics.Address(secrets.SignatureVerifier)
+	req0 := kmdapi.APIV1POSTKeyImportRequest{
+		WalletHandleToken: walletHandleToken,
+		PrivateKey:        crypto.PrivateKey(secrets.SK),
+	}
+	resp0 := kmdapi.APIV1POSTKeyImportResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+
+	// Sign a payment with it
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     addr,
+			Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid: basics.Round(1),
+			LastValid:  basics.Round(1),
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: basics.Address{1},
+			Amount:   basics.MicroAlgos{Raw: 12345},
+		},
+	}
+	req1 := kmdapi.APIV1POSTTransactionSignRequest{
+		WalletHandleToken: walletHandleToken,
+		Transaction:       protocol.Encode(&tx),
+		WalletPassword:    f.WalletPassword,
+	}
+	resp1 := kmdapi.APIV1POSTTransactionSignResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+
+	// Fail to export it
+	req2 := kmdapi.APIV1POSTKeyExportRequest{
+		WalletHandleToken: walletHandleToken,
+		Address:           addr.String(),
+		WalletPassword:    "wr0ng_p4ssw0rd",
+	}
+	resp2 := kmdapi.APIV1POSTKeyExportResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.Error(err)
+
+	// All three operations should be in the audit log
+	resp3, err := f.Client.ListAudit(nil, addr.String(), "", 0, 0)
+	a.NoError(err)
+	a.Empty(resp3.ChainError)
+	a.Len(resp3.Entries, 3)
+
+	a.Equal("import_key", resp3.Entries[0].Operation)
+	a.Empty(resp3.Entries[0].Error)
+
+	sign := resp3.Entries[1]
+	a.Equal("sign_transaction", sign.Operation)
+	a.Equal(tx.ID().String(), sign.TxID)
+	a.Equal(addr.String(), sign.Sender)
+	a.Equal(string(protocol.PaymentTx), sign.TxType)
+	a.Equal(uint64(12345), sign.Amount)
+	a.NotEmpty(sign.Origin)
+	a.Equal(resp3.Entries[0].Hash, sign.PrevHash)
+
+	a.Equal("export_key", resp3.Entries[2].Operation)
+	a.NotEmpty(resp3.Entries[2].Error)
+
+	// Filters narrow the results
+	resp4, err := f.Client.ListAudit(nil, "", "sign_transaction", 0, 0)
+	a.NoError(err)
+	a.Len(resp4.Entries, 1)
+	resp5, err := f.Client.ListAudit(nil, addr.String(), "", sign.Seq, 1)
+	a.NoError(err)
+	a.Len(resp5.Entries, 1)
+	a.Equal("export_key", resp5.Entries[0].Operation)
+}
@@ -213,6 +213,77 @@ func TestWalletRename(t *testing.T) {
 	a.Equal(resp1.Wallets[0].ID, resp4.Wallets[0].ID)
 }
 
+func TestWalletBackup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate a key to back up
+	req0 := kmdapi.APIV1POSTKeyRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp0 := kmdapi.APIV1POSTKeyResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+
+	// Try to back up the wallet with the wrong password
+	req1 := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    "wr0ng_p4ssw0rd",
+		BackupPassword:    "backup",
+	}
+	resp1 := kmdapi.APIV1POSTWalletBackupResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+
+	// Should be an error
+	a.Error(err)
+
+	// Back up the wallet with the correct password
+	req2 := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+		BackupPassword:    "backup",
+	}
+	resp2 := kmdapi.APIV1POSTWalletBackupResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.NoError(err)
+	a.NotEmpty(resp2.Backup)
+
+	// Restoring with the wrong backup password should fail
+	req3 := kmdapi.APIV1POSTWalletRestoreRequest{
+		Backup:         resp2.Backup,
+		BackupPassword: "wr0ng_p4ssw0rd",
+		WalletName:     "restored",
+		WalletPassword: "password",
+	}
+	resp3 := kmdapi.APIV1POSTWalletRestoreResponse{}
+	err = f.Client.DoV1Request(req3, &resp3)
+	a.Error(err)
+
+	// Restoring next to the original wallet should fail, since the IDs collide
+	req4 := kmdapi.APIV1POSTWalletRestoreRequest{
+		Backup:         resp2.Backup,
+		BackupPassword: "backup",
+		WalletName:     "restored",
+		WalletPassword: "password",
+	}
+	resp4 := kmdapi.APIV1POSTWalletRestoreResponse{}
+	err = f.Client.DoV1Request(req4, &resp4)
+	a.Error(err)
+
+	// There should still be a single wallet
+	req5 := kmdapi.APIV1GETWalletsRequest{}
+	resp5 := kmdapi.APIV1GETWalletsResponse{}
+	err = f.Client.DoV1Request(req5, &resp5)
+	a.NoError(err)
+	a.Equal(len(resp5.Wallets), 1)
+}
+
 func TestWalletSessionRelease(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	defer fixtures.ShutdownSynchronizedTest(t)
@@ -39,6 +39,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -419,6 +420,37 @@ end:
 		sort.Strings(actualBoxes)
 
 		a.Equal(expectedCreatedBoxes, actualBoxes)
+
+		// Paging through the boxes returns them in name order.
+		pagedBoxes := []string{}
+		next := ""
+		for {
+			resp, err = testClient.ApplicationBoxesPage(uint64(createdAppID), "", next, 4, true)
+			a.NoError(err)
+			a.LessOrEqual(len(resp.Boxes), 4)
+			for _, box := range resp.Boxes {
+				pagedBoxes = append(pagedBoxes, string(box.Name))
+				a.NotNil(box.Value)
+			}
+			if resp.NextToken == nil {
+				break
+			}
+			next = *resp.NextToken
+		}
+		a.Equal(expectedCreatedBoxes, pagedBoxes)
+
+		// Filtering by the full name of a box returns it, along with the boxes it is a prefix of.
+		if len(expectedCreatedBoxes) > 0 {
+			prefix := expectedCreatedBoxes[0]
+			resp, err = testClient.ApplicationBoxesPage(uint64(createdAppID), "b64:"+base64.StdEncoding.EncodeToString([]byte(prefix)), "", 0, false)
+			a.NoError(err)
+			a.NotEmpty(resp.Boxes)
+			a.Equal(prefix, string(resp.Boxes[0].Name))
+			for _, box := range resp.Boxes {
+				a.True(strings.HasPrefix(string(box.Name), prefix))
+				a.Nil(box.Value)
+			}
+		}
 	}
 
 	testingBoxNames := []string{
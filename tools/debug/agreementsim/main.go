@@ -0,0 +1,265 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// agreementsim runs a committee of agreement services over a simulated
+// network and reports how quickly rounds are finalized.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/agreement/agreementsim"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	numNodes      int
+	numRounds     int
+	stakeDist     string
+	seed          int64
+	latency       time.Duration
+	jitter        time.Duration
+	dropRate      float64
+	partitions    []string
+	catchupDelay  time.Duration
+	maxDuration   time.Duration
+	filterTimeout time.Duration
+	filterPeriod0 time.Duration
+	dynamicFilter string
+	cadaverDir    string
+	jsonOutput    bool
+)
+
+func init() {
+	rootCmd.Flags().IntVarP(&numNodes, "nodes", "n", 10, "Number of participating nodes")
+	rootCmd.Flags().IntVarP(&numRounds, "rounds", "r", 10, "Number of rounds to finalize")
+	rootCmd.Flags().StringVar(&stakeDist, "stake", "equal", "Stake distribution across nodes: equal, linear, or exponential")
+	rootCmd.Flags().Int64Var(&seed, "seed", 0, "Seed for keys and network randomness")
+	rootCmd.Flags().DurationVar(&latency, "latency", 100*time.Millisecond, "One-way message latency")
+	rootCmd.Flags().DurationVar(&jitter, "jitter", 0, "Maximum random delay added to the latency of each message")
+	rootCmd.Flags().Float64Var(&dropRate, "drop-rate", 0, "Probability that a message is lost")
+	rootCmd.Flags().StringArrayVar(&partitions, "partition", nil, "Partition the network, as start-end:nodes[/nodes...] (e.g. 0s-30s:0,1,2/3,4); unlisted nodes form one more group; repeatable")
+	rootCmd.Flags().DurationVar(&catchupDelay, "catchup-delay", 0, "How long a node may lag behind before it catches up; 0 disables catchup")
+	rootCmd.Flags().DurationVar(&maxDuration, "max-duration", 0, "Simulated time after which the run is considered stalled (default 10m per round)")
+	rootCmd.Flags().DurationVar(&filterTimeout, "filter-timeout", 0, "Override AgreementFilterTimeout")
+	rootCmd.Flags().DurationVar(&filterPeriod0, "filter-timeout-period0", 0, "Override AgreementFilterTimeoutPeriod0")
+	rootCmd.Flags().StringVar(&dynamicFilter, "dynamic-filter-timeout", "", "Override DynamicFilterTimeout (true or false)")
+	rootCmd.Flags().StringVar(&cadaverDir, "cadaver-dir", "", "Record a cadaver for each node in this directory")
+	rootCmd.Flags().BoolVar(&jsonOutput, "json", false, "Print the result as JSON")
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "agreementsim",
+	Short: "Agreement protocol simulator",
+	Long:  "Run a committee of agreement services over a simulated network and report rounds to finality, periods used, and message counts",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		cfg := agreementsim.Config{
+			Rounds:       numRounds,
+			Seed:         seed,
+			Latency:      latency,
+			Jitter:       jitter,
+			DropRate:     dropRate,
+			CatchupDelay: catchupDelay,
+			MaxDuration:  maxDuration,
+
+			CadaverDirectory: cadaverDir,
+		}
+
+		var err error
+		cfg.Stakes, err = stakes(stakeDist, numNodes)
+		if err != nil {
+			return err
+		}
+		for _, p := range partitions {
+			partition, err := parsePartition(p)
+			if err != nil {
+				return err
+			}
+			cfg.Partitions = append(cfg.Partitions, partition)
+		}
+		cfg.ConsensusVersion, err = consensusVersion()
+		if err != nil {
+			return err
+		}
+
+		sim, err := agreementsim.MakeSimulator(cfg)
+		if err != nil {
+			return err
+		}
+		res, err := sim.Run()
+		if err != nil {
+			return err
+		}
+
+		if jsonOutput {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(res)
+		}
+		printResult(res)
+		return nil
+	},
+}
+
+// stakes returns the stake of each of n nodes under the named distribution.
+func stakes(dist string, n int) ([]uint64, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("the number of nodes must be positive")
+	}
+	const unit = 1_000_000_000_000
+
+	out := make([]uint64, n)
+	for i := range out {
+		switch dist {
+		case "equal":
+			out[i] = unit
+		case "linear":
+			out[i] = unit * uint64(i+1)
+		case "exponential":
+			if i >= 20 {
+				return nil, fmt.Errorf("an exponential stake distribution supports at most 20 nodes")
+			}
+			out[i] = unit << i
+		default:
+			return nil, fmt.Errorf("unknown stake distribution %q", dist)
+		}
+	}
+	return out, nil
+}
+
+// parsePartition parses a partition of the form start-end:0,1,2/3,4.
+func parsePartition(s string) (agreementsim.Partition, error) {
+	var p agreementsim.Partition
+	window, groups, ok := strings.Cut(s, ":")
+	if !ok {
+		return p, fmt.Errorf("partition %q is not of the form start-end:nodes[/nodes...]", s)
+	}
+	start, end, ok := strings.Cut(window, "-")
+	if !ok {
+		return p, fmt.Errorf("partition %q has no end time", s)
+	}
+	var err error
+	if p.Start, err = time.ParseDuration(start); err != nil {
+		return p, fmt.Errorf("partition %q: %w", s, err)
+	}
+	if p.End, err = time.ParseDuration(end); err != nil {
+		return p, fmt.Errorf("partition %q: %w", s, err)
+	}
+	for _, group := range strings.Split(groups, "/") {
+		var nodes []int
+		for _, node := range strings.Split(group, ",") {
+			i, err := strconv.Atoi(strings.TrimSpace(node))
+			if err != nil || i < 0 || i >= numNodes {
+				return p, fmt.Errorf("partition %q: invalid node %q", s, node)
+			}
+			nodes = append(nodes, i)
+		}
+		p.Groups = append(p.Groups, nodes)
+	}
+	return p, nil
+}
+
+// consensusVersion registers a consensus version with the overridden
+// agreement parameters, if any.
+func consensusVersion() (protocol.ConsensusVersion, error) {
+	if filterTimeout == 0 && filterPeriod0 == 0 && dynamicFilter == "" {
+		return protocol.ConsensusCurrentVersion, nil
+	}
+	var dynamic bool
+	if dynamicFilter != "" {
+		var err error
+		if dynamic, err = strconv.ParseBool(dynamicFilter); err != nil {
+			return "", fmt.Errorf("invalid --dynamic-filter-timeout: %w", err)
+		}
+	}
+
+	name := protocol.ConsensusVersion("agreementsim")
+	err := agreementsim.RegisterConsensusVersion(name, protocol.ConsensusCurrentVersion, func(p *config.ConsensusParams) {
+		if filterTimeout != 0 {
+			p.AgreementFilterTimeout = filterTimeout
+		}
+		if filterPeriod0 != 0 {
+			p.AgreementFilterTimeoutPeriod0 = filterPeriod0
+		}
+		if dynamicFilter != "" {
+			p.DynamicFilterTimeout = dynamic
+		}
+	})
+	return name, err
+}
+
+func printResult(res agreementsim.Result) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ROUND\tPERIODS\tDURATION\tFIRST FINALIZED\tALL FINALIZED")
+	var total time.Duration
+	var periods uint64
+	for _, rr := range res.Rounds {
+		all := "-"
+		if rr.AllFinalized != 0 {
+			all = rr.AllFinalized.String()
+		}
+		fmt.Fprintf(w, "%d\t%d\t%v\t%v\t%s\n", rr.Round, rr.Periods, rr.Duration, rr.FirstFinalized, all)
+		total += rr.Duration
+		periods += rr.Periods
+	}
+	w.Flush()
+
+	fmt.Println()
+	if len(res.Rounds) > 0 {
+		fmt.Printf("rounds: %d, mean duration: %v, mean periods: %.2f\n",
+			len(res.Rounds), total/time.Duration(len(res.Rounds)), float64(periods)/float64(len(res.Rounds)))
+	}
+	fmt.Printf("simulated time: %v\n", res.Duration)
+	if res.Stalled {
+		fmt.Println("stalled: not every node finalized every round")
+	}
+	for _, fork := range res.Forks {
+		fmt.Printf("fork: %s\n", fork)
+	}
+
+	tags := make([]protocol.Tag, 0, len(res.Messages))
+	for tag := range res.Messages {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+	fmt.Println()
+	w = tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "MESSAGE\tSENT\tDELIVERED\tDROPPED")
+	for _, tag := range tags {
+		stats := res.Messages[tag]
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", tag, stats.Sent, stats.Delivered, stats.Dropped)
+	}
+	w.Flush()
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
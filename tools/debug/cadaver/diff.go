@@ -0,0 +1,201 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+var (
+	diffRound uint64
+	diffJSON  bool
+)
+
+func init() {
+	diffCmd.Flags().Uint64VarP(&diffRound, "round", "r", 0, "Round to compare")
+	diffCmd.Flags().BoolVar(&diffJSON, "json", false, "Print the comparison as JSON")
+	diffCmd.MarkFlagRequired("round")
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [cadaver file] [cadaver file]",
+	Short: "Compare how two nodes progressed through a round",
+	Long: "Compare the cadavers of two nodes for the same round. The player state transitions of the two nodes are " +
+		"aligned to find the first point where they diverged, and the verified inputs seen by only one of the nodes are listed.",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter := agreement.AutopsyEventFilter{Rounds: agreement.AutopsyFilter{Enabled: true, First: basics.Round(diffRound), Last: basics.Round(diffRound)}}
+		a, err := readEvents(args[0], filter)
+		if err != nil {
+			return err
+		}
+		b, err := readEvents(args[1], filter)
+		if err != nil {
+			return err
+		}
+
+		d := diffEvents(a, b)
+		if diffJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(d)
+		}
+		writeDiffText(os.Stdout, args[0], args[1], diffRound, d)
+		return nil
+	},
+}
+
+// A transition is a change of the externally meaningful player state, along
+// with the event which caused it.
+type transition struct {
+	Event agreement.AutopsyEvent `json:"event"`
+	State string                 `json:"state"`
+}
+
+// cadaverDiff describes how two nodes' traces of the same round differ.
+type cadaverDiff struct {
+	EventsA int `json:"events-a"`
+	EventsB int `json:"events-b"`
+
+	TransitionsA int `json:"transitions-a"`
+	TransitionsB int `json:"transitions-b"`
+
+	// Diverged is set if the transitions differ; DivergedAt is the index of
+	// the first differing transition, and A and B are the transitions at that
+	// index (either may be nil if one trace ends early).
+	Diverged   bool        `json:"diverged"`
+	DivergedAt int         `json:"diverged-at,omitempty"`
+	A          *transition `json:"a,omitempty"`
+	B          *transition `json:"b,omitempty"`
+
+	// OnlyA and OnlyB list the verified inputs seen by only one of the nodes.
+	OnlyA []string `json:"only-a,omitempty"`
+	OnlyB []string `json:"only-b,omitempty"`
+}
+
+// transitionKey summarizes the parts of a player state which are expected to
+// evolve identically on well-behaved nodes which saw the same messages.
+// Timers and pending proposals are excluded since they depend on local timing.
+func transitionKey(s agreement.AutopsyPlayerState) string {
+	return fmt.Sprintf("(%d,%d,%d) napping=%v pinned=%s staging=%s freshest=%s", s.Round, s.Period, s.Step, s.Napping, s.Pinned, s.Staging, s.FreshestBundle)
+}
+
+func transitions(events []agreement.AutopsyEvent) []transition {
+	var ts []transition
+	prev := ""
+	for _, e := range events {
+		key := transitionKey(e.State)
+		if key != prev {
+			ts = append(ts, transition{Event: e, State: key})
+			prev = key
+		}
+	}
+	return ts
+}
+
+// verifiedInputs returns the inputs of the verified message events, in order
+// and without duplicates.
+func verifiedInputs(events []agreement.AutopsyEvent) (inputs []string, seen map[string]bool) {
+	seen = make(map[string]bool)
+	for _, e := range events {
+		if !strings.HasSuffix(e.Type, "Verified") || e.Err != "" || e.Input == "" {
+			continue
+		}
+		if !seen[e.Input] {
+			seen[e.Input] = true
+			inputs = append(inputs, e.Input)
+		}
+	}
+	return
+}
+
+func diffEvents(a, b []agreement.AutopsyEvent) cadaverDiff {
+	d := cadaverDiff{EventsA: len(a), EventsB: len(b)}
+
+	ta, tb := transitions(a), transitions(b)
+	d.TransitionsA, d.TransitionsB = len(ta), len(tb)
+	for i := 0; i < len(ta) || i < len(tb); i++ {
+		if i < len(ta) && i < len(tb) && ta[i].State == tb[i].State {
+			continue
+		}
+		d.Diverged = true
+		d.DivergedAt = i
+		if i < len(ta) {
+			d.A = &ta[i]
+		}
+		if i < len(tb) {
+			d.B = &tb[i]
+		}
+		break
+	}
+
+	ia, seenA := verifiedInputs(a)
+	ib, seenB := verifiedInputs(b)
+	for _, in := range ia {
+		if !seenB[in] {
+			d.OnlyA = append(d.OnlyA, in)
+		}
+	}
+	for _, in := range ib {
+		if !seenA[in] {
+			d.OnlyB = append(d.OnlyB, in)
+		}
+	}
+	return d
+}
+
+func writeDiffText(w io.Writer, nameA, nameB string, rnd uint64, d cadaverDiff) {
+	fmt.Fprintf(w, "round %d: %s has %d events (%d transitions), %s has %d events (%d transitions)\n",
+		rnd, nameA, d.EventsA, d.TransitionsA, nameB, d.EventsB, d.TransitionsB)
+
+	if !d.Diverged {
+		fmt.Fprintln(w, "state transitions match")
+	} else {
+		fmt.Fprintf(w, "state transitions diverge at transition %d:\n", d.DivergedAt)
+		for _, side := range []struct {
+			name string
+			t    *transition
+		}{{nameA, d.A}, {nameB, d.B}} {
+			if side.t == nil {
+				fmt.Fprintf(w, "  %s: no further transitions\n", side.name)
+				continue
+			}
+			e := side.t.Event
+			fmt.Fprintf(w, "  %s: after event %d/%d %s %s\n", side.name, e.Seq, e.Index, e.Type, e.Input)
+			fmt.Fprintf(w, "  %s  now %s\n", strings.Repeat(" ", len(side.name)), side.t.State)
+		}
+	}
+
+	for _, side := range []struct {
+		name   string
+		inputs []string
+	}{{nameA, d.OnlyA}, {nameB, d.OnlyB}} {
+		fmt.Fprintf(w, "inputs seen only by %s: %d\n", side.name, len(side.inputs))
+		for _, in := range side.inputs {
+			fmt.Fprintf(w, "  %s\n", in)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func testEvent(index int, typ, input string, period, step uint64, staging string) agreement.AutopsyEvent {
+	return agreement.AutopsyEvent{
+		Index: index,
+		Round: 10,
+		Type:  typ,
+		Input: input,
+		State: agreement.AutopsyPlayerState{Round: 10, Period: period, Step: step, Staging: staging},
+	}
+}
+
+func TestDiffEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := []agreement.AutopsyEvent{
+		testEvent(0, "voteVerified", "vote 1", 0, 1, ""),
+		testEvent(1, "voteVerified", "vote 2", 0, 1, "X"),
+		testEvent(2, "timeout", "timeout (10)", 0, 2, "X"),
+	}
+	b := []agreement.AutopsyEvent{
+		testEvent(0, "votePresent", "vote 1", 0, 1, ""),
+		testEvent(1, "voteVerified", "vote 1", 0, 1, ""),
+		testEvent(2, "timeout", "timeout (10)", 0, 2, ""),
+		testEvent(3, "voteVerified", "vote 3", 0, 2, ""),
+	}
+
+	d := diffEvents(a, b)
+	require.Equal(t, 3, d.EventsA)
+	require.Equal(t, 4, d.EventsB)
+	require.Equal(t, 3, d.TransitionsA)
+	require.Equal(t, 2, d.TransitionsB)
+	require.True(t, d.Diverged)
+	require.Equal(t, 1, d.DivergedAt)
+	require.Equal(t, "vote 2", d.A.Event.Input)
+	require.Equal(t, "timeout (10)", d.B.Event.Input)
+	require.Equal(t, []string{"vote 2"}, d.OnlyA)
+	require.Equal(t, []string{"vote 3"}, d.OnlyB)
+
+	var buf bytes.Buffer
+	writeDiffText(&buf, "a.cdv", "b.cdv", 10, d)
+	require.Contains(t, buf.String(), "diverge at transition 1")
+	require.Contains(t, buf.String(), "inputs seen only by b.cdv: 1")
+
+	d = diffEvents(a, a)
+	require.False(t, d.Diverged)
+	require.Empty(t, d.OnlyA)
+	require.Empty(t, d.OnlyB)
+
+	// one trace ending early is a divergence
+	d = diffEvents(a, a[:1])
+	require.True(t, d.Diverged)
+	require.Equal(t, 1, d.DivergedAt)
+	require.NotNil(t, d.A)
+	require.Nil(t, d.B)
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/agreement"
+)
+
+var (
+	eventsFormat string
+	eventsOutput string
+	withState    bool
+)
+
+func init() {
+	addFilterFlags(eventsCmd)
+	eventsCmd.Flags().StringVar(&eventsFormat, "format", "text", "Output format: text or jsonl")
+	eventsCmd.Flags().StringVarP(&eventsOutput, "output", "o", "", "Write events to the given file instead of stdout")
+	eventsCmd.Flags().BoolVar(&withState, "state", false, "Include the reconstructed player state after each event in text output")
+}
+
+var eventsCmd = &cobra.Command{
+	Use:   "events [cadaver file]",
+	Short: "List the events recorded in a cadaver",
+	Long:  "List the events recorded in a cadaver, optionally filtered by round, period, and event type. Use - to read from stdin.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var write func(io.Writer, agreement.AutopsyEvent) error
+		switch eventsFormat {
+		case "text":
+			write = writeEventText
+		case "jsonl":
+			write = writeEventJSON
+		default:
+			return fmt.Errorf("unknown format %q", eventsFormat)
+		}
+
+		out := io.Writer(os.Stdout)
+		if eventsOutput != "" {
+			f, err := os.Create(eventsOutput)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		w := bufio.NewWriter(out)
+		defer w.Flush()
+
+		return forEachEvent(args[0], eventFilter(), func(e agreement.AutopsyEvent) error {
+			return write(w, e)
+		})
+	},
+}
+
+func writeEventJSON(w io.Writer, e agreement.AutopsyEvent) error {
+	return json.NewEncoder(w).Encode(e)
+}
+
+func writeEventText(w io.Writer, e agreement.AutopsyEvent) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d/%d\t(%d,%d,%d)\t%s", e.Seq, e.Index, e.Round, e.Period, e.Step, e.Type)
+	if e.Input != "" {
+		fmt.Fprintf(&b, "\t%s", e.Input)
+	}
+	if e.Err != "" {
+		fmt.Fprintf(&b, "\terr: %s", e.Err)
+	}
+	b.WriteString("\n")
+	for _, a := range e.Actions {
+		fmt.Fprintf(&b, "\t-> %s\n", a)
+	}
+	if withState {
+		fmt.Fprintf(&b, "\tstate: %s\n", formatState(e.State))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatState returns a single-line summary of a player state.
+func formatState(s agreement.AutopsyPlayerState) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "(%d,%d,%d) last-concluding %d deadline %v", s.Round, s.Period, s.Step, s.LastConcluding, s.Deadline)
+	if s.Napping {
+		b.WriteString(" napping")
+	}
+	if s.FastRecoveryDeadline != 0 {
+		fmt.Fprintf(&b, " fast-recovery-deadline %v", s.FastRecoveryDeadline)
+	}
+	if s.PendingProposals != 0 {
+		fmt.Fprintf(&b, " pending %d", s.PendingProposals)
+	}
+	if s.Pinned != "" {
+		fmt.Fprintf(&b, " pinned %s", s.Pinned)
+	}
+	if s.Staging != "" {
+		fmt.Fprintf(&b, " staging %s", s.Staging)
+	}
+	if s.FreshestBundle != "" {
+		fmt.Fprintf(&b, " freshest %s", s.FreshestBundle)
+	}
+	if len(s.Blocks) != 0 {
+		fmt.Fprintf(&b, " blocks [%s]", strings.Join(s.Blocks, ", "))
+	}
+	return b.String()
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// cadaver filters, exports, and compares agreement cadaver files
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+var (
+	firstRound  uint64
+	lastRound   uint64
+	firstPeriod uint64
+	lastPeriod  uint64
+	eventTypes  []string
+)
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	rootCmd.AddCommand(stateCmd)
+	rootCmd.AddCommand(diffCmd)
+}
+
+// addFilterFlags registers the flags shared by subcommands which select a
+// subset of the events in a cadaver.
+func addFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(&firstRound, "first-round", 0, "First round to report events for; inclusive")
+	cmd.Flags().Uint64Var(&lastRound, "last-round", math.MaxUint64, "Last round to report events for; inclusive")
+	cmd.Flags().Uint64Var(&firstPeriod, "first-period", 0, "First period to report events for; inclusive")
+	cmd.Flags().Uint64Var(&lastPeriod, "last-period", math.MaxUint64, "Last period to report events for; inclusive")
+	cmd.Flags().StringSliceVarP(&eventTypes, "type", "t", nil, "Only report events of the given types (e.g. voteVerified,timeout)")
+}
+
+func eventFilter() agreement.AutopsyEventFilter {
+	return agreement.AutopsyEventFilter{
+		Rounds: agreement.AutopsyFilter{
+			Enabled: true,
+			First:   basics.Round(firstRound),
+			Last:    basics.Round(lastRound),
+		},
+		PeriodsEnabled: true,
+		FirstPeriod:    firstPeriod,
+		LastPeriod:     lastPeriod,
+		Types:          eventTypes,
+	}
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "cadaver",
+	Short: "Agreement cadaver analysis utility",
+	Long:  "Filter, export, and compare the agreement state machine traces recorded in cadaver files",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+// readEvents replays the cadaver with the given base filename (or stdin, if
+// the filename is "-") and returns the events selected by filter.
+func readEvents(filename string, filter agreement.AutopsyEventFilter) ([]agreement.AutopsyEvent, error) {
+	var events []agreement.AutopsyEvent
+	err := forEachEvent(filename, filter, func(e agreement.AutopsyEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	return events, err
+}
+
+// forEachEvent replays the cadaver with the given base filename (or stdin, if
+// the filename is "-") and calls fn with every event selected by filter.
+func forEachEvent(filename string, filter agreement.AutopsyEventFilter, fn func(agreement.AutopsyEvent) error) error {
+	var traceErr error
+	nextBounds := func(int, agreement.AutopsyBounds) {}
+	done := func(n int, err error) {
+		traceErr = err
+	}
+
+	var autopsy *agreement.Autopsy
+	var err error
+	if filename == "-" {
+		autopsy, err = agreement.PrepareAutopsyFromStream(os.Stdin, nextBounds, done)
+	} else {
+		autopsy, err = agreement.PrepareAutopsy(filename, nextBounds, done)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to prepare autopsy of %s: %w", filename, err)
+	}
+	defer autopsy.Close()
+
+	version, err := autopsy.Events(filter, fn)
+	if err != nil {
+		return err
+	}
+	if traceErr != nil {
+		log.Printf("cadaver: failed to extract full trace from %s: %v", filename, traceErr)
+	}
+	if version != "" && version != config.GetCurrentVersion().GetCommitHash() {
+		log.Printf("cadaver: %s was recorded by a different version (%s) than this tool (%s)", filename, version, config.GetCurrentVersion().GetCommitHash())
+	}
+	return nil
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
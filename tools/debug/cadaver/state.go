@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+var (
+	stateSeq   int
+	stateIndex int
+	stateRound uint64
+)
+
+func init() {
+	stateCmd.Flags().IntVar(&stateSeq, "seq", 0, "Cadaver sequence containing the event")
+	stateCmd.Flags().IntVarP(&stateIndex, "index", "i", -1, "Index of the event within the sequence")
+	stateCmd.Flags().Uint64VarP(&stateRound, "round", "r", 0, "Report the state after the last event of the given round instead of a specific event")
+}
+
+var stateCmd = &cobra.Command{
+	Use:   "state [cadaver file]",
+	Short: "Reconstruct the player state at a point in a cadaver",
+	Long:  "Replay a cadaver and print, as JSON, the player state after the given event (--seq and --index) or after the last event of the given round (--round).",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		byRound := cmd.Flags().Changed("round")
+		if byRound == (stateIndex >= 0) {
+			return fmt.Errorf("exactly one of --index or --round must be given")
+		}
+
+		var filter agreement.AutopsyEventFilter
+		if byRound {
+			filter.Rounds.Enabled = true
+			filter.Rounds.First = basics.Round(stateRound)
+			filter.Rounds.Last = basics.Round(stateRound)
+		}
+
+		var found *agreement.AutopsyEvent
+		err := forEachEvent(args[0], filter, func(e agreement.AutopsyEvent) error {
+			if byRound || (e.Seq == stateSeq && e.Index == stateIndex) {
+				found = &e
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("no matching event found in %s", args[0])
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(found)
+	},
+}
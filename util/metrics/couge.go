@@ -146,6 +146,26 @@ func (cg *couge) setLabels(x uint64, labels map[string]string) {
 	}
 }
 
+// deleteLabels removes the value for the given labels, if there is one.
+func (cg *couge) deleteLabels(labels map[string]string) {
+	cg.Lock()
+	defer cg.Unlock()
+
+	labelIndex := cg.findLabelIndex(labels)
+	counterIdx, has := cg.valuesIndices[labelIndex]
+	if !has {
+		return
+	}
+	cg.values = append(cg.values[:counterIdx], cg.values[counterIdx+1:]...)
+	delete(cg.valuesIndices, labelIndex)
+	// values after the removed one have moved down by one.
+	for idx, valueIdx := range cg.valuesIndices {
+		if valueIdx > counterIdx {
+			cg.valuesIndices[idx] = valueIdx - 1
+		}
+	}
+}
+
 // getUint64ValueForLabels returns the value of the counter for the given labels or 0 if it's not found.
 func (cg *couge) getUint64ValueForLabels(labels map[string]string) uint64 {
 	cg.Lock()
@@ -94,6 +94,11 @@ func (gauge *Gauge) SetLabels(x uint64, labels map[string]string) {
 	gauge.g.setLabels(x, labels)
 }
 
+// DeleteLabels removes the gauge value with labels, so that it is no longer reported
+func (gauge *Gauge) DeleteLabels(labels map[string]string) {
+	gauge.g.deleteLabels(labels)
+}
+
 // WriteMetric writes the metric into the output stream
 func (gauge *Gauge) WriteMetric(buf *strings.Builder, parentLabels string) {
 	gauge.g.writeMetric(buf, "gauge", parentLabels)
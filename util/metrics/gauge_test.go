@@ -131,6 +131,18 @@ func TestGaugeLabels(t *testing.T) {
 	require.Contains(t, res, `testname{p1=v1,p2="v2",a="b"} 2`)
 	require.Contains(t, res, `testname{p1=v1,p2="v2",c="d"} 10`)
 
+	m.DeleteLabels(map[string]string{"a": "b"})
+	m.DeleteLabels(map[string]string{"e": "f"})
+	require.Equal(t, uint64(0), m.GetUint64ValueForLabels(map[string]string{"a": "b"}))
+	require.Equal(t, uint64(10), m.GetUint64ValueForLabels(map[string]string{"c": "d"}))
+	buf = strings.Builder{}
+	m.WriteMetric(&buf, "")
+	res = buf.String()
+	require.NotContains(t, res, `a="b"`)
+	require.Contains(t, res, `testname{c="d"} 10`)
+	m.SetLabels(3, map[string]string{"c": "d"})
+	require.Equal(t, uint64(3), m.GetUint64ValueForLabels(map[string]string{"c": "d"}))
+
 	m = MakeGauge(MetricName{Name: "testname2", Description: "testhelp2"})
 	m.Deregister(nil)
 
@@ -163,6 +163,24 @@ var (
 	// TransactionMessagesP2PValidateMessage "Number of p2p pubsub transaction messages received for validation"
 	TransactionMessagesP2PValidateMessage = MetricName{Name: "algod_transaction_messages_p2p_validate", Description: "Number of p2p pubsub transaction messages received for validation"}
 
+	// NetworkP2PGossipSubScoredPeers "Number of peers tracked by the gossipsub peer scorer"
+	NetworkP2PGossipSubScoredPeers = MetricName{Name: "algod_network_p2p_gs_scored_peers", Description: "Number of peers tracked by the gossipsub peer scorer"}
+	// NetworkP2PGossipSubPeersBelowThreshold "Number of gossipsub peers with a score below a threshold"
+	NetworkP2PGossipSubPeersBelowThreshold = MetricName{Name: "algod_network_p2p_gs_peers_below_threshold", Description: "Number of gossipsub peers with a score below a threshold"}
+	// NetworkP2PGossipSubTopicMeshPeers "Number of scored gossipsub peers in a topic mesh"
+	NetworkP2PGossipSubTopicMeshPeers = MetricName{Name: "algod_network_p2p_gs_topic_mesh_peers", Description: "Number of scored gossipsub peers in a topic mesh"}
+	// NetworkP2PGossipSubTopicInvalidDeliveries "Decayed number of invalid messages delivered by peers on a topic"
+	NetworkP2PGossipSubTopicInvalidDeliveries = MetricName{Name: "algod_network_p2p_gs_topic_invalid_deliveries", Description: "Decayed number of invalid messages delivered by peers on a topic"}
+
+	// ParticipationKeyRoundsRemaining "Number of rounds until the registered participation key of an account expires"
+	ParticipationKeyRoundsRemaining = MetricName{Name: "algod_participation_key_rounds_remaining", Description: "Number of rounds until the registered participation key of an account expires"}
+	// ParticipationRoundsSinceVote "Number of rounds since an online account last voted"
+	ParticipationRoundsSinceVote = MetricName{Name: "algod_participation_rounds_since_vote", Description: "Number of rounds since an online account last voted"}
+	// ParticipationAccountOnline "Whether an account with participation keys is online"
+	ParticipationAccountOnline = MetricName{Name: "algod_participation_account_online", Description: "Whether an account with participation keys is online"}
+	// ParticipationAlerts "Whether a participation alert is raised for an account"
+	ParticipationAlerts = MetricName{Name: "algod_participation_alerts", Description: "Whether a participation alert is raised for an account"}
+
 	// TransactionGroupTxSyncHandled "Number of transaction groups handled via txsync"
 	TransactionGroupTxSyncHandled = MetricName{Name: "algod_transaction_group_txsync_handled", Description: "Number of transaction groups handled via txsync"}
 	// TransactionGroupTxSyncRemember "Number of transaction groups remembered via txsync"
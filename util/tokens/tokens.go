@@ -48,7 +48,10 @@ const maximumAPITokenLength = 256
 const (
 	AlgodTokenFilename      = "algod.token"
 	AlgodAdminTokenFilename = "algod.admin.token"
-	KmdTokenFilename        = "kmd.token"
+	// AlgodScopedTokensFilename defines named algod tokens restricted to
+	// some endpoints
+	AlgodScopedTokensFilename = "algod_tokens.json"
+	KmdTokenFilename          = "kmd.token"
 )
 
 func tokenFilepath(dataDir, tokenFilename string) string {
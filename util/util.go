@@ -88,3 +88,13 @@ func GetCurrentProcessTimes() (utime int64, stime int64, err error) {
 	}
 	return
 }
+
+// FreeDiskSpace returns the number of bytes available to unprivileged users on the file system holding path.
+func FreeDiskSpace(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(path, &stat)
+	if err != nil {
+		return 0, fmt.Errorf("FreeDiskSpace() err: %w", err)
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
@@ -37,6 +37,8 @@ import (
 	"math"
 	"syscall"
 	"time"
+
+	"golang.org/x/sys/windows"
 )
 
 /* misc */
@@ -81,6 +83,20 @@ func GetCurrentProcessTimes() (utime int64, stime int64, err error) {
 	return
 }
 
+// FreeDiskSpace returns the number of bytes available to the current user on the disk holding path.
+func FreeDiskSpace(path string) (uint64, error) {
+	pathPtr, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+	var freeBytesAvailable, totalBytes, totalFreeBytes uint64
+	err = windows.GetDiskFreeSpaceEx(pathPtr, &freeBytesAvailable, &totalBytes, &totalFreeBytes)
+	if err != nil {
+		return 0, err
+	}
+	return freeBytesAvailable, nil
+}
+
 func filetimeToDuration(ft *syscall.Filetime) time.Duration {
 	n := int64(ft.HighDateTime)<<32 + int64(ft.LowDateTime) // in 100-nanosecond intervals
 	return time.Duration(n * 100)